@@ -245,6 +245,71 @@ func (m *AISbp) GetInfo(clusterConf cmn.BackendConfAIS) (res meta.RemAisVec) {
 	return
 }
 
+// Ping measures round-trip latency to each node of each attached cluster:
+// remote proxies (control plane) and remote targets (data plane - the ones
+// that get redirected to when reading, writing, and copying remote objects).
+// Each node is probed via its public network health endpoint (see also `ais remote-cluster ping`).
+func (m *AISbp) Ping(timeout time.Duration) []*meta.RemAisPing {
+	var (
+		cliPlain, cliTLS = cmn.NewDefaultClients(timeout)
+		res              []*meta.RemAisPing
+		wg               sync.WaitGroup
+	)
+	m.mu.RLock()
+	res = make([]*meta.RemAisPing, 0, len(m.remote))
+	remotes := make([]*remAis, 0, len(m.remote))
+	for uuid, remAis := range m.remote {
+		out := &meta.RemAisPing{UUID: uuid}
+		for a, u := range m.alias {
+			if uuid == u {
+				out.Alias = a
+				break
+			}
+		}
+		res = append(res, out)
+		remotes = append(remotes, remAis)
+	}
+	m.mu.RUnlock()
+
+	for i, remAis := range remotes {
+		out := res[i]
+		client := cliPlain
+		if cos.IsHTTPS(remAis.url) {
+			client = cliTLS
+		}
+		// current remote Smap
+		smap, err := api.GetClusterMap(api.BaseParams{Client: client, URL: remAis.url, UA: ua})
+		if err != nil {
+			out.Err = err.Error()
+			smap = remAis.smap // (still try the last known one)
+		}
+		if smap == nil {
+			continue
+		}
+		out.Nodes = make([]*meta.RemAisNodePing, 0, smap.Count())
+		for _, nmap := range []meta.NodeMap{smap.Pmap, smap.Tmap} {
+			for _, si := range nmap {
+				np := &meta.RemAisNodePing{ID: si.ID(), URL: si.URL(cmn.NetPublic), Proxy: si.IsProxy()}
+				out.Nodes = append(out.Nodes, np)
+				wg.Add(1)
+				go _ping(np, client, &wg)
+			}
+		}
+	}
+	wg.Wait()
+	return res
+}
+
+func _ping(np *meta.RemAisNodePing, client *http.Client, wg *sync.WaitGroup) {
+	started := time.Now()
+	err := api.Health(api.BaseParams{Client: client, URL: np.URL, UA: ua})
+	np.Latency = int64(time.Since(started))
+	if err != nil {
+		np.Err = err.Error()
+	}
+	wg.Done()
+}
+
 func remaisClients(clientConf *cmn.ClientConf) (client, clientTLS *http.Client) {
 	return cmn.NewDefaultClients(clientConf.Timeout.D())
 }
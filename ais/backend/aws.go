@@ -16,6 +16,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	ratomic "sync/atomic"
 	"time"
 
 	aiss3 "github.com/NVIDIA/aistore/ais/s3"
@@ -46,8 +47,9 @@ type (
 		base
 	}
 	sessConf struct {
-		bck    *cmn.Bck
-		region string
+		bck       *cmn.Bck
+		region    string
+		pathStyle bool // config.Backend.ClientConf(aws)
 	}
 )
 
@@ -56,6 +58,9 @@ var (
 	// per (profile, region, endpoint) triplet
 	clients sync.Map
 
+	// client tuning (config.Backend.ClientConf) the cached clients were created with
+	clientConf ratomic.Pointer[cmn.BackendClientConf]
+
 	s3Endpoint string
 	awsProfile string
 )
@@ -441,6 +446,8 @@ func (*s3bp) HeadObj(_ context.Context, lom *core.LOM, oreq *http.Request) (oa *
 	var (
 		svc        *s3.Client
 		headOutput *s3.HeadObjectOutput
+		input      s3.HeadObjectInput
+		sse        string
 		h          = cmn.BackendHelpers.Amazon
 		cloudBck   = lom.Bck().RemoteBck()
 		sessConf   = sessConf{bck: cloudBck}
@@ -463,10 +470,14 @@ func (*s3bp) HeadObj(_ context.Context, lom *core.LOM, oreq *http.Request) (oa *
 	if err != nil {
 		return
 	}
-	headOutput, err = svc.HeadObject(context.Background(), &s3.HeadObjectInput{
+	input = s3.HeadObjectInput{
 		Bucket: aws.String(cloudBck.Name),
 		Key:    aws.String(lom.ObjName),
-	})
+	}
+	if ssec := _ssec(oreq); ssec != nil {
+		input.SSECustomerAlgorithm, input.SSECustomerKey, input.SSECustomerKeyMD5 = ssecArgs(ssec)
+	}
+	headOutput, err = svc.HeadObject(context.Background(), &input)
 	if err != nil {
 		ecode, err = awsErrorToAISError(err, cloudBck, lom.ObjName)
 		return
@@ -479,15 +490,19 @@ func (*s3bp) HeadObj(_ context.Context, lom *core.LOM, oreq *http.Request) (oa *
 		lom.SetCustomKey(cmn.VersionObjMD, v)
 		oa.SetVersion(v)
 	}
+	sse = aiss3.SSEStatus(string(headOutput.ServerSideEncryption), aws.ToString(headOutput.SSECustomerAlgorithm))
+	if sse != "" {
+		oa.SetCustomKey(cmn.SSEObjMD, sse)
+	}
 	if v, ok := h.EncodeCksum(headOutput.ETag); ok {
 		oa.SetCustomKey(cmn.ETag, v)
-		// assuming SSE-S3 or plaintext encryption
+		// ETag is MD5 only when plaintext or SSE-S3 encrypted (and not multipart);
 		// from https://docs.aws.amazon.com/AmazonS3/latest/API/API_Object.html:
 		// - "The entity tag is a hash of the object. The ETag reflects changes only
 		//    to the contents of an object, not its metadata."
 		// - "The ETag may or may not be an MD5 digest of the object data. Whether or
 		//    not it is depends on how the object was created and how it is encrypted..."
-		if !cmn.IsS3MultipartEtag(v) {
+		if !cmn.IsS3MultipartEtag(v) && aiss3.ETagIsMD5(sse) {
 			oa.SetCustomKey(cmn.MD5ObjMD, v)
 		}
 	}
@@ -544,7 +559,7 @@ func (s3bp *s3bp) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, oreq *
 		}
 	}
 
-	res = s3bp.GetObjReader(ctx, lom, 0, 0)
+	res = s3bp.getObjReader(ctx, lom, 0, 0, _ssec(oreq))
 
 finalize:
 	if res.Err != nil {
@@ -559,7 +574,11 @@ finalize:
 	return 0, err
 }
 
-func (*s3bp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
+func (s3bp *s3bp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int64) core.GetReaderResult {
+	return s3bp.getObjReader(ctx, lom, offset, length, nil)
+}
+
+func (*s3bp) getObjReader(ctx context.Context, lom *core.LOM, offset, length int64, ssec *aiss3.SSEC) (res core.GetReaderResult) {
 	var (
 		obj      *s3.GetObjectOutput
 		cloudBck = lom.Bck().RemoteBck()
@@ -569,6 +588,9 @@ func (*s3bp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int
 			Key:    aws.String(lom.ObjName),
 		}
 	)
+	if ssec != nil {
+		input.SSECustomerAlgorithm, input.SSECustomerKey, input.SSECustomerKeyMD5 = ssecArgs(ssec)
+	}
 	svc, err := sessConf.s3client("[get_obj_reader]")
 	if err != nil {
 		res.Err = err
@@ -617,10 +639,14 @@ func _getCustom(lom *core.LOM, obj *s3.GetObjectOutput) (md5 *cos.Cksum) {
 		lom.SetVersion(v)
 		lom.SetCustomKey(cmn.VersionObjMD, v)
 	}
+	sse := aiss3.SSEStatus(string(obj.ServerSideEncryption), aws.ToString(obj.SSECustomerAlgorithm))
+	if sse != "" {
+		lom.SetCustomKey(cmn.SSEObjMD, sse)
+	}
 	// see ETag/MD5 NOTE above
 	if v, ok := h.EncodeCksum(obj.ETag); ok {
 		lom.SetCustomKey(cmn.ETag, v)
-		if !cmn.IsS3MultipartEtag(v) {
+		if !cmn.IsS3MultipartEtag(v) && aiss3.ETagIsMD5(sse) {
 			md5 = cos.NewCksum(cos.ChecksumMD5, v)
 			lom.SetCustomKey(cmn.MD5ObjMD, v)
 		}
@@ -639,6 +665,9 @@ func (*s3bp) PutObj(r io.ReadCloser, lom *core.LOM, oreq *http.Request) (ecode i
 		svc                   *s3.Client
 		uploader              *s3manager.Uploader
 		uploadOutput          *s3manager.UploadOutput
+		input                 s3.PutObjectInput
+		ssec                  *aiss3.SSEC
+		sse                   string
 		h                     = cmn.BackendHelpers.Amazon
 		cksumType, cksumValue = lom.Checksum().Get()
 		cloudBck              = lom.Bck().RemoteBck()
@@ -656,6 +685,7 @@ func (*s3bp) PutObj(r io.ReadCloser, lom *core.LOM, oreq *http.Request) (ecode i
 			uploadOutput = &s3manager.UploadOutput{
 				ETag: aws.String(resp.Header.Get(cos.HdrETag)),
 			}
+			sse = aiss3.SSEStatus(resp.Header.Get(cos.S3HdrSSE), resp.Header.Get(cos.S3HdrSSECAlgorithm))
 			goto exit
 		}
 	}
@@ -668,18 +698,30 @@ func (*s3bp) PutObj(r io.ReadCloser, lom *core.LOM, oreq *http.Request) (ecode i
 	md[cos.S3MetadataChecksumType] = cksumType
 	md[cos.S3MetadataChecksumVal] = cksumValue
 
-	uploader = s3manager.NewUploader(svc)
-	uploadOutput, err = uploader.Upload(context.Background(), &s3.PutObjectInput{
+	input = s3.PutObjectInput{
 		Bucket:   aws.String(cloudBck.Name),
 		Key:      aws.String(lom.ObjName),
 		Body:     r,
 		Metadata: md,
-	})
+	}
+	if ssec = _ssec(oreq); ssec != nil {
+		input.SSECustomerAlgorithm, input.SSECustomerKey, input.SSECustomerKeyMD5 = ssecArgs(ssec)
+	} else if keyID := sseKMSKeyID(cloudBck); keyID != "" {
+		input.ServerSideEncryption, input.SSEKMSKeyId = types.ServerSideEncryptionAwsKms, aws.String(keyID)
+	}
+
+	uploader = s3manager.NewUploader(svc)
+	uploadOutput, err = uploader.Upload(context.Background(), &input)
 	if err != nil {
 		ecode, err = awsErrorToAISError(err, cloudBck, lom.ObjName)
 		cos.Close(r)
 		return
 	}
+	if ssec != nil {
+		sse = aiss3.SSECustomer
+	} else {
+		sse = string(uploadOutput.ServerSideEncryption)
+	}
 
 exit:
 	// compare with setCustomS3() above
@@ -687,10 +729,13 @@ exit:
 		lom.SetCustomKey(cmn.VersionObjMD, v)
 		lom.SetVersion(v)
 	}
+	if sse != "" {
+		lom.SetCustomKey(cmn.SSEObjMD, sse)
+	}
 	if v, ok := h.EncodeCksum(uploadOutput.ETag); ok {
 		lom.SetCustomKey(cmn.ETag, v)
 		// see ETag/MD5 NOTE above
-		if !cmn.IsS3MultipartEtag(v) {
+		if !cmn.IsS3MultipartEtag(v) && aiss3.ETagIsMD5(sse) {
 			lom.SetCustomKey(cmn.MD5ObjMD, v)
 		}
 	}
@@ -743,7 +788,12 @@ func (sessConf *sessConf) s3client(tag string) (*s3.Client, error) {
 	var (
 		endpoint = s3Endpoint
 		profile  = awsProfile
+		cc       = awsClientConf()
 	)
+	if cc.Endpoint != "" {
+		endpoint = cc.Endpoint
+	}
+	sessConf.pathStyle = cc.UsePathStyle
 	if sessConf.bck != nil && sessConf.bck.Props != nil {
 		if sessConf.region == "" {
 			sessConf.region = sessConf.bck.Props.Extra.AWS.CloudRegion
@@ -765,7 +815,7 @@ func (sessConf *sessConf) s3client(tag string) (*s3.Client, error) {
 	}
 
 	// slow path
-	cfg, err := loadConfig(endpoint, profile)
+	cfg, err := loadConfig(endpoint, profile, cc)
 	if err != nil {
 		return nil, err
 	}
@@ -803,6 +853,28 @@ func (sessConf *sessConf) options(options *s3.Options) {
 			options.UsePathStyle = cmn.Rom.Features().IsSet(feat.S3UsePathStyle)
 		}
 	}
+	if sessConf.pathStyle {
+		options.UsePathStyle = true
+	}
+}
+
+// returns current client tuning; when changed, drops all cached clients
+// (to be recreated with the new settings)
+func awsClientConf() *cmn.BackendClientConf {
+	cc := cmn.GCO.Get().Backend.ClientConf(apc.AWS)
+	prev := clientConf.Load()
+	if prev != nil && *prev == cc {
+		return prev
+	}
+	if prev != nil {
+		clients.Range(func(k, _ any) bool {
+			clients.Delete(k)
+			return true
+		})
+		nlog.Infoln("backend.aws client tuning changed - resetting s3 clients")
+	}
+	clientConf.Store(&cc)
+	return &cc
 }
 
 func _cid(profile, region, endpoint string) string {
@@ -822,13 +894,16 @@ func _cid(profile, region, endpoint string) string {
 }
 
 // loadConfig create config using default creds from ~/.aws/credentials and environment variables.
-func loadConfig(endpoint, profile string) (aws.Config, error) {
+func loadConfig(endpoint, profile string, cc *cmn.BackendClientConf) (aws.Config, error) {
 	// NOTE: The AWS SDK for Go v2, uses lower case header maps by default.
-	cfg, err := config.LoadDefaultConfig(
-		context.Background(),
-		config.WithHTTPClient(cmn.NewClient(cmn.TransportArgs{})),
+	opts := []func(*config.LoadOptions) error{
+		config.WithHTTPClient(cmn.NewClient(cc.TransportArgs())),
 		config.WithSharedConfigProfile(profile),
-	)
+	}
+	if cc.MaxRetries > 0 {
+		opts = append(opts, config.WithRetryMaxAttempts(cc.MaxRetries))
+	}
+	cfg, err := config.LoadDefaultConfig(context.Background(), opts...)
 	if err != nil {
 		return cfg, err
 	}
@@ -894,6 +969,26 @@ func awsErrorToAISError(awsError error, bck *cmn.Bck, objName string) (int, erro
 	}
 }
 
+// default SSE-KMS key (bucket property), if configured
+func sseKMSKeyID(bck *cmn.Bck) string {
+	if bck.Props == nil {
+		return ""
+	}
+	return bck.Props.Extra.AWS.SSEKMSKeyID
+}
+
+func ssecArgs(ssec *aiss3.SSEC) (alg, key, keyMD5 *string) {
+	return aws.String(ssec.Algorithm), aws.String(ssec.Key), aws.String(ssec.KeyMD5)
+}
+
+// customer-provided key (SSE-C) that came with the original request, if any
+func _ssec(oreq *http.Request) *aiss3.SSEC {
+	if oreq == nil {
+		return nil
+	}
+	return aiss3.SSECFromHeader(oreq.Header)
+}
+
 // Strip original AWS error to its essentials: type code and error message
 // See also:
 // * ais/s3/err.go WriteErr() that (NOTE) relies on the formatting below
@@ -56,6 +56,12 @@ func StartMpt(lom *core.LOM, oreq *http.Request, oq url.Values) (id string, ecod
 			Key:    aws.String(lom.ObjName),
 		}
 	)
+	// (same SSE as in PutObj)
+	if ssec := _ssec(oreq); ssec != nil {
+		input.SSECustomerAlgorithm, input.SSECustomerKey, input.SSECustomerKeyMD5 = ssecArgs(ssec)
+	} else if keyID := sseKMSKeyID(cloudBck); keyID != "" {
+		input.ServerSideEncryption, input.SSEKMSKeyId = types.ServerSideEncryptionAwsKms, aws.String(keyID)
+	}
 	svc, errN := sessConf.s3client("[start_mpt]")
 	if errN != nil && cmn.Rom.FastV(5, cos.SmoduleBackend) {
 		nlog.Warningln(errN)
@@ -96,6 +102,10 @@ func PutMptPart(lom *core.LOM, r io.ReadCloser, oreq *http.Request, oq url.Value
 			ContentLength: &size,
 		}
 	)
+	// SSE-C: each part must carry the same customer-provided key
+	if ssec := _ssec(oreq); ssec != nil {
+		input.SSECustomerAlgorithm, input.SSECustomerKey, input.SSECustomerKeyMD5 = ssecArgs(ssec)
+	}
 	svc, errN := sessConf.s3client("[put_mpt_part]")
 	if errN != nil && cmn.Rom.FastV(5, cos.SmoduleBackend) {
 		nlog.Warningln(errN)
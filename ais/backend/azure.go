@@ -24,6 +24,7 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	ratomic "sync/atomic"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
@@ -46,9 +47,16 @@ type (
 	azbp struct {
 		t     core.TargetPut
 		creds *azblob.SharedKeyCredential
+		opts  ratomic.Pointer[azClientOpts]
 		u     string
 		base
 	}
+	// client tuning (config.Backend.ClientConf) => shared client options and endpoint
+	azClientOpts struct {
+		cc   cmn.BackendClientConf
+		opts azcore.ClientOptions
+		u    string
+	}
 )
 
 const (
@@ -116,6 +124,26 @@ func NewAzure(t core.TargetPut, tstats stats.Tracker) (core.Backend, error) {
 	return bp, nil
 }
 
+// returns shared client options - recreated when the configured tuning changes
+func (azbp *azbp) clientOpts() *azClientOpts {
+	cc := cmn.GCO.Get().Backend.ClientConf(apc.Azure)
+	if o := azbp.opts.Load(); o != nil && o.cc == cc {
+		return o
+	}
+	o := &azClientOpts{cc: cc, u: azbp.u}
+	if cc.Endpoint != "" {
+		o.u = cc.Endpoint
+	}
+	if targs := cc.TransportArgs(); targs != (cmn.TransportArgs{}) {
+		o.opts.Transport = cmn.NewClient(targs)
+	}
+	if cc.MaxRetries > 0 {
+		o.opts.Retry.MaxRetries = int32(cc.MaxRetries)
+	}
+	azbp.opts.Store(o)
+	return o
+}
+
 // (compare w/ cmn/backend)
 func azEncodeEtag(etag azcore.ETag) string { return cmn.UnquoteCEV(string(etag)) }
 
@@ -214,9 +242,10 @@ func azureErrorToAISError(azureError error, bck *cmn.Bck, objName string) (int,
 func (azbp *azbp) HeadBucket(ctx context.Context, bck *meta.Bck) (cos.StrKVs, int, error) {
 	var (
 		cloudBck = bck.RemoteBck()
-		cntURL   = azbp.u + "/" + cloudBck.Name
+		o        = azbp.clientOpts()
+		cntURL   = o.u + "/" + cloudBck.Name
 	)
-	client, err := container.NewClientWithSharedKeyCredential(cntURL, azbp.creds, nil)
+	client, err := container.NewClientWithSharedKeyCredential(cntURL, azbp.creds, &container.ClientOptions{ClientOptions: o.opts})
 	if err != nil {
 		status, err := azureErrorToAISError(err, cloudBck, "")
 		return nil, status, err
@@ -251,11 +280,12 @@ func (azbp *azbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (
 	msg.PageSize = calcPageSize(msg.PageSize, bck.MaxPageSize())
 	var (
 		cloudBck = bck.RemoteBck()
-		cntURL   = azbp.u + "/" + cloudBck.Name
+		o        = azbp.clientOpts()
+		cntURL   = o.u + "/" + cloudBck.Name
 		num      = int32(msg.PageSize)
 		opts     = container.ListBlobsFlatOptions{Prefix: apc.Ptr(msg.Prefix), MaxResults: &num}
 	)
-	client, err := container.NewClientWithSharedKeyCredential(cntURL, azbp.creds, nil)
+	client, err := container.NewClientWithSharedKeyCredential(cntURL, azbp.creds, &container.ClientOptions{ClientOptions: o.opts})
 	if err != nil {
 		return azureErrorToAISError(err, cloudBck, "")
 	}
@@ -325,7 +355,8 @@ func (azbp *azbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (
 //
 
 func (azbp *azbp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, _ int, _ error) {
-	serviceClient, err := service.NewClientWithSharedKeyCredential(azbp.u, azbp.creds, nil)
+	o := azbp.clientOpts()
+	serviceClient, err := service.NewClientWithSharedKeyCredential(o.u, azbp.creds, &service.ClientOptions{ClientOptions: o.opts})
 	if err != nil {
 		status, err := azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
 		return nil, status, err
@@ -357,9 +388,10 @@ func (azbp *azbp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, _ int, _ error) {
 func (azbp *azbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (*cmn.ObjAttrs, int, error) {
 	var (
 		cloudBck = lom.Bucket().RemoteBck()
-		blURL    = azbp.u + "/" + cloudBck.Name + "/" + lom.ObjName
+		o        = azbp.clientOpts()
+		blURL    = o.u + "/" + cloudBck.Name + "/" + lom.ObjName
 	)
-	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, nil)
+	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, &blockblob.ClientOptions{ClientOptions: o.opts})
 	if err != nil {
 		status, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
 		return nil, status, err
@@ -420,9 +452,10 @@ func (azbp *azbp) GetObj(ctx context.Context, lom *core.LOM, owt cmn.OWT, _ *htt
 func (azbp *azbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, length int64) (res core.GetReaderResult) {
 	var (
 		cloudBck = lom.Bucket().RemoteBck()
-		blURL    = azbp.u + "/" + cloudBck.Name + "/" + lom.ObjName
+		o        = azbp.clientOpts()
+		blURL    = o.u + "/" + cloudBck.Name + "/" + lom.ObjName
 	)
-	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, nil)
+	client, err := blockblob.NewClientWithSharedKeyCredential(blURL, azbp.creds, &blockblob.ClientOptions{ClientOptions: o.opts})
 	if err != nil {
 		res.ErrCode, res.Err = azureErrorToAISError(err, cloudBck, lom.ObjName)
 		return
@@ -476,7 +509,8 @@ func (azbp *azbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 func (azbp *azbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (int, error) {
 	defer cos.Close(r)
 
-	client, err := azblob.NewClientWithSharedKeyCredential(azbp.u, azbp.creds, nil)
+	o := azbp.clientOpts()
+	client, err := azblob.NewClientWithSharedKeyCredential(o.u, azbp.creds, &azblob.ClientOptions{ClientOptions: o.opts})
 	if err != nil {
 		return azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
 	}
@@ -511,7 +545,8 @@ func (azbp *azbp) PutObj(r io.ReadCloser, lom *core.LOM, _ *http.Request) (int,
 //
 
 func (azbp *azbp) DeleteObj(lom *core.LOM) (int, error) {
-	client, err := azblob.NewClientWithSharedKeyCredential(azbp.u, azbp.creds, nil)
+	o := azbp.clientOpts()
+	client, err := azblob.NewClientWithSharedKeyCredential(o.u, azbp.creds, &azblob.ClientOptions{ClientOptions: o.opts})
 	if err != nil {
 		return azureErrorToAISError(err, &cmn.Bck{Provider: apc.Azure}, "")
 	}
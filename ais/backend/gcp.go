@@ -96,13 +96,17 @@ func NewGCP(t core.TargetPut, tstats stats.Tracker) (_ core.Backend, err error)
 	return bp, err
 }
 
+// NOTE: client tuning (config.Backend.ClientConf) is applied once, at startup
 func (gsbp *gsbp) createClient(ctx context.Context) (*storage.Client, error) {
-	opts := []option.ClientOption{option.WithScopes(storage.ScopeFullControl)}
+	var (
+		cc   = cmn.GCO.Get().Backend.ClientConf(apc.GCP)
+		opts = []option.ClientOption{option.WithScopes(storage.ScopeFullControl)}
+	)
 	if gsbp.projectID == "" {
 		opts = append(opts, option.WithoutAuthentication())
 	}
 	// create HTTP transport
-	transport, err := htransport.NewTransport(ctx, cmn.NewTransport(cmn.TransportArgs{}), opts...)
+	transport, err := htransport.NewTransport(ctx, cmn.NewTransport(cc.TransportArgs()), opts...)
 	if err != nil {
 		if strings.Contains(err.Error(), "credentials") {
 			details := fmt.Sprintf("%s Hint: check your %q and %q environment settings for project ID=%q.",
@@ -111,12 +115,18 @@ func (gsbp *gsbp) createClient(ctx context.Context) (*storage.Client, error) {
 		}
 		return nil, cmn.NewErrFailedTo(nil, "gcp-backend: create", "http transport", err)
 	}
-	opts = append(opts, option.WithHTTPClient(&http.Client{Transport: transport}))
+	opts = append(opts, option.WithHTTPClient(&http.Client{Transport: transport, Timeout: cc.Timeout.D()}))
+	if cc.Endpoint != "" {
+		opts = append(opts, option.WithEndpoint(cc.Endpoint))
+	}
 	// create HTTP client
 	client, err := storage.NewClient(ctx, opts...)
 	if err != nil {
 		return nil, cmn.NewErrFailedTo(nil, "gcp-backend: create", "client", err)
 	}
+	if cc.MaxRetries > 0 {
+		client.SetRetry(storage.WithMaxAttempts(cc.MaxRetries))
+	}
 	return client, nil
 }
 
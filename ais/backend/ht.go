@@ -24,9 +24,10 @@ import (
 
 type (
 	htbp struct {
-		t      core.TargetPut
-		cliH   *http.Client
-		cliTLS *http.Client
+		t       core.TargetPut
+		cliH    *http.Client
+		cliTLS  *http.Client
+		indexes htIndexes // see htlist.go
 		base
 	}
 )
@@ -39,7 +40,13 @@ func NewHT(t core.TargetPut, config *cmn.Config, tstats stats.Tracker) (core.Bac
 		t:    t,
 		base: base{provider: apc.HT},
 	}
-	bp.cliH, bp.cliTLS = cmn.NewDefaultClients(config.Client.TimeoutLong.D())
+	cc := config.Backend.ClientConf(apc.HT)
+	targs := cc.TransportArgs()
+	if targs.Timeout == 0 {
+		targs.Timeout = config.Client.TimeoutLong.D()
+	}
+	bp.cliH = cmn.NewClient(targs)
+	bp.cliTLS = cmn.NewClientTLS(targs, cmn.TLSArgs{SkipVerify: true}, false /*intra-cluster*/)
 	bp.init(t.Snode(), tstats)
 	return bp, nil
 }
@@ -85,16 +92,6 @@ func (htbp *htbp) HeadBucket(ctx context.Context, bck *meta.Bck) (bckProps cos.S
 	return
 }
 
-func (*htbp) ListObjects(*meta.Bck, *apc.LsoMsg, *cmn.LsoRes) (ecode int, err error) {
-	debug.Assert(false)
-	return
-}
-
-func (*htbp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, ecode int, err error) {
-	debug.Assert(false)
-	return
-}
-
 func getOriginalURL(ctx context.Context, bck *meta.Bck, objName string) (string, error) {
 	origURL, ok := ctx.Value(cos.CtxOriginalURL).(string)
 	if !ok || origURL == "" {
@@ -111,10 +108,7 @@ func getOriginalURL(ctx context.Context, bck *meta.Bck, objName string) (string,
 }
 
 func (htbp *htbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (oa *cmn.ObjAttrs, ecode int, err error) {
-	var (
-		h   = cmn.BackendHelpers.HTTP
-		bck = lom.Bck() // TODO: This should be `cloudBck = lom.Bck().RemoteBck()`
-	)
+	bck := lom.Bck() // TODO: This should be `cloudBck = lom.Bck().RemoteBck()`
 	origURL, err := getOriginalURL(ctx, bck, lom.ObjName)
 	debug.AssertNoErr(err)
 
@@ -134,9 +128,10 @@ func (htbp *htbp) HeadObj(ctx context.Context, lom *core.LOM, _ *http.Request) (
 	if resp.ContentLength >= 0 {
 		oa.Size = resp.ContentLength
 	}
-	if v, ok := h.EncodeVersion(resp.Header.Get(cos.HdrETag)); ok {
-		oa.SetCustomKey(cmn.ETag, v)
+	if v, ok := htVersion(resp.Header); ok {
+		oa.SetVersion(v)
 	}
+	htCustom(resp.Header, oa.CustomMD)
 	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
 		nlog.Infof("[head_object] %s", lom)
 	}
@@ -164,7 +159,6 @@ func (htbp *htbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 	var (
 		req  *http.Request
 		resp *http.Response
-		h    = cmn.BackendHelpers.HTTP
 		bck  = lom.Bck() // TODO: This should be `cloudBck = lom.Bck().RemoteBck()`
 	)
 
@@ -200,9 +194,10 @@ func (htbp *htbp) GetObjReader(ctx context.Context, lom *core.LOM, offset, lengt
 
 	lom.SetCustomKey(cmn.SourceObjMD, apc.HT)
 	lom.SetCustomKey(cmn.OrigURLObjMD, origURL)
-	if v, ok := h.EncodeVersion(resp.Header.Get(cos.HdrETag)); ok {
-		lom.SetCustomKey(cmn.ETag, v)
+	if v, ok := htVersion(resp.Header); ok {
+		lom.SetVersion(v)
 	}
+	htCustom(resp.Header, lom.GetCustomMD())
 	res.Size = resp.ContentLength
 	res.R = resp.Body
 	return res
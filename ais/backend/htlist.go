@@ -0,0 +1,392 @@
+//go:build ht
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Listing ht:// buckets (bucket property extra.http.index):
+// - "html": crawl HTML directory index pages (nginx autoindex, Apache mod_autoindex, `python -m http.server`, etc.)
+//   starting from the bucket's original URL; follow only those links that point to the same or deeper location;
+// - manifest URL: plain text, one object per line: name (relative to the original URL, or full URL) and,
+//   optionally, size in bytes - separated by whitespace; empty lines and '#' comments are ignored;
+// - the resulting (sorted) list is cached for a short while - to serve subsequent pages;
+// - sizes (unless provided by the manifest), versions (ETag or Last-Modified), and custom metadata
+//   are obtained via HEAD - and only if requested.
+
+const (
+	htIndexTTL      = time.Minute
+	htCrawlMaxDepth = 32
+	htCrawlMaxPages = 10_000
+	htPageMaxSize   = 16 * cos.MiB // max size of a single HTML page (or manifest: x 64)
+	htHeadWorkers   = 16
+)
+
+type (
+	htEnt struct {
+		name string
+		size int64 // -1 when unknown
+	}
+	htIndex struct {
+		ents []htEnt
+		ts   int64 // mono time
+	}
+	htIndexes struct {
+		m  map[string]*htIndex
+		mu sync.Mutex
+	}
+)
+
+var htHref = regexp.MustCompile(`(?i)<a\s[^>]*?href\s*=\s*["']([^"']+)["']`)
+
+func (htbp *htbp) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoRes) (int, error) {
+	if bck.Props == nil || !bck.Props.Extra.HTTP.Listable() {
+		return http.StatusBadRequest, cmn.NewErrUnsupp("list remote objects of", bck.Cname("")+
+			" (hint: set bucket property extra.http.index)")
+	}
+	ents, err := htbp.index(bck)
+	if err != nil {
+		return http.StatusBadGateway, err
+	}
+	msg.PageSize = calcPageSize(msg.PageSize, bck.MaxPageSize())
+
+	// start after the continuation token (that is, the last name of the previous page)
+	i := sort.Search(len(ents), func(i int) bool {
+		if msg.ContinuationToken != "" {
+			return ents[i].name > msg.ContinuationToken
+		}
+		return ents[i].name >= msg.Prefix
+	})
+	var (
+		dirs    = cos.StrSet{}
+		noRecur = msg.IsFlagSet(apc.LsNoRecursion)
+	)
+	lst.Entries = lst.Entries[:0]
+	lst.ContinuationToken = ""
+	for ; i < len(ents); i++ {
+		ent := &ents[i]
+		if noRecur && cos.IsLastB(msg.ContinuationToken, '/') && strings.HasPrefix(ent.name, msg.ContinuationToken) {
+			continue // (virtual directory returned by the previous page)
+		}
+		if !strings.HasPrefix(ent.name, msg.Prefix) {
+			if ent.name > msg.Prefix {
+				break
+			}
+			continue
+		}
+		if int64(len(lst.Entries)) >= msg.PageSize {
+			lst.ContinuationToken = lst.Entries[len(lst.Entries)-1].Name
+			break
+		}
+		if noRecur {
+			if j := strings.IndexByte(ent.name[len(msg.Prefix):], '/'); j >= 0 {
+				dir := ent.name[:len(msg.Prefix)+j+1]
+				if !msg.IsFlagSet(apc.LsNoDirs) && !dirs.Contains(dir) {
+					dirs.Add(dir)
+					lst.Entries = append(lst.Entries, &cmn.LsoEnt{Name: dir, Flags: apc.EntryIsDir})
+				}
+				continue
+			}
+		}
+		en := &cmn.LsoEnt{Name: ent.name}
+		if ent.size >= 0 {
+			en.Size = ent.size
+		}
+		lst.Entries = append(lst.Entries, en)
+	}
+
+	// (size, version, custom)
+	if !msg.IsFlagSet(apc.LsNameOnly) {
+		htbp.headEntries(bck, msg, lst.Entries, ents)
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleBackend) {
+		nlog.Infoln("[list_objects]", bck.Cname(msg.Prefix), "count", len(lst.Entries), "total", len(ents))
+	}
+	return 0, nil
+}
+
+func (*htbp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, ecode int, err error) {
+	return nil, http.StatusBadRequest, cmn.NewErrUnsupp("list", "buckets of HTTP provider")
+}
+
+// cached or (re)built
+func (htbp *htbp) index(bck *meta.Bck) ([]htEnt, error) {
+	var (
+		extra = &bck.Props.Extra.HTTP
+		key   = bck.Cname("") + "\x00" + extra.Index
+	)
+	htbp.indexes.mu.Lock()
+	idx, ok := htbp.indexes.m[key]
+	htbp.indexes.mu.Unlock()
+	if ok && mono.Since(idx.ts) < htIndexTTL {
+		return idx.ents, nil
+	}
+
+	var (
+		ents []htEnt
+		err  error
+		base = extra.OrigURLBck
+	)
+	if !cos.IsLastB(base, '/') {
+		base += "/"
+	}
+	if extra.Index == apc.HTIndexHTML {
+		ents, err = htbp.crawl(base)
+	} else {
+		ents, err = htbp.manifest(base, extra.Index)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(ents, func(i, j int) bool { return ents[i].name < ents[j].name })
+
+	htbp.indexes.mu.Lock()
+	if htbp.indexes.m == nil {
+		htbp.indexes.m = make(map[string]*htIndex, 4)
+	}
+	for k, v := range htbp.indexes.m {
+		if mono.Since(v.ts) > htIndexTTL {
+			delete(htbp.indexes.m, k)
+		}
+	}
+	htbp.indexes.m[key] = &htIndex{ents: ents, ts: mono.NanoTime()}
+	htbp.indexes.mu.Unlock()
+	return ents, nil
+}
+
+// breadth-first crawl of HTML directory index pages
+func (htbp *htbp) crawl(base string) ([]htEnt, error) {
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return nil, err
+	}
+	type page struct {
+		u     *url.URL
+		depth int
+	}
+	var (
+		ents  []htEnt
+		seen  = cos.StrSet{baseURL.Path: {}}
+		queue = []page{{baseURL, 0}}
+		npage int
+	)
+	for len(queue) > 0 {
+		pg := queue[0]
+		queue = queue[1:]
+		if npage++; npage > htCrawlMaxPages {
+			return nil, fmt.Errorf("%s: exceeded max number of index pages (%d)", base, htCrawlMaxPages)
+		}
+		hrefs, err := htbp.hrefs(pg.u.String())
+		if err != nil {
+			return nil, err
+		}
+		for _, href := range hrefs {
+			u, err := pg.u.Parse(href)
+			if err != nil || u.RawQuery != "" { // (e.g., Apache's "?C=N;O=D" sorting links)
+				continue
+			}
+			if u.Scheme != baseURL.Scheme || u.Host != baseURL.Host || !strings.HasPrefix(u.Path, baseURL.Path) {
+				continue // parent directory, external link, etc.
+			}
+			if seen.Contains(u.Path) {
+				continue
+			}
+			seen.Add(u.Path)
+			if cos.IsLastB(u.Path, '/') {
+				if pg.depth < htCrawlMaxDepth {
+					u.Fragment = ""
+					queue = append(queue, page{u, pg.depth + 1})
+				}
+				continue
+			}
+			ents = append(ents, htEnt{name: u.Path[len(baseURL.Path):], size: -1})
+		}
+	}
+	return ents, nil
+}
+
+func (htbp *htbp) hrefs(pageURL string) ([]string, error) {
+	resp, err := htbp.get(pageURL)
+	if err != nil {
+		return nil, err
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, htPageMaxSize))
+	cos.Close(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	matches := htHref.FindAllSubmatch(b, -1)
+	hrefs := make([]string, 0, len(matches))
+	for _, m := range matches {
+		hrefs = append(hrefs, html.UnescapeString(string(m[1])))
+	}
+	return hrefs, nil
+}
+
+func (htbp *htbp) manifest(base, murl string) ([]htEnt, error) {
+	resp, err := htbp.get(murl)
+	if err != nil {
+		return nil, err
+	}
+	defer cos.Close(resp.Body)
+
+	var (
+		ents    []htEnt
+		seen    = cos.StrSet{}
+		scanner = bufio.NewScanner(io.LimitReader(resp.Body, 64*htPageMaxSize))
+	)
+	for lno := 1; scanner.Scan(); lno++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(line)
+		ent := htEnt{name: fields[0], size: -1}
+		switch {
+		case strings.HasPrefix(ent.name, base):
+			ent.name = ent.name[len(base):]
+		case cos.IsHT(ent.name) || cos.IsHTTPS(ent.name):
+			return nil, fmt.Errorf("manifest %s, line %d: %q is not located under %s", murl, lno, ent.name, base)
+		default:
+			ent.name = strings.TrimLeft(ent.name, "/")
+		}
+		if len(fields) > 1 {
+			if ent.size, err = strconv.ParseInt(fields[1], 10, 64); err != nil || ent.size < 0 {
+				return nil, fmt.Errorf("manifest %s, line %d: invalid size %q", murl, lno, fields[1])
+			}
+		}
+		if ent.name == "" || seen.Contains(ent.name) {
+			continue
+		}
+		seen.Add(ent.name)
+		ents = append(ents, ent)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("manifest %s: %v", murl, err)
+	}
+	return ents, nil
+}
+
+func (htbp *htbp) get(u string) (*http.Response, error) {
+	resp, err := htbp.client(u).Get(u)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		cos.Close(resp.Body)
+		return nil, fmt.Errorf("GET(%s) failed, status %d", u, resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// HEAD listed objects (in parallel) when their size is unknown or when versions/custom metadata are requested
+func (htbp *htbp) headEntries(bck *meta.Bck, msg *apc.LsoMsg, entries cmn.LsoEntries, ents []htEnt) {
+	var (
+		wantMD = !msg.IsFlagSet(apc.LsNameSize) &&
+			(msg.WantProp(apc.GetPropsVersion) || msg.WantProp(apc.GetPropsCustom))
+		todo = make([]*cmn.LsoEnt, 0, len(entries))
+	)
+	for _, en := range entries {
+		if en.IsDir() {
+			continue
+		}
+		if wantMD || en.Size == 0 && !htSizeKnown(ents, en.Name) {
+			todo = append(todo, en)
+		}
+	}
+	if len(todo) == 0 {
+		return
+	}
+	var (
+		wg   sync.WaitGroup
+		ch   = make(chan *cmn.LsoEnt, len(todo))
+		base = bck.Props.Extra.HTTP.OrigURLBck
+	)
+	for _, en := range todo {
+		ch <- en
+	}
+	close(ch)
+	for range min(htHeadWorkers, len(todo)) {
+		wg.Add(1)
+		go func() {
+			for en := range ch {
+				if err := htbp.headEntry(cos.JoinPath(base, en.Name), en, wantMD); err != nil {
+					nlog.Warningln("[list_objects]", bck.Cname(en.Name)+":", err)
+				}
+			}
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+}
+
+func htSizeKnown(ents []htEnt, name string) bool {
+	i := sort.Search(len(ents), func(i int) bool { return ents[i].name >= name })
+	return i < len(ents) && ents[i].name == name && ents[i].size >= 0
+}
+
+func (htbp *htbp) headEntry(u string, en *cmn.LsoEnt, wantMD bool) error {
+	resp, err := htbp.client(u).Head(u)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("HEAD failed, status " + strconv.Itoa(resp.StatusCode))
+	}
+	if resp.ContentLength >= 0 {
+		en.Size = resp.ContentLength
+	}
+	if !wantMD {
+		return nil
+	}
+	if v, ok := htVersion(resp.Header); ok {
+		en.Version = v
+	}
+	custom := cos.StrKVs{cmn.SourceObjMD: apc.HT}
+	htCustom(resp.Header, custom)
+	en.Custom = cmn.CustomMD2S(custom)
+	return nil
+}
+
+// version: ETag, if provided - otherwise, Last-Modified
+func htVersion(hdr http.Header) (string, bool) {
+	if v, ok := cmn.BackendHelpers.HTTP.EncodeVersion(hdr.Get(cos.HdrETag)); ok {
+		return v, true
+	}
+	v := hdr.Get(cos.HdrLastModified)
+	return v, v != ""
+}
+
+func htCustom(hdr http.Header, custom cos.StrKVs) {
+	if v, ok := cmn.BackendHelpers.HTTP.EncodeVersion(hdr.Get(cos.HdrETag)); ok {
+		custom[cmn.ETag] = v
+	}
+	if v := hdr.Get(cos.HdrLastModified); v != "" {
+		custom[cmn.LastModified] = v
+	}
+}
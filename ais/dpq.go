@@ -30,6 +30,9 @@ type dpq struct {
 	arch struct {
 		path, mime, regx, mmode string // QparamArchpath et al. (plus archmode below)
 	}
+	patch struct {
+		off, cksum string // QparamPatchOffset, QparamPatchCksum
+	}
 
 	ptime       string // req timestamp at calling/redirecting proxy (QparamUnixTime)
 	uuid        string // xaction
@@ -38,12 +41,17 @@ type dpq struct {
 	fltPresence string // QparamFltPresence
 	etlName     string // QparamETLName
 	binfo       string // bucket info, with or without requirement to summarize remote obj-s
+	checkAccess string // QparamCheckAccess
+	hredir      string // QparamHealthRedirect (ID of the degraded target)
+	fbredir     string // QparamFallbackRedirect (ID of the unreachable target)
+	traceparent string // QparamTraceparent
 
 	skipVC        bool // QparamSkipVC (skip loading existing object's metadata)
 	isGFN         bool // QparamIsGFNRequest
 	dontAddRemote bool // QparamDontAddRemote
 	silent        bool // QparamSilent
 	latestVer     bool // QparamLatestVer
+	batchPart     bool // QparamGetBatchPart
 	isS3          bool // special use: frontend S3 API
 }
 
@@ -139,6 +147,10 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			if dpq.apnd.hdl, err = url.QueryUnescape(value); err != nil {
 				return
 			}
+		case apc.QparamPatchOffset:
+			dpq.patch.off = value
+		case apc.QparamPatchCksum:
+			dpq.patch.cksum = value
 		case apc.QparamOWT:
 			dpq.owt = value
 
@@ -148,6 +160,8 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			dpq.dontAddRemote = cos.IsParseBool(value)
 		case apc.QparamBinfoWithOrWithoutRemote:
 			dpq.binfo = value
+		case apc.QparamCheckAccess:
+			dpq.checkAccess = value
 
 		case apc.QparamETLName:
 			dpq.etlName = value
@@ -155,8 +169,20 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			dpq.silent = cos.IsParseBool(value)
 		case apc.QparamLatestVer:
 			dpq.latestVer = cos.IsParseBool(value)
+		case apc.QparamGetBatchPart:
+			dpq.batchPart = cos.IsParseBool(value)
+		case apc.QparamHealthRedirect:
+			dpq.hredir = value
+		case apc.QparamFallbackRedirect:
+			dpq.fbredir = value
+		case apc.QparamTraceparent:
+			dpq.traceparent = value
 
 		default:
+			// user-specified ETL parameters are passed through to the transformer (see ext/etl)
+			if strings.HasPrefix(key, apc.QparamETLParamPrefix) {
+				continue
+			}
 			// the key must be known or _except-ed
 			if _, ok := _except[key]; !ok {
 				err = fmt.Errorf("invalid query parameter: %q", key)
@@ -48,6 +48,7 @@ type (
 		final func(ctx *etlMDModifier, clone *etlMD)
 
 		msg     etl.InitMsg
+		canary  *etl.Canary
 		etlName string
 		wait    bool
 	}
@@ -101,6 +102,13 @@ func (e *etlMD) clone() *etlMD {
 	for id, etl := range e.ETLs {
 		dst.ETLs[id] = etl
 	}
+	if len(e.Canaries) > 0 {
+		dst.Canaries = make(etl.Canaries, len(e.Canaries))
+		for name, c := range e.Canaries {
+			clone := *c
+			dst.Canaries[name] = &clone
+		}
+	}
 	return dst
 }
 
@@ -117,6 +125,22 @@ func (e *etlMD) del(id string) (exists bool) {
 	return
 }
 
+func (e *etlMD) setCanary(c *etl.Canary) {
+	if e.Canaries == nil {
+		e.Canaries = make(etl.Canaries, 1)
+	}
+	e.Canaries[c.Name] = c
+	e.Version++
+}
+
+func (e *etlMD) delCanary(name string) (c *etl.Canary) {
+	if c = e.Canaries.Get(name); c != nil {
+		delete(e.Canaries, name)
+		e.Version++
+	}
+	return
+}
+
 ////////////////////
 // etlMDOwnerBase //
 ////////////////////
@@ -392,8 +392,10 @@ type (
 	cresEI struct{} // -> etl.InfoList
 	cresEL struct{} // -> etl.Logs
 	cresEM struct{} // -> etl.CPUMemUsed
+	cresED struct{} // -> etl.DryRunIssues
 	cresIC struct{} // -> icBundle
 	cresBM struct{} // -> bucketMD
+	cresNL struct{} // -> nodeLoad
 
 	cresLso   struct{} // -> cmn.LsoRes
 	cresBsumm struct{} // -> cmn.AllBsummResults
@@ -408,8 +410,10 @@ var (
 	_ cresv = cresEI{}
 	_ cresv = cresEL{}
 	_ cresv = cresEM{}
+	_ cresv = cresED{}
 	_ cresv = cresIC{}
 	_ cresv = cresBM{}
+	_ cresv = cresNL{}
 	_ cresv = cresBsumm{}
 )
 
@@ -453,12 +457,18 @@ func (c cresEL) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jr
 func (cresEM) newV() any                              { return &etl.CPUMemUsed{} }
 func (c cresEM) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
+func (cresED) newV() any                              { return &etl.DryRunIssues{} }
+func (c cresED) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
 func (cresIC) newV() any                              { return &icBundle{} }
 func (c cresIC) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
 func (cresBM) newV() any                              { return &bucketMD{} }
 func (c cresBM) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
+func (cresNL) newV() any                              { return &nodeLoad{} }
+func (c cresNL) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
+
 func (cresBsumm) newV() any                              { return &cmn.AllBsummResults{} }
 func (c cresBsumm) read(res *callResult, body io.Reader) { res.v = c.newV(); res.jread(body) }
 
@@ -891,12 +901,17 @@ func _reMirror(bprops, nprops *cmn.Bprops) bool {
 }
 
 func _reEC(bprops, nprops *cmn.Bprops, bck *meta.Bck, smap *smapX) (targetCnt int, yes bool) {
+	if !nprops.EC.Enabled && bprops.EC.Enabled {
+		// abort running ec-encode xaction, if exists
+		flt := xreg.Flt{Kind: apc.ActECEncode, Bck: bck}
+		xreg.DoAbort(flt, errors.New("ec-disabled"))
+	}
+	return _needReEC(bprops, nprops, smap)
+}
+
+// same as above without side effects
+func _needReEC(bprops, nprops *cmn.Bprops, smap *smapX) (targetCnt int, yes bool) {
 	if !nprops.EC.Enabled {
-		if bprops.EC.Enabled {
-			// abort running ec-encode xaction, if exists
-			flt := xreg.Flt{Kind: apc.ActECEncode, Bck: bck}
-			xreg.DoAbort(flt, errors.New("ec-disabled"))
-		}
 		return
 	}
 	if smap != nil {
@@ -0,0 +1,87 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Cross-origin resource sharing (config.CORS), e.g.:
+// `ais config cluster cors.allowed_origins="https://*.example.com" cors.enabled=true`
+// - applies to all public-network handlers, including the S3 API (both proxies and targets,
+//   the latter - to serve GET and PUT requests that proxies redirect);
+// - preflight (OPTIONS) requests from allowed origins are answered right here, with 204;
+//   disallowed methods get 403;
+// - other requests from allowed origins get Access-Control-Allow-Origin (and the rest)
+//   response headers and then proceed as usual;
+// - requests without "Origin" header (that is, all non-browser clients) are not affected.
+
+func corsHandler(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get(cos.HdrOrigin)
+		if origin == "" {
+			h(w, r)
+			return
+		}
+		conf := &cmn.GCO.Get().CORS
+		if !conf.Enabled {
+			h(w, r)
+			return
+		}
+		hdr := w.Header()
+		hdr.Add(cos.HdrVary, cos.HdrOrigin)
+		if !conf.AllowOrigin(origin) {
+			h(w, r) // (browser won't see the response)
+			return
+		}
+
+		// preflight
+		if r.Method == http.MethodOptions {
+			if method := r.Header.Get(cos.HdrACRequestMethod); method != "" {
+				corsPreflight(w, r, conf, origin, method)
+				return
+			}
+		}
+
+		hdr.Set(cos.HdrACAllowOrigin, origin)
+		if conf.AllowCredentials {
+			hdr.Set(cos.HdrACAllowCredentials, "true")
+		}
+		if conf.ExposeHeaders != "" {
+			hdr.Set(cos.HdrACExposeHeaders, conf.ExposeHeaders)
+		}
+		h(w, r)
+	}
+}
+
+func corsPreflight(w http.ResponseWriter, r *http.Request, conf *cmn.CORSConf, origin, method string) {
+	hdr := w.Header()
+	hdr.Add(cos.HdrVary, cos.HdrACRequestMethod)
+	hdr.Add(cos.HdrVary, cos.HdrACRequestHeaders)
+	if !conf.AllowMethod(method) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	hdr.Set(cos.HdrACAllowOrigin, origin)
+	hdr.Set(cos.HdrACAllowMethods, conf.AllowedMethods)
+	if conf.AllowedHeaders == "*" {
+		if reqHdrs := r.Header.Get(cos.HdrACRequestHeaders); reqHdrs != "" {
+			hdr.Set(cos.HdrACAllowHeaders, reqHdrs)
+		}
+	} else if conf.AllowedHeaders != "" {
+		hdr.Set(cos.HdrACAllowHeaders, conf.AllowedHeaders)
+	}
+	if conf.AllowCredentials {
+		hdr.Set(cos.HdrACAllowCredentials, "true")
+	}
+	if conf.MaxAge > 0 {
+		hdr.Set(cos.HdrACMaxAge, strconv.FormatInt(int64(conf.MaxAge.D().Seconds()), 10))
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,108 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCORSHandler(t *testing.T) {
+	oldConfig := cmn.GCO.Get()
+	defer func() {
+		cmn.GCO.BeginUpdate()
+		cmn.GCO.CommitUpdate(oldConfig)
+	}()
+	config := cmn.GCO.BeginUpdate()
+	config.CORS = cmn.CORSConf{
+		Enabled:        true,
+		AllowedOrigins: "https://dash.example.com, https://*.corp.example.com",
+		AllowedMethods: "get,head,put",
+		AllowedHeaders: "*",
+		ExposeHeaders:  "ETag",
+		MaxAge:         cos.Duration(10 * time.Minute),
+	}
+	tassert.CheckFatal(t, config.CORS.Validate())
+	cmn.GCO.CommitUpdate(config)
+
+	var called int
+	h := corsHandler(func(w http.ResponseWriter, _ *http.Request) {
+		called++
+		w.WriteHeader(http.StatusOK)
+	})
+	do := func(method, origin, reqMethod string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/v1/objects/bck/obj", http.NoBody)
+		if origin != "" {
+			r.Header.Set(cos.HdrOrigin, origin)
+		}
+		if reqMethod != "" {
+			r.Header.Set(cos.HdrACRequestMethod, reqMethod)
+			r.Header.Set(cos.HdrACRequestHeaders, "content-type")
+		}
+		w := httptest.NewRecorder()
+		h(w, r)
+		return w
+	}
+
+	// preflight: allowed
+	w := do(http.MethodOptions, "https://dash.example.com", http.MethodPut)
+	tassert.Errorf(t, w.Code == http.StatusNoContent, "expected 204, got %d", w.Code)
+	tassert.Errorf(t, w.Header().Get(cos.HdrACAllowOrigin) == "https://dash.example.com", "allow-origin: %q",
+		w.Header().Get(cos.HdrACAllowOrigin))
+	tassert.Errorf(t, w.Header().Get(cos.HdrACAllowMethods) == "GET,HEAD,PUT", "allow-methods: %q",
+		w.Header().Get(cos.HdrACAllowMethods))
+	tassert.Errorf(t, w.Header().Get(cos.HdrACAllowHeaders) == "content-type", "allow-headers: %q",
+		w.Header().Get(cos.HdrACAllowHeaders))
+	tassert.Errorf(t, w.Header().Get(cos.HdrACMaxAge) == "600", "max-age: %q", w.Header().Get(cos.HdrACMaxAge))
+	tassert.Errorf(t, called == 0, "preflight must not reach the handler")
+
+	// preflight: method not allowed
+	w = do(http.MethodOptions, "https://ui.corp.example.com", http.MethodDelete)
+	tassert.Errorf(t, w.Code == http.StatusForbidden, "expected 403, got %d", w.Code)
+
+	// actual request from a wildcard-matching origin
+	w = do(http.MethodGet, "https://ui.corp.example.com", "")
+	tassert.Errorf(t, w.Code == http.StatusOK && called == 1, "expected handler call (%d, %d)", w.Code, called)
+	tassert.Errorf(t, w.Header().Get(cos.HdrACAllowOrigin) == "https://ui.corp.example.com", "allow-origin: %q",
+		w.Header().Get(cos.HdrACAllowOrigin))
+	tassert.Errorf(t, w.Header().Get(cos.HdrACExposeHeaders) == "ETag", "expose-headers: %q",
+		w.Header().Get(cos.HdrACExposeHeaders))
+
+	// disallowed origin
+	w = do(http.MethodGet, "https://evil.example.org", "")
+	tassert.Errorf(t, w.Header().Get(cos.HdrACAllowOrigin) == "", "unexpected allow-origin %q",
+		w.Header().Get(cos.HdrACAllowOrigin))
+
+	// no origin (non-browser client)
+	w = do(http.MethodGet, "", "")
+	tassert.Errorf(t, w.Header().Get(cos.HdrVary) == "", "unexpected Vary: %q", w.Header().Get(cos.HdrVary))
+	tassert.Errorf(t, called == 3, "expected 3 handler calls, got %d", called)
+}
+
+func TestCORSConfValidate(t *testing.T) {
+	tests := []struct {
+		conf cmn.CORSConf
+		ok   bool
+	}{
+		{cmn.CORSConf{}, true},
+		{cmn.CORSConf{Enabled: true}, false},
+		{cmn.CORSConf{Enabled: true, AllowedOrigins: "*"}, true},
+		{cmn.CORSConf{Enabled: true, AllowedOrigins: "*", AllowCredentials: true}, false},
+		{cmn.CORSConf{Enabled: true, AllowedOrigins: "dash.example.com"}, false},
+		{cmn.CORSConf{Enabled: true, AllowedOrigins: "https://*.*.example.com"}, false},
+		{cmn.CORSConf{Enabled: true, AllowedOrigins: "https://a.com", AllowedMethods: "GET,FETCH"}, false},
+		{cmn.CORSConf{Enabled: true, AllowedOrigins: "https://a.com", MaxAge: -1}, false},
+	}
+	for i, test := range tests {
+		err := test.conf.Validate()
+		tassert.Errorf(t, (err == nil) == test.ok, "%d: %+v: expected ok=%t, got err=%v", i, test.conf, test.ok, err)
+	}
+}
@@ -79,8 +79,9 @@ type htrun struct {
 		cluster atomic.Int64 // mono.NanoTime() since cluster startup, zero prior to that
 		node    atomic.Int64 // ditto - for the node
 	}
-	gmm *memsys.MMSA // system pagesize-based memory manager and slab allocator
-	smm *memsys.MMSA // system MMSA for small-size allocations
+	gmm  *memsys.MMSA   // system pagesize-based memory manager and slab allocator
+	smm  *memsys.MMSA   // system MMSA for small-size allocations
+	nifs []apc.NetIface // network interface used by each traffic class (see initSnode)
 }
 
 ///////////
@@ -227,7 +228,7 @@ func (h *htrun) regNetHandlers(networkHandlers []networkHandler) {
 		}
 		debug.Assert(nh.net != 0)
 		if nh.net.isSet(accessNetPublic) {
-			handlePub(path, nh.h)
+			handlePub(path, corsHandler(nh.h))
 			reg = true
 		}
 		if config.HostNet.UseIntraControl && nh.net.isSet(accessNetIntraControl) {
@@ -244,7 +245,7 @@ func (h *htrun) regNetHandlers(networkHandlers []networkHandler) {
 		// none of the above
 		if !config.HostNet.UseIntraControl && !config.HostNet.UseIntraData {
 			// no intra-cluster networks: default to pub net
-			handlePub(path, nh.h)
+			handlePub(path, corsHandler(nh.h))
 		} else if config.HostNet.UseIntraControl && nh.net.isSet(accessNetIntraData) {
 			// (not configured) data defaults to (configured) control
 			handleControl(path, nh.h)
@@ -310,10 +311,16 @@ func (h *htrun) initSnode(config *cmn.Config) {
 		pubExtra []meta.NetInfo
 		ctrlAddr meta.NetInfo
 		dataAddr meta.NetInfo
+		pubIP    *localIPInfo
+		ctrlIP   *localIPInfo
+		dataIP   *localIPInfo
+		ctrlSel  = apc.NetSelPublic
+		dataSel  = apc.NetSelPublic
+		pubSel   string
 		port     = strconv.Itoa(config.HostNet.Port)
 		proto    = config.Net.HTTP.Proto
 	)
-	addrList, err := getLocalIPv4s(config)
+	addrList, err := getLocalIPs(config)
 	if err != nil {
 		cos.ExitLogf("failed to get local IP addr list: %v", err)
 	}
@@ -339,8 +346,12 @@ func (h *htrun) initSnode(config *cmn.Config) {
 		// public hostname could be a load balancer's external IP or a service DNS
 		nlog.Infoln("K8s deployment: skipping hostname validation for", config.HostNet.Hostname)
 		pubAddr.Init(proto, pub, port)
-	} else if err = initNetInfo(&pubAddr, addrList, proto, config.HostNet.Hostname, port); err != nil {
-		cos.ExitLogf("failed to get %s IPv4/hostname: %v", cmn.NetPublic, err)
+		pubSel = apc.NetSelHostname
+	} else {
+		pubIP, pubSel, err = initNetInfo(&pubAddr, addrList, proto, config.HostNet.Hostname, config.HostNet.CIDR, port)
+		if err != nil {
+			cos.ExitLogf("failed to get %s IP/hostname: %v", cmn.NetPublic, err)
+		}
 	}
 
 	// multi-home (when config.HostNet.Hostname is a comma-separated list)
@@ -360,12 +371,13 @@ func (h *htrun) initSnode(config *cmn.Config) {
 	}
 
 	// 2. intra-cluster
-	ctrlAddr = pubAddr
+	ctrlAddr, ctrlIP = pubAddr, pubIP
 	if config.HostNet.UseIntraControl {
 		icport := strconv.Itoa(config.HostNet.PortIntraControl)
-		err = initNetInfo(&ctrlAddr, addrList, proto, config.HostNet.HostnameIntraControl, icport)
+		ctrlIP, ctrlSel, err = initNetInfo(&ctrlAddr, addrList, proto, config.HostNet.HostnameIntraControl,
+			config.HostNet.CIDRIntraControl, icport)
 		if err != nil {
-			cos.ExitLogf("failed to get %s IPv4/hostname: %v", cmn.NetIntraControl, err)
+			cos.ExitLogf("failed to get %s IP/hostname: %v", cmn.NetIntraControl, err)
 		}
 		var s string
 		if config.HostNet.HostnameIntraControl != "" {
@@ -373,12 +385,13 @@ func (h *htrun) initSnode(config *cmn.Config) {
 		}
 		nlog.Infof("%s access: %v%s", cmn.NetIntraControl, ctrlAddr, s)
 	}
-	dataAddr = pubAddr
+	dataAddr, dataIP = pubAddr, pubIP
 	if config.HostNet.UseIntraData {
 		idport := strconv.Itoa(config.HostNet.PortIntraData)
-		err = initNetInfo(&dataAddr, addrList, proto, config.HostNet.HostnameIntraData, idport)
+		dataIP, dataSel, err = initNetInfo(&dataAddr, addrList, proto, config.HostNet.HostnameIntraData,
+			config.HostNet.CIDRIntraData, idport)
 		if err != nil {
-			cos.ExitLogf("failed to get %s IPv4/hostname: %v", cmn.NetIntraData, err)
+			cos.ExitLogf("failed to get %s IP/hostname: %v", cmn.NetIntraData, err)
 		}
 		var s string
 		if config.HostNet.HostnameIntraData != "" {
@@ -414,6 +427,11 @@ func (h *htrun) initSnode(config *cmn.Config) {
 	)
 
 	// 4. new Snode
+	h.nifs = []apc.NetIface{
+		newNetIface(cmn.NetPublic, &pubAddr, pubIP, config.HostNet.CIDR, pubSel),
+		newNetIface(cmn.NetIntraControl, &ctrlAddr, ctrlIP, config.HostNet.CIDRIntraControl, ctrlSel),
+		newNetIface(cmn.NetIntraData, &dataAddr, dataIP, config.HostNet.CIDRIntraData, dataSel),
+	}
 	h.si = &meta.Snode{
 		PubNet:     pubAddr,
 		ControlNet: ctrlAddr,
@@ -1089,6 +1107,7 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 		// hide secret
 		out = *config
 		out.Auth.Secret = "**********"
+		out.Alerts.HidePasswords()
 		body = &out
 	case apc.WhatSmap:
 		body = h.owner.smap.get()
@@ -1123,6 +1142,10 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 		body = statsNode
 	case apc.WhatMetricNames:
 		body = h.statsT.GetMetricNames()
+	case apc.WhatCertificate:
+		body = certloader.GetInfo()
+	case apc.WhatNetIfaces:
+		body = h.nifs
 	case apc.WhatNodeStatsAndStatusV322:
 		ds := h.statsAndStatusV322()
 		daeStats := h.statsT.GetStatsV322()
@@ -18,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/xact"
@@ -38,6 +39,9 @@ const (
 	// that have no associated cache other than start/end timestamps and stats counters
 	// (case in point: list/query-objects that MAY be cached, etc.)
 	equalIC = "\x00"
+
+	// max time to hold xaction watch request (long poll) when there are no updates
+	xwatchMaxWait = 10 * time.Second
 )
 
 type (
@@ -268,6 +272,90 @@ func (ic *ic) xstatusOne(w http.ResponseWriter, r *http.Request) {
 	w.Write(b)
 }
 
+// long poll: respond with aggregated progress snapshot as soon as the xaction's stats get updated
+// past the client's sequence number (apc.QparamWatchSeq), or when it finishes, or upon xwatchMaxWait;
+// in the meantime, refresh the stats from the targets (that are tardy to report) every so often
+func (ic *ic) xwatch(w http.ResponseWriter, r *http.Request, query url.Values) {
+	msg := &xact.QueryMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	if !xact.IsValidUUID(msg.ID) {
+		ic.p.writeErrf(w, r, "%s: invalid xaction ID %q", apc.WhatXactWatch, msg.ID)
+		return
+	}
+	if ic.reverseToOwner(w, r, msg.ID, msg) {
+		return
+	}
+	var (
+		nl    nl.Listener
+		since int64
+		ivl   = xact.MinPollTime
+	)
+	withRetry(cmn.Rom.CplaneOperation(), func() bool {
+		nl = ic.p.notifs.entry(msg.ID)
+		return nl != nil
+	})
+	if nl == nil {
+		smap := ic.p.owner.smap.get()
+		err := fmt.Errorf("nl not found: %s, %s", smap.StrIC(ic.p.si), msg)
+		ic.p.writeErr(w, r, err, http.StatusNotFound, Silent)
+		return
+	}
+	if s := query.Get(apc.QparamWatchSeq); s != "" {
+		var err error
+		if since, err = strconv.ParseInt(s, 10, 64); err != nil {
+			ic.p.writeErrf(w, r, "%s: invalid %s=%q: %v", apc.WhatXactWatch, apc.QparamWatchSeq, s, err)
+			return
+		}
+	}
+	if s := query.Get(apc.QparamWatchIvl); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			ic.p.writeErrf(w, r, "%s: invalid %s=%q: %v", apc.WhatXactWatch, apc.QparamWatchIvl, s, err)
+			return
+		}
+		ivl = min(max(d, xact.MinPollTime), xwatchMaxWait)
+	}
+
+	var (
+		seq      int64
+		upd      <-chan struct{}
+		deadline = time.Now().Add(xwatchMaxWait)
+	)
+	for {
+		ic.p.notifs.bcastGetStats(nl, ivl)
+		nl.Lock()
+		seq, upd = nl.Watch()
+		nl.Unlock()
+		if seq > since || nl.Finished() || !time.Now().Before(deadline) {
+			break
+		}
+		timer := time.NewTimer(min(ivl, time.Until(deadline)))
+		select {
+		case <-upd:
+		case <-timer.C:
+		case <-r.Context().Done():
+			timer.Stop()
+			return
+		}
+		timer.Stop()
+	}
+
+	out := &xact.Watch{Snaps: make(xact.MultiSnap, len(nl.Notifiers())), Seq: seq}
+	out.Status = *nl.Status()
+	if err := nl.Err(); err != nil {
+		out.Status.ErrMsg = err.Error()
+	}
+	nl.NodeStats().Range(func(tid string, stats any) bool {
+		if snap, ok := stats.(*core.Snap); ok {
+			out.Snaps[tid] = []*core.Snap{snap}
+		}
+		return true
+	})
+	ic.p.writeJSON(w, r, out, apc.WhatXactWatch)
+}
+
 // verb /v1/ic
 func (ic *ic) handler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
@@ -335,6 +423,13 @@ func (ic *ic) handlePost(w http.ResponseWriter, r *http.Request) {
 			ic.p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActXactGC:
+		xargs := &xact.ArgsMsg{}
+		if err := cos.MorphMarshal(msg.Value, xargs); err != nil {
+			ic.p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, ic.p.si, msg.Action, msg.Value, err)
+			return
+		}
+		ic.p.notifs.gc(xargs)
 	case apc.ActRegGlobalXaction:
 		var (
 			regMsg     = &xactRegMsg{}
@@ -62,6 +62,13 @@ type (
 		rproxy     reverseProxy
 		notifs     notifs
 		lstca      lstca
+		dlsched    dlsched
+		dlcreds    dlcreds
+		dlpresets  dlpresets
+		alerts     alertEng
+		hredir     hredir
+		bsummc     bsummCache
+		rlim       roleLimiter
 		reg        struct {
 			pool nodeRegPool
 			mu   sync.RWMutex
@@ -200,6 +207,11 @@ func (p *proxy) Run() error {
 	p.notifs.init(p)
 	p.ic.init(p)
 	p.qm.init()
+	p.dlsched.init(p, config)
+	p.dlcreds.init(p, config)
+	p.dlpresets.init(p, config)
+	p.alerts.init(p, config)
+	p.hredir.init(p)
 
 	//
 	// REST API: register proxy handlers and start listening
@@ -208,11 +220,11 @@ func (p *proxy) Run() error {
 		{r: apc.Reverse, h: p.reverseHandler, net: accessNetPublic},
 
 		// pubnet handlers: cluster must be started
-		{r: apc.Buckets, h: p.bucketHandler, net: accessNetPublic},
-		{r: apc.Objects, h: p.objectHandler, net: accessNetPublic},
-		{r: apc.Download, h: p.dloadHandler, net: accessNetPublic},
-		{r: apc.ETL, h: p.etlHandler, net: accessNetPublic},
-		{r: apc.Sort, h: p.dsortHandler, net: accessNetPublic},
+		{r: apc.Buckets, h: p.rlimHandler(p.bucketHandler), net: accessNetPublic},
+		{r: apc.Objects, h: p.rlimHandler(p.objectHandler), net: accessNetPublic},
+		{r: apc.Download, h: p.rlimHandler(p.dloadHandler), net: accessNetPublicControl}, // (control: intra-cluster replication)
+		{r: apc.ETL, h: p.rlimHandler(p.etlHandler), net: accessNetPublic},
+		{r: apc.Sort, h: p.rlimHandler(p.dsortHandler), net: accessNetPublic},
 
 		{r: apc.IC, h: p.ic.handler, net: accessNetIntraControl},
 		{r: apc.Daemon, h: p.daemonHandler, net: accessNetPublicControl},
@@ -226,15 +238,15 @@ func (p *proxy) Run() error {
 		{r: apc.Notifs, h: p.notifs.handler, net: accessNetIntraControl},
 
 		// S3 compatibility
-		{r: "/" + apc.S3, h: p.s3Handler, net: accessNetPublic},
+		{r: "/" + apc.S3, h: p.rlimHandler(p.s3Handler), net: accessNetPublic},
 
 		// "easy URL"
-		{r: "/" + apc.GSScheme, h: p.easyURLHandler, net: accessNetPublic},
-		{r: "/" + apc.AZScheme, h: p.easyURLHandler, net: accessNetPublic},
-		{r: "/" + apc.AISScheme, h: p.easyURLHandler, net: accessNetPublic},
+		{r: "/" + apc.GSScheme, h: p.rlimHandler(p.easyURLHandler), net: accessNetPublic},
+		{r: "/" + apc.AZScheme, h: p.rlimHandler(p.easyURLHandler), net: accessNetPublic},
+		{r: "/" + apc.AISScheme, h: p.rlimHandler(p.easyURLHandler), net: accessNetPublic},
 
 		// ht:// _or_ S3 compatibility, depending on feature flag
-		{r: "/", h: p.rootHandler, net: accessNetPublic},
+		{r: "/", h: p.rlimHandler(p.rootHandler), net: accessNetPublic},
 	}
 	p.regNetHandlers(networkHandlers)
 
@@ -445,6 +457,10 @@ func (p *proxy) bucketHandler(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusServiceUnavailable)
 		return
 	}
+	// (POST is decided by action - see httpbckpost and httpobjpost)
+	if r.Method != http.MethodGet && r.Method != http.MethodHead && r.Method != http.MethodPost && p.rejectReadOnly(w, r) {
+		return
+	}
 	switch r.Method {
 	case http.MethodGet:
 		dpq := dpqAlloc()
@@ -474,6 +490,10 @@ func (p *proxy) bucketHandler(w http.ResponseWriter, r *http.Request) {
 
 // verb /v1/objects/
 func (p *proxy) objectHandler(w http.ResponseWriter, r *http.Request) {
+	// (POST is decided by action - see httpbckpost and httpobjpost)
+	if r.Method != http.MethodGet && r.Method != http.MethodHead && r.Method != http.MethodPost && p.rejectReadOnly(w, r) {
+		return
+	}
 	switch r.Method {
 	case http.MethodGet:
 		p.httpobjget(w, r)
@@ -619,7 +639,7 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		return
 	}
 
-	// switch (I) through (IV) --------------------------
+	// switch (I) through (VI) --------------------------
 
 	// (I) summarize buckets
 	if msg.Action == apc.ActSummaryBck {
@@ -641,13 +661,39 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		return
 	}
 
-	// (II) invalid action
+	// (II) get-batch
+	if msg.Action == apc.ActGetBatch {
+		if !qbck.IsBucket() {
+			p.writeErrf(w, r, "bad %s request: %q is not a bucket", msg.Action, qbck)
+			return
+		}
+		p.getBatch(w, r, (*cmn.Bck)(qbck), msg, dpq)
+		return
+	}
+
+	// (III) list bucket snapshots
+	if msg.Action == apc.ActListSnaps {
+		if !qbck.IsBucket() {
+			p.writeErrf(w, r, "bad %s request: %q is not a bucket", msg.Action, qbck)
+			return
+		}
+		bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: apc.AceBckHEAD, bck: (*meta.Bck)(qbck), dpq: dpq}
+		bckArgs.createAIS = false
+		bck, err := bckArgs.initAndTry()
+		if err != nil {
+			return
+		}
+		p.listSnaps(w, r, bck, msg)
+		return
+	}
+
+	// (IV) invalid action
 	if msg.Action != apc.ActList {
 		p.writeErrAct(w, r, msg.Action)
 		return
 	}
 
-	// (III) list buckets
+	// (V) list buckets
 	if msg.Value == nil {
 		if qbck.Name != "" && qbck.Name != msg.Name {
 			p.writeErrf(w, r, "bad list-buckets request: %q vs %q (%+v, %+v)", qbck.Name, msg.Name, qbck, msg)
@@ -663,7 +709,7 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		return
 	}
 
-	// (IV) list objects (NOTE -- TODO: currently, always forwarding)
+	// (VI) list objects (NOTE -- TODO: currently, always forwarding)
 	if !qbck.IsBucket() {
 		p.writeErrf(w, r, "bad list-objects request: %q is not a bucket (is a bucket query?)", qbck)
 		return
@@ -702,6 +748,41 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 	}
 }
 
+// redirect to a random (designated) target that will then assemble the TAR (see tgtbatch)
+func (p *proxy) getBatch(w http.ResponseWriter, r *http.Request, bck *cmn.Bck, msg *apc.ActMsg, dpq *dpq) {
+	var gbmsg apc.GetBatchMsg
+	if err := cos.MorphMarshal(msg.Value, &gbmsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	switch {
+	case gbmsg.Prefix != "" && len(gbmsg.ObjNames) > 0:
+		p.writeErrf(w, r, "bad %s request: prefix and list of names are mutually exclusive", msg.Action)
+		return
+	case strings.Contains(gbmsg.Prefix, "../"):
+		p.writeErrf(w, r, "bad %s request: invalid prefix %q", msg.Action, gbmsg.Prefix)
+		return
+	}
+	bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: apc.AceGET, bck: meta.CloneBck(bck), dpq: dpq}
+	bckArgs.createAIS = false
+	mbck, err := bckArgs.initAndTry()
+	if err != nil {
+		return
+	}
+	smap := p.owner.smap.get()
+	tsi, err := smap.GetRandTarget()
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(msg.Action, mbck.Cname(gbmsg.Prefix), "num names:", len(gbmsg.ObjNames), "=>", tsi.StringEx())
+	}
+	// (307 to preserve the request body)
+	redirectURL := p.redirectURL(r, tsi, time.Now() /*started*/, cmn.NetIntraData)
+	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+}
+
 // GET /v1/objects/bucket-name/object-name
 func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ...string) {
 	// 1. request
@@ -736,7 +817,7 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 
 	// 3. redirect
 	smap := p.owner.smap.get()
-	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
+	redirectURL, tsi, err := p.getRedirectURL(r, smap, bck, objName, time.Now() /*started*/)
 	if err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -744,8 +825,9 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
 		nlog.Infoln("GET " + bck.Cname(objName) + " => " + tsi.String())
 	}
-	redirectURL := p.redirectURL(r, tsi, time.Now() /*started*/, cmn.NetIntraData, netPub)
+	span, redirectURL := p.traceRedirect(r, "ais.proxy.get", redirectURL, bck, objName, tsi)
 	http.Redirect(w, r, redirectURL, http.StatusMovedPermanently)
+	span.End(nil)
 
 	// 4. stats
 	p.statsT.Inc(stats.GetCount)
@@ -817,8 +899,11 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 	// verbose
 	if cmn.Rom.FastV(5, cos.SmoduleAIS) {
 		verb, s := "PUT", ""
-		if appendTyProvided {
+		switch {
+		case appendTyProvided:
 			verb = "APPEND"
+		case apireq.dpq.patch.off != "":
+			verb = "PATCH"
 		}
 		if bck.Props.Mirror.Enabled {
 			s = " (put-mirror)"
@@ -827,13 +912,18 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 	}
 
 	redirectURL := p.redirectURL(r, tsi, started, cmn.NetIntraData, netPub)
+	span, redirectURL := p.traceRedirect(r, "ais.proxy.put", redirectURL, bck, objName, tsi)
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+	span.End(nil)
 
 	// 4. stats
-	if !appendTyProvided {
-		p.statsT.Inc(stats.PutCount)
-	} else {
+	switch {
+	case appendTyProvided:
 		p.statsT.Inc(stats.AppendCount)
+	case apireq.dpq.patch.off != "": // apc.QparamPatchOffset
+		p.statsT.Inc(stats.PatchCount)
+	default:
+		p.statsT.Inc(stats.PutCount)
 	}
 }
 
@@ -1202,6 +1292,9 @@ func (p *proxy) httpbckpost(w http.ResponseWriter, r *http.Request) {
 	if msg, err = p.readActionMsg(w, r); err != nil {
 		return
 	}
+	if p.rejectReadOnlyAct(w, r, msg) {
+		return
+	}
 	bucket := apiItems[0]
 	if len(apiItems) > 1 {
 		err := cmn.InitErrHTTP(r, fmt.Errorf("invalid request URI %q", r.URL.Path), 0)
@@ -1311,6 +1404,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 				return
 			}
+			if err := tcbmsg.CopyBckMsg.Validate(); err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
 		}
 		if tcbmsg.Sync && tcbmsg.Prepend != "" {
 			p.writeErrf(w, r, errPrependSync, tcbmsg.Prepend)
@@ -1377,6 +1474,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 			return
 		}
+		if err = tcomsg.CopyBckMsg.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		if tcomsg.Sync && tcomsg.Prepend != "" {
 			p.writeErrf(w, r, errPrependSync, tcomsg.Prepend)
 			return
@@ -1430,9 +1531,21 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActWarmUp:
+		if xid, err = p.listrange(r.Method, bucket, msg, query); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	case apc.ActRenameObjects:
+		if xid, err = p.renameObjs(w, r, bck, msg, query); err != nil {
+			return
+		}
 	case apc.ActInvalListCache:
 		p.qm.c.invalidate(bck.Bucket())
 		return
+	case apc.ActCreateSnap, apc.ActRestoreSnap, apc.ActDestroySnap:
+		p.snapact(w, r, bck, msg)
+		return
 	case apc.ActMakeNCopies:
 		if xid, err = p.makeNCopies(msg, bck); err != nil {
 			p.writeErr(w, r, err)
@@ -1453,6 +1566,46 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 	w.Write([]byte(xid))
 }
 
+// multi-object rename (batch mv); compare w/ single-object apc.ActRenameObject (httpobjpost)
+func (p *proxy) renameObjs(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *apc.ActMsg, query url.Values) (string, error) {
+	if err := p.checkAccess(w, r, bck, apc.AceObjMOVE); err != nil {
+		return "", err
+	}
+	if bck.IsRemote() {
+		err := fmt.Errorf("%s: not supported for remote buckets (%s)", msg.Action, bck)
+		p.writeErr(w, r, err)
+		return "", err
+	}
+	if bck.Props.EC.Enabled {
+		err := fmt.Errorf("%s: not supported for erasure-coded buckets (%s)", msg.Action, bck)
+		p.writeErr(w, r, err)
+		return "", err
+	}
+	renmsg := &apc.RenameObjsMsg{}
+	if err := cos.MorphMarshal(msg.Value, renmsg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return "", err
+	}
+	err := renmsg.Validate()
+	if err == nil && !renmsg.IsList() {
+		err = cmn.ValidatePrefix(renmsg.Prefix)
+	}
+	if err != nil {
+		p.writeErr(w, r, err)
+		return "", err
+	}
+	for _, name := range renmsg.NewNames {
+		if !p.isValidObjname(w, r, name) {
+			return "", errors.New("invalid object name")
+		}
+	}
+	xid, err := p.listrange(r.Method, bck.Name, msg, query)
+	if err != nil {
+		p.writeErr(w, r, err)
+	}
+	return xid, err
+}
+
 // init existing or create remote
 // not calling `initAndTry` - delegating ais:from// props cloning to the separate method
 func (p *proxy) initBckTo(w http.ResponseWriter, r *http.Request, query url.Values, bckTo *meta.Bck) (*meta.Bck, int, error) {
@@ -1621,7 +1774,7 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	case lsmsg.Props == apc.GetPropsNameSize:
 		lsmsg.SetFlag(apc.LsNameSize)
 	}
-	if bck.IsHT() || lsmsg.IsFlagSet(apc.LsArchDir) {
+	if (bck.IsHT() && !bck.Props.Extra.HTTP.Listable()) || lsmsg.IsFlagSet(apc.LsArchDir) {
 		lsmsg.SetFlag(apc.LsObjCached)
 	}
 
@@ -1771,6 +1924,9 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 	if err != nil {
 		return
 	}
+	if p.rejectReadOnlyAct(w, r, msg) {
+		return
+	}
 	if msg.Action == apc.ActRenameObject {
 		apireq.after = 2
 	}
@@ -1780,6 +1936,9 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 
 	bck := apireq.bck
 	bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: apc.AcePUT, bck: bck}
+	if msg.Action == apc.ActECVerify {
+		bckArgs.perms = apc.AceObjHEAD
+	}
 	bckArgs.createAIS = false
 	bckArgs.dontHeadRemote = true
 	if _, err := bckArgs.initAndTry(); err != nil {
@@ -1826,6 +1985,10 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 			p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 			return
 		}
+		if err := args.Validate(); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 		var tsi *meta.Snode
 		if args.DaemonID != "" {
 			smap := p.owner.smap.get()
@@ -1835,7 +1998,7 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 				return
 			}
 		}
-		xid, err := p.promote(bck, msg, tsi)
+		xid, err := p.promote(bck, msg, args, tsi)
 		if err != nil {
 			p.writeErr(w, r, err)
 			return
@@ -1851,6 +2014,16 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 		objName := msg.Name
 		p.redirectObjAction(w, r, bck, objName, msg)
+	case apc.ActECVerify:
+		if !bck.Props.EC.Enabled {
+			p.writeErrActf(w, r, msg.Action, "erasure coding is not enabled for %s", bck)
+			return
+		}
+		if msg.Name == "" {
+			p.writeErrMsg(w, r, "object name is empty")
+			return
+		}
+		p.redirectObjAction(w, r, bck, msg.Name, msg)
 	default:
 		p.writeErrAct(w, r, msg.Action)
 	}
@@ -1916,6 +2089,17 @@ func (p *proxy) httpbckhead(w http.ResponseWriter, r *http.Request, apireq *apiR
 	if err != nil {
 		return
 	}
+	if dpq.checkAccess != "" && bck.Props != nil {
+		ace, err := strconv.ParseUint(dpq.checkAccess, 10, 64)
+		if err != nil {
+			p.writeErrf(w, r, "%s: invalid %s=%q: %v", p, apc.QparamCheckAccess, dpq.checkAccess, err)
+			return
+		}
+		if err := p.checkAccessEach(r, bck, apc.AccessAttrs(ace)); err != nil {
+			p.writeErr(w, r, err, aceErrToCode(err), Silent)
+			return
+		}
+	}
 
 	// 1. bucket is present (and was present prior to this call), and we are done with it here
 	if bckArgs.isPresent {
@@ -2043,6 +2227,15 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 	}
+	if cos.IsParseBool(apireq.query.Get(apc.QparamDryRun)) {
+		res, err := p.dryrunBprops(msg, bck, nprops)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		p.writeJSON(w, r, res, "dry-run "+msg.Action)
+		return
+	}
 	if xid, err = p.setBprops(msg, bck, nprops); err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -2605,7 +2798,7 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		fallthrough // fallthrough
 	case apc.WhatNodeConfig, apc.WhatSmapVote, apc.WhatSnode, apc.WhatLog,
 		apc.WhatNodeStats, apc.WhatNodeStatsV322, apc.WhatMetricNames,
-		apc.WhatNodeStatsAndStatusV322:
+		apc.WhatNodeStatsAndStatusV322, apc.WhatCertificate, apc.WhatNetIfaces:
 		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
 
 	case apc.WhatNodeStatsAndStatus:
@@ -2618,6 +2811,9 @@ func (p *proxy) httpdaeget(w http.ResponseWriter, r *http.Request) {
 	case apc.WhatSysInfo:
 		p.writeJSON(w, r, apc.GetMemCPU(), what)
 
+	case apc.WhatRoleUsage:
+		p.writeJSON(w, r, p.rlim.usage(mono.NanoTime()), what)
+
 	case apc.WhatSmap:
 		const retries = 16
 		var (
@@ -3025,6 +3221,13 @@ func (p *proxy) dsortHandler(w http.ResponseWriter, r *http.Request) {
 
 	switch r.Method {
 	case http.MethodPost:
+		if p.rejectReadOnly(w, r) {
+			return
+		}
+		if len(apiItems) == 1 && apiItems[0] == apc.Resume {
+			p.dsortResume(w, r)
+			return
+		}
 		// - validate request, check input_bck and output_bck
 		// - start dsort
 		body, err := cos.ReadAllN(r.Body, r.ContentLength)
@@ -3096,6 +3299,38 @@ func (p *proxy) dsortHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// POST /v1/sort/resume?uuid=...
+// (resumable job's output bucket must still exist)
+func (p *proxy) dsortResume(w http.ResponseWriter, r *http.Request) {
+	jobID := r.URL.Query().Get(apc.QparamUUID)
+	if jobID == "" {
+		p.writeErrf(w, r, "%s: missing %s job ID to resume", p, apc.ActDsort)
+		return
+	}
+	parsc, err := dsort.PresumeCtx(jobID)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	bck := meta.CloneBck(&parsc.InputBck)
+	args := bctx{p: p, w: w, r: r, bck: bck, perms: apc.AceObjLIST | apc.AceGET}
+	if _, err = args.initAndTry(); err != nil {
+		return
+	}
+	if !parsc.OutputBck.Equal(&parsc.InputBck) {
+		bckTo := meta.CloneBck(&parsc.OutputBck)
+		_, ecode, err := p.initBckTo(w, r, nil /*query*/, bckTo)
+		if err != nil {
+			return
+		}
+		if ecode == http.StatusNotFound {
+			p.writeErr(w, r, cmn.NewErrBckNotFound(bckTo.Bucket()), ecode)
+			return
+		}
+	}
+	dsort.PstartHandler(w, r, parsc)
+}
+
 func (p *proxy) rootHandler(w http.ResponseWriter, r *http.Request) {
 	const fs3 = "/" + apc.S3
 	if !p.cluStartedWithRetry() {
@@ -0,0 +1,339 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Alerts (config.Alerts), e.g.:
+// `ais config cluster alerts.rules='[{"name": "disk-full", "metric": "capacity", "threshold": 90}]' alerts.enabled=true`
+// - the primary proxy evaluates all configured rules every `alerts.interval`, using node stats
+//   that it collects from all nodes in the cluster map (except those in maintenance);
+// - a node that does not respond - or gets removed from the cluster map without being
+//   put into maintenance first - is considered offline;
+// - counters (metric names ending with ".n") and "err.rate" are evaluated as per-second rates
+//   between consecutive evaluations; all other metrics - as is;
+// - a rule that holds for a given node results in a "pending" alert that starts "firing"
+//   once the condition holds for at least the rule's `for` duration; firing alerts get
+//   "resolved" when the condition no longer holds;
+// - firing and resolved transitions are sent to all configured sinks (best effort);
+// - alert state is kept in memory by the primary (and starts anew upon primary change);
+//   other proxies forward `apc.WhatAlerts` queries to the primary.
+
+const (
+	alertsIdleIval    = time.Minute // when disabled (or not primary)
+	alertsMaxResolved = 64          // keep so many most recently resolved alerts
+)
+
+type (
+	alertSample struct {
+		si      *meta.Snode
+		node    *stats.Node // nil when offline
+		at      int64       // unix nanoseconds
+		offline bool
+	}
+	alertEng struct {
+		p        *proxy
+		client   *http.Client
+		samples  map[string]*alertSample // last collected, by node ID
+		active   map[string]*stats.Alert // pending and firing, by rule name and node ID
+		resolved stats.Alerts            // most recent first
+		mu       sync.Mutex
+	}
+
+	// webhook payload
+	alertsMsg struct {
+		Cluster string       `json:"cluster"` // cluster UUID
+		Primary string       `json:"primary"`
+		Alerts  stats.Alerts `json:"alerts"`
+	}
+)
+
+func (ae *alertEng) init(p *proxy, config *cmn.Config) {
+	ae.p = p
+	ae.client = cmn.NewClient(cmn.TransportArgs{Timeout: config.Client.Timeout.D()})
+	ae.samples = make(map[string]*alertSample, 8)
+	ae.active = make(map[string]*stats.Alert, 8)
+	hk.Reg("alerts"+hk.NameSuffix, ae.housekeep, alertsIdleIval)
+}
+
+func (ae *alertEng) housekeep() time.Duration {
+	var (
+		p    = ae.p
+		conf = &cmn.GCO.Get().Alerts
+		smap = p.owner.smap.get()
+	)
+	if !conf.Enabled || !p.ClusterStarted() || !smap.isPrimary(p.si) {
+		ae.reset()
+		return alertsIdleIval
+	}
+	samples := ae.collect(smap)
+	if alerts := ae.eval(conf.Rules, samples); len(alerts) > 0 {
+		for _, a := range alerts {
+			nlog.Warningln(p.String(), "alert:", a.String())
+		}
+		if len(conf.Sinks) > 0 {
+			go ae.notify(alerts, conf.Sinks, smap)
+		}
+	}
+	return conf.Interval.D()
+}
+
+func (ae *alertEng) reset() {
+	ae.mu.Lock()
+	if len(ae.samples) > 0 || len(ae.active) > 0 || len(ae.resolved) > 0 {
+		clear(ae.samples)
+		clear(ae.active)
+		ae.resolved = nil
+	}
+	ae.mu.Unlock()
+}
+
+// active alerts (firing first) followed by recently resolved
+func (ae *alertEng) list() stats.Alerts {
+	ae.mu.Lock()
+	out := make(stats.Alerts, 0, len(ae.active)+len(ae.resolved))
+	for _, a := range ae.active {
+		clone := *a
+		out = append(out, &clone)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].State != out[j].State {
+			return out[i].State == stats.AlertFiring
+		}
+		return out[i].Since < out[j].Since
+	})
+	for _, a := range ae.resolved {
+		clone := *a
+		out = append(out, &clone)
+	}
+	ae.mu.Unlock()
+	return out
+}
+
+// query all nodes (except self) for their respective stats
+func (ae *alertEng) collect(smap *smapX) map[string]*alertSample {
+	var (
+		p       = ae.p
+		now     = time.Now().UnixNano()
+		samples = make(map[string]*alertSample, smap.Count())
+	)
+	self := p.statsT.GetStats()
+	self.Snode = p.si
+	samples[p.SID()] = &alertSample{si: p.si, node: self, at: now}
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathDae.S,
+		Query:  url.Values{apc.QparamWhat: []string{apc.WhatNodeStats}},
+	}
+	args.to = core.AllNodes
+	args.smap = smap
+	args.timeout = cmn.Rom.MaxKeepalive()
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		s := &alertSample{si: res.si, at: now}
+		if res.err != nil {
+			s.offline = true
+		} else {
+			s.node = &stats.Node{}
+			if err := jsoniter.Unmarshal(res.bytes, s.node); err != nil {
+				nlog.Warningln(p.String(), "failed to unmarshal", res.si.StringEx(), "stats:", err)
+				continue
+			}
+		}
+		samples[res.si.ID()] = s
+	}
+	freeBcastRes(results)
+
+	// removed from the cluster map without (graceful) maintenance
+	for sid, prev := range ae.samples {
+		if _, ok := samples[sid]; !ok && smap.GetNode(sid) == nil {
+			samples[sid] = &alertSample{si: prev.si, at: now, offline: true}
+		}
+	}
+	return samples
+}
+
+// evaluate all rules against the current samples;
+// return firing and resolved transitions (to notify)
+func (ae *alertEng) eval(rules []cmn.AlertRule, samples map[string]*alertSample) (out stats.Alerts) {
+	ae.mu.Lock()
+	keep := make(cos.StrSet, len(ae.active))
+	for i := range rules {
+		rule := &rules[i]
+		for sid, s := range samples {
+			if rule.NodeType != "" && rule.NodeType != s.si.Type() {
+				continue
+			}
+			var (
+				key              = rule.Name + "/" + sid
+				value, holds, ok = ae.value(rule, s, ae.samples[sid])
+			)
+			if !ok {
+				keep.Add(key) // cannot evaluate - keep as is
+				continue
+			}
+			if !holds {
+				continue
+			}
+			keep.Add(key)
+			a, exists := ae.active[key]
+			if !exists {
+				a = &stats.Alert{Rule: rule.Name, Metric: rule.Metric, NodeID: sid, State: stats.AlertPending, Since: s.at}
+				ae.active[key] = a
+			}
+			a.Severity, a.Threshold, a.Value = rule.Severity, rule.Threshold, value
+			if a.State == stats.AlertPending && s.at-a.Since >= int64(rule.For) {
+				a.State, a.Fired = stats.AlertFiring, s.at
+				clone := *a
+				out = append(out, &clone)
+			}
+		}
+	}
+
+	now := time.Now().UnixNano()
+	for key, a := range ae.active {
+		if keep.Contains(key) {
+			continue
+		}
+		delete(ae.active, key)
+		if a.State != stats.AlertFiring {
+			continue
+		}
+		a.State, a.Resolved = stats.AlertResolved, now
+		clone := *a
+		out = append(out, &clone)
+		ae.resolved = append(stats.Alerts{a}, ae.resolved...)
+	}
+	if len(ae.resolved) > alertsMaxResolved {
+		ae.resolved = ae.resolved[:alertsMaxResolved]
+	}
+	ae.samples = samples
+	ae.mu.Unlock()
+	return out
+}
+
+func (*alertEng) value(rule *cmn.AlertRule, s, prev *alertSample) (value float64, holds, ok bool) {
+	if rule.Metric == cmn.AlertMetricOffline {
+		if s.offline {
+			value = 1
+		}
+		return value, s.offline, true
+	}
+	if s.offline {
+		return 0, false, false
+	}
+	v, exists := stats.NodeMetric(s.node, rule.Metric)
+	if !exists {
+		return 0, false, false
+	}
+	value = float64(v)
+	if stats.IsRateMetric(rule.Metric) {
+		if prev == nil || prev.offline || s.at <= prev.at {
+			return 0, false, false
+		}
+		pv, _ := stats.NodeMetric(prev.node, rule.Metric)
+		value = float64(max(v-pv, 0)) / time.Duration(s.at-prev.at).Seconds()
+	}
+	return value, value > rule.Threshold, true
+}
+
+//
+// notifications
+//
+
+func (ae *alertEng) notify(alerts stats.Alerts, sinks []cmn.AlertSink, smap *smapX) {
+	for i := range sinks {
+		var (
+			err    error
+			sink   = &sinks[i]
+			tosend = make(stats.Alerts, 0, len(alerts))
+		)
+		for _, a := range alerts {
+			if sink.Accept(a.Severity) {
+				tosend = append(tosend, a)
+			}
+		}
+		if len(tosend) == 0 {
+			continue
+		}
+		switch sink.Type {
+		case cmn.AlertSinkWebhook:
+			err = ae.post(sink.URL, &alertsMsg{Cluster: smap.UUID, Primary: ae.p.SID(), Alerts: tosend})
+		case cmn.AlertSinkSlack:
+			err = ae.post(sink.URL, cos.StrKVs{"text": alertsText(tosend, smap)})
+		case cmn.AlertSinkEmail:
+			err = alertsEmail(sink, tosend, smap)
+		}
+		if err != nil {
+			nlog.Errorln(ae.p.String(), "failed to send alert(s) to", sink.Type, "sink:", err)
+		}
+	}
+}
+
+func (ae *alertEng) post(u string, msg any) error {
+	req, err := http.NewRequest(http.MethodPost, u, bytes.NewReader(cos.MustMarshal(msg)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(cos.HdrContentType, cos.ContentJSON)
+	resp, err := ae.client.Do(req) //nolint:bodyclose // cos.DrainReader
+	if err != nil {
+		return err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("POST %s: %s", u, resp.Status)
+	}
+	return nil
+}
+
+func alertsText(alerts stats.Alerts, smap *smapX) string {
+	var sb strings.Builder
+	sb.WriteString("aistore cluster " + smap.UUID + ":")
+	for _, a := range alerts {
+		sb.WriteString("\n" + a.String())
+	}
+	return sb.String()
+}
+
+func alertsEmail(sink *cmn.AlertSink, alerts stats.Alerts, smap *smapX) error {
+	var (
+		auth    smtp.Auth
+		to      = strings.Split(strings.ReplaceAll(sink.To, " ", ""), ",")
+		subject = fmt.Sprintf("[aistore] %s: %d alert%s", smap.UUID, len(alerts), cos.Plural(len(alerts)))
+	)
+	if sink.Username != "" {
+		host, _, _ := net.SplitHostPort(sink.SMTP)
+		auth = smtp.PlainAuth("", sink.Username, sink.Password, host)
+	}
+	msg := "From: " + sink.From + "\r\nTo: " + strings.Join(to, ", ") + "\r\nSubject: " + subject + "\r\n\r\n" +
+		strings.ReplaceAll(alertsText(alerts, smap), "\n", "\r\n") + "\r\n"
+	return smtp.SendMail(sink.SMTP, auth, sink.From, to, []byte(msg))
+}
@@ -0,0 +1,109 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestAlertsEval(t *testing.T) {
+	var (
+		tsi   = &meta.Snode{DaeID: "t1", DaeType: apc.Target}
+		psi   = &meta.Snode{DaeID: "p1", DaeType: apc.Proxy}
+		start = time.Now().UnixNano()
+		ae    = &alertEng{samples: map[string]*alertSample{}, active: map[string]*stats.Alert{}}
+		rules = []cmn.AlertRule{
+			{Name: "disk-full", Metric: cmn.AlertMetricCapacity, Threshold: 90, Severity: cmn.AlertSeverityCritical},
+			{Name: "errors", Metric: cmn.AlertMetricErrRate, Threshold: 1, Severity: cmn.AlertSeverityWarning},
+			{Name: "down", Metric: cmn.AlertMetricOffline, For: cos.Duration(20 * time.Second), NodeType: apc.Proxy},
+		}
+	)
+	sample := func(si *meta.Snode, sec int, pct, errs int) *alertSample {
+		s := &alertSample{si: si, at: start + int64(time.Duration(sec)*time.Second)}
+		if pct < 0 {
+			s.offline = true
+			return s
+		}
+		s.node = &stats.Node{Snode: si}
+		body := fmt.Sprintf(`{"tracker": {"err.get.n": %d, "get.n": 1000}, "capacity": {"pct_max": %d}}`, errs, pct)
+		tassert.CheckFatal(t, jsoniter.UnmarshalFromString(body, s.node))
+		return s
+	}
+	eval := func(sec, tpct, terrs int, poffline bool) stats.Alerts {
+		ppct := 0
+		if poffline {
+			ppct = -1
+		}
+		samples := map[string]*alertSample{"t1": sample(tsi, sec, tpct, terrs), "p1": sample(psi, sec, ppct, 0)}
+		return ae.eval(rules, samples)
+	}
+	check := func(out stats.Alerts, expected ...string) {
+		t.Helper()
+		tassert.Fatalf(t, len(out) == len(expected), "expected %d transition(s), got %v", len(expected), out)
+		for i, a := range out {
+			s := a.Rule + "/" + a.NodeID + ":" + a.State
+			tassert.Errorf(t, s == expected[i], "expected %q, got %q", expected[i], s)
+		}
+	}
+
+	// capacity fires right away; error rate cannot be computed yet
+	check(eval(0, 95, 0, false), "disk-full/t1:firing")
+
+	// 50 errors in 10s; proxy goes offline (pending)
+	check(eval(10, 95, 50, true), "errors/t1:firing")
+	list := ae.list()
+	tassert.Fatalf(t, len(list) == 3, "expected 3 active alerts, got %v", list)
+	tassert.Errorf(t, list[2].State == stats.AlertPending && list[2].Rule == "down", "expected pending 'down', got %v", list[2])
+	tassert.Errorf(t, list[1].Value == 5, "expected 5 errors/s, got %v", list[1])
+
+	// capacity back to normal and no new errors: both resolved
+	out := eval(20, 80, 50, true)
+	tassert.Fatalf(t, len(out) == 2, "expected 2 transitions, got %v", out)
+	for _, a := range out {
+		tassert.Errorf(t, a.State == stats.AlertResolved, "expected resolved, got %v", a)
+	}
+
+	// proxy offline for 20s
+	check(eval(30, 80, 50, true), "down/p1:firing")
+
+	// back online
+	check(eval(40, 80, 50, false), "down/p1:resolved")
+	list = ae.list()
+	tassert.Fatalf(t, len(list) == 3, "expected 3 resolved alerts, got %v", list)
+	tassert.Errorf(t, list[0].Rule == "down", "expected most recently resolved first, got %v", list[0])
+}
+
+func TestAlertsConfValidate(t *testing.T) {
+	tests := []struct {
+		conf cmn.AlertsConf
+		ok   bool
+	}{
+		{cmn.AlertsConf{}, true},
+		{cmn.AlertsConf{Enabled: true}, false},
+		{cmn.AlertsConf{Enabled: true, Rules: []cmn.AlertRule{{Name: "a", Metric: "err.rate", Threshold: 1}}}, true},
+		{cmn.AlertsConf{Rules: []cmn.AlertRule{{Name: "a", Metric: "capacity", Threshold: 101}}}, false},
+		{cmn.AlertsConf{Rules: []cmn.AlertRule{{Name: "a", Metric: "capacity", NodeType: apc.Proxy}}}, false},
+		{cmn.AlertsConf{Rules: []cmn.AlertRule{{Name: "a", Metric: "offline"}, {Name: "a", Metric: "capacity"}}}, false},
+		{cmn.AlertsConf{Rules: []cmn.AlertRule{{Name: "a", Metric: "offline", Severity: "fatal"}}}, false},
+		{cmn.AlertsConf{Sinks: []cmn.AlertSink{{Type: "slack", URL: "https://hooks.slack.com/services/x"}}}, true},
+		{cmn.AlertsConf{Sinks: []cmn.AlertSink{{Type: "webhook", URL: "hooks.example.com"}}}, false},
+		{cmn.AlertsConf{Sinks: []cmn.AlertSink{{Type: "email", SMTP: "smtp.example.com:25", From: "ais@example.com"}}}, false},
+		{cmn.AlertsConf{Sinks: []cmn.AlertSink{{Type: "pager", URL: "https://example.com"}}}, false},
+	}
+	for i, test := range tests {
+		err := test.conf.Validate()
+		tassert.Errorf(t, (err == nil) == test.ok, "%d: %+v: expected ok=%t, got err=%v", i, test.conf, test.ok, err)
+	}
+}
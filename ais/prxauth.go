@@ -6,8 +6,10 @@ package ais
 
 import (
 	"fmt"
+	"net"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -23,6 +25,8 @@ import (
 	"github.com/NVIDIA/aistore/memsys"
 )
 
+const hdrForwardedFor = "X-Forwarded-For"
+
 type (
 	tokenList   authn.TokenList       // token strings
 	tkList      map[string]*tok.Token // tk structs
@@ -212,9 +216,9 @@ func (p *proxy) delToken(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Validates a token from the request header
-func (p *proxy) validateToken(hdr http.Header) (*tok.Token, error) {
-	token, err := tok.ExtractToken(hdr)
+// Validates a token from the request header, including its source address ranges, if any
+func (p *proxy) validateToken(r *http.Request) (*tok.Token, error) {
+	token, err := tok.ExtractToken(r.Header)
 	if err != nil {
 		return nil, err
 	}
@@ -223,9 +227,40 @@ func (p *proxy) validateToken(hdr http.Header) (*tok.Token, error) {
 		nlog.Errorf("invalid token: %v", err)
 		return nil, err
 	}
+	if err := tk.CheckSource(p.srcIP(r)); err != nil {
+		nlog.Errorln(err)
+		return nil, err
+	}
 	return tk, nil
 }
 
+// Client's IP address. Requests forwarded by other gateways (e.g., to primary - see forwardCP)
+// carry the original one as the last X-Forwarded-For entry (see httputil.ReverseProxy);
+// otherwise, the latter is ignored.
+func (p *proxy) srcIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if xff := r.Header.Get(hdrForwardedFor); xff != "" && p.isGatewayAddr(host) {
+		if i := strings.LastIndexByte(xff, ','); i >= 0 {
+			xff = xff[i+1:]
+		}
+		host = strings.TrimSpace(xff)
+	}
+	return net.ParseIP(host)
+}
+
+func (p *proxy) isGatewayAddr(host string) bool {
+	smap := p.owner.smap.get()
+	for _, psi := range smap.Pmap {
+		if psi.PubNet.Hostname == host || psi.ControlNet.Hostname == host {
+			return true
+		}
+	}
+	return false
+}
+
 // When AuthN is on, accessing a bucket requires two permissions:
 //   - access to the bucket is granted to a user
 //   - bucket ACL allows the required operation
@@ -237,7 +272,7 @@ func (p *proxy) validateToken(hdr http.Header) (*tok.Token, error) {
 //	- read-only access to a bucket is always granted
 //	- PATCH cannot be forbidden
 func (p *proxy) checkAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
-	if err = p.access(r.Header, bck, ace); err != nil {
+	if err = p.access(r, bck, ace); err != nil {
 		p.writeErr(w, r, err, aceErrToCode(err))
 	}
 	return
@@ -254,16 +289,30 @@ func aceErrToCode(err error) (status int) {
 	return status
 }
 
-func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
+// pre-flight check of the requested operations, one at a time (so that the resulting
+// error would name the first denied one); see apc.QparamCheckAccess
+func (p *proxy) checkAccessEach(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) error {
+	for bit := apc.AccessAttrs(1); bit != 0 && bit <= ace; bit <<= 1 {
+		if ace&bit == 0 {
+			continue
+		}
+		if err := p.access(r, bck, bit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *proxy) access(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
 	var (
 		tk     *tok.Token
 		bucket *cmn.Bck
 	)
-	if p.isIntraCall(hdr, false /*from primary*/) == nil {
+	if p.isIntraCall(r.Header, false /*from primary*/) == nil {
 		return nil
 	}
 	if cmn.Rom.AuthEnabled() { // config.Auth.Enabled
-		tk, err = p.validateToken(hdr)
+		tk, err = p.validateToken(r)
 		if err != nil {
 			// NOTE: making exception to allow 3rd party clients read remote ht://bucket
 			if err == tok.ErrNoToken && bck != nil && bck.IsHT() {
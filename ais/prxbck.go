@@ -179,7 +179,7 @@ rerr:
 
 // (compare w/ accessSupported)
 func (bctx *bctx) accessAllowed(bck *meta.Bck) (ecode int, err error) {
-	err = bctx.p.access(bctx.r.Header, bck, bctx.perms)
+	err = bctx.p.access(bctx.r, bck, bctx.perms)
 	ecode = aceErrToCode(err)
 	return ecode, err
 }
@@ -276,7 +276,7 @@ func (bctx *bctx) _try() (bck *meta.Bck, ecode int, err error) {
 		bck = backend
 	}
 	if bck.IsAIS() {
-		if err = bctx.p.access(bctx.r.Header, nil /*bck*/, apc.AceCreateBucket); err != nil {
+		if err = bctx.p.access(bctx.r, nil /*bck*/, apc.AceCreateBucket); err != nil {
 			ecode = aceErrToCode(err)
 			return
 		}
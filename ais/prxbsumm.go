@@ -1,6 +1,6 @@
 // Package ais provides core functionality for the AIStore object storage.
 /*
- * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
  */
 package ais
 
@@ -8,11 +8,15 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
 )
@@ -20,13 +24,35 @@ import (
 // in this source:
 // - bsummact  <= api.GetBucketSummary(query-bcks, ActMsg)
 // - bsummhead <= api.GetBucketInfo(bck, QparamBinfoWithOrWithoutRemote)
+// - bsummCache: completed summaries (see apc.BsummCtrlMsg.FromCache)
+
+const bsummCacheMax = 64 // max cached (completed) summaries
+
+type (
+	// keyed by query-bcks, prefix, and flags; invalidated upon BMD change
+	bsummCache struct {
+		m  map[string]*bsummEntry
+		mu sync.Mutex
+	}
+	bsummEntry struct {
+		summaries cmn.AllBsummResults
+		bmdVer    int64
+		added     int64 // mono time
+	}
+)
 
 func (p *proxy) bsummact(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) {
 	news := msg.UUID == ""
 	debug.Assert(msg.UUID == "" || cos.IsValidUUID(msg.UUID), msg.UUID)
 
-	// start new
+	// start new unless cached
 	if news {
+		if msg.FromCache {
+			if summaries := p.bsummc.get(qbck, msg, p.owner.bmd.get().Version); summaries != nil {
+				p.writeJSON(w, r, summaries, "bucket-summary")
+				return
+			}
+		}
 		err := p.bsummNew(qbck, msg)
 		if err != nil {
 			p.writeErr(w, r, err)
@@ -140,9 +166,21 @@ func (p *proxy) bsummCollect(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) (_ cmn.
 	summaries.Finalize(dsize, cmn.Rom.TestingEnv())
 	freeBcastRes(results)
 
+	bmd := p.owner.bmd.get()
+	for _, summ := range summaries {
+		if props, present := bmd.Get(meta.CloneBck(&summ.Bck)); present {
+			summ.SetQuota(props)
+		}
+	}
+
 	switch {
 	case numPartial == 0 && numAccepted == 0:
 		status = http.StatusOK
+		now := time.Now().UnixNano()
+		for _, summ := range summaries {
+			summ.Computed = now
+		}
+		p.bsummc.put(qbck, msg, bmd.Version, summaries)
 	case numPartial == 0:
 		status = http.StatusAccepted
 	default:
@@ -169,3 +207,57 @@ func (p *proxy) bsummhead(bck *meta.Bck, msg *apc.BsummCtrlMsg) (info *cmn.Bsumm
 	}
 	return info, status, err
 }
+
+////////////////
+// bsummCache //
+////////////////
+
+func bsummKey(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg) string {
+	var sb strings.Builder
+	sb.WriteString((*cmn.Bck)(qbck).Cname(msg.Prefix))
+	for _, flag := range []bool{msg.ObjCached, msg.BckPresent, msg.DontAddRemote} {
+		if flag {
+			sb.WriteByte('1')
+		} else {
+			sb.WriteByte('0')
+		}
+	}
+	return sb.String()
+}
+
+func (c *bsummCache) get(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg, bmdVer int64) cmn.AllBsummResults {
+	key := bsummKey(qbck, msg)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.m[key]
+	if !ok {
+		return nil
+	}
+	if e.bmdVer != bmdVer {
+		delete(c.m, key)
+		return nil
+	}
+	return e.summaries
+}
+
+func (c *bsummCache) put(qbck *cmn.QueryBcks, msg *apc.BsummCtrlMsg, bmdVer int64, summaries cmn.AllBsummResults) {
+	key := bsummKey(qbck, msg)
+	c.mu.Lock()
+	if c.m == nil {
+		c.m = make(map[string]*bsummEntry, 8)
+	}
+	if _, ok := c.m[key]; !ok && len(c.m) >= bsummCacheMax {
+		var (
+			oldest string
+			added  int64
+		)
+		for k, e := range c.m {
+			if oldest == "" || e.added < added {
+				oldest, added = k, e.added
+			}
+		}
+		delete(c.m, oldest)
+	}
+	c.m[key] = &bsummEntry{summaries: summaries, bmdVer: bmdVer, added: mono.NanoTime()}
+	c.mu.Unlock()
+}
@@ -0,0 +1,45 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBsummCache(t *testing.T) {
+	var (
+		c    bsummCache
+		qbck = &cmn.QueryBcks{Name: "b", Provider: apc.AIS}
+		msg  = &apc.BsummCtrlMsg{ObjCached: true, BckPresent: true, FromCache: true}
+		summ = cmn.AllBsummResults{&cmn.BsummResult{Bck: cmn.Bck(*qbck)}}
+	)
+	tassert.Errorf(t, c.get(qbck, msg, 1) == nil, "expecting miss")
+	c.put(qbck, msg, 1, summ)
+	tassert.Errorf(t, len(c.get(qbck, msg, 1)) == 1, "expecting hit")
+
+	// different prefix or flags
+	other := *msg
+	other.Prefix = "a/"
+	tassert.Errorf(t, c.get(qbck, &other, 1) == nil, "expecting miss (prefix)")
+	other = *msg
+	other.ObjCached = false
+	tassert.Errorf(t, c.get(qbck, &other, 1) == nil, "expecting miss (flags)")
+
+	// BMD changed
+	tassert.Errorf(t, c.get(qbck, msg, 2) == nil, "expecting miss (BMD)")
+	tassert.Errorf(t, c.get(qbck, msg, 1) == nil, "expecting invalidated entry")
+
+	// bounded
+	for i := range bsummCacheMax + 10 {
+		q := &cmn.QueryBcks{Name: "b" + strconv.Itoa(i), Provider: apc.AIS}
+		c.put(q, msg, 1, summ)
+	}
+	tassert.Errorf(t, len(c.m) == bsummCacheMax, "expecting %d entries, got %d", bsummCacheMax, len(c.m))
+}
@@ -17,12 +17,14 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/certloader"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/reb"
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
 	jsoniter "github.com/json-iterator/go"
@@ -71,6 +73,8 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 	switch what {
 	case apc.WhatAllXactStatus:
 		p.ic.xstatusAll(w, r, query)
+	case apc.WhatXactWatch:
+		p.ic.xwatch(w, r, query)
 	case apc.WhatQueryXactStats:
 		p.xquery(w, r, what, query)
 	case apc.WhatAllRunningXacts:
@@ -79,8 +83,19 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		p.qcluStats(w, r, what, query)
 	case apc.WhatSysInfo:
 		p.qcluSysinfo(w, r, what, query)
+	case apc.WhatAlerts:
+		if p.forwardCP(w, r, nil, what) {
+			return
+		}
+		p.writeJSON(w, r, p.alerts.list(), what)
+	case apc.WhatRebEstimate:
+		p.qcluRebEstimate(w, r, what, query)
 	case apc.WhatMountpaths:
 		p.qcluMountpaths(w, r, what, query)
+	case apc.WhatCertificate:
+		p.qcluCertificate(w, r, what, query)
+	case apc.WhatNetIfaces:
+		p.qcluNetIfaces(w, r, what, query)
 	case apc.WhatBackends:
 		config := cmn.GCO.Get()
 		out := make([]string, 0, len(config.Backend.Providers))
@@ -95,6 +110,15 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		p.writeJSON(w, r, all, what)
+	case apc.WhatRemAisPing:
+		// all targets => all nodes of all attached clusters
+		config := cmn.GCO.Get()
+		out, err := p._sysinfo(r, config.Client.Timeout.D(), core.Targets, query)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		p.writeJSON(w, r, out, what)
 	case apc.WhatTargetIPs:
 		// Return comma-separated IPs of the targets.
 		// It can be used to easily fill the `--noproxy` parameter in cURL.
@@ -120,6 +144,7 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		// hide secret
 		c := config.ClusterConfig
 		c.Auth.Secret = "**********"
+		c.Alerts.HidePasswords()
 		p.writeJSON(w, r, &c, what)
 	case apc.WhatBMD, apc.WhatSmapVote, apc.WhatSnode, apc.WhatSmap:
 		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
@@ -231,6 +256,30 @@ func (p *proxy) qcluSysinfo(w http.ResponseWriter, r *http.Request, what string,
 	p.writeJSON(w, r, out, what)
 }
 
+// all nodes, including this one
+func (p *proxy) qcluCertificate(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	config := cmn.GCO.Get()
+	out, err := p._sysinfo(r, config.Client.Timeout.D(), core.AllNodes, query)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	out[p.SID()] = cos.MustMarshal(certloader.GetInfo())
+	p.writeJSON(w, r, out, what)
+}
+
+// all nodes, including this one
+func (p *proxy) qcluNetIfaces(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	config := cmn.GCO.Get()
+	out, err := p._sysinfo(r, config.Client.Timeout.D(), core.AllNodes, query)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	out[p.SID()] = cos.MustMarshal(p.nifs)
+	p.writeJSON(w, r, out, what)
+}
+
 func (p *proxy) getRemAisVec(refresh bool) (*meta.RemAisVec, error) {
 	smap := p.owner.smap.get()
 	si, errT := smap.GetRandTarget()
@@ -307,6 +356,43 @@ func (p *proxy) qcluMountpaths(w http.ResponseWriter, r *http.Request, what stri
 	p.writeJSON(w, r, out, what)
 }
 
+// rebalance pre-flight: all targets estimate (in parallel) their respective parts
+// (see reb/estimate.go)
+func (p *proxy) qcluRebEstimate(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	var (
+		smap    = p.owner.smap.get()
+		exclude = query.Get(apc.QparamRebExclude)
+	)
+	if exclude != "" && smap.GetTarget(exclude) == nil {
+		p.writeErr(w, r, &errNodeNotFound{"cannot estimate rebalance:", exclude, p.si, smap}, http.StatusNotFound)
+		return
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: query}
+	args.smap = smap
+	args.timeout = apc.LongTimeout
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	ests := make([]*reb.Estimate, 0, len(results))
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			p.writeErr(w, r, err)
+			return
+		}
+		est := &reb.Estimate{}
+		if err := jsoniter.Unmarshal(res.bytes, est); err != nil {
+			freeBcastRes(results)
+			p.writeErrf(w, r, cmn.FmtErrUnmarshal, p, "rebalance estimate", cos.BHead(res.bytes), err)
+			return
+		}
+		ests = append(ests, est)
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, reb.NewPlan(ests, exclude, smap.Version), what)
+}
+
 // helper methods for querying targets
 
 func (p *proxy) _queryTs(w http.ResponseWriter, r *http.Request, query url.Values) (cos.JSONRawMsgs, bool) {
@@ -1065,6 +1151,10 @@ func (p *proxy) cluputMsg(w http.ResponseWriter, r *http.Request) {
 		p.xstart(w, r, msg)
 	case apc.ActXactStop:
 		p.xstop(w, r, msg)
+	case apc.ActXactGC:
+		p.xgc(w, r, msg)
+	case apc.ActXactDeadline:
+		p.xdeadline(w, r, msg)
 	case apc.ActSendOwnershipTbl:
 		p.sendOwnTbl(w, r, msg)
 	default:
@@ -1204,8 +1294,17 @@ func (p *proxy) xstart(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 	}
 	xargs.Kind, _ = xact.GetKindName(xargs.Kind) // display name => kind
 
+	if xargs.MaxRuntime < 0 {
+		p.writeErrf(w, r, "%s: invalid (negative) max-runtime %v", msg.Action, xargs.MaxRuntime)
+		return
+	}
+
 	// rebalance
 	if xargs.Kind == apc.ActRebalance {
+		if xargs.MaxRuntime > 0 {
+			p.writeErrf(w, r, "%s: max-runtime is not supported for %s", msg.Action, xargs.Kind)
+			return
+		}
 		p.rebalanceCluster(w, r, msg)
 		return
 	}
@@ -1290,6 +1389,89 @@ func (p *proxy) blobdl(smap *smapX, xargs *xact.ArgsMsg, msg *apc.ActMsg) (tsi *
 	return tsi, err
 }
 
+func (p *proxy) xgc(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var (
+		xargs = xact.ArgsMsg{}
+	)
+	if err := cos.MorphMarshal(msg.Value, &xargs); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if xargs.Kind != "" {
+		kind, _ := xact.GetKindName(xargs.Kind) // display name => kind
+		if kind == "" {
+			p.writeErrf(w, r, "%s: invalid xaction kind %q", msg.Action, xargs.Kind)
+			return
+		}
+		xargs.Kind = kind
+	}
+	if xargs.OlderThan < 0 {
+		p.writeErrf(w, r, "%s: invalid (negative) age %v", msg.Action, xargs.OlderThan)
+		return
+	}
+	if xargs.ID != "" && !xact.IsValidUUID(xargs.ID) {
+		p.writeErrf(w, r, "%s: invalid job ID %q", msg.Action, xargs.ID)
+		return
+	}
+
+	// 1. targets
+	actMsg := apc.ActMsg{Action: msg.Action, Value: xargs}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S, Body: cos.MustMarshal(actMsg)}
+	args.to = core.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			freeBcastRes(results)
+			return
+		}
+	}
+	freeBcastRes(results)
+
+	// 2. IC: finished notification listeners
+	smap := p.owner.smap.get()
+	if smap.IsIC(p.si) {
+		p.notifs.gc(&xargs)
+	}
+	p.bcastAsyncIC(p.newAmsg(&actMsg, nil))
+}
+
+func (p *proxy) xdeadline(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var (
+		xargs = xact.ArgsMsg{}
+	)
+	if err := cos.MorphMarshal(msg.Value, &xargs); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if !xact.IsValidUUID(xargs.ID) {
+		p.writeErrf(w, r, "%s: invalid job ID %q", msg.Action, xargs.ID)
+		return
+	}
+	if xargs.MaxRuntime < 0 {
+		p.writeErrf(w, r, "%s: invalid (negative) max-runtime %v", msg.Action, xargs.MaxRuntime)
+		return
+	}
+
+	body := cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xact.ArgsMsg{ID: xargs.ID, MaxRuntime: xargs.MaxRuntime}})
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S, Body: body}
+	args.to = core.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			break
+		}
+	}
+	freeBcastRes(results)
+}
+
 func (p *proxy) xstop(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 	var (
 		xargs = xact.ArgsMsg{}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -27,10 +28,25 @@ func (p *proxy) dloadHandler(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusServiceUnavailable)
 		return
 	}
+	if strings.HasPrefix(r.URL.Path, apc.URLPathDownloadSched.S) {
+		p.httpdlsched(w, r)
+		return
+	}
+	if strings.HasPrefix(r.URL.Path, apc.URLPathDownloadCreds.S) {
+		p.httpdlcreds(w, r)
+		return
+	}
+	if strings.HasPrefix(r.URL.Path, apc.URLPathDownloadPreset.S) {
+		p.httpdlpresets(w, r)
+		return
+	}
 	switch r.Method {
 	case http.MethodGet, http.MethodDelete:
 		p.httpdladm(w, r)
 	case http.MethodPost:
+		if p.rejectReadOnly(w, r) {
+			return
+		}
 		p.httpdlpost(w, r)
 	default:
 		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost)
@@ -78,48 +94,77 @@ func (p *proxy) httpdladm(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// POST /v1/download
+// POST /v1/download[?preset=NAME]
 func (p *proxy) httpdlpost(w http.ResponseWriter, r *http.Request) {
 	if _, err := p.parseURL(w, r, apc.URLPathDownload.L, 0, false); err != nil {
 		return
 	}
 
-	jobID := dload.PrefixJobID + cos.GenUUID() // prefix to visually differentiate vs. xaction IDs
-
 	body, err := cos.ReadAllN(r.Body, r.ContentLength)
 	if err != nil {
 		p.writeErrStatusf(w, r, http.StatusInternalServerError, "failed to receive download request: %v", err)
 		return
 	}
+	if name := r.URL.Query().Get(apc.QparamDlPreset); name != "" {
+		// the body (if any) contains overrides
+		if body, err = p.dlpresets.instantiate(name, body); err != nil {
+			if cos.IsNotExist(err, 0) {
+				p.writeErr(w, r, err, http.StatusNotFound)
+			} else {
+				p.writeErr(w, r, err)
+			}
+			return
+		}
+	}
 	dlb, dlBase, ok := p.validateDownload(w, r, body)
 	if !ok {
 		return
 	}
 
+	jobID, ecode, err := p.dlnew(body, dlb.Type, dlBase.ProgressInterval)
+	if err != nil {
+		p.writeErrStatusf(w, r, ecode, "Error starting download: %v", err)
+		return
+	}
+
+	b := cos.MustMarshal(dload.DlPostResp{ID: jobID})
+	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(b)))
+	w.Write(b)
+}
+
+// start new download job on all targets and register the corresponding notification listener
+// (used by both the user-initiated and scheduled downloads)
+func (p *proxy) dlnew(body []byte, dlt dload.Type, progressIval string) (string, int, error) {
 	var progressInterval = dload.DownloadProgressInterval
-	if dlBase.ProgressInterval != "" {
-		ival, err := time.ParseDuration(dlBase.ProgressInterval)
+	if progressIval != "" {
+		ival, err := time.ParseDuration(progressIval)
 		if err != nil {
-			p.writeErrf(w, r, "%s: invalid progress interval %q: %v", p, dlBase.ProgressInterval, err)
-			return
+			return "", http.StatusBadRequest, fmt.Errorf("%s: invalid progress interval %q: %v", p, progressIval, err)
 		}
 		progressInterval = ival
 	}
 
-	xid := cos.GenUUID()
-	if ecode, err := p.dlstart(r, xid, jobID, body); err != nil {
-		p.writeErrStatusf(w, r, ecode, "Error starting download: %v", err)
-		return
+	body, err := p.dlcreds.resolve(body)
+	if err != nil {
+		ecode := http.StatusBadRequest
+		if cos.IsNotExist(err, 0) {
+			ecode = http.StatusNotFound
+		}
+		return "", ecode, err
+	}
+	var (
+		jobID = dload.PrefixJobID + cos.GenUUID() // prefix to visually differentiate vs. xaction IDs
+		xid   = cos.GenUUID()
+	)
+	if ecode, err := p.dlstart(xid, jobID, body); err != nil {
+		return "", ecode, err
 	}
 	smap := p.owner.smap.get()
-	nl := dload.NewDownloadNL(jobID, string(dlb.Type), &smap.Smap, progressInterval)
+	nl := dload.NewDownloadNL(jobID, string(dlt), &smap.Smap, progressInterval)
 	nl.SetOwner(equalIC)
 	p.ic.registerEqual(regIC{nl: nl, smap: smap})
-
-	b := cos.MustMarshal(dload.DlPostResp{ID: jobID})
-	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
-	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(b)))
-	w.Write(b)
+	return jobID, http.StatusOK, nil
 }
 
 func (p *proxy) dladm(method, path string, msg *dload.AdminBody) ([]byte, int, error) {
@@ -245,7 +290,7 @@ func (p *proxy) dlstatus(nl nl.Listener, config *cmn.Config) []byte {
 	return cos.MustMarshal(resp)
 }
 
-func (p *proxy) dlstart(r *http.Request, xid, jobID string, body []byte) (ecode int, err error) {
+func (p *proxy) dlstart(xid, jobID string, body []byte) (ecode int, err error) {
 	var (
 		config = cmn.GCO.Get()
 		query  = make(url.Values, 2)
@@ -253,7 +298,7 @@ func (p *proxy) dlstart(r *http.Request, xid, jobID string, body []byte) (ecode
 	)
 	query.Set(apc.QparamUUID, xid)
 	query.Set(apc.QparamJobID, jobID)
-	args.req = cmn.HreqArgs{Method: http.MethodPost, Path: r.URL.Path, Body: body, Query: query}
+	args.req = cmn.HreqArgs{Method: http.MethodPost, Path: apc.URLPathDownload.S, Body: body, Query: query}
 	args.timeout = config.Timeout.MaxHostBusy.D()
 
 	results := p.bcastGroup(args)
@@ -281,8 +326,17 @@ func (p *proxy) validateDownload(w http.ResponseWriter, r *http.Request, body []
 		p.writeErr(w, r, err)
 		return
 	}
+	if dlBase.Creds != "" {
+		if _, err := p.dlcreds.get(dlBase.Creds); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+	}
 	bck := meta.CloneBck(&dlBase.Bck)
 	args := bctx{p: p, w: w, r: r, reqBody: body, bck: bck, perms: apc.AccessRW}
+	if dlb.Type == dload.TypeFS {
+		args.perms |= apc.AcePromote // reading targets' (local or mounted) filesystems
+	}
 	args.createAIS = true
 	if _, err := args.initAndTry(); err == nil {
 		ok = true
@@ -0,0 +1,299 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/ext/dload"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Downloader credentials profiles (see ext/dload/creds.go):
+// - all proxies keep (and persist) a replicated copy of all profiles - encrypted
+//   with the key derived from env.AIS.DloadCredsKey (required to add or change profiles);
+// - the primary makes all the changes and replicates the (encrypted) result to the other proxies
+//   that accept it only from the primary, and only if it opens with their own key;
+// - upon new download job, the proxy resolves the job's profile by name and sends it
+//   to all targets as part of the download body
+
+const dlcredsMetaver = 1
+
+type dlcreds struct {
+	p      *proxy
+	fpath  string
+	sealed dload.CredsSealed
+	mu     sync.Mutex
+}
+
+func (dc *dlcreds) init(p *proxy, config *cmn.Config) {
+	dc.p = p
+	dc.fpath = filepath.Join(config.ConfigDir, fname.DlCreds)
+	if _, err := jsp.Load(dc.fpath, &dc.sealed, jsp.CksumSign(dlcredsMetaver)); err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln(p.String(), "failed to load download credentials profiles:", err)
+		}
+	} else {
+		nlog.Infoln(p.String(), "loaded download credentials profiles, version", dc.sealed.Version)
+	}
+}
+
+func (*dlcreds) secret() string { return os.Getenv(env.AIS.DloadCredsKey) }
+
+// under lock
+func (dc *dlcreds) open() (*dload.CredsMD, error) { return dc.sealed.Open(dc.secret()) }
+
+// under lock
+func (dc *dlcreds) persist(md *dload.CredsMD) error {
+	secret := dc.secret()
+	if secret == "" {
+		return fmt.Errorf("%s: cannot store download credentials profiles: %s (the encryption key) is not set",
+			dc.p, env.AIS.DloadCredsKey)
+	}
+	md.Version = dc.sealed.Version + 1
+	sealed, err := md.Seal(secret)
+	if err != nil {
+		return err
+	}
+	if err := jsp.Save(dc.fpath, sealed, jsp.CksumSign(dlcredsMetaver), nil); err != nil {
+		return err
+	}
+	dc.sealed = *sealed
+	return nil
+}
+
+func (dc *dlcreds) get(name string) (*dload.CredsProfile, error) {
+	dc.mu.Lock()
+	md, err := dc.open()
+	dc.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if _, cp := md.Profiles.Find(name); cp != nil {
+		return cp, nil
+	}
+	return nil, cos.NewErrNotFound(dc.p, "download credentials profile "+name)
+}
+
+// all profiles, with secrets redacted
+func (dc *dlcreds) list() (dload.CredsProfiles, error) {
+	dc.mu.Lock()
+	md, err := dc.open()
+	dc.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	cps := make(dload.CredsProfiles, 0, len(md.Profiles))
+	for _, cp := range md.Profiles {
+		cps = append(cps, cp.Redacted())
+	}
+	cps.Sort()
+	return cps, nil
+}
+
+// add new or replace existing (ditto remove); returns true if the profile existed
+func (dc *dlcreds) modify(name string, cp *dload.CredsProfile) (bool, error) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	md, err := dc.open()
+	if err != nil {
+		return false, err
+	}
+	i, existing := md.Profiles.Find(name)
+	switch {
+	case cp == nil && existing == nil:
+		return false, nil
+	case cp == nil:
+		md.Profiles = append(md.Profiles[:i], md.Profiles[i+1:]...)
+	case existing == nil:
+		md.Profiles = append(md.Profiles, cp)
+	default:
+		md.Profiles[i] = cp
+	}
+	return existing != nil, dc.persist(md)
+}
+
+// replicate to all other proxies (best effort)
+func (dc *dlcreds) bcast() {
+	dc.mu.Lock()
+	body := cos.MustMarshal(&dc.sealed)
+	dc.mu.Unlock()
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathDownloadCredsSync.S, Body: body}
+	args.to = core.Proxies
+	results := dc.p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			nlog.Warningln(dc.p.String(), "failed to replicate download credentials to", res.si.StringEx(), "err:", res.err)
+		}
+	}
+	freeBcastRes(results)
+}
+
+// non-primary: receive replicated profiles (as is, encrypted)
+func (dc *dlcreds) recv(sealed *dload.CredsSealed) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	if sealed.Version <= dc.sealed.Version {
+		return nil
+	}
+	if _, err := sealed.Open(dc.secret()); err != nil {
+		return err
+	}
+	if err := jsp.Save(dc.fpath, sealed, jsp.CksumSign(dlcredsMetaver), nil); err != nil {
+		return err
+	}
+	dc.sealed = *sealed
+	return nil
+}
+
+// check that the download body references existing profile (if any), and
+// return the body with the profile resolved (to send to targets)
+func (dc *dlcreds) resolve(body []byte) ([]byte, error) {
+	var (
+		dlb    dload.Body
+		dlBase dload.Base
+	)
+	if err := jsoniter.Unmarshal(body, &dlb); err != nil {
+		return nil, err
+	}
+	if err := jsoniter.Unmarshal(dlb.RawMessage, &dlBase); err != nil {
+		return nil, err
+	}
+	if dlBase.Auth != nil {
+		return nil, errors.New("invalid download request: 'auth' is reserved (use 'creds' to reference credentials profile)")
+	}
+	if dlBase.Creds == "" {
+		return body, nil
+	}
+	if dlb.Type == dload.TypeBackend || dlb.Type == dload.TypeFS {
+		return nil, fmt.Errorf("credentials profile %q: not supported with %q downloads (expecting HTTP(S) source links)",
+			dlBase.Creds, dlb.Type)
+	}
+	cp, err := dc.get(dlBase.Creds)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]jsoniter.RawMessage, 8)
+	if err := jsoniter.Unmarshal(dlb.RawMessage, &m); err != nil {
+		return nil, err
+	}
+	m["auth"] = cos.MustMarshal(cp)
+	dlb.RawMessage = cos.MustMarshal(m)
+	return cos.MustMarshal(dlb), nil
+}
+
+//
+// HTTP: /v1/download/creds
+//
+
+// GET    /v1/download/creds        - list all profiles (secrets redacted)
+// POST   /v1/download/creds        - add new or update existing profile (dload.CredsProfile)
+// DELETE /v1/download/creds/{name} - remove
+// PUT    /v1/download/creds/sync   - (internal) replicate all profiles
+func (p *proxy) httpdlcreds(w http.ResponseWriter, r *http.Request) {
+	items, err := p.parseURL(w, r, apc.URLPathDownloadCreds.L, 0, true)
+	if err != nil {
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		cps, err := p.dlcreds.list()
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		p.writeJSON(w, r, cps, "download-creds")
+	case http.MethodPost:
+		p.httpdlcredsAdd(w, r)
+	case http.MethodPut:
+		if len(items) == 0 || items[0] != apc.Sync {
+			p.writeErrURL(w, r)
+			return
+		}
+		if !p.ensureIntraControl(w, r, true /* from primary */) {
+			return
+		}
+		sealed := &dload.CredsSealed{}
+		if cmn.ReadJSON(w, r, sealed) != nil {
+			return
+		}
+		if err := p.dlcreds.recv(sealed); err != nil {
+			p.writeErr(w, r, err)
+		}
+	case http.MethodDelete:
+		if len(items) == 0 {
+			p.writeErrURL(w, r)
+			return
+		}
+		p.httpdlcredsRemove(w, r, items[0])
+	default:
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost, http.MethodPut)
+	}
+}
+
+func (p *proxy) httpdlcredsAdd(w http.ResponseWriter, r *http.Request) {
+	if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+		return
+	}
+	cp := &dload.CredsProfile{}
+	if cmn.ReadJSON(w, r, cp) != nil {
+		return
+	}
+	if err := cp.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if p.forwardCP(w, r, nil, "add download credentials", cos.MustMarshal(cp)) {
+		return
+	}
+	existed, err := p.dlcreds.modify(cp.Name, cp)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	go p.dlcreds.bcast()
+	if existed {
+		nlog.Infoln(p.String(), "updated download credentials profile", cp.Name)
+	} else {
+		nlog.Infoln(p.String(), "added download credentials profile", cp.Name)
+	}
+}
+
+func (p *proxy) httpdlcredsRemove(w http.ResponseWriter, r *http.Request, name string) {
+	if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+		return
+	}
+	if p.forwardCP(w, r, nil, "remove download credentials") {
+		return
+	}
+	existed, err := p.dlcreds.modify(name, nil)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if !existed {
+		err := cos.NewErrNotFound(p, "download credentials profile "+name)
+		p.writeErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	go p.dlcreds.bcast()
+	nlog.Infoln(p.String(), "removed download credentials profile", name)
+}
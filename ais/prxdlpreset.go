@@ -0,0 +1,225 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/ext/dload"
+)
+
+// Download presets (see ext/dload/preset.go):
+// - all proxies keep (and persist) a replicated copy of all presets;
+// - the primary makes all the changes and replicates the result to the other proxies;
+// - any proxy can then instantiate a preset: POST /v1/download?preset=NAME
+
+const dlpresetsMetaver = 1
+
+type dlpresets struct {
+	p     *proxy
+	fpath string
+	md    dload.PresetsMD
+	mu    sync.Mutex
+}
+
+func (dp *dlpresets) init(p *proxy, config *cmn.Config) {
+	dp.p = p
+	dp.fpath = filepath.Join(config.ConfigDir, fname.DlPresets)
+	if _, err := jsp.Load(dp.fpath, &dp.md, jsp.CksumSign(dlpresetsMetaver)); err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln(p.String(), "failed to load download presets:", err)
+		}
+	} else if l := len(dp.md.Presets); l > 0 {
+		nlog.Infoln(p.String(), "loaded", l, "download preset(s), version", dp.md.Version)
+	}
+}
+
+// under lock
+func (dp *dlpresets) persist() error {
+	dp.md.Version++
+	return jsp.Save(dp.fpath, &dp.md, jsp.CksumSign(dlpresetsMetaver), nil)
+}
+
+func (dp *dlpresets) list() (pss dload.Presets) {
+	dp.mu.Lock()
+	pss = make(dload.Presets, 0, len(dp.md.Presets))
+	for _, ps := range dp.md.Presets {
+		clone := *ps
+		pss = append(pss, &clone)
+	}
+	dp.mu.Unlock()
+	pss.Sort()
+	return pss
+}
+
+// returns download body to start new job with
+func (dp *dlpresets) instantiate(name string, overrides []byte) ([]byte, error) {
+	dp.mu.Lock()
+	_, ps := dp.md.Presets.Find(name)
+	dp.mu.Unlock()
+	if ps == nil {
+		return nil, cos.NewErrNotFound(dp.p, "download preset "+name)
+	}
+	body, err := ps.Instantiate(overrides)
+	if err != nil {
+		return nil, err
+	}
+	return cos.MustMarshal(body), nil
+}
+
+// add new or replace existing (ditto remove); returns true if the preset existed
+func (dp *dlpresets) modify(name string, ps *dload.Preset) (bool, error) {
+	dp.mu.Lock()
+	defer dp.mu.Unlock()
+	i, existing := dp.md.Presets.Find(name)
+	switch {
+	case ps == nil && existing == nil:
+		return false, nil
+	case ps == nil:
+		dp.md.Presets = append(dp.md.Presets[:i], dp.md.Presets[i+1:]...)
+	case existing == nil:
+		dp.md.Presets = append(dp.md.Presets, ps)
+	default:
+		dp.md.Presets[i] = ps
+	}
+	return existing != nil, dp.persist()
+}
+
+// replicate to all other proxies (best effort)
+func (dp *dlpresets) bcast() {
+	dp.mu.Lock()
+	body := cos.MustMarshal(&dp.md)
+	dp.mu.Unlock()
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathDownloadPresetSync.S, Body: body}
+	args.to = core.Proxies
+	results := dp.p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			nlog.Warningln(dp.p.String(), "failed to replicate download presets to", res.si.StringEx(), "err:", res.err)
+		}
+	}
+	freeBcastRes(results)
+}
+
+// non-primary: receive replicated presets
+func (dp *dlpresets) recv(md *dload.PresetsMD) error {
+	dp.mu.Lock()
+	defer dp.mu.Unlock()
+	if md.Version <= dp.md.Version {
+		return nil
+	}
+	dp.md = *md
+	return jsp.Save(dp.fpath, &dp.md, jsp.CksumSign(dlpresetsMetaver), nil)
+}
+
+//
+// HTTP: /v1/download/preset
+//
+
+// GET    /v1/download/preset        - list all presets
+// POST   /v1/download/preset        - add new or update existing preset (dload.Preset)
+// DELETE /v1/download/preset/{name} - remove
+// PUT    /v1/download/preset/sync   - (internal) replicate all presets
+func (p *proxy) httpdlpresets(w http.ResponseWriter, r *http.Request) {
+	items, err := p.parseURL(w, r, apc.URLPathDownloadPreset.L, 0, true)
+	if err != nil {
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		p.writeJSON(w, r, p.dlpresets.list(), "download-presets")
+	case http.MethodPost:
+		p.httpdlpresetsAdd(w, r)
+	case http.MethodPut:
+		if len(items) == 0 || items[0] != apc.Sync {
+			p.writeErrURL(w, r)
+			return
+		}
+		if !p.ensureIntraControl(w, r, true /* from primary */) {
+			return
+		}
+		md := &dload.PresetsMD{}
+		if cmn.ReadJSON(w, r, md) != nil {
+			return
+		}
+		if err := p.dlpresets.recv(md); err != nil {
+			p.writeErr(w, r, err)
+		}
+	case http.MethodDelete:
+		if len(items) == 0 {
+			p.writeErrURL(w, r)
+			return
+		}
+		p.httpdlpresetsRemove(w, r, items[0])
+	default:
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost, http.MethodPut)
+	}
+}
+
+func (p *proxy) httpdlpresetsAdd(w http.ResponseWriter, r *http.Request) {
+	if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+		return
+	}
+	ps := &dload.Preset{}
+	if cmn.ReadJSON(w, r, ps) != nil {
+		return
+	}
+	if err := ps.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if ps.Created.IsZero() {
+		ps.Created = time.Now()
+	}
+	if p.forwardCP(w, r, nil, "add download preset", cos.MustMarshal(ps)) {
+		return
+	}
+	existed, err := p.dlpresets.modify(ps.Name, ps)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	go p.dlpresets.bcast()
+	if existed {
+		nlog.Infoln(p.String(), "updated download preset", ps.Name)
+	} else {
+		nlog.Infoln(p.String(), "added download preset", ps.Name)
+	}
+}
+
+func (p *proxy) httpdlpresetsRemove(w http.ResponseWriter, r *http.Request, name string) {
+	if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+		return
+	}
+	if p.forwardCP(w, r, nil, "remove download preset") {
+		return
+	}
+	existed, err := p.dlpresets.modify(name, nil)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if !existed {
+		err := cos.NewErrNotFound(p, "download preset "+name)
+		p.writeErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	go p.dlpresets.bcast()
+	nlog.Infoln(p.String(), "removed download preset", name)
+}
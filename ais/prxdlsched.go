@@ -0,0 +1,319 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/ext/dload"
+	"github.com/NVIDIA/aistore/hk"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Scheduled (recurring) downloads:
+// - all proxies keep (and persist) a replicated copy of all schedules;
+// - the primary makes all the changes and replicates the result to the other proxies;
+// - the primary also periodically starts new download jobs for the schedules that are due
+//   (see dload.Schedule)
+
+const (
+	dlschedMetaver = 1
+	dlschedIval    = time.Minute // check schedules every so often
+)
+
+type dlsched struct {
+	p     *proxy
+	fpath string
+	md    dload.SchedulesMD
+	mu    sync.Mutex
+}
+
+func (ds *dlsched) init(p *proxy, config *cmn.Config) {
+	ds.p = p
+	ds.fpath = filepath.Join(config.ConfigDir, fname.DlSchedules)
+	if _, err := jsp.Load(ds.fpath, &ds.md, jsp.CksumSign(dlschedMetaver)); err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln(p.String(), "failed to load download schedules:", err)
+		}
+	} else if l := len(ds.md.Schedules); l > 0 {
+		nlog.Infoln(p.String(), "loaded", l, "download schedule(s), version", ds.md.Version)
+	}
+	hk.Reg("dload-schedules"+hk.NameSuffix, ds.housekeep, dlschedIval)
+}
+
+// under lock
+func (ds *dlsched) persist() error {
+	ds.md.Version++
+	return jsp.Save(ds.fpath, &ds.md, jsp.CksumSign(dlschedMetaver), nil)
+}
+
+func (ds *dlsched) list() (ss dload.Schedules) {
+	ds.mu.Lock()
+	ss = make(dload.Schedules, 0, len(ds.md.Schedules))
+	for _, s := range ds.md.Schedules {
+		clone := *s
+		ss = append(ss, &clone)
+	}
+	ds.mu.Unlock()
+	ss.Sort()
+	return ss
+}
+
+// replicate to all other proxies (best effort)
+func (ds *dlsched) bcast() {
+	ds.mu.Lock()
+	body := cos.MustMarshal(&ds.md)
+	ds.mu.Unlock()
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathDownloadSchedSync.S, Body: body}
+	args.to = core.Proxies
+	results := ds.p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			nlog.Warningln(ds.p.String(), "failed to replicate download schedules to", res.si.StringEx(), "err:", res.err)
+		}
+	}
+	freeBcastRes(results)
+}
+
+// non-primary: receive replicated schedules
+func (ds *dlsched) recv(md *dload.SchedulesMD) error {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if md.Version <= ds.md.Version {
+		return nil
+	}
+	ds.md = *md
+	return jsp.Save(ds.fpath, &ds.md, jsp.CksumSign(dlschedMetaver), nil)
+}
+
+// periodically run all due schedules (primary only)
+func (ds *dlsched) housekeep() time.Duration {
+	p := ds.p
+	if !p.ClusterStarted() || !p.owner.smap.get().isPrimary(p.si) {
+		return dlschedIval
+	}
+	if cmn.GCO.Get().Proxy.ReadOnly {
+		return dlschedIval // skip while in read-only mode
+	}
+	var (
+		now = time.Now()
+		due dload.Schedules
+	)
+	ds.mu.Lock()
+	for _, s := range ds.md.Schedules {
+		if s.Due(now) {
+			due = append(due, s)
+		}
+	}
+	ds.mu.Unlock()
+	if len(due) == 0 {
+		return dlschedIval
+	}
+
+	var cnt int
+	for _, s := range due {
+		if ds.running(s) {
+			nlog.Infoln(p.String(), "scheduled download", s.String(), "- previous job", s.LastJobID, "still running, skipping")
+			continue
+		}
+		jobID, err := ds.run(s)
+		cnt++
+		ds.mu.Lock()
+		s.Ran(now, jobID, err)
+		ds.mu.Unlock()
+		if err != nil {
+			nlog.Errorln(p.String(), "failed to run scheduled download", s.String()+":", err)
+		} else {
+			nlog.Infoln(p.String(), "scheduled download", s.String(), "=> job", jobID)
+		}
+	}
+	if cnt == 0 {
+		return dlschedIval
+	}
+
+	ds.mu.Lock()
+	err := ds.persist()
+	ds.mu.Unlock()
+	if err != nil {
+		nlog.Errorln(p.String(), "failed to persist download schedules:", err)
+	}
+	ds.bcast()
+	return dlschedIval
+}
+
+// whether the job started by the previous run of the schedule is still active
+func (ds *dlsched) running(s *dload.Schedule) bool {
+	if s.LastJobID == "" {
+		return false
+	}
+	nl := ds.p.notifs.entry(s.LastJobID)
+	return nl != nil && !nl.Finished()
+}
+
+func (ds *dlsched) run(s *dload.Schedule) (string, error) {
+	var dlBase dload.Base
+	if err := jsoniter.Unmarshal(s.Body.RawMessage, &dlBase); err != nil {
+		return "", err
+	}
+	body := cos.MustMarshal(s.Body)
+	jobID, _, err := ds.p.dlnew(body, s.Body.Type, dlBase.ProgressInterval)
+	return jobID, err
+}
+
+//
+// HTTP: /v1/download/schedule
+//
+
+// GET    /v1/download/schedule                  - list all schedules
+// POST   /v1/download/schedule                  - create new schedule (dload.ScheduleMsg)
+// PUT    /v1/download/schedule/{enable|disable} - enable or disable (dload.AdminBody)
+// DELETE /v1/download/schedule/remove           - remove (dload.AdminBody)
+// PUT    /v1/download/schedule/sync             - (internal) replicate all schedules
+func (p *proxy) httpdlsched(w http.ResponseWriter, r *http.Request) {
+	items, err := p.parseURL(w, r, apc.URLPathDownloadSched.L, 0, true)
+	if err != nil {
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		if err := p.checkAccess(w, r, nil, apc.AceShowCluster); err != nil {
+			return
+		}
+		p.writeJSON(w, r, p.dlsched.list(), "download-schedules")
+	case http.MethodPost:
+		p.httpdlschedNew(w, r)
+	case http.MethodPut:
+		if len(items) == 0 {
+			p.writeErrURL(w, r)
+			return
+		}
+		switch items[0] {
+		case apc.Sync:
+			if !p.ensureIntraControl(w, r, true /* from primary */) {
+				return
+			}
+			md := &dload.SchedulesMD{}
+			if cmn.ReadJSON(w, r, md) != nil {
+				return
+			}
+			if err := p.dlsched.recv(md); err != nil {
+				p.writeErr(w, r, err)
+			}
+		case apc.Enable, apc.Disable:
+			p.httpdlschedAdm(w, r, items[0])
+		default:
+			p.writeErrAct(w, r, items[0])
+		}
+	case http.MethodDelete:
+		if len(items) == 0 || items[0] != apc.Remove {
+			p.writeErrURL(w, r)
+			return
+		}
+		p.httpdlschedAdm(w, r, apc.Remove)
+	default:
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost, http.MethodPut)
+	}
+}
+
+func (p *proxy) httpdlschedNew(w http.ResponseWriter, r *http.Request) {
+	if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+		return
+	}
+	body, err := cos.ReadAllN(r.Body, r.ContentLength)
+	if err != nil {
+		p.writeErrStatusf(w, r, http.StatusInternalServerError, "failed to receive download schedule: %v", err)
+		return
+	}
+	if p.forwardCP(w, r, nil, "schedule download", body) {
+		return
+	}
+	msg := &dload.ScheduleMsg{}
+	if err := jsoniter.Unmarshal(body, msg); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrUnmarshal, p, "download schedule", cos.BHead(body), err)
+		return
+	}
+	if err := msg.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	dlBody := cos.MustMarshal(msg.Body)
+	_, dlBase, ok := p.validateDownload(w, r, dlBody)
+	if !ok {
+		return
+	}
+
+	s := dload.NewSchedule(msg, dlBase.Description, time.Now())
+	ds := &p.dlsched
+	ds.mu.Lock()
+	ds.md.Schedules = append(ds.md.Schedules, s)
+	err = ds.persist()
+	ds.mu.Unlock()
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	go ds.bcast()
+
+	nlog.Infoln(p.String(), "new download schedule", s.String())
+	p.writeJSON(w, r, dload.DlPostResp{ID: s.ID}, "schedule-download")
+}
+
+func (p *proxy) httpdlschedAdm(w http.ResponseWriter, r *http.Request, action string) {
+	if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+		return
+	}
+	msg := &dload.AdminBody{}
+	if cmn.ReadJSON(w, r, msg) != nil {
+		return
+	}
+	if err := msg.Validate(true /*requireID*/); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if p.forwardCP(w, r, nil, action+" download schedule", cos.MustMarshal(msg)) {
+		return
+	}
+
+	ds := &p.dlsched
+	ds.mu.Lock()
+	i, s := ds.md.Schedules.Find(msg.ID)
+	if s == nil {
+		ds.mu.Unlock()
+		err := cos.NewErrNotFound(p, "download schedule "+msg.ID)
+		p.writeErr(w, r, err, http.StatusNotFound)
+		return
+	}
+	switch action {
+	case apc.Enable:
+		s.Enable(time.Now())
+	case apc.Disable:
+		s.Enabled = false
+	case apc.Remove:
+		ds.md.Schedules = append(ds.md.Schedules[:i], ds.md.Schedules[i+1:]...)
+	}
+	err := ds.persist()
+	ds.mu.Unlock()
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	go ds.bcast()
+	nlog.Infoln(p.String(), fmt.Sprintf("%s download schedule %s", action, msg.ID))
+}
@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -34,6 +35,9 @@ func (p *proxy) etlHandler(w http.ResponseWriter, r *http.Request) {
 		if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
 			return
 		}
+		if p.rejectReadOnly(w, r) {
+			return
+		}
 		p.httpetlput(w, r)
 	case r.Method == http.MethodPost:
 		p.httpetlpost(w, r)
@@ -44,6 +48,9 @@ func (p *proxy) etlHandler(w http.ResponseWriter, r *http.Request) {
 		if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
 			return
 		}
+		if p.rejectReadOnly(w, r) {
+			return
+		}
 		p.httpetldel(w, r)
 	default:
 		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost)
@@ -111,10 +118,24 @@ func (p *proxy) httpetlput(w http.ResponseWriter, r *http.Request) {
 		p.writeErr(w, r, err)
 		return
 	}
+	if cos.IsParseBool(r.URL.Query().Get(apc.QparamDryRun)) {
+		p.dryrunETL(w, r, initMsg)
+		return
+	}
 	if err := initMsg.Validate(); err != nil {
 		p.writeErr(w, r, err)
 		return
 	}
+	if err := etl.ValidateGPUs(initMsg, &p.owner.smap.get().Smap); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+
+	// canary deployment of a new version of an existing ETL
+	if s := r.URL.Query().Get(apc.QparamCanaryPct); s != "" {
+		p.initCanary(w, r, initMsg, s)
+		return
+	}
 
 	// must be new
 	etlMD := p.owner.etl.get()
@@ -133,6 +154,53 @@ func (p *proxy) httpetlput(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// PUT /v1/etl?dry_run=true
+// validate only (see etl.DryRun), and return structured results; nothing gets started
+func (p *proxy) dryrunETL(w http.ResponseWriter, r *http.Request, initMsg etl.InitMsg) {
+	res := &etl.DryRunResult{Name: initMsg.Name()}
+	if err := initMsg.Validate(); err != nil {
+		res.Add(etl.DryRunCheckSpec, "", err)
+		p.writeJSON(w, r, res, "etl-dry-run")
+		return
+	}
+	var (
+		name  = initMsg.Name()
+		etlMD = p.owner.etl.get()
+		smap  = p.owner.smap.get()
+	)
+	switch {
+	case etlMD.get(name) != nil:
+		res.Add(etl.DryRunCheckName, "", fmt.Errorf("etl[%s] already exists", name))
+	case etlMD.IsCanary(name):
+		res.Add(etl.DryRunCheckName, "", fmt.Errorf("etl[%s] is a canary deployment in progress", name))
+	}
+	if err := etl.ValidateGPUs(initMsg, &smap.Smap); err != nil {
+		res.Add(etl.DryRunCheckGPUs, "", err)
+	}
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodPut,
+		Path:   apc.URLPathETL.S,
+		Body:   cos.MustMarshal(initMsg), // (validated, with defaults)
+		Query:  url.Values{apc.QparamDryRun: []string{"true"}},
+	}
+	args.timeout = apc.DefaultTimeout
+	args.cresv = cresED{} // -> etl.DryRunIssues
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, result := range results {
+		if result.err != nil {
+			res.Add(etl.DryRunCheckNode, result.si.ID(), result.toErr())
+			continue
+		}
+		res.NumTargets++
+		res.Issues = append(res.Issues, *result.v.(*etl.DryRunIssues)...)
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, res, "etl-dry-run")
+}
+
 // POST /v1/etl/<etl-name>/stop (or) /v1/etl/<etl-name>/start
 // start/stop ETL pods
 func (p *proxy) httpetlpost(w http.ResponseWriter, r *http.Request) {
@@ -155,8 +223,30 @@ func (p *proxy) httpetlpost(w http.ResponseWriter, r *http.Request) {
 	switch op := apiItems[1]; op {
 	case apc.ETLStop:
 		p.stopETL(w, r)
+	case apc.ETLAbort:
+		p.abortETL(w, r, etlName)
 	case apc.ETLStart:
 		p.startETL(w, etlMsg, false /*add to etlMD*/)
+	case apc.ETLCanary, apc.ETLPromote, apc.ETLRollback:
+		if err := p.checkAccess(w, r, nil, apc.AceAdmin); err != nil {
+			return
+		}
+		if p.forwardCP(w, r, nil, op+" ETL") {
+			return
+		}
+		canary := etlMD.Canaries.Get(etlName)
+		if canary == nil {
+			p.writeErr(w, r, cos.NewErrNotFound(p, "canary of the etl job "+etlName))
+			return
+		}
+		switch op {
+		case apc.ETLCanary:
+			p.setCanary(w, r, canary)
+		case apc.ETLPromote:
+			p.promoteCanary(w, r, canary, etlMD.get(canary.ETL))
+		default:
+			p.rollbackCanary(w, r, canary)
+		}
 	default:
 		debug.Assert(false, "invalid operation: "+op)
 		p.writeErrURL(w, r)
@@ -191,6 +281,14 @@ func (p *proxy) httpetldel(w http.ResponseWriter, r *http.Request) {
 
 func (p *proxy) _deleteETLPre(ctx *etlMDModifier, clone *etlMD) (err error) {
 	debug.AssertNoErr(k8s.ValidateEtlName(ctx.etlName))
+	if c := clone.Canaries.Get(ctx.etlName); c != nil {
+		return fmt.Errorf("%s: cannot delete etl[%s] - %s is in progress (promote or roll it back first)",
+			p, ctx.etlName, c)
+	}
+	if clone.IsCanary(ctx.etlName) {
+		return fmt.Errorf("%s: cannot delete etl[%s] - canary deployment is in progress (promote or roll it back)",
+			p, ctx.etlName)
+	}
 	if exists := clone.del(ctx.etlName); !exists {
 		err = cos.NewErrNotFound(p, "etl job "+ctx.etlName)
 	}
@@ -199,11 +297,19 @@ func (p *proxy) _deleteETLPre(ctx *etlMDModifier, clone *etlMD) (err error) {
 
 // broadcast (start ETL) request to all targets
 func (p *proxy) startETL(w http.ResponseWriter, msg etl.InitMsg, addToMD bool) error {
-	var (
-		err  error
-		args = allocBcArgs()
-		xid  = etl.PrefixXactID + cos.GenUUID()
-	)
+	xid, err := p._startETL(msg, addToMD)
+	if err != nil {
+		return err
+	}
+	// All init calls succeeded - return running xaction
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(xid)))
+	w.Write(cos.UnsafeB(xid))
+	return nil
+}
+
+func (p *proxy) _startETL(msg etl.InitMsg, addToMD bool) (xid string, err error) {
+	args := allocBcArgs()
+	xid = etl.PrefixXactID + cos.GenUUID()
 	{
 		args.req = cmn.HreqArgs{
 			Method: http.MethodPut,
@@ -233,7 +339,7 @@ func (p *proxy) startETL(w http.ResponseWriter, msg etl.InitMsg, addToMD bool) e
 		argsTerm.timeout = apc.LongTimeout
 		p.bcastGroup(argsTerm)
 		freeBcArgs(argsTerm)
-		return err
+		return "", err
 	}
 
 	if addToMD {
@@ -245,10 +351,7 @@ func (p *proxy) startETL(w http.ResponseWriter, msg etl.InitMsg, addToMD bool) e
 		}
 		p.owner.etl.modify(ctx)
 	}
-	// All init calls succeeded - return running xaction
-	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(xid)))
-	w.Write(cos.UnsafeB(xid))
-	return nil
+	return xid, nil
 }
 
 func _addETLPre(ctx *etlMDModifier, clone *etlMD) (_ error) {
@@ -305,6 +408,7 @@ func (p *proxy) listETL(w http.ResponseWriter, r *http.Request) {
 		} else {
 			another := res.v.(*etl.InfoList)
 			sort.Sort(another)
+			etls.MergeBreakers(*another)
 			if !reflect.DeepEqual(etls, another) {
 				// TODO: Should we return an error to a user?
 				// Or stop mismatching ETLs and return internal server error?
@@ -423,8 +527,41 @@ func (p *proxy) metricsETL(w http.ResponseWriter, r *http.Request) {
 
 // POST /v1/etl/<etl-name>/stop
 func (p *proxy) stopETL(w http.ResponseWriter, r *http.Request) {
+	if err := p._stopETL(r.URL.Path); err != nil {
+		p.writeErr(w, r, err)
+	}
+}
+
+// POST /v1/etl/<etl-name>/abort
+// (internal) a target gave up on its ETL pod (see ext/etl/health.go) - abort the ETL on all targets
+func (p *proxy) abortETL(w http.ResponseWriter, r *http.Request, etlName string) {
+	if !p.ensureIntraControl(w, r, false /*from target*/) {
+		return
+	}
+	cause, err := cos.ReadAll(r.Body)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	r.Body.Close()
+	nlog.Errorf("%s: aborting etl[%s] cluster-wide, cause: %s", p, etlName, cause)
+
 	args := allocBcArgs()
-	args.req = cmn.HreqArgs{Method: http.MethodPost, Path: r.URL.Path}
+	args.req = cmn.HreqArgs{Method: http.MethodPost, Path: r.URL.Path, Body: cause}
+	args.timeout = apc.LongTimeout
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			nlog.Warningln(p.String(), "failed to abort etl["+etlName+"] on", res.si.StringEx(), "err:", res.err)
+		}
+	}
+	freeBcastRes(results)
+}
+
+func (p *proxy) _stopETL(path string) (err error) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPost, Path: path}
 	args.timeout = apc.LongTimeout
 	results := p.bcastGroup(args)
 	freeBcArgs(args)
@@ -432,8 +569,9 @@ func (p *proxy) stopETL(w http.ResponseWriter, r *http.Request) {
 		if res.err == nil {
 			continue
 		}
-		p.writeErr(w, r, res.toErr())
+		err = res.toErr()
 		break
 	}
 	freeBcastRes(results)
+	return err
 }
@@ -0,0 +1,163 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/ext/etl"
+)
+
+// ETL canary deployment (see ext/etl/canary.go):
+// - PUT  /v1/etl?canary_pct=N                   - start a new version of an existing ETL as a canary
+// - POST /v1/etl/<name>/canary?canary_pct=N      - change the percentage of requests routed to the canary
+// - POST /v1/etl/<name>/promote[?etl_version=V] - replace the current version with the canary
+// - POST /v1/etl/<name>/rollback                - stop and remove the canary
+
+func (p *proxy) initCanary(w http.ResponseWriter, r *http.Request, msg etl.InitMsg, spct string) {
+	pct, err := strconv.Atoi(spct)
+	if err != nil {
+		p.writeErrf(w, r, "%s: invalid %s=%q: %v", p, apc.QparamCanaryPct, spct, err)
+		return
+	}
+	var (
+		name  = msg.Name()
+		etlMD = p.owner.etl.get()
+	)
+	if etlMD.get(name) == nil {
+		p.writeErr(w, r, cos.NewErrNotFound(p, "etl job "+name))
+		return
+	}
+	if c := etlMD.Canaries.Get(name); c != nil {
+		p.writeErrf(w, r, "%s: %s is already in progress", p, c)
+		return
+	}
+	canary, err := etl.NewCanary(name, msg.Version(), pct)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if etlMD.get(canary.ETL) != nil {
+		p.writeErrf(w, r, "%s: etl[%s] already exists", p, canary.ETL)
+		return
+	}
+
+	xid, err := p._startETL(etl.Rename(msg, canary.ETL, canary.Version), true /*add to etlMD*/)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if err := p._modCanary(canary, false /*del*/); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	nlog.Infoln(p.String()+":", "started", canary.String())
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(xid)))
+	w.Write(cos.UnsafeB(xid))
+}
+
+func (p *proxy) setCanary(w http.ResponseWriter, r *http.Request, canary *etl.Canary) {
+	spct := r.URL.Query().Get(apc.QparamCanaryPct)
+	pct, err := strconv.Atoi(spct)
+	if err != nil {
+		p.writeErrf(w, r, "%s: invalid %s=%q: %v", p, apc.QparamCanaryPct, spct, err)
+		return
+	}
+	c := *canary
+	c.Pct = pct
+	if err := c.Validate(); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if err := p._modCanary(&c, false /*del*/); err != nil {
+		p.writeErr(w, r, err)
+	}
+}
+
+// 1. route all requests to the canary
+// 2. restart the current ETL with the canary's init message
+// 3. remove the canary
+func (p *proxy) promoteCanary(w http.ResponseWriter, r *http.Request, canary *etl.Canary, canaryMsg etl.InitMsg) {
+	debug.Assert(canaryMsg != nil, canary.String())
+	if version := r.URL.Query().Get(apc.QparamETLVersion); version != "" && version != canary.Version {
+		p.writeErrf(w, r, "%s: cannot promote version %q - %s is in progress", p, version, canary)
+		return
+	}
+	c := *canary
+	c.Pct = 100
+	if err := p._modCanary(&c, false /*del*/); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if err := p._stopETL(apc.URLPathETL.Join(c.Name, apc.ETLStop)); err != nil {
+		p.writeErr(w, r, fmt.Errorf("%s: failed to promote %s: %v", p, &c, err))
+		return
+	}
+	xid, err := p._startETL(etl.Rename(canaryMsg, c.Name, c.Version), true /*add to etlMD*/)
+	if err != nil {
+		// (all requests remain routed to the canary)
+		p.writeErr(w, r, fmt.Errorf("%s: failed to promote %s: %v", p, &c, err))
+		return
+	}
+	if err := p._modCanary(&c, true /*del*/); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if err := p._stopETL(apc.URLPathETL.Join(c.ETL, apc.ETLStop)); err != nil {
+		nlog.Errorln(p.String()+":", "failed to stop", c.ETL, "upon promotion:", err)
+	}
+	nlog.Infoln(p.String()+":", "promoted", c.String())
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(xid)))
+	w.Write(cos.UnsafeB(xid))
+}
+
+func (p *proxy) rollbackCanary(w http.ResponseWriter, r *http.Request, canary *etl.Canary) {
+	if err := p._modCanary(canary, true /*del*/); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	if err := p._stopETL(apc.URLPathETL.Join(canary.ETL, apc.ETLStop)); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	nlog.Infoln(p.String()+":", "rolled back", canary.String())
+}
+
+// add/update or delete canary (in the latter case, along with its ETL)
+func (p *proxy) _modCanary(canary *etl.Canary, del bool) error {
+	ctx := &etlMDModifier{
+		pre:    _setCanaryPre,
+		final:  p._syncEtlMDFinal,
+		canary: canary,
+		wait:   true,
+	}
+	if del {
+		ctx.pre = _delCanaryPre
+	}
+	_, err := p.owner.etl.modify(ctx)
+	return err
+}
+
+func _setCanaryPre(ctx *etlMDModifier, clone *etlMD) error {
+	if clone.get(ctx.canary.Name) == nil {
+		return cos.NewErrNotFound(nil, "etl job "+ctx.canary.Name)
+	}
+	clone.setCanary(ctx.canary)
+	return nil
+}
+
+func _delCanaryPre(ctx *etlMDModifier, clone *etlMD) error {
+	if clone.delCanary(ctx.canary.Name) == nil {
+		return cos.NewErrNotFound(nil, ctx.canary.String())
+	}
+	clone.del(ctx.canary.ETL)
+	return nil
+}
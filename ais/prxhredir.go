@@ -0,0 +1,305 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Health-based GET redirects (config.Proxy.HealthRedirect):
+// - each proxy periodically polls all targets for their state flags and max disk utilization;
+// - a target is considered degraded when it is in red-alert state (cos.NodeStateFlags.IsRed)
+//   or when its disks are saturated (utilization >= config.Disk.DiskUtilMaxWM);
+// - hysteresis: a degraded target stays degraded for at least hredirMinDwell and until its
+//   utilization drops below config.Disk.DiskUtilHighWM;
+// - the only cross-target copies of an object are EC replicas, which is why only GETs
+//   from EC buckets get redirected away from a degraded target: to the next (healthy) target
+//   in the object's HRW order, with the QparamHealthRedirect query;
+// - the target serves its local replica if it has one; otherwise (e.g., the object is
+//   erasure-coded rather than replicated), it redirects the request back to the owner
+//   (see t.redirBack);
+// - stats.GetRedirAwayCount counts GETs redirected away.
+//
+// Fallback HRW map (same config knob):
+// - a target that fails to respond to the periodic poll (cos.IsUnreachable) is considered down
+//   until it responds again or gets removed from the cluster map (see keepalive);
+// - the proxy then maintains a fallback map: the current cluster map minus targets that are down;
+//   the map is derived lazily and re-derived upon any change of the cluster map or of the down set;
+// - GETs of the objects owned by a down target are routed via the fallback map - by the HRW
+//   property, to the next target in the object's (full) HRW order - with the QparamFallbackRedirect query;
+// - correctness: only ais:// buckets (no backend to cold-GET from) with erasure coding, as per
+//   the current BMD (same bucket ID), and only if the number of down targets does not exceed
+//   the bucket's parity slices - otherwise, GETs go to the owner as usual;
+// - the selected target serves its local replica or restores the object from EC slices
+//   (see t.fallbackGet); n-way mirroring is local to a target and does not help here;
+// - stats.GetRedirFallbackCount counts GETs routed via the fallback map.
+
+const (
+	hredirIval     = 10 * time.Second
+	hredirMinDwell = 30 * time.Second
+)
+
+type (
+	// node_load (apc.WhatNodeLoad)
+	nodeLoad struct {
+		Flags    cos.NodeStateFlags `json:"flags"`
+		DiskUtil int64              `json:"disk_util"` // max across mountpaths
+	}
+	hredir struct {
+		p        *proxy
+		degraded map[string]int64 // tid => when became degraded (mono)
+		down     map[string]int64 // tid => when became unreachable (mono)
+		fb       *hrfb            // fallback map (nil: to be derived)
+		cnt      atomic.Int32     // len(degraded) (fast path)
+		ndown    atomic.Int32     // len(down) (ditto)
+		mu       sync.RWMutex
+	}
+	// fallback HRW map: excludes targets that are down
+	hrfb struct {
+		smap *meta.Smap // (only Tmap, and Version of the cluster map it was derived from)
+	}
+)
+
+func (hr *hredir) init(p *proxy) {
+	hr.p = p
+	hr.degraded = make(map[string]int64, 4)
+	hr.down = make(map[string]int64, 2)
+	hk.Reg("health-redirect"+hk.NameSuffix, hr.housekeep, hredirIval)
+}
+
+func (hr *hredir) isDegraded(tid string) (yes bool) {
+	if hr.cnt.Load() == 0 {
+		return false
+	}
+	hr.mu.RLock()
+	_, yes = hr.degraded[tid]
+	hr.mu.RUnlock()
+	return yes
+}
+
+func (hr *hredir) isDown(tid string) (yes bool) {
+	if hr.ndown.Load() == 0 {
+		return false
+	}
+	hr.mu.RLock()
+	_, yes = hr.down[tid]
+	hr.mu.RUnlock()
+	return yes
+}
+
+func (hr *hredir) housekeep() time.Duration {
+	var (
+		p      = hr.p
+		config = cmn.GCO.Get()
+	)
+	if !config.Proxy.HealthRedirect || !p.ClusterStarted() {
+		hr.reset()
+		return hredirIval
+	}
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathDae.S,
+		Query:  url.Values{apc.QparamWhat: []string{apc.WhatNodeLoad}},
+	}
+	args.to = core.Targets
+	args.cresv = cresNL{} // -> nodeLoad
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	now := mono.NanoTime()
+	hr.mu.Lock()
+	for _, res := range results {
+		if res.err != nil {
+			if cos.IsUnreachable(res.err, res.status) {
+				hr.markDown(res.si, res.err, now)
+			}
+			continue
+		}
+		hr.markUp(res.si)
+		hr.update(res.si, res.v.(*nodeLoad), &config.Disk, now)
+	}
+	// remove those that are no longer present
+	smap := p.owner.smap.get()
+	for tid := range hr.degraded {
+		if smap.GetTarget(tid) == nil {
+			delete(hr.degraded, tid)
+		}
+	}
+	for tid := range hr.down {
+		if smap.GetTarget(tid) == nil {
+			delete(hr.down, tid)
+			hr.fb = nil
+		}
+	}
+	hr.cnt.Store(int32(len(hr.degraded)))
+	hr.ndown.Store(int32(len(hr.down)))
+	hr.mu.Unlock()
+	freeBcastRes(results)
+	return hredirIval
+}
+
+// under lock
+func (hr *hredir) update(tsi *meta.Snode, load *nodeLoad, dconf *cmn.DiskConf, now int64) {
+	since, degraded := hr.degraded[tsi.ID()]
+	switch {
+	case !degraded && (load.Flags.IsRed() || load.DiskUtil >= dconf.DiskUtilMaxWM):
+		hr.degraded[tsi.ID()] = now
+		nlog.Warningln(hr.p.String()+":", tsi.StringEx(), "is degraded [", load.Flags.String(),
+			"disk util", load.DiskUtil, "%] - redirecting GETs to replicas, if any")
+	case degraded && !load.Flags.IsRed() && load.DiskUtil < dconf.DiskUtilHighWM:
+		if time.Duration(now-since) < hredirMinDwell {
+			return
+		}
+		delete(hr.degraded, tsi.ID())
+		nlog.Infoln(hr.p.String()+":", tsi.StringEx(), "is no longer degraded [ disk util", load.DiskUtil, "%]")
+	}
+}
+
+// under lock
+func (hr *hredir) markDown(tsi *meta.Snode, err error, now int64) {
+	if _, ok := hr.down[tsi.ID()]; ok {
+		return
+	}
+	hr.down[tsi.ID()] = now
+	hr.fb = nil
+	nlog.Warningln(hr.p.String()+":", tsi.StringEx(), "is unreachable - routing GETs via fallback map [ err:", err, "]")
+}
+
+// under lock
+func (hr *hredir) markUp(tsi *meta.Snode) {
+	since, ok := hr.down[tsi.ID()]
+	if !ok {
+		return
+	}
+	delete(hr.down, tsi.ID())
+	hr.fb = nil
+	nlog.Infoln(hr.p.String()+":", tsi.StringEx(), "is reachable again after", mono.Since(since))
+}
+
+func (hr *hredir) reset() {
+	if hr.cnt.Load() == 0 && hr.ndown.Load() == 0 {
+		return
+	}
+	hr.mu.Lock()
+	clear(hr.degraded)
+	clear(hr.down)
+	hr.fb = nil
+	hr.cnt.Store(0)
+	hr.ndown.Store(0)
+	hr.mu.Unlock()
+}
+
+// select the next healthy target in the object's HRW order that may store its replica
+// (see ec.putJogger: full replicas are stored on the first ParitySlices+1 HRW targets)
+func (hr *hredir) alt(smap *smapX, bck *meta.Bck, objName string, owner *meta.Snode) *meta.Snode {
+	ecconf := &bck.Props.EC
+	if !ecconf.Enabled || ecconf.ParitySlices < 1 {
+		return nil
+	}
+	uname := bck.MakeUname(objName)
+	sis, err := smap.HrwTargetList(cos.UnsafeSptr(uname), ecconf.ParitySlices+1)
+	if err != nil {
+		return nil
+	}
+	for _, tsi := range sis {
+		if tsi.ID() != owner.ID() && !hr.isDegraded(tsi.ID()) {
+			return tsi
+		}
+	}
+	return nil
+}
+
+// fallback map for the given cluster map: derive (or re-derive) if need be;
+// returns the map and the number of down targets it excludes
+func (hr *hredir) fbmap(smap *smapX) (*meta.Smap, int) {
+	hr.mu.RLock()
+	fb, ndown := hr.fb, len(hr.down)
+	hr.mu.RUnlock()
+	if fb != nil && fb.smap.Version == smap.Version {
+		return fb.smap, ndown
+	}
+
+	hr.mu.Lock()
+	defer hr.mu.Unlock()
+	if hr.fb != nil && hr.fb.smap.Version == smap.Version {
+		return hr.fb.smap, len(hr.down)
+	}
+	fb = &hrfb{smap: &meta.Smap{Tmap: make(meta.NodeMap, len(smap.Tmap)), Version: smap.Version}}
+	ndown = 0
+	for tid, tsi := range smap.Tmap {
+		if _, down := hr.down[tid]; down {
+			ndown++
+			continue
+		}
+		fb.smap.Tmap[tid] = tsi
+	}
+	hr.fb = fb
+	return fb.smap, ndown
+}
+
+// select the owner's successor in the object's HRW order - via the fallback map and
+// subject to the bucket's (current) EC configuration
+func (hr *hredir) fallback(smap *smapX, bmd *bucketMD, bck *meta.Bck, objName string) *meta.Snode {
+	if !bck.IsAIS() {
+		return nil // (remote buckets: the owner cold-GETs)
+	}
+	bprops, present := bmd.Get(bck)
+	if !present || bprops.BID != bck.Props.BID {
+		return nil
+	}
+	ecconf := &bprops.EC
+	if !ecconf.Enabled || ecconf.ParitySlices < 1 {
+		return nil
+	}
+	fbsmap, ndown := hr.fbmap(smap)
+	if ndown == 0 || ndown > ecconf.ParitySlices || fbsmap.CountActiveTs() < ecconf.RequiredRestoreTargets() {
+		return nil
+	}
+	tsi, err := fbsmap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		return nil
+	}
+	return tsi
+}
+
+// GET redirect URL: owner, or (when the owner is degraded) one of the replica targets,
+// or (when the owner is down) the owner's successor in the fallback map
+func (p *proxy) getRedirectURL(r *http.Request, smap *smapX, bck *meta.Bck, objName string,
+	started time.Time) (string, *meta.Snode, error) {
+	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
+	if err != nil {
+		return "", nil, err
+	}
+	if p.hredir.isDown(tsi.ID()) {
+		if alt := p.hredir.fallback(smap, p.owner.bmd.get(), bck, objName); alt != nil {
+			p.statsT.Inc(stats.GetRedirFallbackCount)
+			redirectURL := p.redirectURL(r, alt, started, cmn.NetIntraData)
+			return redirectURL + "&" + apc.QparamFallbackRedirect + "=" + tsi.ID(), alt, nil
+		}
+	}
+	if p.hredir.isDegraded(tsi.ID()) {
+		if alt := p.hredir.alt(smap, bck, objName, tsi); alt != nil {
+			p.statsT.Inc(stats.GetRedirAwayCount)
+			redirectURL := p.redirectURL(r, alt, started, cmn.NetIntraData)
+			return redirectURL + "&" + apc.QparamHealthRedirect + "=" + tsi.ID(), alt, nil
+		}
+	}
+	return p.redirectURL(r, tsi, started, cmn.NetIntraData, netPub), tsi, nil
+}
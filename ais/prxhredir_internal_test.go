@@ -0,0 +1,128 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+func TestHealthRedirectHysteresis(t *testing.T) {
+	var (
+		p     = &proxy{}
+		hr    = &hredir{p: p, degraded: make(map[string]int64)}
+		tsi   = &meta.Snode{}
+		dconf = &cmn.DiskConf{DiskUtilLowWM: 20, DiskUtilHighWM: 80, DiskUtilMaxWM: 95}
+		now   int64
+	)
+	p.si = &meta.Snode{}
+	p.si.Init("p1", apc.Proxy)
+	tsi.Init("t1", apc.Target)
+
+	step := func(load *nodeLoad, d time.Duration, expected bool) {
+		t.Helper()
+		now += int64(d)
+		hr.update(tsi, load, dconf, now)
+		if _, degraded := hr.degraded[tsi.ID()]; degraded != expected {
+			t.Fatalf("%+v: expected degraded=%t", load, expected)
+		}
+	}
+
+	step(&nodeLoad{DiskUtil: 90}, 0, false)
+	step(&nodeLoad{DiskUtil: 95}, hredirIval, true)
+	step(&nodeLoad{DiskUtil: 85}, hredirIval, true)                        // above high wm
+	step(&nodeLoad{DiskUtil: 10}, hredirIval, true)                        // min dwell
+	step(&nodeLoad{DiskUtil: 10}, hredirMinDwell, false)                   // recovered
+	step(&nodeLoad{Flags: cos.DiskFault, DiskUtil: 10}, time.Second, true) // red alert
+	step(&nodeLoad{DiskUtil: 10}, hredirMinDwell, false)
+}
+
+func TestHealthRedirectFallback(t *testing.T) {
+	var (
+		p     = &proxy{}
+		hr    = &hredir{p: p, degraded: make(map[string]int64), down: make(map[string]int64)}
+		smap  = newSmap()
+		bmd   = newBucketMD()
+		ecbck = meta.NewBck("ec", apc.AIS, cmn.NsGlobal)
+		plain = meta.NewBck("plain", apc.AIS, cmn.NsGlobal)
+	)
+	p.si = &meta.Snode{}
+	p.si.Init("p1", apc.Proxy)
+	for _, tid := range []string{"t1", "t2", "t3", "t4", "t5"} {
+		smap.Tmap[tid] = newSnode(tid, apc.Target, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{})
+	}
+	smap.Version = 10
+	bmd.add(ecbck, &cmn.Bprops{EC: cmn.ECConf{Enabled: true, DataSlices: 2, ParitySlices: 1}})
+	bmd.add(plain, &cmn.Bprops{})
+
+	// nothing's down
+	if tsi := hr.fallback(smap, bmd, ecbck, "obj"); tsi != nil {
+		t.Fatalf("unexpected fallback %s", tsi)
+	}
+
+	for i := range 100 {
+		objName := "obj-" + strconv.Itoa(i)
+		owner, err := smap.HrwName2T(ecbck.MakeUname(objName))
+		if err != nil {
+			t.Fatal(err)
+		}
+		hr.down = map[string]int64{owner.ID(): 1}
+		hr.fb = nil
+
+		// must be the owner's successor in the object's HRW order
+		sis, err := smap.HrwTargetList(cos.UnsafeSptr(ecbck.MakeUname(objName)), 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tsi := hr.fallback(smap, bmd, ecbck, objName)
+		if tsi == nil || tsi.ID() != sis[1].ID() {
+			t.Fatalf("%s: expected fallback %s, got %v", objName, sis[1], tsi)
+		}
+
+		// not erasure coded
+		if tsi := hr.fallback(smap, bmd, plain, objName); tsi != nil {
+			t.Fatalf("%s: unexpected fallback %s for %s", objName, tsi, plain)
+		}
+	}
+
+	// more targets down than parity slices
+	hr.down = map[string]int64{"t1": 1, "t2": 1}
+	hr.fb = nil
+	if tsi := hr.fallback(smap, bmd, ecbck, "obj"); tsi != nil {
+		t.Fatalf("unexpected fallback %s with %d targets down", tsi, len(hr.down))
+	}
+
+	// re-derived upon cluster map change
+	hr.down = map[string]int64{"t1": 1}
+	hr.fb = nil
+	fbsmap, ndown := hr.fbmap(smap)
+	if ndown != 1 || fbsmap.CountTargets() != 4 || fbsmap.GetTarget("t1") != nil {
+		t.Fatalf("unexpected fallback map %s (down %d)", fbsmap, ndown)
+	}
+	clone := newSmap()
+	for tid, tsi := range smap.Tmap {
+		if tid != "t5" {
+			clone.Tmap[tid] = tsi
+		}
+	}
+	clone.Version = smap.Version + 1
+	if fbsmap, _ = hr.fbmap(clone); fbsmap.CountTargets() != 3 || fbsmap.Version != clone.Version {
+		t.Fatalf("expected fallback map to be re-derived, got %s", fbsmap)
+	}
+
+	// bucket re-created (BID mismatch)
+	stale := meta.NewBck("ec", apc.AIS, cmn.NsGlobal)
+	stale.Props = ecbck.Props.Clone()
+	stale.Props.BID++
+	if tsi := hr.fallback(smap, bmd, stale, "obj"); tsi != nil {
+		t.Fatalf("unexpected fallback %s for stale bucket", tsi)
+	}
+}
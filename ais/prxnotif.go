@@ -381,6 +381,32 @@ func (n *notifs) housekeep() time.Duration {
 	return hk.PruneActiveIval
 }
 
+// remove finished listeners (all, of a given kind, or a given one by ID) that finished
+// at least xargs.OlderThan ago (see apc.ActXactGC)
+func (n *notifs) gc(xargs *xact.ArgsMsg) {
+	var (
+		cnt int
+		now = time.Now().UnixNano()
+	)
+	n.fin.Lock()
+	for uuid, nl := range n.fin.m {
+		if xargs.ID != "" && uuid != xargs.ID {
+			continue
+		}
+		if xargs.Kind != "" && nl.Kind() != xargs.Kind {
+			continue
+		}
+		if time.Duration(now-nl.EndTime()) >= xargs.OlderThan {
+			n.fin.del(nl, true /*locked*/)
+			cnt++
+		}
+	}
+	n.fin.Unlock()
+	if cnt > 0 {
+		nlog.Infoln(n.p.String(), apc.ActXactGC, xargs.ID, xargs.Kind, "removed:", cnt)
+	}
+}
+
 // conditional: query targets iff they delayed updating
 func (n *notifs) bcastGetStats(nl nl.Listener, dur time.Duration) {
 	var (
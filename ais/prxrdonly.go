@@ -0,0 +1,84 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Cluster-wide read-only mode (config.Proxy.ReadOnly), e.g.:
+// `ais cluster set-state read-only` (and later, `ais cluster set-state normal`)
+// - rejects all user requests that modify data or bucket metadata: object PUT/DELETE/PATCH,
+//   bucket PUT/DELETE/PATCH, S3 writes, creating and deleting ETLs, and starting new download and dsort jobs;
+// - bucket and object POST requests are decided by action (apc.Act*): actions that only read
+//   (e.g., EC verify, dry-run copy) are served, all others are rejected;
+// - dry-run requests (apc.QparamDryRun), e.g. set bucket props and ETL init, are served as well;
+// - responds with 503 (Service Unavailable) and "Retry-After" header;
+// - GET, HEAD, and list requests continue to be served;
+// - cluster administration (configuration, nodes, xactions) remains available.
+
+const readOnlyRetryAfter = "60" // seconds
+
+// POST actions that do not modify data or bucket metadata
+var readOnlyActs = cos.NewStrSet(
+	apc.ActECVerify,
+	apc.ActInvalListCache,
+)
+
+func errReadOnly(p *proxy, r *http.Request) error {
+	return fmt.Errorf("%s: cluster is in read-only mode, cannot execute %s %s", p, r.Method, r.URL.Path)
+}
+
+// returns true if the request was rejected
+func (p *proxy) rejectReadOnly(w http.ResponseWriter, r *http.Request) bool {
+	if !cmn.GCO.Get().Proxy.ReadOnly || isDryRun(r) {
+		return false
+	}
+	w.Header().Set(cos.HdrRetryAfter, readOnlyRetryAfter)
+	p.writeErr(w, r, errReadOnly(p, r), http.StatusServiceUnavailable, Silent)
+	return true
+}
+
+// POST { action }: returns true if the request was rejected
+func (p *proxy) rejectReadOnlyAct(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) bool {
+	if readOnlyAct(msg) {
+		return false
+	}
+	return p.rejectReadOnly(w, r)
+}
+
+// validate and report without making any changes
+func isDryRun(r *http.Request) bool { return cos.IsParseBool(r.URL.Query().Get(apc.QparamDryRun)) }
+
+func readOnlyAct(msg *apc.ActMsg) bool {
+	if readOnlyActs.Contains(msg.Action) {
+		return true
+	}
+	// (compare with _bckpost)
+	var tcbmsg apc.TCBMsg
+	switch msg.Action {
+	case apc.ActCopyBck:
+		return cos.MorphMarshal(msg.Value, &tcbmsg.CopyBckMsg) == nil && tcbmsg.DryRun
+	case apc.ActETLBck:
+		return cos.MorphMarshal(msg.Value, &tcbmsg) == nil && tcbmsg.DryRun
+	default:
+		return false
+	}
+}
+
+func (p *proxy) rejectReadOnlyS3(w http.ResponseWriter, r *http.Request) bool {
+	if !cmn.GCO.Get().Proxy.ReadOnly {
+		return false
+	}
+	w.Header().Set(cos.HdrRetryAfter, readOnlyRetryAfter)
+	s3.WriteErr(w, r, errReadOnly(p, r), http.StatusServiceUnavailable)
+	return true
+}
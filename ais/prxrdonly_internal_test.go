@@ -0,0 +1,58 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestReadOnlyActs(t *testing.T) {
+	tests := []struct {
+		msg      *apc.ActMsg
+		readOnly bool
+	}{
+		{&apc.ActMsg{Action: apc.ActECVerify}, true},
+		{&apc.ActMsg{Action: apc.ActInvalListCache}, true},
+		{&apc.ActMsg{Action: apc.ActCopyBck, Value: &apc.CopyBckMsg{DryRun: true}}, true},
+		{&apc.ActMsg{Action: apc.ActETLBck, Value: map[string]any{"id": "md5", "dry_run": true}}, true},
+		{&apc.ActMsg{Action: apc.ActCopyBck, Value: &apc.CopyBckMsg{}}, false},
+		{&apc.ActMsg{Action: apc.ActETLBck, Value: &apc.TCBMsg{Transform: apc.Transform{Name: "md5"}}}, false},
+		{&apc.ActMsg{Action: apc.ActRenameObject}, false},
+		{&apc.ActMsg{Action: apc.ActPromote}, false},
+		{&apc.ActMsg{Action: apc.ActBlobDl}, false},
+		{&apc.ActMsg{Action: apc.ActMoveBck}, false},
+		{&apc.ActMsg{Action: apc.ActECEncode}, false},
+		{&apc.ActMsg{Action: apc.ActMakeNCopies}, false},
+		{&apc.ActMsg{Action: apc.ActWarmUp}, false},
+		{&apc.ActMsg{Action: apc.ActPrefetchObjects}, false},
+		{&apc.ActMsg{}, false},
+	}
+	for _, test := range tests {
+		tassert.Errorf(t, readOnlyAct(test.msg) == test.readOnly, "%q (%+v): expecting read-only=%t",
+			test.msg.Action, test.msg.Value, test.readOnly)
+	}
+}
+
+func TestReadOnlyDryRun(t *testing.T) {
+	tests := []struct {
+		method, url string
+		dryRun      bool
+	}{
+		{http.MethodPatch, "/v1/buckets/abc?dry_run=true", true}, // set bucket props
+		{http.MethodPut, "/v1/etl?dry_run=true", true},           // ETL init
+		{http.MethodPatch, "/v1/buckets/abc?dry_run=false", false},
+		{http.MethodPatch, "/v1/buckets/abc", false},
+		{http.MethodPut, "/v1/etl", false},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(test.method, test.url, http.NoBody)
+		tassert.Errorf(t, isDryRun(r) == test.dryRun, "%s %s: expecting dry-run=%t", test.method, test.url, test.dryRun)
+	}
+}
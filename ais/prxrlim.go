@@ -0,0 +1,167 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// Per-role rate limits and concurrency caps (authn.Role.QPS and MaxConc), e.g.:
+// `ais auth update role batch-jobs --qps 100 --max-conc 16`
+// - limits come with the (validated) access token - AuthN puts them there at login time;
+// - enforced by each gateway independently: with 3 gateways, qps=100 allows up to 300 req/s cluster-wide;
+// - apply to the data path (buckets, objects, S3 API, etc.) but not to cluster and node management;
+// - a request counts against each of the user's limited roles; exceeding any one of them results in 429
+//   (that native API clients retry with increasing delays);
+// - QPS: token bucket refilled continuously, with a burst of at most one second worth of requests;
+// - concurrency: requests that are being handled by the gateway; GET and PUT get redirected
+//   to targets, and so only the redirect counts;
+// - current usage: apc.WhatRoleUsage and `ais auth show role --usage`.
+
+type (
+	roleLimiter struct {
+		roles map[string]*rlim
+		mu    sync.Mutex
+	}
+	rlim struct {
+		authn.RoleUsage
+		avail     float64 // requests (QPS token bucket)
+		last      int64   // mono time of the last refill
+		sec       int64   // current second (mono)
+		cur, prev int64   // requests in the current and previous seconds
+	}
+)
+
+func (p *proxy) rlimHandler(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !cmn.Rom.AuthEnabled() {
+			h(w, r)
+			return
+		}
+		token, err := tok.ExtractToken(r.Header)
+		if err != nil {
+			h(w, r) // (access control will take care of it)
+			return
+		}
+		tk, err := p.authn.validateToken(token)
+		if err != nil || len(tk.Limits) == 0 || tk.IsAdmin {
+			h(w, r)
+			return
+		}
+		if err := p.rlim.acquire(tk.Limits, mono.NanoTime()); err != nil {
+			p.writeErr(w, r, err, http.StatusTooManyRequests, Silent)
+			return
+		}
+		defer p.rlim.release(tk.Limits)
+		h(w, r)
+	}
+}
+
+// all or nothing
+func (rl *roleLimiter) acquire(limits []*authn.RoleLimit, now int64) error {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	if rl.roles == nil {
+		rl.roles = make(map[string]*rlim, 4)
+	}
+	for _, limit := range limits {
+		l := rl.get(limit, now)
+		if err := l.check(now); err != nil {
+			l.Rejected++
+			return err
+		}
+	}
+	for _, limit := range limits {
+		l := rl.roles[limit.Role]
+		if l.QPS > 0 {
+			l.avail--
+		}
+		l.InFlight++
+		l.Requests++
+		l.cur++
+	}
+	return nil
+}
+
+func (rl *roleLimiter) release(limits []*authn.RoleLimit) {
+	rl.mu.Lock()
+	for _, limit := range limits {
+		if l, ok := rl.roles[limit.Role]; ok {
+			l.InFlight--
+		}
+	}
+	rl.mu.Unlock()
+}
+
+// under lock; limits are updated whenever a newer token says so
+func (rl *roleLimiter) get(limit *authn.RoleLimit, now int64) *rlim {
+	l, ok := rl.roles[limit.Role]
+	if !ok {
+		l = &rlim{last: now, avail: float64(limit.QPS)}
+		l.RoleLimit = *limit
+		rl.roles[limit.Role] = l
+		return l
+	}
+	if l.QPS != limit.QPS {
+		l.avail = min(l.avail, float64(limit.QPS))
+	}
+	l.QPS, l.MaxConc = limit.QPS, limit.MaxConc
+	return l
+}
+
+func (rl *roleLimiter) usage(now int64) []*authn.RoleUsage {
+	rl.mu.Lock()
+	out := make([]*authn.RoleUsage, 0, len(rl.roles))
+	for _, l := range rl.roles {
+		l.tick(now)
+		u := l.RoleUsage
+		u.Rate = l.prev
+		out = append(out, &u)
+	}
+	rl.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool { return out[i].Role < out[j].Role })
+	return out
+}
+
+//////////
+// rlim //
+//////////
+
+func (l *rlim) check(now int64) error {
+	l.tick(now)
+	if l.MaxConc > 0 && l.InFlight >= int64(l.MaxConc) {
+		return fmt.Errorf("role %q: too many concurrent requests (max %d)", l.Role, l.MaxConc)
+	}
+	if l.QPS > 0 {
+		l.avail = min(l.avail+float64(now-l.last)*float64(l.QPS)/float64(time.Second), float64(l.QPS))
+		l.last = now
+		if l.avail < 1 {
+			return fmt.Errorf("role %q: request rate exceeded (max %d/s)", l.Role, l.QPS)
+		}
+	}
+	return nil
+}
+
+// count requests per second
+func (l *rlim) tick(now int64) {
+	sec := now / int64(time.Second)
+	switch {
+	case sec == l.sec:
+	case sec == l.sec+1:
+		l.prev, l.cur = l.cur, 0
+	default:
+		l.prev, l.cur = 0, 0
+	}
+	l.sec = sec
+}
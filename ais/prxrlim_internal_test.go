@@ -0,0 +1,62 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestRoleLimiter(t *testing.T) {
+	var (
+		rl    roleLimiter
+		now   = int64(time.Hour)
+		batch = &authn.RoleLimit{Role: "batch", QPS: 10}
+		etl   = &authn.RoleLimit{Role: "etl", MaxConc: 2}
+		both  = []*authn.RoleLimit{batch, etl}
+	)
+	// QPS: burst of (at most) one second worth of requests
+	for i := range 10 {
+		tassert.Fatalf(t, rl.acquire([]*authn.RoleLimit{batch}, now) == nil, "request %d: expecting success", i)
+		rl.release([]*authn.RoleLimit{batch})
+	}
+	tassert.Errorf(t, rl.acquire([]*authn.RoleLimit{batch}, now) != nil, "expecting rate exceeded")
+
+	// refill: 10/s => one request per 100ms
+	now += int64(100 * time.Millisecond)
+	tassert.Fatalf(t, rl.acquire([]*authn.RoleLimit{batch}, now) == nil, "expecting success after refill")
+	rl.release([]*authn.RoleLimit{batch})
+
+	// concurrency
+	tassert.Fatalf(t, rl.acquire([]*authn.RoleLimit{etl}, now) == nil, "expecting success")
+	tassert.Fatalf(t, rl.acquire([]*authn.RoleLimit{etl}, now) == nil, "expecting success")
+	tassert.Errorf(t, rl.acquire([]*authn.RoleLimit{etl}, now) != nil, "expecting too many concurrent")
+
+	// all or nothing: "etl" is at its max - "batch" must not be charged
+	now += int64(time.Second)
+	for range 10 {
+		tassert.Errorf(t, rl.acquire(both, now) != nil, "expecting too many concurrent")
+	}
+	rl.release([]*authn.RoleLimit{etl})
+	tassert.Errorf(t, rl.acquire(both, now) == nil, "expecting success")
+
+	// updated limit (newer token)
+	rl.release(both)
+	tassert.Errorf(t, rl.acquire([]*authn.RoleLimit{{Role: "etl", MaxConc: 1}}, now) != nil, "expecting new limit")
+
+	usage := rl.usage(now)
+	tassert.Fatalf(t, len(usage) == 2 && usage[0].Role == "batch" && usage[1].Role == "etl", "unexpected usage: %+v", usage)
+	b, e := usage[0], usage[1]
+	tassert.Errorf(t, b.Requests == 12 && b.Rejected == 1 && b.InFlight == 0, "unexpected batch usage: %+v", b)
+	tassert.Errorf(t, e.Requests == 3 && e.Rejected == 12 && e.InFlight == 1 && e.MaxConc == 1, "unexpected etl usage: %+v", e)
+
+	// requests during the previous (full) second
+	tassert.Errorf(t, b.Rate == 11 && e.Rate == 2, "expecting rates 11 and 2, got %d, %d", b.Rate, e.Rate)
+	usage = rl.usage(now + int64(time.Second))
+	tassert.Errorf(t, usage[0].Rate == 1 && usage[1].Rate == 1, "expecting rate 1, got %d, %d", usage[0].Rate, usage[1].Rate)
+}
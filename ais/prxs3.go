@@ -44,6 +44,9 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead && p.rejectReadOnlyS3(w, r) {
+		return
+	}
 
 	switch r.Method {
 	case http.MethodHead:
@@ -0,0 +1,177 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Bucket snapshots - proxy side (for the target side and limitations, see tgtsnap.go)
+// - create, destroy: broadcast to all targets;
+// - restore: two phases - begin (validate) and commit;
+// - list: merge per-target snapshot infos.
+
+// POST /v1/buckets/bucket-name {apc.ActCreateSnap | apc.ActRestoreSnap | apc.ActDestroySnap}
+func (p *proxy) snapact(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *apc.ActMsg) {
+	if err := p.validateSnap(bck, msg); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	perms := apc.AcePATCH
+	if msg.Action == apc.ActRestoreSnap {
+		perms = apc.AcePUT | apc.AceObjDELETE
+	}
+	if err := p.checkAccess(w, r, bck, perms); err != nil {
+		return
+	}
+	// serialize via primary
+	if p.forwardCP(w, r, msg, bck.Cname("")) {
+		return
+	}
+	nlp := newBckNLP(bck)
+	if !nlp.TryLock(cmn.Rom.CplaneOperation() / 2) {
+		p.writeErr(w, r, cmn.NewErrBusy("bucket", bck.Cname("")))
+		return
+	}
+	defer nlp.Unlock()
+
+	if msg.Action == apc.ActRestoreSnap {
+		if err := p.bcastSnap(bck, msg, apc.ActBegin); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		msg.Value = apc.ActCommit
+	}
+	if err := p.bcastSnap(bck, msg, ""); err != nil {
+		if herr := cmn.Err2HTTPErr(err); msg.Action == apc.ActCreateSnap && (herr == nil || herr.Status != http.StatusConflict) {
+			// cleanup (best effort)
+			p.bcastSnap(bck, &apc.ActMsg{Action: apc.ActDestroySnap, Name: msg.Name}, "")
+		}
+		p.writeErr(w, r, err)
+		return
+	}
+	if msg.Action == apc.ActRestoreSnap {
+		p.qm.c.invalidate(bck.Bucket())
+	}
+	nlog.Infoln(msg.Action, bck.Cname(""), "snapshot:", msg.Name)
+}
+
+func (*proxy) validateSnap(bck *meta.Bck, msg *apc.ActMsg) error {
+	if !bck.IsAIS() {
+		return fmt.Errorf("%s: bucket snapshots are supported only for ais:// buckets (%s is not)", msg.Action, bck.Cname(""))
+	}
+	if bck.Props.EC.Enabled {
+		return fmt.Errorf("%s: not supported for erasure-coded buckets (%s)", msg.Action, bck.Cname(""))
+	}
+	return apc.ValidateSnapName(msg.Name)
+}
+
+func (p *proxy) bcastSnap(bck *meta.Bck, msg *apc.ActMsg, phase string) error {
+	if phase != "" {
+		msg.Value = phase
+	}
+	var (
+		smap = p.owner.smap.get()
+		args = allocBcArgs()
+	)
+	args.req = cmn.HreqArgs{
+		Method: http.MethodPost,
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Query:  bck.NewQuery(),
+		Body:   cos.MustMarshal(p.newAmsg(msg, nil)),
+	}
+	args.smap = smap
+	args.timeout = apc.LongTimeout
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	var (
+		err      error
+		notFound int
+	)
+	for _, res := range results {
+		if res.err == nil {
+			continue
+		}
+		// destroy: ok as long as at least one target had it
+		if msg.Action == apc.ActDestroySnap && res.status == http.StatusNotFound {
+			notFound++
+			continue
+		}
+		err = res.toErr()
+		break
+	}
+	if err == nil && notFound == len(results) && notFound > 0 {
+		err = cos.NewErrNotFound(p, "snapshot "+bck.Cname(msg.Name))
+	}
+	freeBcastRes(results)
+	return err
+}
+
+// GET /v1/buckets/bucket-name {apc.ActListSnaps}
+func (p *proxy) listSnaps(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *apc.ActMsg) {
+	if !bck.IsAIS() {
+		p.writeErrf(w, r, "%s: bucket snapshots are supported only for ais:// buckets (%s is not)", msg.Action, bck.Cname(""))
+		return
+	}
+	var (
+		smap = p.owner.smap.get()
+		args = allocBcArgs()
+	)
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathBuckets.Join(bck.Name),
+		Query:  bck.NewQuery(),
+		Body:   cos.MustMarshal(p.newAmsg(msg, nil)),
+	}
+	args.smap = smap
+	args.timeout = apc.DefaultTimeout
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	all := make(map[string]*apc.SnapInfo, 4)
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			p.writeErr(w, r, err)
+			return
+		}
+		var snaps []*apc.SnapInfo
+		if err := jsoniter.Unmarshal(res.bytes, &snaps); err != nil {
+			freeBcastRes(results)
+			p.writeErrf(w, r, cmn.FmtErrUnmarshal, p, "snapshots", cos.BHead(res.bytes), err)
+			return
+		}
+		for _, snap := range snaps {
+			merged, ok := all[snap.Name]
+			if !ok {
+				all[snap.Name] = snap
+				continue
+			}
+			merged.ObjCount += snap.ObjCount
+			merged.Size += snap.Size
+			merged.NumTargets += snap.NumTargets
+			merged.Created = min(merged.Created, snap.Created)
+		}
+	}
+	freeBcastRes(results)
+
+	out := make([]*apc.SnapInfo, 0, len(all))
+	for _, snap := range all {
+		out = append(out, snap)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Created < out[j].Created })
+	p.writeJSON(w, r, out, msg.Action)
+}
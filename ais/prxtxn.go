@@ -381,20 +381,10 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 	case apc.ActSetBprops:
 		// do nothing here (caller's responsible for validation)
 	case apc.ActResetBprops:
-		bargs := bckPropsArgs{bck: bck}
-		if bck.IsRemote() {
-			if backend := bck.Backend(); backend != nil {
-				err := fmt.Errorf("%q has backend %q (hint: detach prior to resetting the props)",
-					bck, backend)
-				return "", err
-			}
-			remoteBckProps, _, err := p.headRemoteBck(bck.Bucket(), nil)
-			if err != nil {
-				return "", err
-			}
-			bargs.hdr = remoteBckProps
+		var err error
+		if nprops, err = p.resetBprops(bck); err != nil {
+			return "", err
 		}
-		nprops = defaultBckProps(bargs)
 	default:
 		return "", fmt.Errorf(fmtErrInvaldAction, msg.Action, []string{apc.ActSetBprops, apc.ActResetBprops})
 	}
@@ -446,6 +436,47 @@ func (p *proxy) setBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (s
 	return xid, rerr
 }
 
+func (p *proxy) resetBprops(bck *meta.Bck) (*cmn.Bprops, error) {
+	bargs := bckPropsArgs{bck: bck}
+	if bck.IsRemote() {
+		if backend := bck.Backend(); backend != nil {
+			err := fmt.Errorf("%q has backend %q (hint: detach prior to resetting the props)",
+				bck, backend)
+			return nil, err
+		}
+		remoteBckProps, _, err := p.headRemoteBck(bck.Bucket(), nil)
+		if err != nil {
+			return nil, err
+		}
+		bargs.hdr = remoteBckProps
+	}
+	return defaultBckProps(bargs), nil
+}
+
+// set-bucket-props and reset-bucket-props with apc.QparamDryRun: compute and return
+// the resulting props and the xactions that would run - no BMD changes, no txn
+// (compare w/ setBprops and bmodSetProps)
+func (p *proxy) dryrunBprops(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bprops) (*cmn.BpropsDryRun, error) {
+	bprops, present := p.owner.bmd.get().Get(bck)
+	if !present {
+		return nil, cmn.NewErrBckNotFound(bck.Bucket())
+	}
+	if msg.Action == apc.ActResetBprops {
+		var err error
+		if nprops, err = p.resetBprops(bck); err != nil {
+			return nil, err
+		}
+	}
+	res := &cmn.BpropsDryRun{Props: nprops, Diff: bprops.Diff(nprops)}
+	if _reMirror(bprops, nprops) {
+		res.Xactions = append(res.Xactions, apc.ActMakeNCopies)
+	}
+	if _, reec := _needReEC(bprops, nprops, p.owner.smap.get()); reec {
+		res.Xactions = append(res.Xactions, apc.ActECEncode)
+	}
+	return res, nil
+}
+
 // compare w/ bmodUpdateProps
 func (p *proxy) bmodSetProps(ctx *bmdModifier, clone *bucketMD) (err error) {
 	var (
@@ -800,6 +831,9 @@ func (p *proxy) validateECConf(bck *meta.Bck, confToSet *cmn.ECConfToSet, currCo
 	if confToSet.ObjSizeLimit != nil {
 		newConf.ObjSizeLimit = *confToSet.ObjSizeLimit
 	}
+	if confToSet.MinSliceSize != nil {
+		newConf.MinSliceSize = *confToSet.MinSliceSize
+	}
 
 	if currConf.Enabled {
 		err := fmt.Errorf("%s: EC is already enabled on the bucket %s", p, bck.Cname(""))
@@ -928,7 +962,7 @@ func (p *proxy) destroyBucketData(msg *apc.ActMsg, bck *meta.Bck) error {
 // promote synchronously if the number of files (to promote) is less or equal
 const promoteNumSync = 16
 
-func (p *proxy) promote(bck *meta.Bck, msg *apc.ActMsg, tsi *meta.Snode) (xid string, err error) {
+func (p *proxy) promote(bck *meta.Bck, msg *apc.ActMsg, args *apc.PromoteArgs, tsi *meta.Snode) (xid string, err error) {
 	var (
 		totalN           int64
 		waitmsync        bool
@@ -953,7 +987,7 @@ func (p *proxy) promote(bck *meta.Bck, msg *apc.ActMsg, tsi *meta.Snode) (xid st
 		// confirm file share when, and only if, all targets see identical content
 		// (so that they go ahead and partition the work accordingly)
 		c.req.Query.Set(apc.QparamConfirmFshare, "true")
-	} else if totalN <= promoteNumSync {
+	} else if totalN <= promoteNumSync && !args.Xact {
 		// targets to operate autonomously and synchronously
 		c.req.Query.Set(apc.QparamActNoXact, "true")
 		noXact = true
@@ -1127,7 +1161,7 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BpropsToSet, c
 	}
 	// cannot have re-mirroring and erasure coding on the same bucket at the same time
 	remirror := _reMirror(bprops, nprops)
-	targetCnt, reec := _reEC(bprops, nprops, bck, p.owner.smap.get())
+	targetCnt, reec := _needReEC(bprops, nprops, p.owner.smap.get())
 	if len(creating) == 0 && remirror && reec {
 		err = cmn.NewErrBusy("bucket", bck.Cname(""))
 		return
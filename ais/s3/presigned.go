@@ -144,7 +144,11 @@ func (resp *PresignedResp) ObjAttrs() (oa *cmn.ObjAttrs) {
 	etag := cmn.UnquoteCEV(resp.Header.Get(cos.HdrETag))
 	debug.Assert(etag != "")
 	oa.SetCustomKey(cmn.ETag, etag)
-	if !cmn.IsS3MultipartEtag(etag) {
+	sse := SSEStatus(resp.Header.Get(cos.S3HdrSSE), resp.Header.Get(cos.S3HdrSSECAlgorithm))
+	if sse != "" {
+		oa.SetCustomKey(cmn.SSEObjMD, sse)
+	}
+	if !cmn.IsS3MultipartEtag(etag) && ETagIsMD5(sse) {
 		oa.SetCustomKey(cmn.MD5ObjMD, etag)
 	}
 	if sz := resp.Header.Get(cos.HdrContentLength); sz != "" {
@@ -0,0 +1,51 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Server-side encryption (SSE) of S3-backed buckets:
+// - SSE-KMS: bucket property `extra.aws.sse_kms_key_id` - applies to PUT, write-back, and multipart upload;
+// - SSE-C: customer-provided key that must accompany each request - forwarded as is (presigned
+//   pass-through) or via the SDK (see SSEC);
+// - encryption status of the remote object is stored in its custom metadata (cmn.SSEObjMD).
+
+const (
+	SSEKMS      = "aws:kms"
+	SSES3       = "AES256"
+	SSECustomer = "SSE-C"
+)
+
+// customer-provided key (SSE-C)
+type SSEC struct {
+	Algorithm string
+	Key       string // base64-encoded
+	KeyMD5    string
+}
+
+// returns nil if the (original) request carries no SSE-C headers
+func SSECFromHeader(header http.Header) *SSEC {
+	alg := header.Get(cos.S3HdrSSECAlgorithm)
+	if alg == "" {
+		return nil
+	}
+	return &SSEC{Algorithm: alg, Key: header.Get(cos.S3HdrSSECKey), KeyMD5: header.Get(cos.S3HdrSSECKeyMD5)}
+}
+
+// SSEStatus returns the value to store as cmn.SSEObjMD (empty: not encrypted or unknown)
+func SSEStatus(sse, customerAlgorithm string) string {
+	if customerAlgorithm != "" {
+		return SSECustomer
+	}
+	return sse
+}
+
+// from https://docs.aws.amazon.com/AmazonS3/latest/API/API_Object.html:
+// "Objects encrypted by SSE-C or SSE-KMS have ETags that are not an MD5 digest of their object data."
+func ETagIsMD5(status string) bool { return status == "" || status == SSES3 }
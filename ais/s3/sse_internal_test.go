@@ -0,0 +1,46 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestSSE(t *testing.T) {
+	header := http.Header{}
+	if ssec := SSECFromHeader(header); ssec != nil {
+		t.Fatalf("expected no SSE-C, got %+v", ssec)
+	}
+	header.Set(cos.S3HdrSSECAlgorithm, "AES256")
+	header.Set(cos.S3HdrSSECKey, "a2V5")
+	header.Set(cos.S3HdrSSECKeyMD5, "bWQ1")
+	ssec := SSECFromHeader(header)
+	if ssec == nil || ssec.Algorithm != "AES256" || ssec.Key != "a2V5" || ssec.KeyMD5 != "bWQ1" {
+		t.Fatalf("unexpected SSE-C %+v", ssec)
+	}
+
+	tests := []struct {
+		sse, calg, status string
+		md5               bool
+	}{
+		{"", "", "", true},
+		{SSES3, "", SSES3, true},
+		{SSEKMS, "", SSEKMS, false},
+		{"aws:kms:dsse", "", "aws:kms:dsse", false},
+		{"", "AES256", SSECustomer, false},
+	}
+	for _, test := range tests {
+		status := SSEStatus(test.sse, test.calg)
+		if status != test.status {
+			t.Errorf("SSEStatus(%q, %q): expected %q, got %q", test.sse, test.calg, test.status, status)
+		}
+		if ETagIsMD5(status) != test.md5 {
+			t.Errorf("ETagIsMD5(%q): expected %t", status, test.md5)
+		}
+	}
+}
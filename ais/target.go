@@ -40,6 +40,7 @@ import (
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/health"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/reb"
@@ -72,6 +73,9 @@ type (
 		res          *res.Res
 		transactions transactions
 		regstate     regstate
+		quotas       bquotas
+		patches      patchq
+		st           selftest
 	}
 )
 
@@ -324,12 +328,15 @@ func (t *target) Run() error {
 	// register object type and workfile type
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+	fs.CSM.Reg(fs.ArchIdxType, &fs.ArchIdxContentResolver{})
+	fs.CSM.Reg(fs.SnapType, &fs.SnapContentResolver{})
 
 	// Init meta-owners and load local instances
 	if prev := t.owner.bmd.init(); prev {
 		t.regstate.prevbmd.Store(true)
 	}
 	t.owner.etl.init()
+	etl.AbortClusterWide = t.abortETLClu
 
 	smap, reliable := t.loadSmap()
 	if !reliable {
@@ -385,11 +392,18 @@ func (t *target) Run() error {
 
 	// register storage target's handler(s) and start listening
 	t.initRecvHandlers()
+	t.initSelftest()
 
 	ec.Init()
 	mirror.Init()
 
-	xreg.RegWithHK()
+	xreg.RegWithHK(t.statsT)
+
+	fs.InitCapSnaps(config)
+	hk.Reg("cap-snap"+hk.NameSuffix, t.capSnap, time.Minute)
+	hk.Reg("defrag"+hk.NameSuffix, t.defragHK, defragCheckIval)
+	t.patches.init(t, config)
+	hk.Reg("patch-cksum"+hk.NameSuffix, t.patches.housekeep, patchCksumIval)
 
 	marked := xreg.GetResilverMarked()
 	if marked.Interrupted || daemon.resilver.required {
@@ -490,7 +504,7 @@ func (t *target) initRecvHandlers() {
 		{r: apc.Txn, h: t.txnHandler, net: accessNetIntraControl},
 		{r: apc.ObjStream, h: transport.RxAnyStream, net: accessControlData},
 
-		{r: apc.Download, h: t.downloadHandler, net: accessNetIntraControl},
+		{r: apc.Download, h: t.downloadHandler, net: accessControlData},
 		{r: apc.Sort, h: dsort.TargetHandler, net: accessControlData},
 		{r: apc.ETL, h: t.etlHandler, net: accessNetAll},
 
@@ -533,7 +547,7 @@ func (red *redial) acked() bool {
 	}
 	for _, addr := range addrs {
 		for elapsed := time.Duration(0); elapsed < red.totalTout; elapsed += sleep {
-			_, err = net.DialTimeout("tcp4", addr, max(2*time.Second, red.dialTout))
+			_, err = net.DialTimeout("tcp", addr, max(2*time.Second, red.dialTout))
 			if err != nil {
 				break
 			}
@@ -726,6 +740,37 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		return lom, err
 	}
 
+	// conditional GET
+	if cond, err := cmn.ParseCond(r.Header); err != nil {
+		return lom, err
+	} else if cond != nil {
+		ecode, err := evalCond(lom, cond, true /*read*/, false /*locked*/)
+		switch {
+		case err != nil:
+			if dpq.isS3 {
+				s3.WriteErr(w, r, err, ecode)
+			} else {
+				t.writeErr(w, r, err, ecode)
+			}
+			return lom, nil
+		case ecode == http.StatusNotModified:
+			cmn.ToHeader(lom.ObjAttrs(), w.Header(), 0 /*skip setting content-length*/)
+			w.WriteHeader(http.StatusNotModified)
+			return lom, nil
+		}
+	}
+
+	// GET redirected away from a degraded target (see prxhredir.go)
+	if dpq.hredir != "" && t.redirBack(w, r, dpq, lom) {
+		return lom, nil
+	}
+	// GET routed around an unreachable target (ditto)
+	if dpq.fbredir != "" {
+		if err := t.fallbackGet(dpq, lom); err != nil {
+			return lom, err
+		}
+	}
+
 	// GET: regular | archive | range
 	goi := allocGOI()
 	{
@@ -734,6 +779,7 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		if dpq.ptime != "" {
 			if d := ptLatency(goi.atime, dpq.ptime, r.Header.Get(apc.HdrCallerIsPrimary)); d > 0 {
 				t.statsT.Add(stats.GetRedirLatency, d)
+				goi.rdtime = d
 			}
 		}
 		goi.t = t
@@ -744,6 +790,7 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.ctx = context.Background()
 		goi.ranges = byteRanges{Range: r.Header.Get(cos.HdrRange), Size: 0}
 		goi.latestVer = _validateWarmGet(goi.lom, dpq.latestVer) // apc.QparamLatestVer || versioning.*_warm_get
+		goi.span = t.startSpan(r, dpq, cmn.GCO.Get(), "ais.target.get")
 	}
 	if dpq.isArch() {
 		if goi.ranges.Range != "" {
@@ -766,7 +813,9 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 	}
 
 	// do
-	if ecode, err := goi.getObject(); err != nil {
+	ecode, err := goi.getObject()
+	goi.endSpan(err)
+	if err != nil {
 		t.statsT.IncErr(stats.ErrGetCount)
 		if goi.isIOErr {
 			t.statsT.IncErr(stats.IOErrGetCount)
@@ -806,7 +855,7 @@ func (t *target) _erris(w http.ResponseWriter, r *http.Request, err error, code
 }
 
 // PUT /v1/objects/bucket-name/object-name; does:
-// 1) append object 2) append to archive 3) PUT
+// 1) append object 2) append to archive 3) partial update (patch) 4) PUT
 func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRequest, lom *core.LOM) {
 	var (
 		config  = cmn.GCO.Get()
@@ -829,6 +878,12 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 	}
+	if !t2tput {
+		if err := cs.ErrReserved(config.Space.ReservedPct); err != nil {
+			t.writeErr(w, r, err, http.StatusInsufficientStorage)
+			return
+		}
+	}
 
 	// init
 	if err := lom.InitBck(apireq.bck.Bucket()); err != nil {
@@ -842,16 +897,48 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		}
 	}
 
+	// bucket quota (user writes only)
+	osize := int64(-1)
+	if !t2tput {
+		var err error
+		qwrite, size := qwritePut, r.ContentLength
+		switch {
+		case apireq.dpq.apnd.ty != "" || apireq.dpq.arch.path != "":
+			qwrite = qwriteApnd
+		case apireq.dpq.patch.off != "":
+			// (invalid offset gets rejected below - see patchOI.parse)
+			if off, errV := strconv.ParseInt(apireq.dpq.patch.off, 10, 64); errV == nil {
+				qwrite, size = qwriteRange, off+max(size, 0)
+			}
+		}
+		if osize, err = t.checkQuota(lom, qwrite, size); err != nil {
+			t.writeErr(w, r, err, http.StatusInsufficientStorage)
+			return
+		}
+	}
+
 	// load (maybe)
 	skipVC := lom.IsFeatureSet(feat.SkipVC) || apireq.dpq.skipVC
 	if !skipVC {
 		_ = lom.Load(true, false)
 	}
 
+	// conditional PUT (and see poi.cond below)
+	cond, err := cmn.ParseCond(r.Header)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if cond != nil {
+		if ecode, err := evalCond(lom, cond, false /*read*/, false /*locked*/); err != nil {
+			t.writeErr(w, r, err, ecode)
+			return
+		}
+	}
+
 	// do
 	var (
 		handle string
-		err    error
 		ecode  int
 	)
 	switch {
@@ -864,6 +951,9 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		lom.Lock(true)
 		ecode, err = t.putApndArch(r, lom, started, apireq.dpq)
 		lom.Unlock(true)
+		if err == nil {
+			t.addQuotaLoad(lom, osize)
+		}
 	case apireq.dpq.apnd.ty != "": // apc.QparamAppendType
 		a := &apndOI{
 			started: started,
@@ -878,11 +968,30 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 		handle, ecode, err = a.do(r)
-		if err == nil && handle != "" {
-			w.Header().Set(apc.HdrAppendHandle, handle)
-			return
+		if err == nil {
+			if handle != "" {
+				w.Header().Set(apc.HdrAppendHandle, handle)
+				return
+			}
+			if a.final != nil { // flushed
+				t.addQuotaLoad(lom, osize)
+				w.Header().Set(apc.HdrObjCksumType, a.final.Ty())
+				w.Header().Set(apc.HdrObjCksumVal, a.final.Val())
+				return
+			}
 		}
 		t.statsT.IncErr(stats.ErrAppendCount)
+	case apireq.dpq.patch.off != "": // apc.QparamPatchOffset
+		pa := &patchOI{t: t, lom: lom, r: r.Body, started: started}
+		if err := pa.parse(apireq.dpq, r.ContentLength); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		var cksum *cos.Cksum
+		if cksum, ecode, err = pa.do(); err == nil && !cksum.IsEmpty() {
+			w.Header().Set(apc.HdrObjCksumType, cksum.Ty())
+			w.Header().Set(apc.HdrObjCksumVal, cksum.Val())
+		}
 	default:
 		poi := allocPOI()
 		{
@@ -890,6 +999,7 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			if apireq.dpq.ptime != "" {
 				if d := ptLatency(poi.atime, apireq.dpq.ptime, r.Header.Get(apc.HdrCallerIsPrimary)); d > 0 {
 					t.statsT.Add(stats.PutRedirLatency, d)
+					poi.rdtime = d
 				}
 			}
 			poi.t = t
@@ -898,9 +1008,15 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			poi.skipVC = skipVC // feat.SkipVC || apc.QparamSkipVC
 			poi.restful = true
 			poi.t2t = t2tput
+			poi.cond = cond
 		}
+		span := t.startSpan(r, apireq.dpq, config, "ais.target.put")
 		ecode, err = poi.do(w.Header(), r, apireq.dpq)
+		poi.endSpan(span, err)
 		freePOI(poi)
+		if err == nil {
+			t.addQuota(lom, osize, lom.Lsize())
+		}
 	}
 	if err != nil {
 		t.FSHC(err, lom.Mountpath(), "") // TODO -- FIXME: removed from the place where happened, fqn missing...
@@ -927,6 +1043,11 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 	}
 
 	evict := msg.Action == apc.ActEvictObjects
+	cond, err := cmn.ParseCond(r.Header)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
 	lom := core.AllocLOM(objName)
 	if err := lom.InitBck(apireq.bck.Bucket()); err != nil {
 		t.writeErr(w, r, err)
@@ -934,7 +1055,7 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		return
 	}
 
-	ecode, err := t.DeleteObject(lom, evict)
+	ecode, err := t.delObject(lom, evict, cond)
 	if err == nil && ecode == 0 {
 		// EC cleanup if EC is enabled
 		ec.ECM.CleanupObject(lom)
@@ -954,7 +1075,7 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 	if err != nil {
 		return
 	}
-	if msg.Action == apc.ActBlobDl {
+	if msg.Action == apc.ActBlobDl || msg.Action == apc.ActECVerify {
 		apireq.after = 1
 	}
 	if t.parseReq(w, r, apireq) != nil {
@@ -971,7 +1092,7 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 		if err = lom.InitBck(apireq.bck.Bucket()); err != nil {
 			break
 		}
-		if err = t.objMv(lom, msg); err == nil {
+		if err = t.objMv(lom, msg.Name); err == nil {
 			t.statsT.Inc(stats.RenameCount)
 			core.FreeLOM(lom)
 			lom = nil
@@ -1002,6 +1123,17 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 			w.Write([]byte(xid))
 			// lom is eventually freed by x-blob
 		}
+	case apc.ActECVerify:
+		lom = core.AllocLOM(msg.Name)
+		if err = lom.InitBck(apireq.bck.Bucket()); err != nil {
+			break
+		}
+		var res *ec.VerifyResult
+		if res, err = ec.VerifyObject(lom); err == nil {
+			t.writeJSON(w, r, res, apc.ActECVerify)
+			core.FreeLOM(lom)
+			lom = nil
+		}
 	default:
 		t.writeErrAct(w, r, msg.Action)
 		return
@@ -1235,12 +1367,32 @@ func (t *target) httpecget(w http.ResponseWriter, r *http.Request) {
 		lom := core.AllocLOM(apireq.items[2])
 		t.sendECCT(w, r, apireq.bck, lom)
 		core.FreeLOM(lom)
+	case ec.URLVerify:
+		t.verifyECCT(w, r, apireq.bck, apireq.items[2])
 	default:
 		t.writeErrURL(w, r)
 	}
 	apiReqFree(apireq)
 }
 
+// Checksums local CT (see ec.VerifyObject).
+func (t *target) verifyECCT(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	if err := bck.Init(t.owner.bmd); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	var generation int64
+	if s := r.URL.Query().Get(apc.QparamECGen); s != "" {
+		var err error
+		if generation, err = strconv.ParseInt(s, 10, 64); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+	}
+	st := ec.VerifyCT(bck, objName, generation)
+	t.writeJSON(w, r, st, "ec-verify-ct")
+}
+
 // Returns a CT's metadata.
 func (t *target) sendECMetafile(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
 	if err := bck.Init(t.owner.bmd); err != nil {
@@ -1327,13 +1479,14 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 		if !os.IsNotExist(err) {
 			return http.StatusInternalServerError, err
 		}
-		if flags == apc.ArchAppend {
+		if flags&apc.ArchAppend != 0 {
 			return http.StatusNotFound, err
 		}
 		a.put = true
 	} else {
-		a.put = (flags == 0)
+		a.put = flags&(apc.ArchAppend|apc.ArchAppendIfExist) == 0
 	}
+	a.mkidx = flags&apc.ArchCreateIndex != 0
 	if s := r.Header.Get(cos.HdrContentLength); s != "" {
 		if size, err := strconv.ParseInt(s, 10, 64); err == nil {
 			a.size = size
@@ -1346,9 +1499,19 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 	return a.do()
 }
 
-func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
+func (t *target) DeleteObject(lom *core.LOM, evict bool) (int, error) {
+	return t.delObject(lom, evict, nil)
+}
+
+func (t *target) delObject(lom *core.LOM, evict bool, cond *cmn.Cond) (code int, err error) {
 	var isback bool
 	lom.Lock(true)
+	if cond != nil {
+		if code, err = evalCond(lom, cond, false /*read*/, true /*locked*/); err != nil {
+			lom.Unlock(true)
+			return code, err
+		}
+	}
 	code, err, isback = t.delobj(lom, evict)
 	lom.Unlock(true)
 
@@ -1425,14 +1588,16 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 }
 
 // rename obj
-func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) (err error) {
+// rename = copy + remove the source; failure to remove the source rolls back the copy
+// (so that the object ends up having either the old name or the new one - not both)
+func (t *target) objMv(lom *core.LOM, objNameTo string) (err error) {
 	if lom.Bck().IsRemote() {
 		return fmt.Errorf("%s: cannot rename object %s from remote bucket", t.si, lom)
 	}
 	if lom.ECEnabled() {
 		return fmt.Errorf("%s: cannot rename erasure-coded object %s", t.si, lom)
 	}
-	if msg.Name == lom.ObjName {
+	if objNameTo == lom.ObjName {
 		return fmt.Errorf("%s: cannot rename/move object %s onto itself", t.si, lom)
 	}
 
@@ -1440,7 +1605,7 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) (err error) {
 	coiParams := core.AllocCOI()
 	{
 		coiParams.BckTo = lom.Bck()
-		coiParams.ObjnameTo = msg.Name /* new object name */
+		coiParams.ObjnameTo = objNameTo
 		coiParams.Buf = buf
 		coiParams.Config = cmn.GCO.Get()
 		coiParams.OWT = cmn.OwtCopy
@@ -1456,11 +1621,54 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) (err error) {
 
 	// TODO: combine copy+delete under a single write lock
 	lom.Lock(true)
-	if err := lom.RemoveObj(); err != nil {
-		nlog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
-	}
+	err = lom.RemoveObj()
 	lom.Unlock(true)
-	return nil
+	if err == nil || cos.IsNotExist(err, 0) {
+		return nil
+	}
+	if errV := t.rmRenamed(lom.Bck(), objNameTo); errV != nil {
+		nlog.Errorf("%s: failed to roll back renaming %s => %s: %v", t, lom, objNameTo, errV)
+	}
+	return fmt.Errorf("%s: failed to rename %s => %s (rolled back): %v", t, lom, objNameTo, err)
+}
+
+// remove the new copy (rollback)
+func (t *target) rmRenamed(bck *meta.Bck, objName string) error {
+	smap := t.owner.smap.get()
+	tsi, err := smap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		return err
+	}
+	if tsi.ID() == t.SID() {
+		dst := core.AllocLOM(objName)
+		if err = dst.InitBck(bck.Bucket()); err == nil {
+			dst.Lock(true)
+			err = dst.RemoveObj()
+			dst.Unlock(true)
+		}
+		core.FreeLOM(dst)
+		return err
+	}
+	// intra-cluster DELETE (see httpobjdelete and isRedirect)
+	q := bck.NewQuery()
+	q.Set(apc.QparamProxyID, t.SID())
+	q.Set(apc.QparamUnixTime, cos.UnixNano2S(time.Now().UnixNano()))
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodDelete,
+			Base:   tsi.URL(cmn.NetIntraControl),
+			Path:   apc.URLPathObjects.Join(bck.Name, objName),
+			Query:  q,
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+	}
+	res := t.call(cargs, smap)
+	err = res.err
+	freeCargs(cargs)
+	freeCR(res)
+	return err
 }
 
 // compare running the same via (generic) t.xstart
@@ -6,7 +6,9 @@ package integration_test
 
 import (
 	"archive/tar"
+	"bytes"
 	"fmt"
+	"io"
 	"math/rand/v2"
 	"net/url"
 	"os"
@@ -611,3 +613,66 @@ func TestAppendToArch(t *testing.T) {
 		})
 	}
 }
+
+//
+// GET batch
+//
+
+func TestGetBatchArchive(t *testing.T) {
+	var (
+		bck = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		m   = ioContext{
+			t:       t,
+			bck:     bck,
+			num:     200,
+			prefix:  "batch/",
+			ordered: true,
+		}
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+	)
+	if testing.Short() {
+		m.num = 50
+	}
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+	m.init(true /*cleanup*/)
+	m.puts()
+
+	t.Run("prefix", func(t *testing.T) {
+		names := getBatchNames(t, baseParams, bck, &apc.GetBatchMsg{Prefix: m.prefix})
+		tassert.Errorf(t, len(names) == m.num, "expected %d, have %d", m.num, len(names))
+		for _, name := range m.objNames {
+			tassert.Errorf(t, names.Contains(name), "%q is missing", name)
+		}
+	})
+	t.Run("list", func(t *testing.T) {
+		list := make([]string, 0, m.num/2+1)
+		list = append(list, m.objNames[:m.num/2]...)
+		list = append(list, m.prefix+"does-not-exist")
+
+		_, err := api.GetBatchArchive(baseParams, bck, &apc.GetBatchMsg{ObjNames: list}, io.Discard)
+		tassert.Errorf(t, err != nil, "expected to fail (missing object)")
+
+		names := getBatchNames(t, baseParams, bck, &apc.GetBatchMsg{ObjNames: list, ContinueOnError: true})
+		tassert.Errorf(t, len(names) == len(list)-1, "expected %d, have %d", len(list)-1, len(names))
+	})
+}
+
+func getBatchNames(t *testing.T, bp api.BaseParams, bck cmn.Bck, msg *apc.GetBatchMsg) cos.StrSet {
+	var buf bytes.Buffer
+	n, err := api.GetBatchArchive(bp, bck, msg, &buf)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, n == int64(buf.Len()), "expected %d bytes, have %d", n, buf.Len())
+
+	names := cos.NewStrSet()
+	tr := tar.NewReader(&buf)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		tassert.CheckFatal(t, err)
+		names.Add(hdr.Name)
+	}
+	return names
+}
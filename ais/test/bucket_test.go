@@ -3494,3 +3494,65 @@ func TestListObjectsNoRecursion(t *testing.T) {
 		tassert.Errorf(t, false, "[%s] Invalid number of objects %d (expected %d)", tst.prefix, len(lst.Entries), tst.count)
 	}
 }
+
+func TestBucketSnapshot(t *testing.T) {
+	const (
+		objCnt   = 20
+		snapName = "before"
+	)
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: t.Name(), Provider: apc.AIS}
+		cksumType  = bck.DefaultProps(initialClusterConfig).Cksum.Type
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	objNames, _, err := tools.PutRandObjs(tools.PutObjectsArgs{
+		ProxyURL:  proxyURL,
+		Bck:       bck,
+		ObjPath:   "snap/",
+		ObjCnt:    objCnt,
+		ObjSize:   cos.KiB,
+		FixedSize: true,
+		CksumType: cksumType,
+	})
+	tassert.CheckFatal(t, err)
+	sort.Strings(objNames)
+
+	tassert.CheckFatal(t, api.CreateSnapshot(baseParams, bck, snapName))
+	err = api.CreateSnapshot(baseParams, bck, snapName)
+	tassert.Errorf(t, err != nil, "expected error creating duplicate snapshot %q", snapName)
+
+	snaps, err := api.ListSnapshots(baseParams, bck)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(snaps) == 1 && snaps[0].Name == snapName, "expected snapshot %q, got %+v", snapName, snaps)
+	tassert.Errorf(t, snaps[0].ObjCount == objCnt && snaps[0].Size == objCnt*cos.KiB,
+		"expected %d objects (%d bytes), got %d (%d)", objCnt, objCnt*cos.KiB, snaps[0].ObjCount, snaps[0].Size)
+
+	// overwrite, delete, and add
+	reader, _ := readers.NewRand(2*cos.KiB, cksumType)
+	_, err = api.PutObject(&api.PutArgs{BaseParams: baseParams, Bck: bck, ObjName: objNames[0], Reader: reader})
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, api.DeleteObject(baseParams, bck, objNames[1]))
+	reader, _ = readers.NewRand(cos.KiB, cksumType)
+	_, err = api.PutObject(&api.PutArgs{BaseParams: baseParams, Bck: bck, ObjName: "snap/new", Reader: reader})
+	tassert.CheckFatal(t, err)
+
+	tassert.CheckFatal(t, api.RestoreSnapshot(baseParams, bck, snapName))
+
+	lst, err := api.ListObjects(baseParams, bck, &apc.LsoMsg{Prefix: "snap/"}, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == objCnt, "expected %d objects after restore, got %d", objCnt, len(lst.Entries))
+	for _, en := range lst.Entries {
+		tassert.Errorf(t, en.Name != "snap/new", "%q was created after the snapshot and must be removed", en.Name)
+		tassert.Errorf(t, en.Size == cos.KiB, "%q: expected size %d, got %d", en.Name, cos.KiB, en.Size)
+	}
+	_, err = api.GetObjectWithValidation(baseParams, bck, objNames[1], nil)
+	tassert.CheckError(t, err)
+
+	tassert.CheckFatal(t, api.DestroySnapshot(baseParams, bck, snapName))
+	snaps, err = api.ListSnapshots(baseParams, bck)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(snaps) == 0, "expected no snapshots, got %+v", snaps)
+}
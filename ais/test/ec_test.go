@@ -1031,6 +1031,113 @@ func TestECChecksum(t *testing.T) {
 	tassert.Fatalf(t, err != nil, "Object should not be restored when checksums are wrong")
 }
 
+func TestECVerifyObject(t *testing.T) {
+	if docker.IsRunning() {
+		t.Skipf("test %q requires direct access to mountpaths, doesn't work with docker", t.Name())
+	}
+
+	var (
+		proxyURL = tools.RandomProxyURL()
+		bck      = cmn.Bck{
+			Name:     testBucketName + "-ec-verify",
+			Provider: apc.AIS,
+		}
+	)
+
+	o := ecOptions{
+		minTargets:   4,
+		dataCnt:      1,
+		parityCnt:    1,
+		pattern:      "obj-verify-%04d",
+		objSizeLimit: ecObjLimit,
+	}.init(t, proxyURL)
+	baseParams := tools.BaseAPIParams(proxyURL)
+	initMountpaths(t, proxyURL)
+
+	newLocalBckWithProps(t, baseParams, bck, defaultECBckProps(o), o)
+
+	objName := fmt.Sprintf(o.pattern, 1)
+	objPath := ecTestDir + objName
+	foundParts, mainObjPath := createECFile(t, baseParams, bck, objName, o)
+
+	res, err := api.ECVerifyObject(baseParams, bck, objPath)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, res.OK(), "expected %s to be intact: %+v", objPath, res)
+
+	// corrupt one slice
+	for fqn := range foundParts {
+		ct, err := core.NewCTFromFQN(fqn, nil)
+		tassert.CheckFatal(t, err)
+		if fqn == mainObjPath || ct.ContentType() != fs.ECSliceType {
+			continue
+		}
+		tlog.Logf("Corrupting slice %s\n", fqn)
+		fh, err := os.OpenFile(fqn, os.O_WRONLY, 0)
+		tassert.CheckFatal(t, err)
+		_, err = fh.WriteAt([]byte("corrupted"), 0)
+		fh.Close()
+		tassert.CheckFatal(t, err)
+		break
+	}
+
+	res, err = api.ECVerifyObject(baseParams, bck, objPath)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, !res.OK(), "expected %s to be corrupted", objPath)
+	var corrupted int
+	for _, st := range res.CTs {
+		if st.Status == ec.CTcorrupt {
+			tassert.Errorf(t, st.SliceID > 0, "expected corrupted slice, got %+v", st)
+			corrupted++
+		}
+	}
+	tassert.Errorf(t, corrupted == 1, "expected exactly one corrupted slice, got %d", corrupted)
+	tassert.Errorf(t, res.Parity == ec.ParitySkipped, "expected parity check to be skipped, got %q", res.Parity)
+}
+
+// EC verify only reads data and must keep working when the cluster is read-only
+func TestECVerifyObjectReadOnly(t *testing.T) {
+	if docker.IsRunning() {
+		t.Skipf("test %q requires direct access to mountpaths, doesn't work with docker", t.Name())
+	}
+
+	var (
+		proxyURL = tools.RandomProxyURL()
+		bck      = cmn.Bck{
+			Name:     testBucketName + "-ec-verify-rdonly",
+			Provider: apc.AIS,
+		}
+	)
+
+	o := ecOptions{
+		minTargets:   4,
+		dataCnt:      1,
+		parityCnt:    1,
+		pattern:      "obj-verify-rdonly-%04d",
+		objSizeLimit: ecObjLimit,
+	}.init(t, proxyURL)
+	baseParams := tools.BaseAPIParams(proxyURL)
+	initMountpaths(t, proxyURL)
+
+	newLocalBckWithProps(t, baseParams, bck, defaultECBckProps(o), o)
+
+	objName := fmt.Sprintf(o.pattern, 1)
+	objPath := ecTestDir + objName
+	createECFile(t, baseParams, bck, objName, o)
+
+	tassert.CheckFatal(t, api.SetClusterReadOnly(baseParams, true))
+	t.Cleanup(func() {
+		tassert.CheckError(t, api.SetClusterReadOnly(baseParams, false))
+	})
+
+	res, err := api.ECVerifyObject(baseParams, bck, objPath)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, res.OK(), "expected %s to be intact: %+v", objPath, res)
+
+	// POST actions that modify data are still rejected
+	err = api.RenameObject(baseParams, bck, objPath, objPath+".renamed")
+	tassert.Fatalf(t, cmn.IsStatusServiceUnavailable(err), "expected rename to fail with 503, got %v", err)
+}
+
 func TestECEnabledDisabledEnabled(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 
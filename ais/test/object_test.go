@@ -301,6 +301,50 @@ func TestAppendObject(t *testing.T) {
 	}
 }
 
+// append via api.Appender; validate the entire concatenation using a checksum type
+// other than the bucket-configured one
+func TestAppender(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		parts      = []string{"1111111111", "222222222222222", "333333333"}
+		content    = strings.Join(parts, "")
+		expected   = cos.NewCksumHash(cos.ChecksumSHA256)
+	)
+	tools.CreateBucket(t, proxyURL, bck,
+		&cmn.BpropsToSet{Cksum: &cmn.CksumConfToSet{Type: apc.Ptr(cos.ChecksumXXHash)}},
+		true, /*cleanup*/
+	)
+	_, err := expected.H.Write([]byte(content))
+	tassert.CheckFatal(t, err)
+	expected.Finalize()
+
+	// 1. checksum mismatch
+	a := api.NewAppender(baseParams, bck, "obj-bad")
+	for _, part := range parts {
+		tassert.CheckFatal(t, a.Append(cos.NewByteHandle([]byte(part)), int64(len(part))))
+	}
+	_, err = a.Flush(cos.NewCksum(cos.ChecksumSHA256, strings.Repeat("0", len(expected.Val()))))
+	tassert.Fatalf(t, err != nil, "expected checksum mismatch")
+
+	// 2. success
+	a = api.NewAppender(baseParams, bck, "obj-good")
+	for _, part := range parts {
+		tassert.CheckFatal(t, a.Append(cos.NewByteHandle([]byte(part)), int64(len(part))))
+	}
+	tassert.Errorf(t, a.Size() == int64(len(content)), "expected size %d, got %d", len(content), a.Size())
+	cksum, err := a.Flush(expected.Clone())
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, cksum != nil && cksum.Ty() == cos.ChecksumXXHash, "expected %s checksum, got %s", cos.ChecksumXXHash, cksum)
+
+	writer := bytes.NewBuffer(nil)
+	oah, err := api.GetObjectWithValidation(baseParams, bck, "obj-good", &api.GetArgs{Writer: writer})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, writer.String() == content, "invalid object content: %q, expected: %q", writer.String(), content)
+	tassert.Errorf(t, oah.Attrs().Cksum.Equal(cksum), "checksum mismatch: %s vs %s", oah.Attrs().Cksum, cksum)
+}
+
 func TestSameBucketName(t *testing.T) {
 	var (
 		proxyURL   = tools.RandomProxyURL(t)
@@ -1628,6 +1672,47 @@ func TestPutObjectWithChecksum(t *testing.T) {
 	}
 }
 
+func TestGetObjectReaderWithAttrs(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		objName    = "reader-with-attrs"
+		objData    = []byte("I am object data that's being read via io.Reader")
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	putArgs := api.PutArgs{
+		BaseParams: baseParams,
+		Bck:        bck,
+		ObjName:    objName,
+		Reader:     readers.NewBytes(objData),
+	}
+	poah, err := api.PutObject(&putArgs)
+	tassert.CheckFatal(t, err)
+
+	r, oah, err := api.GetObjectReaderWithAttrs(baseParams, bck, objName, nil)
+	tassert.CheckFatal(t, err)
+	data, err := io.ReadAll(r)
+	r.Close()
+	tassert.CheckFatal(t, err)
+
+	tassert.Fatalf(t, bytes.Equal(data, objData), "read %q, expected %q", data, objData)
+	tassert.Errorf(t, oah.Size() == int64(len(objData)), "size %d, expected %d", oah.Size(), len(objData))
+	var (
+		attrs  = oah.Attrs()
+		pattrs = poah.Attrs()
+	)
+	tassert.Errorf(t, attrs.CheckEq(&pattrs) == nil, "GET(obj) attrs %s != %s PUT\n", attrs.String(), pattrs.String())
+	tassert.Errorf(t, attrs.Atime != 0, "expecting atime in GET response")
+
+	// not found
+	_, _, err = api.GetObjectReaderWithAttrs(baseParams, bck, objName+"-nonexistent", nil)
+	if err == nil || !strings.Contains(err.Error(), strconv.Itoa(http.StatusNotFound)) {
+		t.Errorf("expecting not-found error, got %v", err)
+	}
+}
+
 func TestOperationsWithRanges(t *testing.T) {
 	const (
 		objCnt  = 50 // NOTE: must by a multiple of 10
@@ -17,6 +17,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/tools"
@@ -91,6 +92,57 @@ func TestPromote(t *testing.T) {
 	}
 }
 
+// tracked promotion (always x-promote) with progress and overwrite policies
+func TestPromoteProgress(t *testing.T) {
+	const num = 10
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: t.Name(), Provider: apc.AIS}
+		test       = &prmTests{num: num}
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	tempdir, err := os.MkdirTemp("", "prm")
+	tassert.CheckFatal(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(tempdir) })
+	tassert.CheckFatal(t, cos.CreateDir(filepath.Join(tempdir, subdir)))
+	test.generate(t, 1, num, tempdir, subdir)
+
+	promote := func(owp string) *api.PromoteProgress {
+		xid, err := api.StartPromote(baseParams, bck, &api.PromoteArgs{
+			SrcFQN:          tempdir,
+			Overwrite:       owp,
+			ContinueOnError: true,
+		})
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, xact.IsValidUUID(xid), "expecting valid xaction ID, got %q", xid)
+		args := xact.ArgsMsg{ID: xid, Kind: apc.ActPromote, Timeout: tools.RebalanceTimeout}
+		_, err = api.WaitForXactionIC(baseParams, &args)
+		tassert.CheckFatal(t, err)
+		prog, err := api.GetPromoteProgress(baseParams, xid)
+		tassert.CheckFatal(t, err)
+		tlog.Logf("%s (policy %q): %+v\n", xid, owp, prog.PromoteStats)
+		tassert.Errorf(t, prog.Finished, "expecting x-promote[%s] to finish", xid)
+		return prog
+	}
+
+	prog := promote("")
+	tassert.Errorf(t, prog.Promoted == num, "expected %d promoted, got %d", num, prog.Promoted)
+
+	prog = promote(apc.PromoteOwSkip)
+	tassert.Errorf(t, prog.Promoted == 0 && prog.Skipped == num, "expected %d skipped, got %+v", num, prog.PromoteStats)
+
+	prog = promote(apc.PromoteOwFail)
+	tassert.Errorf(t, prog.Promoted == 0 && prog.Failed == num, "expected %d failed, got %+v", num, prog.PromoteStats)
+
+	prog = promote(apc.PromoteOwAlways)
+	tassert.Errorf(t, prog.Promoted == num, "expected %d promoted (overwritten), got %+v", num, prog.PromoteStats)
+
+	_, err = api.StartPromote(baseParams, bck, &api.PromoteArgs{SrcFQN: tempdir, Overwrite: "sometimes"})
+	tassert.Fatalf(t, err != nil, "expected invalid overwrite policy error")
+}
+
 // generate ngen files in tempdir and tempdir/subdir, respectively
 var genfiles = `for f in {%d..%d}; do b=$RANDOM;
 for i in {1..3}; do echo $b; done > %s/$f.test;
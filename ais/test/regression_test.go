@@ -458,6 +458,146 @@ func TestRenameObjects(t *testing.T) {
 	}
 }
 
+func TestRenameMultiObj(t *testing.T) {
+	const (
+		objCnt = 200
+		srcDir = "src/"
+		dstDir = "dst/"
+	)
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: t.Name(), Provider: apc.AIS}
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	objNames, _, err := tools.PutRandObjs(tools.PutObjectsArgs{
+		ProxyURL:  proxyURL,
+		Bck:       bck,
+		ObjPath:   srcDir,
+		ObjCnt:    objCnt,
+		CksumType: bck.DefaultProps(initialClusterConfig).Cksum.Type,
+	})
+	tassert.CheckFatal(t, err)
+
+	renameAndWait := func(msg *apc.RenameObjsMsg) {
+		xid, err := api.RenameMultiObj(baseParams, bck, msg)
+		tassert.CheckFatal(t, err)
+		args := xact.ArgsMsg{ID: xid, Kind: apc.ActRenameObjects, Timeout: tools.RebalanceTimeout}
+		_, err = api.WaitForXactionIC(baseParams, &args)
+		tassert.CheckFatal(t, err)
+	}
+	countPrefix := func(prefix string) int {
+		lst, err := api.ListObjects(baseParams, bck, &apc.LsoMsg{Prefix: prefix}, api.ListArgs{})
+		tassert.CheckFatal(t, err)
+		return len(lst.Entries)
+	}
+
+	// 1. prefix => prefix
+	tlog.Logf("Renaming %s => %s\n", bck.Cname(srcDir), bck.Cname(dstDir))
+	renameAndWait(&apc.RenameObjsMsg{Prefix: srcDir, NewPrefix: dstDir})
+	if n := countPrefix(srcDir); n != 0 {
+		t.Errorf("expected no objects with prefix %q, got %d", srcDir, n)
+	}
+	if n := countPrefix(dstDir); n != objCnt {
+		t.Fatalf("expected %d objects with prefix %q, got %d", objCnt, dstDir, n)
+	}
+
+	// 2. list (renaming back)
+	msg := &apc.RenameObjsMsg{}
+	for _, objName := range objNames[:objCnt/2] {
+		msg.ObjNames = append(msg.ObjNames, path.Join(dstDir, path.Base(objName)))
+		msg.NewNames = append(msg.NewNames, objName)
+	}
+	tlog.Logf("Renaming %d objects back to %s\n", len(msg.ObjNames), bck.Cname(srcDir))
+	renameAndWait(msg)
+	for _, objName := range msg.NewNames {
+		_, err := api.HeadObject(baseParams, bck, objName, api.HeadArgs{})
+		tassert.CheckError(t, err)
+	}
+	tassert.Errorf(t, countPrefix(dstDir) == objCnt-objCnt/2, "expected %d objects with prefix %q", objCnt-objCnt/2, dstDir)
+
+	// 3. invalid
+	_, err = api.RenameMultiObj(baseParams, bck, &apc.RenameObjsMsg{Prefix: srcDir, NewPrefix: srcDir + "x/"})
+	tassert.Fatalf(t, err != nil, "expected error renaming %q as %q", srcDir, srcDir+"x/")
+}
+
+func TestPreloadVerify(t *testing.T) {
+	const objCnt = 100
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: t.Name(), Provider: apc.AIS}
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	_, _, err := tools.PutRandObjs(tools.PutObjectsArgs{
+		ProxyURL:  proxyURL,
+		Bck:       bck,
+		ObjPath:   "preload/",
+		ObjCnt:    objCnt,
+		CksumType: bck.DefaultProps(initialClusterConfig).Cksum.Type,
+	})
+	tassert.CheckFatal(t, err)
+
+	xid, err := api.StartXaction(baseParams, &xact.ArgsMsg{Kind: apc.ActLoadLomCache, Bck: bck, Verify: true}, "")
+	tassert.CheckFatal(t, err)
+	args := xact.ArgsMsg{ID: xid, Kind: apc.ActLoadLomCache, Timeout: tools.RebalanceTimeout}
+	_, err = api.WaitForXactionIC(baseParams, &args)
+	tassert.CheckFatal(t, err)
+
+	snaps, err := api.QueryXactionSnaps(baseParams, &args)
+	tassert.CheckFatal(t, err)
+	var verified, objs int64
+	for tid, ws := range snaps.WarmupStats(xid) {
+		tassert.Errorf(t, ws.Corrupted == 0, "%s: unexpected corrupted objects: %d", tid, ws.Corrupted)
+		for _, ms := range ws.Mpaths {
+			tlog.Logf("%s %s: %d objects, %s/s\n", tid, ms.Mpath, ms.Objs, cos.ToSizeIEC(ms.Throughput(), 2))
+			objs += ms.Objs
+		}
+		verified += ws.Verified + ws.NoCksum
+	}
+	tassert.Errorf(t, objs == objCnt, "expected %d objects read, got %d", objCnt, objs)
+	tassert.Errorf(t, verified == objCnt, "expected %d objects validated, got %d", objCnt, verified)
+}
+
+func TestWarmUpPrefix(t *testing.T) {
+	const objCnt = 50
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: t.Name(), Provider: apc.AIS}
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+
+	for _, prefix := range []string{"warm/", "cold/"} {
+		_, _, err := tools.PutRandObjs(tools.PutObjectsArgs{
+			ProxyURL:  proxyURL,
+			Bck:       bck,
+			ObjPath:   prefix,
+			ObjCnt:    objCnt,
+			ObjSize:   cos.KiB,
+			FixedSize: true,
+			CksumType: bck.DefaultProps(initialClusterConfig).Cksum.Type,
+		})
+		tassert.CheckFatal(t, err)
+	}
+
+	xid, err := api.WarmUp(baseParams, bck, &apc.WarmUpMsg{ListRange: apc.ListRange{Template: "warm/"}})
+	tassert.CheckFatal(t, err)
+	args := xact.ArgsMsg{ID: xid, Kind: apc.ActWarmUp, Timeout: tools.RebalanceTimeout}
+	_, err = api.WaitForXactionIC(baseParams, &args)
+	tassert.CheckFatal(t, err)
+
+	snaps, err := api.QueryXactionSnaps(baseParams, &args)
+	tassert.CheckFatal(t, err)
+	objs, _, inObjs := snaps.ObjCounts(xid)
+	size, _, _ := snaps.ByteCounts(xid)
+	tassert.Errorf(t, objs == objCnt, "expected %d objects warmed up, got %d", objCnt, objs)
+	tassert.Errorf(t, size == objCnt*cos.KiB, "expected %d bytes read, got %d", objCnt*cos.KiB, size)
+	tassert.Errorf(t, inObjs == 0, "ais:// bucket: expected nothing prefetched, got %d", inObjs)
+}
+
 func TestObjectPrefix(t *testing.T) {
 	runProviderTests(t, func(t *testing.T, bck *meta.Bck) {
 		var (
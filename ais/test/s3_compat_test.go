@@ -5,9 +5,11 @@
 package integration_test
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"crypto/tls"
+	"fmt"
 	"io"
 	"net"
 	"net/http"
@@ -24,6 +26,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/tools"
 	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/tools/trand"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
@@ -84,6 +87,36 @@ func setBucketFeatures(t *testing.T, bck cmn.Bck, bprops *cmn.Bprops, nf feat.Fl
 	})
 }
 
+// S3 PUT is subject to the bucket quota, same as the native API
+func TestS3PutQuota(t *testing.T) {
+	var (
+		bck      = cmn.Bck{Name: "s3-quota-" + trand.String(6), Provider: apc.AIS}
+		smap     = tools.GetClusterMap(t, proxyURL)
+		maxBytes = int64(smap.CountActiveTs()) * cos.MiB
+		objSize  = 2 * cos.MiB // exceeds any given target's share of the quota
+		status   int
+	)
+	tools.CreateBucket(t, proxyURL, bck, &cmn.BpropsToSet{Quota: &cmn.QuotaConfToSet{MaxBytes: &maxBytes}}, true)
+
+	// local usage gets computed in the background (triggered by the first PUT),
+	// and PUTs are not rejected until it is
+	for i, deadline := 0, time.Now().Add(time.Minute); time.Now().Before(deadline); i++ {
+		u := proxyURL + apc.URLPathS3.S + "/" + bck.Name + "/" + fmt.Sprintf("obj-%d", i)
+		req, err := http.NewRequest(http.MethodPut, u, bytes.NewReader(make([]byte, objSize)))
+		tassert.CheckFatal(t, err)
+		resp, err := baseParams.Client.Do(req)
+		tassert.CheckFatal(t, err)
+		cos.DrainReader(resp.Body)
+		resp.Body.Close()
+		if status = resp.StatusCode; status != http.StatusOK {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	tassert.Fatalf(t, status == http.StatusInsufficientStorage, "expected S3 PUT over quota to fail with %d, got %d",
+		http.StatusInsufficientStorage, status)
+}
+
 func TestS3PresignedPutGet(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Bck: cliBck, RequiresTLS: true, RequiredCloudProvider: apc.AWS})
 
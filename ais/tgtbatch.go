@@ -0,0 +1,318 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// get-batch: read multiple objects as a single TAR stream (apc.ActGetBatch, api.GetBatchArchive)
+// - proxy redirects the request to a random (designated) target - DT;
+// - DT partitions the objects by HRW and writes its own share directly into the response;
+// - DT then requests the remaining shares from the respective targets (apc.QparamGetBatchPart)
+//   that, in turn, respond with TARs of their own - DT copies (merges) those entries as they arrive;
+// - prefix-based request: each target walks its mountpaths to select the objects it owns;
+// - archived objects are grouped by target (DT first); within each group, the order is
+//   either user-specified (list) or lexicographical (prefix);
+// - remote buckets: listed objects that are not present in the cluster get cold-GET;
+//   prefix, on the other hand, selects only those that are present ("cached");
+// - a failure that occurs once the streaming has started aborts the response, so that the client
+//   gets an error rather than a truncated (and still well-formed) archive.
+
+type batchCtx struct {
+	t       *target
+	r       *http.Request
+	bck     *meta.Bck
+	msg     *apc.GetBatchMsg
+	tw      *tar.Writer
+	buf     []byte
+	cnt     int
+	written bool // (at least one header)
+}
+
+// GET /v1/buckets/<bucket-name> (apc.ActGetBatch)
+func (t *target) getBatch(w http.ResponseWriter, r *http.Request, msg *aisMsg, apiItems []string, dpq *dpq) {
+	if dpq.ptime == "" /*isRedirect*/ && t.isIntraCall(r.Header, false /*from primary*/) != nil {
+		t.writeErrf(w, r, "%s: %s(%s) is expected to be redirected (remaddr=%s)", t.si, r.Method, msg.Action, r.RemoteAddr)
+		return
+	}
+	if len(apiItems) == 0 {
+		t.writeErrURL(w, r)
+		return
+	}
+	bck, err := newBckFromQ(apiItems[0], nil, dpq)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if err := bck.Init(t.owner.bmd); err != nil {
+		if cmn.IsErrRemoteBckNotFound(err) {
+			t.BMDVersionFixup(r)
+			err = bck.Init(t.owner.bmd)
+		}
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+	}
+	gbmsg := &apc.GetBatchMsg{}
+	if err := cos.MorphMarshal(msg.Value, gbmsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+
+	b := &batchCtx{t: t, r: r, bck: bck, msg: gbmsg}
+	b.tw = tar.NewWriter(w)
+	buf, slab := t.gmm.Alloc()
+	b.buf = buf
+	w.Header().Set(cos.HdrContentType, cos.ContentTar)
+
+	if dpq.batchPart {
+		err = b.local(gbmsg.ObjNames)
+	} else {
+		err = b.do()
+	}
+	slab.Free(buf)
+
+	if err == nil {
+		err = b.tw.Close()
+	}
+	if err == nil {
+		if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+			nlog.Infoln(t.String(), msg.Action, bck.Cname(gbmsg.Prefix), "part:", dpq.batchPart, "count:", b.cnt)
+		}
+		return
+	}
+	if !b.written {
+		t.writeErr(w, r, err)
+		return
+	}
+	nlog.Errorln(t.String(), msg.Action, bck.Cname(gbmsg.Prefix), "failed:", err)
+	panic(http.ErrAbortHandler) // (do not complete the TAR - see above)
+}
+
+// designated target
+func (b *batchCtx) do() error {
+	var (
+		smap  = b.t.owner.smap.get()
+		parts map[string][]string
+		tids  = make([]string, 0, len(smap.Tmap))
+	)
+	if len(b.msg.ObjNames) > 0 {
+		parts = make(map[string][]string, len(smap.Tmap))
+		for _, name := range b.msg.ObjNames {
+			lom := core.AllocLOM(name)
+			if err := lom.InitBck(b.bck.Bucket()); err != nil {
+				core.FreeLOM(lom)
+				return err
+			}
+			tsi, _, err := lom.HrwTarget(&smap.Smap)
+			core.FreeLOM(lom)
+			if err != nil {
+				return err
+			}
+			parts[tsi.ID()] = append(parts[tsi.ID()], name)
+		}
+	}
+	if err := b.local(parts[b.t.SID()]); err != nil {
+		return err
+	}
+
+	for tid, tsi := range smap.Tmap {
+		if tid == b.t.SID() || tsi.InMaintOrDecomm() {
+			continue
+		}
+		if parts != nil && len(parts[tid]) == 0 {
+			continue
+		}
+		tids = append(tids, tid)
+	}
+	sort.Strings(tids)
+	for _, tid := range tids {
+		if err := b.fromPeer(smap.Tmap[tid], parts[tid]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// this target's share: either the given (HRW-owned) names or the prefix
+func (b *batchCtx) local(names []string) error {
+	if len(b.msg.ObjNames) > 0 {
+		for _, name := range names {
+			if err := b.add(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var (
+		smap = b.t.owner.smap.get()
+		opts = &fs.WalkBckOpts{
+			WalkOpts: fs.WalkOpts{CTs: []string{fs.ObjectType}, Prefix: b.msg.Prefix, Sorted: true},
+		}
+	)
+	opts.WalkOpts.Bck.Copy(b.bck.Bucket())
+	opts.Callback = func(fqn string, _ fs.DirEntry) error {
+		lom := core.AllocLOM("")
+		defer core.FreeLOM(lom)
+		if err := lom.InitFQN(fqn, b.bck.Bucket()); err != nil {
+			return nil // (e.g., misplaced or being moved)
+		}
+		if !strings.HasPrefix(lom.ObjName, b.msg.Prefix) {
+			return nil
+		}
+		if _, local, err := lom.HrwTarget(&smap.Smap); err != nil || !local {
+			return nil // not mine (rebalancing)
+		}
+		return b.add(lom.ObjName)
+	}
+	return fs.WalkBck(opts)
+}
+
+func (b *batchCtx) add(objName string) error {
+	lom := core.AllocLOM(objName)
+	started, err := b._add(lom)
+	core.FreeLOM(lom)
+	if err == nil || started || !b.msg.ContinueOnError {
+		return err
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Warningln(b.t.String(), apc.ActGetBatch, "skipping", b.bck.Cname(objName)+":", err)
+	}
+	return nil
+}
+
+// returns true if the object's TAR header has been written (and the failure, if any, cannot be skipped)
+func (b *batchCtx) _add(lom *core.LOM) (bool, error) {
+	if err := lom.InitBck(b.bck.Bucket()); err != nil {
+		return false, err
+	}
+	lom.Lock(false)
+	err := lom.Load(false /*cache it*/, true /*locked*/)
+	if err != nil && cos.IsNotExist(err, 0) && b.bck.IsRemote() && len(b.msg.ObjNames) > 0 {
+		lom.Unlock(false)
+		if _, err := b.t.GetCold(context.Background(), lom, cmn.OwtGetLock); err != nil {
+			return false, err
+		}
+		lom.Lock(false)
+		err = lom.Load(false, true)
+	}
+	if err != nil {
+		lom.Unlock(false)
+		return false, err
+	}
+	fh, err := cos.NewFileHandle(lom.FQN)
+	if err != nil {
+		lom.Unlock(false)
+		return false, err
+	}
+	hdr := tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     lom.ObjName,
+		Size:     lom.Lsize(),
+		ModTime:  lom.Atime(),
+		Mode:     int64(cos.PermRWRR),
+	}
+	b.written = true
+	if err = b.tw.WriteHeader(&hdr); err == nil {
+		_, err = io.CopyBuffer(b.tw, fh, b.buf)
+	}
+	cos.Close(fh)
+	lom.Unlock(false)
+	if err != nil {
+		return true, err
+	}
+	b.cnt++
+	b.t.statsT.AddMany(
+		cos.NamedVal64{Name: stats.GetCount, Value: 1},
+		cos.NamedVal64{Name: stats.GetSize, Value: hdr.Size},
+		cos.NamedVal64{Name: stats.GetThroughput, Value: hdr.Size},
+	)
+	return true, nil
+}
+
+// request (and merge) another target's share
+func (b *batchCtx) fromPeer(tsi *meta.Snode, names []string) error {
+	var (
+		gbmsg = apc.GetBatchMsg{Prefix: b.msg.Prefix, ObjNames: names, ContinueOnError: b.msg.ContinueOnError}
+		query = b.bck.NewQuery()
+	)
+	query.Set(apc.QparamGetBatchPart, "true")
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodGet
+		reqArgs.Base = tsi.URL(cmn.NetIntraData)
+		reqArgs.Header = http.Header{
+			apc.HdrCallerID:    []string{b.t.SID()},
+			apc.HdrCallerName:  []string{b.t.callerName()},
+			cos.HdrContentType: []string{cos.ContentJSON},
+		}
+		reqArgs.Path = apc.URLPathBuckets.Join(b.bck.Name)
+		reqArgs.Query = query
+		reqArgs.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActGetBatch, Value: &gbmsg})
+	}
+	req, err := reqArgs.Req()
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(b.r.Context()) // (client goes away)
+
+	resp, err := g.client.data.Do(req) //nolint:bodyclose // see below
+	if err != nil {
+		return b.peerErr(tsi, err)
+	}
+	defer cos.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, memsys.PageSize))
+		return b.peerErr(tsi, fmt.Errorf("%s (status %d)", strings.TrimSpace(string(msg)), resp.StatusCode))
+	}
+
+	tr := tar.NewReader(resp.Body)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %s from %s: %v", b.t, apc.ActGetBatch, tsi, err)
+		}
+		b.written = true
+		if err := b.tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := io.CopyBuffer(b.tw, tr, b.buf); err != nil {
+			return err
+		}
+		b.cnt++
+	}
+}
+
+// peer failed before sending anything
+func (b *batchCtx) peerErr(tsi *meta.Snode, err error) error {
+	err = fmt.Errorf("%s: %s from %s: %v", b.t, apc.ActGetBatch, tsi, err)
+	if !b.msg.ContinueOnError {
+		return err
+	}
+	nlog.Warningln(err, "- skipping")
+	return nil
+}
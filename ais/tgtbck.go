@@ -38,20 +38,24 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 	if err != nil {
 		return
 	}
-	if err = t.isIntraCall(r.Header, false); err != nil {
-		t.writeErr(w, r, err)
-		return
-	}
 	msg, err := t.readAisMsg(w, r)
 	if err != nil {
 		return
 	}
-	t.ensureLatestBMD(msg, r)
-
 	if err := dpq.parse(r.URL.RawQuery); err != nil {
 		t.writeErr(w, r, err)
 		return
 	}
+	// the only one that is redirected by proxy (see tgtbatch)
+	if msg.Action == apc.ActGetBatch {
+		t.getBatch(w, r, msg, apiItems, dpq)
+		return
+	}
+	if err = t.isIntraCall(r.Header, false); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	t.ensureLatestBMD(msg, r)
 
 	switch msg.Action {
 	case apc.ActList:
@@ -158,6 +162,21 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 			}
 		}
 		t.bsumm(w, r, phase, bck, &bsumMsg, dpq)
+	case apc.ActListSnaps:
+		if len(apiItems) == 0 {
+			t.writeErrURL(w, r)
+			return
+		}
+		bck, err := newBckFromQ(apiItems[0], nil, dpq)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		if err := bck.Init(t.owner.bmd); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		t.listSnaps(w, r, bck)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -418,7 +437,10 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 	if err != nil {
 		return
 	}
-	if msg.Action != apc.ActPrefetchObjects {
+	switch msg.Action {
+	case apc.ActPrefetchObjects, apc.ActRenameObjects, apc.ActWarmUp:
+	case apc.ActCreateSnap, apc.ActRestoreSnap, apc.ActDestroySnap:
+	default:
 		t.writeErrAct(w, r, msg.Action)
 		return
 	}
@@ -432,6 +454,17 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 		return
 	}
 
+	switch msg.Action {
+	case apc.ActRenameObjects:
+		t.renameObjs(w, r, msg, apireq.bck)
+		return
+	case apc.ActWarmUp:
+		t.warmUp(w, r, msg, apireq.bck)
+		return
+	case apc.ActCreateSnap, apc.ActRestoreSnap, apc.ActDestroySnap:
+		t.snapact(w, r, msg, apireq.bck)
+		return
+	}
 	prfMsg := &apc.PrefetchMsg{}
 	if err := cos.MorphMarshal(msg.Value, prfMsg); err != nil {
 		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
@@ -464,6 +497,60 @@ func (t *target) runPrefetch(xactID string, bck *meta.Bck, prfMsg *apc.PrefetchM
 	return 0, nil
 }
 
+// handle apc.ActRenameObjects <-- via api.RenameMultiObj
+func (t *target) renameObjs(w http.ResponseWriter, r *http.Request, msg *aisMsg, bck *meta.Bck) {
+	renmsg := &apc.RenameObjsMsg{}
+	if err := cos.MorphMarshal(msg.Value, renmsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	// note extra safety check
+	if err := renmsg.Validate(); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	rns := xreg.RenewRenameObjs(msg.UUID, bck, renmsg)
+	if rns.Err != nil {
+		t.writeErr(w, r, rns.Err)
+		return
+	}
+	xctn := rns.Entry.Get()
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.AddNotif(notif)
+	xact.GoRunW(xctn)
+}
+
+// handle apc.ActWarmUp <-- via api.WarmUp
+func (t *target) warmUp(w http.ResponseWriter, r *http.Request, msg *aisMsg, bck *meta.Bck) {
+	wupMsg := &apc.WarmUpMsg{}
+	if err := cos.MorphMarshal(msg.Value, wupMsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	if bck.IsRemote() {
+		cs := fs.Cap()
+		if err := cs.Err(); err != nil {
+			t.writeErr(w, r, err, http.StatusInsufficientStorage)
+			return
+		}
+	}
+	rns := xreg.RenewWarmUp(msg.UUID, bck, wupMsg)
+	if rns.Err != nil {
+		t.writeErr(w, r, rns.Err)
+		return
+	}
+	xctn := rns.Entry.Get()
+	notif := &xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xctn,
+	}
+	xctn.AddNotif(notif)
+	xact.GoRunW(xctn)
+}
+
 // HEAD /v1/buckets/bucket-name
 func (t *target) httpbckhead(w http.ResponseWriter, r *http.Request, apireq *apiRequest) {
 	var (
@@ -0,0 +1,74 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Conditional GET, PUT, and DELETE (see cmn.Cond):
+// - entity tags of an in-cluster object: backend's ETag (if any), checksum value, and version -
+//   any one of them matches;
+// - last modification time: the time the object was written (PUT, cold GET, etc.) in the cluster;
+// - GET: evaluated prior to reading (including cold GET), so that a remote object that is not
+//   (yet) present in the cluster does not match;
+// - PUT: evaluated upon receiving the request and, again, under write lock prior to finalizing
+//   (ais buckets only - remote PUT is not transactional);
+// - DELETE: evaluated under write lock.
+
+func lomETags(lom *core.LOM) []string {
+	tags := make([]string, 0, 3)
+	if v, ok := lom.GetCustomKey(cmn.ETag); ok {
+		tags = append(tags, v)
+	}
+	if cksum := lom.Checksum(); !cksum.IsEmpty() {
+		tags = append(tags, cksum.Val())
+	}
+	if v := lom.Version(); v != "" {
+		tags = append(tags, v)
+	}
+	return tags
+}
+
+// returns http.StatusNotModified, http.StatusPreconditionFailed (with error), or zero to proceed
+func evalCond(lom *core.LOM, cond *cmn.Cond, read, locked bool) (int, error) {
+	var (
+		mtime  time.Time
+		err    = lom.Load(true /*cache it*/, locked)
+		exists = err == nil
+	)
+	if err != nil && !cos.IsNotExist(err, 0) {
+		return 0, err
+	}
+	if exists {
+		finfo, err := os.Stat(lom.FQN)
+		if err != nil {
+			return 0, err
+		}
+		mtime = finfo.ModTime()
+	}
+	ecode := cond.Eval(exists, lomETags(lom), mtime, read)
+	if ecode == http.StatusPreconditionFailed {
+		return ecode, fmt.Errorf("%s: precondition failed", lom.Cname())
+	}
+	return ecode, nil
+}
+
+// PUT: re-check under write lock using a separate LOM (see putOI.fini)
+func (poi *putOI) recheckCond() (int, error) {
+	lom := core.AllocLOM(poi.lom.ObjName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(poi.lom.Bucket()); err != nil {
+		return 0, err
+	}
+	return evalCond(lom, poi.cond, false /*read*/, true /*locked*/)
+}
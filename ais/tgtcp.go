@@ -30,6 +30,7 @@ import (
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/reb"
 	"github.com/NVIDIA/aistore/res"
+	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	jsoniter "github.com/json-iterator/go"
@@ -182,6 +183,8 @@ func (t *target) daeputMsg(w http.ResponseWriter, r *http.Request) {
 	case apc.ActResetStats:
 		errorsOnly := msg.Value.(bool)
 		t.statsT.ResetStats(errorsOnly)
+	case apc.ActSelftest:
+		t.selftest(w, r, msg)
 
 	case apc.ActStartMaintenance:
 		if !t.ensureIntraControl(w, r, true /* from primary */) {
@@ -378,7 +381,7 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 	)
 	switch what {
 	case apc.WhatNodeConfig, apc.WhatSmap, apc.WhatBMD, apc.WhatSmapVote,
-		apc.WhatSnode, apc.WhatLog, apc.WhatMetricNames:
+		apc.WhatSnode, apc.WhatLog, apc.WhatMetricNames, apc.WhatCertificate, apc.WhatNetIfaces:
 		t.htrun.httpdaeget(w, r, query, t /*htext*/)
 	case apc.WhatSysInfo:
 		tsysinfo := apc.TSysInfo{MemCPUInfo: apc.GetMemCPU(), CapacityInfo: fs.CapStatusGetWhat()}
@@ -435,6 +438,21 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		}
 		fs.DiskStats(tcdfExt.AllDiskStats, &tcdfExt.Tcdf, config, true)
 		t.writeJSON(w, r, tcdfExt, httpdaeWhat)
+	case apc.WhatSlowReqs:
+		t.writeJSON(w, r, stats.GetSlow(), httpdaeWhat)
+	case apc.WhatNodeLoad:
+		t.writeJSON(w, r, t.nodeLoad(), httpdaeWhat)
+	case apc.WhatCapForecast:
+		t.writeJSON(w, r, fs.GetCapFcast(cmn.GCO.Get()), httpdaeWhat)
+	case apc.WhatRebHistory:
+		t.writeJSON(w, r, t.reb.History(), httpdaeWhat)
+	case apc.WhatRebEstimate:
+		est, err := t.reb.Estimate(query.Get(apc.QparamRebExclude))
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		t.writeJSON(w, r, est, httpdaeWhat)
 
 	case apc.WhatRemoteAIS:
 		var (
@@ -455,6 +473,8 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		debug.Assert(ok)
 
 		t.writeJSON(w, r, aisbp.GetInfo(aisConf), httpdaeWhat)
+	case apc.WhatRemAisPing:
+		t.writeJSON(w, r, t.aisbp().Ping(cmn.Rom.MaxKeepalive()), httpdaeWhat)
 	default:
 		t.htrun.httpdaeget(w, r, query, t /*htext*/)
 	}
@@ -584,6 +604,9 @@ func (t *target) handleMountpathReq(w http.ResponseWriter, r *http.Request) {
 		t.rescanMpath(w, r, mpath)
 	case apc.ActMountpathFSHC:
 		t.fshcMpath(w, r, mpath)
+	case apc.ActMountpathProbe:
+		t.probeMpath(w, r, mpath)
+		return
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -689,6 +712,19 @@ func (t *target) fshcMpath(w http.ResponseWriter, r *http.Request, mpath string)
 	t.fshc.OnErr(mi, "")
 }
 
+// run the onboarding sequence (checks and a short benchmark) on a new mountpath
+// without attaching it (see fs.Probe)
+func (t *target) probeMpath(w http.ResponseWriter, r *http.Request, mpath string) {
+	label := ios.Label(r.URL.Query().Get(apc.QparamMpathLabel))
+	mpp := fs.Probe(mpath, label, fs.ProbeSize)
+	if mpp.Passed() {
+		nlog.Infoln(t.String()+":", "probed", mpp.Mountpath, "- passed [ label:", mpp.Label, "]")
+	} else {
+		nlog.Warningln(t.String()+":", "probed", mpp.Mountpath, "- failed")
+	}
+	t.writeJSON(w, r, mpp, "probe-mpath")
+}
+
 func (t *target) detachMpath(w http.ResponseWriter, r *http.Request, mpath string) {
 	dontResilver := cos.IsParseBool(r.URL.Query().Get(apc.QparamDontResilver))
 	if _, err := t.fsprg.detachMpath(mpath, dontResilver); err != nil {
@@ -897,9 +933,13 @@ func (t *target) receiveRMD(newRMD *rebMD, msg *aisMsg) (err error) {
 		notif := &xact.NotifXact{
 			Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
 		}
+		cause := msg.Action // (to record in the rebalance history)
 		if msg.Action == apc.ActRebalance {
+			if v, ok := msg.Value.(string); ok && v == metaction3 {
+				cause = v
+			}
 			nlog.Infof("%s: starting user-requested rebalance[%s]", t, msg.UUID)
-			go t.reb.RunRebalance(&smap.Smap, newRMD.Version, notif, t.statsT)
+			go t.reb.RunRebalance(&smap.Smap, newRMD.Version, notif, t.statsT, cause)
 			return
 		}
 
@@ -915,11 +955,17 @@ func (t *target) receiveRMD(newRMD *rebMD, msg *aisMsg) (err error) {
 				}
 				nlog.Infof("%s: starting '%s' triggered rebalance[%s]%s: %+v",
 					t, msg.Action, xact.RebID2S(newRMD.Version), s, opts)
+				cause += " " + meta.Tname(opts.DaemonID)
+			}
+		case apc.ActAdminJoinTarget, apc.ActSelfJoinTarget:
+			if msg.Name != "" {
+				cause += " " + meta.Tname(msg.Name)
 			}
+			nlog.Infoln(t.String() + ": starting rebalance[" + xact.RebID2S(newRMD.Version) + "]")
 		default:
 			nlog.Infoln(t.String() + ": starting rebalance[" + xact.RebID2S(newRMD.Version) + "]")
 		}
-		go t.reb.RunRebalance(&smap.Smap, newRMD.Version, notif, t.statsT)
+		go t.reb.RunRebalance(&smap.Smap, newRMD.Version, notif, t.statsT, cause)
 
 		if newRMD.Resilver != "" {
 			nlog.Infoln(t.String() + ": ... and resilver")
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -32,6 +33,10 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 		respErr    error
 		statusCode int
 	)
+	if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, apc.URLPathDownloadPart.S) {
+		t.dlpart(w, r)
+		return
+	}
 	if !t.ensureIntraControl(w, r, false /* from primary */) {
 		return
 	}
@@ -193,6 +198,26 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GET /v1/download/part (internal)
+// fetch a range of the source on behalf of the target that downloads the object (see dload.Ranged)
+func (t *target) dlpart(w http.ResponseWriter, r *http.Request) {
+	if err := t.isIntraCall(r.Header, false /*from primary*/); err != nil {
+		t.writeErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if _, err := t.parseURL(w, r, apc.URLPathDownloadPart.L, 0, false); err != nil {
+		return
+	}
+	query := r.URL.Query()
+	if ecode, err := dload.FetchPart(w, r, query.Get(apc.QparamDlLink), query.Get(apc.QparamUUID)); err != nil {
+		if ecode == 0 {
+			nlog.Errorln(t.String()+":", err) // (status already sent)
+			return
+		}
+		t.writeErr(w, r, err, ecode)
+	}
+}
+
 func renewdl(xid string, bck *meta.Bck) (*dload.Xact, error) {
 	rns := xreg.RenewDownloader(xid, bck)
 	if rns.Err != nil {
@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -15,6 +16,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/k8s"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -43,14 +45,8 @@ func (t *target) etlHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 // PUT /v1/etl
-// start ETL spec/code
+// start ETL spec/code (or, validate only - see etl.DryRun)
 func (t *target) handleETLPut(w http.ResponseWriter, r *http.Request) {
-	// disallow to run when above high wm (let alone OOS)
-	cs := fs.Cap()
-	if err := cs.Err(); err != nil {
-		t.writeErr(w, r, err, http.StatusInsufficientStorage)
-		return
-	}
 	if _, err := t.parseURL(w, r, apc.URLPathETL.L, 0, false); err != nil {
 		return
 	}
@@ -67,6 +63,22 @@ func (t *target) handleETLPut(w http.ResponseWriter, r *http.Request) {
 		t.writeErr(w, r, err)
 		return
 	}
+
+	// disallow to run when above high wm (let alone OOS)
+	cs := fs.Cap()
+	errCap := cs.Err()
+	if cos.IsParseBool(r.URL.Query().Get(apc.QparamDryRun)) {
+		issues := etl.DryRun(initMsg)
+		if errCap != nil {
+			issues = append(issues, etl.DryRunIssue{Check: etl.DryRunCheckNode, TargetID: t.SID(), Msg: errCap.Error()})
+		}
+		t.writeJSON(w, r, issues, "etl-dry-run")
+		return
+	}
+	if errCap != nil {
+		t.writeErr(w, r, errCap, http.StatusInsufficientStorage)
+		return
+	}
 	xid := r.URL.Query().Get(apc.QparamUUID)
 
 	switch msg := initMsg.(type) {
@@ -132,9 +144,13 @@ func (t *target) handleETLPost(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if apiItems[1] == apc.ETLStop {
+	switch apiItems[1] {
+	case apc.ETLStop:
 		t.stopETL(w, r, apiItems[0])
 		return
+	case apc.ETLAbort:
+		t.abortETL(w, r, apiItems[0])
+		return
 	}
 	// TODO: Implement ETLStart to start inactive ETLs
 	t.writeErrURL(w, r)
@@ -151,11 +167,56 @@ func (t *target) stopETL(w http.ResponseWriter, r *http.Request, etlName string)
 	}
 }
 
+// POST /v1/etl/<etl-name>/abort (from primary, with the cause in the body)
+func (t *target) abortETL(w http.ResponseWriter, r *http.Request, etlName string) {
+	if !t.ensureIntraControl(w, r, false /*from primary*/) {
+		return
+	}
+	b, err := cos.ReadAll(r.Body)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	r.Body.Close()
+	if err := etl.Abort(etlName, errors.New(string(b))); err != nil {
+		if cos.IsErrNotFound(err) {
+			return // (e.g., the one that requested the abort)
+		}
+		t.writeErr(w, r, err)
+	}
+}
+
+// ask primary to abort a given ETL cluster-wide (see etl.AbortClusterWide)
+func (t *target) abortETLClu(etlName string, cause error) error {
+	smap := t.owner.smap.get()
+	if err := smap.validate(); err != nil {
+		return err
+	}
+	cargs := allocCargs()
+	{
+		cargs.si = smap.Primary
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodPost,
+			Base:   smap.Primary.URL(cmn.NetIntraControl),
+			Path:   apc.URLPathETL.Join(etlName, apc.ETLAbort),
+			Body:   cos.UnsafeB(cause.Error()),
+		}
+		cargs.timeout = apc.LongTimeout
+	}
+	res := t.call(cargs, smap)
+	err := res.toErr()
+	freeCargs(cargs)
+	freeCR(res)
+	return err
+}
+
 func (t *target) getETL(w http.ResponseWriter, r *http.Request, etlName string, lom *core.LOM) {
 	var (
 		comm etl.Communicator
 		err  error
 	)
+	// canary deployment: route the configured percentage of requests to the new version
+	etlName = t.owner.etl.Get().Route(etlName)
 	comm, err = etl.GetCommunicator(etlName)
 	if err != nil {
 		if cos.IsErrNotFound(err) {
@@ -168,9 +229,17 @@ func (t *target) getETL(w http.ResponseWriter, r *http.Request, etlName string,
 		t.writeErr(w, r, err)
 		return
 	}
-	if err := comm.InlineTransform(w, r, lom); err != nil {
+	started := mono.NanoTime()
+	err = comm.InlineTransform(w, r, lom)
+	comm.InlineDone(mono.Since(started), err)
+	if err != nil {
 		errV := cmn.NewErrETL(&cmn.ETLErrCtx{ETLName: etlName, PodName: comm.PodName(), SvcName: comm.SvcName()},
 			err.Error())
+		if etl.IsErrUnavailable(err) {
+			// circuit breaker open: fail fast
+			t.writeErr(w, r, errV, http.StatusServiceUnavailable)
+			return
+		}
 		xetl := comm.Xact()
 		xetl.AddErr(errV)
 		t.writeErr(w, r, errV)
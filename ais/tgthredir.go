@@ -0,0 +1,73 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// target side of the health-based GET redirects - see prxhredir.go
+
+func (t *target) nodeLoad() *nodeLoad {
+	load := &nodeLoad{Flags: cos.NodeStateFlags(t.statsT.Get(cos.NodeAlerts))}
+	for mpath := range fs.GetAvail() {
+		load.DiskUtil = max(load.DiskUtil, fs.GetMpathUtil(mpath))
+	}
+	return load
+}
+
+// GET redirected away from a degraded target (dpq.hredir):
+// serve the local replica if there's one; otherwise, redirect back to the owner
+// (to, in particular, prevent this target from restoring or cold-GETting somebody else's object)
+func (t *target) redirBack(w http.ResponseWriter, r *http.Request, dpq *dpq, lom *core.LOM) bool {
+	err := lom.Load(true /*cache it*/, false /*locked*/)
+	if err == nil {
+		return false
+	}
+	smap := t.owner.smap.get()
+	tsi := smap.GetTarget(dpq.hredir)
+	if tsi == nil {
+		if tsi, err = smap.HrwHash2T(lom.Digest()); err != nil {
+			t.writeErr(w, r, err)
+			return true
+		}
+	}
+	if tsi.ID() == t.SID() {
+		return false
+	}
+	q := r.URL.Query()
+	q.Del(apc.QparamHealthRedirect)
+	redirectURL := tsi.URL(cmn.NetPublic) + r.URL.Path + "?" + q.Encode()
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(t.String()+":", "no replica of", lom.Cname(), "- redirecting back to", tsi.StringEx())
+	}
+	http.Redirect(w, r, redirectURL, http.StatusMovedPermanently)
+	return true
+}
+
+// GET routed around an unreachable owner (dpq.fbredir): validate against this target's own BMD
+// and proceed with the regular GET - which serves the local replica, if any, or restores the object
+// from EC slices (see goi.restoreFromAny)
+func (t *target) fallbackGet(dpq *dpq, lom *core.LOM) error {
+	if dpq.fbredir == t.SID() {
+		return nil
+	}
+	if !lom.Bck().IsAIS() || !lom.ECEnabled() {
+		return cmn.NewErrFailedTo(t, "serve", lom.Cname(),
+			fmt.Errorf("owner %s is unreachable and the bucket is not erasure coded", dpq.fbredir), http.StatusServiceUnavailable)
+	}
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(t.String()+":", "GET", lom.Cname(), "on behalf of unreachable", dpq.fbredir)
+	}
+	return nil
+}
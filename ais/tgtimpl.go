@@ -7,6 +7,7 @@ package ais
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"os"
@@ -144,6 +145,17 @@ func (t *target) CopyObject(lom *core.LOM, dm core.DM, params *core.CopyParams)
 	return size, err
 }
 
+// RenameObject (within the same bucket) is the x-rename-objects (multi-object) counterpart of apc.ActRenameObject
+func (t *target) RenameObject(lom *core.LOM, objNameTo string) error {
+	err := t.objMv(lom, objNameTo)
+	if err == nil {
+		t.statsT.Inc(stats.RenameCount)
+	} else {
+		t.statsT.IncErr(stats.ErrRenameCount)
+	}
+	return err
+}
+
 // use `backend.GetObj` (compare w/ other instances calling `backend.GetObjReader`)
 func (t *target) GetCold(ctx context.Context, lom *core.LOM, owt cmn.OWT) (ecode int, err error) {
 	// 1. lock
@@ -271,8 +283,13 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 	)
 	fileSize = -1
 
-	if err = lom.Load(true /*cache it*/, false /*locked*/); err == nil && !params.OverwriteDst {
-		return
+	if err = lom.Load(true /*cache it*/, false /*locked*/); err == nil {
+		switch params.OwPolicy() {
+		case apc.PromoteOwSkip:
+			return
+		case apc.PromoteOwFail:
+			return fileSize, http.StatusConflict, errPromoteExists(lom)
+		}
 	}
 	if params.DeleteSrc {
 		// To use `params.SrcFQN` as `workFQN`, make sure both are
@@ -340,13 +357,20 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 	return
 }
 
+func errPromoteExists(lom *core.LOM) error {
+	return fmt.Errorf("cannot promote to %s: destination exists (overwrite policy %q)", lom.Cname(), apc.PromoteOwFail)
+}
+
 // TODO: use DM streams
 // TODO: Xact.InObjsAdd on the receive side
 func (t *target) _promRemote(params *core.PromoteParams, lom *core.LOM, tsi *meta.Snode, smap *smapX) (int64, error) {
 	lom.FQN = params.SrcFQN
 
 	// when not overwriting check w/ remote target first (and separately)
-	if !params.OverwriteDst && t.headt2t(lom, tsi, smap) {
+	if ow := params.OwPolicy(); ow != apc.PromoteOwAlways && t.headt2t(lom, tsi, smap) {
+		if ow == apc.PromoteOwFail {
+			return -1, errPromoteExists(lom)
+		}
 		return -1, nil
 	}
 
@@ -54,11 +54,16 @@ type (
 		lom        *core.LOM     // obj
 		cksumToUse *cos.Cksum    // if available (not `none`), can be validated and will be stored
 		config     *cmn.Config   // (during this request)
+		cond       *cmn.Cond     // conditional PUT (If-Match, etc.)
 		resphdr    http.Header   // as implied
 		workFQN    string        // temp fqn to be renamed
 		atime      int64         // access time.Now()
 		ltime      int64         // mono.NanoTime, to measure latency
 		rltime     int64         // mono.NanoTime, to measure remote bucket latency
+		rdtime     int64         // redirect latency (slow-request tracing, here and below)
+		lktime     int64         // waiting for wlock
+		rxtime     int64         // receiving
+		wrtime     int64         // writing
 		size       int64         // aka Content-Length
 		owt        cmn.OWT       // object write transaction enum { OwtPut, ..., OwtGet* }
 		restful    bool          // being invoked via RESTful API
@@ -75,12 +80,17 @@ type (
 		ctx        context.Context // context used when getting object from remote backend (access creds)
 		t          *target         // this
 		lom        *core.LOM       // obj
+		span       *stats.Span     // distributed tracing (see tracing.go)
 		dpq        *dpq
 		ranges     byteRanges // range read (see https://www.rfc-editor.org/rfc/rfc7233#section-2.1)
 		atime      int64      // access time.Now()
 		ltime      int64      // mono.NanoTime, to measure latency
 		rstarttime int64      // mono.NanoTime, mark start of remote GET to measure latency
 		rltime     int64      // mono.NanoTime, to measure remote bucket latency
+		rdtime     int64      // redirect latency (slow-request tracing, here and below)
+		lktime     int64      // waiting for rlock
+		dktime     int64      // reading
+		txtime     int64      // transmitting
 		chunked    bool       // chunked transfer (en)coding: https://tools.ietf.org/html/rfc7230#page-36
 		unlocked   bool       // internal
 		verchanged bool       // version changed
@@ -103,6 +113,7 @@ type (
 		config  *cmn.Config   // (during this request)
 		lom     *core.LOM     // append to or _as_
 		cksum   *cos.Cksum    // checksum expected once Flush-ed
+		final   *cos.Cksum    // (flush) checksum of the resulting object
 		hdl     aoHdl         // (packed)
 		op      string        // enum {apc.AppendOp, apc.FlushOp}
 		size    int64         // Content-Length
@@ -130,6 +141,9 @@ type (
 		started  int64         // time of receiving
 		size     int64         // aka Content-Length
 		put      bool          // overwrite
+		mkidx    bool          // apc.ArchCreateIndex
+		idx      *archive.Index
+		dataOff  int64 // (fast append) offset of the appended file's data
 	}
 )
 
@@ -217,7 +231,8 @@ func (poi *putOI) putObject() (ecode int, err error) {
 rerr:
 	if poi.owt == cmn.OwtPut && poi.restful && !poi.t2t {
 		poi.t.statsT.IncErr(stats.ErrPutCount)
-		if err != cmn.ErrSkip && !poi.remoteErr && err != io.ErrUnexpectedEOF && !cos.IsRetriableConnErr(err) {
+		if err != cmn.ErrSkip && !poi.remoteErr && ecode != http.StatusPreconditionFailed &&
+			err != io.ErrUnexpectedEOF && !cos.IsRetriableConnErr(err) {
 			poi.t.statsT.IncErr(stats.IOErrPutCount)
 		}
 	}
@@ -247,6 +262,7 @@ func (poi *putOI) stats() {
 			cos.NamedVal64{Name: backend.MetricName(stats.PutSize), Value: size},
 		)
 	}
+	poi.trace(size, delta)
 }
 
 // verbose only
@@ -342,9 +358,16 @@ func (poi *putOI) fini() (ecode int, err error) {
 		defer lom.Unlock(true)
 	default:
 		debug.Assert(cos.IsValidAtime(poi.atime), poi.atime) // expecting valid atime
+		started := mono.NanoTime()
 		lom.Lock(true)
+		poi.lktime = mono.SinceNano(started)
 		defer lom.Unlock(true)
 		lom.SetAtimeUnix(poi.atime)
+		if poi.cond != nil && !bck.IsRemote() {
+			if ecode, err := poi.recheckCond(); ecode != 0 || err != nil {
+				return ecode, err
+			}
+		}
 	}
 
 	// ais versioning
@@ -427,19 +450,28 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	} else {
 		buf, slab = poi.t.gmm.AllocSize(poi.size)
 	}
+	var (
+		src     io.Reader = poi.r
+		tr      *timedReader
+		started int64
+	)
+	if cmn.Rom.SlowReq() > 0 && poi.restful {
+		tr = &timedReader{r: poi.r}
+		src, started = tr, mono.NanoTime()
+	}
 
 	switch {
 	case ckconf.Type == cos.ChecksumNone:
 		poi.lom.SetCksum(cos.NoneCksum)
 		// not using `ReadFrom` of the `*os.File` -
 		// ultimately, https://github.com/golang/go/blob/master/src/internal/poll/copy_file_range_linux.go#L100
-		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
+		written, err = cos.CopyBuffer(lmfh, src, buf)
 	case !poi.cksumToUse.IsEmpty() && !poi.validateCksum(ckconf):
 		// if the corresponding validation is not configured/enabled we just go ahead
 		// and use the checksum that has arrived with the object
 		poi.lom.SetCksum(poi.cksumToUse)
 		// (ditto)
-		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
+		written, err = cos.CopyBuffer(lmfh, src, buf)
 	default:
 		writers := make([]io.Writer, 0, 3)
 		cksums.store = cos.NewCksumHash(ckconf.Type) // always according to the bucket
@@ -455,11 +487,15 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 			}
 		}
 		writers = append(writers, lmfh)
-		written, err = cos.CopyBuffer(cos.NewWriterMulti(writers...), poi.r, buf) // (ditto)
+		written, err = cos.CopyBuffer(cos.NewWriterMulti(writers...), src, buf) // (ditto)
 	}
 	if err != nil {
 		return
 	}
+	if tr != nil {
+		poi.rxtime = tr.elapsed
+		poi.wrtime = mono.SinceNano(started) - tr.elapsed
+	}
 
 	// validate
 	if cksums.compt != nil {
@@ -535,7 +571,9 @@ func (poi *putOI) validateCksum(c *cmn.CksumConf) (v bool) {
 
 func (goi *getOI) getObject() (ecode int, err error) {
 	debug.Assert(!goi.unlocked)
+	started := mono.NanoTime()
 	goi.lom.Lock(false)
+	goi.lktime = mono.SinceNano(started)
 	ecode, err = goi.get()
 	if !goi.unlocked {
 		goi.lom.Unlock(false)
@@ -603,16 +641,25 @@ do:
 		goto fin // ok, done
 	case cold:
 		// have remote backend - use it
+		if goi.latestVer {
+			goi.w.Header().Set(apc.HdrObjVerCheck, apc.VerCheckCold)
+		}
 	case goi.latestVer:
 		// apc.QparamLatestVer or 'versioning.validate_warm_get'
 		res := goi.lom.CheckRemoteMD(true /* rlocked */, false /*synchronize*/, goi.req)
 		if res.Err != nil {
 			return res.ErrCode, res.Err
 		}
-		if !res.Eq {
+		whdr := goi.w.Header()
+		if res.Eq {
+			whdr.Set(apc.HdrObjVerCheck, apc.VerCheckFresh)
+		} else {
 			cold, goi.verchanged = true, true
+			whdr.Set(apc.HdrObjVerCheck, apc.VerCheckStale)
+		}
+		if rver := _remoteVer(res.ObjAttrs); rver != "" {
+			whdr.Set(apc.HdrObjRemoteVer, rver)
 		}
-		// TODO: utilize res.ObjAttrs
 	}
 
 	// validate checksums and recover (a.k.a. self-heal) if corrupted
@@ -654,9 +701,12 @@ do:
 		goi.lom.SetCustomMD(nil)
 
 		goi.rstarttime = mono.NanoTime()
+		bspan := goi.span.Child("ais.backend.get", stats.SpanKindClient)
+		bspan.Set("ais.provider", goi.lom.Bck().Provider)
 		// get remote reader (compare w/ t.GetCold)
 		res = backend.GetObjReader(goi.ctx, goi.lom, 0, 0)
 		if res.Err != nil {
+			bspan.End(res.Err)
 			goi.lom.Unlock(true)
 			goi.unlocked = true
 			if !cos.IsNotExist(res.Err, res.ErrCode) {
@@ -674,11 +724,13 @@ do:
 			} else {
 				err = goi.coldReopen(&res)
 			}
+			bspan.End(err)
 			goi.unlocked = true // always
 			return 0, err
 		}
 		// otherwise, regular path
 		ecode, err = goi._coldPut(&res)
+		bspan.End(err)
 		if err != nil {
 			goi.unlocked = true
 			return ecode, err
@@ -704,6 +756,18 @@ fin:
 	return ecode, err
 }
 
+// version (or ETag) reported by remote backend
+func _remoteVer(oa *cmn.ObjAttrs) string {
+	if oa == nil {
+		return ""
+	}
+	if ver := oa.Version(); ver != "" {
+		return ver
+	}
+	etag, _ := oa.GetCustomKey(cmn.ETag)
+	return etag
+}
+
 // upgrade rlock => wlock
 // done early to prevent multiple cold-readers duplicating network/disk operation and overwriting each other
 func (goi *getOI) _coldLock() (loaded bool, err error) {
@@ -1114,6 +1178,20 @@ func (goi *getOI) _txarch(fqn string, lmfh *os.File, whdr http.Header) error {
 	if err != nil {
 		return err
 	}
+	// single (indexed TAR): seek directly to the archived file
+	if dpq.arch.path != "" && mime == archive.ExtTar && !lom.IsChunked() {
+		if idx := lom.LoadArchIndex(); idx != nil {
+			e := idx.Find(dpq.arch.path)
+			if e == nil {
+				return cos.NewErrNotFound(goi.t, dpq._archstr()+" in "+lom.Cname())
+			}
+			whdr.Set(cos.HdrContentType, cos.ContentBinary)
+			buf, slab := goi.t.gmm.AllocSize(min(e.Size, memsys.DefaultBuf2Size))
+			err = goi.transmit(idx.Open(lmfh, e), buf, fqn)
+			slab.Free(buf)
+			return err
+		}
+	}
 	ar, err = archive.NewReader(mime, lmfh, lom.Lsize())
 	if err != nil {
 		return fmt.Errorf("failed to open %s: %w", lom.Cname(), err)
@@ -1158,7 +1236,19 @@ func (goi *getOI) _txarch(fqn string, lmfh *os.File, whdr http.Header) error {
 }
 
 func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string) error {
+	var (
+		tr      *timedReader
+		started int64
+	)
+	if cmn.Rom.SlowReq() > 0 {
+		tr = &timedReader{r: r}
+		r, started = tr, mono.NanoTime()
+	}
 	written, err := cos.CopyBuffer(goi.w, r, buf)
+	if tr != nil {
+		goi.dktime = tr.elapsed
+		goi.txtime = mono.SinceNano(started) - tr.elapsed
+	}
 	if err != nil {
 		if !cos.IsRetriableConnErr(err) || cmn.Rom.FastV(5, cos.SmoduleAIS) {
 			nlog.Warningln("failed to GET (Tx)", goi.lom.Cname(), err)
@@ -1223,6 +1313,7 @@ func (goi *getOI) stats(written int64) {
 			)
 		}
 	}
+	goi.trace(written, delta)
 }
 
 // - parse and validate user specified read range (goi.ranges)
@@ -1356,8 +1447,8 @@ func (a *apndOI) flush() (int, error) {
 	debug.Assert(a.hdl.partialCksum != nil)
 	a.hdl.partialCksum.Finalize()
 	partialCksum := a.hdl.partialCksum.Clone()
-	if !a.cksum.IsEmpty() && !partialCksum.Equal(a.cksum) {
-		return http.StatusInternalServerError, cos.NewErrDataCksum(partialCksum, a.cksum)
+	if err := a.validate(partialCksum); err != nil {
+		return http.StatusInternalServerError, err
 	}
 
 	params := core.PromoteParams{
@@ -1371,7 +1462,41 @@ func (a *apndOI) flush() (int, error) {
 			DeleteSrc:    true, // NOTE: always overwrite and remove
 		},
 	}
-	return a.t.Promote(&params)
+	ecode, err := a.t.Promote(&params)
+	if err == nil {
+		a.final = partialCksum
+	}
+	return ecode, err
+}
+
+// validate user-provided checksum of the entire concatenation;
+// when the latter is not of the (bucket-configured) type that was computed while appending,
+// compute it over the work file
+func (a *apndOI) validate(partialCksum *cos.Cksum) error {
+	if a.cksum.IsEmpty() {
+		return nil
+	}
+	if a.cksum.Type() == partialCksum.Type() {
+		if !partialCksum.Equal(a.cksum) {
+			return cos.NewErrDataCksum(partialCksum, a.cksum)
+		}
+		return nil
+	}
+	fh, err := os.Open(a.hdl.workFQN)
+	if err != nil {
+		return err
+	}
+	buf, slab := a.t.gmm.Alloc()
+	_, cksumH, err := cos.CopyAndChecksum(io.Discard, fh, buf, a.cksum.Type())
+	slab.Free(buf)
+	cos.Close(fh)
+	if err != nil {
+		return err
+	}
+	if !cksumH.Equal(a.cksum) {
+		return cos.NewErrDataCksum(&cksumH.Cksum, a.cksum)
+	}
+	return nil
 }
 
 func (a *apndOI) parse(packedHdl string) error {
@@ -1487,6 +1612,10 @@ func (coi *copyOI) _reader(t *target, dm *bundle.DataMover, lom, dst *core.LOM)
 	if lom.Bck().Equal(coi.BckTo, true, true) {
 		dst.CopyVersion(oah)
 	}
+	// e.g., custom metadata returned by ETL (compare with coi._send)
+	for k, v := range oah.GetCustomMD() {
+		dst.SetCustomKey(k, v)
+	}
 
 	poi := allocPOI()
 	{
@@ -1704,9 +1833,13 @@ func (a *putA2I) do() (int, error) {
 	if a.filename == "" {
 		return 0, errors.New("archive path is not defined")
 	}
+	if a.mime == archive.ExtTar && !a.put && !a.lom.IsChunked() {
+		a.idx = a.lom.LoadArchIndex() // (nil if none or stale)
+	}
 	// standard library does not support appending to tgz, zip, and such;
 	// for TAR there is an optimizing workaround not requiring a full copy
-	if a.mime == archive.ExtTar && !a.put /*append*/ && !a.lom.IsChunked() {
+	// (but modifying the file in place - not when the latter is shared with bucket snapshot(s))
+	if a.mime == archive.ExtTar && !a.put /*append*/ && !a.lom.IsChunked() && !a.lom.IsShared() {
 		var (
 			err       error
 			fh        *os.File
@@ -1733,6 +1866,7 @@ func (a *putA2I) do() (int, error) {
 		if size, err = a.fast(fh, tarFormat, offset); err == nil {
 			// TODO: checksum NIY
 			if err = a.finalize(size, cos.NoneCksum, workFQN); err == nil {
+				a.index(true /*appended*/)
 				return http.StatusInternalServerError, nil // ok
 			}
 		} else if errV := a.lom.RenameToMain(workFQN); errV != nil {
@@ -1784,7 +1918,9 @@ cpap: // copy + append
 	cos.Close(wfh)
 	if err == nil {
 		cksum.Finalize()
-		err = a.finalize(cksum.Size, cksum.Clone(), workFQN)
+		if err = a.finalize(cksum.Size, cksum.Clone(), workFQN); err == nil {
+			a.index(false)
+		}
 	} else {
 		cos.RemoveFile(workFQN)
 	}
@@ -1806,7 +1942,8 @@ func (a *putA2I) fast(rwfh *os.File, tarFormat tar.Format, offset int64) (size i
 		}
 	)
 	tw.WriteHeader(&hdr)
-	_, err = io.CopyBuffer(tw, a.r, buf) // append
+	a.dataOff, _ = rwfh.Seek(0, io.SeekCurrent) // (tar writer does not buffer)
+	_, err = io.CopyBuffer(tw, a.r, buf)        // append
 	cos.Close(tw)
 	if err == nil {
 		size, err = rwfh.Seek(0, io.SeekCurrent)
@@ -1817,6 +1954,28 @@ func (a *putA2I) fast(rwfh *os.File, tarFormat tar.Format, offset int64) (size i
 	return
 }
 
+// TAR only: update existing (and valid) archived-content index upon fast append, or (re)build it -
+// if the index existed or was requested (apc.ArchCreateIndex);
+// failure to index is not an error - the shard remains readable and listable via full scan
+func (a *putA2I) index(appended bool) {
+	if a.mime != archive.ExtTar || a.lom.IsChunked() {
+		return
+	}
+	var err error
+	switch {
+	case a.idx != nil && appended:
+		a.idx.Add(a.filename, a.dataOff, a.size)
+		err = a.lom.SaveArchIndex(a.idx)
+	case a.idx != nil || a.mkidx:
+		_, err = a.lom.BuildArchIndex()
+	default:
+		return
+	}
+	if err != nil {
+		nlog.Warningln(a.t.String(), "failed to index", a.lom.Cname()+":", err)
+	}
+}
+
 func (*putA2I) reterr(err error) (int, error) {
 	ecode := http.StatusInternalServerError
 	if cmn.IsErrCapExceeded(err) {
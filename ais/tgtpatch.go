@@ -0,0 +1,334 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// PATCH-style partial update (ranged write) of an existing ais:// object:
+// PUT /v1/objects/bucket-name/object-name?patch_offset=OFFSET, where the request body
+// (of the given Content-Length) gets written at OFFSET - in place, without re-writing
+// the rest of the object (think checkpoints and other large files updated piecemeal).
+// - OFFSET must not exceed the current size; OFFSET == size is effectively an append;
+// - supported for ais:// buckets without remote backend and without erasure coding;
+// - the update is not atomic: readers may observe a partially written range, and
+//   a failure in the middle leaves the range partially written;
+// - the object's version (if versioned) gets incremented; mirrored copies get removed
+//   and then re-created in the background.
+// Checksums: none of the supported checksum types is "rolling" - the whole object is
+// always re-read to recompute it. Therefore, by default, the stored checksum is reset and
+// recomputed lazily (apc.PatchCksumLazy): in the background, once the object stops being
+// updated for patchCksumDelay (and if validate-warm-get is enabled, by the first GET).
+// With apc.PatchCksumSync the checksum is recomputed prior to responding.
+// The set of objects pending (lazy) recompute is persisted, to survive restarts.
+
+const (
+	patchCksumDelay = 10 * time.Second // since the last update
+	patchCksumIval  = 5 * time.Second  // housekeeping
+	patchqMetaver   = 1
+)
+
+type (
+	patchOI struct {
+		t       *target
+		lom     *core.LOM
+		r       io.Reader
+		started int64 // (unix nano)
+		off     int64
+		size    int64
+		sync    bool // apc.PatchCksumSync
+	}
+	// objects pending (lazy) checksum recompute
+	patchq struct {
+		t       *target
+		m       map[string]int64 // uname => mono time of the last update
+		fpath   string
+		mu      sync.Mutex
+		running atomic.Bool
+	}
+	patchqMD struct {
+		Unames []string `json:"unames"`
+	}
+)
+
+func (pa *patchOI) parse(dpq *dpq, contentLength int64) (err error) {
+	if pa.off, err = strconv.ParseInt(dpq.patch.off, 10, 64); err != nil || pa.off < 0 {
+		return fmt.Errorf("invalid %s=%q (expecting non-negative integer)", apc.QparamPatchOffset, dpq.patch.off)
+	}
+	switch dpq.patch.cksum {
+	case "", apc.PatchCksumLazy:
+	case apc.PatchCksumSync:
+		pa.sync = true
+	default:
+		return fmt.Errorf("invalid %s=%q (expecting %q or %q)", apc.QparamPatchCksum, dpq.patch.cksum,
+			apc.PatchCksumLazy, apc.PatchCksumSync)
+	}
+	if contentLength < 0 {
+		return cmn.NewErrFailedTo(pa.t, "patch", pa.lom.Cname(), errors.New("missing Content-Length"), http.StatusLengthRequired)
+	}
+	pa.size = contentLength
+	return nil
+}
+
+func (pa *patchOI) do() (cksum *cos.Cksum, ecode int, err error) {
+	var (
+		lom = pa.lom
+		bck = lom.Bck()
+	)
+	if !bck.IsAIS() || bck.IsRemote() {
+		return nil, http.StatusNotImplemented, cmn.NewErrUnsupp("patch objects in", bck.Cname("")+" (ais:// buckets only)")
+	}
+	if lom.ECEnabled() {
+		return nil, http.StatusBadRequest, cmn.NewErrUnsupp("patch objects in", bck.Cname("")+" (erasure coded)")
+	}
+
+	lom.Lock(true)
+	cksum, ecode, err = pa._do()
+	lom.Unlock(true)
+
+	if err != nil {
+		pa.t.statsT.IncErr(stats.ErrPatchCount)
+		return nil, ecode, err
+	}
+	pa.t.statsT.Inc(stats.PatchCount)
+	pa.t.putMirror(lom)
+	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Infoln(pa.t.String()+":", "PATCH", lom.Cname(), "offset", pa.off, "size", pa.size)
+	}
+	return cksum, 0, nil
+}
+
+// under wlock
+func (pa *patchOI) _do() (*cos.Cksum, int, error) {
+	lom := pa.lom
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cos.IsNotExist(err, 0) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, 0, err
+	}
+	osize := lom.Lsize()
+	if pa.off > osize {
+		err := cmn.NewErrRangeNotSatisfiable(fmt.Errorf("%s: offset %d exceeds object size %d", lom.Cname(), pa.off, osize), nil, osize)
+		return nil, http.StatusRequestedRangeNotSatisfiable, err
+	}
+	if pa.size == 0 {
+		return lom.Checksum(), 0, nil
+	}
+
+	buf, slab := pa.t.gmm.AllocSize(pa.size)
+	n, err := patchFile(lom.FQN, pa.r, pa.off, pa.size, buf, lom.IsFeatureSet(feat.FsyncPUT))
+	slab.Free(buf)
+	if n == 0 && err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	// (partially) updated: new size, reset checksum and ETag et al., inc version
+	nsize := max(osize, pa.off+n)
+	lom.SetSize(nsize)
+	lom.SetCksum(cos.NoneCksum)
+	lom.ObjAttrs().DelCustomKeys(cmn.ETag, cmn.MD5ObjMD, cmn.CRC32CObjMD)
+	if lom.VersionConf().Enabled {
+		if errV := lom.IncVersion(); errV != nil {
+			nlog.Errorln(errV) // (unlikely)
+		}
+	}
+	lom.SetAtimeUnix(pa.started)
+	if lom.HasCopies() {
+		if errdc := lom.DelAllCopies(); errdc != nil {
+			nlog.Errorln("PATCH", lom.Cname(), "failed to delete old copies:", errdc)
+		}
+	}
+	pa.t.addQuota(lom, osize, nsize)
+
+	var cksum *cos.Cksum
+	if pa.sync && err == nil {
+		cksum, err = lom.ComputeSetCksum()
+	}
+	if errP := lom.PersistMain(); errP != nil {
+		lom.Uncache()
+		if err == nil {
+			err = errP
+		}
+	}
+	if cksum.IsEmpty() && lom.CksumType() != cos.ChecksumNone {
+		pa.t.patches.add(lom.Uname())
+	} else {
+		pa.t.patches.del(lom.Uname(), 0)
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return cksum, 0, nil
+}
+
+// write exactly `size` bytes from `r` into the existing file at `off`;
+// returns the number of bytes written
+func patchFile(fqn string, r io.Reader, off, size int64, buf []byte, fsync bool) (int64, error) {
+	fh, err := os.OpenFile(fqn, os.O_WRONLY, cos.PermRWR)
+	if err != nil {
+		return 0, err
+	}
+	n, err := cos.CopyBuffer(io.NewOffsetWriter(fh, off), io.LimitReader(r, size), buf)
+	if err == nil && n < size {
+		err = fmt.Errorf("%s: expected %d bytes, received %d", io.ErrUnexpectedEOF, size, n)
+	}
+	if err == nil && fsync {
+		err = fh.Sync()
+	}
+	if errC := fh.Close(); errC != nil && err == nil {
+		err = errC
+	}
+	return n, err
+}
+
+////////////
+// patchq //
+////////////
+
+// load objects that were pending recompute prior to restart (and recompute them in due time)
+func (pq *patchq) init(t *target, config *cmn.Config) {
+	pq.t = t
+	pq.m = make(map[string]int64, 4)
+	if config.ConfigDir == "" {
+		return // (unit tests)
+	}
+	pq.fpath = filepath.Join(config.ConfigDir, fname.PatchPending)
+	var md patchqMD
+	if _, err := jsp.Load(pq.fpath, &md, jsp.CksumSign(patchqMetaver)); err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln(t.String()+":", "failed to load patched objects pending checksum recompute:", err)
+		}
+		return
+	}
+	now := mono.NanoTime()
+	for _, uname := range md.Unames {
+		pq.m[uname] = now
+	}
+}
+
+func (pq *patchq) add(uname string) {
+	pq.mu.Lock()
+	_, ok := pq.m[uname]
+	pq.m[uname] = mono.NanoTime()
+	if !ok {
+		pq.persist()
+	}
+	pq.mu.Unlock()
+}
+
+// remove unless updated since `updated` (zero: remove unconditionally); returns true if removed
+func (pq *patchq) del(uname string, updated int64) bool {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	ts, ok := pq.m[uname]
+	if updated != 0 && ts != updated {
+		return false
+	}
+	if ok {
+		delete(pq.m, uname)
+		pq.persist()
+	}
+	return true
+}
+
+// under lock
+func (pq *patchq) persist() {
+	if pq.fpath == "" {
+		return
+	}
+	md := patchqMD{Unames: make([]string, 0, len(pq.m))}
+	for uname := range pq.m {
+		md.Unames = append(md.Unames, uname)
+	}
+	if err := jsp.Save(pq.fpath, &md, jsp.CksumSign(patchqMetaver), nil); err != nil {
+		nlog.Errorln(pq.t.String()+":", "failed to persist patched objects pending checksum recompute:", err)
+	}
+}
+
+// not updated for at least `delay`
+func (pq *patchq) due(now int64, delay time.Duration) (unames []string, updated []int64) {
+	pq.mu.Lock()
+	for uname, ts := range pq.m {
+		if time.Duration(now-ts) >= delay {
+			unames = append(unames, uname)
+			updated = append(updated, ts)
+		}
+	}
+	pq.mu.Unlock()
+	return unames, updated
+}
+
+func (pq *patchq) housekeep() time.Duration {
+	unames, updated := pq.due(mono.NanoTime(), patchCksumDelay)
+	if len(unames) > 0 && pq.running.CAS(false, true) {
+		go func() {
+			for i, uname := range unames {
+				pq.recompute(uname, updated[i])
+			}
+			pq.running.Store(false)
+		}()
+	}
+	return patchCksumIval
+}
+
+// compute under rlock, store under wlock - unless updated (or deleted) in between
+func (pq *patchq) recompute(uname string, updated int64) {
+	bck, objName := cmn.ParseUname(uname)
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&bck); err != nil {
+		pq.del(uname, updated) // e.g., bucket destroyed
+		return
+	}
+
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil || !lom.Checksum().IsEmpty() {
+		lom.Unlock(false)
+		pq.del(uname, updated) // deleted, overwritten, or validated (and checksummed) by GET
+		return
+	}
+	cksumH, err := lom.ComputeCksum(lom.CksumType())
+	lom.Unlock(false)
+	if err != nil {
+		nlog.Errorln(pq.t.String()+":", "failed to compute checksum of the patched", lom.Cname(), "err:", err)
+		pq.del(uname, updated)
+		return
+	}
+
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if !pq.del(uname, updated) {
+		return // updated again - will recompute later
+	}
+	if cksumH == nil || lom.Load(false, true) != nil || !lom.Checksum().IsEmpty() {
+		return
+	}
+	lom.SetCksum(cksumH.Clone())
+	if err := lom.Persist(); err != nil {
+		nlog.Errorln(pq.t.String()+":", "failed to persist checksum of the patched", lom.Cname(), "err:", err)
+	}
+}
@@ -0,0 +1,92 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestPatchFile(t *testing.T) {
+	var (
+		fqn = filepath.Join(t.TempDir(), "ckpt")
+		buf = make([]byte, 4)
+	)
+	tassert.CheckFatal(t, os.WriteFile(fqn, []byte("0123456789"), 0o644))
+
+	tests := []struct {
+		off      int64
+		data     string
+		size     int64
+		expected string
+		fail     bool
+	}{
+		{off: 0, data: "ab", size: 2, expected: "ab23456789"},
+		{off: 4, data: "cdefgh", size: 6, expected: "ab23cdefgh"},
+		{off: 8, data: "XYZW", size: 4, expected: "ab23cdefXYZW"},  // extends
+		{off: 12, data: "++", size: 2, expected: "ab23cdefXYZW++"}, // appends
+		{off: 1, data: "ABCDE", size: 3, expected: "aABCcdefXYZW++"},
+		{off: 0, data: "short", size: 8, expected: "shortdefXYZW++", fail: true},
+	}
+	for _, test := range tests {
+		n, err := patchFile(fqn, strings.NewReader(test.data), test.off, test.size, buf, true)
+		if test.fail {
+			tassert.Errorf(t, err != nil, "expected error writing %d bytes out of %q", test.size, test.data)
+		} else {
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, n == test.size, "expected %d bytes written, got %d", test.size, n)
+		}
+		b, err := os.ReadFile(fqn)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(b, []byte(test.expected)), "offset %d: expected %q, got %q", test.off, test.expected, b)
+	}
+}
+
+func TestPatchQueue(t *testing.T) {
+	const uname = "ais/@#nnn/ckpt"
+	pq := &patchq{}
+	pq.init(&target{}, &cmn.Config{})
+
+	pq.add(uname)
+	updated := pq.m[uname]
+	unames, _ := pq.due(updated+int64(time.Second), patchCksumDelay)
+	tassert.Errorf(t, len(unames) == 0, "not expecting %v to be due", unames)
+	unames, ts := pq.due(updated+int64(patchCksumDelay), patchCksumDelay)
+	tassert.Fatalf(t, len(unames) == 1 && unames[0] == uname && ts[0] == updated, "expecting %q to be due (%v)", uname, unames)
+
+	// updated again in the meantime
+	pq.m[uname] = updated + 1
+	tassert.Errorf(t, !pq.del(uname, updated), "expecting %q to remain pending", uname)
+	tassert.Errorf(t, pq.del(uname, updated+1), "expecting %q to be removed", uname)
+	tassert.Errorf(t, len(pq.m) == 0, "expecting empty queue, got %v", pq.m)
+}
+
+// pending recomputes survive restarts
+func TestPatchQueuePersist(t *testing.T) {
+	const uname = "ais/@#nnn/ckpt"
+	config := &cmn.Config{}
+	config.ConfigDir = t.TempDir()
+
+	pq := &patchq{}
+	pq.init(&target{}, config)
+	pq.add(uname)
+
+	restarted := &patchq{}
+	restarted.init(&target{}, config)
+	_, ok := restarted.m[uname]
+	tassert.Fatalf(t, ok && len(restarted.m) == 1, "expecting %q to remain pending after restart, got %v", uname, restarted.m)
+
+	tassert.Errorf(t, restarted.del(uname, 0), "expecting %q to be removed", uname)
+	restarted = &patchq{}
+	restarted.init(&target{}, config)
+	tassert.Errorf(t, len(restarted.m) == 0, "expecting empty queue after restart, got %v", restarted.m)
+}
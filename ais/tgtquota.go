@@ -0,0 +1,171 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Per-bucket usage quotas (see cmn.QuotaConf):
+// - bucket quota is cluster-wide, and each target enforces its (equal) share of it
+//   given that objects are uniformly distributed across targets (HRW);
+// - local usage is computed on disk in the background: first triggered by the first PUT
+//   (that, along with all PUTs that follow, doesn't wait), and then periodically
+//   (config.LRU.CapacityUpdTime);
+// - in between, local usage gets adjusted by each successful write (PUT, append, archive,
+//   S3 PUT and multipart upload): new object's size or, when overwriting or appending,
+//   the difference between the new and the previous sizes
+//   (deletes are not accounted for - hence, approximate until the next recompute);
+// - soft threshold (quota.soft_pct): PUTs succeed but get counted (stats.QuotaSoftCount)
+//   and periodically logged; hard limit: PUTs fail (stats.ErrQuotaCount).
+
+type (
+	bquota struct {
+		used      atomic.Int64
+		nobj      atomic.Int64 // number of objects (only when quota.max_objects is set)
+		updated   atomic.Int64 // mono time
+		warned    atomic.Int64 // mono time of the last soft-threshold warning
+		computing atomic.Bool
+	}
+	bquotas struct {
+		m sync.Map // BID => *bquota
+	}
+)
+
+// (re)compute local usage in the background (one at a time) when never computed or outdated
+func (q *bquotas) get(bck *meta.Bck) (bq *bquota) {
+	v, _ := q.m.LoadOrStore(bck.Props.BID, &bquota{})
+	bq = v.(*bquota)
+	updated := bq.updated.Load()
+	if updated != 0 && mono.Since(updated) <= cmn.GCO.Get().LRU.CapacityUpdTime.D() {
+		return
+	}
+	if bq.computing.CAS(false, true) {
+		go func() {
+			bq.compute(bck)
+			bq.computing.Store(false)
+		}()
+	}
+	return
+}
+
+func (bq *bquota) compute(bck *meta.Bck) {
+	if bck.Props.Quota.MaxBytes > 0 {
+		size := fs.OnDiskSize(bck.Bucket(), "")
+		bq.used.Store(int64(size))
+	}
+	if bck.Props.Quota.MaxObjects > 0 {
+		bq.nobj.Store(fs.NumObjects(bck.Bucket()))
+	}
+	bq.updated.Store(mono.NanoTime())
+}
+
+// how a given write changes the object (see checkQuota)
+const (
+	qwritePut   = iota // (over)write the entire object with `size` bytes
+	qwriteApnd         // append `size` bytes (including S3 multipart upload parts)
+	qwriteRange        // write in place (PATCH), where `size` is the end of the written range (offset + length)
+)
+
+// returns cmn.ErrQuotaExceeded if writing `size` bytes (or one more object)
+// would exceed this target's share of the bucket quota;
+// also returns the object's current size (-1 if it doesn't exist) - see addQuota
+func (t *target) checkQuota(lom *core.LOM, qwrite int, size int64) (osize int64, _ error) {
+	var (
+		bck = lom.Bck()
+		q   = &bck.Props.Quota
+	)
+	osize = -1
+	if !q.Enabled() {
+		return osize, nil
+	}
+	if lom.Load(false /*cache it*/, false /*locked*/) == nil {
+		osize = lom.Lsize()
+	}
+	bq := t.quotas.get(bck)
+	if bq.updated.Load() == 0 {
+		return osize, nil // not computed yet
+	}
+	var (
+		ntargets = int64(max(t.owner.smap.get().CountActiveTs(), 1))
+		delta    = max(size, 0)
+		soft     bool
+		err      error
+	)
+	switch qwrite {
+	case qwritePut:
+		delta -= max(osize, 0)
+	case qwriteRange: // only growing past the current size counts
+		delta = max(delta-max(osize, 0), 0)
+	}
+	if q.MaxBytes > 0 {
+		limit, used := q.MaxBytes/ntargets, bq.used.Load()
+		if delta > 0 && used+delta > limit {
+			err = cmn.NewErrQuotaExceeded(bck.Cname(""), used, delta, limit, q.MaxBytes)
+		} else {
+			soft = q.SoftExceeded(used+delta, limit)
+		}
+	}
+	if err == nil && q.MaxObjects > 0 && osize < 0 { // (overwriting existing object does not change the count)
+		limit, nobj := max(q.MaxObjects/ntargets, 1), bq.nobj.Load()
+		if nobj+1 <= limit {
+			soft = soft || q.SoftExceeded(nobj+1, limit)
+		} else {
+			err = cmn.NewErrObjQuotaExceeded(bck.Cname(""), nobj, limit, q.MaxObjects)
+		}
+	}
+
+	if err != nil {
+		t.statsT.Inc(stats.ErrQuotaCount)
+		if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+			nlog.Warningln(t.String(), err)
+		}
+		return osize, err
+	}
+	if soft {
+		t.statsT.Inc(stats.QuotaSoftCount)
+		if now := mono.NanoTime(); time.Duration(now-bq.warned.Load()) > cmn.GCO.Get().LRU.CapacityUpdTime.D() {
+			bq.warned.Store(now)
+			nlog.Warningf("%s: bucket %s is above the soft threshold (%d%%) of its quota", t, bck.Cname(""), q.SoftPct)
+		}
+	}
+	return osize, nil
+}
+
+// account for a newly written object of a given size (nsize)
+// or, if osize >= 0, for the size change of an existing one
+func (t *target) addQuota(lom *core.LOM, osize, nsize int64) {
+	if !lom.Bck().Props.Quota.Enabled() {
+		return
+	}
+	if v, ok := t.quotas.m.Load(lom.Bprops().BID); ok {
+		bq := v.(*bquota)
+		bq.used.Add(nsize - max(osize, 0))
+		if osize < 0 {
+			bq.nobj.Inc()
+		}
+	}
+}
+
+// same as above when the new size is not known upfront (e.g., append, archive)
+func (t *target) addQuotaLoad(lom *core.LOM, osize int64) {
+	if !lom.Bck().Props.Quota.Enabled() {
+		return
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err == nil {
+		t.addQuota(lom, osize, lom.Lsize())
+	}
+}
@@ -71,6 +71,10 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 		s3.WriteErr(w, r, cs.Err(), http.StatusInsufficientStorage)
 		return
 	}
+	if err := cs.ErrReserved(config.Space.ReservedPct); err != nil {
+		s3.WriteErr(w, r, err, http.StatusInsufficientStorage)
+		return
+	}
 	bck, err, ecode := meta.InitByNameOnly(items[0], t.owner.bmd)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
@@ -190,6 +194,11 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 			return
 		}
 	}
+	osize, err := t.checkQuota(lom, qwritePut, r.ContentLength)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusInsufficientStorage)
+		return
+	}
 	started := time.Now()
 	lom.SetAtimeUnix(started.UnixNano())
 
@@ -216,6 +225,7 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 		t.FSHC(err, lom.Mountpath(), lom.FQN)
 		s3.WriteErr(w, r, err, ecode)
 	} else {
+		t.addQuota(lom, osize, lom.Lsize())
 		s3.SetEtag(w.Header(), lom)
 	}
 	dpqFree(dpq)
@@ -125,6 +125,11 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	// (the part is yet to become a part of the object - hence, append)
+	if _, err := t.checkQuota(lom, qwriteApnd, r.ContentLength); err != nil {
+		s3.WriteMptErr(w, r, err, http.StatusInsufficientStorage, lom, uploadID)
+		return
+	}
 	// workfile name format: <upload-id>.<part-number>.<obj-name>
 	prefix := uploadID + "." + strconv.FormatInt(int64(partNum), 10)
 	wfqn := fs.CSM.Gen(lom, fs.WorkfileType, prefix)
@@ -261,6 +266,11 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		s3.WriteMptErr(w, r, errN, 0, lom, uploadID)
 		return
 	}
+	osize, errQ := t.checkQuota(lom, qwritePut, size)
+	if errQ != nil {
+		s3.WriteMptErr(w, r, errQ, http.StatusInsufficientStorage, lom, uploadID)
+		return
+	}
 
 	// call s3
 	var (
@@ -370,6 +380,8 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 			return
 		}
 		nlog.Errorf("upload %q: failed to complete %s locally: %v(%d)", uploadID, lom.Cname(), err, ecode)
+	} else {
+		t.addQuota(lom, osize, size)
 	}
 
 	// .7 respond
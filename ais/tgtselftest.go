@@ -0,0 +1,168 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/transport"
+)
+
+// Self-test (apc.ActSelftest): short controlled measurements run on demand, one kind at a time:
+// - disk: fs.Selftest of every available mountpath, in parallel (one goroutine per mountpath);
+// - net:  intra-cluster (data network) transport throughput to each of the other targets,
+//   one peer at a time; the receiving side discards the payload (see recvSelftest).
+// The caller (CLI) is expected to run "net" on one target at a time, to avoid measuring
+// contention between concurrent self-tests.
+// Only one self-test per target can run at any given time.
+
+const (
+	trnameSelftest = "selftest"
+
+	selftestXferSize = 256 * cos.MiB // default bytes to send to each peer
+	selftestObjSize  = cos.MiB
+)
+
+type selftest struct {
+	running sync.Mutex
+}
+
+func (t *target) initSelftest() {
+	if err := transport.Handle(trnameSelftest, recvSelftest); err != nil {
+		nlog.Errorln(t.String(), "failed to register", trnameSelftest, "receive handler:", err)
+	}
+}
+
+func recvSelftest(_ *transport.ObjHdr, objReader io.Reader, err error) error {
+	if err != nil {
+		return err
+	}
+	cos.DrainReader(objReader)
+	return nil
+}
+
+func (t *target) selftest(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var stmsg apc.SelftestMsg
+	if err := cos.MorphMarshal(msg.Value, &stmsg); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	if !t.st.running.TryLock() {
+		t.writeErrStatusf(w, r, http.StatusConflict, "%s: self-test is already running", t)
+		return
+	}
+	defer t.st.running.Unlock()
+
+	var (
+		res     apc.TargetSelftest
+		started = mono.NanoTime()
+	)
+	switch stmsg.What {
+	case apc.SelftestDisk:
+		res.Mpaths = t.selftestDisk(stmsg.MpathSize)
+	case apc.SelftestNet:
+		res.Peers = t.selftestNet(stmsg.XferSize)
+	default:
+		t.writeErrf(w, r, "%s: invalid self-test %q (expecting %q or %q)", t, stmsg.What, apc.SelftestDisk, apc.SelftestNet)
+		return
+	}
+	res.Elapsed = int64(mono.Since(started))
+	nlog.Infoln(t.String()+":", "self-test", stmsg.What, "done in", mono.Since(started))
+	t.writeJSON(w, r, &res, "selftest")
+}
+
+func (*target) selftestDisk(size int64) []apc.MpathSelftest {
+	var (
+		wg       sync.WaitGroup
+		avail, _ = fs.Get()
+		res      = make([]apc.MpathSelftest, len(avail))
+		i        int
+	)
+	for _, mi := range avail {
+		wg.Add(1)
+		go func(mi *fs.Mountpath, i int) {
+			res[i] = mi.Selftest(size)
+			wg.Done()
+		}(mi, i)
+		i++
+	}
+	wg.Wait()
+	return res
+}
+
+func (t *target) selftestNet(size int64) []apc.PeerSelftest {
+	if size <= 0 {
+		size = selftestXferSize
+	}
+	buf := make([]byte, selftestObjSize)
+	if _, err := cryptorand.Read(buf); err != nil {
+		return []apc.PeerSelftest{{ID: t.SID(), Err: err.Error()}}
+	}
+	var (
+		smap   = t.owner.smap.get()
+		client = transport.NewIntraDataClient()
+		res    = make([]apc.PeerSelftest, 0, smap.CountActiveTs())
+	)
+	for _, si := range smap.Tmap {
+		if si.ID() == t.SID() || si.InMaintOrDecomm() {
+			continue
+		}
+		res = append(res, t.selftestPeer(client, si, buf, size))
+	}
+	return res
+}
+
+// send `size` bytes to a given peer, and measure throughput upon stream completion
+func (t *target) selftestPeer(client transport.Client, si *meta.Snode, buf []byte, size int64) (res apc.PeerSelftest) {
+	var (
+		errs   []error
+		mu     sync.Mutex
+		dstURL = si.URL(cmn.NetIntraData) + transport.ObjURLPath(trnameSelftest)
+		cb     = func(_ *transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}
+		extra   = &transport.Extra{Callback: cb, SenderID: t.SID()}
+		started = mono.NanoTime()
+		stream  = transport.NewObjStream(client, dstURL, si.ID(), extra)
+		sent    int64
+	)
+	res.ID = si.ID()
+	for i := 0; sent < size; i++ {
+		obj := &transport.Obj{Reader: io.NopCloser(bytes.NewReader(buf))}
+		obj.Hdr.SID = t.SID()
+		obj.Hdr.ObjName = trnameSelftest + "-" + strconv.Itoa(i)
+		obj.Hdr.ObjAttrs.Size = int64(len(buf))
+		if err := stream.Send(obj); err != nil {
+			break // (error is passed to the callback)
+		}
+		sent += int64(len(buf))
+	}
+	stream.Fin()
+	elapsed := mono.Since(started)
+
+	if len(errs) > 0 {
+		res.Err = fmt.Sprintf("failed to send %d object(s): %v", len(errs), errs[0])
+		return res
+	}
+	res.Bps = int64(float64(sent) / max(elapsed.Seconds(), 1e-6))
+	return res
+}
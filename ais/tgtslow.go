@@ -0,0 +1,70 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// slow-request tracing (config.Log.SlowReq) - see stats/slowreq.go for the phases
+
+// times individual reads (e.g., reading from disk vs. writing into the response)
+type timedReader struct {
+	r       io.Reader
+	elapsed int64
+}
+
+func (tr *timedReader) Read(b []byte) (n int, err error) {
+	started := mono.NanoTime()
+	n, err = tr.r.Read(b)
+	tr.elapsed += mono.SinceNano(started)
+	return n, err
+}
+
+func (goi *getOI) trace(written, delta int64) {
+	threshold := cmn.Rom.SlowReq()
+	total := time.Duration(goi.rdtime + delta)
+	if threshold == 0 || total < threshold {
+		return
+	}
+	stats.AddSlow(&stats.SlowReq{
+		Method:   http.MethodGet,
+		Cname:    goi.lom.Cname(),
+		Time:     goi.atime,
+		Size:     written,
+		Total:    total,
+		Redirect: time.Duration(goi.rdtime),
+		Lock:     time.Duration(goi.lktime),
+		Disk:     time.Duration(goi.dktime),
+		Backend:  time.Duration(goi.rltime),
+		Transmit: time.Duration(goi.txtime),
+	})
+}
+
+func (poi *putOI) trace(size, delta int64) {
+	threshold := cmn.Rom.SlowReq()
+	total := time.Duration(poi.rdtime + delta)
+	if threshold == 0 || total < threshold {
+		return
+	}
+	stats.AddSlow(&stats.SlowReq{
+		Method:   http.MethodPut,
+		Cname:    poi.lom.Cname(),
+		Time:     poi.atime,
+		Size:     size,
+		Total:    total,
+		Redirect: time.Duration(poi.rdtime),
+		Lock:     time.Duration(poi.lktime),
+		Disk:     time.Duration(poi.wrtime),
+		Backend:  time.Duration(poi.rltime),
+		Transmit: time.Duration(poi.rxtime),
+	})
+}
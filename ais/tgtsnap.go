@@ -0,0 +1,382 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Bucket snapshots - target side:
+// - each object (ie., its main replica) gets hard-linked under the snapshot's directory
+//   of the same mountpath: <mountpath>/@ais/<bucket>/%sn/<snapshot>/<object>;
+// - object metadata gets recorded in the per-mountpath manifest (%sn/<snapshot>.json) -
+//   not in xattrs, which are per-inode and, therefore, shared with the live object;
+// - copy-on-write: PUT and APPEND write a new file that then replaces the object,
+//   leaving the snapshot's inode intact; in-place TAR append checks lom.IsShared()
+//   and falls back to copying;
+// - restore: hard-link back, restore metadata, and remove objects created after the snapshot;
+// - limitations:
+//   - writers are expected to be quiesced during create and restore (objects are locked one at a time);
+//   - restore is refused if cluster membership or mountpaths have changed since the snapshot;
+//   - mirrored copies are not restored; erasure-coded buckets are not supported;
+//   - snapshots do not survive bucket rename and get destroyed together with the bucket.
+
+type snapManifest struct {
+	Objs    map[string]*cmn.ObjAttrs `json:"objs"`
+	Created int64                    `json:"created"`
+}
+
+// serializes snapshot operations (all buckets)
+var snapMu sync.Mutex
+
+// POST /v1/buckets/bucket-name {apc.ActCreateSnap | apc.ActRestoreSnap | apc.ActDestroySnap}
+func (t *target) snapact(w http.ResponseWriter, r *http.Request, msg *aisMsg, bck *meta.Bck) {
+	if err := apc.ValidateSnapName(msg.Name); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	var (
+		err   error
+		ecode int
+	)
+	snapMu.Lock()
+	switch msg.Action {
+	case apc.ActCreateSnap:
+		ecode, err = t.createSnap(bck, msg.Name)
+	case apc.ActRestoreSnap:
+		phase, _ := msg.Value.(string)
+		switch phase {
+		case apc.ActBegin:
+			ecode, err = t.validateSnap(bck, msg.Name)
+		case apc.ActCommit:
+			err = t.restoreSnap(bck, msg.Name)
+		default:
+			err = fmt.Errorf("%s %s: invalid phase %q", msg.Action, bck.Cname(msg.Name), phase)
+		}
+	case apc.ActDestroySnap:
+		ecode, err = t.destroySnap(bck, msg.Name)
+	}
+	snapMu.Unlock()
+	if err != nil {
+		t.writeErr(w, r, err, ecode)
+	}
+}
+
+// run the callback for each available mountpath, in parallel
+func snapEach(cb func(mi *fs.Mountpath) error) error {
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		first error
+		avail = fs.GetAvail()
+	)
+	for _, mi := range avail {
+		wg.Add(1)
+		go func(mi *fs.Mountpath) {
+			if err := cb(mi); err != nil {
+				mu.Lock()
+				if first == nil {
+					first = err
+				}
+				mu.Unlock()
+			}
+			wg.Done()
+		}(mi)
+	}
+	wg.Wait()
+	return first
+}
+
+func snapManifestFQN(mi *fs.Mountpath, bck *meta.Bck, name string) string {
+	return mi.MakePathFQN(bck.Bucket(), fs.SnapType, name+".json")
+}
+
+//
+// create
+//
+
+func (t *target) createSnap(bck *meta.Bck, name string) (int, error) {
+	for _, mi := range fs.GetAvail() {
+		if err := cos.Stat(snapManifestFQN(mi, bck, name)); err == nil {
+			return http.StatusConflict, fmt.Errorf("%s: snapshot %s already exists", t, bck.Cname(name))
+		}
+	}
+	created := time.Now().UnixNano()
+	err := snapEach(func(mi *fs.Mountpath) error { return _createSnap(mi, bck, name, created) })
+	if err != nil {
+		t.destroySnap(bck, name) // cleanup
+		return 0, err
+	}
+	return 0, nil
+}
+
+func _createSnap(mi *fs.Mountpath, bck *meta.Bck, name string, created int64) error {
+	var (
+		mf   = &snapManifest{Objs: make(map[string]*cmn.ObjAttrs, 64), Created: created}
+		opts = &fs.WalkOpts{Mi: mi, Bck: *bck.Bucket(), CTs: []string{fs.ObjectType}}
+	)
+	opts.Callback = func(fqn string, de fs.DirEntry) error {
+		if de.IsDir() {
+			return nil
+		}
+		lom := core.AllocLOM("")
+		defer core.FreeLOM(lom)
+		if err := lom.InitFQN(fqn, nil); err != nil {
+			if cmn.IsErrBucketLevel(err) {
+				return err
+			}
+			return nil
+		}
+		if !lom.IsHRW() {
+			return nil // (mirrored copy)
+		}
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+			return nil // (e.g., deleted in the meantime)
+		}
+		sfqn := fs.CSM.Gen(lom, fs.SnapType, name)
+		if err := cos.CreateDir(filepath.Dir(sfqn)); err != nil {
+			return err
+		}
+		if err := os.Link(lom.FQN, sfqn); err != nil {
+			return err
+		}
+		oa := &cmn.ObjAttrs{}
+		oa.CopyFrom(lom.ObjAttrs(), false)
+		mf.Objs[lom.ObjName] = oa
+		return nil
+	}
+	if err := fs.Walk(opts); err != nil {
+		return err
+	}
+	return jsp.Save(snapManifestFQN(mi, bck, name), mf, jsp.CCSign(1), nil)
+}
+
+//
+// restore
+//
+
+func loadSnap(mi *fs.Mountpath, bck *meta.Bck, name string) (*snapManifest, error) {
+	mf := &snapManifest{}
+	if _, err := jsp.Load(snapManifestFQN(mi, bck, name), mf, jsp.CCSign(1)); err != nil {
+		return nil, err
+	}
+	return mf, nil
+}
+
+// (begin) all snapshotted objects must still map to the same mountpaths and to this target
+func (t *target) validateSnap(bck *meta.Bck, name string) (int, error) {
+	var (
+		smap  = t.owner.smap.get()
+		avail = fs.GetAvail()
+		cnt   int
+	)
+	for _, mi := range avail {
+		if err := cos.Stat(snapManifestFQN(mi, bck, name)); err == nil {
+			cnt++
+		}
+	}
+	if cnt == 0 {
+		return http.StatusNotFound, cos.NewErrNotFound(t, "snapshot "+bck.Cname(name))
+	}
+	if cnt < len(avail) {
+		return 0, fmt.Errorf("%s: cannot restore snapshot %s - mountpaths have changed", t, bck.Cname(name))
+	}
+	err := snapEach(func(mi *fs.Mountpath) error {
+		mf, err := loadSnap(mi, bck, name)
+		if err != nil {
+			return err
+		}
+		for objName := range mf.Objs {
+			if err := t._validateSnap(mi, bck, name, objName, smap); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return 0, err
+}
+
+func (t *target) _validateSnap(mi *fs.Mountpath, bck *meta.Bck, name, objName string, smap *smapX) error {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return err
+	}
+	if lom.Mountpath().Path != mi.Path {
+		return fmt.Errorf("%s: cannot restore snapshot %s - mountpaths have changed (%s)", t, bck.Cname(name), lom)
+	}
+	if _, local, err := lom.HrwTarget(&smap.Smap); err != nil || !local {
+		return fmt.Errorf("%s: cannot restore snapshot %s - cluster membership has changed (%s)", t, bck.Cname(name), lom)
+	}
+	if err := cos.Stat(fs.CSM.Gen(lom, fs.SnapType, name)); err != nil {
+		return fmt.Errorf("%s: snapshot %s is incomplete (%s): %w", t, bck.Cname(name), lom, err)
+	}
+	return nil
+}
+
+// (commit)
+func (t *target) restoreSnap(bck *meta.Bck, name string) error {
+	return snapEach(func(mi *fs.Mountpath) error {
+		mf, err := loadSnap(mi, bck, name)
+		if err != nil {
+			return err
+		}
+		for objName, oa := range mf.Objs {
+			if err := restoreObj(bck, name, objName, oa); err != nil {
+				return err
+			}
+		}
+		return rmNewer(mi, bck, mf)
+	})
+}
+
+func restoreObj(bck *meta.Bck, name, objName string, oa *cmn.ObjAttrs) error {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return err
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	exists := lom.Load(false /*cache it*/, true /*locked*/) == nil
+
+	var (
+		sfqn = fs.CSM.Gen(lom, fs.SnapType, name)
+		wfqn = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileSnapRestore)
+	)
+	if err := cos.CreateDir(filepath.Dir(wfqn)); err != nil {
+		return err
+	}
+	if err := os.Link(sfqn, wfqn); err != nil {
+		return err
+	}
+	if err := lom.RenameFinalize(wfqn); err != nil {
+		cos.RemoveFile(wfqn)
+		return err
+	}
+	if exists && lom.HasCopies() {
+		if err := lom.DelAllCopies(); err != nil {
+			nlog.Errorf("restore %s: failed to delete copies [%v], proceeding anyway...", lom, err)
+		}
+	}
+	lom.SetCustomMD(nil)
+	lom.CopyAttrs(oa, false)
+	if lom.AtimeUnix() == 0 {
+		lom.SetAtimeUnix(time.Now().UnixNano())
+	}
+	return lom.PersistMain()
+}
+
+// remove objects that were created after the snapshot
+func rmNewer(mi *fs.Mountpath, bck *meta.Bck, mf *snapManifest) error {
+	opts := &fs.WalkOpts{Mi: mi, Bck: *bck.Bucket(), CTs: []string{fs.ObjectType}}
+	opts.Callback = func(fqn string, de fs.DirEntry) error {
+		if de.IsDir() {
+			return nil
+		}
+		lom := core.AllocLOM("")
+		defer core.FreeLOM(lom)
+		if err := lom.InitFQN(fqn, nil); err != nil {
+			if cmn.IsErrBucketLevel(err) {
+				return err
+			}
+			return nil
+		}
+		if _, ok := mf.Objs[lom.ObjName]; ok || !lom.IsHRW() {
+			return nil
+		}
+		lom.Lock(true)
+		defer lom.Unlock(true)
+		if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+			return nil
+		}
+		if err := lom.RemoveObj(); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return fs.Walk(opts)
+}
+
+//
+// destroy and list
+//
+
+func (t *target) destroySnap(bck *meta.Bck, name string) (int, error) {
+	var (
+		found bool
+		mu    sync.Mutex
+	)
+	err := snapEach(func(mi *fs.Mountpath) error {
+		mfqn := snapManifestFQN(mi, bck, name)
+		if err := cos.Stat(mfqn); err == nil {
+			mu.Lock()
+			found = true
+			mu.Unlock()
+		}
+		if err := os.RemoveAll(mi.MakePathFQN(bck.Bucket(), fs.SnapType, name)); err != nil {
+			return err
+		}
+		if err := os.Remove(mfqn); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	})
+	if err == nil && !found {
+		return http.StatusNotFound, cos.NewErrNotFound(t, "snapshot "+bck.Cname(name))
+	}
+	return 0, err
+}
+
+// GET /v1/buckets/bucket-name {apc.ActListSnaps}
+func (t *target) listSnaps(w http.ResponseWriter, r *http.Request, bck *meta.Bck) {
+	all := make(map[string]*apc.SnapInfo, 4)
+	for _, mi := range fs.GetAvail() {
+		fqns, err := filepath.Glob(filepath.Join(mi.MakePathCT(bck.Bucket(), fs.SnapType), "*.json"))
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		for _, fqn := range fqns {
+			name := strings.TrimSuffix(filepath.Base(fqn), ".json")
+			mf, err := loadSnap(mi, bck, name)
+			if err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
+			info, ok := all[name]
+			if !ok {
+				info = &apc.SnapInfo{Name: name, Created: mf.Created, NumTargets: 1}
+				all[name] = info
+			}
+			for _, oa := range mf.Objs {
+				info.ObjCount++
+				info.Size += oa.Size
+			}
+		}
+	}
+	out := make([]*apc.SnapInfo, 0, len(all))
+	for _, info := range all {
+		out = append(out, info)
+	}
+	t.writeJSON(w, r, out, apc.ActListSnaps)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/nl"
@@ -29,10 +30,16 @@ const (
 	// - compare with cmn/cos/oom
 	// - compare with fs/health/fshc
 	minAutoDetectInterval = 10 * time.Minute
+
+	// within maintenance window, run directory defragmentation at most once a day
+	defragAutoInterval = 24 * time.Hour
+	defragCheckIval    = 10 * time.Minute
 )
 
 var (
-	lastTrigOOS atomic.Int64
+	lastTrigOOS    atomic.Int64
+	lastTrigEarly  atomic.Int64
+	lastTrigDefrag atomic.Int64
 )
 
 // triggers by an out-of-space condition or a suspicion of thereof
@@ -56,7 +63,10 @@ func (t *target) OOS(csRefreshed *fs.CapStatus, config *cmn.Config, tcdf *fs.Tcd
 		}
 	}
 	if errCap == nil {
-		return // unlikely; nothing to do
+		if csRefreshed != nil && cs.EvictEarly(config) {
+			t.evictEarly(&cs)
+		}
+		return
 	}
 	if prev := lastTrigOOS.Load(); mono.Since(prev) < minAutoDetectInterval {
 		nlog.Warningf("%s: _not_ running store cleanup: (%v, %v), %s", t, prev, minAutoDetectInterval, cs.String())
@@ -75,13 +85,25 @@ func (t *target) OOS(csRefreshed *fs.CapStatus, config *cmn.Config, tcdf *fs.Tcd
 		lastTrigOOS.Store(mono.NanoTime())
 		if cs.Err() != nil {
 			nlog.Warningln(t.String(), "still out of space, running LRU eviction now:", cs.String())
-			t.runLRU("" /*uuid*/, nil /*wg*/, false)
+			t.runLRU("" /*uuid*/, nil /*wg*/, false /*force*/, false /*early*/)
 		}
 	}()
 	return
 }
 
-func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, bcks ...cmn.Bck) {
+// high watermark is predicted to be exceeded soon (see fs.CapPredict)
+func (t *target) evictEarly(cs *fs.CapStatus) {
+	if prev := lastTrigEarly.Load(); mono.Since(prev) < minAutoDetectInterval {
+		return
+	}
+	lastTrigEarly.Store(mono.NanoTime())
+	rate, eta := fs.CapPredict()
+	nlog.Warningln(t.String(), "running early LRU eviction: ingest rate", cos.ToSizeIEC(rate, 1)+"/s,",
+		"high-wm predicted in", eta.Round(time.Second), cs.String())
+	go t.runLRU("" /*uuid*/, nil /*wg*/, false /*force*/, true /*early*/)
+}
+
+func (t *target) runLRU(id string, wg *sync.WaitGroup, force, early bool, bcks ...cmn.Bck) {
 	regToIC := id == ""
 	if regToIC {
 		id = cos.GenUUID()
@@ -110,6 +132,7 @@ func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, bcks ...cmn.B
 		GetFSStats:          ios.GetFSStats,
 		WG:                  wg,
 		Force:               force,
+		Early:               early,
 	}
 	xlru.AddNotif(&xact.NotifXact{
 		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
@@ -151,3 +174,72 @@ func (t *target) runStoreCleanup(id string, wg *sync.WaitGroup, bcks ...cmn.Bck)
 	})
 	return space.RunCleanup(&ini)
 }
+
+func (t *target) runDefrag(id string, wg *sync.WaitGroup, bcks ...cmn.Bck) {
+	regToIC := id == ""
+	if regToIC {
+		id = cos.GenUUID()
+	}
+	rns := xreg.RenewDefragDirs(id)
+	if rns.Err != nil || rns.IsRunning() {
+		debug.Assert(rns.Err == nil || cmn.IsErrXactUsePrev(rns.Err))
+		if wg != nil {
+			wg.Done()
+		}
+		return
+	}
+	xdfg := rns.Entry.Get()
+	if regToIC && xdfg.ID() == id {
+		// pre-existing UUID: notify IC members
+		regMsg := xactRegMsg{UUID: id, Kind: apc.ActDefragDirs, Srcs: []string{t.SID()}}
+		msg := t.newAmsgActVal(apc.ActRegGlobalXaction, regMsg)
+		t.bcastAsyncIC(msg)
+	}
+	ini := space.IniDfg{
+		Xaction: xdfg.(*space.XactDfg),
+		Config:  cmn.GCO.Get(),
+		Buckets: bcks,
+		WG:      wg,
+	}
+	xdfg.AddNotif(&xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xdfg,
+	})
+	space.RunDefrag(&ini)
+}
+
+// (housekeeping) directory defragmentation - automatically, within maintenance window
+// or, when no window is configured, when all mountpaths are idle
+func (t *target) defragHK() time.Duration {
+	config := cmn.GCO.Get()
+	switch config.Housekeep.Pace(time.Now()) {
+	case cmn.HkPaceMax:
+	case cmn.HkPaceDefault:
+		for _, mi := range fs.GetAvail() {
+			if !mi.IsIdle(config) {
+				return defragCheckIval
+			}
+		}
+	default:
+		return defragCheckIval
+	}
+	if prev := lastTrigDefrag.Load(); prev != 0 && mono.Since(prev) < defragAutoInterval {
+		return defragCheckIval
+	}
+	lastTrigDefrag.Store(mono.NanoTime())
+	go t.runDefrag("" /*uuid*/, nil /*wg*/)
+	return defragCheckIval
+}
+
+// (housekeeping) periodic capacity snapshot - see fs/capfcast.go
+func (t *target) capSnap() time.Duration {
+	var (
+		bmd  = t.owner.bmd.get()
+		bcks = make([]*cmn.Bck, 0, 8)
+	)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		bcks = append(bcks, bck.Bucket())
+		return false
+	})
+	return fs.TakeCapSnap(cmn.GCO.Get(), bcks)
+}
@@ -1066,6 +1066,7 @@ func (t *target) prmNumFiles(c *txnSrv, txnPrm *txnPromote, confirmedFshare bool
 			PromoteArgs: apc.PromoteArgs{
 				SrcFQN:       fqn,
 				ObjName:      objName,
+				Overwrite:    txnPrm.msg.OwPolicy(),
 				OverwriteDst: txnPrm.msg.OverwriteDst,
 				DeleteSrc:    txnPrm.msg.DeleteSrc,
 			},
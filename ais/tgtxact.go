@@ -132,6 +132,12 @@ func (t *target) httpxput(w http.ResponseWriter, r *http.Request) {
 			t.writeErr(w, r, err)
 			return
 		}
+		if xargs.MaxRuntime > 0 && xid != "" {
+			if err := xreg.SetDeadline(xid, xargs.MaxRuntime); err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
+		}
 		if l := len(xid); l > 0 {
 			w.Header().Set(cos.HdrContentLength, strconv.Itoa(l))
 			w.Write([]byte(xid))
@@ -144,6 +150,21 @@ func (t *target) httpxput(w http.ResponseWriter, r *http.Request) {
 		}
 		flt := xreg.Flt{ID: xargs.ID, Kind: xargs.Kind, Bck: bck}
 		xreg.DoAbort(flt, err)
+	case apc.ActXactGC:
+		if xargs.ID != "" {
+			if xctn, err := xreg.GetXact(xargs.ID); err == nil && xctn != nil && !xctn.Finished() {
+				t.writeErrStatusf(w, r, http.StatusConflict, "%s: cannot remove %s - still running", t, xctn.Name())
+				return
+			}
+		}
+		n := xreg.GC(xargs.ID, xargs.Kind, xargs.OlderThan)
+		if n > 0 {
+			nlog.Infoln(t.String(), msg.Action, xargs.ID, xargs.Kind, "removed:", n)
+		}
+	case apc.ActXactDeadline:
+		if err := xreg.SetDeadline(xargs.ID, xargs.MaxRuntime); err != nil {
+			t.writeErr(w, r, err)
+		}
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -202,13 +223,22 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 		}
 		wg := &sync.WaitGroup{}
 		wg.Add(1)
-		go t.runLRU(args.ID, wg, args.Force, args.Buckets...)
+		go t.runLRU(args.ID, wg, args.Force, false /*early*/, args.Buckets...)
 		wg.Wait()
 	case apc.ActStoreCleanup:
 		wg := &sync.WaitGroup{}
 		wg.Add(1)
 		go t.runStoreCleanup(args.ID, wg, args.Buckets...)
 		wg.Wait()
+	case apc.ActDefragDirs:
+		bcks := args.Buckets
+		if bck != nil {
+			bcks = append(bcks, *bck.Bucket())
+		}
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+		go t.runDefrag(args.ID, wg, bcks...)
+		wg.Wait()
 	case apc.ActResilver:
 		if bck != nil {
 			nlog.Errorf(erfmb, args.Kind, bck)
@@ -229,7 +259,13 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 		go t.runResilver(res.Args{UUID: args.ID, Notif: notif}, wg)
 		wg.Wait()
 	case apc.ActLoadLomCache:
-		rns := xreg.RenewBckLoadLomCache(args.ID, bck)
+		rns := xreg.RenewBckLoadLomCache(args.ID, bck, args.Verify)
+		return xid, rns.Err
+	case apc.ActCksumMigrate:
+		rns := xreg.RenewCksumMigrate(args.ID, bck)
+		return xid, rns.Err
+	case apc.ActECRestore:
+		rns := xreg.RenewECRestore(args.ID, bck)
 		return xid, rns.Err
 	case apc.ActBlobDl:
 		debug.Assert(msg.Name != "")
@@ -249,7 +285,7 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 	// 3. cannot start
 	case apc.ActPutCopies:
 		return xid, fmt.Errorf("cannot start %q (is driven by PUTs into a mirrored bucket)", args)
-	case apc.ActDownload, apc.ActEvictObjects, apc.ActDeleteObjects, apc.ActMakeNCopies, apc.ActECEncode:
+	case apc.ActDownload, apc.ActEvictObjects, apc.ActDeleteObjects, apc.ActRenameObjects, apc.ActMakeNCopies, apc.ActECEncode:
 		return xid, fmt.Errorf("initiating %q must be done via a separate documented API", args)
 	// 4. unknown
 	case "":
@@ -0,0 +1,78 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// distributed tracing (config.Tracing) - see stats/tracing.go
+
+// (proxy) continue the caller's trace or start a new one, and pass the context to the target
+func (*proxy) traceRedirect(r *http.Request, name, redirectURL string, bck *meta.Bck, objName string,
+	tsi *meta.Snode) (*stats.Span, string) {
+	tp := r.Header.Get(cos.HdrTraceparent)
+	span := stats.StartSpan(&cmn.GCO.Get().Tracing, name, tp)
+	if span != nil {
+		span.Set("ais.bucket", bck.Cname(""))
+		span.Set("ais.object", objName)
+		span.Set("ais.target", tsi.ID())
+		tp = span.Traceparent()
+	}
+	if tp == "" {
+		return nil, redirectURL
+	}
+	return span, redirectURL + "&" + apc.QparamTraceparent + "=" + url.QueryEscape(tp)
+}
+
+// (target) redirected requests carry the proxy's context in the query; direct ones - in the header
+func (*target) startSpan(r *http.Request, dpq *dpq, config *cmn.Config, name string) (span *stats.Span) {
+	if !config.Tracing.Enabled {
+		return nil
+	}
+	if dpq.ptime != "" {
+		span = stats.ContinueSpan(&config.Tracing, name, dpq.traceparent)
+	} else {
+		span = stats.StartSpan(&config.Tracing, name, r.Header.Get(cos.HdrTraceparent))
+	}
+	return span
+}
+
+func (goi *getOI) endSpan(err error) {
+	span := goi.span
+	if span == nil {
+		return
+	}
+	span.Set("ais.object", goi.lom.Cname())
+	span.Set("ais.size", goi.lom.Lsize(true))
+	span.Set("ais.cold", goi.cold)
+	span.Set("ais.lock.ns", time.Duration(goi.lktime))
+	span.Set("ais.disk.ns", time.Duration(goi.dktime))
+	span.Set("ais.transmit.ns", time.Duration(goi.txtime))
+	span.End(err)
+}
+
+func (poi *putOI) endSpan(span *stats.Span, err error) {
+	if span == nil {
+		return
+	}
+	span.Set("ais.object", poi.lom.Cname())
+	span.Set("ais.size", poi.size)
+	span.Set("ais.lock.ns", time.Duration(poi.lktime))
+	span.Set("ais.disk.ns", time.Duration(poi.wrtime))
+	span.Set("ais.receive.ns", time.Duration(poi.rxtime))
+	if poi.rltime > 0 {
+		span.Set("ais.backend.ns", time.Duration(poi.rltime))
+	}
+	span.End(err)
+}
@@ -46,36 +46,42 @@ type (
 	}
 
 	// Local unicast IP info
-	localIPv4Info struct {
-		ipv4 string
-		mtu  int
+	localIPInfo struct {
+		ip    string
+		iface string // network interface name
+		mtu   int
+		ipv6  bool
 	}
 )
 
 func (na netAccess) isSet(flag netAccess) bool { return na&flag == flag }
 
-func (addr *localIPv4Info) String() string {
-	return fmt.Sprintf("IP: %s (MTU %d)", addr.ipv4, addr.mtu)
+func (addr *localIPInfo) String() string {
+	return fmt.Sprintf("IP: %s (%s, MTU %d)", addr.ip, addr.iface, addr.mtu)
 }
 
-func (addr *localIPv4Info) warn() {
+func (addr *localIPInfo) warn() {
 	if addr.mtu <= 1500 {
 		nlog.Warningln("Warning: small MTU")
 	}
 }
 
 //
-// IPV4
+// local unicast IPs
 //
 
-// returns a list of local unicast (IPv4, MTU)
-func getLocalIPv4s(config *cmn.Config) (addrlist []*localIPv4Info, err error) {
-	addrlist = make([]*localIPv4Info, 0, 4)
-
-	addrs, e := net.InterfaceAddrs()
-	if e != nil {
-		err = fmt.Errorf("failed to get host unicast IPs: %w", e)
-		return
+// returns a list of local unicast (IP, MTU) given:
+// - config.HostNet.IPFamily: IPv4 (default), IPv6, or both ("dual", in which case IPv4 addresses go first);
+// - config.HostNet.Interfaces: optional comma-separated list of network interfaces to select from
+// (IPv6 link-local addresses are always excluded - they require zone and are not routable)
+func getLocalIPs(config *cmn.Config) (addrlist []*localIPInfo, err error) {
+	var (
+		family = config.HostNet.IPFamily
+		ifsel  cos.StrSet
+		ipv6s  []*localIPInfo
+	)
+	if config.HostNet.Interfaces != "" {
+		ifsel = cos.NewStrSet(strings.Split(config.HostNet.Interfaces, cmn.HostnameListSepa)...)
 	}
 	iflist, e := net.Interfaces()
 	if e != nil {
@@ -83,52 +89,75 @@ func getLocalIPv4s(config *cmn.Config) (addrlist []*localIPv4Info, err error) {
 		return
 	}
 
-	for _, addr := range addrs {
-		curr := &localIPv4Info{}
-		if ipnet, ok := addr.(*net.IPNet); ok {
-			if ipnet.IP.IsLoopback() {
-				// K8s: always exclude 127.0.0.1 loopback
+	addrlist = make([]*localIPInfo, 0, 4)
+	for _, intf := range iflist {
+		if ifsel != nil && !ifsel.Contains(intf.Name) {
+			continue
+		}
+		if intf.Flags&net.FlagUp == 0 {
+			continue
+		}
+		ifAddrs, e := intf.Addrs()
+		// skip invalid interfaces
+		if e != nil {
+			continue
+		}
+		for _, ifAddr := range ifAddrs {
+			ipnet, ok := ifAddr.(*net.IPNet)
+			if !ok {
+				continue
+			}
+			ip := ipnet.IP
+			if ip.IsLoopback() {
+				// K8s: always exclude 127.0.0.1 (::1) loopback
 				if k8s.IsK8s() {
 					continue
 				}
 				// non K8s and fspaths:
 				if !config.TestingEnv() {
 					if excludeLoopbackIP() {
-						if ipnet.IP.To4() != nil {
-							nlog.Warningln("(non-K8s, fspaths) deployment: excluding loopback IP:", ipnet.IP)
+						if ip.To4() != nil {
+							nlog.Warningln("(non-K8s, fspaths) deployment: excluding loopback IP:", ip)
 						}
 						continue
 					}
 				}
 			}
-			if ipnet.IP.To4() == nil {
-				continue
-			}
-			curr.ipv4 = ipnet.IP.String()
-		}
-
-		for _, intf := range iflist {
-			ifAddrs, e := intf.Addrs()
-			// skip invalid interfaces
-			if e != nil {
+			curr := &localIPInfo{ip: ip.String(), iface: intf.Name, mtu: intf.MTU}
+			switch {
+			case ip.To4() != nil:
+				if family == cmn.IPFamilyV6 {
+					continue
+				}
+				addrlist = append(addrlist, curr)
+			case ip.IsLinkLocalUnicast():
 				continue
-			}
-			for _, ifAddr := range ifAddrs {
-				if ipnet, ok := ifAddr.(*net.IPNet); ok && ipnet.IP.To4() != nil && ipnet.IP.String() == curr.ipv4 {
-					curr.mtu = intf.MTU
-					addrlist = append(addrlist, curr)
-					break
+			default:
+				if family != cmn.IPFamilyV6 && family != cmn.IPFamilyDual {
+					continue
 				}
-			}
-			if curr.mtu != 0 {
-				break
+				curr.ipv6 = true
+				ipv6s = append(ipv6s, curr)
 			}
 		}
 	}
+	addrlist = append(addrlist, ipv6s...)
+
 	if len(addrlist) == 0 {
-		return addrlist, errors.New("the host does not have any IPv4 addresses")
+		var s string
+		if ifsel != nil {
+			s = " on interface(s) " + config.HostNet.Interfaces
+		}
+		switch family {
+		case cmn.IPFamilyV6:
+			err = errors.New("the host does not have any IPv6 addresses" + s)
+		case cmn.IPFamilyDual:
+			err = errors.New("the host does not have any IP addresses" + s)
+		default:
+			err = errors.New("the host does not have any IPv4 addresses" + s)
+		}
 	}
-	return addrlist, nil
+	return addrlist, err
 }
 
 // HACK, to accommodate non-K8s docker deployments and non-containerized
@@ -139,12 +168,12 @@ func excludeLoopbackIP() bool {
 	return true
 }
 
-// given configured list of hostnames, return the first one matching local unicast IPv4
-func _selectHost(locIPs []*localIPv4Info, hostnames []string) (string, error) {
+// given configured list of hostnames, return the first one matching local unicast IP
+func _selectHost(locIPs []*localIPInfo, hostnames []string) (string, *localIPInfo, error) {
 	sb := &strings.Builder{}
 	sb.WriteByte('[')
 	for i, lip := range locIPs {
-		sb.WriteString(lip.ipv4)
+		sb.WriteString(lip.ip)
 		sb.WriteString("(MTU=")
 		sb.WriteString(strconv.Itoa(lip.mtu))
 		sb.WriteByte(')')
@@ -153,93 +182,97 @@ func _selectHost(locIPs []*localIPv4Info, hostnames []string) (string, error) {
 		}
 	}
 	sb.WriteByte(']')
-	nlog.Infoln("local IPv4:", sb.String())
+	nlog.Infoln("local IPs:", sb.String())
 	nlog.Infoln("configured:", hostnames)
 
 	for i, host := range hostnames {
 		host = strings.TrimSpace(host)
-		var ipv4 string
-		if net.ParseIP(host) != nil { // parses as IP
-			ipv4 = host
+		var ipaddr string
+		if ip := net.ParseIP(host); ip != nil { // parses as IP
+			ipaddr = ip.String() // (canonical IPv6 form)
 		} else {
 			ip, err := cmn.Host2IP(host)
 			if err != nil {
 				nlog.Errorln("failed to resolve hostname(?)", host, "err:", err, "[idx:", i, len(hostnames))
 				continue
 			}
-			ipv4 = ip.String()
-			nlog.Infoln("resolved hostname", host, "to IP addr", ipv4)
+			ipaddr = ip.String()
+			nlog.Infoln("resolved hostname", host, "to IP addr", ipaddr)
 		}
 		for _, addr := range locIPs {
-			if addr.ipv4 == ipv4 {
-				nlog.Infoln("selected: hostname", host, "IP", ipv4)
-				return host, nil
+			if addr.ip == ipaddr {
+				nlog.Infoln("selected: hostname", host, "IP", ipaddr, "interface", addr.iface)
+				return host, addr, nil
 			}
 		}
 	}
 
 	err := fmt.Errorf("failed to select hostname from: (%s, %v)", sb.String(), hostnames)
 	nlog.Errorln(err)
-	return "", err
+	return "", nil, err
 }
 
-// given a list of local IPv4s return the best fit to listen on
-func _localIP(addrList []*localIPv4Info) (ip net.IP, _ error) {
+// given a list of local IPs return the best fit to listen on
+func _localIP(addrList []*localIPInfo) (*localIPInfo, error) {
 	l := len(addrList)
 	if l == 0 {
 		return nil, errors.New("no unicast addresses to choose from")
 	}
 
 	if l == 1 {
-		if ip = net.ParseIP(addrList[0].ipv4); ip == nil {
-			return nil, fmt.Errorf(fmtErrParseIP, addrList[0].ipv4)
+		if ip := net.ParseIP(addrList[0].ip); ip == nil {
+			return nil, fmt.Errorf(fmtErrParseIP, addrList[0].ip)
 		}
 		nlog.Infoln("Found a single", addrList[0].String())
 		addrList[0].warn()
-		return ip, nil
+		return addrList[0], nil
 	}
 
 	// NOTE:
 	// - try using environment to eliminate ambiguity
 	// - env.AIS.PubIPv4CIDR ("AIS_PUBLIC_IP_CIDR") takes precedence
-	var (
-		selected     = -1
-		parsed       net.IP
-		network, err = _parseCIDR(env.AIS.LocalRedirectCIDR, env.AIS.PubIPv4CIDR)
-	)
+	network, err := _parseCIDR(env.AIS.LocalRedirectCIDR, env.AIS.PubIPv4CIDR)
 	if err != nil {
 		return nil, err
 	}
-	if network == nil {
-		goto warn
-	}
-	for j := range l {
-		if ip = net.ParseIP(addrList[j].ipv4); ip == nil {
-			return nil, fmt.Errorf(fmtErrParseIP, addrList[0].ipv4)
+	if network != nil {
+		selected, err := _cidrIP(addrList, network)
+		if err != nil {
+			return nil, err
 		}
-		if network.Contains(ip) {
-			if selected >= 0 {
-				return nil, fmt.Errorf("CIDR network %s contains multiple local unicast IPs: %s and %s",
-					network, addrList[selected].ipv4, addrList[j].ipv4)
-			}
-			selected, parsed = j, ip
+		if selected != nil {
+			nlog.Infoln("CIDR network", network.String(), "contains a single local unicast IP:", selected.ip)
+			selected.warn()
+			return selected, nil
 		}
-	}
-	if selected < 0 {
 		nlog.Warningln("CIDR network", network.String(), "does not contain any local unicast IPs")
-		goto warn
 	}
-	nlog.Infoln("CIDR network", network.String(), "contains a single local unicast IP:", addrList[selected].ipv4)
-	addrList[selected].warn()
-	return parsed, nil
 
-warn:
-	if ip = net.ParseIP(addrList[0].ipv4); ip == nil {
-		return nil, fmt.Errorf(fmtErrParseIP, addrList[0].ipv4)
+	if ip := net.ParseIP(addrList[0].ip); ip == nil {
+		return nil, fmt.Errorf(fmtErrParseIP, addrList[0].ip)
 	}
 	nlog.Warningln("given multiple choice, selecting the first", addrList[0].String())
 	addrList[0].warn()
-	return ip, nil
+	return addrList[0], nil
+}
+
+// return the one and only local unicast IP that belongs to a given CIDR network (nil if none)
+func _cidrIP(addrList []*localIPInfo, network *net.IPNet) (selected *localIPInfo, _ error) {
+	for _, addr := range addrList {
+		ip := net.ParseIP(addr.ip)
+		if ip == nil {
+			return nil, fmt.Errorf(fmtErrParseIP, addr.ip)
+		}
+		if !network.Contains(ip) {
+			continue
+		}
+		if selected != nil {
+			return nil, fmt.Errorf("CIDR network %s contains multiple local unicast IPs: %s and %s",
+				network, selected.ip, addr.ip)
+		}
+		selected = addr
+	}
+	return selected, nil
 }
 
 func _parseCIDR(name, name2 string) (*net.IPNet, error) {
@@ -259,45 +292,75 @@ func _parseCIDR(name, name2 string) (*net.IPNet, error) {
 	return network, nil
 }
 
-func multihome(configuredIPv4s string) (pub string, extra []string) {
-	if i := strings.IndexByte(configuredIPv4s, cmn.HostnameListSepa[0]); i <= 0 {
-		cos.ExitAssertLog(i < 0, "invalid format:", configuredIPv4s)
-		return configuredIPv4s, nil
+func multihome(configuredIPs string) (pub string, extra []string) {
+	if i := strings.IndexByte(configuredIPs, cmn.HostnameListSepa[0]); i <= 0 {
+		cos.ExitAssertLog(i < 0, "invalid format:", configuredIPs)
+		return configuredIPs, nil
 	}
 
 	// trim + validation
-	lst := strings.Split(configuredIPv4s, cmn.HostnameListSepa)
+	lst := strings.Split(configuredIPs, cmn.HostnameListSepa)
 	pub, extra = strings.TrimSpace(lst[0]), lst[1:]
 	for i := range extra {
 		extra[i] = strings.TrimSpace(extra[i])
-		cos.ExitAssertLog(extra[i] != "", "invalid format (empty value):", configuredIPv4s)
-		cos.ExitAssertLog(extra[i] != pub, "duplicated addr or hostname:", configuredIPv4s)
+		cos.ExitAssertLog(extra[i] != "", "invalid format (empty value):", configuredIPs)
+		cos.ExitAssertLog(extra[i] != pub, "duplicated addr or hostname:", configuredIPs)
 		for j := range i {
-			cos.ExitAssertLog(extra[i] != extra[j], "duplicated addr or hostname:", configuredIPv4s)
+			cos.ExitAssertLog(extra[i] != extra[j], "duplicated addr or hostname:", configuredIPs)
 		}
 	}
 	nlog.Infof("multihome: %s and %v", pub, extra)
 	return pub, extra
 }
 
-// choose one of the local IPv4s if local config doesn't contain (explicitly) specified
-func initNetInfo(ni *meta.NetInfo, addrList []*localIPv4Info, proto, configuredIPv4s, port string) (err error) {
-	var (
-		ip   net.IP
-		host string
-	)
-	if configuredIPv4s == "" {
-		if ip, err = _localIP(addrList); err == nil {
-			ni.Init(proto, ip.String(), port)
+// choose one of the local IPs, in the order of precedence:
+// 1. configured hostname(s) - see _selectHost;
+// 2. configured CIDR - the one and only local IP that belongs to it;
+// 3. the best fit - see _localIP
+// returns the selected local IP and how it was selected (apc.NetSel* enum)
+func initNetInfo(ni *meta.NetInfo, addrList []*localIPInfo, proto, configuredIPs, cidr, port string) (*localIPInfo, string, error) {
+	switch {
+	case configuredIPs != "":
+		lst := strings.Split(configuredIPs, cmn.HostnameListSepa)
+		host, addr, err := _selectHost(addrList, lst)
+		if err != nil {
+			return nil, "", err
 		}
-		return
+		ni.Init(proto, host, port)
+		return addr, apc.NetSelHostname, nil
+	case cidr != "":
+		_, network, err := net.ParseCIDR(cidr) // (validated by LocalNetConfig.Validate)
+		if err != nil {
+			return nil, "", err
+		}
+		addr, err := _cidrIP(addrList, network)
+		if err != nil {
+			return nil, "", err
+		}
+		if addr == nil {
+			return nil, "", fmt.Errorf("CIDR network %s does not contain any local unicast IPs %v", network, addrList)
+		}
+		nlog.Infoln("selected: CIDR network", network.String(), "IP", addr.ip, "interface", addr.iface)
+		addr.warn()
+		ni.Init(proto, addr.ip, port)
+		return addr, apc.NetSelCIDR, nil
+	default:
+		addr, err := _localIP(addrList)
+		if err != nil {
+			return nil, "", err
+		}
+		ni.Init(proto, addr.ip, port)
+		return addr, apc.NetSelAuto, nil
 	}
+}
 
-	lst := strings.Split(configuredIPv4s, cmn.HostnameListSepa)
-	if host, err = _selectHost(addrList, lst); err == nil {
-		ni.Init(proto, host, port)
+// (see `ais show cluster network`)
+func newNetIface(name string, ni *meta.NetInfo, addr *localIPInfo, cidr, source string) apc.NetIface {
+	nif := apc.NetIface{Net: name, Hostname: ni.Hostname, Port: ni.Port, CIDR: cidr, Source: source}
+	if addr != nil {
+		nif.IP, nif.Iface, nif.MTU = addr.ip, addr.iface, addr.mtu
 	}
-	return
+	return nif
 }
 
 /////////////
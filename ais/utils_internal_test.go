@@ -0,0 +1,45 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestInitNetInfoCIDR(t *testing.T) {
+	addrList := []*localIPInfo{
+		{ip: "10.0.1.5", iface: "eth0", mtu: 1500},
+		{ip: "192.168.10.5", iface: "eth1", mtu: 9000},
+		{ip: "192.168.20.5", iface: "eth2", mtu: 9000},
+	}
+
+	// control => eth1, data => eth2
+	var ctrl, data meta.NetInfo
+	addr, source, err := initNetInfo(&ctrl, addrList, "http", "", "192.168.10.0/24", "9081")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, addr.iface == "eth1" && source == apc.NetSelCIDR, "expecting eth1 via cidr, got %s via %s", addr.iface, source)
+	tassert.Errorf(t, ctrl.Hostname == "192.168.10.5", "unexpected control hostname %q", ctrl.Hostname)
+
+	addr, _, err = initNetInfo(&data, addrList, "http", "", "192.168.20.0/24", "9082")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, addr.iface == "eth2" && data.Hostname == "192.168.20.5", "expecting eth2, got %s (%s)", addr.iface, data.Hostname)
+
+	// configured hostname takes precedence
+	addr, source, err = initNetInfo(&data, addrList, "http", "10.0.1.5", "192.168.20.0/24", "9082")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, addr.iface == "eth0" && source == apc.NetSelHostname, "expecting eth0 via hostname, got %s via %s", addr.iface, source)
+
+	// no match
+	_, _, err = initNetInfo(&data, addrList, "http", "", "172.16.0.0/16", "9082")
+	tassert.Errorf(t, err != nil, "expecting error: no local IPs in CIDR")
+
+	// ambiguous
+	_, _, err = initNetInfo(&data, addrList, "http", "", "192.168.0.0/16", "9082")
+	tassert.Errorf(t, err != nil, "expecting error: multiple local IPs in CIDR")
+}
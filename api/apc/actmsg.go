@@ -21,14 +21,21 @@ const (
 
 	ActSummaryBck = "summary-bck"
 
-	ActECEncode  = "ec-encode" // erasure code a bucket
-	ActECGet     = "ec-get"    // read erasure coded objects
-	ActECPut     = "ec-put"    // erasure code objects
-	ActECRespond = "ec-resp"   // respond to other targets' EC requests
+	ActECEncode  = "ec-encode"  // erasure code a bucket
+	ActECGet     = "ec-get"     // read erasure coded objects
+	ActECPut     = "ec-put"     // erasure code objects
+	ActECRespond = "ec-resp"    // respond to other targets' EC requests
+	ActECRestore = "ec-restore" // recover objects with missing slices and/or replicas, most exposed first
 
 	ActCopyBck = "copy-bck"
 	ActETLBck  = "etl-bck"
 
+	// bucket snapshots (ais:// buckets only; ActMsg.Name is the snapshot name)
+	ActCreateSnap  = "create-snapshot"
+	ActRestoreSnap = "restore-snapshot"
+	ActDestroySnap = "destroy-snapshot"
+	ActListSnaps   = "list-snapshots"
+
 	ActETLInline = "etl-inline"
 
 	ActDsort    = "dsort"
@@ -36,6 +43,10 @@ const (
 
 	ActBlobDl = "blob-download"
 
+	ActECVerify = "ec-verify" // on-demand integrity check of a single erasure-coded object
+
+	ActCksumMigrate = "cksum-migrate" // re-checksum existing objects to the bucket's (new) checksum type
+
 	ActMakeNCopies = "make-n-copies"
 	ActPutCopies   = "put-copies"
 
@@ -48,6 +59,7 @@ const (
 
 	ActLRU          = "lru"
 	ActStoreCleanup = "cleanup-store"
+	ActDefragDirs   = "defrag-dirs"
 
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
 	ActInvalListCache = "inval-listobj-cache"
@@ -63,6 +75,7 @@ const (
 	ActSetConfig   = "set-config"
 
 	ActRotateLogs = "rotate-logs"
+	ActSelftest   = "selftest" // short controlled measurements - see SelftestMsg
 
 	ActShutdownCluster = "shutdown" // see also: ActShutdownNode
 
@@ -72,7 +85,11 @@ const (
 	ActETLObjects      = "etl-listrange"
 	ActEvictObjects    = "evict-listrange"
 	ActPrefetchObjects = "prefetch-listrange"
-	ActArchive         = "archive" // see ArchiveMsg
+	ActRenameObjects   = "rename-listrange" // see RenameObjsMsg
+	ActWarmUp          = "warm-up"          // see WarmUpMsg
+	ActArchive         = "archive"          // see ArchiveMsg
+
+	ActGetBatch = "get-batch" // read multiple objects as a single TAR stream (see GetBatchMsg)
 
 	ActAttachRemAis = "attach"
 	ActDetachRemAis = "detach"
@@ -120,10 +137,14 @@ const (
 
 	ActMountpathRescan = "rescan-mp"
 	ActMountpathFSHC   = "fshc-mp"
+	ActMountpathProbe  = "probe-mp" // check and benchmark a (not yet attached) mountpath - see MpathProbe
 
 	// Actions on xactions
 	ActXactStop  = Stop
 	ActXactStart = Start
+	ActXactGC    = "gc-xactions" // remove finished xactions from the registry (see api.GCXactions)
+
+	ActXactDeadline = "xact-deadline" // set max-runtime of a running xaction (see api.SetXactDeadline)
 
 	// auxiliary
 	ActTransient = "transient" // transient - in-memory only
@@ -179,6 +200,74 @@ type (
 	}
 )
 
+// MpathProbe is the report produced by ActMountpathProbe: results of the checks
+// (filesystem, permissions, xattr support, etc.) and a short benchmark of a new mountpath,
+// plus suggested mountpath label; the probe does not attach the mountpath
+type (
+	MpathCheck struct {
+		Name string `json:"name"`
+		Err  string `json:"err,omitempty"`  // empty: passed
+		Warn bool   `json:"warn,omitempty"` // not fatal (attaching is still possible)
+	}
+	MpathProbe struct {
+		Mountpath  string       `json:"mountpath"`
+		FS         string       `json:"fs"`      // filesystem (e.g., device name)
+		FsType     string       `json:"fs_type"` // e.g., xfs
+		Capacity   uint64       `json:"capacity,string"`
+		Avail      uint64       `json:"avail,string"`
+		Checks     []MpathCheck `json:"checks"`
+		WriteBps   int64        `json:"write_bps,string"`    // sequential write (including fsync)
+		ReadBps    int64        `json:"read_bps,string"`     // sequential read (direct I/O, if supported)
+		SyncLat    int64        `json:"sync_latency,string"` // average small write + fsync, ns
+		Label      string       `json:"label"`               // suggested mountpath label (see fs.Probe)
+		ProbeBytes int64        `json:"probe_bytes,string"`  // size of the benchmark file
+	}
+)
+
+func (mpp *MpathProbe) Passed() bool {
+	for i := range mpp.Checks {
+		if c := &mpp.Checks[i]; c.Err != "" && !c.Warn {
+			return false
+		}
+	}
+	return true
+}
+
+// ActSelftest: short controlled measurements that a given target runs on demand,
+// one kind at a time - to establish (and later compare against) a performance baseline:
+// - SelftestDisk: sequential write (including fsync), sequential and random reads - every mountpath;
+// - SelftestNet:  intra-cluster transport throughput to each of the other targets.
+const (
+	SelftestDisk = "disk"
+	SelftestNet  = "net"
+)
+
+type (
+	SelftestMsg struct {
+		What      string `json:"what"`              // SelftestDisk | SelftestNet
+		MpathSize int64  `json:"mpath_size,string"` // bytes to write and read, per mountpath (0: default)
+		XferSize  int64  `json:"xfer_size,string"`  // bytes to send to each peer target (0: default)
+	}
+	MpathSelftest struct {
+		Mountpath string `json:"mountpath"`
+		Err       string `json:"err,omitempty"`
+		WriteBps  int64  `json:"write_bps,string"`    // sequential write (including fsync)
+		ReadBps   int64  `json:"read_bps,string"`     // sequential read (direct I/O, if supported)
+		RandIOPS  int64  `json:"rand_iops,string"`    // random 4KiB reads (ditto)
+		RandLat   int64  `json:"rand_latency,string"` // average random read latency, ns
+	}
+	PeerSelftest struct {
+		ID  string `json:"id"`
+		Err string `json:"err,omitempty"`
+		Bps int64  `json:"bps,string"`
+	}
+	TargetSelftest struct {
+		Mpaths  []MpathSelftest `json:"mountpaths,omitempty"`
+		Peers   []PeerSelftest  `json:"peers,omitempty"`
+		Elapsed int64           `json:"elapsed,string"` // ns
+	}
+)
+
 // sysinfo
 type (
 	CapacityInfo struct {
@@ -12,6 +12,10 @@ type (
 		ObjCached     bool   `json:"cached"`
 		BckPresent    bool   `json:"present"`
 		DontAddRemote bool   `json:"dont_add_remote"`
+		// return the most recent completed summary (of the same buckets, prefix, and flags) cached by the proxy,
+		// if available and unless bucket metadata has changed since; otherwise, start a new job - as usual
+		// (no job ever gets started when the cache is hit - use BsummResult.Computed to tell the age)
+		FromCache bool `json:"from_cache,omitempty"`
 	}
 
 	// "summarized" result for a given bucket
@@ -31,7 +35,15 @@ type (
 			RemoteObjs  uint64 `json:"size_all_remote_objs,string"`  // sum(all object sizes in a remote bucket)
 			Disks       uint64 `json:"total_disks_size,string"`
 		}
+		Quota struct {
+			MaxBytes   uint64 `json:"quota_max_bytes,string,omitempty"`   // bucket property (zero when not set)
+			UsedPct    uint64 `json:"quota_used_pct,omitempty"`           // size on disk as % of the quota
+			MaxObjects uint64 `json:"quota_max_objects,string,omitempty"` // ditto
+			ObjUsedPct uint64 `json:"quota_obj_used_pct,omitempty"`       // number of present objects as % of the quota
+			SoftPct    uint64 `json:"quota_soft_pct,omitempty"`           // soft threshold (ditto)
+		}
 		UsedPct      uint64 `json:"used_pct"`
-		IsBckPresent bool   `json:"is_present"` // in BMD
+		Computed     int64  `json:"computed,string,omitempty"` // unix time (nanoseconds) when completed; zero when partial
+		IsBckPresent bool   `json:"is_present"`                // in BMD
 	}
 )
@@ -61,6 +61,15 @@ const (
 	HdrObjCustomMD  = aisPrefix + "Custom-Md"      // Object custom metadata.
 	HdrObjVersion   = aisPrefix + "Version"        // Object version/generation - ais or cloud.
 
+	// GET(object) with QparamLatestVer (or 'versioning.validate_warm_get'):
+	// outcome of checking in-cluster object against its remote backend
+	HdrObjVerCheck  = aisPrefix + "Ver-Check"      // one of the enumerated VerCheck* values (below)
+	HdrObjRemoteVer = aisPrefix + "Remote-Version" // version (or, if unversioned, ETag) reported by the remote backend
+
+	// GET(object) with QparamETLName: user-specified (per-request) transformer parameter, e.g.:
+	// "Ais-Etl-Param-Width: 224" (see also QparamETLParamPrefix)
+	HdrETLParamPrefix = aisPrefix + "Etl-Param-"
+
 	// Append object header
 	HdrAppendHandle = aisPrefix + "Append-Handle"
 
@@ -78,6 +87,13 @@ const (
 	HdrClusterUptime = aisPrefix + "Cluster-Uptime"
 )
 
+// enumerated values of the HdrObjVerCheck header
+const (
+	VerCheckFresh = "fresh" // in-cluster copy is the latest version (served as is)
+	VerCheckStale = "stale" // in-cluster copy is outdated (and was replaced via cold GET)
+	VerCheckCold  = "cold"  // not present in-cluster (cold GET)
+)
+
 // AuthN consts
 const (
 	HdrAuthorization         = "Authorization" // https://developer.mozilla.org/en-US/docs/Web/HTTP/Hdrs/Authorization
@@ -95,8 +111,10 @@ const (
 	HdrXactionID = aisPrefix + "Xaction-Id"
 
 	// intra-cluster streams
-	HdrSessID   = aisPrefix + "Session-Id"
-	HdrCompress = aisPrefix + "Compress" // LZ4
+	HdrSessID       = aisPrefix + "Session-Id"
+	HdrCompress     = aisPrefix + "Compress"      // LZ4
+	HdrStreamCaps   = aisPrefix + "Stream-Caps"   // capability negotiation (see transport/caps.go)
+	HdrStreamReplay = aisPrefix + "Stream-Replay" // stream's replay token and last delivered sequence number (see transport/replay.go)
 
 	// Promote(dir)
 	HdrPromoteNamesHash = aisPrefix + "Promote-Names-Hash"
@@ -4,6 +4,14 @@
  */
 package apc
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
 type (
 	// List of object names _or_ a template specifying { optional Prefix, zero or more Ranges }
 	ListRange struct {
@@ -16,6 +24,14 @@ type (
 		ContinueOnError bool  `json:"coer"`
 		LatestVer       bool  `json:"latest-ver"` // see also: QparamLatestVer, 'versioning.validate_warm_get'
 	}
+	// WarmUpMsg selects objects to warm up: remote objects that are not present in the cluster
+	// get prefetched (cold GET), while those that are present get read (to populate the OS page cache).
+	// See also: api.WarmUp
+	WarmUpMsg struct {
+		ListRange
+		LatestVer       bool `json:"latest-ver"` // ditto
+		ContinueOnError bool `json:"coer"`
+	}
 
 	// ArchiveMsg contains the parameters (all except the destination bucket)
 	// for archiving mutiple objects as one of the supported archive.FileExtensions types
@@ -34,12 +50,33 @@ type (
 		AppendIfExists  bool `json:"aate"`   // adding a list or a range of objects to an existing archive
 		ContinueOnError bool `json:"coer"`   // on err, keep running arc xaction in a any given multi-object transaction
 	}
+	// GetBatchMsg selects objects to read as a single (server-side constructed) TAR stream:
+	// either all objects with names starting with a given prefix or an explicit list of names;
+	// empty (zero-value) message implies the entire bucket.
+	// See also: api.GetBatchArchive
+	GetBatchMsg struct {
+		Prefix          string   `json:"prefix"`
+		ObjNames        []string `json:"objnames"`
+		ContinueOnError bool     `json:"coer"` // skip missing (or otherwise failing) objects rather than fail the request
+	}
 	//  Multi-object copy & transform (see also: TCBMsg)
 	TCObjsMsg struct {
 		ListRange
 		TxnUUID string // (plstcx client, internal use)
-		TCBMsg
-		ContinueOnError bool `json:"coer"`
+		TCBMsg         // NOTE: including retries and ContinueOnError (see CopyBckMsg)
+	}
+	// Multi-object rename (batch mv) within a given ais:// bucket - either:
+	// - list: ObjNames[i] gets renamed as NewNames[i], or
+	// - prefix to prefix: all objects with names starting with Prefix get renamed by replacing
+	//   the Prefix with NewPrefix.
+	// Each object gets renamed atomically: either the new name or the old one (never both, never none).
+	// See also: api.RenameMultiObj
+	RenameObjsMsg struct {
+		ObjNames        []string `json:"objnames,omitempty"`
+		NewNames        []string `json:"new_names,omitempty"`
+		Prefix          string   `json:"prefix,omitempty"`
+		NewPrefix       string   `json:"new_prefix,omitempty"`
+		ContinueOnError bool     `json:"coer"` // keep renaming other objects when a given one fails to rename
 	}
 )
 
@@ -51,3 +88,58 @@ type (
 
 func (lrm *ListRange) IsList() bool      { return len(lrm.ObjNames) > 0 }
 func (lrm *ListRange) HasTemplate() bool { return lrm.Template != "" }
+
+///////////////////
+// RenameObjsMsg //
+///////////////////
+
+func (msg *RenameObjsMsg) IsList() bool { return len(msg.ObjNames) > 0 }
+
+func (msg *RenameObjsMsg) Validate() error {
+	if !msg.IsList() {
+		if len(msg.NewNames) > 0 {
+			return errors.New("rename objects: new names require the list of source names")
+		}
+		if msg.Prefix == "" {
+			return errors.New("rename objects: source prefix is required (to rename entire bucket, use ActMoveBck)")
+		}
+		if strings.HasPrefix(msg.NewPrefix, msg.Prefix) {
+			// (including the case of the same prefix)
+			return fmt.Errorf("rename objects: new prefix %q must not start with the source prefix %q", msg.NewPrefix, msg.Prefix)
+		}
+		return nil
+	}
+	if msg.Prefix != "" || msg.NewPrefix != "" {
+		return errors.New("rename objects: list and prefix are mutually exclusive")
+	}
+	if len(msg.NewNames) != len(msg.ObjNames) {
+		return fmt.Errorf("rename objects: number of new names (%d) differs from the number of source names (%d)",
+			len(msg.NewNames), len(msg.ObjNames))
+	}
+	src := make(cos.StrSet, len(msg.ObjNames))
+	for _, name := range msg.ObjNames {
+		if src.Contains(name) {
+			return fmt.Errorf("rename objects: duplicate source name %q", name)
+		}
+		src.Set(name)
+	}
+	dst := make(cos.StrSet, len(msg.NewNames))
+	for _, name := range msg.NewNames {
+		if name == "" {
+			return errors.New("rename objects: empty new name")
+		}
+		if src.Contains(name) {
+			return fmt.Errorf("rename objects: %q is both source and destination", name)
+		}
+		if dst.Contains(name) {
+			return fmt.Errorf("rename objects: duplicate new name %q", name)
+		}
+		dst.Set(name)
+	}
+	return nil
+}
+
+// prefix to prefix: new name of a given (matching) object
+func (msg *RenameObjsMsg) ToName(objName string) string {
+	return msg.NewPrefix + strings.TrimPrefix(objName, msg.Prefix)
+}
@@ -1,14 +1,24 @@
 // Package apc: API control messages and constants
 /*
- * Copyright (c) 2018-2022, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
  */
 package apc
 
+import "fmt"
+
+// promote: overwrite policy (see PromoteArgs.Overwrite)
+const (
+	PromoteOwSkip   = "skip"   // (default) keep existing destination objects, skip the corresponding files
+	PromoteOwAlways = "always" // overwrite existing destination objects (same as OverwriteDst)
+	PromoteOwFail   = "fail"   // fail to promote a file if its destination object exists
+)
+
 // common part that's used in `api.PromoteArgs` and `PromoteParams`(server side), both
 type PromoteArgs struct {
 	DaemonID  string `json:"tid,omitempty"` // target ID
 	SrcFQN    string `json:"src,omitempty"` // source file or directory (must be absolute pathname)
 	ObjName   string `json:"obj,omitempty"` // destination object name or prefix
+	Overwrite string `json:"owp,omitempty"` // overwrite policy (enum above); takes precedence over OverwriteDst
 	Recursive bool   `json:"rcr,omitempty"` // recursively promote nested dirs
 	// once successfully promoted:
 	OverwriteDst bool `json:"ovw,omitempty"` // overwrite destination
@@ -17,4 +27,30 @@ type PromoteArgs struct {
 	// and _not_ to try to auto-detect if it is;
 	// (auto-detection takes time, etc.)
 	SrcIsNotFshare bool `json:"notshr,omitempty"` // the source is not a file share equally accessible by all targets
+	// always run x-promote, independently of the number of files to promote
+	// (by default, a few files get promoted synchronously, without xaction)
+	Xact bool `json:"xact,omitempty"`
+	// count and report failures, keep going (otherwise, the first failure terminates x-promote)
+	ContinueOnError bool `json:"coer,omitempty"`
+}
+
+func (args *PromoteArgs) Validate() error {
+	switch args.Overwrite {
+	case "", PromoteOwSkip, PromoteOwAlways, PromoteOwFail:
+		return nil
+	default:
+		return fmt.Errorf("invalid promote overwrite policy %q (expecting one of: %q, %q, %q)",
+			args.Overwrite, PromoteOwSkip, PromoteOwAlways, PromoteOwFail)
+	}
+}
+
+func (args *PromoteArgs) OwPolicy() string {
+	switch {
+	case args.Overwrite != "":
+		return args.Overwrite
+	case args.OverwriteDst:
+		return PromoteOwAlways
+	default:
+		return PromoteOwSkip
+	}
 }
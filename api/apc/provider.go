@@ -32,6 +32,9 @@ const (
 	AISScheme     = "ais"
 )
 
+// ht:// bucket listing via HTML directory index (bucket property extra.http.index)
+const HTIndexHTML = "html"
+
 const RemAIS = "remais" // to differentiate ais vs ais; also, default (remote ais cluster) alias
 
 var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, HT)
@@ -8,4 +8,5 @@ package apc
 const (
 	ArchAppend = 1 << iota
 	ArchAppendIfExist
+	ArchCreateIndex // TAR only: build archived-content index if missing (see cmn/archive/index.go)
 )
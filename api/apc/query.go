@@ -15,9 +15,31 @@ const (
 	QparamJobID   = "jobid"    // job
 	QparamETLName = "etl_name" // etl
 
+	// etl: user-specified (per-request) transformer parameter, e.g. "etl.width=224";
+	// passed to the ETL container without the prefix (see also HdrETLParamPrefix and Transform.Params)
+	QparamETLParamPrefix = "etl."
+
+	QparamCanaryPct  = "canary_pct"  // etl: percentage of transform requests routed to the new (canary) version
+	QparamETLVersion = "etl_version" // etl: (canary) version to promote
+
 	QparamRegex      = "regex"       // dsort: list regex
 	QparamOnlyActive = "only_active" // dsort: list only active
 
+	// xaction watch (long poll): last seen update sequence number and the interval
+	// to refresh aggregated stats at (see api.WatchXaction)
+	QparamWatchSeq = "watch_seq"
+	QparamWatchIvl = "watch_ivl"
+
+	QparamDlLink = "link" // downloader: source link (internal, to fetch a part of the source on behalf of another target)
+
+	QparamDlPreset = "preset" // downloader: start new download job from the named preset (template)
+
+	QparamRebExclude = "exclude" // rebalance estimate: target ID to estimate as if it were leaving the cluster
+
+	// authn: LDAP sync - show what would be done but do not make any changes; ETL init: validate only;
+	// set (or reset) bucket props: return resulting props and diff (see cmn.BpropsDryRun)
+	QparamDryRun = "dry_run"
+
 	// remove existing custom keys and store new custom metadata
 	// NOTE: making an s/_/-/ naming exception because of the namesake CLI usage
 	QparamNewCustom = "set-new-custom"
@@ -46,6 +68,10 @@ const (
 	// - docs/cli/aws_profile_endpoint.md
 	QparamDontHeadRemote = "dont_head_remote_bck"
 
+	// HEAD(bucket) pre-flight check: verify that the caller (and, with AuthN, the caller's token)
+	// can perform the specified operations - AccessAttrs in decimal (see api.CheckBucketAccess)
+	QparamCheckAccess = "check_access"
+
 	// When evicting, keep remote bucket in BMD (i.e., evict data only)
 	QparamKeepRemote = "keep_bck_md"
 
@@ -63,6 +89,10 @@ const (
 	QparamAppendType   = "append_type"
 	QparamAppendHandle = "append_handle"
 
+	// PATCH-style partial update of an existing ais:// object: write request body at the given offset
+	QparamPatchOffset = "patch_offset"
+	QparamPatchCksum  = "patch_cksum" // PatchCksum* enum below
+
 	// HTTP bucket support.
 	QparamOrigURL = "original_url"
 
@@ -170,6 +200,12 @@ const (
 	FlushOp  = "flush"
 )
 
+// QparamPatchCksum enum: when to recompute the checksum of the partially updated object
+const (
+	PatchCksumLazy = "lazy" // (default) in the background, shortly after the last update
+	PatchCksumSync = "sync" // prior to responding
+)
+
 // health
 const (
 	QparamHealthReadiness = "readiness" // to be used by external watchdogs (e.g. K8s)
@@ -187,8 +223,13 @@ const (
 	QparamIsGFNRequest     = "gfn" // true if the request is a Get-From-Neighbor
 	QparamRebStatus        = "rbs" // true: get detailed rebalancing status
 	QparamRebData          = "rbd" // true: get EC rebalance data (pulling data if push way fails)
+	QparamECGen            = "ecg" // EC generation of the object (to verify its CTs)
 	QparamClusterInfo      = "cii" // true: /Health to return `cos.NodeStateInfo` including cluster metadata versions and state flags
 	QparamOWT              = "owt" // object write transaction enum { OwtPut, ..., OwtGet* }
+	QparamGetBatchPart     = "gbp" // true: get-batch - respond with this target's share only (see ActGetBatch)
+	QparamHealthRedirect   = "hrd" // GET redirected away from a degraded target (value: its ID); see config.Proxy.HealthRedirect
+	QparamFallbackRedirect = "fbr" // GET routed via fallback map around an unreachable target (value: its ID); ditto
+	QparamTraceparent      = "tpr" // W3C trace context (`traceparent`) passed by proxy to target via redirect URL; see config.Tracing
 
 	QparamDontResilver = "dntres" // true: do not resilver data off of mountpaths that are being disabled/detached
 
@@ -196,6 +237,7 @@ const (
 	QparamTotalCompressedSize       = "tcs"
 	QparamTotalInputShardsExtracted = "tise"
 	QparamTotalUncompressedSize     = "tunc"
+	QparamSkippedObjects            = "sko" // resumed job: number of (local) objects in the skipped output shards
 
 	// 2PC transactions - control plane
 	QparamNetwTimeout  = "xnt" // [begin, start-commit] timeout
@@ -227,16 +269,26 @@ const (
 	WhatNodeStatsAndStatusV322 = "status" // [ ditto ]
 	WhatNodeStats              = "node_stats"
 	WhatNodeStatsAndStatus     = "node_status"
-	WhatDiskRWUtilCap          = "disk" // read/write stats, disk utilization, capacity
+	WhatDiskRWUtilCap          = "disk"          // read/write stats, disk utilization, capacity
+	WhatSlowReqs               = "slow_requests" // recent slow GET and PUT requests (see config.Log.SlowReq)
+	WhatNodeLoad               = "node_load"     // node state flags and max disk utilization (see config.Proxy.HealthRedirect)
+	WhatCapForecast            = "cap_forecast"  // capacity growth trends (see fs.CapFcast)
+	WhatRebHistory             = "reb_history"   // summaries of past rebalance runs (see reb.RunSummary)
+	WhatRebEstimate            = "reb_estimate"  // objects and bytes that rebalance would move (see reb.Plan)
+	WhatAlerts                 = "alerts"        // active and recently resolved alerts (see config.Alerts)
+	WhatRoleUsage              = "role_usage"    // per-role request rate and concurrency (see authn.RoleUsage)
 
 	WhatMetricNames = "metrics"
 
 	// assorted
-	WhatMountpaths = "mountpaths"
-	WhatRemoteAIS  = "remote"
-	WhatSmapVote   = "smapvote"
-	WhatSysInfo    = "sysinfo"
-	WhatTargetIPs  = "target_ips" // comma-separated list of all target IPs (compare w/ GetWhatSnode)
+	WhatMountpaths  = "mountpaths"
+	WhatRemoteAIS   = "remote"
+	WhatRemAisPing  = "remote_ping" // latencies: all targets => all nodes of all attached clusters
+	WhatSmapVote    = "smapvote"
+	WhatSysInfo     = "sysinfo"
+	WhatTargetIPs   = "target_ips"  // comma-separated list of all target IPs (compare w/ GetWhatSnode)
+	WhatCertificate = "certificate" // TLS: currently loaded X.509 certificate (validity bounds, etc.)
+	WhatNetIfaces   = "net_ifaces"  // network interface (NIC) used by each traffic class: public, intra-control, intra-data
 	// log
 	WhatLog = "log"
 	// xactions
@@ -245,6 +297,7 @@ const (
 	WhatXactStats       = "getxstats"   // stats: xaction by uuid
 	WhatQueryXactStats  = "qryxstats"   // stats: all matching xactions
 	WhatAllRunningXacts = "running_all" // e.g. e.g.: put-copies[D-ViE6HEL_j] list[H96Y7bhR2s] ...
+	WhatXactWatch       = "watch"       // IC: next progress snapshot by uuid (long poll)
 	// internal
 	WhatSnode    = "snode"
 	WhatICBundle = "ic_bundle"
@@ -0,0 +1,32 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// bucket snapshot: point-in-time listing and object metadata of an ais:// bucket;
+// the data is not copied (see ActCreateSnap and api.CreateSnapshot)
+type SnapInfo struct {
+	Name       string `json:"name"`
+	Created    int64  `json:"created"` // nanoseconds since UNIX epoch
+	ObjCount   int64  `json:"obj_count"`
+	Size       int64  `json:"size"`
+	NumTargets int    `json:"num_targets"` // the number of targets that have the snapshot
+}
+
+func ValidateSnapName(name string) error {
+	if name == "" {
+		return errors.New("snapshot name cannot be empty")
+	}
+	if strings.IndexByte(name, '.') >= 0 {
+		return errors.New("snapshot name is invalid: may only contain letters, numbers, dashes (-), underscores (_)")
+	}
+	return cos.CheckAlphaPlus(name, "snapshot name")
+}
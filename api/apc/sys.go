@@ -17,6 +17,8 @@ type MemCPUInfo struct {
 	PctMemUsed float64     `json:"pct_mem_used"`
 	PctCPUUsed float64     `json:"pct_cpu_used"`
 	LoadAvg    sys.LoadAvg `json:"load_avg"`
+	// (optional) see cmn.GPUConf
+	GPUs []sys.GPUStat `json:"gpus,omitempty"`
 }
 
 func GetMemCPU() MemCPUInfo {
@@ -39,5 +41,26 @@ func GetMemCPU() MemCPUInfo {
 		PctMemUsed: float64(proc.Mem.Resident) * 100 / float64(mem.Total),
 		PctCPUUsed: proc.CPU.Percent,
 		LoadAvg:    load,
+		GPUs:       sys.GPUs(),
 	}
 }
+
+// NetIface.Source enum: how a node has selected its network interface (local unicast IP)
+const (
+	NetSelHostname = "hostname" // configured hostname or IP (config.HostNet.Hostname*)
+	NetSelCIDR     = "cidr"     // the one and only local IP within configured subnet (config.HostNet.CIDR*)
+	NetSelAuto     = "auto"     // the only local IP, or the best fit among many
+	NetSelPublic   = "public"   // intra-cluster network not configured - same as public
+)
+
+// network interface used by a given node for a given traffic class
+type NetIface struct {
+	Net      string `json:"net"` // one of cmn.KnownNetworks
+	Hostname string `json:"hostname"`
+	Port     string `json:"port"`
+	IP       string `json:"ip,omitempty"`
+	Iface    string `json:"iface,omitempty"` // NIC name (empty when not local - e.g., K8s load balancer)
+	MTU      int    `json:"mtu,omitempty"`
+	CIDR     string `json:"cidr,omitempty"`
+	Source   string `json:"source"`
+}
@@ -6,6 +6,7 @@ package apc
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -20,11 +21,41 @@ type (
 		Force     bool   `json:"force"`       // force running in presence of "limited coexistence" type conflicts
 		LatestVer bool   `json:"latest-ver"`  // see also: QparamLatestVer, 'versioning.validate_warm_get', PrefetchMsg
 		Sync      bool   `json:"synchronize"` // see also: 'versioning.synchronize'
+		// per-object error handling (compare w/ ArchiveMsg and PrefetchMsg):
+		// - retry failed copy up to NumRetries times, with RetryBackoff (or 1s, if not specified) doubling each time;
+		// - once the retries are exhausted: skip the object and keep running if ContinueOnError, otherwise abort;
+		// - objects that were retried and those that ultimately failed are reported via xaction snapshot (see xact.CopyRetries)
+		NumRetries      int          `json:"num-retries,omitempty"`
+		RetryBackoff    cos.Duration `json:"retry-backoff,omitempty"`
+		ContinueOnError bool         `json:"coer"`
+		// bandwidth limit and scheduled (e.g., off-peak) execution:
+		// - LimitBph: max number of bytes per hour, cluster-wide (0: unlimited);
+		// - Schedule: semicolon-separated cron expressions (same format as `housekeeping.window`)
+		//   or CopyScheduleOffPeak; outside the scheduled time the job pauses, and resumes when the time comes
+		LimitBph int64  `json:"limit-bph,omitempty"`
+		Schedule string `json:"schedule,omitempty"`
 	}
 	Transform struct {
+		Filter *ETLFilter `json:"filter,omitempty"`
+		// user-specified transformer parameters (e.g., resize dimensions) - passed to the ETL container
+		// as query parameters with each transform request; recorded with the job (see xact.TCExt)
+		Params  cos.StrKVs   `json:"params,omitempty"`
 		Name    string       `json:"id,omitempty"`
 		Timeout cos.Duration `json:"request_timeout,omitempty"`
 	}
+	// ETL input filter - evaluated by targets prior to invoking the transformer;
+	// objects that don't match are skipped or, if CopyUnmatched, copied as is (untransformed)
+	// - all specified conditions must hold;
+	// - extensions are case-insensitive, with or without leading dot (e.g., "jpg", ".png");
+	// - custom metadata: each key must be present with the specified value (empty value: any)
+	ETLFilter struct {
+		CustomMD      cos.StrKVs `json:"custom_md,omitempty"`
+		Prefix        string     `json:"prefix,omitempty"`
+		Exts          []string   `json:"exts,omitempty"`
+		MinSize       int64      `json:"min_size,omitempty"`
+		MaxSize       int64      `json:"max_size,omitempty"` // 0: unlimited
+		CopyUnmatched bool       `json:"copy_unmatched,omitempty"`
+	}
 	TCBMsg struct {
 		// NOTE: objname extension ----------------------------------------------------------------------
 		// - resulting object names will have this extension, if specified.
@@ -44,9 +75,127 @@ type (
 
 func (msg *TCBMsg) Validate(isEtl bool) (err error) {
 	if isEtl && msg.Transform.Name == "" {
-		err = errors.New("ETL name can't be empty")
+		return errors.New("ETL name can't be empty")
+	}
+	if msg.Filter != nil {
+		if !isEtl {
+			return errors.New("ETL filter requires ETL name")
+		}
+		if err := msg.Filter.Validate(); err != nil {
+			return err
+		}
+	}
+	if len(msg.Params) > 0 {
+		if !isEtl {
+			return errors.New("ETL parameters require ETL name")
+		}
+		for k := range msg.Params {
+			if k == "" {
+				return errors.New("invalid ETL parameters: empty name")
+			}
+		}
+	}
+	return msg.CopyBckMsg.Validate()
+}
+
+///////////////
+// ETLFilter //
+///////////////
+
+func (f *ETLFilter) Validate() error {
+	if f.MinSize < 0 || f.MaxSize < 0 {
+		return fmt.Errorf("invalid ETL filter: negative size range [%d, %d]", f.MinSize, f.MaxSize)
+	}
+	if f.MaxSize > 0 && f.MinSize > f.MaxSize {
+		return fmt.Errorf("invalid ETL filter: min size %d is greater than max size %d", f.MinSize, f.MaxSize)
+	}
+	for _, ext := range f.Exts {
+		if strings.TrimLeft(ext, ".") == "" {
+			return errors.New("invalid ETL filter: empty extension")
+		}
+	}
+	return nil
+}
+
+// (whether matching requires object's metadata)
+func (f *ETLFilter) NeedsMD() bool { return f.MinSize > 0 || f.MaxSize > 0 || len(f.CustomMD) > 0 }
+
+func (f *ETLFilter) Match(objName string, size int64, md cos.StrKVs) bool {
+	if f.Prefix != "" && !strings.HasPrefix(objName, f.Prefix) {
+		return false
+	}
+	if size < f.MinSize || (f.MaxSize > 0 && size > f.MaxSize) {
+		return false
+	}
+	if len(f.Exts) > 0 && !f.matchExt(objName) {
+		return false
+	}
+	for k, v := range f.CustomMD {
+		val, ok := md[k]
+		if !ok || (v != "" && v != val) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *ETLFilter) matchExt(objName string) bool {
+	idx := strings.LastIndexByte(objName, '.')
+	if idx < 0 || strings.IndexByte(objName[idx:], '/') >= 0 {
+		return false
+	}
+	ext := objName[idx+1:]
+	for _, e := range f.Exts {
+		if strings.EqualFold(strings.TrimLeft(e, "."), ext) {
+			return true
+		}
+	}
+	return false
+}
+
+////////////////
+// CopyBckMsg //
+////////////////
+
+const MaxCopyRetries = 10
+
+// copy (transform) during the configured maintenance window (see `housekeeping.window`)
+const CopyScheduleOffPeak = "off-peak"
+
+func (msg *CopyBckMsg) Validate() error {
+	if msg.NumRetries < 0 || msg.NumRetries > MaxCopyRetries {
+		return fmt.Errorf("invalid number of retries %d (expecting 0 to %d)", msg.NumRetries, MaxCopyRetries)
+	}
+	if msg.RetryBackoff < 0 {
+		return fmt.Errorf("invalid retry backoff %v", msg.RetryBackoff)
+	}
+	if msg.LimitBph < 0 {
+		return fmt.Errorf("invalid bandwidth limit %d (bytes per hour)", msg.LimitBph)
+	}
+	_, err := msg.ParseSchedule()
+	return err
+}
+
+// returns parsed cron expressions, if any; returns nil when the schedule is either not specified
+// or CopyScheduleOffPeak (the latter being resolved at runtime)
+func (msg *CopyBckMsg) ParseSchedule() (crons []*cos.Cron, _ error) {
+	if msg.Schedule == "" || msg.Schedule == CopyScheduleOffPeak {
+		return nil, nil
+	}
+	for _, e := range strings.Split(msg.Schedule, ";") {
+		if e = strings.TrimSpace(e); e == "" {
+			continue
+		}
+		cron, err := cos.ParseCron(e)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule: %v", err)
+		}
+		crons = append(crons, cron)
+	}
+	if len(crons) == 0 {
+		return nil, fmt.Errorf("invalid schedule %q", msg.Schedule)
 	}
-	return
+	return crons, nil
 }
 
 // Replace extension and add suffix if provided.
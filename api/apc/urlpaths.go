@@ -31,6 +31,8 @@ const (
 	Users     = "users"    // AuthN
 	Clusters  = "clusters" // AuthN
 	Roles     = "roles"    // AuthN
+	LDAP      = "ldap"     // AuthN
+	Check     = "check"    // AuthN (permission check)
 	IC        = "ic"       // information center
 
 	// l3 ---
@@ -66,6 +68,15 @@ const (
 	Next        = "next"
 	Peek        = "peek"
 	Discard     = "discard"
+	Schedule    = "schedule"
+	Creds       = "creds"
+	Preset      = "preset"
+	Enable      = "enable"
+	Disable     = "disable"
+	Sync        = "sync"
+	Part        = "part"
+	Resume      = "resume"
+	Checkpoint  = "checkpoint"
 	WorkerOwner = "worker" // TODO: it should be removed once get-next-bytes endpoint is ready
 
 	LoadX509 = "load-x509"
@@ -78,8 +89,14 @@ const (
 	ETLObject  = "_object"
 	ETLStop    = Stop
 	ETLStart   = Start
+	ETLAbort   = Abort // (internal: target => primary => all targets)
 	ETLHealth  = "health"
 	ETLMetrics = "metrics"
+
+	// ETL canary deployment
+	ETLCanary   = "canary"
+	ETLPromote  = "promote"
+	ETLRollback = "rollback"
 )
 
 // RESTful l3, internal use
@@ -153,10 +170,25 @@ var (
 	URLPathdSortMetrics = urlpath(Version, Sort, Metrics)
 	URLPathdSortAck     = urlpath(Version, Sort, FinishedAck)
 	URLPathdSortRemove  = urlpath(Version, Sort, Remove)
+	URLPathdSortResume  = urlpath(Version, Sort, Resume)
+	URLPathdSortCkpt    = urlpath(Version, Sort, Checkpoint)
 
 	URLPathDownload       = urlpath(Version, Download)
 	URLPathDownloadAbort  = urlpath(Version, Download, Abort)
 	URLPathDownloadRemove = urlpath(Version, Download, Remove)
+	URLPathDownloadPart   = urlpath(Version, Download, Part) // (internal)
+
+	URLPathDownloadSched        = urlpath(Version, Download, Schedule)
+	URLPathDownloadSchedEnable  = urlpath(Version, Download, Schedule, Enable)
+	URLPathDownloadSchedDisable = urlpath(Version, Download, Schedule, Disable)
+	URLPathDownloadSchedRemove  = urlpath(Version, Download, Schedule, Remove)
+	URLPathDownloadSchedSync    = urlpath(Version, Download, Schedule, Sync) // (internal)
+
+	URLPathDownloadCreds     = urlpath(Version, Download, Creds)
+	URLPathDownloadCredsSync = urlpath(Version, Download, Creds, Sync) // (internal)
+
+	URLPathDownloadPreset     = urlpath(Version, Download, Preset)
+	URLPathDownloadPresetSync = urlpath(Version, Download, Preset, Sync) // (internal)
 
 	URLPathETL       = urlpath(Version, ETL)
 	URLPathETLObject = urlpath(Version, ETL, ETLObject)
@@ -165,6 +197,8 @@ var (
 	URLPathUsers    = urlpath(Version, Users)
 	URLPathClusters = urlpath(Version, Clusters)
 	URLPathRoles    = urlpath(Version, Roles)
+	URLPathLDAP     = urlpath(Version, LDAP)
+	URLPathCheck    = urlpath(Version, Check)
 )
 
 func (u URLPath) Join(words ...string) string {
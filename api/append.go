@@ -0,0 +1,67 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Appender is a handle-based convenience wrapper around api.AppendObject and api.FlushObject
+// to concatenate any number of readers into a single ais:// object, e.g.:
+//
+//	a := api.NewAppender(bp, bck, objName)
+//	for _, r := range readers {
+//		if err := a.Append(r, size); err != nil { ... }
+//	}
+//	cksum, err := a.Flush(nil /*optional checksum to validate*/)
+//
+// The object becomes visible (and accessible) only after Flush. Until then, the appended
+// content is accumulated in a work file on the (HRW) target, whereby the handle keeps track
+// of the latter, as well as the (partial) checksum of the content appended so far.
+// Thus, the returned checksum - of the bucket-configured type - covers the entire concatenation.
+type Appender struct {
+	bp      BaseParams
+	bck     cmn.Bck
+	objName string
+	handle  string
+	size    int64
+}
+
+func NewAppender(bp BaseParams, bck cmn.Bck, objName string) *Appender {
+	return &Appender{bp: bp, bck: bck, objName: objName}
+}
+
+// append the entire content of the reader; `size` is optional (zero when unknown)
+func (a *Appender) Append(reader cos.ReadOpenCloser, size int64) (err error) {
+	args := AppendArgs{
+		BaseParams: a.bp,
+		Bck:        a.bck,
+		Object:     a.objName,
+		Reader:     reader,
+		Handle:     a.handle,
+		Size:       size,
+	}
+	if a.handle, err = AppendObject(&args); err == nil {
+		a.size += size
+	}
+	return err
+}
+
+// finalize the object; if not nil, `cksum` is validated against the entire concatenation
+// (and may be of any supported type)
+func (a *Appender) Flush(cksum *cos.Cksum) (*cos.Cksum, error) {
+	args := FlushArgs{
+		BaseParams: a.bp,
+		Bck:        a.bck,
+		Object:     a.objName,
+		Handle:     a.handle,
+		Cksum:      cksum,
+	}
+	return flushObject(&args)
+}
+
+func (a *Appender) Handle() string { return a.handle }
+func (a *Appender) Size() int64    { return a.size } // total size, as per Append(size) calls
@@ -7,7 +7,9 @@ package authn
 import (
 	"errors"
 	"net/http"
+	"net/url"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -62,8 +64,28 @@ func DeleteUser(bp api.BaseParams, userID string) error {
 // The token expires in `expire` time. If `expire` is `nil` the expiration
 // time is set by AuthN (default AuthN expiration time is 24 hours)
 func LoginUser(bp api.BaseParams, userID, pass string, expire *time.Duration) (token *TokenMsg, err error) {
+	return login(bp, userID, &LoginMsg{Password: pass, ExpiresIn: expire})
+}
+
+// Same as above, plus refresh token that can be used to obtain new access tokens
+// (see RefreshToken and TokenRefresher) with no need to re-login.
+// Unless `expire` is specified, the access token is short-lived.
+func LoginUserRefresh(bp api.BaseParams, userID, pass string, expire *time.Duration) (token *TokenMsg, err error) {
+	token, err = login(bp, userID, &LoginMsg{Password: pass, ExpiresIn: expire, Refresh: true})
+	if err == nil && token.RefreshToken == "" {
+		err = errors.New("login failed: AuthN server did not issue refresh token")
+	}
+	return token, err
+}
+
+// Same as LoginUser, with the token bound to the specified client source address
+// ranges, e.g. "10.0.0.0/8": AIS gateways reject the token when used from anywhere else.
+func IssueToken(bp api.BaseParams, userID, pass string, expire *time.Duration, cidrs []string) (*TokenMsg, error) {
+	return login(bp, userID, &LoginMsg{Password: pass, ExpiresIn: expire, CIDRs: cidrs})
+}
+
+func login(bp api.BaseParams, userID string, rec *LoginMsg) (token *TokenMsg, err error) {
 	bp.Method = http.MethodPost
-	rec := LoginMsg{Password: pass, ExpiresIn: expire}
 	reqParams := api.AllocRp()
 	defer api.FreeRp(reqParams)
 	{
@@ -81,6 +103,28 @@ func LoginUser(bp api.BaseParams, userID, pass string, expire *time.Duration) (t
 	return token, nil
 }
 
+// Exchange refresh token for a new access token; the returned message
+// includes the same refresh token (that remains valid until it expires or gets revoked)
+func RefreshToken(bp api.BaseParams, refreshToken string) (token *TokenMsg, err error) {
+	bp.Method = http.MethodPost
+	bp.Token, bp.TokenSource = "", nil // (the refresh token is the credential)
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathTokens.S
+		reqParams.Body = cos.MustMarshal(&TokenMsg{RefreshToken: refreshToken})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	if _, err = reqParams.DoReqAny(&token); err != nil {
+		return nil, err
+	}
+	if token.Token == "" {
+		return nil, errors.New("refresh failed: empty response from AuthN server")
+	}
+	return token, nil
+}
+
 func RegisterCluster(bp api.BaseParams, cluSpec CluACL) error {
 	msg := cos.MustMarshal(cluSpec)
 	bp.Method = http.MethodPost
@@ -272,6 +316,44 @@ func RevokeToken(bp api.BaseParams, token string) error {
 	return reqParams.DoRequest()
 }
 
+// ValidateToken verifies the token's signature and checks whether it has expired or been revoked
+func ValidateToken(bp api.BaseParams, token string) (*TokenStatus, error) {
+	bp.Method = http.MethodGet
+	status := &TokenStatus{}
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.Body = cos.MustMarshal(&TokenMsg{Token: token})
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathTokens.S
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	if _, err := reqParams.DoReqAny(status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// CheckAccess evaluates whether a given user (or token) would be allowed to perform
+// a given operation - without actually performing it; returns the matching role(s) and rule(s).
+// Checking a user (by ID) requires admin; checking a token does not.
+func CheckAccess(bp api.BaseParams, msg *CheckMsg) (*CheckResult, error) {
+	bp.Method = http.MethodGet
+	res := &CheckResult{}
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathCheck.S
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	if _, err := reqParams.DoReqAny(res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func GetConfig(bp api.BaseParams) (*Config, error) {
 	bp.Method = http.MethodGet
 	reqParams := api.AllocRp()
@@ -298,3 +380,19 @@ func SetConfig(bp api.BaseParams, conf *ConfigToUpdate) error {
 	}
 	return reqParams.DoRequest()
 }
+
+// SyncLDAP synchronizes LDAP groups and their members with AuthN roles and users;
+// with dryRun, returns the changes without making them.
+func SyncLDAP(bp api.BaseParams, dryRun bool) (*LDAPSyncResult, error) {
+	bp.Method = http.MethodPost
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathLDAP.S
+		reqParams.Query = url.Values{apc.QparamDryRun: []string{strconv.FormatBool(dryRun)}}
+	}
+	res := &LDAPSyncResult{}
+	_, err := reqParams.DoReqAny(res)
+	return res, err
+}
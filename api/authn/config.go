@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,6 +23,7 @@ type (
 		Net     NetConf     `json:"net"`
 		Server  ServerConf  `json:"auth"`
 		Timeout TimeoutConf `json:"timeout"`
+		LDAP    LDAPConf    `json:"ldap"`
 		// private
 		mu sync.RWMutex `json:"-"`
 	}
@@ -39,21 +41,40 @@ type (
 		UseHTTPS    bool   `json:"use_https"`
 	}
 	ServerConf struct {
-		Secret string       `json:"secret"`
-		Expire cos.Duration `json:"expiration_time"`
+		Secret        string       `json:"secret"`
+		Expire        cos.Duration `json:"expiration_time"`
+		RefreshExpire cos.Duration `json:"refresh_expiration_time,omitempty"` // (default: DfltRefreshExpire)
 		// private
-		psecret *string       `json:"-"`
-		pexpire *cos.Duration `json:"-"`
+		psecret  *string       `json:"-"`
+		pexpire  *cos.Duration `json:"-"`
+		prexpire *cos.Duration `json:"-"`
 	}
 	TimeoutConf struct {
 		Default cos.Duration `json:"default_timeout"`
 	}
+	// LDAP (or Active Directory) groups => AIS roles, group membership => AIS users
+	LDAPConf struct {
+		URL            string       `json:"url"`             // "ldap://host[:389]" or "ldaps://host[:636]"; empty - disabled
+		BindDN         string       `json:"bind_dn"`         // (service) account to search the directory
+		BindPassword   string       `json:"bind_password"`   //
+		GroupBaseDN    string       `json:"group_base_dn"`   // where to search for groups
+		GroupFilter    string       `json:"group_filter"`    // e.g. "(&(objectClass=group)(cn=ais-*))"
+		GroupNameAttr  string       `json:"group_name_attr"` // group's attribute that names the role (default: "cn")
+		MemberAttr     string       `json:"member_attr"`     // group's attribute that lists members' DNs (default: "member")
+		UserNameAttr   string       `json:"user_name_attr"`  // member's attribute that names the user, e.g. "uid" or "sAMAccountName" (default: RDN value)
+		RolePrefix     string       `json:"role_prefix"`     // optional prefix for the resulting role names
+		ConflictPolicy string       `json:"conflict_policy"` // locally-created user with the same name: "skip" (default) | "merge" | "overwrite"
+		SyncInterval   cos.Duration `json:"sync_interval"`   // periodic sync; zero - on demand only
+		Timeout        cos.Duration `json:"timeout"`         // (default: 30s)
+		SkipVerify     bool         `json:"skip_verify"`     // ldaps: skip server certificate verification
+	}
 	ConfigToUpdate struct {
 		Server *ServerConfToSet `json:"auth"`
 	}
 	ServerConfToSet struct {
-		Secret *string `json:"secret,omitempty"`
-		Expire *string `json:"expiration_time,omitempty"`
+		Secret        *string `json:"secret,omitempty"`
+		Expire        *string `json:"expiration_time,omitempty"`
+		RefreshExpire *string `json:"refresh_expiration_time,omitempty"`
 	}
 	// TokenList is a list of tokens pushed by authn
 	TokenList struct {
@@ -62,6 +83,11 @@ type (
 	}
 )
 
+// refresh tokens (see LoginMsg.Refresh)
+const (
+	DfltRefreshExpire = 30 * 24 * time.Hour
+)
+
 var (
 	_ jsp.Opts = (*Config)(nil)
 
@@ -77,6 +103,7 @@ func (c *Config) Unlock() { c.mu.Unlock() }
 func (c *Config) Init() {
 	c.Server.psecret = &c.Server.Secret
 	c.Server.pexpire = &c.Server.Expire
+	c.Server.prexpire = &c.Server.RefreshExpire
 }
 
 func (c *Config) Verbose() bool {
@@ -88,6 +115,13 @@ func (c *Config) Verbose() bool {
 func (c *Config) Secret() string        { return *c.Server.psecret }
 func (c *Config) Expire() time.Duration { return time.Duration(*c.Server.pexpire) }
 
+func (c *Config) RefreshExpire() time.Duration {
+	if d := time.Duration(*c.Server.prexpire); d > 0 {
+		return d
+	}
+	return DfltRefreshExpire
+}
+
 func (c *Config) SetSecret(val *string) {
 	c.Server.Secret = *val
 	c.Server.psecret = val
@@ -112,5 +146,49 @@ func (c *Config) ApplyUpdate(cu *ConfigToUpdate) error {
 		c.Server.Expire = v
 		c.Server.pexpire = &v
 	}
+	if cu.Server.RefreshExpire != nil {
+		dur, err := time.ParseDuration(*cu.Server.RefreshExpire)
+		if err != nil {
+			return fmt.Errorf("invalid time format %s: %v", *cu.Server.RefreshExpire, err)
+		}
+		v := cos.Duration(dur)
+		c.Server.RefreshExpire = v
+		c.Server.prexpire = &v
+	}
+	return nil
+}
+
+//////////////
+// LDAPConf //
+//////////////
+
+// conflict policies: what to do with a locally-created user that has the same name as LDAP group member
+const (
+	LDAPConflictSkip      = "skip"      // leave the local user alone (and report the conflict)
+	LDAPConflictMerge     = "merge"     // add LDAP-derived roles to the local user (keep local password)
+	LDAPConflictOverwrite = "overwrite" // convert the local user into LDAP-managed one
+)
+
+func (c *LDAPConf) Enabled() bool { return c.URL != "" }
+
+func (c *LDAPConf) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+	if !strings.HasPrefix(c.URL, "ldap://") && !strings.HasPrefix(c.URL, "ldaps://") {
+		return fmt.Errorf("invalid LDAP URL %q (expecting ldap:// or ldaps://)", c.URL)
+	}
+	if c.GroupBaseDN == "" {
+		return errors.New("LDAP group_base_dn is required")
+	}
+	switch c.ConflictPolicy {
+	case "", LDAPConflictSkip, LDAPConflictMerge, LDAPConflictOverwrite:
+	default:
+		return fmt.Errorf("invalid LDAP conflict_policy %q (expecting one of: %q, %q, %q)", c.ConflictPolicy,
+			LDAPConflictSkip, LDAPConflictMerge, LDAPConflictOverwrite)
+	}
+	if c.SyncInterval < 0 || c.Timeout < 0 {
+		return errors.New("LDAP sync_interval and timeout must be non-negative")
+	}
 	return nil
 }
@@ -20,6 +20,7 @@ type (
 	User struct {
 		ID       string  `json:"id"`
 		Password string  `json:"pass,omitempty"`
+		DN       string  `json:"dn,omitempty"` // LDAP-managed user (see LDAPConf)
 		Roles    []*Role `json:"roles"`
 	}
 
@@ -36,12 +37,49 @@ type (
 	}
 
 	TokenMsg struct {
-		Token string `json:"token"`
+		Token        string `json:"token"`
+		RefreshToken string `json:"refresh_token,omitempty"` // (see LoginMsg.Refresh)
+		Expires      int64  `json:"expires,omitempty"`       // access token's expiration time (Unix seconds)
+	}
+
+	// AuthN's view of a given token (see ValidateToken)
+	TokenStatus struct {
+		Err     string   `json:"error,omitempty"` // signature or format: invalid token
+		Roles   []string `json:"roles,omitempty"` // token owner's current roles
+		Valid   bool     `json:"valid"`           // signed by this AuthN
+		Expired bool     `json:"expired"`
+		Revoked bool     `json:"revoked"`
+	}
+
+	// Permission check (simulation): would a given user (or token) be allowed
+	// to perform a given operation (see CheckAccess)
+	CheckMsg struct {
+		UserID  string          `json:"user_id,omitempty"` // either user ID
+		Token   string          `json:"token,omitempty"`   // or token
+		Cluster string          `json:"cluster,omitempty"` // cluster ID or alias (may be omitted when there's only one)
+		Bck     cmn.Bck         `json:"bck"`
+		ObjName string          `json:"objname,omitempty"`
+		Access  apc.AccessAttrs `json:"perm,string"` // requested permissions
+	}
+	CheckResult struct {
+		UserID  string          `json:"user_id"`
+		Cluster string          `json:"cluster"` // resolved cluster ID
+		Reason  string          `json:"reason,omitempty"`
+		Matched []*MatchedACL   `json:"matched,omitempty"` // ACL(s) that allowed or denied the access
+		Missing apc.AccessAttrs `json:"missing,string"`    // requested but not granted
+		Allowed bool            `json:"allowed"`
+	}
+	MatchedACL struct {
+		Role    string          `json:"role,omitempty"` // role that contributed the ACL (empty when not found)
+		Rule    string          `json:"rule"`           // e.g. "bucket ais://nnn", "cluster [ID]", "admin"
+		Granted apc.AccessAttrs `json:"granted,string"`
 	}
 
 	LoginMsg struct {
 		Password  string         `json:"password"`
 		ExpiresIn *time.Duration `json:"expires_in"`
+		CIDRs     []string       `json:"cidrs,omitempty"`   // bind token(s) to client source address ranges
+		Refresh   bool           `json:"refresh,omitempty"` // issue (access, refresh) token pair
 	}
 
 	RegisteredClusters struct {
@@ -53,7 +91,41 @@ type (
 		Description string    `json:"desc"`
 		ClusterACLs []*CluACL `json:"clusters"`
 		BucketACLs  []*BckACL `json:"buckets"`
+		DN          string    `json:"dn,omitempty"` // LDAP group (see LDAPConf)
 		IsAdmin     bool      `json:"admin"`
+		// (optional) limits enforced by each AIS gateway across all users with this role:
+		// max requests per second and max concurrent requests (zero: unlimited)
+		QPS     int `json:"qps,omitempty"`
+		MaxConc int `json:"max_conc,omitempty"`
+	}
+
+	// role limits carried by access tokens (see Role.QPS and Role.MaxConc)
+	RoleLimit struct {
+		Role    string `json:"role"`
+		QPS     int    `json:"qps,omitempty"`
+		MaxConc int    `json:"max_conc,omitempty"`
+	}
+	// current per-role usage, as seen by a given AIS gateway (apc.WhatRoleUsage)
+	RoleUsage struct {
+		RoleLimit
+		InFlight int64 `json:"in_flight"` // requests currently being handled
+		Rate     int64 `json:"rate"`      // requests during the last full second
+		Requests int64 `json:"requests"`  // total (since gateway startup)
+		Rejected int64 `json:"rejected"`  // total rejected with 429 (too many requests)
+	}
+
+	// LDAP sync
+	LDAPChange struct {
+		Op      string `json:"op"`   // add | update | delete | conflict
+		Kind    string `json:"kind"` // role | user
+		Name    string `json:"name"`
+		Details string `json:"details,omitempty"`
+	}
+	LDAPSyncResult struct {
+		Changes []*LDAPChange `json:"changes"`
+		Groups  int           `json:"groups"` // number of LDAP groups
+		Users   int           `json:"users"`  // number of (unique) group members
+		DryRun  bool          `json:"dry_run"`
 	}
 )
 
@@ -94,3 +166,8 @@ func (clu *CluACL) String() string {
 var _ jsp.Opts = (*TokenMsg)(nil)
 
 func (*TokenMsg) JspOpts() jsp.Options { return authtokJspOpts }
+
+// whether the access token expires within the specified time
+func (tm *TokenMsg) ExpiresWithin(d time.Duration) bool {
+	return tm.Expires != 0 && time.Until(time.Unix(tm.Expires, 0)) < d
+}
@@ -1,6 +1,6 @@
 // Package authn provides AuthN API over HTTP(S)
 /*
- * Copyright (c) 2018-2022, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
  */
 package authn
 
@@ -14,8 +14,18 @@ import (
 	"github.com/NVIDIA/aistore/cmn/jsp"
 )
 
+// token file is readable and writable by the owner only
+const permTokenFile os.FileMode = 0o600
+
 // NOTE: must load when tokenFile != ""
 func LoadToken(tokenFile string) string {
+	token, _ := LoadTokenMsg(tokenFile)
+	return token.Token
+}
+
+// LoadTokenMsg is LoadToken that also returns refresh token and expiration time (if any),
+// and the resolved location of the token file
+func LoadTokenMsg(tokenFile string) (*TokenMsg, string) {
 	var (
 		token    TokenMsg
 		mustLoad = true
@@ -33,5 +43,25 @@ func LoadToken(tokenFile string) string {
 	if err != nil && (mustLoad || !os.IsNotExist(err)) {
 		cos.Errorf("Failed to load token %q: %v", tokenFile, err)
 	}
-	return token.Token
+	return &token, tokenFile
+}
+
+// SaveToken stores the token (and refresh token, if any) in a file accessible only by its owner
+func SaveToken(tokenFile string, token *TokenMsg) error {
+	tmp := tokenFile + ".tmp." + cos.GenTie()
+	fh, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, permTokenFile)
+	if err != nil {
+		return err
+	}
+	err = jsp.Encode(fh, token, token.JspOpts())
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		err = os.Rename(tmp, tokenFile)
+	}
+	if err != nil {
+		os.Remove(tmp)
+	}
+	return err
 }
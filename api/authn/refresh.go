@@ -0,0 +1,67 @@
+// Package authn provides AuthN API over HTTP(S)
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package authn
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// refresh access token that expires within
+const RefreshAhead = time.Minute
+
+// TokenRefresher (api.TokenSource) provides the current access token and transparently
+// refreshes it - prior to expiration - using the refresh token (see LoginUserRefresh).
+// Refreshed tokens are saved in the token file, if specified.
+//
+// Usage:
+//
+//	bp.TokenSource = authn.NewTokenRefresher(authnBP, token, tokenFile)
+//
+// where authnBP are AuthN (not aistore) base params.
+type TokenRefresher struct {
+	bp   api.BaseParams // AuthN
+	tok  TokenMsg
+	file string
+	mu   sync.Mutex
+}
+
+// interface guard
+var _ api.TokenSource = (*TokenRefresher)(nil)
+
+func NewTokenRefresher(authnBP api.BaseParams, token *TokenMsg, tokenFile string) *TokenRefresher {
+	authnBP.Token, authnBP.TokenSource = "", nil
+	return &TokenRefresher{bp: authnBP, tok: *token, file: tokenFile}
+}
+
+// upon failure to refresh, returns the current token along with the error
+func (tr *TokenRefresher) Token() (string, error) {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tr.tok.RefreshToken == "" {
+		if tr.tok.Token == "" {
+			return "", errors.New("no token")
+		}
+		return tr.tok.Token, nil
+	}
+	if tr.tok.Token != "" && !tr.tok.ExpiresWithin(RefreshAhead) {
+		return tr.tok.Token, nil
+	}
+	token, err := RefreshToken(tr.bp, tr.tok.RefreshToken)
+	if err != nil {
+		return tr.tok.Token, err
+	}
+	tr.tok = *token
+	if tr.file != "" {
+		if err := SaveToken(tr.file, token); err != nil {
+			cos.Errorf("Failed to save refreshed token %q: %v", tr.file, err)
+		}
+	}
+	return tr.tok.Token, nil
+}
@@ -0,0 +1,170 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+const DefaultBPTimeout = 10 * time.Minute // (when not specified)
+
+type (
+	BPOpt func(*bpArgs)
+
+	bpArgs struct {
+		client    *http.Client
+		tls       *cmn.TLSArgs
+		cache     *Cache
+		token     string
+		tokenFile string
+		ua        string
+		timeout   time.Duration
+		noToken   bool
+	}
+)
+
+// BPClient specifies the HTTP(S) client to use (mutually exclusive with BPTimeout and BPTLS).
+func BPClient(client *http.Client) BPOpt { return func(a *bpArgs) { a.client = client } }
+
+// BPTimeout specifies the client's timeout (zero - no timeout; default: DefaultBPTimeout).
+func BPTimeout(timeout time.Duration) BPOpt { return func(a *bpArgs) { a.timeout = timeout } }
+
+// BPTLS specifies client-side TLS (https only); overrides TLS environment.
+func BPTLS(sargs cmn.TLSArgs) BPOpt { return func(a *bpArgs) { a.tls = &sargs } }
+
+// BPToken specifies the AuthN token (mutually exclusive with BPTokenFile and BPNoToken).
+func BPToken(token string) BPOpt { return func(a *bpArgs) { a.token = token } }
+
+// BPTokenFile specifies the file to load AuthN token from; the file must exist.
+func BPTokenFile(path string) BPOpt { return func(a *bpArgs) { a.tokenFile = path } }
+
+// BPNoToken disables token lookup.
+func BPNoToken() BPOpt { return func(a *bpArgs) { a.noToken = true } }
+
+// BPUA specifies the User-Agent.
+func BPUA(ua string) BPOpt { return func(a *bpArgs) { a.ua = ua } }
+
+// NewBaseParams is the recommended way to construct BaseParams (compare with the bare-struct
+// `api.BaseParams{URL: ...}` that leaves it up to the caller to provide HTTP(S) client, etc.):
+//   - validates AIS endpoint URL;
+//   - unless the client is explicitly specified, creates one via cmn.NewDefaultClients (and the scheme
+//     of the URL - http or https - determines which one to use);
+//   - when TLS is specified - via BPTLS option and/or environment (see cmn.EnvToTLS) - loads
+//     certificates and returns an error (if any) right away;
+//   - unless the token is explicitly specified, loads one from the token file (BPTokenFile option)
+//     or, otherwise, via the default chain of token providers (see LoadToken);
+//   - rejects conflicting options.
+//
+// Example:
+//
+//	bp, err := api.NewBaseParams("https://10.0.0.1:51080", api.BPTimeout(time.Minute), api.BPUA("my-app"))
+func NewBaseParams(endpoint string, opts ...BPOpt) (bp BaseParams, err error) {
+	args := bpArgs{timeout: -1}
+	for _, opt := range opts {
+		opt(&args)
+	}
+	u, err := validateEndpoint(endpoint)
+	if err != nil {
+		return bp, err
+	}
+	bp.URL, bp.UA, bp.Cache = u.String(), args.ua, args.cache
+	https := u.Scheme == "https"
+
+	// 1. client
+	switch {
+	case args.client != nil:
+		if args.timeout >= 0 || args.tls != nil {
+			return bp, errors.New("api: client option cannot be used together with timeout and/or TLS options")
+		}
+		bp.Client = args.client
+	case args.tls != nil && !https:
+		return bp, fmt.Errorf("api: TLS option requires https endpoint, got %q", endpoint)
+	default:
+		if args.timeout < 0 {
+			args.timeout = DefaultBPTimeout
+		}
+		if bp.Client, err = _bpClient(&args, https); err != nil {
+			return bp, err
+		}
+	}
+
+	// 2. token
+	switch {
+	case args.noToken:
+		if args.token != "" || args.tokenFile != "" {
+			return bp, errors.New("api: no-token option cannot be used together with token options")
+		}
+	case args.token != "":
+		if args.tokenFile != "" {
+			return bp, errors.New("api: token and token-file options are mutually exclusive")
+		}
+		bp.Token = args.token
+	default:
+		bp.Token, err = _bpToken(args.tokenFile)
+	}
+	return bp, err
+}
+
+func validateEndpoint(endpoint string) (*url.URL, error) {
+	if endpoint == "" {
+		return nil, errors.New("api: empty endpoint URL")
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("api: invalid endpoint URL %q: %v", endpoint, err)
+	}
+	switch {
+	case u.Scheme != "http" && u.Scheme != "https":
+		err = fmt.Errorf("api: invalid endpoint URL %q: expecting http or https scheme", endpoint)
+	case u.Host == "":
+		err = fmt.Errorf("api: invalid endpoint URL %q: missing host", endpoint)
+	case u.RawQuery != "" || u.Fragment != "" || u.User != nil:
+		err = fmt.Errorf("api: invalid endpoint URL %q: unexpected query, fragment, and/or user info", endpoint)
+	}
+	if err != nil {
+		return nil, err
+	}
+	u.Path = strings.TrimRight(u.Path, "/")
+	return u, nil
+}
+
+func _bpClient(args *bpArgs, https bool) (*http.Client, error) {
+	var sargs cmn.TLSArgs
+	if args.tls != nil {
+		sargs = *args.tls
+	} else if https {
+		cmn.EnvToTLS(&sargs)
+	}
+	if !https || sargs == (cmn.TLSArgs{}) {
+		clientH, clientTLS := cmn.NewDefaultClients(args.timeout)
+		if https {
+			return clientTLS, nil
+		}
+		return clientH, nil
+	}
+	// (unlike cmn.NewClientTLS that terminates on error)
+	tlsConf, err := cmn.NewTLS(sargs, false /*intra-cluster*/)
+	if err != nil {
+		return nil, err
+	}
+	transport := cmn.NewTransport(cmn.TransportArgs{Timeout: args.timeout})
+	transport.TLSClientConfig = tlsConf
+	return &http.Client{Transport: transport, Timeout: args.timeout}, nil
+}
+
+func _bpToken(tokenFile string) (string, error) {
+	if tokenFile != "" {
+		token, _, err := ResolveToken(TokenFile(tokenFile, true /*must exist*/))
+		return token, err
+	}
+	return LoadToken("")
+}
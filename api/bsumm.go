@@ -176,6 +176,8 @@ func _binfo(reqParams *ReqParams, bck cmn.Bck, args *BinfoArgs) (xid string, p *
 // and the numbers of objects, both _in_ the cluster and remote
 // GetBucketSummary supports a single specified bucket or multiple buckets, as per `cmn.QueryBcks` query.
 // (e.g., GetBucketSummary with an empty bucket query will return "summary" info for all buckets)
+// With msg.FromCache, returns the most recent completed summary cached by the proxy (if any) right away,
+// with empty xid; see also BsummResult.Computed.
 func GetBucketSummary(bp BaseParams, qbck cmn.QueryBcks, msg *apc.BsummCtrlMsg, args BsummArgs) (xid string,
 	res cmn.AllBsummResults, err error) {
 	if msg == nil {
@@ -226,6 +228,9 @@ func _bsumm(reqParams *ReqParams, msg *apc.BsummCtrlMsg, res *cmn.AllBsummResult
 	if err != nil {
 		return xid, err
 	}
+	if status == http.StatusOK && msg.FromCache {
+		return "", _bsummCached(xid, res, args.Callback)
+	}
 	if status != http.StatusAccepted {
 		return xid, _invalidStatus(status)
 	}
@@ -273,7 +278,11 @@ func _bsummDontWait(reqParams *ReqParams, msg *apc.BsummCtrlMsg, res *cmn.AllBsu
 	if news {
 		status, err = reqParams.doReqStr(&xid)
 		if err == nil {
-			if status != http.StatusAccepted {
+			switch {
+			case status == http.StatusOK && msg.FromCache:
+				err = _bsummCached(xid, res, nil)
+				xid = ""
+			case status != http.StatusAccepted:
 				err = _invalidStatus(status)
 			}
 		}
@@ -294,6 +303,17 @@ func _bsummDontWait(reqParams *ReqParams, msg *apc.BsummCtrlMsg, res *cmn.AllBsu
 	return
 }
 
+// cache hit (compare with _bsumm polling)
+func _bsummCached(body string, res *cmn.AllBsummResults, cb BsummCB) error {
+	if err := jsoniter.Unmarshal(cos.UnsafeB(body), res); err != nil {
+		return err
+	}
+	if cb != nil {
+		cb(res, true)
+	}
+	return nil
+}
+
 func _invalidStatus(status int) error {
 	return &cmn.ErrHTTP{
 		Message: fmt.Sprintf(fmtErrStatus, status),
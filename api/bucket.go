@@ -29,6 +29,41 @@ func ResetBucketProps(bp BaseParams, bck cmn.Bck) (string, error) {
 	return patchBprops(bp, bck, b)
 }
 
+// SetBucketPropsDryRun validates props-to-update and returns the resulting (effective) props,
+// the differences vs. current props, and the xactions (if any) that the change would trigger -
+// without changing anything.
+func SetBucketPropsDryRun(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet) (*cmn.BpropsDryRun, error) {
+	b := cos.MustMarshal(apc.ActMsg{Action: apc.ActSetBprops, Value: props})
+	return patchBpropsDryRun(bp, bck, b)
+}
+
+// ResetBucketPropsDryRun: same as above for resetting bucket props to the global configuration.
+func ResetBucketPropsDryRun(bp BaseParams, bck cmn.Bck) (*cmn.BpropsDryRun, error) {
+	b := cos.MustMarshal(apc.ActMsg{Action: apc.ActResetBprops})
+	return patchBpropsDryRun(bp, bck, b)
+}
+
+func patchBpropsDryRun(bp BaseParams, bck cmn.Bck, body []byte) (*cmn.BpropsDryRun, error) {
+	bp.Method = http.MethodPatch
+	q := bck.NewQuery()
+	q.Set(apc.QparamDryRun, "true")
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = body
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	res := &cmn.BpropsDryRun{}
+	_, err := reqParams.DoReqAny(res)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func patchBprops(bp BaseParams, bck cmn.Bck, body []byte) (xid string, err error) {
 	bp.Method = http.MethodPatch
 	path := apc.URLPathBuckets.Join(bck.Name)
@@ -86,6 +121,67 @@ func HeadBucket(bp BaseParams, bck cmn.Bck, dontAddRemote bool) (p *cmn.Bprops,
 	return
 }
 
+// CheckBucketAccess is a pre-flight check to fail fast (with a clear message) prior to
+// bulk operations: verify that a given bucket exists and the caller (and, with AuthN,
+// the caller's token) can perform all the requested operations, e.g.:
+// `api.CheckBucketAccess(bp, bck, apc.AceGET|apc.AcePUT)`.
+// Does not add remote bucket to the cluster's BMD. Returns:
+// - nil if all requested operations are permitted;
+// - *cmn.ErrBckNotFound or *cmn.ErrRemoteBckNotFound if the bucket does not exist;
+// - *cmn.ErrUnauthorized if the token is missing, invalid, or expired;
+// - *cmn.ErrForbidden if denied by the bucket's ACL, the token's permissions, or the remote backend;
+// - any other error as is.
+func CheckBucketAccess(bp BaseParams, bck cmn.Bck, access apc.AccessAttrs) error {
+	q := make(url.Values, 4)
+	q.Set(apc.QparamDontAddRemote, "true")
+	if access != 0 {
+		q.Set(apc.QparamCheckAccess, access.String())
+	}
+	q = bck.AddToQuery(q)
+
+	bp.Method = http.MethodHead
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Query = q
+	}
+	_, _, err := reqParams.doReqHdr()
+	FreeRp(reqParams)
+	if err == nil {
+		return nil
+	}
+	herr, ok := err.(*cmn.ErrHTTP)
+	if !ok {
+		return err
+	}
+	switch herr.Status {
+	case http.StatusNotFound:
+		if bck.IsRemote() {
+			return cmn.NewErrRemoteBckNotFound(&bck)
+		}
+		return cmn.NewErrBckNotFound(&bck)
+	case http.StatusUnauthorized:
+		return cmn.NewErrUnauthorized(&bck, _cause(herr))
+	case http.StatusForbidden:
+		return cmn.NewErrForbidden(&bck, _cause(herr))
+	default:
+		return herr
+	}
+}
+
+// HEAD response carries JSON-encoded error in the apc.HdrError header (see cmn.ErrHTTP)
+func _cause(herr *cmn.ErrHTTP) string {
+	var inner cmn.ErrHTTP
+	if err := jsoniter.UnmarshalFromString(herr.Message, &inner); err == nil && inner.Message != "" {
+		return inner.Message
+	}
+	if herr.Message == "" {
+		return http.StatusText(herr.Status)
+	}
+	return herr.Message
+}
+
 // fill-in herr message (HEAD response will never contain one)
 func hdr2msg(bck cmn.Bck, status int, err error) error {
 	herr, ok := err.(*cmn.ErrHTTP)
@@ -144,6 +240,7 @@ func CreateBucket(bp BaseParams, bck cmn.Bck, props *cmn.BpropsToSet, dontHeadRe
 
 // DestroyBucket sends request to remove an AIS bucket with the given name.
 func DestroyBucket(bp BaseParams, bck cmn.Bck) error {
+	uncacheBck(&bp, &bck)
 	bp.Method = http.MethodDelete
 	reqParams := AllocRp()
 	{
@@ -179,6 +276,7 @@ func DestroyBucket(bp BaseParams, bck cmn.Bck) error {
 //
 // Returns xaction ID if successful, an error otherwise. See also closely related api.ETLBucket
 func CopyBucket(bp BaseParams, bckFrom, bckTo cmn.Bck, msg *apc.CopyBckMsg, fltPresence ...int) (xid string, err error) {
+	uncacheBck(&bp, &bckTo)
 	if err = bckTo.Validate(); err != nil {
 		return
 	}
@@ -204,6 +302,7 @@ func CopyBucket(bp BaseParams, bckFrom, bckTo cmn.Bck, msg *apc.CopyBckMsg, fltP
 // RenameBucket renames bckFrom as bckTo.
 // Returns xaction ID if successful, an error otherwise.
 func RenameBucket(bp BaseParams, bckFrom, bckTo cmn.Bck) (xid string, err error) {
+	uncacheBck(&bp, &bckFrom)
 	if err = bckTo.Validate(); err != nil {
 		return
 	}
@@ -226,6 +325,7 @@ func RenameBucket(bp BaseParams, bckFrom, bckTo cmn.Bck) (xid string, err error)
 // EvictRemoteBucket sends request to evict an entire remote bucket from the AIStore
 // - keepMD: evict objects but keep bucket metadata
 func EvictRemoteBucket(bp BaseParams, bck cmn.Bck, keepMD bool) error {
+	uncacheBck(&bp, &bck)
 	var q url.Values
 	if keepMD {
 		q = url.Values{apc.QparamKeepRemote: []string{"true"}}
@@ -0,0 +1,267 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"container/list"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// Client-side cache (opt-in) for immutable reads - to cut request amplification for tools
+// (e.g., data loaders) that repeatedly stat the same objects:
+// - caches HEAD(object) properties, cluster map (Smap), and bucket metadata (BMD);
+// - bounded in size (LRU) and time (TTL);
+// - cached object props are evicted upon PUT, APPEND, DELETE, rename, evict, and set-custom-props
+//   executed via the same BaseParams; ditto multi-object delete, evict, copy, and transform,
+//   and bucket-level destroy, evict, rename, copy, and transform (destination);
+// - version-based invalidation: a newer BMD with a given bucket destroyed or re-created (different BID)
+//   evicts all the bucket's cached objects; cached Smap and BMD never go back in version (when, e.g.,
+//   a lagging node returns an older one);
+// - HEAD(object) with LatestVer, ValidateCksum, and/or presence filter other than apc.FltExists (default)
+//   and apc.FltPresent always goes to the cluster.
+//
+// Intended for buckets with immutable content - out-of-band updates (by other clients) remain visible only
+// upon TTL expiration.
+//
+// Usage:
+//
+//	bp, err := api.NewBaseParams(endpoint, api.BPCache(api.NewCache(4096, time.Minute)))
+
+const (
+	DefaultCacheSize = 1024
+	DefaultCacheTTL  = time.Minute
+)
+
+type (
+	Cache struct {
+		lru    *list.List               // front: most recently used
+		objs   map[string]*list.Element // by bck.Cname(objName)
+		smap   *meta.Smap
+		bmd    *meta.BMD
+		smapT  int64 // mono time of the last Smap refresh
+		bmdT   int64 // ditto BMD
+		ttl    int64
+		size   int
+		mu     sync.Mutex
+		hits   atomic.Int64
+		misses atomic.Int64
+	}
+	cachedProps struct {
+		props *cmn.ObjectProps
+		key   string
+		bck   cmn.Bck
+		added int64 // mono time
+	}
+	CacheStats struct {
+		Hits   int64
+		Misses int64
+		Len    int
+	}
+)
+
+// NewCache creates client-side cache; non-positive size and ttl - use defaults
+func NewCache(size int, ttl time.Duration) *Cache {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	return &Cache{lru: list.New(), objs: make(map[string]*list.Element, size), size: size, ttl: int64(ttl)}
+}
+
+// BPCache specifies client-side cache (see NewCache).
+func BPCache(cache *Cache) BPOpt { return func(a *bpArgs) { a.cache = cache } }
+
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	l := c.lru.Len()
+	c.mu.Unlock()
+	return CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load(), Len: l}
+}
+
+// Purge drops all cached entries.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	c.lru.Init()
+	clear(c.objs)
+	c.smap, c.bmd = nil, nil
+	c.mu.Unlock()
+}
+
+func (c *Cache) expired(t int64) bool { return mono.Since(t) > time.Duration(c.ttl) }
+
+//
+// HEAD(object)
+//
+
+func cacheable(args *HeadArgs) bool {
+	if args.FltPresence != apc.FltExists && args.FltPresence != apc.FltPresent {
+		return false
+	}
+	return !args.LatestVer && !args.ValidateCksum
+}
+
+// returns a copy
+func (c *Cache) getProps(bck *cmn.Bck, objName string) *cmn.ObjectProps {
+	key := bck.Cname(objName)
+	c.mu.Lock()
+	el, ok := c.objs[key]
+	if ok {
+		cp := el.Value.(*cachedProps)
+		if !c.expired(cp.added) {
+			c.lru.MoveToFront(el)
+			op := *cp.props
+			op.CustomMD = maps.Clone(cp.props.CustomMD)
+			c.mu.Unlock()
+			c.hits.Inc()
+			return &op
+		}
+		c.remove(el)
+	}
+	c.mu.Unlock()
+	c.misses.Inc()
+	return nil
+}
+
+func (c *Cache) putProps(bck *cmn.Bck, objName string, props *cmn.ObjectProps) {
+	var (
+		key = bck.Cname(objName)
+		op  = *props
+		now = mono.NanoTime()
+	)
+	op.CustomMD = maps.Clone(props.CustomMD)
+	c.mu.Lock()
+	if el, ok := c.objs[key]; ok {
+		cp := el.Value.(*cachedProps)
+		cp.props, cp.added = &op, now
+		c.lru.MoveToFront(el)
+	} else {
+		c.objs[key] = c.lru.PushFront(&cachedProps{props: &op, key: key, bck: *bck, added: now})
+		for c.lru.Len() > c.size {
+			c.remove(c.lru.Back())
+		}
+	}
+	c.mu.Unlock()
+}
+
+// under lock
+func (c *Cache) remove(el *list.Element) {
+	cp := c.lru.Remove(el).(*cachedProps)
+	delete(c.objs, cp.key)
+}
+
+func (c *Cache) evictObj(bck *cmn.Bck, objName string) {
+	c.mu.Lock()
+	if el, ok := c.objs[bck.Cname(objName)]; ok {
+		c.remove(el)
+	}
+	c.mu.Unlock()
+}
+
+// upon modification via the same BaseParams
+func uncache(bp *BaseParams, bck *cmn.Bck, objName string) {
+	if bp.Cache != nil {
+		bp.Cache.evictObj(bck, objName)
+	}
+}
+
+func uncacheBck(bp *BaseParams, bck *cmn.Bck) {
+	if bp.Cache != nil {
+		bp.Cache.evictBck(bck)
+	}
+}
+
+func (c *Cache) evictBck(bck *cmn.Bck) {
+	c.mu.Lock()
+	c._evictBck(bck)
+	c.mu.Unlock()
+}
+
+// under lock
+func (c *Cache) _evictBck(bck *cmn.Bck) {
+	for el := c.lru.Front(); el != nil; {
+		next := el.Next()
+		if cp := el.Value.(*cachedProps); cp.bck.Equal(bck) {
+			c.remove(el)
+		}
+		el = next
+	}
+}
+
+//
+// Smap and BMD
+//
+
+func (c *Cache) getSmap() *meta.Smap {
+	c.mu.Lock()
+	smap := c.smap
+	if smap != nil && c.expired(c.smapT) {
+		smap = nil
+	}
+	c.mu.Unlock()
+	c._hit(smap != nil)
+	return smap
+}
+
+// returns the newer one
+func (c *Cache) putSmap(smap *meta.Smap) *meta.Smap {
+	c.mu.Lock()
+	if c.smap == nil || smap.Version >= c.smap.Version {
+		c.smap = smap
+	}
+	c.smapT = mono.NanoTime()
+	smap = c.smap
+	c.mu.Unlock()
+	return smap
+}
+
+func (c *Cache) getBMD() *meta.BMD {
+	c.mu.Lock()
+	bmd := c.bmd
+	if bmd != nil && c.expired(c.bmdT) {
+		bmd = nil
+	}
+	c.mu.Unlock()
+	c._hit(bmd != nil)
+	return bmd
+}
+
+// returns the newer one; evicts cached objects of the buckets that were destroyed or re-created
+func (c *Cache) putBMD(bmd *meta.BMD) *meta.BMD {
+	c.mu.Lock()
+	switch {
+	case c.bmd == nil:
+		c.bmd = bmd
+	case bmd.Version > c.bmd.Version:
+		c.bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+			if props, present := bmd.Get(bck); !present || props.BID != bck.Props.BID {
+				c._evictBck(bck.Bucket())
+			}
+			return false
+		})
+		c.bmd = bmd
+	}
+	c.bmdT = mono.NanoTime()
+	bmd = c.bmd
+	c.mu.Unlock()
+	return bmd
+}
+
+func (c *Cache) _hit(hit bool) {
+	if hit {
+		c.hits.Inc()
+	} else {
+		c.misses.Inc()
+	}
+}
@@ -0,0 +1,92 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCacheLRU(t *testing.T) {
+	var (
+		c   = NewCache(2, time.Minute)
+		bck = cmn.Bck{Name: "b", Provider: apc.AIS}
+	)
+	for _, name := range []string{"o1", "o2"} {
+		c.putProps(&bck, name, &cmn.ObjectProps{Name: name, ObjAttrs: cmn.ObjAttrs{Size: 1}})
+	}
+	tassert.Fatalf(t, c.getProps(&bck, "o1") != nil, "expecting o1")
+	c.putProps(&bck, "o3", &cmn.ObjectProps{Name: "o3"}) // evicts o2 (the least recently used)
+
+	tassert.Errorf(t, c.getProps(&bck, "o2") == nil, "o2 must've been evicted")
+	tassert.Errorf(t, c.getProps(&bck, "o3") != nil, "expecting o3")
+
+	// returns a copy
+	op := c.getProps(&bck, "o1")
+	op.Size = 100
+	op = c.getProps(&bck, "o1")
+	tassert.Errorf(t, op.Size == 1, "expecting cached size 1, got %d", op.Size)
+
+	c.evictObj(&bck, "o1")
+	tassert.Errorf(t, c.getProps(&bck, "o1") == nil, "o1 must've been evicted")
+
+	stats := c.Stats()
+	tassert.Errorf(t, stats.Len == 1, "expecting 1 entry, got %d", stats.Len)
+	tassert.Errorf(t, stats.Hits == 4 && stats.Misses == 2, "unexpected hits/misses: %+v", stats)
+}
+
+func TestCacheTTL(t *testing.T) {
+	var (
+		c   = NewCache(10, time.Millisecond)
+		bck = cmn.Bck{Name: "b", Provider: apc.AIS}
+	)
+	c.putProps(&bck, "o", &cmn.ObjectProps{Name: "o"})
+	c.putSmap(&meta.Smap{Version: 1})
+	time.Sleep(5 * time.Millisecond)
+	tassert.Errorf(t, c.getProps(&bck, "o") == nil, "expecting expired props")
+	tassert.Errorf(t, c.getSmap() == nil, "expecting expired Smap")
+}
+
+func TestCacheVersions(t *testing.T) {
+	var (
+		c      = NewCache(10, time.Minute)
+		bck1   = meta.NewBck("b1", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 1})
+		bck2   = meta.NewBck("b2", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 2})
+		bck3   = meta.NewBck("b3", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 3})
+		newBMD = func(ver int64, bcks ...*meta.Bck) *meta.BMD {
+			bmd := &meta.BMD{Version: ver, Providers: make(meta.Providers, 1)}
+			for _, bck := range bcks {
+				bmd.Add(bck)
+			}
+			return bmd
+		}
+	)
+	// Smap never goes back
+	c.putSmap(&meta.Smap{Version: 5})
+	smap := c.putSmap(&meta.Smap{Version: 4})
+	tassert.Errorf(t, smap.Version == 5, "expecting Smap v5, got v%d", smap.Version)
+
+	c.putBMD(newBMD(10, bck1, bck2, bck3))
+	for _, bck := range []*meta.Bck{bck1, bck2, bck3} {
+		c.putProps(bck.Bucket(), "o", &cmn.ObjectProps{Name: "o"})
+	}
+
+	// older BMD: ignored
+	bmd := c.putBMD(newBMD(9))
+	tassert.Errorf(t, bmd.Version == 10, "expecting BMD v10, got v%d", bmd.Version)
+	tassert.Errorf(t, c.getProps(bck1.Bucket(), "o") != nil, "expecting b1/o")
+
+	// newer BMD: b1 destroyed, b2 re-created, b3 unchanged
+	recreated := meta.NewBck("b2", apc.AIS, cmn.NsGlobal, &cmn.Bprops{BID: 22})
+	c.putBMD(newBMD(11, recreated, bck3))
+	tassert.Errorf(t, c.getProps(bck1.Bucket(), "o") == nil, "b1/o must've been evicted")
+	tassert.Errorf(t, c.getProps(bck2.Bucket(), "o") == nil, "b2/o must've been evicted")
+	tassert.Errorf(t, c.getProps(bck3.Bucket(), "o") != nil, "expecting b3/o")
+}
@@ -28,11 +28,18 @@ const (
 
 type (
 	BaseParams struct {
-		Client *http.Client
-		URL    string
-		Method string
-		Token  string
-		UA     string
+		Client      *http.Client
+		TokenSource TokenSource // when set, takes precedence over the (static) Token
+		Cache       *Cache      // optional client-side cache (see cache.go)
+		URL         string
+		Method      string
+		Token       string
+		UA          string
+	}
+
+	// provides AuthN token for each request and refreshes it as needed (see authn.TokenRefresher)
+	TokenSource interface {
+		Token() (string, error)
 	}
 
 	// ReqParams is used in constructing client-side API requests to aistore.
@@ -85,8 +92,15 @@ func HTTPStatus(err error) int {
 }
 
 func SetAuxHeaders(r *http.Request, bp *BaseParams) {
-	if bp.Token != "" {
-		r.Header.Set(apc.HdrAuthorization, apc.AuthenticationTypeBearer+" "+bp.Token)
+	token := bp.Token
+	if bp.TokenSource != nil {
+		// (upon failure to refresh, the current token gets returned anyway)
+		if tok, _ := bp.TokenSource.Token(); tok != "" {
+			token = tok
+		}
+	}
+	if token != "" {
+		r.Header.Set(apc.HdrAuthorization, apc.AuthenticationTypeBearer+" "+token)
 	}
 	if bp.UA != "" {
 		r.Header.Set(cos.HdrUserAgent, bp.UA)
@@ -174,15 +188,24 @@ func (reqParams *ReqParams) doWriter(w io.Writer) (wresp *wrappedResp, err error
 
 // same as above except that it returns response body (as io.ReadCloser) for subsequent reading
 func (reqParams *ReqParams) doReader() (io.ReadCloser, int64, error) {
-	resp, err := reqParams.do()
+	resp, err := reqParams.doReaderResp()
 	if err != nil {
 		return nil, 0, err
 	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+// ditto, returning the entire (checked) response with its body unread
+func (reqParams *ReqParams) doReaderResp() (*http.Response, error) {
+	resp, err := reqParams.do()
+	if err != nil {
+		return nil, err
+	}
 	if err := reqParams.checkResp(resp); err != nil {
 		resp.Body.Close()
-		return nil, 0, err
+		return nil, err
 	}
-	return resp.Body, resp.ContentLength, nil
+	return resp, nil
 }
 
 // makes HTTP request, retries on connection-refused and reset errors, and returns the response
@@ -336,6 +359,14 @@ func (reqParams *ReqParams) readValidate(resp *http.Response, w io.Writer) (*wra
 
 func (reqParams *ReqParams) checkResp(resp *http.Response) error {
 	if resp.StatusCode < http.StatusBadRequest {
+		if resp.StatusCode == http.StatusNotModified { // conditional GET (see CondArgs)
+			return &cmn.ErrHTTP{
+				Message: http.StatusText(http.StatusNotModified),
+				Status:  resp.StatusCode,
+				Method:  reqParams.BaseParams.Method,
+				URLPath: reqParams.Path,
+			}
+		}
 		return nil
 	}
 	if reqParams.BaseParams.Method == http.MethodHead {
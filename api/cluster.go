@@ -11,8 +11,11 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/certloader"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/reb"
+	"github.com/NVIDIA/aistore/stats"
 )
 
 // to be used by external watchdogs (Kubernetes, etc.)
@@ -66,6 +69,11 @@ func mkhealth(bp BaseParams, readyToRebalance ...bool) (reqParams *ReqParams) {
 
 // get cluster map from a BaseParams-referenced node
 func GetClusterMap(bp BaseParams) (smap *meta.Smap, err error) {
+	if bp.Cache != nil {
+		if smap = bp.Cache.getSmap(); smap != nil {
+			return smap, nil
+		}
+	}
 	bp.Method = http.MethodGet
 	reqParams := AllocRp()
 	{
@@ -75,6 +83,9 @@ func GetClusterMap(bp BaseParams) (smap *meta.Smap, err error) {
 	}
 	_, err = reqParams.DoReqAny(&smap)
 	FreeRp(reqParams)
+	if err == nil && bp.Cache != nil {
+		smap = bp.Cache.putSmap(smap)
+	}
 	return smap, err
 }
 
@@ -95,6 +106,11 @@ func GetNodeClusterMap(bp BaseParams, sid string) (smap *meta.Smap, err error) {
 
 // get bucket metadata (BMD) from a BaseParams-referenced node
 func GetBMD(bp BaseParams) (bmd *meta.BMD, err error) {
+	if bp.Cache != nil {
+		if bmd = bp.Cache.getBMD(); bmd != nil {
+			return bmd, nil
+		}
+	}
 	bp.Method = http.MethodGet
 	reqParams := AllocRp()
 	{
@@ -106,6 +122,9 @@ func GetBMD(bp BaseParams) (bmd *meta.BMD, err error) {
 	bmd = &meta.BMD{}
 	_, err = reqParams.DoReqAny(bmd)
 	FreeRp(reqParams)
+	if err == nil && bp.Cache != nil {
+		bmd = bp.Cache.putBMD(bmd)
+	}
 	return bmd, err
 }
 
@@ -156,6 +175,36 @@ func GetClusterSysInfo(bp BaseParams) (info apc.ClusterSysInfo, err error) {
 	return
 }
 
+// GetClusterCertInfo returns TLS certificates currently loaded by all nodes, keyed by node ID
+// (a node that does not use TLS returns empty info)
+func GetClusterCertInfo(bp BaseParams) (info map[string]*certloader.Info, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatCertificate}}
+	}
+	_, err = reqParams.DoReqAny(&info)
+	FreeRp(reqParams)
+	return
+}
+
+// GetClusterNetIfaces returns network interfaces (NICs) used by all nodes for each traffic class
+// (public, intra-cluster control, and intra-cluster data), keyed by node ID
+func GetClusterNetIfaces(bp BaseParams) (info map[string][]apc.NetIface, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatNetIfaces}}
+	}
+	_, err = reqParams.DoReqAny(&info)
+	FreeRp(reqParams)
+	return
+}
+
 func GetRemoteAIS(bp BaseParams) (remais meta.RemAisVec, err error) {
 	bp.Method = http.MethodGet
 	reqParams := AllocRp()
@@ -169,6 +218,21 @@ func GetRemoteAIS(bp BaseParams) (remais meta.RemAisVec, err error) {
 	return
 }
 
+// PingRemoteAIS measures latencies from each target in the cluster to each node
+// of each attached remote AIS cluster; returns results indexed by (local) target ID
+func PingRemoteAIS(bp BaseParams) (out map[string][]*meta.RemAisPing, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatRemAisPing}}
+	}
+	_, err = reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	return
+}
+
 // (see also enable/disable backend below)
 func GetConfiguredBackends(bp BaseParams) (out []string, err error) {
 	bp.Method = http.MethodGet
@@ -238,6 +302,14 @@ func SetClusterConfig(bp BaseParams, nvs cos.StrKVs, transient bool) error {
 	return err
 }
 
+// SetClusterReadOnly enables (or disables) cluster-wide read-only mode, in which
+// all requests that modify user data or bucket metadata fail with
+// 503 (Service Unavailable) while reads continue to be served.
+func SetClusterReadOnly(bp BaseParams, readOnly bool) error {
+	toUpdate := &cmn.ConfigToSet{Proxy: &cmn.ProxyConfToSet{ReadOnly: &readOnly}}
+	return SetClusterConfigUsingMsg(bp, toUpdate, false /*transient*/)
+}
+
 // SetClusterConfigUsingMsg sets the cluster-wide configuration
 // using the `cmn.ConfigToSet` parameter provided.
 func SetClusterConfigUsingMsg(bp BaseParams, configToUpdate *cmn.ConfigToSet, transient bool) error {
@@ -322,6 +394,46 @@ func GetClusterConfig(bp BaseParams) (*cmn.ClusterConfig, error) {
 	return cluConfig, nil
 }
 
+// GetClusterAlerts returns active (firing and pending) alerts followed by recently
+// resolved ones, as evaluated by the primary proxy (see cmn.AlertsConf)
+func GetClusterAlerts(bp BaseParams) (alerts stats.Alerts, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatAlerts}}
+	}
+	_, err = reqParams.DoReqAny(&alerts)
+	FreeRp(reqParams)
+	return alerts, err
+}
+
+// EstimateRebalance returns rebalance plan - objects and bytes that global rebalance would
+// move between targets, and projected duration - without moving any data;
+// non-empty `exclude` (target ID) estimates rebalance as if the target were leaving the cluster
+// NOTE: all targets walk all their objects - the call may take a while
+func EstimateRebalance(bp BaseParams, exclude string) (*reb.Plan, error) {
+	q := url.Values{apc.QparamWhat: []string{apc.WhatRebEstimate}}
+	if exclude != "" {
+		q.Set(apc.QparamRebExclude, exclude)
+	}
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = q
+	}
+	plan := &reb.Plan{}
+	_, err := reqParams.DoReqAny(plan)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
 func AttachRemoteAIS(bp BaseParams, alias, u string) error {
 	bp.Method = http.MethodPut
 	reqParams := AllocRp()
@@ -52,6 +52,53 @@ func AttachMountpath(bp BaseParams, node *meta.Snode, mountpath string, label ..
 	return _actMpath(bp, node, mountpath, apc.ActMountpathAttach, q)
 }
 
+// ProbeMountpath runs the onboarding sequence (filesystem, permissions, and xattr checks,
+// plus a short benchmark) on a new mountpath without attaching it
+func ProbeMountpath(bp BaseParams, node *meta.Snode, mountpath string, label ...ios.Label) (*apc.MpathProbe, error) {
+	var q url.Values
+	if len(label) > 0 {
+		if lb := string(label[0]); lb != "" {
+			q = url.Values{apc.QparamMpathLabel: []string{lb}}
+		}
+	}
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.Join(apc.Mountpaths) // NOTE: reverse, via p.reverseHandler
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActMountpathProbe, Value: mountpath})
+		reqParams.Header = http.Header{
+			apc.HdrNodeID:      []string{node.ID()},
+			cos.HdrContentType: []string{cos.ContentJSON},
+		}
+		reqParams.Query = q
+	}
+	mpp := &apc.MpathProbe{}
+	_, err := reqParams.DoReqAny(mpp)
+	FreeRp(reqParams)
+	return mpp, err
+}
+
+// Selftest runs a given self-test (apc.SelftestDisk or apc.SelftestNet) on a given target
+// and returns the measurements (see apc.ActSelftest)
+func Selftest(bp BaseParams, node *meta.Snode, msg *apc.SelftestMsg) (*apc.TargetSelftest, error) {
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseDae.S // NOTE: reverse, via p.reverseHandler
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActSelftest, Value: msg})
+		reqParams.Header = http.Header{
+			apc.HdrNodeID:      []string{node.ID()},
+			cos.HdrContentType: []string{cos.ContentJSON},
+		}
+	}
+	res := &apc.TargetSelftest{}
+	_, err := reqParams.DoReqAny(res)
+	FreeRp(reqParams)
+	return res, err
+}
+
 func EnableMountpath(bp BaseParams, node *meta.Snode, mountpath string) error {
 	bp.Method = http.MethodPost
 	return _actMpath(bp, node, mountpath, apc.ActMountpathEnable, nil)
@@ -6,6 +6,7 @@ package api
 
 import (
 	"net/http"
+	"net/url"
 	"sort"
 	"time"
 
@@ -77,6 +78,17 @@ func DownloadBackend(bp BaseParams, descr string, bck cmn.Bck, prefix, suffix st
 	return DownloadWithParam(bp, dload.TypeBackend, dlBody)
 }
 
+// import from a directory (absolute path) that all targets can access, e.g. mounted NFS
+func DownloadFS(bp BaseParams, descr string, bck cmn.Bck, dir, prefix string, recursive bool, ivals ...time.Duration) (string, error) {
+	dlBody := dload.FSBody{Path: dir, Prefix: prefix, Recursive: recursive}
+	if len(ivals) > 0 {
+		dlBody.ProgressInterval = ivals[0].String()
+	}
+	dlBody.Bck = bck
+	dlBody.Description = descr
+	return DownloadWithParam(bp, dload.TypeFS, dlBody)
+}
+
 func DownloadStatus(bp BaseParams, id string, onlyActive bool) (dlStatus *dload.StatusResp, err error) {
 	dlBody := dload.AdminBody{ID: id, OnlyActive: onlyActive}
 	bp.Method = http.MethodGet
@@ -140,6 +152,170 @@ func RemoveDownload(bp BaseParams, id string) error {
 	return err
 }
 
+// ScheduleDownload creates a new recurring download: the same download job (see
+// `DownloadWithParam`) will run every `interval`, starting now (unless `disabled`).
+// Returns the schedule ID (compare with the download job ID).
+func ScheduleDownload(bp BaseParams, interval time.Duration, dlt dload.Type, body any, disabled bool) (string, error) {
+	msg := dload.ScheduleMsg{
+		Body:     dload.Body{Type: dlt, RawMessage: cos.MustMarshal(body)},
+		Interval: cos.Duration(interval),
+		Disabled: disabled,
+	}
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownloadSched.S
+		reqParams.Body = cos.MustMarshal(&msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	id, err := reqParams.doDlDownloadRequest()
+	FreeRp(reqParams)
+	return id, err
+}
+
+func GetDownloadSchedules(bp BaseParams) (ss dload.Schedules, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownloadSched.S
+	}
+	_, err = reqParams.DoReqAny(&ss)
+	FreeRp(reqParams)
+	return
+}
+
+func EnableDownloadSchedule(bp BaseParams, id string) error {
+	bp.Method = http.MethodPut
+	return dlschedAdm(bp, apc.URLPathDownloadSchedEnable.S, id)
+}
+
+func DisableDownloadSchedule(bp BaseParams, id string) error {
+	bp.Method = http.MethodPut
+	return dlschedAdm(bp, apc.URLPathDownloadSchedDisable.S, id)
+}
+
+func RemoveDownloadSchedule(bp BaseParams, id string) error {
+	bp.Method = http.MethodDelete
+	return dlschedAdm(bp, apc.URLPathDownloadSchedRemove.S, id)
+}
+
+func dlschedAdm(bp BaseParams, path, id string) error {
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = path
+		reqParams.Body = cos.MustMarshal(dload.AdminBody{ID: id})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// AddDownloadCreds adds a new (or updates existing) credentials profile that download jobs
+// can then reference by name (see `dload.Base.Creds`)
+func AddDownloadCreds(bp BaseParams, cp *dload.CredsProfile) error {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownloadCreds.S
+		reqParams.Body = cos.MustMarshal(cp)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// GetDownloadCreds returns all credentials profiles, with secrets redacted
+func GetDownloadCreds(bp BaseParams) (cps dload.CredsProfiles, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownloadCreds.S
+	}
+	_, err = reqParams.DoReqAny(&cps)
+	FreeRp(reqParams)
+	return
+}
+
+func RemoveDownloadCreds(bp BaseParams, name string) error {
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownloadCreds.Join(name)
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// AddDownloadPreset adds a new (or updates existing) named download job template
+// that can then be instantiated by name (see `DownloadPreset`)
+func AddDownloadPreset(bp BaseParams, ps *dload.Preset) error {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownloadPreset.S
+		reqParams.Body = cos.MustMarshal(ps)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+func GetDownloadPresets(bp BaseParams) (pss dload.Presets, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownloadPreset.S
+	}
+	_, err = reqParams.DoReqAny(&pss)
+	FreeRp(reqParams)
+	return
+}
+
+func RemoveDownloadPreset(bp BaseParams, name string) error {
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownloadPreset.Join(name)
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// DownloadPreset starts a new download job from the named preset;
+// `overrides` (optional) is a (type-specific) download body - e.g., `dload.BackendBody` or
+// `map[string]any{"description": "run #2"}` - whose fields override those of the preset
+// (see `dload.Preset.Instantiate`). Returns download job ID.
+func DownloadPreset(bp BaseParams, name string, overrides any) (id string, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDownload.S
+		reqParams.Query = url.Values{apc.QparamDlPreset: []string{name}}
+		if overrides != nil {
+			reqParams.Body = cos.MustMarshal(overrides)
+			reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		}
+	}
+	id, err = reqParams.doDlDownloadRequest()
+	FreeRp(reqParams)
+	return
+}
+
 // TODO: simplify `dload.DlPostResp` => string
 func (reqParams *ReqParams) doDlDownloadRequest() (string, error) {
 	var resp dload.DlPostResp
@@ -83,3 +83,18 @@ func MetricsDsort(bp BaseParams, managerUUID string) (metrics map[string]*dsort.
 	FreeRp(reqParams)
 	return metrics, err
 }
+
+// ResumeDsort resumes a given (aborted) resumable job - see `RequestSpec.Resumable`;
+// returns the ID of the new job
+func ResumeDsort(bp BaseParams, managerUUID string) (id string, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathdSortResume.S
+		reqParams.Query = url.Values{apc.QparamUUID: []string{managerUUID}}
+	}
+	_, err = reqParams.doReqStr(&id)
+	FreeRp(reqParams)
+	return
+}
@@ -31,6 +31,9 @@ var (
 		ClientCA      string
 		SkipVerifyCrt string
 
+		// proxy: downloader credentials profiles
+		DloadCredsKey string
+
 		// tests, CI
 		NumTarget string
 		NumProxy  string
@@ -62,6 +65,10 @@ var (
 		// TLS: common
 		SkipVerifyCrt: "AIS_SKIP_VERIFY_CRT", // cluster config: "net.http.skip_verify"
 
+		// secret to encrypt downloader credentials profiles (same on all proxies);
+		// when not set, the cluster UUID is used instead
+		DloadCredsKey: "AIS_DLOAD_CREDS_KEY",
+
 		// variables used in tests and CI
 		NumTarget: "NUM_TARGET",
 		NumProxy:  "NUM_PROXY",
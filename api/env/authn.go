@@ -11,7 +11,9 @@ var (
 	AuthN = struct {
 		Enabled       string
 		URL           string
+		Token         string
 		TokenFile     string
+		TokenSecret   string
 		ConfDir       string
 		LogDir        string
 		LogLevel      string
@@ -26,8 +28,10 @@ var (
 	}{
 		Enabled:       "AIS_AUTHN_ENABLED",
 		URL:           "AIS_AUTHN_URL",
-		TokenFile:     "AIS_AUTHN_TOKEN_FILE", // fully qualified
-		ConfDir:       "AIS_AUTHN_CONF_DIR",   // contains AuthN config and tokens DB
+		Token:         "AIS_AUTHN_TOKEN",        // token itself (takes precedence over token file - see api.LoadToken)
+		TokenFile:     "AIS_AUTHN_TOKEN_FILE",   // fully qualified
+		TokenSecret:   "AIS_AUTHN_TOKEN_SECRET", // Kubernetes secret mount (to override api.K8sTokenPath)
+		ConfDir:       "AIS_AUTHN_CONF_DIR",     // contains AuthN config and tokens DB
 		LogDir:        "AIS_AUTHN_LOG_DIR",
 		LogLevel:      "AIS_AUTHN_LOG_LEVEL",
 		Port:          "AIS_AUTHN_PORT",
@@ -36,6 +36,82 @@ func ETLInit(bp BaseParams, msg etl.InitMsg) (xid string, err error) {
 	return
 }
 
+// Validate ETL without starting it: all the checks that precede the actual ETL init
+// (including pod spec linting, comm-type compatibility, container images,
+// and ETL name collisions) are performed by the proxy and by each target.
+// See also: etl.DryRun
+func ETLInitDryRun(bp BaseParams, msg etl.InitMsg) (*etl.DryRunResult, error) {
+	var res etl.DryRunResult
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathETL.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Query = url.Values{apc.QparamDryRun: []string{"true"}}
+	}
+	_, err := reqParams.DoReqAny(&res)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Canary deployment: start a new version (`msg.Version()`) of the existing ETL `msg.Name()`
+// side by side with the current one, and route `pct` percent of inline transform requests to it.
+// See also: ETLSetCanary, ETLPromote, ETLRollback.
+func ETLInitCanary(bp BaseParams, msg etl.InitMsg, pct int) (xid string, err error) {
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathETL.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Query = url.Values{apc.QparamCanaryPct: []string{strconv.Itoa(pct)}}
+	}
+	_, err = reqParams.doReqStr(&xid)
+	FreeRp(reqParams)
+	return
+}
+
+// change the percentage of inline transform requests routed to the canary
+func ETLSetCanary(bp BaseParams, etlName string, pct int) (err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathETL.Join(etlName, apc.ETLCanary)
+		reqParams.Query = url.Values{apc.QparamCanaryPct: []string{strconv.Itoa(pct)}}
+	}
+	err = reqParams.DoRequest()
+	FreeRp(reqParams)
+	return
+}
+
+// replace the current version of the ETL with its canary (optionally, verifying
+// that the canary is the specified `version`);
+// returns ID of the (restarted) ETL xaction
+func ETLPromote(bp BaseParams, etlName, version string) (xid string, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathETL.Join(etlName, apc.ETLPromote)
+		if version != "" {
+			reqParams.Query = url.Values{apc.QparamETLVersion: []string{version}}
+		}
+	}
+	_, err = reqParams.doReqStr(&xid)
+	FreeRp(reqParams)
+	return
+}
+
+// stop and remove the canary
+func ETLRollback(bp BaseParams, etlName string) (err error) {
+	return etlPostAction(bp, etlName, apc.ETLRollback)
+}
+
 func ETLList(bp BaseParams) (list []etl.Info, err error) {
 	bp.Method = http.MethodGet
 	reqParams := AllocRp()
@@ -148,10 +224,18 @@ func etlPostAction(bp BaseParams, etlName, action string) (err error) {
 
 // TODO: add ETL-specific query param and change the examples/docs (!4455)
 func ETLObject(bp BaseParams, etlName string, bck cmn.Bck, objName string, w io.Writer) (err error) {
-	_, err = GetObject(bp, bck, objName, &GetArgs{
-		Writer: w,
-		Query:  url.Values{apc.QparamETLName: []string{etlName}},
-	})
+	return ETLObjectWithParams(bp, etlName, bck, objName, w, nil)
+}
+
+// same as above with user-specified parameters for the transformer (e.g., resize dimensions);
+// the parameters are passed to the ETL container as (unprefixed) query parameters
+func ETLObjectWithParams(bp BaseParams, etlName string, bck cmn.Bck, objName string, w io.Writer, params cos.StrKVs) (err error) {
+	q := make(url.Values, 1+len(params))
+	q.Set(apc.QparamETLName, etlName)
+	for k, v := range params {
+		q.Set(apc.QparamETLParamPrefix+k, v)
+	}
+	_, err = GetObject(bp, bck, objName, &GetArgs{Writer: w, Query: q})
 	return
 }
 
@@ -170,6 +254,7 @@ func ETLObject(bp BaseParams, etlName string, bck cmn.Bck, objName string, w io.
 //
 // Returns xaction ID if successful, an error otherwise. See also: api.CopyBucket
 func ETLBucket(bp BaseParams, bckFrom, bckTo cmn.Bck, msg *apc.TCBMsg, fltPresence ...int) (xid string, err error) {
+	uncacheBck(&bp, &bckTo)
 	if err = bckTo.Validate(); err != nil {
 		return
 	}
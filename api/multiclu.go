@@ -0,0 +1,182 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// MultiCluster executes the same read-only API call against multiple clusters
+// concurrently - typically, this (local) cluster and all remote AIS clusters
+// attached to it (see AttachRemoteAIS) - and returns per-cluster results
+// that can also be merged into a single list (see MultiMerge).
+//
+// Usage:
+//
+//	mc, err := api.NewMultiCluster(bp, true /*include local*/, remoteBP)
+//	...
+//	for _, res := range mc.ListBuckets(cmn.QueryBcks{}, apc.FltPresent) {
+//		if res.Err != nil { ... }
+//		fmt.Println(res.ClusterID, res.Value)
+//	}
+//	...
+//	bcks, err := mc.ListBucketsMerged(cmn.QueryBcks{}, apc.FltPresent)
+//
+// Each cluster is accessed with its own BaseParams - in particular, credentials
+// of the local cluster are never sent to remote clusters (see RemoteBP).
+// Mutating calls are intentionally not supported.
+
+type (
+	MultiCluster struct {
+		Clusters []ClusterBP
+	}
+	ClusterBP struct {
+		ID   string // cluster alias, if defined; otherwise, cluster UUID
+		UUID string
+		BaseParams
+	}
+	ClusterResult[T any] struct {
+		Value     T
+		Err       error
+		ClusterID string
+	}
+	// a single (merged) item tagged with the ID of the cluster it came from
+	ClusterItem[E any] struct {
+		Item      E
+		ClusterID string
+	}
+
+	// RemoteBP returns BaseParams (URL, client, and credentials) to access a given
+	// remote cluster; returning false excludes the cluster
+	RemoteBP func(remais *meta.RemAis) (BaseParams, bool)
+)
+
+// NewMultiCluster populates MultiCluster with all remote clusters attached to the one
+// that is referenced by `bp` (and optionally, with the latter).
+// When `remoteBP` is nil, remote clusters are accessed with the same HTTP client and
+// user agent but without authentication token (and without `bp` cache).
+func NewMultiCluster(bp BaseParams, inclLocal bool, remoteBP RemoteBP) (*MultiCluster, error) {
+	remais, err := GetRemoteAIS(bp)
+	if err != nil {
+		return nil, err
+	}
+	mc := &MultiCluster{Clusters: make([]ClusterBP, 0, len(remais.A)+1)}
+	if inclLocal {
+		smap, err := GetClusterMap(bp)
+		if err != nil {
+			return nil, err
+		}
+		mc.Add(smap.UUID, smap.UUID, bp)
+	}
+	for _, remais := range remais.A {
+		var (
+			rbp BaseParams
+			ok  = true
+		)
+		if remoteBP != nil {
+			rbp, ok = remoteBP(remais)
+		} else {
+			rbp = BaseParams{Client: bp.Client, URL: remais.URL, UA: bp.UA}
+		}
+		if ok {
+			mc.Add(remais.Alias, remais.UUID, rbp)
+		}
+	}
+	return mc, nil
+}
+
+func (mc *MultiCluster) Add(id, uuid string, bp BaseParams) {
+	if id == "" {
+		id = uuid
+	}
+	mc.Clusters = append(mc.Clusters, ClusterBP{ID: id, UUID: uuid, BaseParams: bp})
+}
+
+func (mc *MultiCluster) ListBuckets(qbck cmn.QueryBcks, fltPresence int) []ClusterResult[cmn.Bcks] {
+	return MultiDo(mc, func(bp BaseParams) (cmn.Bcks, error) {
+		return ListBuckets(bp, qbck, fltPresence)
+	})
+}
+
+// all buckets from all clusters, sorted by cluster ID
+func (mc *MultiCluster) ListBucketsMerged(qbck cmn.QueryBcks, fltPresence int) ([]ClusterItem[cmn.Bck], error) {
+	return MultiMerge(mc.ListBuckets(qbck, fltPresence), func(bcks cmn.Bcks) []cmn.Bck { return bcks })
+}
+
+func (mc *MultiCluster) GetClusterConfig() []ClusterResult[*cmn.ClusterConfig] {
+	return MultiDo(mc, GetClusterConfig)
+}
+
+func (mc *MultiCluster) GetAllXactionStatus(args *xact.ArgsMsg) []ClusterResult[nl.StatusVec] {
+	return MultiDo(mc, func(bp BaseParams) (nl.StatusVec, error) {
+		return GetAllXactionStatus(bp, args)
+	})
+}
+
+// all xactions from all clusters, sorted by cluster ID
+func (mc *MultiCluster) GetAllXactionStatusMerged(args *xact.ArgsMsg) ([]ClusterItem[nl.Status], error) {
+	return MultiMerge(mc.GetAllXactionStatus(args), func(vec nl.StatusVec) []nl.Status { return vec })
+}
+
+func (mc *MultiCluster) QueryXactionSnaps(args *xact.ArgsMsg) []ClusterResult[xact.MultiSnap] {
+	return MultiDo(mc, func(bp BaseParams) (xact.MultiSnap, error) {
+		return QueryXactionSnaps(bp, args)
+	})
+}
+
+// MultiDo runs `f` concurrently against all clusters and returns the results
+// sorted by cluster ID
+func MultiDo[T any](mc *MultiCluster, f func(bp BaseParams) (T, error)) []ClusterResult[T] {
+	var (
+		wg      sync.WaitGroup
+		results = make([]ClusterResult[T], len(mc.Clusters))
+	)
+	for i := range mc.Clusters {
+		wg.Add(1)
+		go func(i int) {
+			c := &mc.Clusters[i]
+			results[i].ClusterID = c.ID
+			results[i].Value, results[i].Err = f(c.BaseParams)
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+	sort.Slice(results, func(i, j int) bool { return results[i].ClusterID < results[j].ClusterID })
+	return results
+}
+
+// MultiMerge flattens successful per-cluster results into a single list of items
+// tagged with their respective cluster IDs, and returns MultiErr for the failed ones
+func MultiMerge[T, E any](results []ClusterResult[T], items func(T) []E) ([]ClusterItem[E], error) {
+	var merged []ClusterItem[E]
+	for _, res := range results {
+		if res.Err != nil {
+			continue
+		}
+		for _, item := range items(res.Value) {
+			merged = append(merged, ClusterItem[E]{Item: item, ClusterID: res.ClusterID})
+		}
+	}
+	return merged, MultiErr(results)
+}
+
+// MultiErr joins all per-cluster errors (if any)
+func MultiErr[T any](results []ClusterResult[T]) error {
+	var errs []error
+	for _, res := range results {
+		if res.Err != nil {
+			errs = append(errs, fmt.Errorf("cluster %q: %w", res.ClusterID, res.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
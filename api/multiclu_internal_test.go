@@ -0,0 +1,118 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// mock cluster: lists a single bucket and records the token it was called with
+type mockClu struct {
+	srv    *httptest.Server
+	bck    cmn.Bck
+	remais []*meta.RemAis
+	token  string
+	fail   bool
+}
+
+func newMockClu(t *testing.T, name string) *mockClu {
+	m := &mockClu{bck: cmn.Bck{Name: name, Provider: apc.AIS}}
+	m.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.token = strings.TrimPrefix(r.Header.Get(apc.HdrAuthorization), apc.AuthenticationTypeBearer+" ")
+		if m.fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var v any
+		switch {
+		case r.URL.Path == apc.URLPathBuckets.S:
+			v = cmn.Bcks{m.bck}
+		case r.URL.Path == apc.URLPathClu.S && r.URL.Query().Get(apc.QparamWhat) == apc.WhatRemoteAIS:
+			v = meta.RemAisVec{A: m.remais}
+		case r.URL.Path == apc.URLPathDae.S && r.URL.Query().Get(apc.QparamWhat) == apc.WhatSmap:
+			v = &meta.Smap{UUID: "local-uuid"}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+		w.Write(cos.MustMarshal(v))
+	}))
+	t.Cleanup(m.srv.Close)
+	return m
+}
+
+func TestMultiClusterTokens(t *testing.T) {
+	var (
+		local  = newMockClu(t, "local-bck")
+		remote = newMockClu(t, "remote-bck")
+		bp     = BaseParams{Client: http.DefaultClient, URL: local.srv.URL, Token: "local-token"}
+	)
+	local.remais = []*meta.RemAis{{URL: remote.srv.URL, Alias: "remote", UUID: "remote-uuid"}}
+
+	// default: no local credentials sent to remote clusters
+	mc, err := NewMultiCluster(bp, true, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(mc.Clusters) == 2, "expecting 2 clusters, got %d", len(mc.Clusters))
+	_, err = mc.ListBucketsMerged(cmn.QueryBcks{}, apc.FltPresent)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, local.token == "local-token", "local: expecting local token, got %q", local.token)
+	tassert.Errorf(t, remote.token == "", "remote: expecting no token, got %q", remote.token)
+
+	// per-cluster credentials
+	remoteBP := func(remais *meta.RemAis) (BaseParams, bool) {
+		return BaseParams{Client: http.DefaultClient, URL: remais.URL, Token: "remote-token"}, true
+	}
+	mc, err = NewMultiCluster(bp, true, remoteBP)
+	tassert.CheckFatal(t, err)
+	_, err = mc.ListBucketsMerged(cmn.QueryBcks{}, apc.FltPresent)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, local.token == "local-token", "local: expecting local token, got %q", local.token)
+	tassert.Errorf(t, remote.token == "remote-token", "remote: expecting remote token, got %q", remote.token)
+
+	// excluded
+	mc, err = NewMultiCluster(bp, false, func(*meta.RemAis) (BaseParams, bool) { return BaseParams{}, false })
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(mc.Clusters) == 0, "expecting no clusters, got %d", len(mc.Clusters))
+}
+
+func TestMultiClusterMerge(t *testing.T) {
+	var (
+		mc  = &MultiCluster{}
+		clu = []*mockClu{newMockClu(t, "bck-b"), newMockClu(t, "bck-a"), newMockClu(t, "bck-c")}
+	)
+	mc.Add("b", "uuid-b", BaseParams{Client: http.DefaultClient, URL: clu[0].srv.URL})
+	mc.Add("", "uuid-a", BaseParams{Client: http.DefaultClient, URL: clu[1].srv.URL})
+	mc.Add("c", "uuid-c", BaseParams{Client: http.DefaultClient, URL: clu[2].srv.URL})
+
+	bcks, err := mc.ListBucketsMerged(cmn.QueryBcks{}, apc.FltPresent)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(bcks) == 3, "expecting 3 buckets, got %d", len(bcks))
+	expected := []ClusterItem[cmn.Bck]{
+		{ClusterID: "b", Item: clu[0].bck},
+		{ClusterID: "c", Item: clu[2].bck},
+		{ClusterID: "uuid-a", Item: clu[1].bck},
+	}
+	for i := range expected {
+		tassert.Errorf(t, bcks[i].ClusterID == expected[i].ClusterID && bcks[i].Item.Equal(&expected[i].Item),
+			"%d: expected %v, got %v", i, expected[i], bcks[i])
+	}
+
+	// partial failure: merged results from the healthy clusters, plus an error naming the failed one
+	clu[2].fail = true
+	bcks, err = mc.ListBucketsMerged(cmn.QueryBcks{}, apc.FltPresent)
+	tassert.Errorf(t, len(bcks) == 2, "expecting 2 buckets, got %d", len(bcks))
+	tassert.Fatalf(t, err != nil, "expecting error")
+	tassert.Errorf(t, strings.Contains(err.Error(), `"c"`), "expecting error to name cluster \"c\", got %v", err)
+}
@@ -5,6 +5,7 @@
 package api
 
 import (
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -26,15 +27,45 @@ import (
 // For supported archiving formats, see `archive.FileExtensions`.
 // See also: api.PutApndArch
 func ArchiveMultiObj(bp BaseParams, bckFrom cmn.Bck, msg *cmn.ArchiveBckMsg) (string, error) {
+	uncache(&bp, &msg.ToBck, msg.ArchName)
 	bp.Method = http.MethodPut
 	q := bckFrom.NewQuery()
 	return dolr(bp, bckFrom, apc.ActArchive, msg, q)
 }
 
+// GetBatchArchive reads multiple objects - either all objects with names starting with a given prefix
+// or an explicit list of names (see apc.GetBatchMsg) - as a single TAR stream, and writes the latter
+// into the provided writer. The TAR is constructed on the fly by the cluster: each target contributes
+// the objects it stores, and one (randomly selected) target merges all contributions.
+// Notes:
+//   - unlike ArchiveMultiObj, nothing gets stored - there's no xaction and no resulting shard;
+//   - the archived objects are grouped by target, with no particular order between the groups;
+//   - with `msg.ContinueOnError` missing objects are skipped; otherwise, the request fails.
+//
+// Returns the number of bytes written.
+func GetBatchArchive(bp BaseParams, bck cmn.Bck, msg *apc.GetBatchMsg, w io.Writer) (int64, error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActGetBatch, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	wresp, err := reqParams.doWriter(w)
+	FreeRp(reqParams)
+	if err != nil {
+		return 0, err
+	}
+	return wresp.n, nil
+}
+
 // `fltPresence` applies exclusively to remote `bckFrom` (is ignored if the source is ais://)
 // and is one of: { apc.FltExists, apc.FltPresent, ... } - for complete enum, see api/apc/query.go
 
 func CopyMultiObj(bp BaseParams, bckFrom cmn.Bck, msg *cmn.TCObjsMsg, fltPresence ...int) (xid string, err error) {
+	uncacheBck(&bp, &msg.ToBck)
 	bp.Method = http.MethodPost
 	q := bckFrom.NewQuery()
 	if len(fltPresence) > 0 {
@@ -44,6 +75,7 @@ func CopyMultiObj(bp BaseParams, bckFrom cmn.Bck, msg *cmn.TCObjsMsg, fltPresenc
 }
 
 func ETLMultiObj(bp BaseParams, bckFrom cmn.Bck, msg *cmn.TCObjsMsg, fltPresence ...int) (xid string, err error) {
+	uncacheBck(&bp, &msg.ToBck)
 	bp.Method = http.MethodPost
 	q := bckFrom.NewQuery()
 	if len(fltPresence) > 0 {
@@ -53,6 +85,7 @@ func ETLMultiObj(bp BaseParams, bckFrom cmn.Bck, msg *cmn.TCObjsMsg, fltPresence
 }
 
 func DeleteMultiObj(bp BaseParams, bck cmn.Bck, objNames []string, template string) (string, error) {
+	uncacheBck(&bp, &bck)
 	bp.Method = http.MethodDelete
 	q := bck.NewQuery()
 	msg := apc.ListRange{ObjNames: objNames, Template: template}
@@ -60,6 +93,7 @@ func DeleteMultiObj(bp BaseParams, bck cmn.Bck, objNames []string, template stri
 }
 
 func EvictMultiObj(bp BaseParams, bck cmn.Bck, objNames []string, template string) (string, error) {
+	uncacheBck(&bp, &bck)
 	bp.Method = http.MethodDelete
 	q := bck.NewQuery()
 	msg := apc.ListRange{ObjNames: objNames, Template: template}
@@ -72,7 +106,26 @@ func Prefetch(bp BaseParams, bck cmn.Bck, msg apc.PrefetchMsg) (string, error) {
 	return dolr(bp, bck, apc.ActPrefetchObjects, msg, q)
 }
 
-// multi-object list-range (delete, prefetch, evict, archive, copy, and etl)
+// WarmUp prefetches (cold-GETs) remote objects that are not present in the cluster, and reads
+// the ones that are to populate the OS page cache - all in one (asynchronous) job
+// that selects objects by list, template, or prefix (see apc.WarmUpMsg);
+// returns xaction ID to monitor the progress and wait for completion.
+func WarmUp(bp BaseParams, bck cmn.Bck, msg *apc.WarmUpMsg) (string, error) {
+	bp.Method = http.MethodPost
+	q := bck.NewQuery()
+	return dolr(bp, bck, apc.ActWarmUp, msg, q)
+}
+
+// RenameMultiObj renames (moves) multiple objects within a given ais:// bucket - either the list
+// of names or all objects with names starting with the specified prefix (see apc.RenameObjsMsg);
+// the operation is asynchronous - use the returned xaction ID to monitor progress and wait for completion.
+func RenameMultiObj(bp BaseParams, bck cmn.Bck, msg *apc.RenameObjsMsg) (string, error) {
+	bp.Method = http.MethodPost
+	q := bck.NewQuery()
+	return dolr(bp, bck, apc.ActRenameObjects, msg, q)
+}
+
+// multi-object list-range (delete, prefetch, evict, rename, archive, copy, and etl)
 func dolr(bp BaseParams, bck cmn.Bck, action string, msg any, q url.Values) (xid string, err error) {
 	reqParams := AllocRp()
 	{
@@ -16,6 +16,8 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/xact"
 )
 
 const (
@@ -37,6 +39,8 @@ type (
 
 		// Currently, this optional Query field can (optionally) carry:
 		// - `apc.QparamETLName`: named ETL to transform the object (i.e., perform "inline transformation")
+		// - "etl."-prefixed parameters (see `apc.QparamETLParamPrefix`) to pass to the ETL transformer, e.g. "etl.width=224"
+		//   (same via headers prefixed with `apc.HdrETLParamPrefix`)
 		// - `apc.QparamOrigURL`: GET from a vanilla http(s) location (`ht://` bucket with the corresponding `OrigURLBck`)
 		// - `apc.QparamSilent`: do not log errors
 		// - `apc.QparamLatestVer`: get latest version from the associated Cloud bucket; see also: `ValidateWarmGet`
@@ -56,6 +60,9 @@ type (
 		// E.g. blob download:
 		// * Header.Set(apc.HdrBlobDownload, "true")
 		Header http.Header
+
+		// optional: conditional GET (returns http.StatusNotModified error - see `cmn.IsStatusNotModified`)
+		Cond *CondArgs
 	}
 
 	// `ObjAttrs` represents object attributes and can be further used to retrieve
@@ -92,9 +99,25 @@ type (
 		// - we massively write a new content into a bucket, and/or
 		// - we simply don't care.
 		SkipVC bool
+
+		// optional: conditional PUT (e.g., optimistic concurrency: IfMatch with the version
+		// or checksum that was read; or create-only: IfNoneMatch = "*")
+		Cond *CondArgs
 	}
 )
 
+// conditional GET, PUT, and DELETE (object)
+//   - in-cluster object's entity tag (ETag) is any one of: remote backend's ETag (if any),
+//     checksum value, and version;
+//   - failed preconditions result in http.StatusPreconditionFailed error
+//     (see `cmn.IsStatusPreconditionFailed`)
+type CondArgs struct {
+	IfMatch           string    // comma-separated ETags; "*" - object must exist
+	IfNoneMatch       string    // ditto; "*" - object must not exist
+	IfModifiedSince   time.Time // GET only
+	IfUnmodifiedSince time.Time
+}
+
 // HEAD(object)
 type (
 	// optional
@@ -112,7 +135,7 @@ type (
 	PutApndArchArgs struct {
 		ArchPath string // filename _in_ archive
 		Mime     string // user-specified mime type (NOTE: takes precedence if defined)
-		Flags    int64  // apc.ArchAppend and apc.ArchAppendIfExist (the former requires destination shard to exist)
+		Flags    int64  // apc.ArchAppend and apc.ArchAppendIfExist (the former requires destination shard to exist); apc.ArchCreateIndex
 		PutArgs
 	}
 
@@ -132,6 +155,17 @@ type (
 		Object     string
 		Handle     string
 	}
+
+	// partial update (ranged write) of an existing ais:// object
+	PatchArgs struct {
+		Reader     cos.ReadOpenCloser
+		BaseParams BaseParams
+		Bck        cmn.Bck
+		Object     string
+		Offset     int64 // must not exceed the current size of the object
+		Size       int64 // number of bytes to write (required)
+		SyncCksum  bool  // recompute object's checksum prior to returning (see apc.PatchCksumSync)
+	}
 )
 
 // GET(object) =========================================================================================
@@ -150,9 +184,32 @@ func (args *GetArgs) ret() (w io.Writer, q url.Values, hdr http.Header) {
 		w = args.Writer
 	}
 	q, hdr = args.Query, args.Header
+	if args.Cond != nil {
+		if hdr == nil {
+			hdr = make(http.Header, 2)
+		} else {
+			hdr = hdr.Clone()
+		}
+		args.Cond.setHeader(hdr)
+	}
 	return
 }
 
+func (c *CondArgs) setHeader(hdr http.Header) {
+	if c.IfMatch != "" {
+		hdr.Set(cos.HdrIfMatch, c.IfMatch)
+	}
+	if c.IfNoneMatch != "" {
+		hdr.Set(cos.HdrIfNoneMatch, c.IfNoneMatch)
+	}
+	if !c.IfModifiedSince.IsZero() {
+		hdr.Set(cos.HdrIfModifiedSince, c.IfModifiedSince.UTC().Format(http.TimeFormat))
+	}
+	if !c.IfUnmodifiedSince.IsZero() {
+		hdr.Set(cos.HdrIfUnmodifiedSince, c.IfUnmodifiedSince.UTC().Format(http.TimeFormat))
+	}
+}
+
 func (oah *ObjAttrs) Size() int64 {
 	if oah.n == 0 { // unlikely
 		oah.n = oah.Attrs().Size
@@ -165,6 +222,13 @@ func (oah *ObjAttrs) Attrs() (out cmn.ObjAttrs) {
 	return
 }
 
+// GET with `apc.QparamLatestVer`: outcome of checking in-cluster version against remote backend
+// (one of the enumerated `apc.VerCheck*` values, or empty if not checked),
+// and the version (or ETag) reported by the latter
+func (oah *ObjAttrs) VerCheck() (verdict, remoteVer string) {
+	return oah.wrespHeader.Get(apc.HdrObjVerCheck), oah.wrespHeader.Get(apc.HdrObjRemoteVer)
+}
+
 // e.g. usage: range read response
 func (oah *ObjAttrs) RespHeader() http.Header {
 	return oah.wrespHeader
@@ -252,6 +316,32 @@ func GetObjectReader(bp BaseParams, bck cmn.Bck, objName string, args *GetArgs)
 	return
 }
 
+// Same as above, plus object attributes parsed from the response header: size, checksum,
+// version, atime, and custom metadata (see `ObjAttrs.Attrs`). E.g., to plug AIS objects
+// into Go code that expects io.Reader (archive/tar, image decoders, etc.) - with no loss of metadata.
+// Note that `oah.Size()` is the response's content length and can be -1 (unknown), e.g.,
+// when reading an archived file from a shard.
+// Caller is responsible for closing the reader.
+func GetObjectReaderWithAttrs(bp BaseParams, bck cmn.Bck, objName string, args *GetArgs) (r io.ReadCloser, oah ObjAttrs, err error) {
+	_, q, hdr := args.ret()
+	q = bck.AddToQuery(q)
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Query = q
+		reqParams.Header = hdr
+	}
+	resp, err := reqParams.doReaderResp()
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, oah, err
+	}
+	oah.wrespHeader, oah.n = resp.Header, resp.ContentLength
+	return resp.Body, oah, nil
+}
+
 // PUT(object) ============================================================================================
 //
 // Uses the specified reader (`args.Reader`) to write a new object (or a new version of the object).
@@ -282,11 +372,15 @@ func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	if args.Size != 0 {
 		req.ContentLength = int64(args.Size) // as per https://tools.ietf.org/html/rfc7230#section-3.3.2
 	}
+	if args.Cond != nil {
+		args.Cond.setHeader(req.Header)
+	}
 	SetAuxHeaders(req, &args.BaseParams)
 	return req, nil
 }
 
 func PutObject(args *PutArgs) (oah ObjAttrs, err error) {
+	uncache(&args.BaseParams, &args.Bck, args.ObjName)
 	var (
 		resp  *http.Response
 		query = args.Bck.NewQuery()
@@ -317,6 +411,14 @@ func PutObject(args *PutArgs) (oah ObjAttrs, err error) {
 // - silent==true: not to log (not-found) error
 
 func HeadObject(bp BaseParams, bck cmn.Bck, objName string, args HeadArgs) (*cmn.ObjectProps, error) {
+	cache := bp.Cache
+	if cache != nil && cacheable(&args) {
+		if op := cache.getProps(&bck, objName); op != nil {
+			return op, nil
+		}
+	} else {
+		cache = nil
+	}
 	bp.Method = http.MethodHead
 
 	q := bck.NewQuery()
@@ -364,6 +466,9 @@ func HeadObject(bp BaseParams, bck cmn.Bck, objName string, args HeadArgs) (*cmn
 	if err != nil {
 		return nil, err
 	}
+	if cache != nil {
+		cache.putProps(&bck, objName, op)
+	}
 	return op, nil
 }
 
@@ -375,6 +480,7 @@ func HeadObject(bp BaseParams, bck cmn.Bck, objName string, args HeadArgs) (*cmn
 // See also: HeadObject() and apc.HdrObjCustomMD
 
 func SetObjectCustomProps(bp BaseParams, bck cmn.Bck, objName string, custom cos.StrKVs, setNew bool) error {
+	uncache(&bp, &bck, objName)
 	var (
 		actMsg = apc.ActMsg{Value: custom}
 		q      url.Values
@@ -403,12 +509,31 @@ func SetObjectCustomProps(bp BaseParams, bck cmn.Bck, objName string, custom cos
 // DELETE(object) ======================================================================================
 
 func DeleteObject(bp BaseParams, bck cmn.Bck, objName string) error {
+	uncache(&bp, &bck, objName)
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Query = bck.NewQuery()
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// same as above, conditionally (see CondArgs)
+func DeleteObjectCond(bp BaseParams, bck cmn.Bck, objName string, cond *CondArgs) error {
+	uncache(&bp, &bck, objName)
 	bp.Method = http.MethodDelete
+	hdr := make(http.Header, 2)
+	cond.setHeader(hdr)
 	reqParams := AllocRp()
 	{
 		reqParams.BaseParams = bp
 		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
 		reqParams.Query = bck.NewQuery()
+		reqParams.Header = hdr
 	}
 	err := reqParams.DoRequest()
 	FreeRp(reqParams)
@@ -418,6 +543,7 @@ func DeleteObject(bp BaseParams, bck cmn.Bck, objName string) error {
 // Evict(object) ======================================================================================
 
 func EvictObject(bp BaseParams, bck cmn.Bck, objName string) error {
+	uncache(&bp, &bck, objName)
 	bp.Method = http.MethodDelete
 	actMsg := apc.ActMsg{Action: apc.ActEvictObjects, Name: cos.JoinWords(bck.Name, objName)}
 	reqParams := AllocRp()
@@ -455,6 +581,7 @@ func PrefetchObject(bp BaseParams, bck cmn.Bck, objName string) (string, error)
 // - api.AppendObject
 
 func PutApndArch(args *PutApndArchArgs) (err error) {
+	uncache(&args.BaseParams, &args.Bck, args.ObjName)
 	q := make(url.Values, 4)
 	q = args.Bck.AddToQuery(q)
 	q.Set(apc.QparamArchpath, args.ArchPath)
@@ -529,7 +656,15 @@ func AppendObject(args *AppendArgs) (string /*handle*/, error) {
 // FlushObject must be called after all the appends (via `api.AppendObject`).
 // To "flush", it uses the handle returned by `api.AppendObject`.
 // This call will create a fully operational and accessible object.
+// If specified, `args.Cksum` is validated against the content of the entire concatenation.
 func FlushObject(args *FlushArgs) error {
+	_, err := flushObject(args)
+	return err
+}
+
+// same as above, and returns the checksum of the resulting object (if computed)
+func flushObject(args *FlushArgs) (*cos.Cksum, error) {
+	uncache(&args.BaseParams, &args.Bck, args.Object)
 	var (
 		header http.Header
 		q      = make(url.Values, 4)
@@ -552,16 +687,94 @@ func FlushObject(args *FlushArgs) error {
 		reqParams.Query = q
 		reqParams.Header = header
 	}
-	err := reqParams.DoRequest()
+	hdr, _, err := reqParams.doReqHdr()
 	FreeRp(reqParams)
 	args.BaseParams.Method = method
-	return err
+	if err != nil {
+		return nil, err
+	}
+	if ty, val := hdr.Get(apc.HdrObjCksumType), hdr.Get(apc.HdrObjCksumVal); val != "" {
+		return cos.NewCksum(ty, val), nil
+	}
+	return nil, nil
+}
+
+// PatchObject writes `args.Size` bytes at `args.Offset` into an existing object - in place,
+// without re-writing the rest of it; `args.Offset` equal to the object's size appends.
+//   - ais:// buckets only (no remote backend, no erasure coding);
+//   - by default, the object's checksum is recomputed lazily, in the background -
+//     until then the object has no checksum, and the returned checksum is nil;
+//   - with `args.SyncCksum`, returns the recomputed checksum (unless the bucket's
+//     checksum type is "none").
+func PatchObject(args *PatchArgs) (*cos.Cksum, error) {
+	uncache(&args.BaseParams, &args.Bck, args.Object)
+	q := args.Bck.NewQuery()
+	q.Set(apc.QparamPatchOffset, strconv.FormatInt(args.Offset, 10))
+	if args.SyncCksum {
+		q.Set(apc.QparamPatchCksum, apc.PatchCksumSync)
+	}
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodPut
+		reqArgs.Base = args.BaseParams.URL
+		reqArgs.Path = apc.URLPathObjects.Join(args.Bck.Name, args.Object)
+		reqArgs.Query = q
+		reqArgs.BodyR = args.Reader
+	}
+	wresp, err := DoWithRetry(args.BaseParams.Client, args._patch, reqArgs) //nolint:bodyclose // it's closed inside
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		return nil, err
+	}
+	if ty, val := wresp.Header.Get(apc.HdrObjCksumType), wresp.Header.Get(apc.HdrObjCksumVal); val != "" {
+		return cos.NewCksum(ty, val), nil
+	}
+	return nil, nil
+}
+
+func (args *PatchArgs) getBody() (io.ReadCloser, error) { return args.Reader.Open() }
+
+func (args *PatchArgs) _patch(reqArgs *cmn.HreqArgs) (*http.Request, error) {
+	req, err := reqArgs.Req()
+	if err != nil {
+		return nil, newErrCreateHTTPRequest(err)
+	}
+	req.GetBody = args.getBody
+	req.ContentLength = args.Size
+	SetAuxHeaders(req, &args.BaseParams)
+	return req, nil
+}
+
+// ECVerifyObject performs on-demand integrity check of a single erasure-coded object:
+// reads (and checksums) all its slices and replicas, checks parity math, and reports
+// missing and corrupted CTs along with the targets that store (or must store) them.
+// NOTE: does not restore (reconstruct) the object and does not repair anything.
+func ECVerifyObject(bp BaseParams, bck cmn.Bck, objName string) (*ec.VerifyResult, error) {
+	actMsg := apc.ActMsg{Action: apc.ActECVerify, Name: objName}
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(actMsg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	res := &ec.VerifyResult{}
+	_, err := reqParams.DoReqAny(res)
+	FreeRp(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // Rename(object) ==============================================================================
 // renames object name from `oldName` to `newName`. Works only within a given specified bucket.
 
 func RenameObject(bp BaseParams, bck cmn.Bck, oldName, newName string) error {
+	uncache(&bp, &bck, oldName)
+	uncache(&bp, &bck, newName)
 	bp.Method = http.MethodPost
 	reqParams := AllocRp()
 	{
@@ -595,6 +808,66 @@ func Promote(bp BaseParams, bck cmn.Bck, args *apc.PromoteArgs) (xid string, err
 	return xid, err
 }
 
+type (
+	// (tracked) promotion, see StartPromote
+	PromoteArgs struct {
+		SrcFQN          string // source file or directory (absolute pathname accessible by the target(s))
+		ObjName         string // destination object name or prefix
+		Target          string // target pinning: when non-empty, only the specified target (ID) promotes
+		Overwrite       string // overwrite policy: apc.PromoteOwSkip (default), apc.PromoteOwAlways, or apc.PromoteOwFail
+		Recursive       bool   // recursively promote nested directories
+		DeleteSrc       bool   // remove source files once (and if) successfully promoted
+		SrcIsNotFshare  bool   // the source is not a file share (and don't try to auto-detect it)
+		ContinueOnError bool   // count and report failures, keep going
+	}
+	// x-promote progress summed up across targets, see GetPromoteProgress
+	PromoteProgress struct {
+		xact.PromoteStats
+		Bytes    int64 // promoted
+		Running  bool
+		Aborted  bool
+		Finished bool
+	}
+)
+
+// StartPromote promotes files via x-promote - always an xaction, independently of the number of files -
+// and returns its ID that can be used to track the progress (GetPromoteProgress) and wait for completion
+func StartPromote(bp BaseParams, bck cmn.Bck, args *PromoteArgs) (string, error) {
+	msg := &apc.PromoteArgs{
+		DaemonID:        args.Target,
+		SrcFQN:          args.SrcFQN,
+		ObjName:         args.ObjName,
+		Overwrite:       args.Overwrite,
+		Recursive:       args.Recursive,
+		DeleteSrc:       args.DeleteSrc,
+		SrcIsNotFshare:  args.SrcIsNotFshare,
+		ContinueOnError: args.ContinueOnError,
+		Xact:            true,
+	}
+	if err := msg.Validate(); err != nil {
+		return "", err
+	}
+	return Promote(bp, bck, msg)
+}
+
+func GetPromoteProgress(bp BaseParams, xid string) (*PromoteProgress, error) {
+	snaps, err := QueryXactionSnaps(bp, &xact.ArgsMsg{ID: xid, Kind: apc.ActPromote})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		aborted, running, notstarted = snaps.IsIdle(xid)
+		locBytes, _, _               = snaps.ByteCounts(xid)
+	)
+	return &PromoteProgress{
+		PromoteStats: snaps.PromoteStats(xid),
+		Bytes:        locBytes,
+		Running:      running,
+		Aborted:      aborted,
+		Finished:     aborted || (!running && !notstarted),
+	}, nil
+}
+
 //
 // misc. helpers
 //
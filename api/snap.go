@@ -0,0 +1,67 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket snapshots (ais:// buckets only):
+// - CreateSnapshot captures the current listing and metadata of all objects in the bucket;
+//   object data is not copied - subsequent writes do not affect the snapshot (copy-on-write);
+// - RestoreSnapshot reverts the bucket to the snapshot: restores overwritten and deleted objects
+//   (and their metadata) and removes objects that were created after the snapshot;
+// - all snapshots get destroyed together with the bucket.
+
+func CreateSnapshot(bp BaseParams, bck cmn.Bck, name string) error {
+	return snapact(bp, bck, apc.ActCreateSnap, name)
+}
+
+func RestoreSnapshot(bp BaseParams, bck cmn.Bck, name string) error {
+	return snapact(bp, bck, apc.ActRestoreSnap, name)
+}
+
+func DestroySnapshot(bp BaseParams, bck cmn.Bck, name string) error {
+	return snapact(bp, bck, apc.ActDestroySnap, name)
+}
+
+func snapact(bp BaseParams, bck cmn.Bck, action, name string) error {
+	if err := apc.ValidateSnapName(name); err != nil {
+		return err
+	}
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: action, Name: name})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// sorted by creation time
+func ListSnapshots(bp BaseParams, bck cmn.Bck) ([]*apc.SnapInfo, error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActListSnaps})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	var snaps []*apc.SnapInfo
+	_, err := reqParams.DoReqAny(&snaps)
+	FreeRp(reqParams)
+	return snaps, err
+}
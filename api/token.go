@@ -0,0 +1,160 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// AuthN token lookup: LoadToken (below) tries a chain of token providers, in order, and returns
+// the first non-empty token. The default chain, from the highest to the lowest precedence:
+//  1. explicitly specified token (if any);
+//  2. custom providers (if any), in the order given;
+//  3. `AIS_AUTHN_TOKEN` environment (token itself);
+//  4. `AIS_AUTHN_TOKEN_FILE` environment (token file that must exist);
+//  5. CLI token file - the one that `ais auth login` writes by default ($HOME/.config/ais/cli/<fname.Token>);
+//  6. Kubernetes secret mounted at K8sTokenPath (or `AIS_AUTHN_TOKEN_SECRET`, if defined).
+//
+// Token files can contain either the token itself or JSON (`{"token": "..."}`) as written by the CLI.
+
+// default mount path of the Kubernetes secret (key "token") that contains AuthN token
+const K8sTokenPath = "/var/run/secrets/aistore/authn/token"
+
+type (
+	// TokenProvider is the interface to plug custom token sources (vault agents, sidecars, etc.).
+	// Token returns ("", nil) when the provider has no token, to let LoadToken try the next one.
+	TokenProvider interface {
+		Token() (string, error)
+		String() string // provider's name (to identify the source in logs and errors)
+	}
+
+	tokenValue string
+	tokenEnv   string
+	tokenFile  struct {
+		path      string
+		mustExist bool
+	}
+	tokenFileEnv struct {
+		name string
+	}
+)
+
+// interface guard
+var (
+	_ TokenProvider = (*tokenValue)(nil)
+	_ TokenProvider = (*tokenEnv)(nil)
+	_ TokenProvider = (*tokenFile)(nil)
+	_ TokenProvider = (*tokenFileEnv)(nil)
+)
+
+// LoadToken resolves AuthN token from the default chain of providers (see above);
+// returns empty token (and no error) when none of the providers has one.
+//
+// Example:
+//
+//	token, err := api.LoadToken("" /*explicit*/)
+//	bp := api.BaseParams{Client: client, URL: url, Token: token}
+func LoadToken(token string, custom ...TokenProvider) (string, error) {
+	token, _, err := ResolveToken(TokenChain(token, custom...)...)
+	return token, err
+}
+
+// ResolveToken tries the given providers in order; returns the first non-empty token and its source.
+// An error from any provider terminates the search.
+func ResolveToken(providers ...TokenProvider) (token, source string, err error) {
+	for _, p := range providers {
+		if token, err = p.Token(); err != nil {
+			return "", p.String(), fmt.Errorf("api: %s: %v", p, err)
+		}
+		if token != "" {
+			return token, p.String(), nil
+		}
+	}
+	return "", "", nil
+}
+
+// TokenChain returns the default chain of providers (see above)
+func TokenChain(token string, custom ...TokenProvider) []TokenProvider {
+	chain := make([]TokenProvider, 0, len(custom)+5)
+	if token != "" {
+		chain = append(chain, TokenValue(token))
+	}
+	chain = append(chain, custom...)
+	chain = append(chain,
+		TokenEnv(env.AuthN.Token),
+		&tokenFileEnv{name: env.AuthN.TokenFile},
+		TokenFile(filepath.Join(cos.HomeConfigDir(fname.HomeCLI), fname.Token), false /*must exist*/),
+	)
+	if p := os.Getenv(env.AuthN.TokenSecret); p != "" {
+		chain = append(chain, TokenFile(p, true))
+	} else {
+		chain = append(chain, TokenFile(K8sTokenPath, false))
+	}
+	return chain
+}
+
+// TokenValue: explicitly specified token
+func TokenValue(token string) TokenProvider { tv := tokenValue(token); return &tv }
+
+// TokenEnv: token in the named environment variable
+func TokenEnv(name string) TokenProvider { te := tokenEnv(name); return &te }
+
+// TokenFile: token in the file (plain or JSON); when `mustExist` is false a non-existing file is skipped
+func TokenFile(path string, mustExist bool) TokenProvider {
+	return &tokenFile{path: path, mustExist: mustExist}
+}
+
+func (tv *tokenValue) Token() (string, error) { return string(*tv), nil }
+func (*tokenValue) String() string            { return "token" }
+
+func (te *tokenEnv) Token() (string, error) { return strings.TrimSpace(os.Getenv(string(*te))), nil }
+func (te *tokenEnv) String() string         { return "env " + string(*te) }
+
+func (tfe *tokenFileEnv) Token() (string, error) {
+	path := os.Getenv(tfe.name)
+	if path == "" {
+		return "", nil
+	}
+	tf := tokenFile{path: path, mustExist: true}
+	return tf.Token()
+}
+
+func (tfe *tokenFileEnv) String() string { return "env " + tfe.name }
+
+func (tf *tokenFile) Token() (string, error) {
+	b, err := os.ReadFile(tf.path)
+	if err != nil {
+		if !tf.mustExist && os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return parseToken(b, tf.path)
+}
+
+func (tf *tokenFile) String() string { return "token file " + tf.path }
+
+// plain token or JSON (same as authn.TokenMsg)
+func parseToken(b []byte, path string) (string, error) {
+	s := strings.TrimSpace(string(b))
+	if !strings.HasPrefix(s, "{") {
+		return s, nil
+	}
+	var token struct {
+		Token string `json:"token"`
+	}
+	if err := jsoniter.Unmarshal([]byte(s), &token); err != nil {
+		return "", fmt.Errorf("invalid token file %q: %v", path, err)
+	}
+	return token.Token, nil
+}
@@ -0,0 +1,109 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/env"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type testProvider struct {
+	token string
+	err   error
+}
+
+func (tp *testProvider) Token() (string, error) { return tp.token, tp.err }
+func (*testProvider) String() string            { return "test provider" }
+
+func writeToken(t *testing.T, dir, name, content string) string {
+	fpath := filepath.Join(dir, name)
+	tassert.CheckFatal(t, os.WriteFile(fpath, []byte(content), 0o600))
+	return fpath
+}
+
+func TestLoadTokenPrecedence(t *testing.T) {
+	if _, err := os.Stat(filepath.Join(cos.HomeConfigDir(fname.HomeCLI), fname.Token)); err == nil {
+		t.Skip("CLI token file exists (and would take precedence over the Kubernetes secret)")
+	}
+	var (
+		dir        = t.TempDir()
+		tokenFile  = writeToken(t, dir, "token", "file-token\n")
+		secretFile = writeToken(t, dir, "secret", `{"token": "secret-token"}`)
+	)
+	t.Setenv(env.AuthN.Token, "")
+	t.Setenv(env.AuthN.TokenFile, "")
+	t.Setenv(env.AuthN.TokenSecret, secretFile)
+
+	// from the lowest precedence up
+	tests := []struct {
+		setup    func()
+		explicit string
+		custom   []TokenProvider
+		expected string
+		source   string
+	}{
+		{expected: "secret-token", source: "token file " + secretFile},
+		{setup: func() { t.Setenv(env.AuthN.TokenFile, tokenFile) }, expected: "file-token", source: "env " + env.AuthN.TokenFile},
+		{setup: func() { t.Setenv(env.AuthN.Token, " env-token ") }, expected: "env-token", source: "env " + env.AuthN.Token},
+		{custom: []TokenProvider{&testProvider{}, &testProvider{token: "custom-token"}}, expected: "custom-token", source: "test provider"},
+		{explicit: "explicit-token", custom: []TokenProvider{&testProvider{token: "custom-token"}}, expected: "explicit-token", source: "token"},
+	}
+	for i, test := range tests {
+		if test.setup != nil {
+			test.setup()
+		}
+		token, source, err := ResolveToken(TokenChain(test.explicit, test.custom...)...)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, token == test.expected && source == test.source, "%d: expected %q from %q, got %q from %q",
+			i, test.expected, test.source, token, source)
+
+		token, err = LoadToken(test.explicit, test.custom...)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, token == test.expected, "%d: expected %q, got %q", i, test.expected, token)
+	}
+}
+
+func TestLoadTokenMissingFile(t *testing.T) {
+	var (
+		dir     = t.TempDir()
+		missing = filepath.Join(dir, "missing")
+	)
+	t.Setenv(env.AuthN.Token, "")
+	t.Setenv(env.AuthN.TokenSecret, "")
+
+	// optional file: skipped
+	token, err := TokenFile(missing, false).Token()
+	tassert.Errorf(t, err == nil && token == "", "expecting no token and no error, got %q, %v", token, err)
+
+	// must-exist file (explicitly, or via environment): fails the lookup
+	_, err = TokenFile(missing, true).Token()
+	tassert.Errorf(t, err != nil, "expecting error for missing token file")
+
+	t.Setenv(env.AuthN.TokenFile, missing)
+	_, err = LoadToken("")
+	tassert.Errorf(t, err != nil && strings.Contains(err.Error(), env.AuthN.TokenFile), "expecting error naming %s, got %v",
+		env.AuthN.TokenFile, err)
+
+	// a higher-precedence token is returned without looking any further
+	token, err = LoadToken("explicit-token")
+	tassert.Errorf(t, err == nil && token == "explicit-token", "expecting explicit token, got %q, %v", token, err)
+
+	// provider error terminates the search
+	perr := errors.New("provider failure")
+	_, err = LoadToken("", &testProvider{err: perr})
+	tassert.Errorf(t, err != nil && strings.Contains(err.Error(), perr.Error()), "expecting %v, got %v", perr, err)
+
+	// invalid JSON
+	_, err = TokenFile(writeToken(t, dir, "bad", `{"token":`), true).Token()
+	tassert.Errorf(t, err != nil, "expecting error for invalid JSON token file")
+}
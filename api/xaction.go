@@ -5,9 +5,11 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -60,6 +62,52 @@ func AbortXaction(bp BaseParams, args *xact.ArgsMsg) (err error) {
 	return
 }
 
+// GCXactions removes finished xactions (jobs) from the cluster-wide registry:
+// all or those of a given kind that finished at least `olderThan` ago -
+// regardless of the configured retention (see config.Xact)
+func GCXactions(bp BaseParams, kind string, olderThan time.Duration) error {
+	return gcXactions(bp, &xact.ArgsMsg{Kind: kind, OlderThan: olderThan})
+}
+
+// GCXaction removes a given finished (or aborted) xaction (job) from the cluster-wide registry;
+// fails if the xaction is still running
+func GCXaction(bp BaseParams, xid string) error {
+	return gcXactions(bp, &xact.ArgsMsg{ID: xid})
+}
+
+func gcXactions(bp BaseParams, args *xact.ArgsMsg) (err error) {
+	msg := apc.ActMsg{Action: apc.ActXactGC, Value: args}
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err = reqParams.DoRequest()
+	FreeRp(reqParams)
+	return
+}
+
+// SetXactDeadline sets max-runtime of a given (running) xaction (job): the job gets aborted
+// with "deadline exceeded" status if still running `maxRuntime` after its start;
+// zero `maxRuntime` removes the deadline (see also: `xact.ArgsMsg.MaxRuntime`)
+func SetXactDeadline(bp BaseParams, xid string, maxRuntime time.Duration) (err error) {
+	msg := apc.ActMsg{Action: apc.ActXactDeadline, Value: &xact.ArgsMsg{ID: xid, MaxRuntime: maxRuntime}}
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err = reqParams.DoRequest()
+	FreeRp(reqParams)
+	return
+}
+
 //
 // querying and waiting
 //
@@ -153,19 +201,73 @@ func getxst(out any, q url.Values, bp BaseParams, args *xact.ArgsMsg) (err error
 	return
 }
 
+// WatchXaction subscribes to progress of a given (`args.ID`-identified) xaction that executes on
+// all targets and reports its status to IC - via a sequence of long polls, whereby IC (proxy) responds
+// with aggregated snapshots as soon as they get updated (rather than clients polling `QueryXactionSnaps`).
+// The callback gets invoked upon each update (ivl being the suggested refresh interval); returns when:
+// - the xaction finishes (in which case the callback gets invoked one last time), or
+// - the callback returns true (to stop watching), or
+// - args.Timeout expires (see `_times` below)
+func WatchXaction(bp BaseParams, args *xact.ArgsMsg, ivl time.Duration, cb func(*xact.Watch) (stop bool)) error {
+	debug.Assert(xact.IsValidUUID(args.ID))
+	var (
+		seq      int64
+		begin    = mono.NanoTime()
+		total, _ = _times(args)
+		q        = url.Values{apc.QparamWhat: []string{apc.WhatXactWatch}}
+	)
+	if ivl > 0 {
+		q.Set(apc.QparamWatchIvl, ivl.String())
+	}
+	for {
+		var (
+			out = &xact.Watch{}
+			err error
+		)
+		q.Set(apc.QparamWatchSeq, strconv.FormatInt(seq, 10))
+		err = getxst(out, q, bp, args)
+		switch {
+		case err == nil:
+			if out.Seq > seq || out.Status.Finished() {
+				seq = out.Seq
+				if cb(out) || out.Status.Finished() {
+					return nil
+				}
+			}
+		case cos.IsRetriableConnErr(err) || cmn.IsStatusServiceUnavailable(err):
+			time.Sleep(xact.MinPollTime)
+		default:
+			return err
+		}
+		if elapsed := mono.Since(begin); elapsed >= total {
+			return fmt.Errorf("api.watch: timed out (%v) watching %s", total, args.String())
+		}
+	}
+}
+
 //
 // TODO: use `xact.IdlesBeforeFinishing` to provide a single unified wait-for API
 //
 
-type consIdle struct {
-	xid     string
-	cnt     int
-	delayed bool
-}
+type (
+	consIdle struct {
+		xid     string
+		cnt     int
+		delayed bool
+		aborted bool
+	}
+	// all targets finished (xactions that neither idle nor report to IC)
+	allFinished struct {
+		xid     string
+		errMsg  string
+		aborted bool
+	}
+)
 
 func (ci *consIdle) check(snaps xact.MultiSnap) (done, resetProbeFreq bool) {
 	aborted, running, notstarted := snaps.IsIdle(ci.xid)
 	if aborted {
+		ci.aborted = true
 		return true, false
 	}
 	if running {
@@ -184,6 +286,59 @@ func (ci *consIdle) check(snaps xact.MultiSnap) (done, resetProbeFreq bool) {
 	return ci.cnt >= xact.NumConsecutiveIdle, true
 }
 
+func (af *allFinished) check(snaps xact.MultiSnap) (done, resetProbeFreq bool) {
+	var n, nf int
+	for _, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			if snap.ID != af.xid {
+				continue
+			}
+			n++
+			if snap.IsAborted() {
+				af.aborted, af.errMsg = true, snap.AbortErr
+				return true, false
+			}
+			if snap.Finished() {
+				nf++
+			}
+		}
+	}
+	return n > 0 && nf == n, false
+}
+
+// WaitForXactionCtx waits for a given xaction to finish, whereby the waiting method depends
+// on the xaction's kind (`args.Kind` is required):
+// - xactions that report their status to IC: wait for the (IC) status to indicate finished;
+// - xact.IdlesBeforeFinishing: wait for the xaction to be idle (see WaitForXactionIdle);
+// - x-blob-download (no IC notifications): wait for all targets to report finished.
+// Returns upon completion, when ctx is done (ctx.Err()), or when args.Timeout expires (see `_times`).
+// When not nil, `onProgress` gets invoked with the current snapshots upon each poll, e.g. to log progress.
+// The returned status is always non-nil upon success, and indicates whether the xaction was aborted.
+func WaitForXactionCtx(ctx context.Context, bp BaseParams, args *xact.ArgsMsg, onProgress func(xact.MultiSnap)) (*nl.Status, error) {
+	debug.Assert(args.Kind != "")
+	kind, _ := xact.GetKindName(args.Kind)
+	switch {
+	case xact.IdlesBeforeFinishing(kind):
+		ci, running := &consIdle{xid: args.ID}, args.OnlyRunning
+		args.OnlyRunning = true
+		_, err := _waitx(ctx, bp, args, ci.check, onProgress)
+		args.OnlyRunning = running
+		if err != nil {
+			return nil, err
+		}
+		return &nl.Status{Kind: kind, UUID: args.ID, AbortedX: ci.aborted, EndTimeX: time.Now().UnixNano()}, nil
+	case kind == apc.ActBlobDl: // (x-blob doesn't do notif listener - see ais/prxclu xstart)
+		debug.Assert(xact.IsValidUUID(args.ID))
+		af := &allFinished{xid: args.ID}
+		if _, err := _waitx(ctx, bp, args, af.check, onProgress); err != nil {
+			return nil, err
+		}
+		return &nl.Status{Kind: kind, UUID: args.ID, AbortedX: af.aborted, ErrMsg: af.errMsg, EndTimeX: time.Now().UnixNano()}, nil
+	default:
+		return _waitx(ctx, bp, args, nil, onProgress)
+	}
+}
+
 // WaitForXactionIdle waits for a given on-demand xaction to be idle.
 func WaitForXactionIdle(bp BaseParams, args *xact.ArgsMsg) (err error) {
 	ci, running := &consIdle{xid: args.ID}, args.OnlyRunning
@@ -197,7 +352,7 @@ func WaitForXactionIdle(bp BaseParams, args *xact.ArgsMsg) (err error) {
 // Use it only for global xactions
 // (those that execute on all targets and report their status to IC, e.g. rebalance).
 func WaitForXactionIC(bp BaseParams, args *xact.ArgsMsg) (status *nl.Status, err error) {
-	return _waitx(bp, args, nil)
+	return _waitx(context.Background(), bp, args, nil, nil)
 }
 
 // WaitForXactionNode waits for a given xaction to complete.
@@ -206,24 +361,31 @@ func WaitForXactionIC(bp BaseParams, args *xact.ArgsMsg) (status *nl.Status, err
 // - x-resilver (as it usually runs on a single node)
 func WaitForXactionNode(bp BaseParams, args *xact.ArgsMsg, fn func(xact.MultiSnap) (bool, bool)) error {
 	debug.Assert(args.Kind != "" || xact.IsValidUUID(args.ID))
-	_, err := _waitx(bp, args, fn)
+	_, err := _waitx(context.Background(), bp, args, fn, nil)
 	return err
 }
 
 // TODO: `status` is currently always nil when we wait with a (`fn`) callback
 // TODO: un-defer cancel()
-func _waitx(bp BaseParams, args *xact.ArgsMsg, fn func(xact.MultiSnap) (bool, bool)) (status *nl.Status, err error) {
+func _waitx(ctx context.Context, bp BaseParams, args *xact.ArgsMsg, fn func(xact.MultiSnap) (bool, bool),
+	onProgress func(xact.MultiSnap)) (status *nl.Status, err error) {
 	var (
 		elapsed         time.Duration
 		begin           = mono.NanoTime()
 		total, maxSleep = _times(args)
 		sleep           = xact.MinPollTime
+		timer           *time.Timer
 	)
 	for {
 		var done bool
 		if fn == nil {
 			status, err = GetOneXactionStatus(bp, args)
 			done = err == nil && status.Finished() && elapsed >= xact.MinPollTime
+			if onProgress != nil && err == nil {
+				if snaps, errN := QueryXactionSnaps(bp, args); errN == nil {
+					onProgress(snaps)
+				}
+			}
 		} else {
 			var (
 				snaps          xact.MultiSnap
@@ -231,6 +393,9 @@ func _waitx(bp BaseParams, args *xact.ArgsMsg, fn func(xact.MultiSnap) (bool, bo
 			)
 			snaps, err = QueryXactionSnaps(bp, args)
 			if err == nil {
+				if onProgress != nil {
+					onProgress(snaps)
+				}
 				done, resetProbeFreq = fn(snaps)
 				if resetProbeFreq {
 					sleep = xact.MinPollTime
@@ -241,7 +406,17 @@ func _waitx(bp BaseParams, args *xact.ArgsMsg, fn func(xact.MultiSnap) (bool, bo
 		if done || !canRetry /*fail*/ {
 			return
 		}
-		time.Sleep(sleep)
+		if timer == nil {
+			timer = time.NewTimer(sleep)
+			defer timer.Stop()
+		} else {
+			timer.Reset(sleep)
+		}
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-timer.C:
+		}
 		sleep = min(maxSleep, sleep+sleep/2)
 
 		if elapsed = mono.Since(begin); elapsed >= total {
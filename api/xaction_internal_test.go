@@ -0,0 +1,69 @@
+// Package api provides native Go-based API/SDK over HTTP(S).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+func TestWaitAllFinished(t *testing.T) {
+	cos.InitShortID(0)
+	var (
+		xid     = cos.GenUUID()
+		started = time.Now()
+		af      = &allFinished{xid: xid}
+		running = &core.Snap{ID: xid, StartTime: started}
+		done    = &core.Snap{ID: xid, StartTime: started, EndTime: time.Now()}
+		other   = &core.Snap{ID: cos.GenUUID(), StartTime: started}
+	)
+	finished, _ := af.check(xact.MultiSnap{})
+	tassert.Errorf(t, !finished, "expected not finished when no snapshots")
+	finished, _ = af.check(xact.MultiSnap{"t1": {done}, "t2": {running, other}})
+	tassert.Errorf(t, !finished, "expected not finished while t2 is running")
+	finished, _ = af.check(xact.MultiSnap{"t1": {done}, "t2": {done, other}})
+	tassert.Errorf(t, finished && !af.aborted, "expected finished")
+
+	aborted := &core.Snap{ID: xid, StartTime: started, AbortedX: true, AbortErr: "out of space"}
+	finished, _ = af.check(xact.MultiSnap{"t1": {done}, "t2": {aborted}})
+	tassert.Errorf(t, finished && af.aborted && af.errMsg == "out of space", "expected aborted: %+v", af)
+}
+
+func TestWaitForXactionCtx(t *testing.T) {
+	cos.InitShortID(0)
+	xid := cos.GenUUID()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		snaps := xact.MultiSnap{"t1": {{ID: xid, Kind: apc.ActBlobDl, StartTime: time.Now()}}}
+		w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+		w.Write(cos.MustMarshal(snaps))
+	}))
+	defer ts.Close()
+
+	var (
+		bp          = BaseParams{Client: ts.Client(), URL: ts.URL}
+		ctx, cancel = context.WithCancel(context.Background())
+		polls       int
+	)
+	defer cancel()
+	args := &xact.ArgsMsg{ID: xid, Kind: apc.ActBlobDl, Timeout: time.Minute}
+	_, err := WaitForXactionCtx(ctx, bp, args, func(snaps xact.MultiSnap) {
+		polls++
+		_, _, err := snaps.RunningTarget(xid)
+		tassert.CheckError(t, err)
+		cancel() // stop waiting
+	})
+	tassert.Errorf(t, errors.Is(err, context.Canceled), "expected context canceled, got %v", err)
+	tassert.Errorf(t, polls == 1, "expected exactly one progress callback, got %d", polls)
+}
@@ -22,4 +22,7 @@ const (
 	adminUserPass = "admin"
 
 	foreverTokenTime = 24 * 365 * 20 * time.Hour // kind of never-expired token
+
+	// max lifetime of access tokens issued along with refresh tokens (see authn.LoginMsg.Refresh)
+	refreshAccessTokenTime = time.Hour
 )
@@ -6,6 +6,7 @@ package main
 
 import (
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"time"
@@ -112,6 +113,8 @@ func (h *hserv) registerPublicHandlers() {
 	h.registerHandler(apc.URLPathClusters.S, h.clusterHandler)
 	h.registerHandler(apc.URLPathRoles.S, h.roleHandler)
 	h.registerHandler(apc.URLPathDae.S, configHandler)
+	h.registerHandler(apc.URLPathLDAP.S, h.ldapHandler)
+	h.registerHandler(apc.URLPathCheck.S, h.checkHandler)
 }
 
 func (h *hserv) userHandler(w http.ResponseWriter, r *http.Request) {
@@ -129,12 +132,34 @@ func (h *hserv) userHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (h *hserv) ldapHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		h.httpLDAPSync(w, r)
+	default:
+		cmn.WriteErr405(w, r, http.MethodPost)
+	}
+}
+
 func (h *hserv) tokenHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodDelete:
 		h.httpRevokeToken(w, r)
+	case http.MethodGet:
+		h.httpValidateToken(w, r)
+	case http.MethodPost:
+		h.httpRefreshToken(w, r)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPost)
+	}
+}
+
+func (h *hserv) checkHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.httpCheckAccess(w, r)
+	default:
+		cmn.WriteErr405(w, r, http.MethodGet)
 	}
 }
 
@@ -168,12 +193,55 @@ func (h *hserv) httpRevokeToken(w http.ResponseWriter, r *http.Request) {
 	}
 	secret := Conf.Secret()
 	if _, err := tok.DecryptToken(msg.Token, secret); err != nil {
+		if _, errR := tok.DecryptRefreshToken(msg.Token, secret); errR == nil {
+			h.mgr.revokeRefreshToken(msg.Token)
+			return
+		}
 		cmn.WriteErr(w, r, err)
 		return
 	}
 	h.mgr.revokeToken(msg.Token)
 }
 
+// Exchanges refresh token for a new access token
+// (does not require any permissions: the refresh token is the credential)
+func (h *hserv) httpRefreshToken(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathTokens.L); err != nil {
+		return
+	}
+	msg := &authn.TokenMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	if msg.RefreshToken == "" {
+		cmn.WriteErrMsg(w, r, "empty refresh token")
+		return
+	}
+	tm, err := h.mgr.refreshToken(msg.RefreshToken)
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return
+	}
+	writeJSON(w, tm, "refresh token")
+}
+
+// Validates a given token (signature, expiration, revocation) - for debugging
+// (does not require any permissions: knowing the token is enough)
+func (h *hserv) httpValidateToken(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathTokens.L); err != nil {
+		return
+	}
+	msg := &authn.TokenMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	if msg.Token == "" {
+		cmn.WriteErrMsg(w, r, "empty token")
+		return
+	}
+	writeJSON(w, h.mgr.validateToken(msg.Token), "validate token")
+}
+
 func (h *hserv) httpUserDel(w http.ResponseWriter, r *http.Request) {
 	apiItems, err := parseURL(w, r, 1, apc.URLPathUsers.L)
 	if err != nil {
@@ -302,9 +370,22 @@ func validateAdminPerms(w http.ResponseWriter, r *http.Request) error {
 		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
 		return err
 	}
+	if err := tk.CheckSource(srcIP(r)); err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return err
+	}
 	return nil
 }
 
+// client IP address (to check tokens bound to source address ranges - see tok.CheckSource)
+func srcIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return net.ParseIP(host)
+}
+
 // Generate h token for h user if provided credentials are valid.
 // If h token is already issued and it is not expired yet then the old
 // token is returned
@@ -321,19 +402,19 @@ func (h *hserv) userLogin(w http.ResponseWriter, r *http.Request) {
 		cmn.WriteErrMsg(w, r, "empty password", http.StatusUnauthorized)
 		return
 	}
+	if err := tok.ValidateCIDRs(msg.CIDRs); err != nil {
+		cmn.WriteErr(w, r, err)
+		return
+	}
 
-	var (
-		token  string
-		userID = apiItems[0]
-	)
-	if token, err = h.mgr.issueToken(userID, msg.Password, msg); err != nil {
+	userID := apiItems[0]
+	tm, err := h.mgr.login(userID, msg.Password, msg)
+	if err != nil {
 		nlog.Errorf("failed to generate token for user %q: %v\n", userID, err)
 		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
 		return
 	}
-
-	repl := fmt.Sprintf(`{"token": %q}`, token)
-	writeBytes(w, cos.UnsafeB(repl), "login")
+	writeJSON(w, tm, "login")
 }
 
 func writeJSON(w http.ResponseWriter, val any, tag string) {
@@ -549,3 +630,56 @@ func (h *hserv) httpRolePut(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 }
+
+// Synchronizes LDAP groups and their members with AuthN roles and users (see ldapsync.go)
+func (h *hserv) httpLDAPSync(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathLDAP.L); err != nil {
+		return
+	}
+	if err := validateAdminPerms(w, r); err != nil {
+		return
+	}
+	dryRun := cos.IsParseBool(r.URL.Query().Get(apc.QparamDryRun))
+	res, err := h.mgr.ldapSync(dryRun)
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, res, "ldap sync")
+}
+
+// Evaluates (simulates) access permissions for a given user or token (see mgr.checkAccess)
+// Checking by user ID requires admin; checking a token requires only knowing the token.
+func (h *hserv) httpCheckAccess(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathCheck.L); err != nil {
+		return
+	}
+	msg := &authn.CheckMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	switch {
+	case msg.Token != "":
+	case msg.UserID != "":
+		if err := validateAdminPerms(w, r); err != nil {
+			return
+		}
+	default:
+		cmn.WriteErrMsg(w, r, "either user ID or token must be specified")
+		return
+	}
+	if msg.Access == 0 {
+		cmn.WriteErrMsg(w, r, "empty permissions requested")
+		return
+	}
+	res, err := h.mgr.checkAccess(msg, srcIP(r))
+	if err != nil {
+		if cos.IsErrNotFound(err) {
+			cmn.WriteErr(w, r, err, http.StatusNotFound)
+		} else {
+			cmn.WriteErr(w, r, err)
+		}
+		return
+	}
+	writeJSON(w, res, "check access")
+}
@@ -0,0 +1,579 @@
+// Package authn is authentication server for AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package main
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+)
+
+// Minimal LDAPv3 client (RFC 4511) - just enough to:
+// - simple-bind (as the configured service account, or as a user to validate their password);
+// - search the directory (groups and, optionally, their members).
+// No paging, no referrals chasing, no StartTLS (use ldaps:// instead).
+
+const (
+	ldapDfltTimeout = 30 * time.Second
+
+	ldapScopeBase    = 0
+	ldapScopeSubtree = 2
+
+	ldapResultSuccess = 0
+)
+
+// BER tags
+const (
+	berBool     = 0x01
+	berInt      = 0x02
+	berOctets   = 0x04
+	berEnum     = 0x0a
+	berSequence = 0x30
+
+	ldapBindReq    = 0x60
+	ldapBindResp   = 0x61
+	ldapUnbindReq  = 0x42
+	ldapSearchReq  = 0x63
+	ldapSearchEnt  = 0x64
+	ldapSearchDone = 0x65
+	ldapSearchRef  = 0x73
+	ldapAuthSimple = 0x80
+
+	ldapFltAnd     = 0xa0
+	ldapFltOr      = 0xa1
+	ldapFltNot     = 0xa2
+	ldapFltEq      = 0xa3
+	ldapFltSubstr  = 0xa4
+	ldapFltGE      = 0xa5
+	ldapFltLE      = 0xa6
+	ldapFltPresent = 0x87
+	ldapFltApprox  = 0xa8
+
+	ldapSubInitial = 0x80
+	ldapSubAny     = 0x81
+	ldapSubFinal   = 0x82
+)
+
+type (
+	ldapConn struct {
+		conn    net.Conn
+		r       *bufio.Reader
+		timeout time.Duration
+		msgID   int
+	}
+	ldapEntry struct {
+		attrs map[string][]string // lowercase attribute name => values
+		dn    string
+	}
+	berElem struct {
+		data []byte
+		tag  byte
+	}
+	errLDAP struct {
+		op   string
+		msg  string
+		code int
+	}
+)
+
+func ldapDial(conf *authn.LDAPConf) (*ldapConn, error) {
+	u, err := url.Parse(conf.URL)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		host    = u.Host
+		timeout = ldapDfltTimeout
+	)
+	if conf.Timeout > 0 {
+		timeout = conf.Timeout.D()
+	}
+	if u.Port() == "" {
+		if u.Scheme == "ldaps" {
+			host = net.JoinHostPort(u.Hostname(), "636")
+		} else {
+			host = net.JoinHostPort(u.Hostname(), "389")
+		}
+	}
+	var (
+		conn   net.Conn
+		dialer = &net.Dialer{Timeout: timeout}
+	)
+	if u.Scheme == "ldaps" {
+		tlsConf := &tls.Config{ServerName: u.Hostname(), InsecureSkipVerify: conf.SkipVerify} //nolint:gosec // (configurable)
+		conn, err = tls.DialWithDialer(dialer, "tcp", host, tlsConf)
+	} else {
+		conn, err = dialer.Dial("tcp", host)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ldapConn{conn: conn, r: bufio.NewReader(conn), timeout: timeout}, nil
+}
+
+func (lc *ldapConn) close() {
+	lc.send(berTLV(ldapUnbindReq, nil)) // (best effort)
+	lc.conn.Close()
+}
+
+func (lc *ldapConn) bind(dn, password string) error {
+	op := berTLV(ldapBindReq, berInteger(3), berString(dn), berTLV(ldapAuthSimple, []byte(password)))
+	if err := lc.send(op); err != nil {
+		return err
+	}
+	resp, err := lc.recv()
+	if err != nil {
+		return err
+	}
+	if resp.tag != ldapBindResp {
+		return fmt.Errorf("ldap bind: unexpected response 0x%x", resp.tag)
+	}
+	return ldapResult("bind", resp)
+}
+
+func (lc *ldapConn) search(base string, scope int, filter string, attrs []string) ([]*ldapEntry, error) {
+	flt, err := ldapFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	la := make([][]byte, 0, len(attrs))
+	for _, a := range attrs {
+		la = append(la, berString(a))
+	}
+	op := berTLV(ldapSearchReq,
+		berString(base),
+		berTLV(berEnum, berIntBytes(scope)),
+		berTLV(berEnum, berIntBytes(0)), // never deref aliases
+		berInteger(0),                   // no size limit (the server's own limit applies)
+		berInteger(0),                   // no time limit
+		berTLV(berBool, []byte{0}),      // types only: false
+		flt,
+		berTLV(berSequence, la...),
+	)
+	if err := lc.send(op); err != nil {
+		return nil, err
+	}
+	var entries []*ldapEntry
+	for {
+		resp, err := lc.recv()
+		if err != nil {
+			return nil, err
+		}
+		switch resp.tag {
+		case ldapSearchEnt:
+			entry, err := parseEntry(resp.data)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry)
+		case ldapSearchRef:
+			// referrals are not followed
+		case ldapSearchDone:
+			return entries, ldapResult("search", resp)
+		default:
+			return nil, fmt.Errorf("ldap search: unexpected response 0x%x", resp.tag)
+		}
+	}
+}
+
+// LDAPMessage ::= SEQUENCE { messageID, protocolOp, controls [0] OPTIONAL }
+func (lc *ldapConn) send(op []byte) error {
+	lc.msgID++
+	msg := berTLV(berSequence, berInteger(lc.msgID), op)
+	lc.conn.SetDeadline(time.Now().Add(lc.timeout))
+	_, err := lc.conn.Write(msg)
+	return err
+}
+
+func (lc *ldapConn) recv() (*berElem, error) {
+	lc.conn.SetDeadline(time.Now().Add(lc.timeout))
+	msg, err := berRead(lc.r)
+	if err != nil {
+		return nil, err
+	}
+	if msg.tag != berSequence {
+		return nil, fmt.Errorf("ldap: invalid message 0x%x", msg.tag)
+	}
+	elems, err := berParse(msg.data)
+	if err != nil {
+		return nil, err
+	}
+	if len(elems) < 2 {
+		return nil, errors.New("ldap: truncated message")
+	}
+	if id := berToInt(elems[0].data); id != lc.msgID {
+		return nil, fmt.Errorf("ldap: unexpected message ID %d (expected %d)", id, lc.msgID)
+	}
+	return &elems[1], nil
+}
+
+// LDAPResult ::= SEQUENCE { resultCode ENUMERATED, matchedDN, diagnosticMessage, ... }
+func ldapResult(op string, resp *berElem) error {
+	elems, err := berParse(resp.data)
+	if err != nil {
+		return err
+	}
+	if len(elems) < 3 {
+		return fmt.Errorf("ldap %s: truncated result", op)
+	}
+	if code := berToInt(elems[0].data); code != ldapResultSuccess {
+		return &errLDAP{op: op, code: code, msg: string(elems[2].data)}
+	}
+	return nil
+}
+
+// SearchResultEntry ::= [APPLICATION 4] SEQUENCE { objectName, attributes SEQUENCE OF { type, vals SET OF value } }
+func parseEntry(b []byte) (*ldapEntry, error) {
+	elems, err := berParse(b)
+	if err != nil {
+		return nil, err
+	}
+	if len(elems) < 2 {
+		return nil, errors.New("ldap: truncated search entry")
+	}
+	entry := &ldapEntry{dn: string(elems[0].data), attrs: make(map[string][]string, 2)}
+	attrs, err := berParse(elems[1].data)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range attrs {
+		tv, err := berParse(a.data)
+		if err != nil {
+			return nil, err
+		}
+		if len(tv) < 2 {
+			continue
+		}
+		vals, err := berParse(tv[1].data)
+		if err != nil {
+			return nil, err
+		}
+		name := strings.ToLower(string(tv[0].data))
+		for _, v := range vals {
+			entry.attrs[name] = append(entry.attrs[name], string(v.data))
+		}
+	}
+	return entry, nil
+}
+
+func (e *ldapEntry) first(attr string) string {
+	if vals := e.attrs[strings.ToLower(attr)]; len(vals) > 0 {
+		return vals[0]
+	}
+	return ""
+}
+
+func (e *errLDAP) Error() string {
+	return fmt.Sprintf("ldap %s failed: result code %d %s", e.op, e.code, e.msg)
+}
+
+// the value of the first RDN, e.g. "uid=jdoe,ou=people,dc=example,dc=com" => "jdoe"
+func rdnValue(dn string) string {
+	var (
+		rdn = dn
+		esc bool
+	)
+loop:
+	for i := range len(dn) {
+		switch {
+		case esc:
+			esc = false
+		case dn[i] == '\\':
+			esc = true
+		case dn[i] == ',' || dn[i] == '+':
+			rdn = dn[:i]
+			break loop
+		}
+	}
+	if i := strings.IndexByte(rdn, '='); i >= 0 {
+		rdn = rdn[i+1:]
+	}
+	return strings.ReplaceAll(strings.TrimSpace(rdn), "\\", "")
+}
+
+//
+// search filter (RFC 4515) => BER
+//
+
+func ldapFilter(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		s = "(objectClass=*)"
+	}
+	if s[0] != '(' {
+		s = "(" + s + ")"
+	}
+	b, rest, err := _filter(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LDAP filter %q: %v", s, err)
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("invalid LDAP filter %q: unexpected %q", s, rest)
+	}
+	return b, nil
+}
+
+func _filter(s string) ([]byte, string, error) {
+	if s == "" || s[0] != '(' {
+		return nil, "", errors.New("expecting '('")
+	}
+	s = s[1:]
+	if s == "" {
+		return nil, "", errors.New("unexpected end")
+	}
+	switch s[0] {
+	case '&', '|':
+		tag := byte(ldapFltAnd)
+		if s[0] == '|' {
+			tag = ldapFltOr
+		}
+		s = s[1:]
+		var items [][]byte
+		for s != "" && s[0] == '(' {
+			item, rest, err := _filter(s)
+			if err != nil {
+				return nil, "", err
+			}
+			items = append(items, item)
+			s = rest
+		}
+		if s == "" || s[0] != ')' {
+			return nil, "", errors.New("expecting ')'")
+		}
+		return berTLV(tag, items...), s[1:], nil
+	case '!':
+		item, rest, err := _filter(s[1:])
+		if err != nil {
+			return nil, "", err
+		}
+		if rest == "" || rest[0] != ')' {
+			return nil, "", errors.New("expecting ')'")
+		}
+		return berTLV(ldapFltNot, item), rest[1:], nil
+	}
+	i := strings.IndexByte(s, ')')
+	if i < 0 {
+		return nil, "", errors.New("expecting ')'")
+	}
+	b, err := _item(s[:i])
+	return b, s[i+1:], err
+}
+
+func _item(s string) ([]byte, error) {
+	i := strings.IndexByte(s, '=')
+	if i <= 0 {
+		return nil, fmt.Errorf("invalid item %q", s)
+	}
+	var (
+		attr = s[:i]
+		val  = s[i+1:]
+		tag  = byte(ldapFltEq)
+	)
+	switch attr[len(attr)-1] {
+	case '>':
+		tag, attr = ldapFltGE, attr[:len(attr)-1]
+	case '<':
+		tag, attr = ldapFltLE, attr[:len(attr)-1]
+	case '~':
+		tag, attr = ldapFltApprox, attr[:len(attr)-1]
+	}
+	if attr == "" {
+		return nil, fmt.Errorf("invalid item %q", s)
+	}
+	if tag != ldapFltEq || !strings.Contains(val, "*") {
+		v, err := unescapeFlt(val)
+		if err != nil {
+			return nil, err
+		}
+		return berTLV(tag, berString(attr), berString(v)), nil
+	}
+	if val == "*" {
+		return berTLV(ldapFltPresent, []byte(attr)), nil
+	}
+	// substrings
+	var (
+		parts = strings.Split(val, "*")
+		subs  = make([][]byte, 0, len(parts))
+	)
+	for j, p := range parts {
+		if p == "" {
+			continue
+		}
+		v, err := unescapeFlt(p)
+		if err != nil {
+			return nil, err
+		}
+		stag := byte(ldapSubAny)
+		switch j {
+		case 0:
+			stag = ldapSubInitial
+		case len(parts) - 1:
+			stag = ldapSubFinal
+		}
+		subs = append(subs, berTLV(stag, []byte(v)))
+	}
+	return berTLV(ldapFltSubstr, berString(attr), berTLV(berSequence, subs...)), nil
+}
+
+// "\XX" hex escapes
+func unescapeFlt(s string) (string, error) {
+	if !strings.Contains(s, "\\") {
+		return s, nil
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			sb.WriteByte(s[i])
+			continue
+		}
+		if i+3 > len(s) {
+			return "", fmt.Errorf("invalid escape in %q", s)
+		}
+		b, err := hex.DecodeString(s[i+1 : i+3])
+		if err != nil {
+			return "", fmt.Errorf("invalid escape in %q: %v", s, err)
+		}
+		sb.Write(b)
+		i += 2
+	}
+	return sb.String(), nil
+}
+
+//
+// BER (the subset used by LDAP)
+//
+
+func berTLV(tag byte, items ...[]byte) []byte {
+	var l int
+	for _, item := range items {
+		l += len(item)
+	}
+	b := make([]byte, 0, l+6)
+	b = append(b, tag)
+	b = berAppendLen(b, l)
+	for _, item := range items {
+		b = append(b, item...)
+	}
+	return b
+}
+
+func berAppendLen(b []byte, l int) []byte {
+	switch {
+	case l < 0x80:
+		return append(b, byte(l))
+	case l <= 0xff:
+		return append(b, 0x81, byte(l))
+	case l <= 0xffff:
+		return append(b, 0x82, byte(l>>8), byte(l))
+	case l <= 0xffffff:
+		return append(b, 0x83, byte(l>>16), byte(l>>8), byte(l))
+	default:
+		return append(b, 0x84, byte(l>>24), byte(l>>16), byte(l>>8), byte(l))
+	}
+}
+
+func berString(s string) []byte { return berTLV(berOctets, []byte(s)) }
+func berInteger(n int) []byte   { return berTLV(berInt, berIntBytes(n)) }
+
+// (non-negative) integer, minimal two's complement
+func berIntBytes(n int) []byte {
+	b := []byte{byte(n)}
+	for n >>= 8; n > 0; n >>= 8 {
+		b = append([]byte{byte(n)}, b...)
+	}
+	if b[0]&0x80 != 0 {
+		b = append([]byte{0}, b...)
+	}
+	return b
+}
+
+func berToInt(b []byte) (n int) {
+	for _, c := range b {
+		n = n<<8 | int(c)
+	}
+	return n
+}
+
+// read one (top-level) TLV
+func berRead(r *bufio.Reader) (*berElem, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	l, err := berReadLen(r)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, l)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return &berElem{tag: tag, data: data}, nil
+}
+
+func berReadLen(r io.ByteReader) (int, error) {
+	c, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	if c < 0x80 {
+		return int(c), nil
+	}
+	n := int(c & 0x7f)
+	if n == 0 || n > 4 {
+		return 0, fmt.Errorf("ber: unsupported length encoding 0x%x", c)
+	}
+	var l int
+	for range n {
+		if c, err = r.ReadByte(); err != nil {
+			return 0, err
+		}
+		l = l<<8 | int(c)
+	}
+	return l, nil
+}
+
+// parse a sequence of TLVs (the content of a constructed element)
+func berParse(b []byte) ([]berElem, error) {
+	var elems []berElem
+	for len(b) > 0 {
+		if len(b) < 2 {
+			return nil, errors.New("ber: truncated element")
+		}
+		tag := b[0]
+		br := &byteReader{b: b[1:]}
+		l, err := berReadLen(br)
+		if err != nil {
+			return nil, err
+		}
+		b = br.b
+		if l > len(b) {
+			return nil, fmt.Errorf("ber: element length %d exceeds %d", l, len(b))
+		}
+		elems = append(elems, berElem{tag: tag, data: b[:l]})
+		b = b[l:]
+	}
+	return elems, nil
+}
+
+type byteReader struct{ b []byte }
+
+func (br *byteReader) ReadByte() (byte, error) {
+	if len(br.b) == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	c := br.b[0]
+	br.b = br.b[1:]
+	return c, nil
+}
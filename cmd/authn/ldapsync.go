@@ -0,0 +1,385 @@
+// Package authn is authentication server for AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package main
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// LDAP (or Active Directory) synchronization:
+// - each LDAP group found under `GroupBaseDN` (and matching `GroupFilter`) becomes an AIS role named
+//   `RolePrefix` + group name; new roles get created without permissions - it is up to the administrator
+//   to grant them (`ais auth set role`); an existing locally-created role with the same name gets used as is;
+// - each group member becomes an LDAP-managed AIS user that has all the roles of its groups; LDAP-managed
+//   users have no local password and authenticate by binding to the directory with their DNs;
+// - LDAP-managed roles and users that are no longer present in the directory get deleted;
+// - locally-created users with the same names are handled according to `ConflictPolicy`;
+// - dry-run computes (and returns) all the changes without making them.
+//
+// Limitations:
+// - nested groups are not expanded (members must be users);
+// - no paged results and no AD range retrieval: large groups are subject to the server's size limits.
+
+const (
+	ldapOpAdd      = "add"
+	ldapOpUpdate   = "update"
+	ldapOpDelete   = "delete"
+	ldapOpConflict = "conflict"
+
+	ldapKindRole = "role"
+	ldapKindUser = "user"
+
+	ldapDfltGroupFilter = "(|(objectClass=group)(objectClass=groupOfNames)(objectClass=groupOfUniqueNames))"
+	ldapDfltNameAttr    = "cn"
+	ldapDfltMemberAttr  = "member"
+)
+
+type (
+	ldapGroup struct {
+		dn      string
+		role    string   // AIS role name
+		members []string // AIS user IDs
+	}
+	ldapDir struct {
+		users  map[string]string // AIS user ID => DN
+		groups []*ldapGroup
+	}
+)
+
+func (m *mgr) ldapSyncLoop(interval time.Duration) {
+	for {
+		if _, err := m.ldapSync(false); err != nil {
+			nlog.Errorln(err)
+		}
+		time.Sleep(interval)
+	}
+}
+
+func (m *mgr) ldapSync(dryRun bool) (*authn.LDAPSyncResult, error) {
+	conf := &Conf.LDAP
+	if !conf.Enabled() {
+		return nil, errors.New("LDAP is not configured")
+	}
+	m.ldapMu.Lock()
+	defer m.ldapMu.Unlock()
+
+	dir, err := ldapFetch(conf)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.applyLDAP(dir, conf.ConflictPolicy, dryRun)
+	if err == nil && !dryRun && len(res.Changes) > 0 {
+		nlog.Infof("LDAP sync: %d group(s), %d user(s), %d change(s)", res.Groups, res.Users, len(res.Changes))
+	}
+	return res, err
+}
+
+// search the directory for groups and resolve their members
+func ldapFetch(conf *authn.LDAPConf) (*ldapDir, error) {
+	lc, err := ldapDial(conf)
+	if err != nil {
+		return nil, err
+	}
+	defer lc.close()
+	if err := lc.bind(conf.BindDN, conf.BindPassword); err != nil {
+		return nil, err
+	}
+	var (
+		nameAttr   = ldapDflt(conf.GroupNameAttr, ldapDfltNameAttr)
+		memberAttr = ldapDflt(conf.MemberAttr, ldapDfltMemberAttr)
+		filter     = ldapDflt(conf.GroupFilter, ldapDfltGroupFilter)
+	)
+	entries, err := lc.search(conf.GroupBaseDN, ldapScopeSubtree, filter, []string{nameAttr, memberAttr})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		dir   = &ldapDir{users: make(map[string]string, 16), groups: make([]*ldapGroup, 0, len(entries))}
+		names = make(map[string]string, 16) // member DN => user ID (cache)
+	)
+	for _, e := range entries {
+		name := e.first(nameAttr)
+		if name == "" {
+			nlog.Warningf("LDAP group %q: no %q attribute, skipping", e.dn, nameAttr)
+			continue
+		}
+		g := &ldapGroup{dn: e.dn, role: conf.RolePrefix + name}
+		for _, dn := range e.attrs[strings.ToLower(memberAttr)] {
+			uid, ok := names[dn]
+			if !ok {
+				uid = ldapUserID(lc, dn, conf.UserNameAttr)
+				names[dn] = uid
+			}
+			if uid == "" {
+				continue
+			}
+			if prev, ok := dir.users[uid]; ok && !strings.EqualFold(prev, dn) {
+				nlog.Warningf("LDAP: user %q: ambiguous DN (%q vs %q), skipping the latter", uid, prev, dn)
+				continue
+			}
+			dir.users[uid] = dn
+			g.members = append(g.members, uid)
+		}
+		dir.groups = append(dir.groups, g)
+	}
+	return dir, nil
+}
+
+// user ID is either the value of the member's `UserNameAttr` or, by default, the value of its RDN
+func ldapUserID(lc *ldapConn, dn, attr string) string {
+	if attr == "" {
+		return rdnValue(dn)
+	}
+	entries, err := lc.search(dn, ldapScopeBase, "(objectClass=*)", []string{attr})
+	if err != nil || len(entries) == 0 {
+		nlog.Warningf("LDAP: failed to look up member %q: %v", dn, err)
+		return ""
+	}
+	uid := entries[0].first(attr)
+	if uid == "" {
+		// (e.g., nested group)
+		nlog.Warningf("LDAP: member %q has no %q attribute, skipping", dn, attr)
+	}
+	return uid
+}
+
+func ldapDflt(val, dflt string) string {
+	if val == "" {
+		return dflt
+	}
+	return val
+}
+
+// compute the changes and, unless dry-run, apply them
+func (m *mgr) applyLDAP(dir *ldapDir, policy string, dryRun bool) (*authn.LDAPSyncResult, error) {
+	res := &authn.LDAPSyncResult{Groups: len(dir.groups), Users: len(dir.users), DryRun: dryRun}
+	roleList, err := m.roleList()
+	if err != nil {
+		return nil, err
+	}
+	users, err := m.userList()
+	if err != nil {
+		return nil, err
+	}
+	if policy == "" {
+		policy = authn.LDAPConflictSkip
+	}
+
+	// 1. roles
+	var (
+		roles     = make(map[string]*authn.Role, len(roleList))
+		ldapRoles = make(map[string]bool, len(dir.groups)) // all roles mapped to (current or stale) groups
+		memberOf  = make(map[string][]string, len(dir.users))
+		stale     []*authn.Role
+	)
+	for _, role := range roleList {
+		roles[role.Name] = role
+	}
+	for _, g := range dir.groups {
+		ldapRoles[g.role] = true
+		for _, uid := range g.members {
+			memberOf[uid] = append(memberOf[uid], g.role)
+		}
+		role, ok := roles[g.role]
+		switch {
+		case !ok:
+			role = &authn.Role{Name: g.role, Description: "LDAP group " + g.dn, DN: g.dn}
+			roles[g.role] = role
+			resAdd(res, ldapOpAdd, ldapKindRole, g.role, g.dn)
+		case role.IsAdmin:
+			resAdd(res, ldapOpConflict, ldapKindRole, g.role, "built-in role, skipping")
+			delete(ldapRoles, g.role)
+			continue
+		case role.DN == "" || role.DN == g.dn:
+			continue // (locally-created role with the same name gets used as is)
+		default:
+			resAdd(res, ldapOpUpdate, ldapKindRole, g.role, role.DN+" => "+g.dn)
+			role.DN = g.dn
+		}
+		if !dryRun {
+			if err := m.db.Set(rolesCollection, role.Name, role); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, role := range roleList {
+		if role.DN != "" && !_mapped(dir.groups, role.Name) {
+			ldapRoles[role.Name] = true
+			stale = append(stale, role)
+		}
+	}
+
+	// 2. users
+	uids := make([]string, 0, len(dir.users))
+	for uid := range dir.users {
+		uids = append(uids, uid)
+	}
+	sort.Strings(uids)
+	for _, uid := range uids {
+		var (
+			dn       = dir.users[uid]
+			newRoles = _roles(roles, memberOf[uid], ldapRoles)
+			user, ok = users[uid]
+			details  string
+		)
+		switch {
+		case !ok:
+			user = &authn.User{ID: uid, DN: dn, Roles: newRoles}
+			resAdd(res, ldapOpAdd, ldapKindUser, uid, "roles: "+_names(newRoles))
+		case user.IsAdmin():
+			resAdd(res, ldapOpConflict, ldapKindUser, uid, "administrator, skipping")
+			continue
+		case user.DN != "": // LDAP-managed
+			if user.DN == dn && reflect.DeepEqual(user.Roles, newRoles) {
+				continue
+			}
+			user.DN, user.Roles = dn, newRoles
+			resAdd(res, ldapOpUpdate, ldapKindUser, uid, "roles: "+_names(newRoles))
+		default: // locally-created
+			switch policy {
+			case authn.LDAPConflictMerge:
+				merged := append(_localRoles(user.Roles, ldapRoles), newRoles...)
+				if reflect.DeepEqual(user.Roles, merged) {
+					continue
+				}
+				user.Roles = merged
+				details = "local user (merge), roles: " + _names(merged)
+			case authn.LDAPConflictOverwrite:
+				user.DN, user.Password, user.Roles = dn, "", newRoles
+				details = "local user (overwrite), roles: " + _names(newRoles)
+			default:
+				resAdd(res, ldapOpConflict, ldapKindUser, uid, "local user, skipping")
+				continue
+			}
+			resAdd(res, ldapOpUpdate, ldapKindUser, uid, details)
+		}
+		if !dryRun {
+			if err := m.db.Set(usersCollection, uid, user); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// users that are no longer group members (or hold stale roles)
+	for _, uid := range _sorted(users) {
+		user := users[uid]
+		if _, ok := dir.users[uid]; ok || user.IsAdmin() {
+			continue
+		}
+		if user.DN != "" {
+			resAdd(res, ldapOpDelete, ldapKindUser, uid, user.DN)
+			if !dryRun {
+				if err := m.db.Delete(usersCollection, uid); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		local := _localRoles(user.Roles, ldapRoles)
+		if len(local) == len(user.Roles) {
+			continue
+		}
+		user.Roles = local
+		resAdd(res, ldapOpUpdate, ldapKindUser, uid, "local user, roles: "+_names(local))
+		if !dryRun {
+			if err := m.db.Set(usersCollection, uid, user); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// 3. stale roles (last)
+	for _, role := range stale {
+		resAdd(res, ldapOpDelete, ldapKindRole, role.Name, role.DN)
+		if !dryRun {
+			if err := m.db.Delete(rolesCollection, role.Name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+func _mapped(groups []*ldapGroup, role string) bool {
+	for _, g := range groups {
+		if g.role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// (users store copies of their roles)
+func _roles(roles map[string]*authn.Role, names []string, ldapRoles map[string]bool) []*authn.Role {
+	sort.Strings(names)
+	out := make([]*authn.Role, 0, len(names))
+	for i, name := range names {
+		if i > 0 && name == names[i-1] {
+			continue
+		}
+		if role, ok := roles[name]; ok && ldapRoles[name] {
+			out = append(out, role)
+		}
+	}
+	return out
+}
+
+// roles that are not mapped to LDAP groups
+func _localRoles(roles []*authn.Role, ldapRoles map[string]bool) []*authn.Role {
+	out := make([]*authn.Role, 0, len(roles))
+	for _, role := range roles {
+		if !ldapRoles[role.Name] {
+			out = append(out, role)
+		}
+	}
+	return out
+}
+
+func _names(roles []*authn.Role) string {
+	if len(roles) == 0 {
+		return "-"
+	}
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func _sorted(users map[string]*authn.User) []string {
+	uids := make([]string, 0, len(users))
+	for uid := range users {
+		uids = append(uids, uid)
+	}
+	sort.Strings(uids)
+	return uids
+}
+
+func (m *mgr) ldapAuth(dn, password string) error {
+	conf := &Conf.LDAP
+	if !conf.Enabled() {
+		return fmt.Errorf("user %q is LDAP-managed but LDAP is not configured", dn)
+	}
+	if password == "" {
+		return errInvalidCredentials // (an empty password would be an anonymous bind)
+	}
+	lc, err := ldapDial(conf)
+	if err != nil {
+		return err
+	}
+	defer lc.close()
+	return lc.bind(dn, password)
+}
+
+func resAdd(res *authn.LDAPSyncResult, op, kind, name, details string) {
+	res.Changes = append(res.Changes, &authn.LDAPChange{Op: op, Kind: kind, Name: name, Details: details})
+}
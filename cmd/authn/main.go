@@ -71,6 +71,9 @@ func main() {
 		cos.ExitLogf("Failed to load configuration from %q: %v", configPath, err)
 	}
 	Conf.Init()
+	if err := Conf.LDAP.Validate(); err != nil {
+		cos.ExitLogf("Invalid configuration %q: %v", configPath, err)
+	}
 	if val := os.Getenv(env.AuthN.SecretKey); val != "" {
 		Conf.SetSecret(&val)
 	}
@@ -94,6 +97,9 @@ func main() {
 	nlog.Infof("Version %s (build %s)\n", cmn.VersionAuthN+"."+build, buildtime)
 
 	go logFlush()
+	if Conf.LDAP.Enabled() && Conf.LDAP.SyncInterval > 0 {
+		go mgr.ldapSyncLoop(Conf.LDAP.SyncInterval.D())
+	}
 
 	srv := newServer(mgr)
 	err = srv.Run()
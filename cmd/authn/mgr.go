@@ -8,8 +8,10 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -29,6 +31,7 @@ type mgr struct {
 	clientH   *http.Client
 	clientTLS *http.Client
 	db        kvdb.Driver
+	ldapMu    sync.Mutex // serializes LDAP sync (see ldapsync.go)
 }
 
 var (
@@ -95,6 +98,9 @@ func (m *mgr) updateUser(userID string, updateReq *authn.User) error {
 	if userID == adminUserID && len(updateReq.Roles) != 0 {
 		return errors.New("cannot change administrator's role")
 	}
+	if uInfo.DN != "" && updateReq.Password != "" {
+		return fmt.Errorf("cannot change password of LDAP-managed user %q", userID)
+	}
 
 	if updateReq.Password != "" {
 		uInfo.Password = encryptPassword(updateReq.Password)
@@ -141,6 +147,9 @@ func (m *mgr) addRole(info *authn.Role) error {
 	if info.IsAdmin {
 		return fmt.Errorf("only built-in roles can have %q permissions", adminUserID)
 	}
+	if info.QPS < 0 || info.MaxConc < 0 {
+		return fmt.Errorf("role %q: limits cannot be negative (qps %d, max-conc %d)", info.Name, info.QPS, info.MaxConc)
+	}
 
 	_, err := m.db.GetString(rolesCollection, info.Name)
 	if err == nil {
@@ -171,6 +180,13 @@ func (m *mgr) updateRole(role string, updateReq *authn.Role) error {
 	if updateReq.Description != "" {
 		rInfo.Description = updateReq.Description
 	}
+	// limits: zero - no change; negative - remove
+	if updateReq.QPS != 0 {
+		rInfo.QPS = max(updateReq.QPS, 0)
+	}
+	if updateReq.MaxConc != 0 {
+		rInfo.MaxConc = max(updateReq.MaxConc, 0)
+	}
 	rInfo.ClusterACLs = mergeClusterACLs(rInfo.ClusterACLs, updateReq.ClusterACLs, "")
 	rInfo.BucketACLs = mergeBckACLs(rInfo.BucketACLs, updateReq.BucketACLs, "")
 
@@ -349,41 +365,117 @@ func (m *mgr) delCluster(cluID string) error {
 // already generated and is not expired yet the existing token is returned.
 // Token includes user ID, permissions, and token expiration time.
 // If a new token was generated then it sends the proxy a new valid token list
-func (m *mgr) issueToken(uid, pwd string, msg *authn.LoginMsg) (token string, err error) {
-	var (
-		uInfo   = &authn.User{}
-		cid     string
-		cluACLs []*authn.CluACL
-		bckACLs []*authn.BckACL
-	)
-	err = m.db.Get(usersCollection, uid, uInfo)
+func (m *mgr) issueToken(uid, pwd string, msg *authn.LoginMsg) (string, error) {
+	tm, err := m.login(uid, pwd, msg)
 	if err != nil {
+		return "", err
+	}
+	return tm.Token, nil
+}
+
+// Same as above, plus refresh token when requested (see authn.LoginMsg.Refresh)
+func (m *mgr) login(uid, pwd string, msg *authn.LoginMsg) (*authn.TokenMsg, error) {
+	uInfo := &authn.User{}
+	if err := m.db.Get(usersCollection, uid, uInfo); err != nil {
 		nlog.Errorln(err)
-		return "", errInvalidCredentials
+		return nil, errInvalidCredentials
 	}
 
 	debug.Assert(uid == uInfo.ID, uid, " vs ", uInfo.ID)
 
-	if !isSamePassword(pwd, uInfo.Password) {
-		return "", errInvalidCredentials
+	if uInfo.DN != "" {
+		// LDAP-managed user: bind to the directory
+		if err := m.ldapAuth(uInfo.DN, pwd); err != nil {
+			nlog.Errorln(err)
+			return nil, errInvalidCredentials
+		}
+	} else if !isSamePassword(pwd, uInfo.Password) {
+		return nil, errInvalidCredentials
+	}
+
+	var (
+		tm       = &authn.TokenMsg{}
+		expDelta = Conf.Expire()
+	)
+	if msg.Refresh {
+		expDelta = shortExpire() // unless explicitly requested otherwise (below)
+	}
+	if msg.ExpiresIn != nil {
+		expDelta = *msg.ExpiresIn
+	}
+	if err := m.accessToken(tm, uInfo, expDelta, msg.CIDRs); err != nil {
+		return nil, err
+	}
+	if msg.Refresh {
+		var (
+			err     error
+			expires = time.Now().Add(Conf.RefreshExpire())
+		)
+		if tm.RefreshToken, err = tok.RefreshJWT(expires, uInfo.ID, msg.CIDRs, Conf.Secret()); err != nil {
+			return nil, err
+		}
+	}
+	return tm, nil
+}
+
+// Exchange refresh token for a new access token. The user's permissions
+// are re-evaluated (and the user must still exist).
+func (m *mgr) refreshToken(refreshToken string) (*authn.TokenMsg, error) {
+	tk, err := tok.DecryptRefreshToken(refreshToken, Conf.Secret())
+	if err != nil {
+		return nil, tok.ErrInvalidToken
+	}
+	if tk.Expires.Before(time.Now()) {
+		return nil, tok.ErrTokenExpired
+	}
+	if _, err := m.db.GetString(revokedCollection, refreshToken); err == nil {
+		return nil, tok.ErrTokenRevoked
+	}
+	uInfo, err := m.lookupUser(tk.UserID)
+	if err != nil {
+		nlog.Errorln(err)
+		return nil, errInvalidCredentials
+	}
+	tm := &authn.TokenMsg{RefreshToken: refreshToken}
+	if err := m.accessToken(tm, uInfo, shortExpire(), tk.CIDRs); err != nil {
+		return nil, err
+	}
+	return tm, nil
+}
+
+// access tokens that come with refresh tokens are short-lived
+func shortExpire() time.Duration {
+	if d := Conf.Expire(); d > 0 && d < refreshAccessTokenTime {
+		return d
 	}
+	return refreshAccessTokenTime
+}
 
-	// update ACLs with roles' ones
+// user's ACLs: roles' ones, merged
+func userACLs(uInfo *authn.User) (cluACLs []*authn.CluACL, bckACLs []*authn.BckACL) {
+	var cid string
 	for _, role := range uInfo.Roles {
 		cluACLs = mergeClusterACLs(cluACLs, role.ClusterACLs, cid)
 		bckACLs = mergeBckACLs(bckACLs, role.BucketACLs, cid)
 	}
-
-	// generate token
-	token, err = m._token(msg, uInfo, cluACLs, bckACLs)
-	return token, err
+	return cluACLs, bckACLs
 }
 
-func (m *mgr) _token(msg *authn.LoginMsg, uInfo *authn.User, cluACLs []*authn.CluACL, bckACLs []*authn.BckACL) (token string, err error) {
-	expDelta := Conf.Expire()
-	if msg.ExpiresIn != nil {
-		expDelta = *msg.ExpiresIn
+// limits of the user's roles - current ones (rather than the role copies stored with the user)
+func (m *mgr) roleLimits(uInfo *authn.User) (limits []*authn.RoleLimit) {
+	for _, role := range uInfo.Roles {
+		if rInfo, err := m.lookupRole(role.Name); err == nil {
+			role = rInfo
+		}
+		if role.QPS > 0 || role.MaxConc > 0 {
+			limits = append(limits, &authn.RoleLimit{Role: role.Name, QPS: role.QPS, MaxConc: role.MaxConc})
+		}
 	}
+	return limits
+}
+
+func (m *mgr) accessToken(tm *authn.TokenMsg, uInfo *authn.User, expDelta time.Duration, cidrs []string) (err error) {
+	cluACLs, bckACLs := userACLs(uInfo)
 	if expDelta == 0 {
 		expDelta = foreverTokenTime
 	}
@@ -394,12 +486,13 @@ func (m *mgr) _token(msg *authn.LoginMsg, uInfo *authn.User, cluACLs []*authn.Cl
 	expires := time.Now().Add(expDelta)
 	uid := uInfo.ID
 	if uInfo.IsAdmin() {
-		token, err = tok.AdminJWT(expires, uid, Conf.Secret())
+		tm.Token, err = tok.AdminJWT(expires, uid, cidrs, Conf.Secret())
 	} else {
 		m.fixClusterIDs(cluACLs)
-		token, err = tok.JWT(expires, uid, bckACLs, cluACLs, Conf.Secret())
+		tm.Token, err = tok.JWT(expires, uid, bckACLs, cluACLs, m.roleLimits(uInfo), cidrs, Conf.Secret())
 	}
-	return token, err
+	tm.Expires = expires.Unix()
+	return err
 }
 
 // Before putting a list of cluster permissions to a token, cluster aliases
@@ -435,6 +528,163 @@ func (m *mgr) revokeToken(token string) error {
 	return nil
 }
 
+// (AIS clusters never see refresh tokens - nothing to broadcast)
+func (m *mgr) revokeRefreshToken(token string) error {
+	return m.db.Set(revokedCollection, token, "!")
+}
+
+func (m *mgr) validateToken(token string) *authn.TokenStatus {
+	status := &authn.TokenStatus{}
+	tk, err := tok.DecryptToken(token, Conf.Secret())
+	if err != nil {
+		status.Err = err.Error()
+		return status
+	}
+	status.Valid = true
+	status.Expired = tk.Expires.Before(time.Now())
+	status.Revoked = m.isRevoked(token)
+	if uInfo, err := m.lookupUser(tk.UserID); err == nil {
+		for _, role := range uInfo.Roles {
+			status.Roles = append(status.Roles, role.Name)
+		}
+	}
+	return status
+}
+
+// Permission check (simulation). Evaluates the same ACLs, and in the same way, as AIS gateways
+// do (see tok.CheckPermissions). Given a token, it is the token's own ACLs that get evaluated;
+// given a user - the ACLs that a newly issued token would carry. In both cases, the user's
+// current roles are then searched to find out which role contributed the matching ACL(s).
+// A token bound to source address ranges is also checked against the caller's `srcIP`.
+func (m *mgr) checkAccess(msg *authn.CheckMsg, srcIP net.IP) (*authn.CheckResult, error) {
+	var (
+		tk    *tok.Token
+		uInfo *authn.User
+		bck   *cmn.Bck
+		res   = &authn.CheckResult{}
+		err   error
+	)
+	if msg.Token != "" {
+		if tk, err = tok.DecryptToken(msg.Token, Conf.Secret()); err != nil {
+			return nil, err
+		}
+		uInfo, _ = m.lookupUser(tk.UserID) // (used only to find the roles)
+		switch {
+		case tk.Expires.Before(time.Now()):
+			res.Reason = tok.ErrTokenExpired.Error()
+		case m.isRevoked(msg.Token):
+			res.Reason = tok.ErrTokenRevoked.Error()
+		default:
+			if err := tk.CheckSource(srcIP); err != nil {
+				res.Reason = err.Error()
+			}
+		}
+	} else {
+		if uInfo, err = m.lookupUser(msg.UserID); err != nil {
+			return nil, err
+		}
+		tk = &tok.Token{UserID: uInfo.ID, IsAdmin: uInfo.IsAdmin()}
+		if !tk.IsAdmin {
+			tk.ClusterACLs, tk.BucketACLs = userACLs(uInfo)
+			m.fixClusterIDs(tk.ClusterACLs)
+		}
+	}
+	res.UserID = tk.UserID
+	if res.Cluster, err = m.checkCluster(msg.Cluster); err != nil {
+		return nil, err
+	}
+	if msg.Bck.Name != "" {
+		bck = &cmn.Bck{Name: msg.Bck.Name, Provider: cos.Left(msg.Bck.Provider, apc.AIS)}
+	}
+
+	if res.Reason == "" {
+		if err := tk.CheckPermissions(res.Cluster, bck, msg.Access); err != nil {
+			res.Reason = err.Error()
+		} else {
+			res.Allowed = true
+		}
+	}
+
+	// matching ACLs and roles
+	if tk.IsAdmin {
+		res.Matched = []*authn.MatchedACL{{Role: authn.AdminRole, Rule: "admin", Granted: apc.AccessAll}}
+		return res, nil
+	}
+	var granted apc.AccessAttrs
+	bckACL, cluACL := tk.MatchingACLs(res.Cluster, bck, msg.Access)
+	if bckACL != nil {
+		granted |= bckACL.Access
+		matched := &authn.MatchedACL{Rule: "bucket " + bck.Cname(""), Granted: bckACL.Access}
+		if uInfo != nil {
+			matched.Role = lastRole(uInfo, func(role *authn.Role) bool {
+				for _, acl := range role.BucketACLs {
+					if acl.Bck.Equal(&bckACL.Bck) {
+						return true
+					}
+				}
+				return false
+			})
+		}
+		res.Matched = append(res.Matched, matched)
+	}
+	if cluACL != nil {
+		granted |= cluACL.Access
+		matched := &authn.MatchedACL{Rule: "cluster [" + cluACL.ID + "]", Granted: cluACL.Access}
+		if cluACL.ID == "" {
+			matched.Rule = "all clusters"
+		}
+		if uInfo != nil {
+			matched.Role = lastRole(uInfo, func(role *authn.Role) bool {
+				for _, acl := range role.ClusterACLs {
+					if acl.ID == cluACL.ID || (acl.ID != "" && m.cluLookup(acl.ID, acl.ID) == cluACL.ID) {
+						return true
+					}
+				}
+				return false
+			})
+		}
+		res.Matched = append(res.Matched, matched)
+	}
+	if !res.Allowed {
+		res.Missing = msg.Access &^ granted
+	}
+	return res, nil
+}
+
+// (when merging roles' ACLs the last one wins - see mergeClusterACLs and mergeBckACLs)
+func lastRole(uInfo *authn.User, has func(*authn.Role) bool) string {
+	for i := len(uInfo.Roles) - 1; i >= 0; i-- {
+		if has(uInfo.Roles[i]) {
+			return uInfo.Roles[i].Name
+		}
+	}
+	return ""
+}
+
+// Resolves cluster ID or alias; when omitted, defaults to the only registered cluster.
+// (An unknown ID is returned as is - the token may still carry ACLs that apply.)
+func (m *mgr) checkCluster(cluster string) (string, error) {
+	if cluster != "" {
+		return cos.Left(m.cluLookup(cluster, cluster), cluster), nil
+	}
+	clus, err := m.clus()
+	if err != nil {
+		return "", err
+	}
+	if len(clus) != 1 {
+		return "", fmt.Errorf("%d registered clusters: cluster ID (or alias) must be specified", len(clus))
+	}
+	for cid := range clus {
+		cluster = cid
+	}
+	return cluster, nil
+}
+
+func (m *mgr) isRevoked(token string) bool {
+	_, err := m.db.GetString(revokedCollection, token)
+	return err == nil
+}
+
 // Create a list of non-expired and valid revoked tokens.
 // Obsolete and invalid tokens are removed from the database.
 func (m *mgr) generateRevokedTokenList() ([]string, error) {
@@ -450,6 +700,9 @@ func (m *mgr) generateRevokedTokenList() ([]string, error) {
 	for _, token := range tokens {
 		tk, err := tok.DecryptToken(token, secret)
 		if err != nil {
+			if rtk, errR := tok.DecryptRefreshToken(token, secret); errR == nil && rtk.Expires.After(now) {
+				continue // keep revoked (but do not send) until expires
+			}
 			m.db.Delete(revokedCollection, token)
 			continue
 		}
@@ -6,8 +6,10 @@
 package tok
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -20,12 +22,15 @@ import (
 )
 
 type Token struct {
-	UserID      string          `json:"username"`
-	Expires     time.Time       `json:"expires"`
-	Token       string          `json:"token"`
-	ClusterACLs []*authn.CluACL `json:"clusters"`
-	BucketACLs  []*authn.BckACL `json:"buckets,omitempty"`
-	IsAdmin     bool            `json:"admin"`
+	UserID      string             `json:"username"`
+	Expires     time.Time          `json:"expires"`
+	Token       string             `json:"token"`
+	ClusterACLs []*authn.CluACL    `json:"clusters"`
+	BucketACLs  []*authn.BckACL    `json:"buckets,omitempty"`
+	Limits      []*authn.RoleLimit `json:"limits,omitempty"` // per-role (enforced by AIS gateways)
+	CIDRs       []string           `json:"cidrs,omitempty"`  // client source address ranges (enforced by AIS gateways)
+	IsAdmin     bool               `json:"admin"`
+	IsRefresh   bool               `json:"refresh,omitempty"` // refresh token (see RefreshJWT)
 }
 
 var (
@@ -35,30 +40,76 @@ var (
 	ErrNoBearerToken = errors.New("invalid token: no bearer")
 	ErrTokenExpired  = errors.New("token expired")
 	ErrTokenRevoked  = errors.New("token revoked")
+	ErrTokenSource   = errors.New("token not valid from this address")
 )
 
 // TODO: cos.Unsafe* and other micro-optimization and refactoring
 
-func AdminJWT(expires time.Time, userID, secret string) (string, error) {
-	t := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+// (all tokens may optionally be bound to client source address ranges - see ValidateCIDRs)
+
+func AdminJWT(expires time.Time, userID string, cidrs []string, secret string) (string, error) {
+	claims := jwt.MapClaims{
 		"expires":  expires,
 		"username": userID,
 		"admin":    true,
-	})
+	}
+	if len(cidrs) > 0 {
+		claims["cidrs"] = cidrs
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return t.SignedString([]byte(secret))
 }
 
 func JWT(expires time.Time, userID string, bucketACLs []*authn.BckACL, clusterACLs []*authn.CluACL,
-	secret string) (string, error) {
-	t := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	limits []*authn.RoleLimit, cidrs []string, secret string) (string, error) {
+	claims := jwt.MapClaims{
 		"expires":  expires,
 		"username": userID,
 		"buckets":  bucketACLs,
 		"clusters": clusterACLs,
-	})
+	}
+	if len(limits) > 0 {
+		claims["limits"] = limits
+	}
+	if len(cidrs) > 0 {
+		claims["cidrs"] = cidrs
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return t.SignedString([]byte(secret))
 }
 
+// Refresh token: can only be exchanged (with AuthN) for a new access token.
+// It is signed with a key derived from the secret, and so AIS gateways
+// (that validate access tokens with the secret itself) do not accept it.
+// Access tokens issued in exchange inherit the refresh token's source address ranges, if any.
+func RefreshJWT(expires time.Time, userID string, cidrs []string, secret string) (string, error) {
+	claims := jwt.MapClaims{
+		"expires":  expires,
+		"username": userID,
+		"refresh":  true,
+	}
+	if len(cidrs) > 0 {
+		claims["cidrs"] = cidrs
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return t.SignedString(refreshKey(secret))
+}
+
+// ValidateCIDRs checks source address ranges to bind a token to, e.g. "10.0.0.0/8"
+func ValidateCIDRs(cidrs []string) error {
+	for _, s := range cidrs {
+		if _, _, err := net.ParseCIDR(s); err != nil {
+			return fmt.Errorf("invalid source address range %q: %v", s, err)
+		}
+	}
+	return nil
+}
+
+func refreshKey(secret string) []byte {
+	key := sha256.Sum256([]byte("refresh-token:" + secret))
+	return key[:]
+}
+
 // Header format: 'Authorization: Bearer <token>'
 func ExtractToken(hdr http.Header) (string, error) {
 	s := hdr.Get(apc.HdrAuthorization)
@@ -73,11 +124,27 @@ func ExtractToken(hdr http.Header) (string, error) {
 }
 
 func DecryptToken(tokenStr, secret string) (*Token, error) {
+	tk, err := decrypt(tokenStr, []byte(secret))
+	if err == nil && tk.IsRefresh {
+		return nil, ErrInvalidToken
+	}
+	return tk, err
+}
+
+func DecryptRefreshToken(tokenStr, secret string) (*Token, error) {
+	tk, err := decrypt(tokenStr, refreshKey(secret))
+	if err == nil && !tk.IsRefresh {
+		return nil, ErrInvalidToken
+	}
+	return tk, err
+}
+
+func decrypt(tokenStr string, key []byte) (*Token, error) {
 	jwtToken, err := jwt.Parse(tokenStr, func(t *jwt.Token) (any, error) {
 		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
 		}
-		return []byte(secret), nil
+		return key, nil
 	})
 	if err != nil {
 		return nil, err
@@ -101,6 +168,22 @@ func (tk *Token) String() string {
 	return fmt.Sprintf("user %s, %s", tk.UserID, expiresIn(tk.Expires))
 }
 
+// CheckSource returns ErrTokenSource if the token is bound to source address ranges
+// that do not include the given (client) IP address
+func (tk *Token) CheckSource(ip net.IP) error {
+	if len(tk.CIDRs) == 0 {
+		return nil
+	}
+	if ip != nil {
+		for _, s := range tk.CIDRs {
+			if _, ipnet, err := net.ParseCIDR(s); err == nil && ipnet.Contains(ip) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("user `%s` %w (%s): allowed %v", tk.UserID, ErrTokenSource, ip, tk.CIDRs)
+}
+
 // A user has two-level permissions: cluster-wide and on per bucket basis.
 // To be able to access data, a user must have either permission. This
 // allows creating users, e.g, with read-only access to the entire cluster,
@@ -164,6 +247,22 @@ func (tk *Token) CheckPermissions(clusterID string, bck *cmn.Bck, perms apc.Acce
 	return nil
 }
 
+// MatchingACLs returns the bucket and/or cluster ACL that CheckPermissions (above)
+// consults to allow or deny the requested permissions (nil when not consulted or not found)
+func (tk *Token) MatchingACLs(clusterID string, bck *cmn.Bck, perms apc.AccessAttrs) (bckACL *authn.BckACL, cluACL *authn.CluACL) {
+	var (
+		cluPerms = perms & accessCluster
+		objPerms = perms &^ accessCluster
+	)
+	if objPerms != 0 && bck != nil {
+		bckACL = tk.bckACL(clusterID, bck)
+	}
+	if cluPerms != 0 || (objPerms != 0 && bckACL == nil) {
+		cluACL = tk.cluACL(clusterID)
+	}
+	return bckACL, cluACL
+}
+
 //
 // private
 //
@@ -180,22 +279,33 @@ func expiresIn(tm time.Time) string {
 }
 
 func (tk *Token) aclForCluster(clusterID string) (perms apc.AccessAttrs, ok bool) {
+	if acl := tk.cluACL(clusterID); acl != nil {
+		return acl.Access, true
+	}
+	return 0, false
+}
+
+func (tk *Token) aclForBucket(clusterID string, bck *cmn.Bck) (perms apc.AccessAttrs, ok bool) {
+	if acl := tk.bckACL(clusterID, bck); acl != nil {
+		return acl.Access, true
+	}
+	return 0, false
+}
+
+func (tk *Token) cluACL(clusterID string) *authn.CluACL {
 	var defaultCluster *authn.CluACL
 	for _, pm := range tk.ClusterACLs {
 		if pm.ID == clusterID {
-			return pm.Access, true
+			return pm
 		}
 		if pm.ID == "" {
 			defaultCluster = pm
 		}
 	}
-	if defaultCluster != nil {
-		return defaultCluster.Access, true
-	}
-	return 0, false
+	return defaultCluster
 }
 
-func (tk *Token) aclForBucket(clusterID string, bck *cmn.Bck) (perms apc.AccessAttrs, ok bool) {
+func (tk *Token) bckACL(clusterID string, bck *cmn.Bck) *authn.BckACL {
 	for _, b := range tk.BucketACLs {
 		tbBck := b.Bck
 		if tbBck.Ns.UUID != clusterID {
@@ -205,8 +315,8 @@ func (tk *Token) aclForBucket(clusterID string, bck *cmn.Bck) (perms apc.AccessA
 		// To correctly compare with the caller's `bck` we construct tokenBck from the token.
 		tokenBck := cmn.Bck{Name: tbBck.Name, Provider: tbBck.Provider}
 		if tokenBck.Equal(bck) {
-			return b.Access, true
+			return b
 		}
 	}
-	return 0, false
+	return nil
 }
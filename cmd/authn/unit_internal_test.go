@@ -9,6 +9,12 @@ package main
 // NOTE go:build debug (above) =====================================
 
 import (
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -204,6 +210,212 @@ func TestToken(t *testing.T) {
 	}
 }
 
+func TestValidateToken(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+	createUsers(mgr, t)
+	defer deleteUsers(mgr, false, t)
+
+	token, err := mgr.issueToken(users[0], passs[0], &authn.LoginMsg{})
+	tassert.CheckFatal(t, err)
+
+	status := mgr.validateToken(token)
+	tassert.Fatalf(t, status.Valid && !status.Expired && !status.Revoked, "expecting valid token, got %+v", status)
+	tassert.Errorf(t, len(status.Roles) == 1 && status.Roles[0] == GuestRole, "expecting %q role, got %v", GuestRole, status.Roles)
+
+	status = mgr.validateToken(token + "x")
+	tassert.Errorf(t, !status.Valid && status.Err != "", "expecting invalid signature, got %+v", status)
+
+	tassert.CheckFatal(t, mgr.revokeToken(token))
+	status = mgr.validateToken(token)
+	tassert.Errorf(t, status.Valid && status.Revoked, "expecting revoked token, got %+v", status)
+}
+
+func TestRoleLimits(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+
+	role := &authn.Role{Name: "limited", ClusterACLs: guestRole.ClusterACLs, QPS: 50}
+	tassert.CheckFatal(t, mgr.addRole(role))
+	tassert.Errorf(t, mgr.addRole(&authn.Role{Name: "negative", MaxConc: -1}) != nil, "expecting invalid limits")
+
+	const pass = "pass"
+	user := &authn.User{ID: "svc", Password: pass, Roles: []*authn.Role{guestRole, role}}
+	tassert.CheckFatal(t, mgr.addUser(user))
+	defer mgr.delUser(user.ID)
+
+	limits := func() []*authn.RoleLimit {
+		token, err := mgr.issueToken(user.ID, pass, &authn.LoginMsg{})
+		tassert.CheckFatal(t, err)
+		tk, err := tok.DecryptToken(token, Conf.Secret())
+		tassert.CheckFatal(t, err)
+		return tk.Limits
+	}
+	lst := limits()
+	tassert.Fatalf(t, len(lst) == 1, "expecting one limited role, got %d", len(lst))
+	tassert.Errorf(t, *lst[0] == authn.RoleLimit{Role: "limited", QPS: 50}, "unexpected limit: %+v", *lst[0])
+
+	// current role definition (rather than the user's copy) is used
+	tassert.CheckFatal(t, mgr.updateRole(role.Name, &authn.Role{QPS: -1, MaxConc: 4}))
+	lst = limits()
+	tassert.Fatalf(t, len(lst) == 1, "expecting one limited role, got %d", len(lst))
+	tassert.Errorf(t, *lst[0] == authn.RoleLimit{Role: "limited", MaxConc: 4}, "unexpected limit: %+v", *lst[0])
+
+	tassert.CheckFatal(t, mgr.updateRole(role.Name, &authn.Role{MaxConc: -1}))
+	tassert.Errorf(t, len(limits()) == 0, "expecting no limits")
+}
+
+func TestCheckAccess(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+
+	clu := authn.CluACL{ID: "test-clu-id", Alias: "test-clu", URLs: []string{"http://localhost:8080"}}
+	tassert.CheckFatal(t, mgr.db.Set(clustersCollection, clu.ID, clu))
+	var (
+		bck     = cmn.Bck{Name: "nnn", Provider: apc.AIS}
+		ownRole = &authn.Role{
+			Name:       "nnn-owner",
+			BucketACLs: []*authn.BckACL{{Bck: cmn.Bck{Name: bck.Name, Provider: apc.AIS, Ns: cmn.Ns{UUID: clu.ID}}, Access: apc.AccessRW}},
+		}
+		user = &authn.User{ID: "checked", Password: "pass", Roles: []*authn.Role{guestRole, ownRole}}
+	)
+	tassert.CheckFatal(t, mgr.addUser(user))
+
+	// guest (cluster-wide) role: read-only
+	res, err := mgr.checkAccess(&authn.CheckMsg{UserID: user.ID, Cluster: clu.Alias, Bck: cmn.Bck{Name: "mmm"}, Access: apc.AcePUT}, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !res.Allowed && res.Missing == apc.AcePUT, "expecting PUT denied, got %+v", res)
+	tassert.Fatalf(t, len(res.Matched) == 1 && res.Matched[0].Role == GuestRole, "expecting %q role, got %+v", GuestRole, res.Matched)
+	tassert.Errorf(t, res.Cluster == clu.ID, "expecting cluster %q, got %q", clu.ID, res.Cluster)
+
+	// bucket role (cluster omitted: the only registered one)
+	res, err = mgr.checkAccess(&authn.CheckMsg{UserID: user.ID, Bck: bck, Access: apc.AcePUT}, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, res.Allowed, "expecting PUT allowed, got %+v", res)
+	tassert.Fatalf(t, len(res.Matched) == 1 && res.Matched[0].Role == ownRole.Name, "expecting %q role, got %+v", ownRole.Name, res.Matched)
+
+	// token
+	token, err := mgr.issueToken(user.ID, "pass", &authn.LoginMsg{})
+	tassert.CheckFatal(t, err)
+	res, err = mgr.checkAccess(&authn.CheckMsg{Token: token, Bck: bck, Access: apc.AceObjDELETE}, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, res.Allowed && res.UserID == user.ID, "expecting DELETE allowed, got %+v", res)
+
+	tassert.CheckFatal(t, mgr.revokeToken(token))
+	res, err = mgr.checkAccess(&authn.CheckMsg{Token: token, Bck: bck, Access: apc.AceObjDELETE}, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !res.Allowed && res.Reason != "", "expecting revoked token denied, got %+v", res)
+
+	// admin
+	res, err = mgr.checkAccess(&authn.CheckMsg{UserID: adminUserID, Access: apc.AceAdmin}, nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, res.Allowed, "expecting admin allowed, got %+v", res)
+}
+
+func TestRefreshToken(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+	createUsers(mgr, t)
+	defer deleteUsers(mgr, false, t)
+	secret := Conf.Secret()
+
+	tm, err := mgr.login(users[0], passs[0], &authn.LoginMsg{Refresh: true})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, tm.Token != "" && tm.RefreshToken != "", "expecting token pair, got %+v", tm)
+	tassert.Errorf(t, tm.ExpiresWithin(refreshAccessTokenTime+time.Minute), "expecting short-lived access token, got %+v", tm)
+
+	// refresh token is not an access token (and vice versa)
+	_, err = tok.DecryptToken(tm.RefreshToken, secret)
+	tassert.Errorf(t, err != nil, "refresh token must not be accepted as access token")
+	_, err = tok.DecryptRefreshToken(tm.Token, secret)
+	tassert.Errorf(t, err != nil, "access token must not be accepted as refresh token")
+
+	ntm, err := mgr.refreshToken(tm.RefreshToken)
+	tassert.CheckFatal(t, err)
+	tk, err := tok.DecryptToken(ntm.Token, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.UserID == users[0], "expecting user %q, got %q", users[0], tk.UserID)
+	tassert.Errorf(t, ntm.RefreshToken == tm.RefreshToken, "expecting the same refresh token")
+
+	// revoked
+	tassert.CheckFatal(t, mgr.revokeRefreshToken(tm.RefreshToken))
+	_, err = mgr.refreshToken(tm.RefreshToken)
+	tassert.Errorf(t, err == tok.ErrTokenRevoked, "expecting %v, got %v", tok.ErrTokenRevoked, err)
+	revoked, err := mgr.generateRevokedTokenList()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(revoked) == 0, "refresh tokens must not be broadcast, got %v", revoked)
+}
+
+func TestTokenCIDRs(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+	createUsers(mgr, t)
+	defer deleteUsers(mgr, false, t)
+	secret := Conf.Secret()
+
+	tassert.Errorf(t, tok.ValidateCIDRs([]string{"10.0.0.1"}) != nil, "expecting invalid CIDR")
+
+	cidrs := []string{"10.1.0.0/16", "192.168.1.0/24"}
+	tm, err := mgr.login(users[1], passs[1], &authn.LoginMsg{CIDRs: cidrs, Refresh: true})
+	tassert.CheckFatal(t, err)
+	tk, err := tok.DecryptToken(tm.Token, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(tk.CIDRs) == len(cidrs), "expecting %v, got %v", cidrs, tk.CIDRs)
+	tassert.CheckError(t, tk.CheckSource(net.ParseIP("10.1.2.3")))
+	tassert.CheckError(t, tk.CheckSource(net.ParseIP("192.168.1.200")))
+	for _, ip := range []net.IP{net.ParseIP("10.2.0.1"), net.ParseIP("::1"), nil} {
+		err := tk.CheckSource(ip)
+		tassert.Errorf(t, errors.Is(err, tok.ErrTokenSource), "expecting %v (%s), got %v", tok.ErrTokenSource, ip, err)
+	}
+
+	// refreshed access tokens inherit the binding
+	ntm, err := mgr.refreshToken(tm.RefreshToken)
+	tassert.CheckFatal(t, err)
+	tk, err = tok.DecryptToken(ntm.Token, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.CheckSource(net.ParseIP("10.2.0.1")) != nil, "expecting refreshed token bound to %v", cidrs)
+
+	// admin
+	tm, err = mgr.login(adminUserID, adminUserPass, &authn.LoginMsg{CIDRs: cidrs[:1]})
+	tassert.CheckFatal(t, err)
+	tk, err = tok.DecryptToken(tm.Token, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.IsAdmin && tk.CheckSource(net.ParseIP("192.168.1.1")) != nil, "expecting admin token bound to %v", cidrs[:1])
+
+	// using bound tokens directly against AuthN
+	clu := authn.CluACL{ID: "cidr-clu-id", Alias: "cidr-clu", URLs: []string{"http://localhost:8080"}}
+	tassert.CheckFatal(t, mgr.db.Set(clustersCollection, clu.ID, clu))
+	msg := &authn.CheckMsg{Token: ntm.Token, Cluster: clu.ID, Access: apc.AceGET}
+	res, err := mgr.checkAccess(msg, net.ParseIP("10.2.0.1"))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !res.Allowed && strings.Contains(res.Reason, tok.ErrTokenSource.Error()),
+		"expecting bound token denied, got %+v", res)
+	res, err = mgr.checkAccess(msg, net.ParseIP("10.1.2.3"))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !strings.Contains(res.Reason, tok.ErrTokenSource.Error()), "expecting source allowed, got %+v", res)
+
+	r := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	r.RemoteAddr = "10.2.0.1:4321"
+	r.Header.Set(apc.HdrAuthorization, apc.AuthenticationTypeBearer+" "+tm.Token)
+	w := httptest.NewRecorder()
+	tassert.Errorf(t, validateAdminPerms(w, r) != nil && w.Code == http.StatusUnauthorized,
+		"expecting bound admin token rejected, got %d", w.Code)
+	r.RemoteAddr = "10.1.2.3:4321"
+	tassert.CheckError(t, validateAdminPerms(httptest.NewRecorder(), r))
+
+	// not bound
+	token, err := mgr.issueToken(users[0], passs[0], &authn.LoginMsg{})
+	tassert.CheckFatal(t, err)
+	tk, err = tok.DecryptToken(token, secret)
+	tassert.CheckFatal(t, err)
+	tassert.CheckError(t, tk.CheckSource(nil))
+}
+
 func TestMergeCluACLS(t *testing.T) {
 	tests := []struct {
 		title    string
@@ -637,3 +849,99 @@ func TestMergeBckACLS(t *testing.T) {
 		}
 	}
 }
+
+func TestLDAPSync(t *testing.T) {
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+	// locally-created user that is also an LDAP group member
+	tassert.CheckFatal(t, mgr.addUser(&authn.User{ID: "local", Password: "pass", Roles: []*authn.Role{guestRole}}))
+
+	dir := &ldapDir{
+		users: map[string]string{
+			"alice": "uid=alice,ou=people,dc=example,dc=com",
+			"bob":   "uid=bob,ou=people,dc=example,dc=com",
+			"local": "uid=local,ou=people,dc=example,dc=com",
+		},
+		groups: []*ldapGroup{
+			{dn: "cn=readers,ou=groups,dc=example,dc=com", role: "readers", members: []string{"alice", "bob", "local"}},
+			{dn: "cn=writers,ou=groups,dc=example,dc=com", role: "writers", members: []string{"alice"}},
+		},
+	}
+
+	// dry-run: no changes
+	res, err := mgr.applyLDAP(dir, authn.LDAPConflictSkip, true)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(res.Changes) == 5, "expected 2 roles, 2 users, and 1 conflict, got %d changes", len(res.Changes))
+	_, err = mgr.lookupUser("alice")
+	tassert.Errorf(t, err != nil, "dry-run must not create users")
+
+	// sync (policy: skip)
+	_, err = mgr.applyLDAP(dir, authn.LDAPConflictSkip, false)
+	tassert.CheckFatal(t, err)
+	alice, err := mgr.lookupUser("alice")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, alice.DN != "" && len(alice.Roles) == 2, "unexpected %+v", alice)
+	local, err := mgr.lookupUser("local")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, local.DN == "" && len(local.Roles) == 1, "local user must be skipped: %+v", local)
+
+	// idempotent
+	res, err = mgr.applyLDAP(dir, authn.LDAPConflictSkip, false)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(res.Changes) == 1 && res.Changes[0].Op == ldapOpConflict, "expected no changes, got %d", len(res.Changes))
+
+	// merge
+	_, err = mgr.applyLDAP(dir, authn.LDAPConflictMerge, false)
+	tassert.CheckFatal(t, err)
+	local, _ = mgr.lookupUser("local")
+	tassert.Errorf(t, local.DN == "" && local.Password != "" && len(local.Roles) == 2, "expected merged roles: %+v", local)
+
+	// bob leaves, writers group is removed
+	dir.groups = dir.groups[:1]
+	dir.groups[0].members = []string{"alice", "local"}
+	delete(dir.users, "bob")
+	_, err = mgr.applyLDAP(dir, authn.LDAPConflictOverwrite, false)
+	tassert.CheckFatal(t, err)
+	_, err = mgr.lookupUser("bob")
+	tassert.Errorf(t, err != nil, "LDAP-managed user must be deleted")
+	_, err = mgr.lookupRole("writers")
+	tassert.Errorf(t, err != nil, "LDAP-managed role must be deleted")
+	alice, _ = mgr.lookupUser("alice")
+	tassert.Errorf(t, len(alice.Roles) == 1, "expected 1 role, got %d", len(alice.Roles))
+	local, _ = mgr.lookupUser("local")
+	tassert.Errorf(t, local.DN != "" && local.Password == "" && len(local.Roles) == 1, "expected overwritten user: %+v", local)
+
+	// LDAP-managed users cannot change passwords
+	err = mgr.updateUser("alice", &authn.User{Password: "new"})
+	tassert.Errorf(t, err != nil, "expected error")
+}
+
+func TestLDAPFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		enc    string // hex, empty when invalid
+	}{
+		{"(cn=ais)", "a3090402636e0403616973"},
+		{"cn=ais", "a3090402636e0403616973"},
+		{"(objectClass=*)", "870b6f626a656374436c617373"},
+		{"(!(cn=ais))", "a20ba3090402636e0403616973"},
+		{"(cn=ais-*)", "a40c0402636e30068004616973" + "2d"},
+		{"(cn=\\61is)", "a3090402636e0403616973"},
+		{"(cn=ais", ""},
+		{"(&(cn=ais)", ""},
+		{"(=ais)", ""},
+		{"(cn=\\zz)", ""},
+	}
+	for _, test := range tests {
+		b, err := ldapFilter(test.filter)
+		if test.enc == "" {
+			tassert.Errorf(t, err != nil, "%q: expected error", test.filter)
+			continue
+		}
+		tassert.CheckError(t, err)
+		tassert.Errorf(t, hex.EncodeToString(b) == test.enc, "%q: %x != %s", test.filter, b, test.enc)
+	}
+	tassert.Errorf(t, rdnValue("uid=jdoe,ou=people,dc=example,dc=com") == "jdoe", "rdn")
+	tassert.Errorf(t, rdnValue("cn=Doe\\, John,ou=people") == "Doe, John", "rdn (escaped)")
+}
@@ -7,9 +7,13 @@ package cli
 
 import (
 	"fmt"
+	"sort"
+	"text/tabwriter"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
@@ -22,9 +26,11 @@ var (
 		Subcommands: []cli.Command{
 			jobStartResilver,
 			{
-				Name:         cmdPreload,
-				Usage:        "preload object metadata into in-memory cache",
+				Name: cmdPreload,
+				Usage: "preload object metadata into in-memory cache;\n" +
+					indent1 + "with '--verify', also read objects and validate their checksums (storage smoke test)",
 				ArgsUsage:    bucketArgument,
+				Flags:        []cli.Flag{preloadVerifyFlag, waitFlag, waitJobXactFinishedFlag, unitsFlag},
 				Action:       loadLomCacheHandler,
 				BashComplete: bucketCompletions(bcmplop{}),
 			},
@@ -94,7 +100,78 @@ func loadLomCacheHandler(c *cli.Context) error {
 		return err
 	}
 	xargs := xact.ArgsMsg{Kind: apc.ActLoadLomCache, Bck: bck}
-	return startXaction(c, &xargs, "")
+	if !flagIsSet(c, preloadVerifyFlag) {
+		return startXaction(c, &xargs, "")
+	}
+	return preloadVerify(c, &xargs)
+}
+
+// preload with `--verify`: read all objects, validate checksums, and show per-mountpath read throughput
+func preloadVerify(c *cli.Context, xargs *xact.ArgsMsg) error {
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	if _, err := headBucket(xargs.Bck, false /* don't add */); err != nil {
+		return err
+	}
+	xargs.Verify = true
+	xid, err := api.StartXaction(apiBP, xargs, "")
+	if err != nil {
+		return V(err)
+	}
+	_, xname := xact.GetKindName(xargs.Kind)
+	fmt.Fprintf(c.App.Writer, "%s: reading %s and validating checksums ...\n", xact.Cname(xname, xid), xargs.Bck.Cname(""))
+
+	wargs := xact.ArgsMsg{ID: xid, Kind: xargs.Kind}
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		wargs.Timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	if err := waitXact(&wargs); err != nil {
+		return err
+	}
+	snaps, err := api.QueryXactionSnaps(apiBP, &wargs)
+	if err != nil {
+		return V(err)
+	}
+	return showWarmupStats(c, xid, snaps.WarmupStats(xid), units)
+}
+
+func showWarmupStats(c *cli.Context, xid string, stats map[string]*xact.WarmupStats, units string) error {
+	var (
+		tw                          = &tabwriter.Writer{}
+		tids                        = make([]string, 0, len(stats))
+		verified, corrupted, nocksm int64
+	)
+	for tid := range stats {
+		tids = append(tids, tid)
+	}
+	sort.Strings(tids)
+
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TARGET\t MOUNTPATH\t OBJECTS\t SIZE\t READ THROUGHPUT")
+	for _, tid := range tids {
+		ws := stats[tid]
+		for _, ms := range ws.Mpaths {
+			fmt.Fprintf(tw, "%s\t %s\t %d\t %s\t %s/s\n", meta.Tname(tid), ms.Mpath, ms.Objs,
+				teb.FmtSize(ms.Bytes, units, 2), teb.FmtSize(ms.Throughput(), units, 2))
+		}
+		verified += ws.Verified
+		corrupted += ws.Corrupted
+		nocksm += ws.NoCksum
+	}
+	tw.Flush()
+	fmt.Fprintln(c.App.Writer)
+
+	if nocksm > 0 {
+		actionNote(c, fmt.Sprintf("%d object%s without stored checksum (read but not validated)", nocksm, cos.Plural(int(nocksm))))
+	}
+	if corrupted > 0 {
+		return fmt.Errorf("%d object%s failed checksum validation (%d validated); see 'ais show job %s' for details",
+			corrupted, cos.Plural(int(corrupted)), verified, xid)
+	}
+	actionDone(c, fmt.Sprintf("Validated %d object%s", verified, cos.Plural(int(verified))))
+	return nil
 }
 
 func removeNodeFromSmap(c *cli.Context) error {
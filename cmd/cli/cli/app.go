@@ -215,8 +215,11 @@ func (a *acli) runOnce(args []string) error {
 			}
 			briefPause(1)
 		}
+		if err == nil {
+			return nil
+		}
 	}
-	return formatErr(err)
+	return &errExit{err: formatErr(err), code: exitCode(err)}
 }
 
 func (a *acli) runForever(args []string) error {
@@ -307,7 +310,7 @@ func (a *acli) setupCommands(emptyCmdline bool) {
 		storageCmd,
 		archCmd,
 		logCmd,
-		showCmdPeformance,
+		perfCmd,
 		remClusterCmd,
 		a.getAliasCmd(),
 	}
@@ -373,7 +376,7 @@ func commandNotFoundHandler(c *cli.Context, cmd string) {
 	}
 	err := commandNotFoundError(c, cmd)
 	fmt.Fprint(c.App.ErrWriter, err)
-	os.Exit(1)
+	os.Exit(ExitUsage)
 }
 
 func onUsageErrorHandler(c *cli.Context, err error, _ bool) error {
@@ -467,5 +470,5 @@ func getLongRunOutfile(c *cli.Context) *os.File {
 
 func exitln(prompt string, err error) {
 	fmt.Fprintln(os.Stderr, prompt, err)
-	os.Exit(1)
+	os.Exit(ExitGeneric)
 }
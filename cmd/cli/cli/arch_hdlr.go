@@ -64,6 +64,7 @@ var (
 			listRangeProgressWaitFlags,
 			archAppendOrPutFlag,
 			archAppendOnlyFlag,
+			archCreateIndexFlag,
 			archpathFlag,
 			concurrencyFlag,
 			dryRunFlag,
@@ -75,6 +76,19 @@ var (
 			skipVerCksumFlag,
 			continueOnErrorFlag, // TODO: revisit
 		),
+		commandCreate: {
+			archInclFlag,
+			archExclFlag,
+			archShardSizeFlag,
+			archpathFlag,
+			concurrencyFlag,
+			dryRunFlag,
+			recursFlag,
+			verboseFlag,
+			yesFlag,
+			inclSrcDirNameFlag,
+			skipVerCksumFlag,
+		},
 		cmdGenShards: {
 			cleanupFlag,
 			concurrencyFlag,
@@ -109,6 +123,24 @@ var (
 		BashComplete: putPromApndCompletions,
 	}
 
+	// archive create
+	archCreateCmd = cli.Command{
+		Name: commandCreate,
+		Usage: "archive local directory as one or more " + archExts + "-formatted objects (shards)\n" +
+			indent1 + "\tby streaming its content (no intermediate files) while uploading, e.g.:\n" +
+			indent1 + "\t- 'src-dir ais://dst/trunk.tar -r' - recursively archive entire 'src-dir' as a single shard;\n" +
+			indent1 + "\t- 'src-dir ais://dst/trunk.tar.lz4 -r --include \"*.jpg,*.cls\" --shard-size 1GiB' - archive only jpg and cls files\n" +
+			indent1 + "\t   as trunk-000000.tar.lz4, trunk-000001.tar.lz4, etc. - approximately 1GiB each;\n" +
+			indent1 + "\t- 'src-dir ais://dst/trunk.zip -r --exclude \".git/*\" --dry-run -v' - show resulting shards and their content.\n" +
+			indent1 + "\tTips:\n" +
+			indent1 + "\t- files are archived in lexicographical order of their paths;\n" +
+			indent1 + "\t- to append files to an existing shard, run 'ais archive put' (see --help for details).",
+		ArgsUsage:    createArchArgument,
+		Flags:        archCmdsFlags[commandCreate],
+		Action:       archCreateHandler,
+		BashComplete: putPromApndCompletions,
+	}
+
 	// archive get
 	archGetCmd = cli.Command{
 		Name: objectCmdGet.Name,
@@ -159,11 +191,12 @@ var (
 	// main `ais archive`
 	archCmd = cli.Command{
 		Name:   commandArch,
-		Usage:  "archive multiple objects from a given bucket; archive local files and directories; list archived content",
+		Usage:  "archive multiple objects from a given bucket; archive local files and directories (as one or more shards); list archived content",
 		Action: archUsageHandler,
 		Subcommands: []cli.Command{
 			archBucketCmd,
 			archPutCmd,
+			archCreateCmd,
 			archGetCmd,
 			archLsCmd,
 			genShardsCmd,
@@ -422,6 +455,9 @@ func a2aRegular(c *cli.Context, a *archput) error {
 		debug.Assert(!a.appendOnly)
 		putApndArchArgs.Flags = apc.ArchAppendIfExist
 	}
+	if a.createIndex {
+		putApndArchArgs.Flags |= apc.ArchCreateIndex
+	}
 	err = api.PutApndArch(&putApndArchArgs)
 	if progress != nil {
 		progress.Wait()
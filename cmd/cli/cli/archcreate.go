@@ -0,0 +1,278 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles 'ais archive create': local directory => shard(s).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+)
+
+// 'ais archive create' walks a local directory and PUTs its (selected) content as one or more
+// shards - with no intermediate files: each shard is generated on the fly while being uploaded.
+// Generation is deterministic, and so the reader can be reopened (e.g., upon redirect or retry)
+// to produce the same content again (see shardReader).
+
+const archShardIdxFmt = "%s-%06d%s" // name, index, extension
+
+type (
+	archShard struct {
+		name  string
+		mime  string
+		fobjs []fobj
+		size  int64 // total size of archived files (not including archive headers)
+	}
+	shardReader struct {
+		pr    *io.PipeReader
+		shard *archShard
+	}
+)
+
+// interface guard
+var _ cos.ReadOpenCloser = (*shardReader)(nil)
+
+func archCreateHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 2 {
+		return incorrectUsageMsg(c, "too many arguments")
+	}
+	srcdir := c.Args().Get(0)
+	bck, shardName, err := parseBckObjURI(c, c.Args().Get(1), false /*empty ok*/)
+	if err != nil {
+		return err
+	}
+	mime, err := archive.Strict("", shardName)
+	if err != nil {
+		return err
+	}
+	finfo, err := os.Stat(srcdir)
+	if err != nil {
+		return &errDoesNotExist{what: "directory", name: srcdir}
+	}
+	if !finfo.IsDir() {
+		return fmt.Errorf("%q is not a directory (hint: use 'ais archive put' to archive individual files)", srcdir)
+	}
+	var shardSize int64
+	if flagIsSet(c, archShardSizeFlag) {
+		if shardSize, err = parseSizeFlag(c, archShardSizeFlag); err != nil {
+			return err
+		}
+	}
+
+	// select
+	var ndir int
+	all, err := lsFobj(c, srcdir, "" /*trim pref*/, parseStrFlag(c, archpathFlag) /*append pref*/, &ndir,
+		flagIsSet(c, recursFlag), flagIsSet(c, inclSrcDirNameFlag))
+	if err != nil {
+		return err
+	}
+	var (
+		incl      = archPatterns(c, archInclFlag)
+		excl      = archPatterns(c, archExclFlag)
+		files     = make(fobjs, 0, len(all))
+		totalSize int64
+	)
+	for _, fo := range all {
+		if len(incl) > 0 && !archMatch(incl, fo.dstName) {
+			continue
+		}
+		if archMatch(excl, fo.dstName) {
+			continue
+		}
+		files = append(files, fo)
+		totalSize += fo.size
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no files to archive in %q (check the source directory and %s, %s options)",
+			srcdir, qflprn(archInclFlag), qflprn(archExclFlag))
+	}
+	sort.Sort(files)
+	shards := splitShards(files, shardName, mime, shardSize)
+
+	// confirm
+	cptn := fmt.Sprintf("Archive %d file%s (%s) from %s => %d shard%s in %s",
+		len(files), cos.Plural(len(files)), cos.ToSizeIEC(totalSize, 2), srcdir,
+		len(shards), cos.Plural(len(shards)), bck.Cname(""))
+	if flagIsSet(c, dryRunFlag) {
+		actionCptn(c, dryRunHeader()+" ", cptn)
+		for _, shard := range shards {
+			fmt.Fprintf(c.App.Writer, "%s\t%d file%s, %s\n", bck.Cname(shard.name),
+				len(shard.fobjs), cos.Plural(len(shard.fobjs)), cos.ToSizeIEC(shard.size, 2))
+			if flagIsSet(c, verboseFlag) {
+				for _, fo := range shard.fobjs {
+					fmt.Fprintf(c.App.Writer, "\t%s => %s\n", fo.path, fo.dstName)
+				}
+			}
+		}
+		return nil
+	}
+	if _, err := headBucket(bck, false /*don't add*/); err != nil {
+		if _, ok := err.(*errDoesNotExist); ok {
+			return fmt.Errorf("destination %v", err)
+		}
+		return V(err)
+	}
+	if !flagIsSet(c, yesFlag) {
+		if ok := confirm(c, cptn+"?"); !ok {
+			fmt.Fprintln(c.App.Writer, "Operation canceled")
+			return nil
+		}
+	}
+
+	// do
+	var (
+		conc       = max(parseIntFlag(c, concurrencyFlag), 1)
+		sema       = make(chan struct{}, conc)
+		group, ctx = errgroup.WithContext(context.Background())
+	)
+loop:
+	for _, shard := range shards {
+		select {
+		case sema <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+		group.Go(func() error {
+			defer func() { <-sema }()
+			return shard.put(c, bck)
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return err
+	}
+	actionDone(c, fmt.Sprintf("Archived %d file%s (%s) as %d shard%s in %s", len(files), cos.Plural(len(files)),
+		cos.ToSizeIEC(totalSize, 2), len(shards), cos.Plural(len(shards)), bck.Cname("")))
+	return nil
+}
+
+func archPatterns(c *cli.Context, flag cli.StringFlag) []string {
+	if !flagIsSet(c, flag) {
+		return nil
+	}
+	return splitCsv(parseStrFlag(c, flag))
+}
+
+// match name-in-archive or its base
+func archMatch(patterns []string, name string) bool {
+	base := filepath.Base(name)
+	for _, p := range patterns {
+		if ok, _ := filepath.Match(p, name); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(p, base); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// greedy, in (sorted) order; when shardSize is zero - single shard
+func splitShards(files fobjs, shardName, mime string, shardSize int64) (shards []*archShard) {
+	var (
+		shard *archShard
+		base  = strings.TrimSuffix(shardName, mime)
+	)
+	for _, fo := range files {
+		if shard == nil || (shardSize > 0 && shard.size > 0 && shard.size+fo.size > shardSize) {
+			shard = &archShard{name: shardName, mime: mime}
+			if shardSize > 0 {
+				shard.name = fmt.Sprintf(archShardIdxFmt, base, len(shards), mime)
+			}
+			shards = append(shards, shard)
+		}
+		shard.fobjs = append(shard.fobjs, fo)
+		shard.size += fo.size
+	}
+	return shards
+}
+
+///////////////
+// archShard //
+///////////////
+
+func (shard *archShard) put(c *cli.Context, bck cmn.Bck) error {
+	putArgs := api.PutArgs{
+		BaseParams: apiBP,
+		Bck:        bck,
+		ObjName:    shard.name,
+		Reader:     newShardReader(shard),
+		SkipVC:     flagIsSet(c, skipVerCksumFlag),
+	}
+	_, err := api.PutObject(&putArgs)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %v", bck.Cname(shard.name), V(err))
+	}
+	if flagIsSet(c, verboseFlag) {
+		fmt.Fprintf(c.App.Writer, "%s\t%d file%s, %s\n", bck.Cname(shard.name),
+			len(shard.fobjs), cos.Plural(len(shard.fobjs)), cos.ToSizeIEC(shard.size, 2))
+	}
+	return nil
+}
+
+// (runs in its own goroutine) write archive into the pipe
+func (shard *archShard) write(pw *io.PipeWriter) {
+	var (
+		err  error
+		opts = archive.Opts{CB: archive.SetTarHeader}
+		aw   = archive.NewWriter(shard.mime, pw, nil /*cksum*/, &opts)
+	)
+	for i := range shard.fobjs {
+		if err = archFile(aw, &shard.fobjs[i]); err != nil {
+			break
+		}
+	}
+	aw.Fini()
+	pw.CloseWithError(err) // (nil => EOF)
+}
+
+func archFile(aw archive.Writer, fo *fobj) error {
+	fh, err := os.Open(fo.path)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+	finfo, err := fh.Stat()
+	if err != nil {
+		return err
+	}
+	// (the content must stay the same as the reader may need to reproduce it)
+	if finfo.Size() != fo.size {
+		return fmt.Errorf("%q changed in the meantime (size %d => %d)", fo.path, fo.size, finfo.Size())
+	}
+	oah := cos.SimpleOAH{Size: fo.size, Atime: finfo.ModTime().UnixNano()}
+	return aw.Write(fo.dstName, oah, io.LimitReader(fh, fo.size))
+}
+
+/////////////////
+// shardReader //
+/////////////////
+
+func newShardReader(shard *archShard) *shardReader {
+	pr, pw := io.Pipe()
+	go shard.write(pw)
+	return &shardReader{pr: pr, shard: shard}
+}
+
+func (sr *shardReader) Read(b []byte) (int, error) { return sr.pr.Read(b) }
+
+// terminates the writing goroutine, if still running
+func (sr *shardReader) Close() error { return sr.pr.Close() }
+
+// generate the same content from the beginning
+func (sr *shardReader) Open() (cos.ReadOpenCloser, error) { return newShardReader(sr.shard), nil }
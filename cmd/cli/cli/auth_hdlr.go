@@ -6,11 +6,16 @@
 package cli
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -22,7 +27,6 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/fname"
-	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/fatih/color"
 	jsoniter "github.com/json-iterator/go"
@@ -31,27 +35,35 @@ import (
 )
 
 const (
-	flagsAuthUserLogin   = "user_login"
-	flagsAuthUserLogout  = "user_logout"
-	flagsAuthUserShow    = "user_show"
-	flagsAuthRoleAddSet  = "role_add_set"
-	flagsAuthRevokeToken = "revoke_token"
-	flagsAuthRoleShow    = "role_show"
-	flagsAuthConfShow    = "conf_show"
+	flagsAuthUserLogin    = "user_login"
+	flagsAuthUserLogout   = "user_logout"
+	flagsAuthUserShow     = "user_show"
+	flagsAuthRoleAddSet   = "role_add_set"
+	flagsAuthRevokeToken  = "revoke_token"
+	flagsAuthRoleShow     = "role_show"
+	flagsAuthConfShow     = "conf_show"
+	flagsAuthSync         = "sync"
+	flagsAuthTokenInspect = "token_inspect"
+	flagsAuthTokenCreate  = "token_create"
+	flagsAuthCheck        = "check"
 )
 
 const authnUnreachable = `AuthN unreachable at %s. You may need to update AIS CLI configuration or environment variable %s`
 
 var (
 	authFlags = map[string][]cli.Flag{
-		flagsAuthUserLogin:   {tokenFileFlag, passwordFlag, expireFlag, clusterTokenFlag},
-		flagsAuthUserLogout:  {tokenFileFlag},
-		cmdAuthUser:          {passwordFlag},
-		flagsAuthRoleAddSet:  {descRoleFlag, clusterRoleFlag, bucketRoleFlag},
-		flagsAuthRevokeToken: {tokenFileFlag},
-		flagsAuthUserShow:    {nonverboseFlag, verboseFlag},
-		flagsAuthRoleShow:    {nonverboseFlag, verboseFlag, clusterFilterFlag},
-		flagsAuthConfShow:    {jsonFlag},
+		flagsAuthUserLogin:    {tokenFileFlag, passwordFlag, expireFlag, clusterTokenFlag, ssoFlag},
+		flagsAuthUserLogout:   {tokenFileFlag},
+		cmdAuthUser:           {passwordFlag},
+		flagsAuthRoleAddSet:   {descRoleFlag, clusterRoleFlag, bucketRoleFlag, roleQPSFlag, roleMaxConcFlag},
+		flagsAuthRevokeToken:  {tokenFileFlag},
+		flagsAuthUserShow:     {nonverboseFlag, verboseFlag},
+		flagsAuthRoleShow:     {nonverboseFlag, verboseFlag, clusterFilterFlag, roleUsageFlag},
+		flagsAuthConfShow:     {jsonFlag},
+		flagsAuthSync:         {dryRunFlag, jsonFlag},
+		flagsAuthTokenInspect: {tokenFileFlag, jsonFlag},
+		flagsAuthTokenCreate:  {passwordFlag, expireFlag, cidrFlag, tokenFileFlag},
+		flagsAuthCheck:        {clusterCheckFlag, jsonFlag},
 	}
 
 	// define separately to allow for aliasing (see alias_hdlr.go)
@@ -209,6 +221,52 @@ var (
 				Flags:  authFlags[flagsAuthUserLogout],
 				Action: wrapAuthN(logoutUserHandler),
 			},
+			// token
+			{
+				Name:  cmdAuthToken,
+				Usage: "create and inspect AuthN tokens",
+				Subcommands: []cli.Command{
+					{
+						Name: cmdAuthCreate,
+						Usage: "issue access token for a given user and print it (or save it, with '--file');\n" +
+							indent1 + "use '--cidr' to restrict the token to the intended network, e.g.:\n" +
+							indent1 + "\t- 'ais auth token create ci-bot --cidr 10.8.0.0/16 --expire 720h'\t- token valid only from 10.8.x.x",
+						ArgsUsage: userLoginArgument,
+						Flags:     authFlags[flagsAuthTokenCreate],
+						Action:    wrapAuthN(createTokenHandler),
+					},
+					{
+						Name: cmdAuthInspect,
+						Usage: "decode token and show its claims (user, clusters, buckets, expiration);\n" +
+							indent1 + "if AuthN is reachable, also verify the signature and check whether the token is revoked\n" +
+							indent1 + "(use it to debug '401 Unauthorized' errors)",
+						ArgsUsage: inspectAuthTokenArgument,
+						Flags:     authFlags[flagsAuthTokenInspect],
+						Action:    inspectTokenHandler,
+					},
+				},
+			},
+			// permission check
+			{
+				Name: cmdAuthCheck,
+				Usage: "check whether a given user (or token) would be allowed to perform a given operation, e.g.:\n" +
+					indent1 + "\t- 'ais auth check alice PUT ais://nnn/obj'\t- can user alice write obj into ais://nnn?\n" +
+					indent1 + "\t- 'ais auth check $(cat ~/.config/ais/cli/auth.token) DELETE-OBJECT s3://abc'\t- same for a token;\n" +
+					indent1 + "\t- 'ais auth check bob CREATE-BUCKET --cluster prod'\t- cluster-level permission;\n" +
+					indent1 + "shows the matching role(s) and rule(s) and, when denied, the missing permissions\n" +
+					indent1 + "(use it to debug '403 Forbidden' errors without trial requests against real data)",
+				ArgsUsage: checkAuthArgument,
+				Flags:     authFlags[flagsAuthCheck],
+				Action:    wrapAuthN(checkAccessHandler),
+			},
+			// ldap
+			{
+				Name: cmdAuthSync,
+				Usage: "synchronize LDAP (or Active Directory) groups and their members with AuthN roles and users\n" +
+					indent1 + "(tip: use '--dry-run' to preview the changes)",
+				Flags:  authFlags[flagsAuthSync],
+				Action: wrapAuthN(syncLDAPHandler),
+			},
 		},
 	}
 )
@@ -366,7 +424,12 @@ func loginUserHandler(c *cli.Context) (err error) {
 			return err
 		}
 	}
-	token, err := authn.LoginUser(authParams, name, password, expireIn)
+	var token *authn.TokenMsg
+	if flagIsSet(c, ssoFlag) {
+		token, err = authn.LoginUserRefresh(authParams, name, password, expireIn)
+	} else {
+		token, err = authn.LoginUser(authParams, name, password, expireIn)
+	}
 	if err != nil {
 		return err
 	}
@@ -374,13 +437,48 @@ func loginUserHandler(c *cli.Context) (err error) {
 	if err != nil {
 		return err
 	}
-	if err := jsp.Save(tokenFilePath, token, jsp.Plain(), nil); err != nil {
+	// (owner-only access)
+	if err := authn.SaveToken(tokenFilePath, token); err != nil {
 		return fmt.Errorf("failed to write token %q: %v", tokenFilePath, err)
 	}
 	fmt.Fprintf(c.App.Writer, "Logged in (%s)\n", tokenFilePath)
 	return nil
 }
 
+func createTokenHandler(c *cli.Context) error {
+	var (
+		expireIn *time.Duration
+		cidrs    []string
+		name     = cliAuthnUserName(c)
+		password = cliAuthnUserPassword(c, false)
+	)
+	if flagIsSet(c, expireFlag) {
+		expireIn = apc.Ptr(parseDurationFlag(c, expireFlag))
+	}
+	if flagIsSet(c, cidrFlag) {
+		cidrs = splitCsv(parseStrFlag(c, cidrFlag))
+		for _, s := range cidrs {
+			if _, _, err := net.ParseCIDR(s); err != nil {
+				return fmt.Errorf("invalid %s: %v", flprn(cidrFlag), err)
+			}
+		}
+	}
+	token, err := authn.IssueToken(authParams, name, password, expireIn, cidrs)
+	if err != nil {
+		return err
+	}
+	if !flagIsSet(c, tokenFileFlag) {
+		fmt.Fprintln(c.App.Writer, token.Token)
+		return nil
+	}
+	tokenFilePath := parseStrFlag(c, tokenFileFlag)
+	if err := authn.SaveToken(tokenFilePath, token); err != nil {
+		return fmt.Errorf("failed to write token %q: %v", tokenFilePath, err)
+	}
+	actionDone(c, "Token saved to "+tokenFilePath)
+	return nil
+}
+
 func logoutUserHandler(c *cli.Context) (err error) {
 	tokenFilePath, err := getTokenFilePath(c)
 	if err != nil {
@@ -523,12 +621,71 @@ func showAuthAllRoles(c *cli.Context) error {
 
 func showAuthRoleHandler(c *cli.Context) (err error) {
 	roleID := c.Args().Get(0)
+	if flagIsSet(c, roleUsageFlag) {
+		return showRoleUsage(c, roleID)
+	}
 	if roleID != "" {
 		return showAuthSingleRole(c, roleID)
 	}
 	return showAuthAllRoles(c)
 }
 
+// per-role usage, as seen by each gateway (see authn.RoleUsage)
+func showRoleUsage(c *cli.Context, roleID string) error {
+	type usage struct {
+		*authn.RoleUsage
+		pid string
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	var recs []usage
+	for pid, psi := range smap.Pmap {
+		if psi.InMaintOrDecomm() {
+			continue
+		}
+		out, err := api.GetAnyStats(apiBP, pid, apc.WhatRoleUsage)
+		if err != nil {
+			return V(err)
+		}
+		var lst []*authn.RoleUsage
+		if err := jsoniter.Unmarshal(out, &lst); err != nil {
+			return fmt.Errorf("%s: failed to parse role usage: %v", psi.StringEx(), err)
+		}
+		for _, u := range lst {
+			if roleID == "" || u.Role == roleID {
+				recs = append(recs, usage{u, pid})
+			}
+		}
+	}
+	if len(recs) == 0 {
+		fmt.Fprintf(c.App.Writer, "No rate-limited roles in use (hint: see %s and %s)\n", qflprn(roleQPSFlag), qflprn(roleMaxConcFlag))
+		return nil
+	}
+	sort.Slice(recs, func(i, j int) bool {
+		if recs[i].Role != recs[j].Role {
+			return recs[i].Role < recs[j].Role
+		}
+		return recs[i].pid < recs[j].pid
+	})
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "ROLE\tGATEWAY\tQPS LIMIT\tCONC LIMIT\tRATE(req/s)\tIN-FLIGHT\tREQUESTS\tREJECTED")
+	for _, u := range recs {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\n", u.Role, meta.Pname(u.pid),
+			_roleLimit(u.QPS), _roleLimit(u.MaxConc), u.Rate, u.InFlight, u.Requests, u.Rejected)
+	}
+	return tw.Flush()
+}
+
+func _roleLimit(v int) string {
+	if v == 0 {
+		return teb.NotSetVal
+	}
+	return strconv.Itoa(v)
+}
+
 func showAuthUserHandler(c *cli.Context) (err error) {
 	userID := c.Args().Get(0)
 	if userID == "" {
@@ -626,6 +783,8 @@ func addOrUpdateRole(c *cli.Context) (*authn.Role, error) {
 	roleACL := &authn.Role{
 		Name:        role,
 		Description: parseStrFlag(c, descRoleFlag),
+		QPS:         parseIntFlag(c, roleQPSFlag),
+		MaxConc:     parseIntFlag(c, roleMaxConcFlag),
 	}
 	if bucket != "" {
 		bck, err := parseBckURI(c, bucket, false)
@@ -702,8 +861,150 @@ func revokeTokenHandler(c *cli.Context) (err error) {
 	if err := jsoniter.Unmarshal(b, msg); err != nil {
 		return fmt.Errorf("invalid token %q format: %v", tokenFilePath, err)
 	}
+	if msg.RefreshToken != "" {
+		if err := authn.RevokeToken(authParams, msg.RefreshToken); err != nil {
+			return err
+		}
+	}
 	return authn.RevokeToken(authParams, msg.Token)
 }
+
+// (JWT claims - compare with cmd/authn/tok)
+type tokenClaims struct {
+	Expires     time.Time       `json:"expires"`
+	UserID      string          `json:"username"`
+	ClusterACLs []*authn.CluACL `json:"clusters,omitempty"`
+	BucketACLs  []*authn.BckACL `json:"buckets,omitempty"`
+	CIDRs       []string        `json:"cidrs,omitempty"`
+	IsAdmin     bool            `json:"admin"`
+}
+
+func inspectTokenHandler(c *cli.Context) error {
+	token, err := loadToken(c)
+	if err != nil {
+		return err
+	}
+	claims, err := decodeToken(token)
+	if err != nil {
+		return err
+	}
+
+	// AuthN's verdict, if reachable
+	var (
+		status *authn.TokenStatus
+		verr   error
+	)
+	if authParams.Client == nil {
+		verr = errors.New(env.AuthN.URL + " is not set")
+	} else if status, verr = authn.ValidateToken(authParams, token); verr != nil {
+		if msg, unreachable := isUnreachableError(verr); unreachable {
+			verr = fmt.Errorf("AuthN unreachable at %s: %s", authParams.URL, msg)
+		}
+	}
+
+	if flagIsSet(c, jsonFlag) {
+		out := struct {
+			Claims *tokenClaims       `json:"claims"`
+			Status *authn.TokenStatus `json:"status,omitempty"`
+		}{claims, status}
+		return teb.Print(out, "", teb.Jopts(true))
+	}
+
+	var (
+		props = make(nvpairList, 0, 8)
+		now   = time.Now()
+	)
+	props = append(props, nvpair{Name: "user", Value: claims.UserID},
+		nvpair{Name: "admin", Value: strconv.FormatBool(claims.IsAdmin)})
+	for _, clu := range claims.ClusterACLs {
+		props = append(props, nvpair{Name: "cluster " + clu.String(), Value: clu.Access.Describe(false /*all*/)})
+	}
+	for _, b := range claims.BucketACLs {
+		props = append(props, nvpair{Name: "bucket " + b.Bck.Cname(""), Value: b.Access.Describe(false)})
+	}
+	expires := claims.Expires.Format(time.RFC3339)
+	if claims.Expires.Before(now) {
+		expires += " (" + fred("expired") + " " + teb.FormatDuration(now.Sub(claims.Expires)) + " ago)"
+	} else {
+		expires += " (in " + teb.FormatDuration(claims.Expires.Sub(now)) + ")"
+	}
+	props = append(props, nvpair{Name: "expires", Value: expires})
+	if len(claims.CIDRs) > 0 {
+		props = append(props, nvpair{Name: "source", Value: strings.Join(claims.CIDRs, ", ")})
+	} else {
+		props = append(props, nvpair{Name: "source", Value: "any"})
+	}
+
+	switch {
+	case verr != nil:
+		props = append(props, nvpair{Name: "signature", Value: teb.UnknownStatusVal},
+			nvpair{Name: "revoked", Value: teb.UnknownStatusVal})
+	case !status.Valid:
+		props = append(props, nvpair{Name: "signature", Value: fred("invalid") + " (" + status.Err + ")"})
+	default:
+		revoked := "no"
+		if status.Revoked {
+			revoked = fred("yes")
+		}
+		roles := teb.NotSetVal
+		if len(status.Roles) > 0 {
+			roles = strings.Join(status.Roles, ", ")
+		}
+		props = append(props, nvpair{Name: "signature", Value: "valid"}, nvpair{Name: "revoked", Value: revoked},
+			nvpair{Name: "user roles (current)", Value: roles})
+	}
+	if err := teb.Print(props, teb.PropValTmpl); err != nil {
+		return err
+	}
+	if verr != nil {
+		actionWarn(c, "signature and revocation not verified: "+verr.Error())
+	}
+	return nil
+}
+
+// token from (in order of precedence): command line (the token itself or a file that contains it),
+// '--file' flag, environment, or default location (see getTokenFilePath)
+func loadToken(c *cli.Context) (string, error) {
+	tokenFilePath := c.Args().Get(0)
+	if tokenFilePath != "" {
+		if err := cos.Stat(tokenFilePath); err != nil {
+			return strings.TrimSpace(tokenFilePath), nil // (assuming the token itself)
+		}
+	} else {
+		var err error
+		if tokenFilePath, err = getTokenFilePath(c); err != nil {
+			return "", err
+		}
+	}
+	b, err := os.ReadFile(tokenFilePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token %q: %v", tokenFilePath, err)
+	}
+	// either JSON (authn.TokenMsg, as saved by 'ais auth login') or plain text
+	msg := &authn.TokenMsg{}
+	if err := jsoniter.Unmarshal(b, msg); err == nil && msg.Token != "" {
+		return msg.Token, nil
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// decode JWT payload (without verifying the signature)
+func decodeToken(token string) (*tokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid token: expecting 3 dot-separated parts, got %d", len(parts))
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid token payload: %v", err)
+	}
+	claims := &tokenClaims{}
+	if err := jsoniter.Unmarshal(payload, claims); err != nil {
+		return nil, fmt.Errorf("invalid token claims: %v", err)
+	}
+	return claims, nil
+}
+
 func showAuthConfigHandler(c *cli.Context) (err error) {
 	conf, err := authn.GetConfig(authParams)
 	if err != nil {
@@ -782,3 +1083,123 @@ func getTokenFilePath(c *cli.Context) (string, error) {
 	}
 	return tokenFilePath, nil
 }
+
+func syncLDAPHandler(c *cli.Context) error {
+	dryRun := flagIsSet(c, dryRunFlag)
+	res, err := authn.SyncLDAP(authParams, dryRun)
+	if err != nil {
+		return err
+	}
+	usejs := flagIsSet(c, jsonFlag)
+	if usejs {
+		return teb.Print(res, "", teb.Jopts(usejs))
+	}
+	if len(res.Changes) > 0 {
+		if err := teb.Print(res.Changes, teb.AuthNSyncTmpl); err != nil {
+			return err
+		}
+		fmt.Fprintln(c.App.Writer)
+	}
+	summary := fmt.Sprintf("LDAP: %d group%s, %d user%s, %d change%s", res.Groups, cos.Plural(res.Groups),
+		res.Users, cos.Plural(res.Users), len(res.Changes), cos.Plural(len(res.Changes)))
+	if dryRun {
+		actionNote(c, summary+" (dry-run: nothing was changed)")
+	} else {
+		actionDone(c, summary)
+	}
+	return nil
+}
+
+func checkAccessHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 3 {
+		return incorrectUsageMsg(c, "too many arguments")
+	}
+	var (
+		msg = &authn.CheckMsg{Cluster: parseStrFlag(c, clusterCheckFlag)}
+		who = c.Args().Get(0)
+		err error
+	)
+	if cos.Stat(who) == nil {
+		if who, err = loadToken(c); err != nil { // (token file)
+			return err
+		}
+	}
+	if _, err := decodeToken(who); err == nil {
+		msg.Token = who
+	} else {
+		msg.UserID = who
+	}
+	if msg.Access, err = parseAccess(c.Args().Get(1)); err != nil {
+		return err
+	}
+	if uri := c.Args().Get(2); uri != "" {
+		if msg.Bck, msg.ObjName, err = parseBckObjURI(c, uri, true /*empty objname ok*/); err != nil {
+			return err
+		}
+	}
+	if msg.Cluster == "" {
+		// default to the cluster this CLI is configured to access, if reachable
+		if smap, err := getClusterMap(c); err == nil {
+			msg.Cluster = smap.UUID
+		}
+	}
+
+	res, err := authn.CheckAccess(authParams, msg)
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(res, "", teb.Jopts(true))
+	}
+	what := "cluster"
+	if msg.Bck.Name != "" {
+		what = msg.Bck.Cname(msg.ObjName)
+	}
+	verdict := fgreen("allowed")
+	if !res.Allowed {
+		verdict = fred("denied")
+	}
+	props := nvpairList{
+		{Name: "user", Value: res.UserID},
+		{Name: "cluster", Value: res.Cluster},
+		{Name: "operation", Value: msg.Access.Describe(true /*all*/) + " " + what},
+		{Name: "verdict", Value: verdict},
+	}
+	for _, m := range res.Matched {
+		role := cos.Left(m.Role, teb.NotSetVal)
+		props = append(props, nvpair{Name: "role " + role, Value: m.Rule + ": " + m.Granted.Describe(true)})
+	}
+	if len(res.Matched) == 0 {
+		props = append(props, nvpair{Name: "role", Value: teb.NotSetVal + " (no matching ACL)"})
+	}
+	if res.Missing != 0 {
+		props = append(props, nvpair{Name: "missing", Value: res.Missing.Describe(true)})
+	}
+	if res.Reason != "" && !res.Allowed {
+		props = append(props, nvpair{Name: "reason", Value: res.Reason})
+	}
+	return teb.Print(props, teb.PropValTmpl)
+}
+
+// comma-separated permissions, e.g. "GET,PUT" or "ro" (see apc.StrToAccess)
+func parseAccess(s string) (access apc.AccessAttrs, _ error) {
+	for _, v := range splitCsv(s) {
+		a, err := apc.StrToAccess(v)
+		if err != nil {
+			if a, err = apc.StrToAccess(strings.ToUpper(v)); err != nil {
+				if a, err = apc.StrToAccess(strings.ToLower(v)); err != nil {
+					return 0, fmt.Errorf("invalid permission %q (expecting one of: %s)", v,
+						strings.Join(apc.SupportedPermissions(), ", "))
+				}
+			}
+		}
+		access |= a
+	}
+	if access == 0 {
+		return 0, errors.New("no permissions specified")
+	}
+	return access, nil
+}
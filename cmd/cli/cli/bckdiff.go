@@ -0,0 +1,209 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais bucket diff`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/urfave/cli"
+)
+
+// Compare two buckets (or, with `--prefix`, two virtual directories) object by object.
+// - objects are matched by name;
+// - sizes are always compared;
+// - versions are compared only when both buckets have the same provider (otherwise, they are not comparable);
+// - with `--checksum`, stored checksums are compared as well.
+
+const (
+	diffOnlyLeft  = "only-in-left"
+	diffOnlyRight = "only-in-right"
+	diffDiffer    = "differ"
+)
+
+type bckDiff struct {
+	Left      cmn.Bck          `json:"left"`
+	Right     cmn.Bck          `json:"right"`
+	Entries   []teb.BckDiffEnt `json:"entries"`
+	Identical int              `json:"identical"`
+	OnlyLeft  int              `json:"only_in_left"`
+	OnlyRight int              `json:"only_in_right"`
+	Differ    int              `json:"differ"`
+}
+
+var (
+	bucketCmdDiffFlags = []cli.Flag{
+		listObjPrefixFlag,
+		listObjCachedFlag,
+		diffCksumFlag,
+		unitsFlag,
+		jsonFlag,
+		noHeaderFlag,
+	}
+	bucketCmdDiff = cli.Command{
+		Name: cmdDiff,
+		Usage: "compare two buckets and show objects that exist only in one of them, or differ, e.g.:\n" +
+			indent1 + "\t- 'ais bucket diff ais://abc s3://abc'\t- compare names, sizes, and versions (if comparable);\n" +
+			indent1 + "\t- 'ais bucket diff ais://abc ais://xyz --prefix images/'\t- compare virtual directories 'images/';\n" +
+			indent1 + "\t- 'ais bucket diff ais://abc ais://xyz --checksum'\t- content-level comparison via stored checksums",
+		ArgsUsage:    bucketDiffArgument,
+		Flags:        bucketCmdDiffFlags,
+		Action:       diffBucketHandler,
+		BashComplete: manyBucketsCompletions([]cli.BashCompleteFunc{}, 0, 2),
+	}
+)
+
+func diffBucketHandler(c *cli.Context) error {
+	switch c.NArg() {
+	case 0:
+		return missingArgumentsError(c, bucketDiffArgument)
+	case 1:
+		return missingArgumentsError(c, "BUCKET2")
+	}
+	lbck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	rbck, err := parseBckURI(c, c.Args().Get(1), false)
+	if err != nil {
+		return err
+	}
+	if lbck.Equal(&rbck) {
+		return incorrectUsageMsg(c, "cannot compare bucket %s with itself", lbck.Cname(""))
+	}
+
+	withCksum := flagIsSet(c, diffCksumFlag)
+	if withCksum {
+		if err := diffCksumTypes(lbck, rbck); err != nil {
+			return err
+		}
+	}
+
+	msg := &apc.LsoMsg{Prefix: parseStrFlag(c, listObjPrefixFlag)}
+	msg.AddProps(apc.GetPropsName, apc.GetPropsSize, apc.GetPropsVersion)
+	if withCksum {
+		msg.AddProps(apc.GetPropsChecksum)
+	}
+	if flagIsSet(c, listObjCachedFlag) {
+		msg.SetFlag(apc.LsObjCached)
+	}
+	left, err := diffList(lbck, msg)
+	if err != nil {
+		return err
+	}
+	right, err := diffList(rbck, msg)
+	if err != nil {
+		return err
+	}
+
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	diff := diffEntries(left, right, lbck.Provider == rbck.Provider, withCksum, units)
+	diff.Left, diff.Right = lbck, rbck
+
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(diff, "", teb.Opts{UseJSON: true})
+	}
+	if len(diff.Entries) > 0 {
+		tmpl := teb.BckDiffTmpl
+		if flagIsSet(c, noHeaderFlag) {
+			tmpl = teb.BckDiffBody
+		}
+		if err := teb.Print(diff.Entries, tmpl); err != nil {
+			return err
+		}
+		fmt.Fprintln(c.App.Writer)
+	}
+	actionDone(c, fmt.Sprintf("%s vs %s: %d identical, %d only in %s, %d only in %s, %d differ",
+		lbck.Cname(msg.Prefix), rbck.Cname(msg.Prefix), diff.Identical,
+		diff.OnlyLeft, lbck.Cname(""), diff.OnlyRight, rbck.Cname(""), diff.Differ))
+	return nil
+}
+
+func diffCksumTypes(lbck, rbck cmn.Bck) error {
+	lprops, err := headBucket(lbck, true /* don't add */)
+	if err != nil {
+		return err
+	}
+	rprops, err := headBucket(rbck, true /* don't add */)
+	if err != nil {
+		return err
+	}
+	if lprops.Cksum.Type != rprops.Cksum.Type {
+		return fmt.Errorf("cannot compare checksums: %s uses %q while %s uses %q checksum type",
+			lbck.Cname(""), lprops.Cksum.Type, rbck.Cname(""), rprops.Cksum.Type)
+	}
+	return nil
+}
+
+func diffList(bck cmn.Bck, msg *apc.LsoMsg) (cmn.LsoEntries, error) {
+	lst, err := api.ListObjects(apiBP, bck, msg.Clone(), api.ListArgs{})
+	if err != nil {
+		return nil, V(err)
+	}
+	entries := lst.Entries
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries, nil
+}
+
+// merge two sorted listings
+func diffEntries(left, right cmn.LsoEntries, cmpVersion, cmpCksum bool, units string) (diff *bckDiff) {
+	var i, j int
+	diff = &bckDiff{}
+	for i < len(left) || j < len(right) {
+		switch {
+		case j == len(right) || (i < len(left) && left[i].Name < right[j].Name):
+			diff.add(diffOnlyLeft, left[i], nil, units)
+			diff.OnlyLeft++
+			i++
+		case i == len(left) || right[j].Name < left[i].Name:
+			diff.add(diffOnlyRight, nil, right[j], units)
+			diff.OnlyRight++
+			j++
+		default:
+			l, r := left[i], right[j]
+			if l.Size != r.Size ||
+				(cmpVersion && l.Version != "" && r.Version != "" && l.Version != r.Version) ||
+				(cmpCksum && l.Checksum != "" && r.Checksum != "" && l.Checksum != r.Checksum) {
+				diff.add(diffDiffer, l, r, units)
+				diff.Differ++
+			} else {
+				diff.Identical++
+			}
+			i++
+			j++
+		}
+	}
+	return diff
+}
+
+func (diff *bckDiff) add(what string, l, r *cmn.LsoEnt, units string) {
+	e := teb.BckDiffEnt{Diff: what, Left: teb.NotSetVal, Right: teb.NotSetVal}
+	if l != nil {
+		e.Name, e.Left = l.Name, diffEntStr(l, units)
+	}
+	if r != nil {
+		e.Name, e.Right = r.Name, diffEntStr(r, units)
+	}
+	diff.Entries = append(diff.Entries, e)
+}
+
+func diffEntStr(en *cmn.LsoEnt, units string) (s string) {
+	s = teb.FmtSize(en.Size, units, 2)
+	if en.Version != "" {
+		s += ", v" + en.Version
+	}
+	if en.Checksum != "" {
+		s += ", " + en.Checksum
+	}
+	return s
+}
@@ -0,0 +1,149 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais bucket snapshot`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/urfave/cli"
+)
+
+// Bucket snapshots (ais:// buckets only):
+// - point-in-time listing and object metadata; the data is not copied (copy-on-write);
+// - typical usage: snapshot a dataset before running a risky transformation, restore if need be.
+
+var (
+	bucketCmdSnapshot = cli.Command{
+		Name:  cmdSnapshot,
+		Usage: "create, list, restore, and remove bucket snapshots (ais:// buckets only)",
+		Subcommands: []cli.Command{
+			{
+				Name: commandCreate,
+				Usage: "create bucket snapshot - point-in-time listing and metadata without copying data, e.g.:\n" +
+					indent1 + "\t- 'ais bucket snapshot create ais://abc before-etl'\t- snapshot the current content of ais://abc;\n" +
+					indent1 + "\t- 'ais bucket snapshot restore ais://abc before-etl'\t- revert ais://abc to the snapshot",
+				ArgsUsage:    bucketSnapArgument,
+				Action:       createSnapHandler,
+				BashComplete: bucketCompletions(bcmplop{provider: apc.AIS}),
+			},
+			{
+				Name:         commandList,
+				Usage:        "list bucket snapshots",
+				ArgsUsage:    bucketArgument,
+				Flags:        []cli.Flag{jsonFlag, noHeaderFlag},
+				Action:       listSnapsHandler,
+				BashComplete: bucketCompletions(bcmplop{provider: apc.AIS}),
+			},
+			{
+				Name: cmdSnapRestore,
+				Usage: "restore bucket from snapshot: revert overwritten and deleted objects,\n" +
+					indent1 + "and remove objects that were created after the snapshot was taken",
+				ArgsUsage:    bucketSnapArgument,
+				Flags:        []cli.Flag{yesFlag},
+				Action:       restoreSnapHandler,
+				BashComplete: bucketCompletions(bcmplop{provider: apc.AIS}),
+			},
+			{
+				Name:         commandRemove,
+				Usage:        "remove bucket snapshot",
+				ArgsUsage:    bucketSnapArgument,
+				Action:       rmSnapHandler,
+				BashComplete: bucketCompletions(bcmplop{provider: apc.AIS}),
+			},
+		},
+	}
+)
+
+func parseSnapArgs(c *cli.Context) (bck cmn.Bck, name string, err error) {
+	switch c.NArg() {
+	case 0:
+		err = missingArgumentsError(c, bucketSnapArgument)
+		return
+	case 1:
+		err = missingArgumentsError(c, "SNAPSHOT_NAME")
+		return
+	}
+	if bck, err = parseBckURI(c, c.Args().Get(0), false); err != nil {
+		return
+	}
+	name = c.Args().Get(1)
+	if err = apc.ValidateSnapName(name); err != nil {
+		err = incorrectUsageMsg(c, "%v", err)
+	}
+	return
+}
+
+func createSnapHandler(c *cli.Context) error {
+	bck, name, err := parseSnapArgs(c)
+	if err != nil {
+		return err
+	}
+	if err := api.CreateSnapshot(apiBP, bck, name); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Created snapshot %q of %s", name, bck.Cname("")))
+	return nil
+}
+
+func listSnapsHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	snaps, err := api.ListSnapshots(apiBP, bck)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(snaps, "", teb.Opts{UseJSON: true})
+	}
+	if len(snaps) == 0 {
+		actionDone(c, "No snapshots of "+bck.Cname(""))
+		return nil
+	}
+	tmpl := teb.SnapListTmpl
+	if flagIsSet(c, noHeaderFlag) {
+		tmpl = teb.SnapListBody
+	}
+	return teb.Print(snaps, tmpl)
+}
+
+func restoreSnapHandler(c *cli.Context) error {
+	bck, name, err := parseSnapArgs(c)
+	if err != nil {
+		return err
+	}
+	if !flagIsSet(c, yesFlag) {
+		prompt := fmt.Sprintf("Restore %s from snapshot %q", bck.Cname(""), name)
+		if !confirm(c, prompt, "objects created after the snapshot will be removed") {
+			return nil
+		}
+	}
+	if err := api.RestoreSnapshot(apiBP, bck, name); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Restored %s from snapshot %q", bck.Cname(""), name))
+	return nil
+}
+
+func rmSnapHandler(c *cli.Context) error {
+	bck, name, err := parseSnapArgs(c)
+	if err != nil {
+		return err
+	}
+	if err := api.DestroySnapshot(apiBP, bck, name); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Removed snapshot %q of %s", name, bck.Cname("")))
+	return nil
+}
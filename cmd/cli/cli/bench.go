@@ -0,0 +1,398 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais performance bench`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	cryptorand "crypto/rand"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/urfave/cli"
+)
+
+// Built-in load generator: a (much) simplified aisloader that covers basic cases.
+// - workers run GET/PUT mix against a given bucket for a given duration;
+// - PUT sizes are either fixed or uniformly distributed within a given range;
+// - GETs read objects written by this run or, if none yet, the ones that exist under the given prefix;
+// - all using the api package, and reporting throughput and latency percentiles (per operation).
+
+const (
+	benchMaxListed = 100_000 // max existing objects to GET (when not writing)
+	benchProgress  = 10 * time.Second
+)
+
+type (
+	benchOp struct {
+		lat   []time.Duration
+		bytes int64
+		errs  int64
+		err   error // first
+		mu    sync.Mutex
+	}
+	benchCtx struct {
+		c        *cli.Context
+		bck      cmn.Bck
+		prefix   string
+		data     []byte   // random content (max size)
+		names    []string // existing (listed) objects followed by the ones written by this run
+		nlisted  int
+		get, put benchOp
+		minSize  int64
+		maxSize  int64
+		pctPut   int
+		mu       sync.RWMutex
+	}
+)
+
+var perfBenchCmd = cli.Command{
+	Name: cmdPerfBench,
+	Usage: "run built-in load generator: GET and PUT a mix of objects for a given duration, and show\n" +
+		indent1 + "resulting throughput and latency percentiles, e.g.:\n" +
+		indent1 + "\t- 'ais performance bench ais://nnn --duration 1m --workers 32 --pctput 20 --size 16KiB-4MiB'\n" +
+		indent1 + "\t- 'ais performance bench ais://nnn --pctput 0 --prefix dataset/' - read-only: GET existing objects\n" +
+		indent1 + "(for advanced usage and larger-scale benchmarks, see aisloader)",
+	ArgsUsage: bucketArgument,
+	Flags: []cli.Flag{
+		perfBenchDurationFlag,
+		perfBenchWorkersFlag,
+		perfBenchPctPutFlag,
+		perfBenchSizeFlag,
+		perfBenchPrefixFlag,
+		perfBenchCleanupFlag,
+		unitsFlag,
+	},
+	Action:       perfBenchHandler,
+	BashComplete: bucketCompletions(bcmplop{}),
+}
+
+func perfBenchHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	if _, err := headBucket(bck, false /* don't add */); err != nil {
+		return err
+	}
+	ctx := &benchCtx{c: c, bck: bck, prefix: parseStrFlag(c, perfBenchPrefixFlag)}
+	if err := ctx.parse(); err != nil {
+		return err
+	}
+	var (
+		duration = parseDurationFlag(c, perfBenchDurationFlag)
+		workers  = parseIntFlag(c, perfBenchWorkersFlag)
+	)
+	if duration <= 0 {
+		duration = perfBenchDurationFlag.Value
+	}
+	if workers <= 0 {
+		return fmt.Errorf("invalid %s=%d (expecting positive integer)", flprn(perfBenchWorkersFlag), workers)
+	}
+	if ctx.pctPut < 100 {
+		if err := ctx.listExisting(); err != nil {
+			return err
+		}
+	}
+
+	// run
+	var (
+		wg      sync.WaitGroup
+		stopCh  = cos.NewStopCh()
+		sigCh   = make(chan os.Signal, 1)
+		ticker  = time.NewTicker(benchProgress)
+		started = time.Now()
+		timer   = time.NewTimer(duration)
+	)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+
+	fmt.Fprintf(c.App.Writer, "Running %d workers against %s for %v (%d%% PUT, size %s)...\n",
+		workers, bck.Cname(""), duration, ctx.pctPut, ctx.sizeStr())
+	for i := range workers {
+		wg.Add(1)
+		go ctx.run(i, stopCh, &wg)
+	}
+loop:
+	for {
+		select {
+		case <-timer.C:
+			break loop
+		case <-sigCh:
+			actionWarn(c, "interrupted - stopping...")
+			break loop
+		case <-ticker.C:
+			ctx.progress(time.Since(started))
+		}
+	}
+	ticker.Stop()
+	stopCh.Close()
+	wg.Wait()
+	elapsed := time.Since(started)
+
+	ctx.report(elapsed)
+
+	if flagIsSet(c, perfBenchCleanupFlag) {
+		return ctx.cleanup()
+	}
+	return nil
+}
+
+func (ctx *benchCtx) parse() (err error) {
+	ctx.pctPut = parseIntFlag(ctx.c, perfBenchPctPutFlag)
+	if ctx.pctPut < 0 || ctx.pctPut > 100 {
+		return fmt.Errorf("invalid %s=%d (expecting 0 to 100)", flprn(perfBenchPctPutFlag), ctx.pctPut)
+	}
+	// size: fixed or min-max range
+	var (
+		val  = parseStrFlag(ctx.c, perfBenchSizeFlag)
+		smin = val
+		smax string
+	)
+	if i := strings.IndexByte(val, '-'); i > 0 {
+		smin, smax = val[:i], val[i+1:]
+	}
+	if ctx.minSize, err = cos.ParseSize(smin, cos.UnitsIEC); err != nil {
+		return fmt.Errorf("invalid %s=%q: %v", flprn(perfBenchSizeFlag), val, err)
+	}
+	ctx.maxSize = ctx.minSize
+	if smax != "" {
+		if ctx.maxSize, err = cos.ParseSize(smax, cos.UnitsIEC); err != nil {
+			return fmt.Errorf("invalid %s=%q: %v", flprn(perfBenchSizeFlag), val, err)
+		}
+	}
+	if ctx.minSize < 0 || ctx.maxSize < ctx.minSize {
+		return fmt.Errorf("invalid %s=%q (expecting SIZE or MIN-MAX)", flprn(perfBenchSizeFlag), val)
+	}
+	if ctx.pctPut > 0 {
+		ctx.data = make([]byte, ctx.maxSize)
+		_, err = cryptorand.Read(ctx.data)
+	}
+	return err
+}
+
+func (ctx *benchCtx) sizeStr() string {
+	if ctx.minSize == ctx.maxSize {
+		return cos.ToSizeIEC(ctx.minSize, 0)
+	}
+	return cos.ToSizeIEC(ctx.minSize, 0) + "-" + cos.ToSizeIEC(ctx.maxSize, 0)
+}
+
+// when not writing (or until written), GET existing objects
+func (ctx *benchCtx) listExisting() error {
+	lsmsg := &apc.LsoMsg{Prefix: ctx.prefix, Props: apc.GetPropsName}
+	lsmsg.SetFlag(apc.LsNameOnly)
+	if ctx.bck.IsRemote() {
+		lsmsg.SetFlag(apc.LsObjCached)
+	}
+	lst, err := api.ListObjects(apiBP, ctx.bck, lsmsg, api.ListArgs{Limit: benchMaxListed})
+	if err != nil {
+		return V(err)
+	}
+	ctx.names = make([]string, 0, len(lst.Entries))
+	for _, en := range lst.Entries {
+		ctx.names = append(ctx.names, en.Name)
+	}
+	ctx.nlisted = len(ctx.names)
+	if len(ctx.names) == 0 && ctx.pctPut == 0 {
+		return fmt.Errorf("%s: no objects to read (hint: use %s to write some)", ctx.bck.Cname(ctx.prefix),
+			qflprn(perfBenchPctPutFlag))
+	}
+	return nil
+}
+
+func (ctx *benchCtx) run(idx int, stopCh *cos.StopCh, wg *sync.WaitGroup) {
+	defer wg.Done()
+	rnd := rand.New(rand.NewPCG(uint64(time.Now().UnixNano()), uint64(idx)))
+	for seq := 0; ; seq++ {
+		select {
+		case <-stopCh.Listen():
+			return
+		default:
+		}
+		ctx.mu.RLock()
+		num := len(ctx.names)
+		ctx.mu.RUnlock()
+		if num == 0 || rnd.IntN(100) < ctx.pctPut {
+			ctx.doPut(rnd, idx, seq)
+		} else {
+			ctx.doGet(rnd, num)
+		}
+	}
+}
+
+func (ctx *benchCtx) doPut(rnd *rand.Rand, idx, seq int) {
+	size := ctx.minSize
+	if ctx.maxSize > ctx.minSize {
+		size += rnd.Int64N(ctx.maxSize - ctx.minSize + 1)
+	}
+	var (
+		objName = ctx.prefix + "bench-" + strconv.Itoa(idx) + "-" + strconv.Itoa(seq)
+		args    = api.PutArgs{
+			BaseParams: apiBP,
+			Bck:        ctx.bck,
+			ObjName:    objName,
+			Reader:     cos.NewByteHandle(ctx.data[:size]),
+			Size:       uint64(size),
+			SkipVC:     true,
+		}
+		started = time.Now()
+	)
+	_, err := api.PutObject(&args)
+	ctx.put.add(time.Since(started), size, err)
+	if err == nil {
+		ctx.mu.Lock()
+		ctx.names = append(ctx.names, objName)
+		ctx.mu.Unlock()
+	}
+}
+
+func (ctx *benchCtx) doGet(rnd *rand.Rand, num int) {
+	ctx.mu.RLock()
+	objName := ctx.names[rnd.IntN(num)]
+	ctx.mu.RUnlock()
+	started := time.Now()
+	oah, err := api.GetObject(apiBP, ctx.bck, objName, nil)
+	lat := time.Since(started)
+	var size int64
+	if err == nil {
+		size = oah.Size()
+	}
+	ctx.get.add(lat, size, err)
+}
+
+func (op *benchOp) add(lat time.Duration, size int64, err error) {
+	op.mu.Lock()
+	if err != nil {
+		op.errs++
+		if op.err == nil {
+			op.err = err
+		}
+	} else {
+		op.lat = append(op.lat, lat)
+		op.bytes += size
+	}
+	op.mu.Unlock()
+}
+
+func (op *benchOp) snap() (cnt, bytes, errs int64) {
+	op.mu.Lock()
+	cnt, bytes, errs = int64(len(op.lat)), op.bytes, op.errs
+	op.mu.Unlock()
+	return
+}
+
+func (ctx *benchCtx) progress(elapsed time.Duration) {
+	var (
+		sb       strings.Builder
+		units, _ = parseUnitsFlag(ctx.c, unitsFlag)
+	)
+	sb.WriteString(teb.FormatDuration(elapsed.Truncate(time.Second)))
+	for _, op := range []struct {
+		name string
+		op   *benchOp
+	}{{"GET", &ctx.get}, {"PUT", &ctx.put}} {
+		cnt, bytes, errs := op.op.snap()
+		if cnt == 0 && errs == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "  %s: %d (%s/s)", op.name, cnt, teb.FmtSize(int64(float64(bytes)/elapsed.Seconds()), units, 2))
+		if errs > 0 {
+			sb.WriteString(", " + fred("errors: ") + strconv.FormatInt(errs, 10))
+		}
+	}
+	fmt.Fprintln(ctx.c.App.Writer, sb.String())
+}
+
+func (ctx *benchCtx) report(elapsed time.Duration) {
+	var (
+		c        = ctx.c
+		units, _ = parseUnitsFlag(c, unitsFlag)
+		tw       = &tabwriter.Writer{}
+		secs     = elapsed.Seconds()
+	)
+	fmt.Fprintln(c.App.Writer)
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "OP\tCOUNT\tERRORS\tOPS/S\tTHROUGHPUT\tAVG\tP50\tP90\tP99\tMAX")
+	for _, op := range []struct {
+		name string
+		op   *benchOp
+	}{{"GET", &ctx.get}, {"PUT", &ctx.put}} {
+		lat := op.op.lat
+		if len(lat) == 0 && op.op.errs == 0 {
+			continue
+		}
+		errs := strconv.FormatInt(op.op.errs, 10)
+		if op.op.errs > 0 {
+			errs = fred(errs)
+		}
+		if len(lat) == 0 {
+			fmt.Fprintf(tw, "%s\t0\t%s\t-\t-\t-\t-\t-\t-\t-\n", op.name, errs)
+			continue
+		}
+		sort.Slice(lat, func(i, j int) bool { return lat[i] < lat[j] })
+		var total time.Duration
+		for _, l := range lat {
+			total += l
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%.1f\t%s/s\t%s\t%s\t%s\t%s\t%s\n",
+			op.name, len(lat), errs,
+			float64(len(lat))/secs,
+			teb.FmtSize(int64(float64(op.op.bytes)/secs), units, 2),
+			teb.FormatDuration(total/time.Duration(len(lat))),
+			teb.FormatDuration(_pctl(lat, 50)),
+			teb.FormatDuration(_pctl(lat, 90)),
+			teb.FormatDuration(_pctl(lat, 99)),
+			teb.FormatDuration(lat[len(lat)-1]))
+	}
+	tw.Flush()
+	for _, op := range []*benchOp{&ctx.get, &ctx.put} {
+		if op.err != nil {
+			actionWarn(c, "first error: "+op.err.Error())
+		}
+	}
+}
+
+// nearest-rank percentile (sorted input)
+func _pctl(lat []time.Duration, p int) time.Duration {
+	i := (len(lat)*p+99)/100 - 1
+	return lat[max(i, 0)]
+}
+
+// remove objects written by this run
+func (ctx *benchCtx) cleanup() error {
+	names := ctx.names[ctx.nlisted:]
+	if len(names) == 0 {
+		return nil
+	}
+	xid, err := api.DeleteMultiObj(apiBP, ctx.bck, names, "" /*template*/)
+	if err != nil {
+		return V(err)
+	}
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActDeleteObjects}
+	if err := waitXact(&xargs); err != nil {
+		return errors.New("cleanup: " + err.Error())
+	}
+	actionDone(ctx.c, fmt.Sprintf("Removed %d objects written by this run", len(names)))
+	return nil
+}
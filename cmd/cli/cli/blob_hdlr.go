@@ -239,5 +239,8 @@ func _blobOneProgress(xid string, bar *mpb.Bar, errCh chan error, sleep time.Dur
 
 func _blobWaitOne(c *cli.Context, xargs *xact.ArgsMsg, text string) error {
 	fmt.Fprintln(c.App.Writer, text+" ...")
-	return waitXactBlob(xargs)
+	if xargs.Timeout == 0 {
+		xargs.Timeout = -1 // (no timeout unless specified)
+	}
+	return waitXact(xargs)
 }
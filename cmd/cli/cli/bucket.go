@@ -18,6 +18,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 )
 
@@ -45,6 +46,34 @@ func createBucket(c *cli.Context, bck cmn.Bck, props *cmn.BpropsToSet, dontHeadR
 	return
 }
 
+// Returns properties of the '--like' (source) bucket with (optional) '--props' overrides
+// applied on top. Backend bucket is never inherited - a new bucket must not end up writing
+// into the source's backend; provider-specific extra props are inherited only when
+// the providers match.
+func bpropsLike(c *cli.Context, like *cmn.Bprops, overrides *cmn.BpropsToSet, bck *cmn.Bck) (*cmn.BpropsToSet, error) {
+	p := like.Clone()
+	if overrides != nil {
+		p.Apply(overrides)
+	}
+	b, err := jsoniter.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	props := &cmn.BpropsToSet{}
+	if err := jsoniter.Unmarshal(b, props); err != nil {
+		return nil, err
+	}
+	props.BackendBck = nil
+	if overrides != nil {
+		props.BackendBck = overrides.BackendBck
+	}
+	if like.Provider != bck.Provider && (overrides == nil || overrides.Extra == nil) {
+		props.Extra = nil
+	}
+	props.Force = flagIsSet(c, forceFlag)
+	return props, nil
+}
+
 // Destroy ais buckets
 func destroyBuckets(c *cli.Context, buckets []cmn.Bck) (cmn.Bck, error) {
 	for i := range buckets {
@@ -52,7 +81,8 @@ func destroyBuckets(c *cli.Context, buckets []cmn.Bck) (cmn.Bck, error) {
 		empty, errEmp := isBucketEmpty(bck, true /*cached*/)
 		if errEmp == nil && !empty {
 			if !flagIsSet(c, yesFlag) {
-				if ok := confirm(c, fmt.Sprintf("Proceed to destroy %s?", bck)); !ok {
+				imp, err := destroyImpact(c, bck)
+				if ok := confirmImpact(c, imp, err, fmt.Sprintf("Proceed to destroy %s?", bck)); !ok {
 					continue
 				}
 			}
@@ -72,6 +72,7 @@ var (
 		commandCreate: {
 			ignoreErrorFlag,
 			bucketPropsFlag,
+			bucketLikeFlag,
 			forceFlag,
 			dontHeadRemoteFlag,
 		},
@@ -85,6 +86,10 @@ var (
 			verbObjPrefixFlag,
 			copyAllObjsFlag,
 			continueOnErrorFlag,
+			copyRetriesFlag,
+			copyRetryBackoffFlag,
+			copyLimitBphFlag,
+			copyScheduleFlag,
 			forceFlag,
 			copyDryRunFlag,
 			copyPrependFlag,
@@ -112,19 +117,26 @@ var (
 		cmdSetBprops: {
 			forceFlag,
 			dontHeadRemoteFlag,
+			dryRunFlag,
+			bpropsFromFileFlag,
+			yesFlag,
+		},
+		cmdResetBprops: {
+			dryRunFlag,
 		},
-		cmdResetBprops: {},
 
 		commandList: {
 			allObjsOrBcksFlag,
 			listObjCachedFlag,
 			nameOnlyFlag,
 			objPropsFlag,
+			columnsFlag,
 			regexLsAnyFlag,
 			templateFlag,
 			listObjPrefixFlag,
 			pageSizeFlag,
 			pagedFlag,
+			jsonlFlag,
 			objLimitFlag,
 			refreshFlag,
 			showUnmatchedFlag,
@@ -192,6 +204,7 @@ var (
 			indent1 + "\t- 'evict gs:'\t- evict all GCP buckets from the cluster;\n" +
 			indent1 + "\t- 'evict gs://abc --template images/'\t- evict all objects from the virtual subdirectory \"images\";\n" +
 			indent1 + "\t- 'evict gs://abc/images/'\t- same as above;\n" +
+			indent1 + "\t- 'evict gs://abc --keep-md --prefix images/ --wait'\t- same as above; wait and report freed capacity;\n" +
 			indent1 + "\t- 'evict gs://abc --template \"shard-{0000..9999}.tar.lz4\"'\t- evict the matching range (prefix + brace expansion);\n" +
 			indent1 + "\t- 'evict \"gs://abc/shard-{0000..9999}.tar.lz4\"'\t- same as above (notice double quotes)",
 		ArgsUsage:    bucketObjectOrTemplateMultiArg,
@@ -223,6 +236,7 @@ var (
 			indent1 + "\t* ais bucket props set gs://vvv versioning.validate_warm_get=false versioning.synchronize=true\n" +
 			indent1 + "\t* ais bucket props set gs://vvv mirror.enabled=true mirror.copies=4 checksum.type=md5\n" +
 			indent1 + "\t* ais bucket props set s3://mmm ec.enabled true ec.data_slices 6 ec.parity_slices 4 --force\n" +
+			indent1 + "\t* ais bucket props set ais://nnn --from-file props.yaml - validate, show the changes, and apply (upon confirmation)\n" +
 			indent1 + "\tReferences:\n" +
 			indent1 + "\t* for details and many more examples, see docs/cli/bucket.md\n" +
 			indent1 + "\t* to show bucket properties (names and current values), use 'ais bucket show'",
@@ -252,6 +266,8 @@ var (
 			},
 			bucketCmdCopy,
 			bucketCmdRename,
+			bucketCmdDiff,
+			bucketCmdSnapshot,
 			{
 				Name:      commandRemove,
 				Usage:     "remove ais buckets",
@@ -299,9 +315,25 @@ func createBucketHandler(c *cli.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	var like *cmn.Bprops
+	if flagIsSet(c, bucketLikeFlag) {
+		src, err := parseBckURI(c, parseStrFlag(c, bucketLikeFlag), false /*error only*/)
+		if err != nil {
+			return err
+		}
+		if like, err = headBucket(src, true /*don't add*/); err != nil {
+			return err
+		}
+	}
 	dontHeadRemote := flagIsSet(c, dontHeadRemoteFlag)
 	for _, bck := range buckets {
-		if err := createBucket(c, bck, props, dontHeadRemote); err != nil {
+		bprops := props
+		if like != nil {
+			if bprops, err = bpropsLike(c, like, props, &bck); err != nil {
+				return err
+			}
+		}
+		if err := createBucket(c, bck, bprops, dontHeadRemote); err != nil {
 			return err
 		}
 	}
@@ -425,6 +457,14 @@ func resetPropsHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, dryRunFlag) {
+		res, err := api.ResetBucketPropsDryRun(apiBP, bck)
+		if err != nil {
+			return V(err)
+		}
+		showBpropsDryRun(c, bck, res)
+		return nil
+	}
 	if _, err := api.ResetBucketProps(apiBP, bck); err != nil {
 		return V(err)
 	}
@@ -477,6 +517,9 @@ func setPropsHandler(c *cli.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, bpropsFromFileFlag) {
+		return setPropsFromFile(c, bck)
+	}
 	dontHeadRemote := flagIsSet(c, dontHeadRemoteFlag)
 	if !dontHeadRemote {
 		if currProps, err = headBucket(bck, false /* don't add */); err != nil {
@@ -487,6 +530,14 @@ func setPropsHandler(c *cli.Context) (err error) {
 
 	if err == nil {
 		newProps.Force = flagIsSet(c, forceFlag)
+		if flagIsSet(c, dryRunFlag) {
+			res, err := api.SetBucketPropsDryRun(apiBP, bck, newProps)
+			if err != nil {
+				return V(err)
+			}
+			showBpropsDryRun(c, bck, res)
+			return nil
+		}
 		return updateBckProps(c, bck, currProps, newProps)
 	}
 	var (
@@ -579,6 +630,61 @@ func showDiff(c *cli.Context, currProps, newProps *cmn.Bprops) {
 	}
 }
 
+func showBpropsDryRun(c *cli.Context, bck cmn.Bck, res *cmn.BpropsDryRun) {
+	dryRunCptn(c)
+	showBpropsDiff(c, bck, res)
+}
+
+func showBpropsDiff(c *cli.Context, bck cmn.Bck, res *cmn.BpropsDryRun) {
+	if len(res.Diff) == 0 {
+		fmt.Fprintf(c.App.Writer, "Bucket %q: no changes, nothing to do\n", bck.Cname(""))
+		return
+	}
+	for _, d := range res.Diff {
+		fmt.Fprintf(c.App.Writer, "%q set to: %q (was: %q)\n", d.Name, d.New, d.Old)
+	}
+	if len(res.Xactions) > 0 {
+		fmt.Fprintf(c.App.Writer, "\nWould start: %s\n", strings.Join(res.Xactions, ", "))
+	}
+}
+
+// `--from-file`: validate (via dry-run) and preview the changes, and then apply upon confirmation
+func setPropsFromFile(c *cli.Context, bck cmn.Bck) error {
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "%s cannot be used together with property name-value pairs (or inline JSON)",
+			qflprn(bpropsFromFileFlag))
+	}
+	path := parseStrFlag(c, bpropsFromFileFlag)
+	props, err := loadBpropsFile(path)
+	if err != nil {
+		return err
+	}
+	props.Force = flagIsSet(c, forceFlag)
+	res, err := api.SetBucketPropsDryRun(apiBP, bck, props)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, dryRunFlag) {
+		showBpropsDryRun(c, bck, res)
+		return nil
+	}
+	showBpropsDiff(c, bck, res)
+	if len(res.Diff) == 0 {
+		return nil
+	}
+	if !flagIsSet(c, yesFlag) {
+		fmt.Fprintln(c.App.Writer)
+		if !confirm(c, fmt.Sprintf("Apply the above to bucket %s?", bck.Cname(""))) {
+			return nil
+		}
+	}
+	if _, err := api.SetBucketProps(apiBP, bck, props); err != nil {
+		return V(err)
+	}
+	actionDone(c, "\nBucket props successfully updated.")
+	return nil
+}
+
 type lsbCtx struct {
 	regexStr        string
 	regex           *regexp.Regexp
@@ -24,6 +24,12 @@ const (
 	roleTargetShort = "t"
 )
 
+// cluster states (see `ais cluster set-state`)
+const (
+	cluStateReadOnly = "read-only"
+	cluStateNormal   = "normal"
+)
+
 var (
 	clusterCmdsFlags = map[string][]cli.Flag{
 		cmdCluAttach: {},
@@ -62,8 +68,13 @@ var (
 			rmUserDataFlag,
 			yesFlag,
 		},
-		commandStart: {},
-		commandStop:  {},
+		commandStart: {
+			rebEstimateOnlyFlag,
+			rebExcludeFlag,
+			unitsFlag,
+			jsonFlag,
+		},
+		commandStop: {},
 		commandShow: {
 			allJobsFlag,
 			noHeaderFlag,
@@ -71,6 +82,12 @@ var (
 		cmdResetStats: {
 			errorsOnlyFlag,
 		},
+		cmdRebStatus: {
+			waitJobXactFinishedFlag,
+			refreshFlag,
+			rebSkipVerifyFlag,
+			nonverboseFlag,
+		},
 	}
 
 	startRebalance = cli.Command{
@@ -121,6 +138,16 @@ var (
 					},
 				},
 			},
+			{
+				Name: cmdSetState,
+				Usage: "set cluster state, e.g.:\n" +
+					indent4 + "\t - 'set-state read-only' - reject all requests that modify data or bucket metadata\n" +
+					indent4 + "\t   with 503 (Service Unavailable) while continuing to serve reads (e.g., during backend migration);\n" +
+					indent4 + "\t - 'set-state normal' - resume normal operation",
+				ArgsUsage:    cluStateArgument,
+				Action:       setCluStateHandler,
+				BashComplete: func(_ *cli.Context) { fmt.Printf("%s\n%s\n", cluStateReadOnly, cluStateNormal) },
+			},
 			{
 				Name:         cmdPrimary,
 				Usage:        "select a new primary proxy/gateway",
@@ -200,6 +227,16 @@ var (
 						Action:       nodeMaintShutDecommHandler,
 						BashComplete: suggestAllNodes,
 					},
+					{
+						Name: cmdRebStatus,
+						Usage: "wait for all data movement triggered by recent membership changes to complete and verify placement;\n" +
+							indent4 + "\texit with non-zero status upon timeout, aborted rebalance, or misplaced objects, e.g.:\n" +
+							indent4 + "\t - 'rebalance-status' - wait until done (or Ctrl-C), show progress every 5s\n" +
+							indent4 + "\t - 'rebalance-status --timeout 2h --refresh 30s' - for use in scripts between scaling steps\n" +
+							indent4 + "\t - 'rebalance-status --skip-verify -nv' - quietly wait for rebalance to finish, no placement check",
+						Flags:  clusterCmdsFlags[cmdRebStatus],
+						Action: rebStatusHandler,
+					},
 				},
 			},
 			{
@@ -271,8 +308,8 @@ func clusterDecommissionHandler(c *cli.Context) error {
 	if !flagIsSet(c, yesFlag) {
 		warn := fmt.Sprintf("about to permanently decommission cluster (UUID=%s, primary=[%s, %s]).",
 			smap.UUID, smap.Primary.ID(), smap.Primary.PubNet.URL)
-		actionWarn(c, warn)
-		if ok := confirm(c, "The operation cannot be undone. Proceed?"); !ok {
+		imp, err := decommClusterImpact(smap, flagIsSet(c, rmUserDataFlag))
+		if ok := confirmImpact(c, imp, err, "The operation cannot be undone. Proceed?", warn); !ok {
 			return nil
 		}
 	}
@@ -284,6 +321,29 @@ func clusterDecommissionHandler(c *cli.Context) error {
 	return nil
 }
 
+func setCluStateHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	var readOnly bool
+	switch state := c.Args().Get(0); state {
+	case cluStateReadOnly:
+		readOnly = true
+	case cluStateNormal:
+	default:
+		return incorrectUsageMsg(c, "invalid cluster state %q (expecting one of: %s)", state, c.Command.ArgsUsage)
+	}
+	if err := api.SetClusterReadOnly(apiBP, readOnly); err != nil {
+		return V(err)
+	}
+	if readOnly {
+		actionDone(c, "Cluster is now read-only: requests that modify data or bucket metadata will be rejected")
+	} else {
+		actionDone(c, "Cluster is now in normal (read-write) state")
+	}
+	return nil
+}
+
 func joinNodeHandler(c *cli.Context) (err error) {
 	var (
 		daemonType, prefix string
@@ -413,7 +473,8 @@ func nodeMaintShutDecommHandler(c *cli.Context) error {
 	case cmdNodeDecommission:
 		if !flagIsSet(c, yesFlag) {
 			warn := "about to permanently decommission " + sname + ". The operation cannot be undone!"
-			if ok := confirm(c, "Proceed?", warn); !ok {
+			imp, err := decommNodeImpact(node, sname, skipRebalance, rmUserData)
+			if ok := confirmImpact(c, imp, err, "Proceed?", warn); !ok {
 				return nil
 			}
 		}
@@ -487,6 +548,12 @@ func setPrimaryHandler(c *cli.Context) error {
 }
 
 func startClusterRebalanceHandler(c *cli.Context) (err error) {
+	if flagIsSet(c, rebEstimateOnlyFlag) {
+		return estimateRebalance(c)
+	}
+	if flagIsSet(c, rebExcludeFlag) {
+		return fmt.Errorf("option %s requires %s", qflprn(rebExcludeFlag), qflprn(rebEstimateOnlyFlag))
+	}
 	return startXactionKind(c, apc.ActRebalance)
 }
 
@@ -307,7 +307,7 @@ func suggestNodesInMaint(c *cli.Context) {
 func showClusterCompletions(c *cli.Context) {
 	switch c.NArg() {
 	case 0:
-		fmt.Println(apc.Proxy, apc.Target, cmdSmap, cmdBMD, cmdConfig, cmdShowStats)
+		fmt.Println(apc.Proxy, apc.Target, cmdSmap, cmdBMD, cmdConfig, cmdShowStats, cmdShowTLS, cmdShowAlerts, cmdShowNetwork)
 	case 1:
 		switch c.Args().Get(0) {
 		case apc.Proxy:
@@ -321,25 +321,13 @@ func showClusterCompletions(c *cli.Context) {
 }
 
 func setCluConfigCompletions(c *cli.Context) {
-	var (
-		config   cmn.Config
-		propList = make([]string, 0, 48)
-	)
-	err := cmn.IterFields(&config.ClusterConfig, func(tag string, _ cmn.IterField) (err error, b bool) {
-		propList = append(propList, tag)
-		if tag == confLogLevel {
-			propList = append(propList, confLogModules) // insert to assign separately and combine below (ref 836)
-		}
-		return
-	}, cmn.IterOpts{Allowed: apc.Cluster})
-	debug.AssertNoErr(err)
-
-	if propValueCompletion(c, false /*bucket scope*/) {
+	if propValueCompletion(c, false /*bucket scope*/) || cfgBoolCompletion(c) {
 		return
 	}
+	propList := cfgSectionsAndProps(apc.Cluster) // (see config_schema.go)
 
 	// NOTE special case: custom marshaling (ref 080235)
-	if c.NArg() == 0 {
+	if c.NArg() == 0 && !cos.StringInSlice("backend", propList) {
 		propList = append(propList, "backend")
 	}
 
@@ -351,7 +339,7 @@ func setCluConfigCompletions(c *cli.Context) {
 }
 
 func suggestUpdatableConfig(c *cli.Context) {
-	if propValueCompletion(c, false /*bucket scope*/) {
+	if propValueCompletion(c, false /*bucket scope*/) || cfgBoolCompletion(c) {
 		return
 	}
 	scope := apc.Cluster
@@ -704,6 +692,48 @@ func suggestDownloadID(c *cli.Context, filter func(*dload.Job) bool, shift int)
 	}
 }
 
+func downloadSchedCompletions(c *cli.Context) {
+	if c.NArg() > 0 {
+		return
+	}
+	ss, err := api.GetDownloadSchedules(apiBP)
+	if err != nil {
+		completionErr(c, err)
+		return
+	}
+	for _, s := range ss {
+		fmt.Println(s.ID)
+	}
+}
+
+func downloadCredsCompletions(c *cli.Context) {
+	if c.NArg() > 0 {
+		return
+	}
+	cps, err := api.GetDownloadCreds(apiBP)
+	if err != nil {
+		completionErr(c, err)
+		return
+	}
+	for _, cp := range cps {
+		fmt.Println(cp.Name)
+	}
+}
+
+func downloadPresetCompletions(c *cli.Context) {
+	if c.NArg() > 0 {
+		return
+	}
+	pss, err := api.GetDownloadPresets(apiBP)
+	if err != nil {
+		completionErr(c, err)
+		return
+	}
+	for _, ps := range pss {
+		fmt.Println(ps.Name)
+	}
+}
+
 func dsortIDFinishedCompletions(c *cli.Context) { suggestDsortID(c, (*dsort.JobInfo).IsFinished, 0) }
 
 func suggestDsortID(c *cli.Context, filter func(*dsort.JobInfo) bool, shift int) {
@@ -20,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/core/meta"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 )
@@ -28,11 +29,13 @@ var (
 	configCmdsFlags = map[string][]cli.Flag{
 		cmdCluster: {
 			transientFlag,
-			jsonFlag, // to show
+			jsonFlag,         // to show
+			diffDefaultsFlag, // ditto
 		},
 		cmdNode: {
 			transientFlag,
-			jsonFlag, // to show
+			jsonFlag,         // to show
+			diffDefaultsFlag, // ditto
 		},
 	}
 
@@ -206,6 +209,11 @@ func setCluConfigHandler(c *cli.Context) error {
 		}
 	}
 
+	// validate client-side
+	if err := validateCfgProps(nvs, curCluConfig()); err != nil {
+		return fmt.Errorf("%v%s", err, examplesCluSetCfg)
+	}
+
 	// assorted named fields that require (cluster | node) restart
 	// for the change to take an effect
 	if name := nvs.ContainsAnyMatch(cmn.ConfigRestartRequired[:]); name != "" {
@@ -232,6 +240,23 @@ show:
 	return nil
 }
 
+// current config (to validate updates against); nil if not available
+func curCluConfig() *cmn.ClusterConfig {
+	config, err := api.GetClusterConfig(apiBP)
+	if err != nil {
+		return nil
+	}
+	return config
+}
+
+func curNodeConfig(node *meta.Snode) *cmn.ClusterConfig {
+	config, err := api.GetDaemonConfig(apiBP, node)
+	if err != nil {
+		return nil
+	}
+	return &config.ClusterConfig
+}
+
 // an extra call to get the current (ref 836)
 func parseLogModules(v string) (string, error) {
 	config, err := api.GetClusterConfig(apiBP)
@@ -279,6 +304,8 @@ func setcfg(c *cli.Context, nvs cos.StrKVs) error {
 			jsoniter.Unmarshal([]byte(v), &toUpdate.Log)
 		case k == "checksum" || strings.HasPrefix(k, "checksum."):
 			jsoniter.Unmarshal([]byte(v), &toUpdate.Cksum)
+		case k == "alerts" || strings.HasPrefix(k, "alerts."):
+			jsoniter.Unmarshal([]byte(v), &toUpdate.Alerts)
 		default:
 			return fmt.Errorf("cannot update config using JSON-formatted %q - "+NIY, k)
 		}
@@ -369,6 +396,9 @@ func setNodeConfigHandler(c *cli.Context) error {
 		// have api.SetClusterConfigUsingMsg but not "api.SetDaemonConfigUsingMsg"
 		return fmt.Errorf("cannot update node configuration using JSON-formatted %q - "+NIY, jsonval)
 	}
+	if err := validateCfgProps(nvs, curNodeConfig(node)); err != nil {
+		return fmt.Errorf("%v%s", err, examplesNodeSetCfg)
+	}
 	if err := api.SetDaemonConfig(apiBP, node.ID(), nvs, flagIsSet(c, transientFlag)); err != nil {
 		return V(err)
 	}
@@ -0,0 +1,143 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file validates config updates against the config schema.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/urfave/cli"
+)
+
+// The schema is cmn.ClusterConfig itself (walked via cmn.IterFields): property names
+// and their types are used to complete `ais config (cluster | node)` and to validate
+// updates client-side, prior to sending them to the cluster. The latter includes
+// (when the current config is available) running the validators of the updated
+// config sections, which is where the allowed ranges of values are enforced.
+
+// property name => value (and, therefore, type)
+func cfgSchema(config *cmn.ClusterConfig) map[string]any {
+	schema := make(map[string]any, 256)
+	err := cmn.IterFields(config, func(tag string, field cmn.IterField) (error, bool) {
+		schema[tag] = field.Value()
+		return nil, false
+	})
+	debug.AssertNoErr(err)
+	return schema
+}
+
+// sections and property names, sorted
+func cfgSectionsAndProps(scope string) []string {
+	var (
+		config cmn.ClusterConfig
+		names  = cos.NewStrSet()
+	)
+	err := cmn.IterFields(&config, func(tag string, _ cmn.IterField) (error, bool) {
+		names.Set(tag)
+		names.Set(strings.Split(tag, cmn.IterFieldNameSepa)[0])
+		if tag == confLogLevel {
+			names.Set(confLogModules) // (ref 836)
+		}
+		return nil, false
+	}, cmn.IterOpts{Allowed: scope})
+	debug.AssertNoErr(err)
+	list := names.ToSlice()
+	sort.Strings(list)
+	return list
+}
+
+// expected type, in plain words
+func cfgTypeHint(v any) string {
+	switch v.(type) {
+	case cos.Duration:
+		return "duration, e.g. 30s, 5m, 1h"
+	case cos.SizeIEC:
+		return "size, e.g. 64KiB, 4MiB, 1GiB"
+	case feat.Flags:
+		return "feature flag name(s), e.g. " + feat.Cluster[0]
+	case bool:
+		return "boolean (true | false)"
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "integer"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "non-negative integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice:
+		if reflect.TypeOf(v).Elem().Kind() == reflect.Struct {
+			return "JSON-formatted list, e.g. '[{\"name\": \"abc\", ...}]'"
+		}
+		return "list of values, e.g. '[a b c]'"
+	default:
+		return "string"
+	}
+}
+
+// Validate new values of the named properties (that must be already known to exist):
+// - type: by assigning each value to the (current or default) config;
+// - range: by running validators of the updated config sections.
+// When the current config is nil, only the types get validated.
+func validateCfgProps(nvs cos.StrKVs, current *cmn.ClusterConfig) error {
+	config := current
+	if config == nil {
+		config = &cmn.ClusterConfig{}
+	}
+	var (
+		schema   = cfgSchema(config)
+		sections = cos.NewStrSet()
+	)
+	for name, value := range nvs {
+		v, ok := schema[name]
+		if !ok {
+			continue
+		}
+		if err := cmn.UpdateFieldValue(config, name, value); err != nil {
+			return fmt.Errorf("invalid %s=%q: %v (expecting %s)", name, value, err, cfgTypeHint(v))
+		}
+		sections.Set(strings.Split(name, cmn.IterFieldNameSepa)[0])
+	}
+	if current == nil {
+		return nil
+	}
+	return cmn.IterFields(config, func(tag string, field cmn.IterField) (error, bool) {
+		if !sections.Contains(tag) {
+			return nil, false
+		}
+		if vtor, ok := field.Value().(cmn.Validator); ok {
+			if err := vtor.Validate(); err != nil {
+				return fmt.Errorf("invalid %q config: %v", tag, err), true
+			}
+		}
+		return nil, false
+	}, cmn.IterOpts{VisitAll: true})
+}
+
+// boolean config property (and its value completion)
+func cfgBoolCompletion(c *cli.Context) bool {
+	if c.NArg() == 0 {
+		return false
+	}
+	var config cmn.ClusterConfig
+	v, ok := cfgSchema(&config)[argLast(c)]
+	if !ok {
+		return false
+	}
+	if _, ok := v.(bool); !ok {
+		return false
+	}
+	for _, val := range supportedBool {
+		fmt.Println(val)
+	}
+	return true
+}
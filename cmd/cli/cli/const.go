@@ -93,9 +93,16 @@ const (
 	cmdDsort        = apc.ActDsort
 	cmdRebalance    = apc.ActRebalance
 	cmdLRU          = apc.ActLRU
+	cmdWarmUp       = apc.ActWarmUp
 	cmdStgCleanup   = "cleanup" // display name for apc.ActStoreCleanup
 	cmdStgValidate  = "validate"
+	cmdECVerify     = apc.ActECVerify
 	cmdSummary      = "summary" // ditto apc.ActSummaryBck
+	cmdDiff         = "diff"
+	cmdSnapshot     = "snapshot"
+	cmdSnapRestore  = "restore"
+
+	cmdJobsFinished = "finished" // ais job rm finished (apc.ActXactGC)
 
 	cmdCluster    = commandCluster
 	cmdNode       = "node"
@@ -108,16 +115,31 @@ const (
 	cmdAttach     = "attach"
 	cmdDetach     = "detach"
 	cmdResetStats = "reset-stats"
+	cmdSetState   = "set-state"
 
 	cmdDownloadLogs = "download-logs"
 	cmdViewLogs     = "view-logs" // etl
 
+	// job schedule subcommands
+	cmdSchedule = "schedule"
+	cmdEnable   = "enable"
+	cmdDisable  = "disable"
+
+	// job creds (download credentials profiles)
+	cmdCreds = "creds"
+
+	// job preset (download job templates)
+	cmdPreset = "preset"
+
 	// Cluster subcommands
 	cmdCluAttach = "remote-" + cmdAttach
 	cmdCluDetach = "remote-" + cmdDetach
 	cmdCluConfig = "configure"
 	cmdReset     = "reset"
 
+	// remote-cluster subcommands
+	cmdRemAisPing = "ping"
+
 	// Mountpath commands
 	cmdMpathAttach  = cmdAttach
 	cmdMpathEnable  = "enable"
@@ -140,16 +162,26 @@ const (
 	cmdStopMaint           = "stop-maintenance"
 	cmdNodeDecommission    = "decommission"
 	cmdClusterDecommission = "decommission"
+	cmdRebStatus           = "rebalance-status"
 
 	// Show subcommands (not all)
 	cmdShowRemoteAIS  = "remote-cluster"
 	cmdShowStats      = "stats"
+	cmdShowTLS        = "tls"
+	cmdShowAlerts     = "alerts"
+	cmdShowNetwork    = "network"
 	cmdMountpath      = "mountpath"
 	cmdCapacity       = "capacity"
 	cmdShowDisk       = "disk"
 	cmdShowCounters   = "counters"
 	cmdShowThroughput = "throughput"
 	cmdShowLatency    = "latency"
+	cmdShowSlowReqs   = "slow-requests"
+	cmdShowGPU        = "gpu"
+
+	// `ais performance` (top-level only)
+	cmdPerfBench    = "bench"
+	cmdPerfSelftest = "selftest"
 
 	// Bucket properties subcommands
 	cmdSetBprops   = "set"
@@ -167,6 +199,10 @@ const (
 	cmdAuthCluster = cmdCluster
 	cmdAuthToken   = "token"
 	cmdAuthConfig  = cmdConfig
+	cmdAuthSync    = "sync"
+	cmdAuthInspect = "inspect"
+	cmdAuthCreate  = "create"
+	cmdAuthCheck   = "check"
 
 	// K8s subcommans
 	cmdK8s        = "kubectl"
@@ -179,6 +215,10 @@ const (
 	cmdCode    = "code"
 	cmdDetails = "details"
 
+	cmdCanary   = "canary"
+	cmdPromote  = "promote"
+	cmdRollback = "rollback"
+
 	// config subcommands
 	cmdCLI        = "cli"
 	cmdCLIShow    = commandShow
@@ -224,10 +264,14 @@ const (
 	// Job IDs (download, dsort)
 	jobIDArgument                 = "JOB_ID"
 	optionalJobIDArgument         = "[JOB_ID]"
+	scheduleIDArgument            = "SCHEDULE_ID"
+	credsProfileArgument          = "PROFILE_NAME"
+	presetNameArgument            = "PRESET_NAME"
 	optionalJobIDDaemonIDArgument = "[JOB_ID [NODE_ID]]"
 
-	jobAnyArg                = "[NAME] [JOB_ID] [NODE_ID] [BUCKET]"
-	jobShowRebalanceArgument = "[REB_ID] [NODE_ID]"
+	jobAnyArg                   = "[NAME] [JOB_ID] [NODE_ID] [BUCKET]"
+	jobShowRebalanceArgument    = "[REB_ID] [NODE_ID]"
+	optionalJobNameOrIDArgument = "[NAME|JOB_ID]"
 
 	// Perf
 	showPerfArgument = "show performance counters, throughput, latency, disks, used/available capacities (" + tabtab + " specific view)"
@@ -253,6 +297,8 @@ const (
 	bucketObjectSrcArgument = "SRC_BUCKET[/OBJECT_NAME_or_TEMPLATE]"
 	bucketDstArgument       = "DST_BUCKET"
 	bucketNewArgument       = "NEW_BUCKET"
+	bucketDiffArgument      = "BUCKET1 BUCKET2"
+	bucketSnapArgument      = "BUCKET SNAPSHOT_NAME"
 
 	dsortSpecArgument = "[JSON_SPECIFICATION|YAML_SPECIFICATION|-] [SRC_BUCKET] [DST_BUCKET]"
 
@@ -270,6 +316,7 @@ const (
 	shardArgument         = "BUCKET/SHARD_NAME"
 	optionalShardArgument = "BUCKET[/SHARD_NAME]"
 	putApndArchArgument   = "[-|FILE|DIRECTORY[/PATTERN]] " + shardArgument
+	createArchArgument    = "DIRECTORY " + shardArgument
 	getShardArgument      = optionalShardArgument + " [OUT_FILE|OUT_DIR|-]"
 
 	concatObjectArgument = "FILE|DIRECTORY[/PATTERN] [ FILE|DIRECTORY[/PATTERN] ...] " + objectArgument
@@ -280,6 +327,9 @@ const (
 		indent1 +
 		"mykey1=value1 mykey2=value2 OR '{\"mykey1\":\"value1\", \"mykey2\":\"value2\"}'"
 
+	// cluster
+	cluStateArgument = cluStateReadOnly + " | " + cluStateNormal
+
 	// nodes
 	nodeIDArgument            = "NODE_ID"
 	optionalNodeIDArgument    = "[NODE_ID]"
@@ -288,7 +338,7 @@ const (
 	nodeMountpathPairArgument = "NODE_ID=MOUNTPATH [NODE_ID=MOUNTPATH...]"
 
 	// node log
-	showLogArgument = nodeIDArgument
+	showLogArgument = nodeIDArgument + "|cluster [NODE_ID ...]"
 	getLogArgument  = nodeIDArgument + " [OUT_FILE|OUT_DIR|-]"
 
 	// cluster
@@ -305,6 +355,7 @@ const (
 	// remais
 	attachRemoteAISArgument = aliasURLPairArgument
 	detachRemoteAISArgument = aliasArgument
+	pingRemoteAISArgument   = "[" + aliasArgument + "]"
 
 	startDownloadArgument = "SOURCE DESTINATION"
 	showStatsArgument     = "[NODE_ID]"
@@ -328,7 +379,9 @@ const (
 	showAuthUserListArgument  = "[USER_NAME]"
 	addSetAuthRoleArgument    = "ROLE [PERMISSION ...]"
 	deleteAuthRoleArgument    = "ROLE"
-	deleteAuthTokenArgument   = "TOKEN | TOKEN_FILE" //nolint:gosec // false positive G101
+	deleteAuthTokenArgument   = "TOKEN | TOKEN_FILE"   //nolint:gosec // false positive G101
+	inspectAuthTokenArgument  = "[TOKEN | TOKEN_FILE]" //nolint:gosec // ditto
+	checkAuthArgument         = "{USER_NAME | TOKEN | TOKEN_FILE} PERMISSION[,PERMISSION...] [BUCKET[/OBJECT_NAME]]"
 
 	// Alias
 	aliasURLPairArgument = "ALIAS=URL (or UUID=URL)"
@@ -420,6 +473,12 @@ var (
 			indent4 + "\t'--prefix a/b/c' - sum-up sizes of the virtual directory a/b/c and objects from the virtual directory\n" +
 			indent4 + "\ta/b that have names (relative to this directory) starting with the letter c",
 	}
+	bsummFromCacheFlag = cli.BoolFlag{
+		Name: "from-cache",
+		Usage: "show the most recent completed summary cached by the cluster (instant, possibly stale) and its age;\n" +
+			indent4 + "\tif nothing's cached or bucket metadata has changed since - summarize as usual;\n" +
+			indent4 + "\tto recompute (and refresh the cache), run the same command without this option",
+	}
 
 	//
 	// longRunFlags
@@ -455,6 +514,13 @@ var (
 			indent4 + "\t --regex \"(AWS-GET$|VERSION-CHANGE$)\" - show the number object version changes (updates) and cold GETs from AWS\n" +
 			indent4 + "\t --regex \"(GCP-GET$|VERSION-CHANGE$)\" - same as above for GCP ('gs://')",
 	}
+	columnsFlag = cli.StringFlag{
+		Name: "columns",
+		Usage: "comma-separated list of table columns to show, in the specified order and with optional fixed width\n" +
+			indent4 + "\t(column names are case-insensitive), e.g.:\n" +
+			indent4 + "\t --columns \"NAME,SIZE,VERSION\" - in 'ais ls': show object names, sizes, and versions, in that order;\n" +
+			indent4 + "\t --columns \"TARGET,GET(n):12,PUT(n):12\" - in 'ais show performance counters': fixed-width counters",
+	}
 	regexJobsFlag = cli.StringFlag{
 		Name:  regexFlag.Name,
 		Usage: "regular expression to select jobs by name, kind, or description, e.g.: --regex \"ec|mirror|elect\"",
@@ -464,11 +530,21 @@ var (
 	noHeaderFlag = cli.BoolFlag{Name: "no-headers,H", Usage: "display tables without headers"}
 	noFooterFlag = cli.BoolFlag{Name: "no-footers,F", Usage: "display tables without footers"}
 
+	diffDefaultsFlag = cli.BoolFlag{
+		Name: "diff-defaults",
+		Usage: "show only those configuration values that differ from compiled-in defaults,\n" +
+			indent4 + "\tand who set them: cluster or node (local override)",
+	}
+
 	progressFlag = cli.BoolFlag{Name: "progress", Usage: "show progress bar(s) and progress of execution in real time"}
 	dryRunFlag   = cli.BoolFlag{Name: "dry-run", Usage: "preview the results without really running the action"}
 
 	verboseFlag    = cli.BoolFlag{Name: "verbose,v", Usage: "verbose output"}
 	nonverboseFlag = cli.BoolFlag{Name: "non-verbose,nv", Usage: "non-verbose (quiet) output, minimized reporting, fewer warnings"}
+	perNodeJobFlag = cli.BoolFlag{
+		Name:  "per-node",
+		Usage: "show per-target rows instead of a single (cluster-wide) summary row per job",
+	}
 	verboseJobFlag = cli.BoolFlag{
 		Name:  verboseFlag.Name,
 		Usage: "show extended statistics",
@@ -480,6 +556,12 @@ var (
 
 	averageSizeFlag = cli.BoolFlag{Name: "average-size", Usage: "show average GET, PUT, etc. request size"}
 
+	byJobFlag = cli.BoolFlag{
+		Name: "by-job",
+		Usage: "break down disk and network throughput by running job (xaction), on the one hand,\n" +
+			indent4 + "\tand user GETs and PUTs, on the other (e.g., to find out what is currently hammering the disks)",
+	}
+
 	ignoreErrorFlag = cli.BoolFlag{
 		Name:  "ignore-error",
 		Usage: "ignore \"soft\" failures such as \"bucket already exists\", etc.",
@@ -493,6 +575,14 @@ var (
 			indent1 + "\t(tip: use '--props' to override properties that a new bucket inherits from cluster config at creation time;\n" +
 			indent1 + "\t see also: 'ais bucket props show' and 'ais bucket props set')",
 	}
+	bucketLikeFlag = cli.StringFlag{
+		Name: "like",
+		Usage: "create bucket(s) with the same properties as the specified existing bucket, e.g.:\n" +
+			indent1 + "\t* ais create ais://staging --like ais://production\n" +
+			indent1 + "\t* ais create ais://staging --like ais://production --props='mirror.enabled=false ec.enabled=false'\n" +
+			indent1 + "\t(tip: use '--props' to selectively override inherited properties;\n" +
+			indent1 + "\t backend bucket, if any, is never inherited)",
+	}
 
 	forceFlag = cli.BoolFlag{Name: "force,f", Usage: "force an action"}
 
@@ -539,6 +629,11 @@ var (
 		Usage: "list objects page by page - one page at a time (see also '--page-size' and '--limit')\n" +
 			indent4 + "\tnote: recommended for use with very large buckets",
 	}
+	jsonlFlag = cli.BoolFlag{
+		Name: "jsonl",
+		Usage: "stream JSON Lines: one JSON object per listed object, printed as pages arrive (no buffering);\n" +
+			indent4 + "\te.g.: 'ais ls s3://abc --jsonl --props name,size | jq -r .name'",
+	}
 	countAndTimeFlag = cli.BoolFlag{
 		Name:  "count-only",
 		Usage: "print only the resulting number of listed objects and elapsed time",
@@ -601,6 +696,16 @@ var (
 		Usage: "log severity is either 'i' or 'info' (default, can be omitted), or 'error', whereby error logs contain\n" +
 			indent4 + "\tonly errors and warnings, e.g.: '--severity info', '--severity error', '--severity e'",
 	}
+	logFollowFlag = cli.BoolFlag{
+		Name: "follow,f",
+		Usage: "keep fetching and showing newly logged records from all selected nodes\n" +
+			indent4 + "\t(polling interval: " + qflprn(refreshFlag) + ", default 2s)",
+	}
+	logLinesFlag = cli.IntFlag{
+		Name:  "lines,n",
+		Usage: "number of (most recent) merged log records to show; zero or negative value means all",
+		Value: 100,
+	}
 	logFlushFlag = DurationFlag{
 		Name:  "log-flush",
 		Usage: "can be used in combination with " + qflprn(refreshFlag) + " to override configured '" + nodeLogFlushName + "'",
@@ -633,10 +738,67 @@ var (
 			indent4 + "\tthe value is parsed in accordance with the '--units' (see '--units' for details);\n" +
 			indent4 + "\tomitting the flag or (same) specifying '--limit-bph 0' means that download won't be throttled",
 	}
+	dloadPartSizeFlag = cli.StringFlag{
+		Name: "part-size",
+		Usage: "download large files in parallel, in parts (byte ranges) of the specified size, e.g.:\n" +
+			indent4 + "\t'--part-size 256MiB'\t- fetch sources larger than 256MiB in 256MiB parts;\n" +
+			indent4 + "\tapplies only to HTTP(S) sources that support byte ranges (otherwise, the file is downloaded as a whole);\n" +
+			indent4 + "\tthe minimum is 1MiB; omitting the flag or specifying zero disables ranged download",
+	}
+	dloadPartWorkersFlag = cli.IntFlag{
+		Name:  "part-workers",
+		Usage: "max number of parts (see '--part-size') to download concurrently (default: 4)",
+	}
+	dloadSpreadFlag = cli.BoolFlag{
+		Name:  "spread",
+		Usage: "spread download of parts (see '--part-size') across all targets in the cluster",
+	}
 	objectsListFlag = cli.StringFlag{
 		Name:  "object-list,from",
 		Usage: "path to file containing JSON array of object names to download",
 	}
+	dloadEveryFlag = DurationFlag{
+		Name: "every",
+		Usage: "schedule recurring download that runs every so often (starting now), e.g.:\n" +
+			indent4 + "\t'--every 6h --sync'\t- synchronize destination bucket with its remote source every 6 hours;\n" +
+			indent4 + "\tscheduled downloads are managed by the cluster, see 'ais show job download --scheduled';\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	dloadScheduledFlag = cli.BoolFlag{
+		Name:  "scheduled",
+		Usage: "show scheduled (recurring) downloads, see also: 'ais start download --every'",
+	}
+	dloadCredsFlag = cli.StringFlag{
+		Name: "creds",
+		Usage: "access source links using named credentials profile, e.g.:\n" +
+			indent4 + "\t'--creds example'\t- authenticate requests to the profile's host(s);\n" +
+			indent4 + "\tprofiles are stored (encrypted) by the cluster, see 'ais job creds --help'",
+	}
+	dloadPresetFlag = cli.StringFlag{
+		Name: "preset",
+		Usage: "start download job from the named preset (job template) stored in the cluster, e.g.:\n" +
+			indent4 + "\t'--preset nightly-imagenet'\t- source, destination, and options - all as per the preset;\n" +
+			indent4 + "\t'--preset nightly-imagenet --limit-connections 8'\t- ditto, with the specified option(s) overriding the preset's;\n" +
+			indent4 + "\tsee 'ais job preset --help'",
+	}
+	credsHostsFlag = cli.StringFlag{
+		Name: "hosts",
+		Usage: "comma-separated list of source hosts to use the credentials with, e.g.:\n" +
+			indent4 + "\t'--hosts data.example.com,*.example.org'\t- the host itself and all subdomains of example.org",
+	}
+	credsHeaderFlag = cli.StringSliceFlag{
+		Name:  "header",
+		Usage: "request header to add (can be repeated), e.g.: '--header \"X-Api-Key: 12345\"'",
+	}
+	credsBearerFlag = cli.StringFlag{
+		Name:  "bearer",
+		Usage: "bearer token (to send as 'Authorization: Bearer <token>')",
+	}
+	credsAWSProfileFlag = cli.StringFlag{
+		Name: "aws-profile",
+		Usage: "sign (SigV4) requests with the named AWS profile configured on each target\n" +
+			indent4 + "\t(requires aistore built with AWS support)",
+	}
 
 	// sync
 	latestVerFlag = cli.BoolFlag{
@@ -672,6 +834,68 @@ var (
 	dsortLogFlag  = cli.StringFlag{Name: "log", Usage: "filename to log metrics (statistics)"}
 	dsortSpecFlag = cli.StringFlag{Name: "file,f", Value: "", Usage: "path to JSON or YAML job specification"}
 
+	dsortResumeFlag = cli.StringFlag{
+		Name:  "resume",
+		Usage: "resume aborted job (identified by its ID) that was started with 'resumable: true' in its specification",
+	}
+
+	// performance bench
+	perfBenchDurationFlag = DurationFlag{
+		Name:  "duration",
+		Value: time.Minute,
+		Usage: "benchmark duration (Ctrl-C to stop early);\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	perfBenchWorkersFlag = cli.IntFlag{
+		Name:  "workers",
+		Value: 16,
+		Usage: "number of concurrent workers, each running one GET or PUT at a time",
+	}
+	perfBenchPctPutFlag = cli.IntFlag{
+		Name:  "pctput",
+		Value: 50,
+		Usage: "percentage of PUTs in the GET/PUT mix (0 - read-only, 100 - write-only)",
+	}
+	perfBenchSizeFlag = cli.StringFlag{
+		Name:  "size",
+		Value: "1MiB",
+		Usage: "size of written objects: fixed (e.g., 256KiB) or range (e.g., 16KiB-4MiB, uniformly distributed)",
+	}
+	perfBenchPrefixFlag = cli.StringFlag{
+		Name:  "prefix",
+		Usage: "write objects with the given name prefix; read existing objects that have the same prefix",
+	}
+	perfBenchCleanupFlag = cli.BoolFlag{
+		Name:  "cleanup",
+		Usage: "when done, remove all objects written by the benchmark",
+	}
+
+	// `ais performance selftest`
+	perfSelftestMpathSizeFlag = cli.StringFlag{
+		Name:  "mpath-size",
+		Value: "256MiB",
+		Usage: "number of bytes to write and then read, sequentially and randomly, on each target mountpath",
+	}
+	perfSelftestXferSizeFlag = cli.StringFlag{
+		Name:  "xfer-size",
+		Value: "256MiB",
+		Usage: "number of bytes each target sends to each other target via intra-cluster transport",
+	}
+	perfSelftestCountFlag = cli.IntFlag{
+		Name:  "count",
+		Value: 100,
+		Usage: "number of (redirected) GET requests to measure proxy redirect latency",
+	}
+	perfSelftestSaveFlag = cli.BoolFlag{
+		Name: "save",
+		Usage: "save the resulting report as the new baseline, replacing the existing one\n" +
+			indent4 + "\t(the first report for a given cluster is always saved)",
+	}
+	perfSelftestSkipFlag = cli.StringFlag{
+		Name:  "skip",
+		Usage: "comma-separated list of self-tests to skip: disk, net, redirect",
+	}
+
 	cleanupFlag = cli.BoolFlag{
 		Name:  "cleanup",
 		Usage: "remove old bucket and create it again (warning: removes the entire content of the old bucket)",
@@ -693,6 +917,17 @@ var (
 		Usage: "maximum time to wait for a job to finish; if omitted: wait forever or until Ctrl-C;\n" +
 			indent4 + "\tvalid time units: " + timeUnits,
 	}
+	olderThanFlag = DurationFlag{
+		Name: "older-than",
+		Usage: "only those that finished at least so long ago (e.g., '1h'); default: all finished;\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	maxRuntimeFlag = DurationFlag{
+		Name: "max-runtime",
+		Usage: "abort the job if it is still running so long after its start (e.g., '2h');\n" +
+			indent4 + "\tthe job is then reported as aborted with \"deadline exceeded\" status;\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
 	waitFlag = cli.BoolFlag{
 		Name:  "wait",
 		Usage: "wait for an asynchronous operation to finish (optionally, use '--timeout' to limit the waiting time)",
@@ -804,7 +1039,12 @@ var (
 	noRecursFlag = cli.BoolFlag{Name: "non-recursive,nr", Usage: "list objects without including nested virtual subdirectories"}
 	noDirsFlag   = cli.BoolFlag{Name: "no-dirs", Usage: "do not return virtual subdirectories (applies to remote buckets only)"}
 
-	overwriteFlag = cli.BoolFlag{Name: "overwrite-dst,o", Usage: "overwrite destination, if exists"}
+	overwriteFlag  = cli.BoolFlag{Name: "overwrite-dst,o", Usage: "overwrite destination, if exists"}
+	promoteOwpFlag = cli.StringFlag{
+		Name: "overwrite-policy",
+		Usage: "what to do when destination object exists: one of 'skip' (default), 'always' (same as '--overwrite-dst'),\n" +
+			indent4 + "\tor 'fail' (to fail promoting the corresponding file)",
+	}
 	deleteSrcFlag = cli.BoolFlag{Name: "delete-src", Usage: "delete successfully promoted source"}
 	targetIDFlag  = cli.StringFlag{Name: "target-id", Usage: "ais target designated to carry out the entire operation"}
 
@@ -840,6 +1080,12 @@ var (
 
 	cksumFlag = cli.BoolFlag{Name: "checksum", Usage: "validate checksum"}
 
+	diffCksumFlag = cli.BoolFlag{
+		Name: "checksum",
+		Usage: "content-level comparison: in addition to sizes (and versions), compare stored object checksums\n" +
+			indent4 + "\t(both buckets must be configured to use the same checksum type)",
+	}
+
 	putObjCksumText     = indent4 + "\tand provide it as part of the PUT request for subsequent validation on the server side"
 	putObjCksumFlags    = initPutObjCksumFlags()
 	putObjDfltCksumFlag = cli.BoolFlag{
@@ -853,6 +1099,18 @@ var (
 		Usage: "concatenate files: append a file or multiple files as a new _or_ to an existing object",
 	}
 
+	// partial update (ranged write)
+	writeOffsetFlag = cli.StringFlag{
+		Name: "write-offset",
+		Usage: "update existing object in place: write a single source file at the specified offset (ais:// buckets only);\n" +
+			indent4 + "\tthe offset must not exceed object size (offset equal to size appends); default formatting: IEC (use '--units' to override)",
+	}
+	syncCksumFlag = cli.BoolFlag{
+		Name: "sync-checksum",
+		Usage: "with " + qflprn(writeOffsetFlag) + ": recompute object checksum prior to returning\n" +
+			indent4 + "\t(default: lazily, in the background, shortly after the last update)",
+	}
+
 	skipVerCksumFlag = cli.BoolFlag{
 		Name:  "skip-vc",
 		Usage: "skip loading object metadata (and the associated checksum & version related processing)",
@@ -867,6 +1125,34 @@ var (
 		Name:  "cluster",
 		Usage: "comma-separated list of AIS cluster IDs (type ',' for an empty cluster ID)",
 	}
+	clusterCheckFlag = cli.StringFlag{
+		Name:  "cluster",
+		Usage: "AIS cluster ID or alias (default: the cluster this CLI is configured to access)",
+	}
+	ssoFlag = cli.BoolFlag{
+		Name: "sso",
+		Usage: "stay logged in: obtain short-lived access token along with refresh token, and keep refreshing\n" +
+			indent4 + "\tthe former automatically (until the refresh token expires or gets revoked via 'ais auth logout')",
+	}
+	cidrFlag = cli.StringFlag{
+		Name: "cidr",
+		Usage: "comma-separated list of client source address ranges (in CIDR notation) the token is valid from,\n" +
+			indent4 + "\te.g. '10.0.0.0/8,192.168.1.0/24'; AIS gateways reject the token when used from anywhere else",
+	}
+	roleQPSFlag = cli.IntFlag{
+		Name: "qps",
+		Usage: "max requests per second by all users with this role, enforced by each AIS gateway;\n" +
+			indent4 + "\tzero (default): no limit (or no change, when updating); negative: remove existing limit",
+	}
+	roleMaxConcFlag = cli.IntFlag{
+		Name: "max-conc",
+		Usage: "max concurrent requests by all users with this role, enforced by each AIS gateway;\n" +
+			indent4 + "\tzero (default): no limit (or no change, when updating); negative: remove existing limit",
+	}
+	roleUsageFlag = cli.BoolFlag{
+		Name:  "usage",
+		Usage: "show current per-role usage (request rate, concurrency, rejected requests) as reported by each AIS gateway",
+	}
 
 	// archive
 	listArchFlag = cli.BoolFlag{Name: "archive", Usage: "list archived content (see docs/archive.md for details)"}
@@ -929,10 +1215,34 @@ var (
 		Name:  "append",
 		Usage: "add newly archived content to the destination object (\"archive\", \"shard\") that must exist",
 	}
+	// 'ais archive put': TAR index
+	archCreateIndexFlag = cli.BoolFlag{
+		Name: "create-index",
+		Usage: "(.tar only) build archived-content index if the destination shard doesn't have one;\n" +
+			indent4 + "\tshards that do have an index get it updated upon every append regardless;\n" +
+			indent4 + "\tthe index allows to list ('ais ls --archive') and read archived files without reading the entire shard",
+	}
+
+	// 'ais archive create'
+	archInclFlag = cli.StringFlag{
+		Name: "include",
+		Usage: "comma-separated list of shell filename-matching patterns to select files to archive, e.g.:\n" +
+			indent4 + "\t--include '*.jpg,*.cls' (matched against both the relative path and the base name)",
+	}
+	archExclFlag = cli.StringFlag{
+		Name:  "exclude",
+		Usage: "comma-separated list of shell filename-matching patterns to skip, e.g.: --exclude '*.tmp,.git/*'",
+	}
+	archShardSizeFlag = cli.StringFlag{
+		Name: "shard-size",
+		Usage: "split archived content into multiple shards of (approximately) this size, e.g. 256MiB, 1GiB;\n" +
+			indent4 + "\tthe shards get numbered: SHARD_NAME-000000.EXT, SHARD_NAME-000001.EXT, etc.",
+	}
 
 	continueOnErrorFlag = cli.BoolFlag{
-		Name:  "cont-on-err",
-		Usage: "keep running archiving xaction (job) in presence of errors in a any given multi-object transaction",
+		Name: "cont-on-err,continue-on-error",
+		Usage: "keep running archiving (copying, transforming) xaction (job) in presence of errors in a any given multi-object transaction;\n" +
+			indent4 + "\tcopy and transform: skip objects that fail to copy (see also '--retries')",
 	}
 	// end archive
 
@@ -951,6 +1261,28 @@ var (
 		Name:  "dry-run",
 		Usage: "show total size of new objects without really creating them",
 	}
+	copyRetriesFlag = cli.IntFlag{
+		Name: "retries",
+		Usage: "number of times to retry copying (or transforming) a given object before giving up,\n" +
+			indent4 + "\tand then either skipping it ('--cont-on-err') or aborting the job (default)",
+	}
+	copyRetryBackoffFlag = DurationFlag{
+		Name: "retry-backoff",
+		Usage: "time to wait before the first retry (doubles with each subsequent one);\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+		Value: time.Second,
+	}
+	copyLimitBphFlag = cli.StringFlag{
+		Name: "limit-bph",
+		Usage: "maximum number of bytes to copy (transform) per hour, cluster-wide, e.g.: 500GiB, 2TB;\n" +
+			indent4 + "\tthe limit is evenly split between all (active) targets",
+	}
+	copyScheduleFlag = cli.StringFlag{
+		Name: "schedule",
+		Usage: "run only during the specified time, and pause otherwise - either semicolon-separated cron expressions\n" +
+			indent4 + "\t(minute hour day-of-month month day-of-week), e.g.: '* 0-6 * * *; * * * * 0,6' (nights and weekends),\n" +
+			indent4 + "\tor '" + apc.CopyScheduleOffPeak + "' to use the configured maintenance window ('housekeeping.window')",
+	}
 	copyPrependFlag = cli.StringFlag{
 		Name: "prepend",
 		Usage: "prefix to prepend to every copied object name, e.g.:\n" +
@@ -958,6 +1290,25 @@ var (
 			indent4 + "\t--prepend=abc/\t- copy objects into a virtual directory \"abc\" (note trailing filepath separator)",
 	}
 
+	// multi-object rename (ais object mv)
+	mvByPrefixFlag = cli.BoolFlag{
+		Name: "by-prefix",
+		Usage: "rename all objects with names starting with the source prefix, by replacing the latter with NEW_OBJECT_NAME\n" +
+			indent4 + "\t(that, in this case, is the new prefix)",
+	}
+	mvMapFileFlag = cli.StringFlag{
+		Name: "map-file",
+		Usage: "text file that contains (source, new) object names to rename, one pair per line, whitespace-separated;\n" +
+			indent4 + "\tempty lines and lines that start with '#' are ignored",
+	}
+
+	// preload (ais advanced preload)
+	preloadVerifyFlag = cli.BoolFlag{
+		Name: "verify",
+		Usage: "read objects in their entirety (thus warming up page cache) and validate stored checksums;\n" +
+			indent4 + "\twait for completion and show per-mountpath read throughput",
+	}
+
 	// ETL
 	etlExtFlag  = cli.StringFlag{Name: "ext", Usage: "mapping from old to new extensions of transformed objects' names"}
 	etlNameFlag = cli.StringFlag{
@@ -965,16 +1316,91 @@ var (
 		Usage:    "unique ETL name (leaving this field empty will have unique ID auto-generated)",
 		Required: true,
 	}
+	etlVersionFlag = cli.StringFlag{
+		Name: "version",
+		Usage: "ETL version (label), e.g.:\n" +
+			indent4 + "\t--version v2 --canary-pct 10\t- start version \"v2\" of an existing ETL as a canary;\n" +
+			indent4 + "\t'ais etl promote NAME --version v2'\t- make sure that the canary being promoted is \"v2\"",
+	}
+	etlCanaryPctFlag = cli.IntFlag{
+		Name: "canary-pct",
+		Usage: "register a new version (see '--version') of an existing ETL and route the specified percentage\n" +
+			indent4 + "\tof transform requests to it (the rest continue to be served by the current version)",
+	}
+	etlGPUsFlag = cli.IntFlag{
+		Name: "gpus",
+		Usage: "number of GPUs for each ETL pod (one pod per target); pods get placed round-robin on the GPU nodes\n" +
+			indent4 + "\t(nodes with allocatable \"nvidia.com/gpu\") that have enough free GPUs",
+	}
+	etlDryRunFlag = cli.BoolFlag{
+		Name: "dry-run",
+		Usage: "validate ETL without starting it: submit the spec (or code) to the cluster to lint the pod spec,\n" +
+			indent4 + "\tcheck comm-type compatibility, container images, and ETL name collisions",
+	}
+	etlRequestTimeoutFlag = DurationFlag{
+		Name: "request-timeout",
+		Usage: "timeout of a single transform request to ETL pod (default: 45s);\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+	}
+	etlMaxFailuresFlag = cli.IntFlag{
+		Name: "max-failures",
+		Usage: "number of consecutive transform failures (timeouts, connection errors, 5xx) that opens\n" +
+			indent4 + "\tETL's circuit breaker: the pod gets restarted while transform requests fail fast;\n" +
+			indent4 + "\t0 (zero) - use default (5), negative - disable circuit breaker",
+	}
+	etlMaxRestartsFlag = cli.IntFlag{
+		Name: "max-restarts",
+		Usage: "number of consecutive ETL pod restarts (upon crash, failed health probes, or open circuit breaker)\n" +
+			indent4 + "\tafter which the ETL gets aborted cluster-wide;\n" +
+			indent4 + "\t0 (zero) - use default (5), negative - keep restarting indefinitely",
+	}
 	etlBucketRequestTimeout = DurationFlag{
 		Name: "etl-timeout",
 		Usage: "server-side timeout transforming a single object;\n" +
 			indent4 + "\tvalid time units: " + timeUnits,
 	}
+	// ETL input filter (see apc.ETLFilter)
+	etlFilterPrefixFlag = cli.StringFlag{
+		Name:  "filter-prefix",
+		Usage: "transform only source objects with names starting with the specified prefix",
+	}
+	etlFilterExtFlag = cli.StringFlag{
+		Name:  "filter-ext",
+		Usage: "transform only source objects with the specified (comma-separated) extensions, e.g. 'jpg,png'",
+	}
+	etlFilterMinSizeFlag = cli.StringFlag{
+		Name:  "filter-min-size",
+		Usage: "transform only source objects of (at least) this size, e.g. 10KiB",
+	}
+	etlFilterMaxSizeFlag = cli.StringFlag{
+		Name:  "filter-max-size",
+		Usage: "transform only source objects of (at most) this size, e.g. 1GiB",
+	}
+	etlFilterMDFlag = cli.StringFlag{
+		Name: "filter-md",
+		Usage: "transform only source objects with matching custom metadata - comma-separated 'key=value' pairs\n" +
+			indent4 + "\t(or just 'key' to match any value), e.g.: 'label=cat,source'",
+	}
+	etlParamsFlag = cli.StringFlag{
+		Name: "etl-params",
+		Usage: "parameters to pass to the ETL transformer - comma-separated 'key=value' pairs, e.g.: 'width=224,height=224';\n" +
+			indent4 + "\tthe parameters are recorded with the (offline) transform job",
+	}
+	etlCopyUnmatchedFlag = cli.BoolFlag{
+		Name:  "copy-unmatched",
+		Usage: "copy source objects that do not pass the filter (see '--filter-*') as is, without transforming (default: skip)",
+	}
 	fromFileFlag = cli.StringFlag{
 		Name:     "from-file",
 		Usage:    "absolute path to the file with the spec/code for ETL",
 		Required: true,
 	}
+	bpropsFromFileFlag = cli.StringFlag{
+		Name: "from-file",
+		Usage: "path to the YAML or JSON file with bucket properties to apply, or '-' to read from standard input;\n" +
+			indent4 + "\tthe file may contain any subset of the properties, e.g., the (edited) output of\n" +
+			indent4 + "\t'ais bucket props show BUCKET --json', where read-only properties (e.g., 'created') are ignored",
+	}
 	depsFileFlag = cli.StringFlag{
 		Name:  "deps-file",
 		Usage: "absolute path to the file with dependencies that must be installed before running the code",
@@ -1029,6 +1455,12 @@ var (
 			indent2 + "   (potentially useful in virtualized/containerized environments where '/sys/block/' wouldn't show a thing);\n" +
 			indent2 + "5. user-defined grouping of the target mountpaths",
 	}
+	mountpathInitFlag = cli.BoolFlag{
+		Name: "init",
+		Usage: "run onboarding sequence before attaching: filesystem and permission checks, xattr support,\n" +
+			indent1 + "\tshort benchmark, and automatic labeling (unless '--label' is specified); print the report\n" +
+			indent1 + "\tand do not attach if any of the checks fails",
+	}
 	noResilverFlag = cli.BoolFlag{
 		Name:  "no-resilver",
 		Usage: "do _not_ resilver data off of the mountpaths that are being disabled or detached",
@@ -1075,6 +1507,32 @@ var (
 		Name:  "mountpath",
 		Usage: "show target mountpaths with underlying disks and used/available capacities",
 	}
+	capForecastFlag = cli.BoolFlag{
+		Name: "forecast",
+		Usage: "show capacity growth trends and (estimated) number of days until high watermark,\n" +
+			indent4 + "\tper mountpath and per bucket (trends are computed from hourly capacity snapshots persisted on targets)",
+	}
+
+	rebHistoryFlag = cli.BoolFlag{
+		Name: "history",
+		Usage: "show summaries of past rebalance runs: trigger cause, duration, objects and bytes moved,\n" +
+			indent4 + "\terrors, and outcome (aggregated across all targets or, if specified, shown for a given target)",
+	}
+
+	rebEstimateOnlyFlag = cli.BoolFlag{
+		Name: "estimate-only",
+		Usage: "do not rebalance - instead, show per-target numbers of objects and bytes that rebalance would move\n" +
+			indent4 + "\tand projected duration (based on the throughput of the most recent completed rebalance, if any)",
+	}
+	rebExcludeFlag = cli.StringFlag{
+		Name:  "exclude",
+		Usage: "(with '--estimate-only') estimate rebalance as if the specified target were leaving the cluster",
+	}
+	rebSkipVerifyFlag = cli.BoolFlag{
+		Name: "skip-verify",
+		Usage: "do not verify placement upon completion of data movement\n" +
+			indent4 + "\t(by default, all targets walk all their objects to make sure that none is misplaced)",
+	}
 
 	// LRU
 	lruBucketsFlag = cli.StringFlag{
@@ -33,9 +33,9 @@ type cprCtx struct {
 	}
 	timeout, sleep time.Duration
 	// runtime
-	objs     int64
-	size     int64
-	sinceUpd time.Duration
+	objs    int64
+	size    int64
+	lastUpd time.Time
 }
 
 func (cpr *cprCtx) copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck, msg *apc.CopyBckMsg, fltPresence int) error {
@@ -135,88 +135,35 @@ func (cpr *cprCtx) multiobj(c *cli.Context, text string) (err error) {
 	return
 }
 
+// watch the xaction (see api.WatchXaction) and update the progress
 func (cpr *cprCtx) do(c *cli.Context) {
 	cpr.errCh = make(chan error, 1)
 	if flagIsSet(c, waitJobXactFinishedFlag) {
 		cpr.timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
 	}
 	cpr.sleep = _refreshRate(c) // refreshFlag or default
+	cpr.lastUpd = time.Now()
 
 	var (
-		rerr      error
-		totalWait time.Duration
-		xargs     = xact.ArgsMsg{ID: cpr.xid}
+		rerr  error
+		xargs = xact.ArgsMsg{ID: cpr.xid, Timeout: cpr.timeout}
 	)
-
-	// main loop
+	if cpr.timeout == 0 {
+		xargs.Timeout = -1 // no timeout while there's progress (see timeoutNoChange)
+	}
 	for {
-		var (
-			size, objs int64
-			nrun       int
-		)
-		xs, cms, err := queryXactions(&xargs, true /*summarize*/)
-		if err != nil {
-			if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusNotFound {
-				time.Sleep(refreshRateMinDur)
-				continue
-			}
-			rerr = fmt.Errorf("%s failed: %v", cpr.loghdr, err)
-			break
+		err := api.WatchXaction(apiBP, &xargs, cpr.sleep, func(w *xact.Watch) bool {
+			rerr = cpr.upd(w)
+			return rerr != nil || (cpr.objs >= cpr.totals.objs && cpr.size >= cpr.totals.size)
+		})
+		if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusNotFound {
+			time.Sleep(refreshRateMinDur)
+			continue
 		}
-		debug.Assert(cpr.xid == cms.xid, cpr.xid, " vs ", cms.xid)
-		if cms.running {
-			for _, snaps := range xs {
-				debug.Assert(len(snaps) < 2)
-				for _, xsnap := range snaps {
-					debug.Assertf(cpr.xid == xsnap.ID, "%q vs %q", cpr.xid, xsnap.ID)
-					size += xsnap.Stats.Bytes
-					objs += xsnap.Stats.Objs
-					if xsnap.Running() {
-						if xsnap.IsIdle() {
-							debug.Assert(xact.IdlesBeforeFinishing(cpr.xname))
-						} else {
-							nrun++
-						}
-					}
-					break // expecting one from target
-				}
-			}
-		}
-		cpr.updObjs(objs)
-		cpr.updSize(size)
-		if cpr.objs >= cpr.totals.objs && cpr.size >= cpr.totals.size {
-			if nrun > 0 {
-				time.Sleep(cpr.sleep)
-			}
-			break // NOTE: not waiting for all targets to finish
-		}
-		if nrun == 0 {
-			if cpr.objs >= cpr.totals.objs && cpr.size >= cpr.totals.size {
-				break
-			}
-			// force bars -> 100%
-			cpr.updObjs(cpr.totals.objs)
-			cpr.updSize(cpr.totals.size)
-		}
-		time.Sleep(cpr.sleep)
-		totalWait += cpr.sleep
-		cpr.sinceUpd += cpr.sleep
-		if cpr.sinceUpd > timeoutNoChange && cpr.objs < cpr.totals.objs {
-			rerr = fmt.Errorf("%s: timeout with no apparent progress for %v (%s)", cpr.loghdr, cpr.sinceUpd, cpr.log())
-			break
-		}
-		if cpr.timeout != 0 && totalWait > cpr.timeout {
-			rerr = fmt.Errorf("%s: timeout %v (%s)", cpr.loghdr, cpr.timeout, cpr.log())
-			break
-		}
-		if cms.aborted {
-			if cpr.objs > 0 {
-				rerr = fmt.Errorf("%s: aborted (%s)", cpr.loghdr, cpr.log())
-			} else {
-				rerr = fmt.Errorf("%s: aborted", cpr.loghdr)
-			}
-			break
+		if err != nil && rerr == nil {
+			rerr = fmt.Errorf("%s failed: %v (%s)", cpr.loghdr, err, cpr.log())
 		}
+		break
 	}
 
 	if rerr != nil {
@@ -228,6 +175,48 @@ func (cpr *cprCtx) do(c *cli.Context) {
 	}
 }
 
+func (cpr *cprCtx) upd(w *xact.Watch) error {
+	var (
+		size, objs int64
+		nrun       int
+	)
+	for _, snaps := range w.Snaps {
+		debug.Assert(len(snaps) < 2)
+		for _, xsnap := range snaps {
+			debug.Assertf(cpr.xid == xsnap.ID, "%q vs %q", cpr.xid, xsnap.ID)
+			size += xsnap.Stats.Bytes
+			objs += xsnap.Stats.Objs
+			if xsnap.Running() {
+				if xsnap.IsIdle() {
+					debug.Assert(xact.IdlesBeforeFinishing(cpr.xname))
+				} else {
+					nrun++
+				}
+			}
+		}
+	}
+	cpr.updObjs(objs)
+	cpr.updSize(size)
+	switch {
+	case cpr.objs >= cpr.totals.objs && cpr.size >= cpr.totals.size:
+		return nil // NOTE: not waiting for all targets to finish
+	case w.Status.Aborted():
+		if cpr.objs > 0 {
+			return fmt.Errorf("%s: aborted (%s)", cpr.loghdr, cpr.log())
+		}
+		return fmt.Errorf("%s: aborted", cpr.loghdr)
+	case nrun == 0 && len(w.Snaps) > 0, w.Status.Finished():
+		// force bars -> 100%
+		cpr.updObjs(cpr.totals.objs)
+		cpr.updSize(cpr.totals.size)
+		return nil
+	}
+	if since := time.Since(cpr.lastUpd); since > timeoutNoChange && cpr.objs < cpr.totals.objs {
+		return fmt.Errorf("%s: timeout with no apparent progress for %v (%s)", cpr.loghdr, since, cpr.log())
+	}
+	return nil
+}
+
 func (cpr *cprCtx) updObjs(objs int64) {
 	if objs <= cpr.objs {
 		return
@@ -236,7 +225,7 @@ func (cpr *cprCtx) updObjs(objs int64) {
 		cpr.barObjs.IncrInt64(objs - cpr.objs)
 	}
 	cpr.objs = objs
-	cpr.sinceUpd = 0
+	cpr.lastUpd = time.Now()
 }
 
 func (cpr *cprCtx) updSize(size int64) {
@@ -247,7 +236,7 @@ func (cpr *cprCtx) updSize(size int64) {
 		cpr.barSize.IncrInt64(size - cpr.size)
 	}
 	cpr.size = size
-	cpr.sinceUpd = 0
+	cpr.lastUpd = time.Now()
 }
 
 func (cpr *cprCtx) abortObjs() {
@@ -37,22 +37,34 @@ func cluDaeStatus(c *cli.Context, smap *meta.Smap, tstatusMap, pstatusMap teb.St
 	}
 	if res, ok := pstatusMap[sid]; ok {
 		table := teb.NewDaeStatus(res, smap, apc.Proxy, units)
-		out := table.Template(hideHeader)
+		out, err := tableTmpl(c, table, hideHeader)
+		if err != nil {
+			return err
+		}
 		return teb.Print(res, out, teb.Jopts(usejs))
 	}
 	if res, ok := tstatusMap[sid]; ok {
 		table := teb.NewDaeStatus(res, smap, apc.Target, units)
-		out := table.Template(hideHeader)
+		out, err := tableTmpl(c, table, hideHeader)
+		if err != nil {
+			return err
+		}
 		return teb.Print(res, out, teb.Jopts(usejs))
 	}
 	if sid == apc.Proxy {
 		table := teb.NewDaeMapStatus(&body.Status, smap, apc.Proxy, units)
-		out := table.Template(hideHeader)
+		out, err := tableTmpl(c, table, hideHeader)
+		if err != nil {
+			return err
+		}
 		return teb.Print(body, out, teb.Jopts(usejs))
 	}
 	if sid == apc.Target {
 		table := teb.NewDaeMapStatus(&body.Status, smap, apc.Target, units)
-		out := table.Template(hideHeader)
+		out, err := tableTmpl(c, table, hideHeader)
+		if err != nil {
+			return err
+		}
 		return teb.Print(body, out, teb.Jopts(usejs))
 	}
 	if sid != "" {
@@ -62,6 +74,9 @@ func cluDaeStatus(c *cli.Context, smap *meta.Smap, tstatusMap, pstatusMap teb.St
 	//
 	// `ais show cluster` (two tables and Summary)
 	//
+	if flagIsSet(c, columnsFlag) {
+		return incorrectUsageMsg(c, "%s requires 'proxy', 'target', or NODE_ID", qflprn(columnsFlag))
+	}
 	tableP := teb.NewDaeMapStatus(&body.Status, smap, apc.Proxy, units)
 	tableT := teb.NewDaeMapStatus(&body.Status, smap, apc.Target, units)
 
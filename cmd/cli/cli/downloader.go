@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -18,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/ext/dload"
 	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
 	"github.com/vbauerster/mpb/v4/decor"
@@ -485,3 +487,340 @@ func printDownloadStatus(c *cli.Context, d *dload.StatusResp, verbose bool) {
 		fmt.Fprintf(w, "For details, run 'ais show job %s -v'\n", d.ID)
 	}
 }
+
+//
+// scheduled (recurring) downloads
+//
+
+func scheduleDownload(c *cli.Context, dlType dload.Type, payload any) error {
+	every := parseDurationFlag(c, dloadEveryFlag)
+	if every < dload.MinScheduleInterval {
+		return fmt.Errorf("invalid %s=%v: expecting at least %v", flprn(dloadEveryFlag), every, dload.MinScheduleInterval)
+	}
+	id, err := api.ScheduleDownload(apiBP, every, dlType, payload, false /*disabled*/)
+	if err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Scheduled download %s to run every %v (starting now)", id, every))
+	fmt.Fprintf(c.App.Writer, "To monitor, run 'ais show job %s --%s'\n", cmdDownload, dloadScheduledFlag.Name)
+	return nil
+}
+
+func downloadSchedList(c *cli.Context) (int, error) {
+	ss, err := api.GetDownloadSchedules(apiBP)
+	if err != nil {
+		return 0, V(err)
+	}
+	l := len(ss)
+	if l == 0 {
+		fmt.Fprintln(c.App.Writer, "No scheduled downloads")
+		return 0, nil
+	}
+	var (
+		units, errU = parseUnitsFlag(c, unitsFlag)
+		datedTime   = flagIsSet(c, dateTimeFlag)
+		opts        = teb.Opts{AltMap: teb.FuncMapUnits(units, datedTime), UseJSON: flagIsSet(c, jsonFlag)}
+	)
+	debug.AssertNoErr(errU)
+	if flagIsSet(c, noHeaderFlag) {
+		return l, teb.Print(ss, teb.DownloadSchedNoHdrTmpl, opts)
+	}
+	return l, teb.Print(ss, teb.DownloadSchedTmpl, opts)
+}
+
+func enableDownloadSchedHandler(c *cli.Context) error {
+	id, err := dlschedArg(c)
+	if err != nil {
+		return err
+	}
+	if err := api.EnableDownloadSchedule(apiBP, id); err != nil {
+		return V(err)
+	}
+	actionDone(c, "Enabled scheduled download "+id)
+	return nil
+}
+
+func disableDownloadSchedHandler(c *cli.Context) error {
+	id, err := dlschedArg(c)
+	if err != nil {
+		return err
+	}
+	if err := api.DisableDownloadSchedule(apiBP, id); err != nil {
+		return V(err)
+	}
+	actionDone(c, "Disabled scheduled download "+id)
+	return nil
+}
+
+func removeDownloadSchedHandler(c *cli.Context) error {
+	id, err := dlschedArg(c)
+	if err != nil {
+		return err
+	}
+	if err := api.RemoveDownloadSchedule(apiBP, id); err != nil {
+		return V(err)
+	}
+	actionDone(c, "Removed scheduled download "+id)
+	return nil
+}
+
+func dlschedArg(c *cli.Context) (string, error) {
+	if c.NArg() == 0 {
+		return "", missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 1 {
+		return "", incorrectUsageMsg(c, "", c.Args()[1:])
+	}
+	id := c.Args().Get(0)
+	if !strings.HasPrefix(id, dload.PrefixSchedID) {
+		return "", fmt.Errorf("invalid schedule ID %q (expecting %q prefix)", id, dload.PrefixSchedID)
+	}
+	return id, nil
+}
+
+//
+// credentials profiles
+//
+
+func addDownloadCredsHandler(c *cli.Context) error {
+	name, err := credsArg(c)
+	if err != nil {
+		return err
+	}
+	cp := &dload.CredsProfile{
+		Name:       name,
+		Bearer:     parseStrFlag(c, credsBearerFlag),
+		AWSProfile: parseStrFlag(c, credsAWSProfileFlag),
+	}
+	if flagIsSet(c, credsHostsFlag) {
+		cp.Hosts = splitCsv(parseStrFlag(c, credsHostsFlag))
+	}
+	for _, h := range c.StringSlice(credsHeaderFlag.Name) {
+		k, v, ok := strings.Cut(h, ":")
+		if !ok || strings.TrimSpace(k) == "" {
+			return fmt.Errorf("invalid %s %q (expecting \"Name: value\")", flprn(credsHeaderFlag), h)
+		}
+		if cp.Headers == nil {
+			cp.Headers = make(cos.StrKVs, 2)
+		}
+		cp.Headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	if err := cp.Validate(); err != nil {
+		return err
+	}
+	if err := api.AddDownloadCreds(apiBP, cp); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Credentials profile %q: done (to use, run 'ais start download ... --%s %s')",
+		name, dloadCredsFlag.Name, name))
+	return nil
+}
+
+func showDownloadCredsHandler(c *cli.Context) error {
+	cps, err := api.GetDownloadCreds(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(cps, "", teb.Jopts(true))
+	}
+	if len(cps) == 0 {
+		fmt.Fprintln(c.App.Writer, "No credentials profiles")
+		return nil
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "NAME\tHOSTS\tCREDENTIALS")
+	}
+	for _, cp := range cps {
+		var creds []string
+		if len(cp.Headers) > 0 {
+			names := make([]string, 0, len(cp.Headers))
+			for k := range cp.Headers {
+				names = append(names, k)
+			}
+			sort.Strings(names)
+			creds = append(creds, "headers: "+strings.Join(names, ", "))
+		}
+		if cp.Bearer != "" {
+			creds = append(creds, "bearer token")
+		}
+		if cp.AWSProfile != "" {
+			creds = append(creds, "aws profile: "+cp.AWSProfile)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", cp.Name, strings.Join(cp.Hosts, ", "), strings.Join(creds, "; "))
+	}
+	return tw.Flush()
+}
+
+func removeDownloadCredsHandler(c *cli.Context) error {
+	name, err := credsArg(c)
+	if err != nil {
+		return err
+	}
+	if err := api.RemoveDownloadCreds(apiBP, name); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Removed credentials profile %q", name))
+	return nil
+}
+
+func credsArg(c *cli.Context) (string, error) {
+	if c.NArg() == 0 {
+		return "", missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 1 {
+		return "", incorrectUsageMsg(c, "", c.Args()[1:])
+	}
+	return c.Args().Get(0), nil
+}
+
+//
+// presets (download job templates)
+//
+
+func addDownloadPresetHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	name := c.Args().Get(0)
+	src, dst, err := downloadArgs(c, 1 /*shift*/)
+	if err != nil {
+		return err
+	}
+	dlType, payload, err := parseDownload(c, src, dst)
+	if err != nil {
+		return err
+	}
+	ps := &dload.Preset{Name: name, Body: dload.Body{Type: dlType, RawMessage: cos.MustMarshal(payload)}}
+	if err := ps.Validate(); err != nil {
+		return err
+	}
+	if err := api.AddDownloadPreset(apiBP, ps); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Download preset %q: done (to use, run 'ais start download --%s %s')",
+		name, dloadPresetFlag.Name, name))
+	return nil
+}
+
+func showDownloadPresetsHandler(c *cli.Context) error {
+	pss, err := api.GetDownloadPresets(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(pss, "", teb.Jopts(true))
+	}
+	if len(pss) == 0 {
+		fmt.Fprintln(c.App.Writer, "No download presets")
+		return nil
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "NAME\tTYPE\tDESCRIPTION\tCREATED")
+	}
+	for _, ps := range pss {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", ps.Name, ps.Body.Type, _describeDownload(ps.Body), ps.Created.Format(time.Stamp))
+	}
+	return tw.Flush()
+}
+
+// description, if specified; otherwise, destination bucket
+func _describeDownload(body dload.Body) string {
+	var base dload.Base
+	if err := jsoniter.Unmarshal(body.RawMessage, &base); err != nil {
+		return ""
+	}
+	if base.Description != "" {
+		return base.Description
+	}
+	return "=> " + base.Bck.Cname("")
+}
+
+func removeDownloadPresetHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "", c.Args()[1:])
+	}
+	name := c.Args().Get(0)
+	if err := api.RemoveDownloadPreset(apiBP, name); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Removed download preset %q", name))
+	return nil
+}
+
+// 'ais start download --preset NAME [options]'
+// the options that are explicitly specified override the preset's
+func startDownloadPreset(c *cli.Context) error {
+	if c.NArg() > 0 {
+		return incorrectUsageMsg(c, "source and destination are defined by the preset %s (got: %v)",
+			qflprn(dloadPresetFlag), c.Args())
+	}
+	var (
+		name      = parseStrFlag(c, dloadPresetFlag)
+		overrides = make(map[string]any, 4)
+		limits    = make(map[string]any, 2)
+	)
+	if flagIsSet(c, descJobFlag) {
+		overrides["description"] = parseStrFlag(c, descJobFlag)
+	}
+	if flagIsSet(c, dloadTimeoutFlag) {
+		overrides["timeout"] = parseStrFlag(c, dloadTimeoutFlag)
+	}
+	if flagIsSet(c, dloadProgressFlag) {
+		overrides["progress_interval"] = parseStrFlag(c, dloadProgressFlag)
+	}
+	if flagIsSet(c, dloadCredsFlag) {
+		overrides["creds"] = parseStrFlag(c, dloadCredsFlag)
+	}
+	if flagIsSet(c, syncFlag) {
+		overrides["synchronize"] = true
+	}
+	if flagIsSet(c, limitConnectionsFlag) {
+		limits["connections"] = parseIntFlag(c, limitConnectionsFlag)
+	}
+	if flagIsSet(c, limitBytesPerHourFlag) {
+		limitBPH, err := parseSizeFlag(c, limitBytesPerHourFlag)
+		if err != nil {
+			return err
+		}
+		limits["bytes_per_hour"] = limitBPH
+	}
+	if len(limits) > 0 {
+		overrides["limits"] = limits
+	}
+
+	// recurring: the schedule gets created with the preset as it is now
+	// (subsequent changes to the preset won't affect it)
+	if flagIsSet(c, dloadEveryFlag) {
+		pss, err := api.GetDownloadPresets(apiBP)
+		if err != nil {
+			return V(err)
+		}
+		_, ps := pss.Find(name)
+		if ps == nil {
+			return fmt.Errorf("download preset %q does not exist (see 'ais job preset show')", name)
+		}
+		body, err := ps.Instantiate(cos.MustMarshal(overrides))
+		if err != nil {
+			return err
+		}
+		return scheduleDownload(c, body.Type, body.RawMessage)
+	}
+
+	var o any
+	if len(overrides) > 0 {
+		o = overrides
+	}
+	id, err := api.DownloadPreset(apiBP, name, o)
+	if err != nil {
+		return V(err)
+	}
+	return startedDownload(c, id)
+}
@@ -96,6 +96,7 @@ var dsortStartCmd = cli.Command{
 		indent4 + "\t  " + dsortExampleY + "\n" +
 		indent1 + "Tip: use '--dry-run' to see the results without making any changes\n" +
 		indent1 + "Tip: use '--verbose' to print the spec (with all its parameters including applied defaults)\n" +
+		indent1 + "Tip: use '--resume JOB_ID' to resume aborted job that has been started with 'resumable: true'\n" +
 		indent1 + "See also: docs/dsort.md, docs/cli/dsort.md, and ais/test/scripts/dsort*",
 	ArgsUsage: dsortSpecArgument,
 	Flags:     startSpecialFlags[cmdDsort],
@@ -117,6 +118,9 @@ func startDsortHandler(c *cli.Context) (err error) {
 		srcbck, dstbck cmn.Bck
 		spec           dsort.RequestSpec
 	)
+	if flagIsSet(c, dsortResumeFlag) {
+		return resumeDsortHandler(c)
+	}
 	// parse command line
 	specPath = parseStrFlag(c, dsortSpecFlag)
 	if c.NArg() == 0 && specPath == "" {
@@ -214,6 +218,19 @@ func startDsortHandler(c *cli.Context) (err error) {
 	return
 }
 
+func resumeDsortHandler(c *cli.Context) error {
+	if c.NArg() > 0 || flagIsSet(c, dsortSpecFlag) {
+		return fmt.Errorf("option %s cannot be used together with job specification", qflprn(dsortResumeFlag))
+	}
+	xid := parseStrFlag(c, dsortResumeFlag)
+	id, err := api.ResumeDsort(apiBP, xid)
+	if err != nil {
+		return V(err)
+	}
+	fmt.Fprintln(c.App.Writer, id)
+	return nil
+}
+
 // with minor editing
 func _flattenSpec(spec *dsort.RequestSpec) (flat, config nvpairList) {
 	var src, dst cmn.Bck
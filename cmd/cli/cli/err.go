@@ -6,9 +6,11 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
 	"regexp"
 	"strings"
 
@@ -27,6 +29,17 @@ const (
 	errFmtExclusive  = "flags %s and %s are mutually exclusive"
 )
 
+// CLI exit codes (for scripting and automation; see docs/cli.md "Exit codes")
+const (
+	ExitOK          = 0
+	ExitGeneric     = 1 // all other errors
+	ExitUsage       = 2 // incorrect usage: unknown command, invalid or missing argument or option, etc.
+	ExitNotFound    = 3 // bucket, object, job, node, etc. does not exist
+	ExitTimeout     = 4 // timed out (e.g., 'ais wait --timeout')
+	ExitPartial     = 5 // multi-object operation where some of the objects (files) failed
+	ExitUnreachable = 6 // cluster cannot be reached
+)
+
 type (
 	errUsage struct {
 		helpData      any
@@ -44,6 +57,17 @@ type (
 		name   string
 		suffix string
 	}
+	errTimedOut struct {
+		what string
+	}
+	errPartial struct {
+		msg string
+	}
+	// formatted error that carries the exit code of the original one
+	errExit struct {
+		err  error
+		code int
+	}
 )
 
 //////////////
@@ -97,6 +121,73 @@ func isErrDoesNotExist(err error) bool {
 	return ok
 }
 
+/////////////////
+// errTimedOut //
+/////////////////
+
+func (e *errTimedOut) Error() string { return "timed out waiting for " + e.what }
+
+////////////////
+// errPartial //
+////////////////
+
+func newErrPartial(format string, a ...any) error { return &errPartial{fmt.Sprintf(format, a...)} }
+
+func (e *errPartial) Error() string { return e.msg }
+
+/////////////
+// errExit //
+/////////////
+
+func (e *errExit) Error() string { return e.err.Error() }
+func (e *errExit) Unwrap() error { return e.err }
+
+// ExitCode returns the process exit code for the error returned by `Run` (see above)
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	var e *errExit
+	if errors.As(err, &e) {
+		return e.code
+	}
+	return exitCode(err)
+}
+
+func exitCode(err error) int {
+	switch err := err.(type) {
+	case *errUsage:
+		return ExitUsage
+	case *errAdditionalInfo:
+		return exitCode(err.baseErr)
+	case *errDoesNotExist:
+		return ExitNotFound
+	case *errTimedOut:
+		return ExitTimeout
+	case *errPartial:
+		return ExitPartial
+	}
+	if _, unreachable := isUnreachableError(err); unreachable {
+		return ExitUnreachable
+	}
+	var herr *cmn.ErrHTTP
+	if errors.As(err, &herr) {
+		switch herr.Status {
+		case http.StatusNotFound, http.StatusGone:
+			return ExitNotFound
+		case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+			return ExitTimeout
+		}
+	}
+	switch {
+	case cmn.IsErrBckNotFound(err) || cmn.IsErrObjNought(err) || cos.IsNotExist(err, 0):
+		return ExitNotFound
+	case errors.Is(err, context.DeadlineExceeded) || os.IsTimeout(err) || strings.Contains(err.Error(), "timed out"):
+		return ExitTimeout // (including api.wait* errors)
+	}
+	return ExitGeneric
+}
+
 //
 // misc. utils, helpers
 //
@@ -11,7 +11,9 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
@@ -37,6 +39,13 @@ var (
 			chunkSizeFlag,
 			waitPodReadyTimeoutFlag,
 			etlNameFlag,
+			etlVersionFlag,
+			etlCanaryPctFlag,
+			etlGPUsFlag,
+			etlRequestTimeoutFlag,
+			etlMaxFailuresFlag,
+			etlMaxRestartsFlag,
+			etlDryRunFlag,
 		},
 		cmdSpec: {
 			fromFileFlag,
@@ -44,6 +53,19 @@ var (
 			argTypeFlag,
 			waitPodReadyTimeoutFlag,
 			etlNameFlag,
+			etlVersionFlag,
+			etlCanaryPctFlag,
+			etlGPUsFlag,
+			etlRequestTimeoutFlag,
+			etlMaxFailuresFlag,
+			etlMaxRestartsFlag,
+			etlDryRunFlag,
+		},
+		cmdCanary: {
+			etlCanaryPctFlag,
+		},
+		cmdPromote: {
+			etlVersionFlag,
 		},
 		cmdStop: {
 			allRunningJobsFlag,
@@ -51,7 +73,18 @@ var (
 		cmdBucket: {
 			etlAllObjsFlag,
 			continueOnErrorFlag,
+			copyRetriesFlag,
+			copyRetryBackoffFlag,
+			copyLimitBphFlag,
+			copyScheduleFlag,
 			etlExtFlag,
+			etlFilterPrefixFlag,
+			etlFilterExtFlag,
+			etlFilterMinSizeFlag,
+			etlFilterMaxSizeFlag,
+			etlFilterMDFlag,
+			etlCopyUnmatchedFlag,
+			etlParamsFlag,
 			forceFlag,
 			copyPrependFlag,
 			copyDryRunFlag,
@@ -116,6 +149,7 @@ var (
 		Name:         cmdObject,
 		Usage:        "transform object",
 		ArgsUsage:    etlNameArgument + " " + objectArgument + " OUTPUT",
+		Flags:        []cli.Flag{etlParamsFlag},
 		Action:       etlObjectHandler,
 		BashComplete: etlIDCompletions,
 	}
@@ -134,6 +168,31 @@ var (
 		Action:       etlLogsHandler,
 		BashComplete: etlIDCompletions,
 	}
+	canaryCmdETL = cli.Command{
+		Name: cmdCanary,
+		Usage: "change the percentage of transform requests routed to the new (canary) version of the ETL, e.g.:\n" +
+			indent1 + "\t- 'ais etl canary my-etl --canary-pct 50'\t- route half of all requests to the canary",
+		ArgsUsage:    etlNameArgument,
+		Action:       etlCanaryHandler,
+		BashComplete: etlIDCompletions,
+		Flags:        etlSubFlags[cmdCanary],
+	}
+	promoteCmdETL = cli.Command{
+		Name: cmdPromote,
+		Usage: "promote the new (canary) version of the ETL to replace the current one, e.g.:\n" +
+			indent1 + "\t- 'ais etl promote my-etl --version v2'",
+		ArgsUsage:    etlNameArgument,
+		Action:       etlPromoteHandler,
+		BashComplete: etlIDCompletions,
+		Flags:        etlSubFlags[cmdPromote],
+	}
+	rollbackCmdETL = cli.Command{
+		Name:         cmdRollback,
+		Usage:        "stop and remove the new (canary) version of the ETL",
+		ArgsUsage:    etlNameArgument,
+		Action:       etlRollbackHandler,
+		BashComplete: etlIDCompletions,
+	}
 	// subcommands
 	etlCmd = cli.Command{
 		Name:  commandETL,
@@ -146,6 +205,9 @@ var (
 			stopCmdETL,
 			objCmdETL,
 			bckCmdETL,
+			canaryCmdETL,
+			promoteCmdETL,
+			rollbackCmdETL,
 		},
 	}
 )
@@ -206,11 +268,19 @@ func etlInitSpecHandler(c *cli.Context) (err error) {
 		msg.IDX = parseStrFlag(c, etlNameFlag)
 		msg.CommTypeX = parseStrFlag(c, commTypeFlag)
 		msg.ArgTypeX = parseStrFlag(c, argTypeFlag)
+		msg.VersionX = parseStrFlag(c, etlVersionFlag)
+		msg.GPUs = parseIntFlag(c, etlGPUsFlag)
+		msg.ReqTimeout = cos.Duration(parseDurationFlag(c, etlRequestTimeoutFlag))
+		msg.MaxFails = parseIntFlag(c, etlMaxFailuresFlag)
+		msg.MaxRestarts = parseIntFlag(c, etlMaxRestartsFlag)
 		msg.Spec = spec
 	}
 	if !strings.HasSuffix(msg.CommTypeX, etl.CommTypeSeparator) {
 		msg.CommTypeX += etl.CommTypeSeparator
 	}
+	if flagIsSet(c, etlDryRunFlag) {
+		return etlDryRun(c, msg)
+	}
 	if err = msg.Validate(); err != nil {
 		if e, ok := err.(*cmn.ErrETL); ok {
 			err = errors.New(e.Reason)
@@ -218,6 +288,10 @@ func etlInitSpecHandler(c *cli.Context) (err error) {
 		return err
 	}
 
+	if flagIsSet(c, etlCanaryPctFlag) {
+		return etlInitCanary(c, msg)
+	}
+
 	// msg.ID is `metadata.name` from podSpec
 	if err = etlAlreadyExists(msg.Name()); err != nil {
 		return
@@ -241,12 +315,17 @@ func etlInitCodeHandler(c *cli.Context) (err error) {
 	}
 
 	msg.IDX = parseStrFlag(c, etlNameFlag)
-	if msg.Name() != "" {
+	msg.VersionX = parseStrFlag(c, etlVersionFlag)
+	canary := flagIsSet(c, etlCanaryPctFlag)
+	dryRun := flagIsSet(c, etlDryRunFlag)
+	if msg.Name() != "" && !dryRun {
 		if err = k8s.ValidateEtlName(msg.Name()); err != nil {
 			return
 		}
-		if err = etlAlreadyExists(msg.Name()); err != nil {
-			return
+		if !canary {
+			if err = etlAlreadyExists(msg.Name()); err != nil {
+				return
+			}
 		}
 	}
 
@@ -277,10 +356,18 @@ func etlInitCodeHandler(c *cli.Context) (err error) {
 	}
 
 	msg.Timeout = cos.Duration(parseDurationFlag(c, waitPodReadyTimeoutFlag))
+	msg.GPUs = parseIntFlag(c, etlGPUsFlag)
+	msg.ReqTimeout = cos.Duration(parseDurationFlag(c, etlRequestTimeoutFlag))
+	msg.MaxFails = parseIntFlag(c, etlMaxFailuresFlag)
+	msg.MaxRestarts = parseIntFlag(c, etlMaxRestartsFlag)
 
 	// funcs
 	msg.Funcs.Transform = parseStrFlag(c, funcTransformFlag)
 
+	if dryRun {
+		return etlDryRun(c, msg)
+	}
+
 	// validate
 	if err := msg.Validate(); err != nil {
 		if e, ok := err.(*cmn.ErrETL); ok {
@@ -290,6 +377,9 @@ func etlInitCodeHandler(c *cli.Context) (err error) {
 	}
 
 	// start
+	if canary {
+		return etlInitCanary(c, msg)
+	}
 	xid, err := api.ETLInit(apiBP, msg)
 	if err != nil {
 		return V(err)
@@ -298,6 +388,108 @@ func etlInitCodeHandler(c *cli.Context) (err error) {
 	return nil
 }
 
+// validate only: proxy and targets perform all the checks and report errors and warnings
+func etlDryRun(c *cli.Context, msg etl.InitMsg) error {
+	res, err := api.ETLInitDryRun(apiBP, msg)
+	if err != nil {
+		return V(err)
+	}
+	name := res.Name
+	if name == "" {
+		name = msg.Name()
+	}
+	if len(res.Issues) > 0 {
+		tw := tabwriter.NewWriter(c.App.Writer, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(tw, "CHECK\tNODE\tSEVERITY\tDETAILS")
+		for _, issue := range res.Issues {
+			node, severity := issue.TargetID, "error"
+			if node == "" {
+				node = "(proxy)"
+			}
+			if issue.Warning {
+				severity = "warning"
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", issue.Check, node, severity, issue.Msg)
+		}
+		tw.Flush()
+		fmt.Fprintln(c.App.Writer)
+	}
+	if n := res.NumErrs(); n > 0 {
+		return fmt.Errorf("ETL[%s]: dry run failed with %d error%s", name, n, cos.Plural(n))
+	}
+	actionDone(c, fmt.Sprintf("ETL[%s]: dry run passed (%d target%s, %d warning%s)", name,
+		res.NumTargets, cos.Plural(res.NumTargets), len(res.Issues), cos.Plural(len(res.Issues))))
+	return nil
+}
+
+// start a new version of an existing ETL as a canary
+func etlInitCanary(c *cli.Context, msg etl.InitMsg) error {
+	if msg.Version() == "" {
+		return missingArgumentsError(c, qflprn(etlVersionFlag))
+	}
+	if findETL(msg.Name(), "") == nil {
+		return fmt.Errorf("ETL[%s] does not exist (canary deployment requires existing ETL)", msg.Name())
+	}
+	pct := parseIntFlag(c, etlCanaryPctFlag)
+	xid, err := api.ETLInitCanary(apiBP, msg, pct)
+	if err != nil {
+		return V(err)
+	}
+	fmt.Fprintf(c.App.Writer, "ETL[%s]: version %q (job %q) now serves %d%% of transform requests\n",
+		msg.Name(), msg.Version(), xid, pct)
+	return nil
+}
+
+func etlCanaryHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if !flagIsSet(c, etlCanaryPctFlag) {
+		return missingArgumentsError(c, qflprn(etlCanaryPctFlag))
+	}
+	var (
+		etlName = c.Args().Get(0)
+		pct     = parseIntFlag(c, etlCanaryPctFlag)
+	)
+	if err := api.ETLSetCanary(apiBP, etlName, pct); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("ETL[%s]: canary now serves %d%% of transform requests", etlName, pct))
+	return nil
+}
+
+func etlPromoteHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	var (
+		etlName = c.Args().Get(0)
+		version = parseStrFlag(c, etlVersionFlag)
+	)
+	xid, err := api.ETLPromote(apiBP, etlName, version)
+	if err != nil {
+		return V(err)
+	}
+	msg := fmt.Sprintf("ETL[%s]: canary promoted (job %q)", etlName, xid)
+	if version != "" {
+		msg = fmt.Sprintf("ETL[%s]: version %q promoted (job %q)", etlName, version, xid)
+	}
+	actionDone(c, msg)
+	return nil
+}
+
+func etlRollbackHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	etlName := c.Args().Get(0)
+	if err := api.ETLRollback(apiBP, etlName); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("ETL[%s]: canary rolled back", etlName))
+	return nil
+}
+
 func etlListHandler(c *cli.Context) (err error) {
 	_, err = etlList(c, false)
 	return
@@ -347,6 +539,10 @@ func etlPrintDetails(c *cli.Context, id string) error {
 	fmt.Fprintln(c.App.Writer, fblue("NAME: "), msg.Name())
 	fmt.Fprintln(c.App.Writer, fblue("COMMUNICATION TYPE: "), msg.CommType())
 	fmt.Fprintln(c.App.Writer, fblue("ARGUMENT TYPE: "), msg.ArgType())
+	if msg.NumGPUs() > 0 {
+		fmt.Fprintln(c.App.Writer, fblue("GPUS (per pod): "), msg.NumGPUs())
+		etlPrintGPUs(c, id)
+	}
 
 	if initMsg, ok := msg.(*etl.InitCodeMsg); ok {
 		fmt.Fprintln(c.App.Writer, fblue("RUNTIME: "), initMsg.Runtime)
@@ -366,6 +562,26 @@ func etlPrintDetails(c *cli.Context, id string) error {
 	return err
 }
 
+// GPUs allocated to the ETL pods and, for each GPU node, the total allocated (by all pods) out of node's allocatable
+func etlPrintGPUs(c *cli.Context, id string) {
+	metrics, err := api.ETLMetrics(apiBP, id)
+	if err != nil {
+		actionWarn(c, "failed to get GPU utilization: "+err.Error())
+		return
+	}
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].TargetID < metrics[j].TargetID })
+	tw := tabwriter.NewWriter(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "TARGET\tNODE\tGPUS\tNODE GPU ALLOCATION")
+	for _, m := range metrics {
+		alloc := teb.UnknownStatusVal
+		if m.NodeGPUs > 0 {
+			alloc = fmt.Sprintf("%d/%d (%d%%)", m.NodeGPUsAlloc, m.NodeGPUs, m.NodeGPUsAlloc*100/m.NodeGPUs)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\n", m.TargetID, m.GPUNode, m.GPUs, alloc)
+	}
+	tw.Flush()
+}
+
 // TODO: initial, see "download logs"
 func etlLogsHandler(c *cli.Context) (err error) {
 	var (
@@ -482,6 +698,10 @@ func etlObjectHandler(c *cli.Context) error {
 		defer f.Close()
 	}
 
-	err := api.ETLObject(apiBP, etlName, bck, objName, w)
+	params, err := parseETLParams(c)
+	if err != nil {
+		return err
+	}
+	err = api.ETLObjectWithParams(apiBP, etlName, bck, objName, w, params)
 	return handleETLHTTPError(err, etlName)
 }
@@ -341,8 +341,11 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, outFile string, lsarch, extract bo
 		u.progress.Wait()
 		fmt.Fprint(c.App.Writer, u.errSb.String())
 	}
-	if numFailed := u.errCount.Load(); numFailed > 0 {
-		return fmt.Errorf("failed to GET %d object%s", numFailed, cos.Plural(int(numFailed)))
+	if numFailed := int(u.errCount.Load()); numFailed > 0 {
+		if numFailed < l {
+			return newErrPartial("failed to GET %d (out of %d) object%s", numFailed, l, cos.Plural(l))
+		}
+		return fmt.Errorf("failed to GET %d object%s", numFailed, cos.Plural(numFailed))
 	}
 	return nil
 }
@@ -575,9 +578,34 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, outFile string, a qparamArc
 	default:
 		fmt.Fprintf(c.App.Writer, "GET%s %s from %s%s%s%s\n", discard, objName, bn, out, sz, elapsed)
 	}
+	if flagIsSet(c, latestVerFlag) && flagIsSet(c, verboseFlag) {
+		printVerCheck(c, &oah)
+	}
 	return nil
 }
 
+// verbose '--latest': staleness decision and the version reported by remote backend
+func printVerCheck(c *cli.Context, oah *api.ObjAttrs) {
+	verdict, rver := oah.VerCheck()
+	if rver == "" {
+		attrs := oah.Attrs()
+		rver = attrs.Version() // (cold GET) the version that was just fetched
+	}
+	if rver != "" {
+		rver = fmt.Sprintf(" (remote version %q)", rver)
+	}
+	switch verdict {
+	case apc.VerCheckFresh:
+		fmt.Fprintf(c.App.Writer, "\tin-cluster copy is the latest%s\n", rver)
+	case apc.VerCheckStale:
+		fmt.Fprintf(c.App.Writer, "\tin-cluster copy was stale - replaced with the latest%s\n", rver)
+	case apc.VerCheckCold:
+		fmt.Fprintf(c.App.Writer, "\tnot present in-cluster - fetched from remote%s\n", rver)
+	default:
+		fmt.Fprintln(c.App.Writer, "\tlatest version check: not reported")
+	}
+}
+
 //
 // qparamArch
 //
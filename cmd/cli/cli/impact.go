@@ -0,0 +1,172 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file computes and shows the impact of destructive operations prior to (yes/no) confirmation.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/urfave/cli"
+)
+
+// max number of affected nodes to list by name
+const impactMaxNodes = 8
+
+type impact struct {
+	what  string   // e.g., "destroy ais://abc"
+	nodes []string // affected nodes
+	notes []string
+	objs  int64
+	size  int64
+	data  bool // whether objs and size are known
+}
+
+func (imp *impact) print(c *cli.Context) {
+	units, _ := parseUnitsFlag(c, unitsFlag)
+	out := c.App.Writer
+	fmt.Fprintf(out, "Impact of '%s':\n", imp.what)
+	if imp.data {
+		fmt.Fprintf(out, "%sobjects: %s\n", indent1, cos.FormatBigNum(int(imp.objs)))
+		fmt.Fprintf(out, "%ssize:    %s\n", indent1, teb.FmtSize(imp.size, units, 2))
+	}
+	if l := len(imp.nodes); l > 0 {
+		sort.Strings(imp.nodes)
+		names := imp.nodes
+		if l > impactMaxNodes {
+			names = append(names[:impactMaxNodes:impactMaxNodes], "...")
+		}
+		fmt.Fprintf(out, "%snodes:   %d (%s)\n", indent1, l, strings.Join(names, ", "))
+	}
+	for _, note := range imp.notes {
+		fmt.Fprintln(out, indent1+note)
+	}
+}
+
+// show impact (best effort) and prompt
+func confirmImpact(c *cli.Context, imp *impact, err error, prompt string, warning ...string) bool {
+	if err != nil {
+		actionWarn(c, "failed to compute '"+imp.what+"' impact: "+err.Error())
+	} else {
+		imp.print(c)
+	}
+	return confirm(c, prompt, warning...)
+}
+
+// `ais rm BUCKET --all` (given already listed objects)
+func rmAllImpact(bck cmn.Bck, entries cmn.LsoEntries) *impact {
+	var (
+		imp  = &impact{what: "rm " + bck.Cname("") + " " + flprn(rmrfFlag), data: true}
+		tids = make(cos.StrSet, 4)
+	)
+	for _, en := range entries {
+		imp.objs++
+		imp.size += en.Size
+		if en.Location != "" && strings.Contains(en.Location, apc.LocationPropSepa) {
+			tname, _ := core.ParseObjLoc(en.Location)
+			tids.Add(tname)
+		}
+	}
+	imp.nodes = tids.ToSlice()
+	if bck.IsRemote() {
+		imp.notes = append(imp.notes, "(objects will be deleted from the remote backend as well)")
+	}
+	return imp
+}
+
+// `ais bucket rm BUCKET`
+func destroyImpact(c *cli.Context, bck cmn.Bck) (*impact, error) {
+	imp := &impact{what: "destroy " + bck.Cname("")}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return imp, err
+	}
+	msg := &apc.BsummCtrlMsg{ObjCached: true, BckPresent: true}
+	_, res, err := api.GetBucketSummary(apiBP, cmn.QueryBcks(bck), msg, api.BsummArgs{})
+	if err != nil {
+		return imp, V(err)
+	}
+	for _, summ := range res {
+		imp.objs += int64(summ.ObjCount.Present)
+		imp.size += int64(summ.TotalSize.PresentObjs)
+	}
+	imp.data = true
+	imp.nodes = activeTargets(smap)
+	return imp, nil
+}
+
+// `ais cluster add-remove-nodes decommission NODE`
+// (for targets, uses rebalance estimate - see `ais start rebalance --estimate-only`)
+func decommNodeImpact(node *meta.Snode, sname string, skipRebalance, rmUserData bool) (*impact, error) {
+	imp := &impact{what: "decommission " + sname, nodes: []string{sname}}
+	if node.IsProxy() {
+		return imp, nil
+	}
+	plan, err := api.EstimateRebalance(apiBP, node.ID())
+	if err != nil {
+		return imp, V(err)
+	}
+	for _, est := range plan.Targets {
+		if est.TargetID != node.ID() {
+			continue
+		}
+		imp.objs = est.Local.Objs + est.Send.Objs + est.SkippedEC.Objs
+		imp.size = est.Local.Bytes + est.Send.Bytes + est.SkippedEC.Bytes
+		imp.data = true
+		for tid := range est.To {
+			imp.nodes = append(imp.nodes, meta.Tname(tid))
+		}
+	}
+	switch {
+	case skipRebalance && rmUserData:
+		imp.notes = append(imp.notes, "(objects will NOT be migrated and will be removed from "+sname+")")
+	case skipRebalance:
+		imp.notes = append(imp.notes, "(objects will NOT be migrated)")
+	default:
+		imp.notes = append(imp.notes, "(objects will be migrated to the remaining targets)")
+	}
+	return imp, nil
+}
+
+// `ais cluster decommission`
+func decommClusterImpact(smap *meta.Smap, rmUserData bool) (*impact, error) {
+	imp := &impact{what: "decommission cluster " + smap.UUID}
+	for _, si := range smap.Pmap {
+		imp.nodes = append(imp.nodes, si.StringEx())
+	}
+	for _, si := range smap.Tmap {
+		imp.nodes = append(imp.nodes, si.StringEx())
+	}
+	if rmUserData {
+		imp.notes = append(imp.notes, "(all user data will be removed)")
+	}
+	_, res, err := api.GetBucketSummary(apiBP, cmn.QueryBcks{}, nil /*all present*/, api.BsummArgs{})
+	if err != nil {
+		return imp, V(err)
+	}
+	for _, summ := range res {
+		imp.objs += int64(summ.ObjCount.Present)
+		imp.size += int64(summ.TotalSize.PresentObjs)
+	}
+	imp.data = true
+	return imp, nil
+}
+
+func activeTargets(smap *meta.Smap) (names []string) {
+	for _, tsi := range smap.Tmap {
+		if !tsi.InMaintOrDecomm() {
+			names = append(names, tsi.StringEx())
+		}
+	}
+	return
+}
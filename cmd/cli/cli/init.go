@@ -30,7 +30,8 @@ func Init(args []string) (err error) {
 	k8sDetected = detectK8s()
 
 	// auth
-	loggedUserToken = authn.LoadToken("")
+	tokenMsg, tokenFile := authn.LoadTokenMsg("")
+	loggedUserToken = tokenMsg.Token
 
 	// http clients: the main one and the auth, if enabled
 	clusterURL = _clusterURL(cfg)
@@ -82,6 +83,15 @@ func Init(args []string) (err error) {
 			}
 			authParams.Client = clientH
 		}
+		// logged in with '--sso': refresh access token if need be (and keep refreshing)
+		if tokenMsg.RefreshToken != "" {
+			refresher := authn.NewTokenRefresher(authParams, tokenMsg, tokenFile)
+			if token, err := refresher.Token(); err == nil {
+				loggedUserToken = token
+				apiBP.Token, authParams.Token = token, token
+			}
+			apiBP.TokenSource, authParams.TokenSource = refresher, refresher
+		}
 	}
 	return nil
 }
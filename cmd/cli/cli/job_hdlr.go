@@ -35,6 +35,11 @@ const (
 		indent1 + "\t- 'prefetch gs://abc/images/'\t- same as above;\n" +
 		indent1 + "\t- 'prefetch gs://abc --template \"shard-{0000..9999}.tar.lz4\"'\t- prefetch the matching range (prefix + brace expansion);\n" +
 		indent1 + "\t- 'prefetch \"gs://abc/shard-{0000..9999}.tar.lz4\"'\t- same as above (notice double quotes)"
+	warmUpUsage = "warm up selected objects: prefetch remote objects that are not present in the cluster\n" +
+		indent1 + "and read (load into the OS page cache) the ones that are, e.g.:\n" +
+		indent1 + "\t- 'warm-up s3://abc --prefix images/'\t- warm up all objects from the virtual subdirectory \"images\";\n" +
+		indent1 + "\t- 'warm-up ais://abc --template \"shard-{0000..9999}.tar\"'\t- read the matching range (ais:// buckets: preload only);\n" +
+		indent1 + "\t- 'warm-up gs://abc --list \"f1, f2\" --latest'\t- prefetch or preload two objects, and make sure they are up to date"
 )
 
 // top-level job command
@@ -50,6 +55,9 @@ var (
 		jobStopSub,
 		jobWaitSub,
 		jobRemoveSub,
+		jobScheduleSub,
+		jobCredsSub,
+		jobPresetSub,
 		makeAlias(showCmdJob, "", true, commandShow), // alias for `ais show`
 	}
 )
@@ -60,6 +68,7 @@ var (
 		waitFlag,
 		waitJobXactFinishedFlag,
 		nonverboseFlag,
+		maxRuntimeFlag,
 	}
 	startSpecialFlags = map[string][]cli.Flag{
 		cmdDownload: {
@@ -72,11 +81,19 @@ var (
 			waitFlag,
 			waitJobXactFinishedFlag,
 			limitBytesPerHourFlag,
+			dloadPartSizeFlag,
+			dloadPartWorkersFlag,
+			dloadSpreadFlag,
 			syncFlag,
+			recursFlag,
 			unitsFlag,
+			dloadEveryFlag,
+			dloadCredsFlag,
+			dloadPresetFlag,
 		},
 		cmdDsort: {
 			dsortSpecFlag,
+			dsortResumeFlag,
 			verboseFlag,
 		},
 		commandPrefetch: append(
@@ -86,6 +103,13 @@ var (
 			latestVerFlag,
 			blobThresholdFlag,
 		),
+		cmdWarmUp: append(
+			listRangeProgressWaitFlags,
+			dryRunFlag,
+			verbObjPrefixFlag,
+			latestVerFlag,
+			continueOnErrorFlag,
+		),
 		cmdBlobDownload: {
 			refreshFlag,
 			progressFlag,
@@ -101,6 +125,7 @@ var (
 			lruBucketsFlag,
 			forceFlag,
 			nonverboseFlag,
+			maxRuntimeFlag,
 		},
 	}
 
@@ -122,6 +147,14 @@ var (
 		Action:       startPrefetchHandler,
 		BashComplete: bucketCompletions(bcmplop{multiple: true}),
 	}
+	warmUpStartCmd = cli.Command{
+		Name:         cmdWarmUp,
+		Usage:        warmUpUsage,
+		ArgsUsage:    bucketObjectOrTemplateMultiArg,
+		Flags:        startSpecialFlags[cmdWarmUp],
+		Action:       startWarmUpHandler,
+		BashComplete: bucketCompletions(bcmplop{multiple: true}),
+	}
 	blobDownloadCmd = cli.Command{
 		Name: cmdBlobDownload,
 		Usage: "run a job to download large object(s) from remote storage to aistore cluster, e.g.:\n" +
@@ -139,6 +172,7 @@ var (
 		Usage: "run batch job",
 		Subcommands: []cli.Command{
 			prefetchStartCmd,
+			warmUpStartCmd,
 			blobDownloadCmd,
 			{
 				Name:      cmdDownload,
@@ -242,6 +276,135 @@ var (
 				Action:       removeDsortHandler,
 				BashComplete: dsortIDFinishedCompletions,
 			},
+			{
+				Name: cmdJobsFinished,
+				Usage: "remove finished jobs (all, of a given kind, or a given one by ID) from the cluster's job registry,\n" +
+					indent1 + "regardless of the configured retention ('xaction.retention'); e.g.:\n" +
+					indent1 + "\t- 'ais job rm finished'\t- remove all finished jobs;\n" +
+					indent1 + "\t- 'ais job rm finished rebalance --older-than 1h'\t- rebalance jobs that finished more than 1 hour ago;\n" +
+					indent1 + "\t- 'ais job rm finished Nb4Gd9FWp'\t- remove a given (finished or aborted) job",
+				ArgsUsage: optionalJobNameOrIDArgument,
+				Flags:     []cli.Flag{olderThanFlag},
+				Action:    removeFinishedHandler,
+			},
+		},
+	}
+)
+
+// ais job schedule
+var (
+	jobScheduleSub = cli.Command{
+		Name:  cmdSchedule,
+		Usage: "manage scheduled (recurring) jobs, see also: 'ais start download --every'",
+		Subcommands: []cli.Command{
+			{
+				Name:        cmdDownload,
+				Usage:       "enable, disable, or remove scheduled download",
+				Subcommands: dlschedSub,
+			},
+		},
+	}
+	dlschedSub = []cli.Command{
+		{
+			Name:         cmdEnable,
+			Usage:        "enable scheduled download (the next run is due immediately if overdue)",
+			ArgsUsage:    scheduleIDArgument,
+			Action:       enableDownloadSchedHandler,
+			BashComplete: downloadSchedCompletions,
+		},
+		{
+			Name:         cmdDisable,
+			Usage:        "disable scheduled download (does not affect download jobs that are already running)",
+			ArgsUsage:    scheduleIDArgument,
+			Action:       disableDownloadSchedHandler,
+			BashComplete: downloadSchedCompletions,
+		},
+		{
+			Name:         commandRemove,
+			Usage:        "remove scheduled download",
+			ArgsUsage:    scheduleIDArgument,
+			Action:       removeDownloadSchedHandler,
+			BashComplete: downloadSchedCompletions,
+		},
+	}
+)
+
+// ais job creds
+var (
+	jobCredsSub = cli.Command{
+		Name:  cmdCreds,
+		Usage: "manage named credentials profiles to access download sources, see also: 'ais start download --creds'",
+		Subcommands: []cli.Command{
+			{
+				Name: cmdAuthAdd,
+				Usage: "add new or update existing credentials profile, e.g.:\n" +
+					indent1 + "\t- 'ais job creds add example --hosts data.example.com --bearer $TOKEN'",
+				ArgsUsage: credsProfileArgument,
+				Flags: []cli.Flag{
+					credsHostsFlag,
+					credsHeaderFlag,
+					credsBearerFlag,
+					credsAWSProfileFlag,
+				},
+				Action: addDownloadCredsHandler,
+			},
+			{
+				Name:   commandShow,
+				Usage:  "show all credentials profiles (secrets are never shown)",
+				Flags:  []cli.Flag{jsonFlag, noHeaderFlag},
+				Action: showDownloadCredsHandler,
+			},
+			{
+				Name:         commandRemove,
+				Usage:        "remove credentials profile",
+				ArgsUsage:    credsProfileArgument,
+				Action:       removeDownloadCredsHandler,
+				BashComplete: downloadCredsCompletions,
+			},
+		},
+	}
+)
+
+// ais job preset
+var (
+	jobPresetSub = cli.Command{
+		Name:  cmdPreset,
+		Usage: "manage named download job templates (presets), see also: 'ais start download --preset'",
+		Subcommands: []cli.Command{
+			{
+				Name: cmdAuthAdd,
+				Usage: "add new or update existing download preset - same arguments and options as 'ais start download', e.g.:\n" +
+					indent1 + "\t- 'ais job preset add nightly-imagenet gs://imagenet ais://imagenet --sync --limit-connections 16'",
+				ArgsUsage: presetNameArgument + " " + startDownloadArgument,
+				Flags: []cli.Flag{
+					dloadTimeoutFlag,
+					descJobFlag,
+					limitConnectionsFlag,
+					objectsListFlag,
+					dloadProgressFlag,
+					limitBytesPerHourFlag,
+					dloadPartSizeFlag,
+					dloadPartWorkersFlag,
+					dloadSpreadFlag,
+					syncFlag,
+					recursFlag,
+					dloadCredsFlag,
+				},
+				Action: addDownloadPresetHandler,
+			},
+			{
+				Name:   commandShow,
+				Usage:  "show all download presets",
+				Flags:  []cli.Flag{jsonFlag, noHeaderFlag},
+				Action: showDownloadPresetsHandler,
+			},
+			{
+				Name:         commandRemove,
+				Usage:        "remove download preset (does not affect download jobs that are already running)",
+				ArgsUsage:    presetNameArgument,
+				Action:       removeDownloadPresetHandler,
+				BashComplete: downloadPresetCompletions,
+			},
 		},
 	}
 )
@@ -365,6 +528,9 @@ func startXaction(c *cli.Context, xargs *xact.ArgsMsg, extra string) error {
 	} else if xact.IsSameScope(xargs.Kind, xact.ScopeB) {
 		return fmt.Errorf("%q requires bucket to run", xargs.Kind)
 	}
+	if flagIsSet(c, maxRuntimeFlag) {
+		xargs.MaxRuntime = parseDurationFlag(c, maxRuntimeFlag)
+	}
 
 	xid, err := api.StartXaction(apiBP, xargs, extra)
 	if err != nil {
@@ -392,47 +558,79 @@ func startXaction(c *cli.Context, xargs *xact.ArgsMsg, extra string) error {
 }
 
 func startDownloadHandler(c *cli.Context) error {
-	var (
-		description      = parseStrFlag(c, descJobFlag)
-		timeout          = parseStrFlag(c, dloadTimeoutFlag)
-		objectsListPath  = parseStrFlag(c, objectsListFlag)
-		progressInterval = parseStrFlag(c, dloadProgressFlag)
-		id               string
-	)
-	if c.NArg() == 0 {
-		return missingArgumentsError(c, c.Command.ArgsUsage)
+	if flagIsSet(c, dloadPresetFlag) {
+		return startDownloadPreset(c)
+	}
+	src, dst, err := downloadArgs(c, 0 /*shift*/)
+	if err != nil {
+		return err
+	}
+	dlType, payload, err := parseDownload(c, src, dst)
+	if err != nil {
+		return err
+	}
+
+	if flagIsSet(c, dloadEveryFlag) {
+		return scheduleDownload(c, dlType, payload)
 	}
-	if c.NArg() == 1 {
-		return missingArgumentsError(c, "destination")
+
+	id, err := api.DownloadWithParam(apiBP, dlType, payload)
+	if err != nil {
+		return err
 	}
-	if c.NArg() > 2 {
+	return startedDownload(c, id)
+}
+
+// SOURCE and DESTINATION, starting at a given position
+func downloadArgs(c *cli.Context, shift int) (src, dst string, err error) {
+	switch {
+	case c.NArg() <= shift:
+		err = missingArgumentsError(c, c.Command.ArgsUsage)
+	case c.NArg() == shift+1:
+		err = missingArgumentsError(c, "destination")
+	case c.NArg() > shift+2:
 		const q = "For range download, enclose source in quotation marks, e.g.: \"gs://imagenet/train-{00..99}.tgz\""
-		s := fmt.Sprintf("too many arguments - expected 2, got %d.\n%s", len(c.Args()), q)
-		return &errUsage{
+		s := fmt.Sprintf("too many arguments - expected %d, got %d.\n%s", shift+2, len(c.Args()), q)
+		err = &errUsage{
 			context:      c,
 			message:      s,
 			helpData:     c.Command,
 			helpTemplate: cli.CommandHelpTemplate,
 		}
+	default:
+		src, dst = c.Args().Get(shift), c.Args().Get(shift+1)
 	}
+	return
+}
 
-	src, dst := c.Args().Get(0), c.Args().Get(1)
+// determine download type and build the corresponding (type-specific) download body
+func parseDownload(c *cli.Context, src, dst string) (dload.Type, any, error) {
+	var (
+		description      = parseStrFlag(c, descJobFlag)
+		timeout          = parseStrFlag(c, dloadTimeoutFlag)
+		objectsListPath  = parseStrFlag(c, objectsListFlag)
+		progressInterval = parseStrFlag(c, dloadProgressFlag)
+	)
 	source, err := parseSource(src)
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 	bck, pathSuffix, err := parseDest(c, dst)
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
 	limitBPH, err := parseSizeFlag(c, limitBytesPerHourFlag)
 	if err != nil {
-		return err
+		return "", nil, err
+	}
+	partSize, err := parseSizeFlag(c, dloadPartSizeFlag)
+	if err != nil {
+		return "", nil, err
 	}
 
 	if _, err := time.ParseDuration(progressInterval); err != nil {
-		return err
+		return "", nil, err
 	}
 
 	basePayload := dload.Base{
@@ -440,15 +638,21 @@ func startDownloadHandler(c *cli.Context) error {
 		Timeout:          timeout,
 		Description:      description,
 		ProgressInterval: progressInterval,
+		Creds:            parseStrFlag(c, dloadCredsFlag),
 		Limits: dload.Limits{
 			Connections:  parseIntFlag(c, limitConnectionsFlag),
 			BytesPerHour: int(limitBPH),
 		},
+		Ranged: dload.Ranged{
+			PartSize: partSize,
+			Workers:  parseIntFlag(c, dloadPartWorkersFlag),
+			Spread:   flagIsSet(c, dloadSpreadFlag),
+		},
 	}
 
 	if basePayload.Bck.Props, err = api.HeadBucket(apiBP, basePayload.Bck, true /* don't add */); err != nil {
 		if !cmn.IsStatusNotFound(err) {
-			return err
+			return "", nil, err
 		}
 		warn := fmt.Sprintf("destination bucket %s doesn't exist. Bucket with default properties will be created.",
 			basePayload.Bck.Cname(""))
@@ -457,7 +661,9 @@ func startDownloadHandler(c *cli.Context) error {
 
 	// Heuristics to determine the download type.
 	var dlType dload.Type
-	if objectsListPath != "" {
+	if strings.HasPrefix(source.link, dload.FSScheme) {
+		dlType = dload.TypeFS
+	} else if objectsListPath != "" {
 		dlType = dload.TypeMulti
 	} else if strings.Contains(source.link, "{") && strings.Contains(source.link, "}") {
 		dlType = dload.TypeRange
@@ -466,14 +672,14 @@ func startDownloadHandler(c *cli.Context) error {
 	} else {
 		backends, err := api.GetConfiguredBackends(apiBP)
 		if err != nil {
-			return err
+			return "", nil, err
 		}
 		if cos.StringInSlice(source.backend.bck.Provider, backends) {
 			dlType = dload.TypeBackend
 
 			p, err := api.HeadBucket(apiBP, basePayload.Bck, false /* don't add */)
 			if err != nil {
-				return V(err)
+				return "", nil, V(err)
 			}
 			if !p.BackendBck.Equal(&source.backend.bck) {
 				warn := fmt.Sprintf("%s does not have Cloud bucket %s as its *backend* - proceeding to download anyway.",
@@ -482,13 +688,13 @@ func startDownloadHandler(c *cli.Context) error {
 				dlType = dload.TypeSingle
 			}
 		} else if source.backend.prefix == "" {
-			return fmt.Errorf(
+			return "", nil, fmt.Errorf(
 				"cluster is not configured with %q provider: cannot download remote bucket",
 				source.backend.bck.Provider,
 			)
 		} else {
 			if source.link == "" {
-				return fmt.Errorf(
+				return "", nil, fmt.Errorf(
 					"cluster is not configured with %q provider: cannot download bucket's objects",
 					source.backend.bck.Provider,
 				)
@@ -499,57 +705,60 @@ func startDownloadHandler(c *cli.Context) error {
 		}
 	}
 
+	var payload any
 	switch dlType {
 	case dload.TypeSingle:
-		payload := dload.SingleBody{
+		payload = dload.SingleBody{
 			Base: basePayload,
 			SingleObj: dload.SingleObj{
 				Link:    source.link,
 				ObjName: pathSuffix, // in this case pathSuffix is a full name of the object
 			},
 		}
-		id, err = api.DownloadWithParam(apiBP, dlType, payload)
 	case dload.TypeMulti:
 		var objects []string
 		{
 			file, err := os.Open(objectsListPath)
 			if err != nil {
-				return err
+				return "", nil, err
 			}
 			if err := jsoniter.NewDecoder(file).Decode(&objects); err != nil {
-				return fmt.Errorf("file %q doesn't seem to contain JSON array of strings: %v", objectsListPath, err)
+				return "", nil, fmt.Errorf("file %q doesn't seem to contain JSON array of strings: %v", objectsListPath, err)
 			}
 		}
 		for i, object := range objects {
 			objects[i] = source.link + "/" + object
 		}
-		payload := dload.MultiBody{
+		payload = dload.MultiBody{
 			Base:           basePayload,
 			ObjectsPayload: objects,
 		}
-		id, err = api.DownloadWithParam(apiBP, dlType, payload)
 	case dload.TypeRange:
-		payload := dload.RangeBody{
+		payload = dload.RangeBody{
 			Base:     basePayload,
 			Subdir:   pathSuffix, // in this case pathSuffix is a subdirectory in which the objects are to be saved
 			Template: source.link,
 		}
-		id, err = api.DownloadWithParam(apiBP, dlType, payload)
+	case dload.TypeFS:
+		payload = dload.FSBody{
+			Base:      basePayload,
+			Path:      source.link,
+			Prefix:    pathSuffix, // destination prefix
+			Recursive: flagIsSet(c, recursFlag),
+		}
 	case dload.TypeBackend:
-		payload := dload.BackendBody{
+		payload = dload.BackendBody{
 			Base:   basePayload,
 			Sync:   flagIsSet(c, syncFlag),
 			Prefix: source.backend.prefix,
 		}
-		id, err = api.DownloadWithParam(apiBP, dlType, payload)
 	default:
 		debug.Assert(false)
 	}
+	return dlType, payload, nil
+}
 
-	if err != nil {
-		return err
-	}
-
+func startedDownload(c *cli.Context, id string) error {
 	fmt.Fprintf(c.App.Writer, "Started download job %s\n", id)
 
 	if flagIsSet(c, progressFlag) {
@@ -656,7 +865,7 @@ func waitDownload(c *cli.Context, id string) (err error) {
 		time.Sleep(refreshRate)
 		elapsed += refreshRate
 		if timeout != 0 && elapsed > timeout {
-			return fmt.Errorf("timed out waiting for %s", qn)
+			return &errTimedOut{qn}
 		}
 	}
 	if aborted {
@@ -693,6 +902,9 @@ func startLRUHandler(c *cli.Context) (err error) {
 		id    string
 		xargs = xact.ArgsMsg{Kind: apc.ActLRU, Buckets: buckets, Force: flagIsSet(c, forceFlag)}
 	)
+	if flagIsSet(c, maxRuntimeFlag) {
+		xargs.MaxRuntime = parseDurationFlag(c, maxRuntimeFlag)
+	}
 	if id, err = api.StartXaction(apiBP, &xargs, ""); err != nil {
 		return
 	}
@@ -982,14 +1194,6 @@ func waitJob(c *cli.Context, name, xid string, bck cmn.Bck) error {
 		}
 		return waitDsortHandler(c, xid /*job ID*/)
 	}
-	// TODO: niy
-	if flagIsSet(c, refreshFlag) {
-		warn := fmt.Sprintf("ignoring flag %s  - not fully implemented yet", qflprn(refreshFlag))
-		actionWarn(c, warn)
-	} else if flagIsSet(c, progressFlag) {
-		warn := fmt.Sprintf("ignoring flag %s  - not fully implemented yet", qflprn(progressFlag))
-		actionWarn(c, warn)
-	}
 	// x-wait
 	var (
 		xactID, xname = xid, name
@@ -1018,13 +1222,42 @@ func waitJob(c *cli.Context, name, xid string, bck cmn.Bck) error {
 
 	msg := formatXactMsg(xactID, xname, bck)
 	fmt.Fprintln(c.App.Writer, "Waiting for "+msg+" ...")
-	err := waitXact(&xargs)
-	if err == nil {
-		actionDone(c, "Done.")
+	var err error
+	if flagIsSet(c, refreshFlag) || flagIsSet(c, progressFlag) {
+		err = waitXact(&xargs, xactProgress(c, xargs.ID))
+	} else {
+		err = waitXact(&xargs)
+	}
+	if err != nil {
+		return err
 	}
+	actionDone(c, "Done.")
 	return nil
 }
 
+// print (cluster-wide) counts of objects and bytes processed so far, upon each poll
+func xactProgress(c *cli.Context, xid string) func(xact.MultiSnap) {
+	var prevObjs, prevBytes int64 = -1, -1
+	return func(snaps xact.MultiSnap) {
+		id := xid
+		if id == "" {
+			uuids := snaps.GetUUIDs()
+			if len(uuids) != 1 {
+				return
+			}
+			id = uuids[0]
+		}
+		objs, _, _ := snaps.ObjCounts(id)
+		size, _, _ := snaps.ByteCounts(id)
+		if objs == prevObjs && size == prevBytes {
+			return
+		}
+		prevObjs, prevBytes = objs, size
+		fmt.Fprintf(c.App.Writer, "%s\t%d object%s (%s)\n", time.Now().Format(time.TimeOnly),
+			objs, cos.Plural(int(objs)), cos.ToSizeIEC(size, 2))
+	}
+}
+
 func waitDownloadHandler(c *cli.Context, id string) error {
 	refreshRate := _refreshRate(c)
 	if flagIsSet(c, progressFlag) {
@@ -1062,7 +1295,7 @@ func waitDownloadHandler(c *cli.Context, id string) error {
 		time.Sleep(refreshRate)
 		total += refreshRate
 		if timeout != 0 && total > timeout {
-			return fmt.Errorf("timed out waiting for %s", qn)
+			return &errTimedOut{qn}
 		}
 	}
 	actionDone(c, "\n"+qn+" finished")
@@ -1110,7 +1343,7 @@ func waitDsortHandler(c *cli.Context, id string) error {
 		time.Sleep(refreshRate)
 		total += refreshRate
 		if timeout != 0 && total > timeout {
-			return fmt.Errorf("timed out waiting for %s", qn)
+			return &errTimedOut{qn}
 		}
 	}
 	if total > wasFast {
@@ -1193,6 +1426,49 @@ func removeDsortHandler(c *cli.Context) error {
 	return nil
 }
 
+func removeFinishedHandler(c *cli.Context) error {
+	var (
+		kind string
+		d    time.Duration
+	)
+	if c.NArg() > 0 {
+		kind = c.Args().Get(0)
+		if k, _ := xact.GetKindName(kind); k == "" {
+			if !xact.IsValidUUID(kind) {
+				return fmt.Errorf("invalid job name or ID %q", kind)
+			}
+			return removeFinishedByID(c, kind)
+		}
+	}
+	if flagIsSet(c, olderThanFlag) {
+		d = parseDurationFlag(c, olderThanFlag)
+	}
+	if err := api.GCXactions(apiBP, kind, d); err != nil {
+		return V(err)
+	}
+	what := "jobs"
+	if kind != "" {
+		what = kind + " jobs"
+	}
+	if d > 0 {
+		actionDone(c, "Removed "+what+" that finished more than "+d.String()+" ago")
+	} else {
+		actionDone(c, "Removed all finished "+what)
+	}
+	return nil
+}
+
+func removeFinishedByID(c *cli.Context, xid string) error {
+	if flagIsSet(c, olderThanFlag) {
+		return incorrectUsageMsg(c, "option %s cannot be used with job ID", qflprn(olderThanFlag))
+	}
+	if err := api.GCXaction(apiBP, xid); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Removed job %s", xid))
+	return nil
+}
+
 func removeDsortRegex(c *cli.Context, regex string) error {
 	dsortLst, err := api.ListDsort(apiBP, regex, false /*onlyActive*/)
 	if err != nil {
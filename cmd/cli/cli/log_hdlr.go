@@ -30,6 +30,9 @@ var (
 			longRunFlags,
 			logSevFlag,
 			logFlushFlag,
+			logFollowFlag,
+			logLinesFlag,
+			regexFlag,
 		),
 		commandGet: append(
 			longRunFlags,
@@ -42,12 +45,21 @@ var (
 	// 'show log' and 'log show'
 	showCmdLog = cli.Command{
 		Name: cmdLog,
-		Usage: fmt.Sprintf("for a given node: show its current log (use %s to update, %s for details)",
-			qflprn(refreshFlag), qflprn(cli.HelpFlag)),
-		ArgsUsage:    showLogArgument,
-		Flags:        nodeLogFlags[commandShow],
-		Action:       showNodeLogHandler,
-		BashComplete: suggestAllNodes,
+		Usage: fmt.Sprintf("for a given node: show its current log (use %s to update, %s for details), e.g.:\n",
+			qflprn(refreshFlag), qflprn(cli.HelpFlag)) +
+			indent4 + "\t - 'ais log show NODE_ID' - show the specified node's current log;\n" +
+			indent4 + "\t - 'ais log show cluster' - merge recent logs from all nodes, sort by timestamp, prefix with node IDs;\n" +
+			indent4 + "\t - 'ais log show cluster t[abc] t[xyz] --severity e --regex xs' - same, for selected nodes and filtered;\n" +
+			indent4 + "\t - 'ais log show cluster --follow' - keep showing newly logged records (merged) from all nodes",
+		ArgsUsage: showLogArgument,
+		Flags:     nodeLogFlags[commandShow],
+		Action:    showNodeLogHandler,
+		BashComplete: func(c *cli.Context) {
+			if c.NArg() == 0 {
+				fmt.Println(clusterCompletion)
+			}
+			suggestAllNodes(c)
+		},
 	}
 	getCmdLog = cli.Command{
 		Name: commandGet,
@@ -80,6 +92,13 @@ var (
 )
 
 func showNodeLogHandler(c *cli.Context) error {
+	if c.Args().Get(0) == clusterCompletion {
+		return showClusterLogHandler(c)
+	}
+	if flagIsSet(c, logFollowFlag) || flagIsSet(c, regexFlag) {
+		return fmt.Errorf("flags %s and %s require merged (cluster-wide) view, see %s for details",
+			qflprn(logFollowFlag), qflprn(regexFlag), qflprn(cli.HelpFlag))
+	}
 	return _currentLog(c)
 }
 
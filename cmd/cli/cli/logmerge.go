@@ -0,0 +1,208 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais log show cluster` (merged view).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/urfave/cli"
+)
+
+// Cluster-wide merged log:
+// - fetch current logs from all (or selected) nodes in parallel;
+// - split them into records, whereby each record starts with a standard 'I|W|E hh:mm:ss.uuuuuu' header
+//   and includes all subsequent (continuation) lines, if any;
+// - filter records (`--regex`), sort them by timestamp, prefix each with its node ID, and show the last `--lines`;
+// - with `--follow`, keep fetching (and merging) newly logged records at a given interval.
+//
+// Limitations:
+// - log timestamps do not include date: merging across midnight is not supported;
+// - when following, records are merged (sorted) within each polling interval.
+
+const (
+	logFollowDflt = 2 * time.Second
+	logStampLen   = len("I 15:04:05.000000")
+)
+
+type (
+	logRec struct {
+		sname string
+		stamp string // 'hh:mm:ss.uuuuuu'
+		text  []byte
+	}
+	logNode struct {
+		si     *meta.Snode
+		sname  string
+		offset int64
+	}
+)
+
+func showClusterLogHandler(c *cli.Context) error {
+	sev, err := parseLogSev(c)
+	if err != nil {
+		return err
+	}
+	var re *regexp.Regexp
+	if flagIsSet(c, regexFlag) {
+		if re, err = regexp.Compile(parseStrFlag(c, regexFlag)); err != nil {
+			return fmt.Errorf("invalid %s: %v", qflprn(regexFlag), err)
+		}
+	}
+	nodes, err := _logNodes(c)
+	if err != nil {
+		return err
+	}
+	var (
+		width int
+		limit = parseIntFlag(c, logLinesFlag)
+	)
+	for _, n := range nodes {
+		width = max(width, len(n.sname))
+	}
+
+	recs := _mergeLogs(c, nodes, sev, re)
+	if limit > 0 && len(recs) > limit {
+		recs = recs[len(recs)-limit:]
+	}
+	_printLogRecs(c, recs, width)
+	if !flagIsSet(c, logFollowFlag) {
+		return nil
+	}
+
+	interval := logFollowDflt
+	if flagIsSet(c, refreshFlag) {
+		interval = parseDurationFlag(c, refreshFlag)
+	}
+	for {
+		time.Sleep(interval)
+		recs = _mergeLogs(c, nodes, sev, re)
+		_printLogRecs(c, recs, width)
+	}
+}
+
+// all nodes in the cluster, or the ones specified on the command line
+func _logNodes(c *cli.Context) ([]*logNode, error) {
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*logNode
+	if c.NArg() > 1 {
+		for _, arg := range c.Args()[1:] {
+			si, sname, err := getNode(c, arg)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, &logNode{si: si, sname: sname})
+		}
+		return nodes, nil
+	}
+	for _, nodeMap := range []meta.NodeMap{smap.Pmap, smap.Tmap} {
+		for _, si := range nodeMap {
+			nodes = append(nodes, &logNode{si: si, sname: si.StringEx()})
+		}
+	}
+	return nodes, nil
+}
+
+// fetch (from the last offset) and merge
+func _mergeLogs(c *cli.Context, nodes []*logNode, sev string, re *regexp.Regexp) []*logRec {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		recs []*logRec
+	)
+	for _, n := range nodes {
+		wg.Add(1)
+		go func(n *logNode) {
+			defer wg.Done()
+			nrecs, err := n.fetch(sev, re)
+			if err != nil {
+				actionWarn(c, n.sname+" returned error: "+V(err).Error())
+				return
+			}
+			mu.Lock()
+			recs = append(recs, nrecs...)
+			mu.Unlock()
+		}(n)
+	}
+	wg.Wait()
+	sort.SliceStable(recs, func(i, j int) bool { return recs[i].stamp < recs[j].stamp })
+	return recs
+}
+
+func _printLogRecs(c *cli.Context, recs []*logRec, width int) {
+	for _, rec := range recs {
+		fmt.Fprintf(c.App.Writer, "%-*s %s\n", width, rec.sname, rec.text)
+	}
+}
+
+/////////////
+// logNode //
+/////////////
+
+func (n *logNode) fetch(sev string, re *regexp.Regexp) ([]*logRec, error) {
+	var (
+		buf  bytes.Buffer
+		args = api.GetLogInput{Writer: &buf, Severity: sev, Offset: n.offset}
+	)
+	if _, err := api.GetDaemonLog(apiBP, n.si, args); err != nil {
+		// (the log may have been rotated)
+		n.offset = 0
+		return nil, err
+	}
+	b := buf.Bytes()
+	// skip the last (incomplete) line to read it again next time
+	end := bytes.LastIndexByte(b, '\n')
+	if end < 0 {
+		return nil, nil
+	}
+	n.offset += int64(end + 1)
+	return n.parse(b[:end], re), nil
+}
+
+func (n *logNode) parse(b []byte, re *regexp.Regexp) (recs []*logRec) {
+	var rec *logRec
+	add := func() {
+		if rec != nil && (re == nil || re.Match(rec.text)) {
+			recs = append(recs, rec)
+		}
+	}
+	for _, line := range bytes.Split(b, []byte{'\n'}) {
+		if stamp, ok := logStamp(line); ok || rec == nil {
+			add()
+			rec = &logRec{sname: n.sname, stamp: stamp, text: line[:len(line):len(line)]}
+			continue
+		}
+		// continuation
+		rec.text = append(append(rec.text, '\n'), line...)
+	}
+	add()
+	return recs
+}
+
+// parse 'I|W|E hh:mm:ss.uuuuuu' record header
+func logStamp(line []byte) (string, bool) {
+	if len(line) < logStampLen {
+		return "", false
+	}
+	switch line[0] {
+	case 'I', 'W', 'E':
+	default:
+		return "", false
+	}
+	if line[1] != ' ' || line[4] != ':' || line[7] != ':' || line[10] != '.' {
+		return "", false
+	}
+	return string(line[2:logStampLen]), true
+}
@@ -5,6 +5,7 @@
 package cli
 
 import (
+	"bufio"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -21,6 +22,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/core/meta"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 )
 
@@ -323,6 +325,20 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 		propsStr = parseStrFlag(c, objPropsFlag)
 		catOnly  = flagIsSet(c, countAndTimeFlag)
 	)
+	if flagIsSet(c, columnsFlag) {
+		if propsStr != "" {
+			return fmt.Errorf(errFmtExclusive, qflprn(columnsFlag), qflprn(objPropsFlag))
+		}
+		cols, err := lsoColumns(c)
+		if err != nil {
+			return err
+		}
+		names := make([]string, 0, len(cols))
+		for _, col := range cols {
+			names = append(names, strings.ToLower(col.Name))
+		}
+		propsStr = strings.Join(names, apc.LsPropsSepa)
+	}
 	if propsStr != "" {
 		debug.Assert(apc.LsPropsSepa == ",", "',' is documented in 'objPropsFlag' usage and elsewhere")
 		props = splitCsv(propsStr) // split apc.LsPropsSepa
@@ -401,6 +417,14 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 		warn := fmt.Sprintf(errFmtExclusive, qflprn(countAndTimeFlag), qflprn(noFooterFlag))
 		actionWarn(c, warn)
 	}
+	// stream JSON Lines, one page at a time
+	if flagIsSet(c, jsonlFlag) {
+		if catOnly {
+			return fmt.Errorf(errFmtExclusive, qflprn(jsonlFlag), qflprn(countAndTimeFlag))
+		}
+		return lsoJSONL(c, bck, msg, lsargs, lstFilter, maxPages, limit)
+	}
+
 	// list (and immediately show) pages, one page at a time
 	if flagIsSet(c, pagedFlag) {
 		pageCounter, toShow := 0, int(limit)
@@ -468,6 +492,51 @@ func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) erro
 		addCachedCol, bck.IsRemote(), msg.IsFlagSet(apc.LsVerChanged))
 }
 
+// `--jsonl`: list page by page and write out each page's (matching) entries as JSON Lines;
+// memory usage is bounded by the page size regardless of the total number of listed objects
+func lsoJSONL(c *cli.Context, bck cmn.Bck, msg *apc.LsoMsg, lsargs api.ListArgs, lstFilter *lstFilter, maxPages, limit int64) error {
+	var (
+		bw      = bufio.NewWriterSize(c.App.Writer, 64*cos.KiB)
+		enc     = jsoniter.NewEncoder(bw)
+		toShow  = limit
+		numPage int64
+	)
+	for {
+		objList, err := api.ListObjectsPage(apiBP, bck, msg, lsargs)
+		if err != nil {
+			bw.Flush()
+			return lsoErr(msg, err)
+		}
+		entries := objList.Entries
+		if limit > 0 && toShow < int64(len(entries)) {
+			entries = entries[:toShow]
+		}
+		for _, en := range entries {
+			if !lstFilter.and(en) {
+				continue
+			}
+			if err := enc.Encode(en); err != nil {
+				return err
+			}
+		}
+		if err := bw.Flush(); err != nil {
+			return err // e.g., broken pipe
+		}
+
+		if msg.ContinuationToken == "" {
+			return nil
+		}
+		if numPage++; maxPages > 0 && numPage >= maxPages {
+			return nil
+		}
+		if limit > 0 {
+			if toShow -= int64(len(entries)); toShow <= 0 {
+				return nil
+			}
+		}
+	}
+}
+
 func lsoErr(msg *apc.LsoMsg, err error) error {
 	if herr, ok := err.(*cmn.ErrHTTP); ok && msg.IsFlagSet(apc.LsBckPresent) {
 		if herr.TypeCode == "ErrRemoteBckNotFound" {
@@ -521,6 +590,21 @@ func _setPage(c *cli.Context, bck cmn.Bck) (pageSize, maxPages, limit int64, err
 	return
 }
 
+// `--columns`: object properties to show, in order (see teb.ObjectPropsMap)
+func lsoColumns(c *cli.Context) (teb.Columns, error) {
+	cols, err := teb.ParseColumns(parseStrFlag(c, columnsFlag))
+	if err != nil {
+		return nil, incorrectUsageMsg(c, "%v", err)
+	}
+	for _, col := range cols {
+		if _, ok := teb.ObjectPropsMap[strings.ToLower(col.Name)]; !ok {
+			return nil, fmt.Errorf("unknown column %q (expecting one of: %v)",
+				col.Name, cos.StrKVs(teb.ObjectPropsMap).Keys())
+		}
+	}
+	return cols, nil
+}
+
 // NOTE: in addition to CACHED, may also dynamically add STATUS column
 func printLso(c *cli.Context, entries cmn.LsoEntries, lstFilter *lstFilter, props string, _listed *_listed, now int64,
 	addCachedCol, isRemote, addStatusCol bool) error {
@@ -566,7 +650,16 @@ func printLso(c *cli.Context, entries cmn.LsoEntries, lstFilter *lstFilter, prop
 	}
 
 	// otherwise, print names
-	tmpl := teb.LsoTemplate(propsList, hideHeader, addCachedCol, addStatusCol)
+	var tmpl string
+	if flagIsSet(c, columnsFlag) {
+		cols, err := lsoColumns(c)
+		if err != nil {
+			return err
+		}
+		tmpl = teb.LsoColumnsTemplate(cols, hideHeader)
+	} else {
+		tmpl = teb.LsoTemplate(propsList, hideHeader, addCachedCol, addStatusCol)
+	}
 	opts := teb.Opts{AltMap: teb.FuncMapUnits(units, false /*incl. calendar date*/)}
 	if err := teb.Print(matched, tmpl, opts); err != nil {
 		return err
@@ -71,7 +71,9 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 		}
 		msg.LatestVer = flagIsSet(c, latestVerFlag)
 		msg.Sync = flagIsSet(c, syncFlag)
-		msg.ContinueOnError = flagIsSet(c, continueOnErrorFlag)
+	}
+	if err := _iniCopyRetries(c, &msg.CopyBckMsg); err != nil {
+		return err
 	}
 	// 3. start copying/transforming
 	var (
@@ -82,6 +84,12 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 	)
 	if etlName != "" {
 		msg.Name = etlName
+		if msg.Filter, err = parseETLFilter(c); err != nil {
+			return err
+		}
+		if msg.Params, err = parseETLParams(c); err != nil {
+			return err
+		}
 		text = "Transforming objects"
 		xkind = apc.ActETLObjects
 		xid, err = api.ETLMultiObj(apiBP, bckFrom, &msg)
@@ -130,6 +138,7 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 	} else {
 		fmt.Fprint(c.App.Writer, fmtXactSucceeded)
 	}
+	showCopyRetries(c, xid, xkind)
 	return err
 }
 
@@ -237,12 +246,6 @@ func _rmOne(c *cli.Context, shift int) error {
 		return lrCtx.do(c)
 	case objName == "": // 2. all objects
 		if flagIsSet(c, rmrfFlag) {
-			if !flagIsSet(c, yesFlag) {
-				warn := fmt.Sprintf("will remove all objects from %s. The operation cannot be undone!", bck)
-				if ok := confirm(c, "Proceed?", warn); !ok {
-					return nil
-				}
-			}
 			return rmRfAllObjects(c, bck)
 		}
 		return incorrectUsageMsg(c, "use one of: (%s or %s or %s) to indicate _which_ objects to remove",
@@ -308,8 +311,45 @@ func _prefetchOne(c *cli.Context, shift int) error {
 	return lrCtx.do(c)
 }
 
+// `ais job start warm-up`: same as prefetch but for any bucket (ais:// included),
+// and in addition, reading objects that are already present
+func startWarmUpHandler(c *cli.Context) error {
+	if flagIsSet(c, dryRunFlag) {
+		dryRunCptn(c)
+	}
+	if c.NArg() == 0 {
+		return incorrectUsageMsg(c, c.Command.ArgsUsage)
+	}
+	for shift := range c.Args() {
+		if err := _warmUpOne(c, shift); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _warmUpOne(c *cli.Context, shift int) error {
+	uri := preparseBckObjURI(c.Args().Get(shift))
+	bck, objNameOrTmpl, err := parseBckObjURI(c, uri, true /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	if bck.Props, err = headBucket(bck, true /* add */); err != nil {
+		return err
+	}
+	objName, listObjs, tmplObjs, err := parseObjListTemplate(c, objNameOrTmpl)
+	if err != nil {
+		return err
+	}
+	if listObjs == "" && tmplObjs == "" {
+		listObjs = objName
+	}
+	lrCtx := &lrCtx{listObjs, tmplObjs, bck}
+	return lrCtx.do(c)
+}
+
 //
-// lrCtx: evict, rm, prefetch
+// lrCtx: evict, rm, prefetch, warm-up
 //
 
 func (lr *lrCtx) do(c *cli.Context) (err error) {
@@ -341,7 +381,7 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 
 	// 3. do
 	xid, kind, action, errV := lr._do(c, fileList)
-	if err != nil {
+	if errV != nil {
 		return V(errV)
 	}
 
@@ -406,10 +446,46 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 	if err := waitXact(&xargs); err != nil {
 		return err
 	}
+	switch kind {
+	case apc.ActEvictObjects:
+		return lr.evicted(c, &xargs)
+	case apc.ActWarmUp:
+		return lr.warmedUp(c, &xargs)
+	}
 	fmt.Fprint(c.App.Writer, fmtXactSucceeded)
 	return nil
 }
 
+// upon completion: report the number of evicted objects and freed capacity
+func (lr *lrCtx) evicted(c *cli.Context, xargs *xact.ArgsMsg) error {
+	snaps, err := api.QueryXactionSnaps(apiBP, xargs)
+	if err != nil {
+		return V(err)
+	}
+	objs, _, _ := snaps.ObjCounts(xargs.ID)
+	size, _, _ := snaps.ByteCounts(xargs.ID)
+	actionDone(c, fmt.Sprintf("Evicted %d object%s from %s (freed %s)", objs, cos.Plural(int(objs)),
+		lr.bck.Cname(""), cos.ToSizeIEC(size, 2)))
+	return nil
+}
+
+// upon completion: report the number of warmed-up objects, including those that were prefetched
+func (lr *lrCtx) warmedUp(c *cli.Context, xargs *xact.ArgsMsg) error {
+	snaps, err := api.QueryXactionSnaps(apiBP, xargs)
+	if err != nil {
+		return V(err)
+	}
+	objs, _, inObjs := snaps.ObjCounts(xargs.ID)
+	size, _, inSize := snaps.ByteCounts(xargs.ID)
+	msg := fmt.Sprintf("Warmed up %d object%s (%s) in %s", objs, cos.Plural(int(objs)), cos.ToSizeIEC(size, 2),
+		lr.bck.Cname(""))
+	if inObjs > 0 {
+		msg += fmt.Sprintf(", including %d prefetched (%s)", inObjs, cos.ToSizeIEC(inSize, 2))
+	}
+	actionDone(c, msg)
+	return nil
+}
+
 // [DRY-RUN]
 func (lr *lrCtx) dry(c *cli.Context, fileList []string, pt *cos.ParsedTemplate) {
 	if len(fileList) > 0 {
@@ -454,6 +530,15 @@ func (lr *lrCtx) _do(c *cli.Context, fileList []string) (xid, kind, action strin
 		xid, err = api.Prefetch(apiBP, lr.bck, msg)
 		kind = apc.ActPrefetchObjects
 		action = "prefetch"
+	case cmdWarmUp:
+		msg := &apc.WarmUpMsg{
+			ListRange:       apc.ListRange{ObjNames: fileList, Template: lr.tmplObjs},
+			LatestVer:       flagIsSet(c, latestVerFlag),
+			ContinueOnError: flagIsSet(c, continueOnErrorFlag),
+		}
+		xid, err = api.WarmUp(apiBP, lr.bck, msg)
+		kind = apc.ActWarmUp
+		action = "warm up"
 	case commandEvict:
 		if err = ensureRemoteProvider(lr.bck); err != nil {
 			return
@@ -0,0 +1,130 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles multi-object rename (batch mv).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/urfave/cli"
+)
+
+// e.g.:
+// - ais object mv ais://nnn/aaa/ bbb/ --by-prefix
+// - ais object mv ais://nnn --map-file renames.txt --wait
+func mvMultiObj(c *cli.Context) error {
+	if flagIsSet(c, mvByPrefixFlag) && flagIsSet(c, mvMapFileFlag) {
+		return incorrectUsageMsg(c, errFmtExclusive, qflprn(mvByPrefixFlag), qflprn(mvMapFileFlag))
+	}
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	uri := c.Args().Get(0)
+	bck, prefix, err := parseBckObjURI(c, uri, true /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	if !bck.IsAIS() {
+		return incorrectUsageMsg(c, "provider %q not supported", bck.Provider)
+	}
+
+	msg := &apc.RenameObjsMsg{ContinueOnError: flagIsSet(c, continueOnErrorFlag)}
+	if flagIsSet(c, mvMapFileFlag) {
+		if prefix != "" || c.NArg() > 1 {
+			return incorrectUsageMsg(c, "with %s expecting bucket name only (got %q)", qflprn(mvMapFileFlag), c.Args())
+		}
+		if msg.ObjNames, msg.NewNames, err = readRenameMap(parseStrFlag(c, mvMapFileFlag)); err != nil {
+			return err
+		}
+	} else {
+		if c.NArg() < 2 {
+			return missingArgumentsError(c, "new prefix")
+		}
+		if prefix == "" {
+			return incorrectUsageMsg(c, "missing source prefix in %q (to rename bucket, see 'ais bucket mv')", uri)
+		}
+		msg.Prefix, msg.NewPrefix = prefix, c.Args().Get(1)
+	}
+	if err := msg.Validate(); err != nil {
+		return err
+	}
+
+	xid, err := api.RenameMultiObj(apiBP, bck, msg)
+	if err != nil {
+		return V(err)
+	}
+
+	var (
+		_, xname = xact.GetKindName(apc.ActRenameObjects)
+		text     string
+	)
+	if msg.IsList() {
+		n := len(msg.ObjNames)
+		text = fmt.Sprintf("%s: rename %d object%s in %s", xact.Cname(xname, xid), n, cos.Plural(n), bck.Cname(""))
+	} else {
+		text = fmt.Sprintf("%s: rename %s => %s", xact.Cname(xname, xid), bck.Cname(msg.Prefix), bck.Cname(msg.NewPrefix))
+	}
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		fmt.Fprintln(c.App.Writer, text+". "+toMonitorMsg(c, xid, ""))
+		return nil
+	}
+
+	// wait
+	var timeout time.Duration
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	fmt.Fprintln(c.App.Writer, text+" ...")
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActRenameObjects, Timeout: timeout}
+	if err := waitXact(&xargs); err != nil {
+		return err
+	}
+	snaps, err := api.QueryXactionSnaps(apiBP, &xargs)
+	if err != nil {
+		return V(err)
+	}
+	objs, _, _ := snaps.ObjCounts(xid)
+	actionDone(c, fmt.Sprintf("Renamed %d object%s", objs, cos.Plural(int(objs))))
+	return nil
+}
+
+// one (source, new) pair of names per line, whitespace-separated; empty lines and lines
+// that start with '#' are ignored
+func readRenameMap(fname string) (objNames, newNames []string, _ error) {
+	fh, err := os.Open(fname)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer fh.Close()
+
+	scanner := bufio.NewScanner(fh)
+	for i := 1; scanner.Scan(); i++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, nil, fmt.Errorf("%s:%d: expecting (source, new) pair of object names, got %q", fname, i, line)
+		}
+		objNames = append(objNames, fields[0])
+		newNames = append(newNames, fields[1])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	if len(objNames) == 0 {
+		return nil, nil, fmt.Errorf("%s: no object names to rename", fname)
+	}
+	return objNames, newNames, nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/sys"
+	"github.com/NVIDIA/aistore/xact"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
@@ -32,13 +33,24 @@ func promote(c *cli.Context, bck cmn.Bck, objName, fqn string) error {
 		recurs = flagIsSet(c, recursFlag)
 	)
 	args := apc.PromoteArgs{
-		DaemonID:       target,
-		ObjName:        objName,
-		SrcFQN:         fqn,
-		Recursive:      recurs,
-		SrcIsNotFshare: flagIsSet(c, notFshareFlag),
-		OverwriteDst:   flagIsSet(c, overwriteFlag),
-		DeleteSrc:      flagIsSet(c, deleteSrcFlag),
+		DaemonID:        target,
+		ObjName:         objName,
+		SrcFQN:          fqn,
+		Overwrite:       parseStrFlag(c, promoteOwpFlag),
+		Recursive:       recurs,
+		SrcIsNotFshare:  flagIsSet(c, notFshareFlag),
+		OverwriteDst:    flagIsSet(c, overwriteFlag),
+		DeleteSrc:       flagIsSet(c, deleteSrcFlag),
+		ContinueOnError: flagIsSet(c, continueOnErrorFlag),
+	}
+	if args.OverwriteDst && args.Overwrite != "" && args.Overwrite != apc.PromoteOwAlways {
+		return incorrectUsageMsg(c, errFmtExclusive, qflprn(overwriteFlag), qflprn(promoteOwpFlag))
+	}
+	if err := args.Validate(); err != nil {
+		return err
+	}
+	if flagIsSet(c, waitFlag) || flagIsSet(c, waitJobXactFinishedFlag) {
+		return promoteWait(c, bck, &args)
 	}
 	xid, err := api.Promote(apiBP, bck, &args)
 	if err != nil {
@@ -57,6 +69,48 @@ func promote(c *cli.Context, bck cmn.Bck, objName, fqn string) error {
 	return nil
 }
 
+// promote via x-promote (see api.StartPromote), wait for completion, and show the numbers
+func promoteWait(c *cli.Context, bck cmn.Bck, args *apc.PromoteArgs) error {
+	xid, err := api.StartPromote(apiBP, bck, &api.PromoteArgs{
+		SrcFQN:          args.SrcFQN,
+		ObjName:         args.ObjName,
+		Target:          args.DaemonID,
+		Overwrite:       args.OwPolicy(),
+		Recursive:       args.Recursive,
+		DeleteSrc:       args.DeleteSrc,
+		SrcIsNotFshare:  args.SrcIsNotFshare,
+		ContinueOnError: args.ContinueOnError,
+	})
+	if err != nil {
+		return V(err)
+	}
+	_, xname := xact.GetKindName(apc.ActPromote)
+	fmt.Fprintf(c.App.Writer, "%s: promoting %q => %s ...\n", xact.Cname(xname, xid), args.SrcFQN, bck.Cname(args.ObjName))
+
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActPromote}
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		xargs.Timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	if err := waitXact(&xargs); err != nil {
+		return err
+	}
+	prog, err := api.GetPromoteProgress(apiBP, xid)
+	if err != nil {
+		return V(err)
+	}
+	msg := fmt.Sprintf("Promoted %d file%s (%s)", prog.Promoted, cos.Plural(int(prog.Promoted)),
+		teb.FmtSize(prog.Bytes, "", 2))
+	if prog.Skipped > 0 {
+		msg += fmt.Sprintf(", skipped %d (destination exists)", prog.Skipped)
+	}
+	actionDone(c, msg)
+	if prog.Failed > 0 {
+		return fmt.Errorf("failed to promote %d file%s (out of %d); see 'ais show job %s' for details",
+			prog.Failed, cos.Plural(int(prog.Failed)), prog.Scanned, xid)
+	}
+	return nil
+}
+
 func setCustomProps(c *cli.Context, bck cmn.Bck, objName string) (err error) {
 	props := make(cos.StrKVs)
 	propArgs := c.Args().Tail()
@@ -132,9 +186,9 @@ func verbRange(c *cli.Context, wop wop, pt *cos.ParsedTemplate, bck cmn.Bck, tri
 	return verbFobjs(c, wop, allFobjs, bck, ndir, recurs)
 }
 
-func concatObject(c *cli.Context, bck cmn.Bck, objName string, fileNames []string) error {
-	const verb = "Compose"
+func concatObject(c *cli.Context, bck cmn.Bck, objName string, fileNames []string, exists bool) error {
 	var (
+		verb      = "Compose"
 		totalSize int64
 		ndir      int
 		bar       *mpb.Bar
@@ -178,22 +232,14 @@ func concatObject(c *cli.Context, bck cmn.Bck, objName string, fileNames []strin
 		bar = bars[0]
 	}
 	// do
-	var handle string
+	appender := api.NewAppender(apiBP, bck, objName)
 	for _, fsl := range fobjMatrix {
 		for _, f := range fsl {
 			fh, err := cos.NewFileHandle(f.path)
 			if err != nil {
 				return err
 			}
-			appendArgs := api.AppendArgs{
-				BaseParams: apiBP,
-				Bck:        bck,
-				Object:     objName,
-				Reader:     fh,
-				Handle:     handle,
-			}
-			handle, err = api.AppendObject(&appendArgs)
-			if err != nil {
+			if err := appender.Append(fh, f.size); err != nil {
 				return fmt.Errorf("%v. Object not created", err)
 			}
 			if bar != nil {
@@ -205,12 +251,7 @@ func concatObject(c *cli.Context, bck cmn.Bck, objName string, fileNames []strin
 	if progress != nil {
 		progress.Wait()
 	}
-	err := api.FlushObject(&api.FlushArgs{
-		BaseParams: apiBP,
-		Bck:        bck,
-		Object:     objName,
-		Handle:     handle,
-	})
+	cksum, err := appender.Flush(nil)
 	if err != nil {
 		return V(err)
 	}
@@ -220,7 +261,15 @@ func concatObject(c *cli.Context, bck cmn.Bck, objName string, fileNames []strin
 		actionWarn(c, errU.Error())
 		units = ""
 	}
-	fmt.Fprintf(c.App.Writer, "\nCreated %s (size %s)\n", name, teb.FmtSize(totalSize, units, 2))
+	verb = "Created"
+	if exists {
+		verb = "Appended to"
+	}
+	if cksum.IsEmpty() {
+		fmt.Fprintf(c.App.Writer, "\n%s %s (size %s)\n", verb, name, teb.FmtSize(totalSize, units, 2))
+	} else {
+		fmt.Fprintf(c.App.Writer, "\n%s %s (size %s, %s)\n", verb, name, teb.FmtSize(totalSize, units, 2), cksum)
+	}
 	return nil
 }
 
@@ -376,7 +425,9 @@ func propVal(op *cmn.ObjectProps, name string) (v string) {
 }
 
 func rmRfAllObjects(c *cli.Context, bck cmn.Bck) error {
-	objList, err := api.ListObjects(apiBP, bck, nil, api.ListArgs{})
+	msg := &apc.LsoMsg{}
+	msg.AddProps(apc.GetPropsName, apc.GetPropsSize, apc.GetPropsLocation)
+	objList, err := api.ListObjects(apiBP, bck, msg, api.ListArgs{})
 	if err != nil {
 		return err
 	}
@@ -385,6 +436,13 @@ func rmRfAllObjects(c *cli.Context, bck cmn.Bck) error {
 		fmt.Fprintln(c.App.Writer, bck.Cname(""), "is empty, nothing to do.")
 		return nil
 	}
+	if !flagIsSet(c, yesFlag) {
+		imp := rmAllImpact(bck, objList.Entries)
+		warn := fmt.Sprintf("will remove all objects from %s. The operation cannot be undone!", bck)
+		if ok := confirmImpact(c, imp, nil, "Proceed?", warn); !ok {
+			return nil
+		}
+	}
 
 	var (
 		errCh    = make(chan error, 1)
@@ -438,5 +496,8 @@ func rmRfAllObjects(c *cli.Context, bck cmn.Bck) error {
 	warn := fmt.Sprintf("failed to delete %d object%s from %s: (%d deleted, %d error%s)\n", l-cnt, cos.Plural(l-cnt),
 		bck, cnt, errCnt64, cos.Plural(int(errCnt64)))
 	actionWarn(c, warn)
+	if cnt > 0 {
+		return &errPartial{firstErr.Error()}
+	}
 	return firstErr
 }
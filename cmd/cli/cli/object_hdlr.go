@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
@@ -31,7 +32,13 @@ var (
 			nonverboseFlag,
 			yesFlag,
 		),
-		commandRename: {},
+		commandRename: {
+			mvByPrefixFlag,
+			mvMapFileFlag,
+			continueOnErrorFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+		},
 		commandGet: {
 			offsetFlag,
 			lengthFlag,
@@ -82,6 +89,9 @@ var (
 			putObjDfltCksumFlag,
 			// append
 			appendConcatFlag,
+			// partial update
+			writeOffsetFlag,
+			syncCksumFlag,
 		),
 		commandSetCustom: {
 			setNewCustomMDFlag,
@@ -89,12 +99,17 @@ var (
 		commandPromote: {
 			recursFlag,
 			overwriteFlag,
+			promoteOwpFlag,
 			notFshareFlag,
 			deleteSrcFlag,
 			targetIDFlag,
+			continueOnErrorFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
 			verboseFlag,
 		},
 		commandConcat: {
+			appendConcatFlag,
 			recursFlag,
 			unitsFlag,
 			progressFlag,
@@ -137,6 +152,7 @@ var (
 			indent1 + "\t- '--progress': progress bar, to show running counts and sizes of uploaded files;\n" +
 			indent1 + "\t- Ctrl-D: when writing directly from standard input use Ctrl-D to terminate;\n" +
 			indent1 + "\t- '--append' to append (concatenate) files, e.g.: 'ais put docs ais://nnn/all-docs --append';\n" +
+			indent1 + "\t- '--write-offset' to update existing object in place, e.g.: 'ais put ckpt.part ais://nnn/ckpt --write-offset 1GiB';\n" +
 			indent1 + "\t- '--dry-run': see the results without making any changes.\n" +
 			indent1 + "\tNotes:\n" +
 			indent1 + "\t- to write or add files to " + archExts + "-formatted objects (\"shards\"), use 'ais archive'",
@@ -165,9 +181,10 @@ var (
 	}
 	objectCmdConcat = cli.Command{
 		Name: commandConcat,
-		Usage: "append a file, a directory, or multiple files and/or directories\n" +
-			indent1 + "as a new " + objectArgument + " if doesn't exists, and to an existing " + objectArgument + " otherwise, e.g.:\n" +
-			indent1 + "$ ais object concat docs ais://nnn/all-docs ### concatenate all files from docs/ directory.",
+		Usage: "concatenate a file, a directory, or multiple files and/or directories into a new " + objectArgument + ",\n" +
+			indent1 + "or (with " + qflprn(appendConcatFlag) + ") append them to an existing one, e.g.:\n" +
+			indent1 + "$ ais object concat docs ais://nnn/all-docs ### concatenate all files from docs/ directory;\n" +
+			indent1 + "$ ais object concat more-docs ais://nnn/all-docs --append ### append more files to the existing object.",
 		ArgsUsage: concatObjectArgument,
 		Flags:     objectCmdsFlags[commandConcat],
 		Action:    concatHandler,
@@ -221,8 +238,11 @@ var (
 			bucketObjCmdEvict,
 			makeAlias(showCmdObject, "", true, commandShow), // alias for `ais show`
 			{
-				Name:         commandRename,
-				Usage:        "move/rename object",
+				Name: commandRename,
+				Usage: "move/rename object, or multiple objects, e.g.:\n" +
+					indent1 + "\t- 'ais object mv ais://nnn/aaa bbb'\t- rename ais://nnn/aaa as ais://nnn/bbb;\n" +
+					indent1 + "\t- 'ais object mv ais://nnn/aaa/ bbb/ --by-prefix'\t- rename all objects from virtual directory aaa/ as bbb/ (e.g., aaa/1.jpg => bbb/1.jpg);\n" +
+					indent1 + "\t- 'ais object mv ais://nnn --map-file renames.txt'\t- rename the listed objects (each line: source and new name).",
 				ArgsUsage:    renameObjectArgument,
 				Flags:        objectCmdsFlags[commandRename],
 				Action:       mvObjectHandler,
@@ -241,6 +261,9 @@ var (
 )
 
 func mvObjectHandler(c *cli.Context) (err error) {
+	if flagIsSet(c, mvByPrefixFlag) || flagIsSet(c, mvMapFileFlag) {
+		return mvMultiObj(c)
+	}
 	if c.NArg() != 2 {
 		return incorrectUsageMsg(c, "invalid number of arguments")
 	}
@@ -292,6 +315,9 @@ func putHandler(c *cli.Context) error {
 	if flagIsSet(c, appendConcatFlag) {
 		return concatHandler(c)
 	}
+	if flagIsSet(c, writeOffsetFlag) {
+		return patchHandler(c)
+	}
 
 	var a putargs
 	if err := a.parse(c, true /*empty dst oname*/); err != nil {
@@ -438,7 +464,62 @@ func concatHandler(c *cli.Context) (err error) {
 	if _, err = headBucket(bck, false /* don't add */); err != nil {
 		return
 	}
-	return concatObject(c, bck, objName, fileNames)
+	// NOTE: append (as in: api.AppendObject) always appends to the destination, if exists
+	hargs := api.HeadArgs{FltPresence: apc.FltPresentNoProps, Silent: true}
+	_, err = api.HeadObject(apiBP, bck, objName, hargs)
+	exists := err == nil
+	switch {
+	case err != nil && !cmn.IsStatusNotFound(err):
+		return V(err)
+	case exists && !flagIsSet(c, appendConcatFlag):
+		return fmt.Errorf("destination %s already exists (use %s to append to it)", bck.Cname(objName), qflprn(appendConcatFlag))
+	}
+	return concatObject(c, bck, objName, fileNames, exists)
+}
+
+// partial update (ranged write) of an existing object: one file => one object, in place
+func patchHandler(c *cli.Context) error {
+	var a putargs
+	if err := a.parse(c, false /*empty dst oname*/); err != nil {
+		return err
+	}
+	if !a.srcIsRegular() {
+		return incorrectUsageMsg(c, "%s requires a single source file", qflprn(writeOffsetFlag))
+	}
+	offset, err := parseSizeFlag(c, writeOffsetFlag)
+	if err != nil {
+		return err
+	}
+	size := a.src.finfo.Size()
+	msg := fmt.Sprintf("PATCH %q => %s (offset %s, size %s)", a.src.arg, a.dest(),
+		cos.ToSizeIEC(offset, 2), cos.ToSizeIEC(size, 2))
+	if flagIsSet(c, dryRunFlag) {
+		dryRunCptn(c)
+		actionDone(c, msg)
+		return nil
+	}
+	fh, err := cos.NewFileHandle(a.src.abspath)
+	if err != nil {
+		return err
+	}
+	args := api.PatchArgs{
+		BaseParams: apiBP,
+		Bck:        a.dst.bck,
+		Object:     a.dst.oname,
+		Reader:     fh,
+		Offset:     offset,
+		Size:       size,
+		SyncCksum:  flagIsSet(c, syncCksumFlag),
+	}
+	cksum, err := api.PatchObject(&args)
+	if err != nil {
+		return V(err)
+	}
+	if cksum != nil {
+		msg += ", checksum " + cksum.String()
+	}
+	actionDone(c, msg)
+	return nil
 }
 
 func promoteHandler(c *cli.Context) (err error) {
@@ -8,16 +8,24 @@ package cli
 import (
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
+	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 )
 
@@ -35,6 +43,7 @@ var verboseCounters = [...]string{
 	stats.LcacheFlushColdCount,
 	cos.StreamsOutObjCount,
 	cos.StreamsOutObjSize,
+	cos.StreamsOutZeroCopySize,
 	cos.StreamsInObjCount,
 	cos.StreamsInObjSize,
 }
@@ -44,26 +53,42 @@ var (
 		longRunFlags,
 		noHeaderFlag,
 		regexColsFlag,
+		columnsFlag,
 		unitsFlag,
 		averageSizeFlag,
 		nonverboseFlag,
 		verboseFlag,
 	)
+	showPerfTopFlags = append(showPerfFlags, byJobFlag)
+
+	showPerfSubcmds = []cli.Command{
+		showCounters,
+		showThroughput,
+		showLatency,
+		showSlowReqs,
+		showGPU,
+		showCmdMpathCapacity,
+		makeAlias(showCmdDisk, "", true /*silent*/, cmdShowDisk),
+	}
 
 	// `show performance` command
 	showCmdPeformance = cli.Command{
-		Name:      commandPerf,
-		Usage:     showPerfArgument,
-		ArgsUsage: optionalTargetIDArgument,
-		Flags:     showPerfFlags,
-		Action:    showPerfHandler,
-		Subcommands: []cli.Command{
-			showCounters,
-			showThroughput,
-			showLatency,
-			showCmdMpathCapacity,
-			makeAlias(showCmdDisk, "", true /*silent*/, cmdShowDisk),
-		},
+		Name:        commandPerf,
+		Usage:       showPerfArgument,
+		ArgsUsage:   optionalTargetIDArgument,
+		Flags:       showPerfTopFlags,
+		Action:      showPerfHandler,
+		Subcommands: showPerfSubcmds,
+	}
+
+	// top-level `ais performance` - same as above plus built-in load generator (see bench.go)
+	perfCmd = cli.Command{
+		Name:        commandPerf,
+		Usage:       showPerfArgument,
+		ArgsUsage:   optionalTargetIDArgument,
+		Flags:       showPerfTopFlags,
+		Action:      showPerfHandler,
+		Subcommands: append(showPerfSubcmds[:len(showPerfSubcmds):len(showPerfSubcmds)], perfBenchCmd, perfSelftestCmd),
 	}
 	showCounters = cli.Command{
 		Name: cmdShowCounters,
@@ -77,8 +102,9 @@ var (
 		BashComplete: suggestTargets,
 	}
 	showThroughput = cli.Command{
-		Name:         cmdShowThroughput,
-		Usage:        "show GET and PUT throughput, associated (cumulative, average) sizes and counters",
+		Name: cmdShowThroughput,
+		Usage: "show GET and PUT throughput, associated (cumulative, average) sizes and counters,\n" +
+			indent2 + "\tas well as intra-cluster streaming throughput by stream kind (rebalance, EC, copy bucket, etc.)",
 		ArgsUsage:    optionalTargetIDArgument,
 		Flags:        showPerfFlags,
 		Action:       showThroughputHandler,
@@ -92,17 +118,43 @@ var (
 		Action:       showLatencyHandler,
 		BashComplete: suggestTargets,
 	}
+	showSlowReqs = cli.Command{
+		Name: cmdShowSlowReqs,
+		Usage: "show recent GET and PUT requests that took longer than configured 'log.slow_req',\n" +
+			indent2 + "with time spent in each phase: redirect, lock, disk, backend (cold GET or remote PUT), and transmit;\n" +
+			indent2 + "e.g.: 'ais config cluster log.slow_req=500ms' to enable, and '... log.slow_req=0' to disable",
+		ArgsUsage:    optionalTargetIDArgument,
+		Flags:        []cli.Flag{noHeaderFlag, unitsFlag},
+		Action:       showSlowReqsHandler,
+		BashComplete: suggestTargets,
+	}
+	showGPU = cli.Command{
+		Name: cmdShowGPU,
+		Usage: "show GPU utilization, memory, temperature, and power side by side with target CPU and disk utilization,\n" +
+			indent2 + "to compare storage vs compute bottlenecks (GPU stats are optional and disabled by default);\n" +
+			indent2 + "e.g.: 'ais config cluster gpu.source=nvidia-smi' (or 'gpu.source=dcgm') to enable",
+		ArgsUsage:    optionalTargetIDArgument,
+		Flags:        append(longRunFlags, noHeaderFlag, unitsFlag),
+		Action:       showGPUHandler,
+		BashComplete: suggestTargets,
+	}
 	showCmdMpathCapacity = cli.Command{
 		Name:         cmdCapacity,
 		Usage:        "show target mountpaths, disks, and used/available capacity",
 		ArgsUsage:    optionalTargetIDArgument,
-		Flags:        append(showPerfFlags, mountpathFlag),
+		Flags:        append(showPerfFlags, mountpathFlag, capForecastFlag),
 		Action:       showMpathCapHandler,
 		BashComplete: suggestTargets,
 	}
 )
 
 func showPerfHandler(c *cli.Context) error {
+	if flagIsSet(c, byJobFlag) {
+		return showPerfByJobHandler(c)
+	}
+	if flagIsSet(c, columnsFlag) {
+		return incorrectUsageMsg(c, "%s requires a specific view (e.g., '%s %s')", qflprn(columnsFlag), c.Command.Name, cmdShowCounters)
+	}
 	allPerfTabs = true // global (TODO: consider passing as param)
 
 	if c.NArg() > 1 && strings.HasPrefix(c.Args().Get(1), "-") {
@@ -125,7 +177,8 @@ func showPerfHandler(c *cli.Context) error {
 	}
 	fmt.Fprintln(c.App.Writer)
 
-	return nil
+	// (only when configured and available)
+	return showGPUHandler(c)
 }
 
 func _warnThruLatIters(c *cli.Context) {
@@ -157,7 +210,7 @@ func showCountersHandler(c *cli.Context) error {
 			// skip assorted internal counters and sizes, unless verbose
 			//
 			if !flagIsSet(c, verboseFlag) {
-				if cos.StringInSlice(name, verboseCounters[:]) {
+				if cos.StringInSlice(name, verboseCounters[:]) || isStreamKindMetric(name) {
 					continue
 				}
 			}
@@ -167,6 +220,16 @@ func showCountersHandler(c *cli.Context) error {
 	return showPerfTab(c, selected, nil, cmdShowCounters, nil, false)
 }
 
+// per stream kind, e.g. "stream.out.reb.n" (see cos.StreamKinds)
+func isStreamKindMetric(name string) bool {
+	for _, kind := range cos.StreamKinds {
+		if strings.HasPrefix(name, "stream.out."+kind+".") || strings.HasPrefix(name, "stream.in."+kind+".") {
+			return true
+		}
+	}
+	return false
+}
+
 func showThroughputHandler(c *cli.Context) error {
 	var (
 		totals       = make(map[string]int64, 4) // throughput metrics ("columns") to tally up
@@ -395,7 +458,10 @@ func showPerfTab(c *cli.Context, metrics cos.StrKVs, cb perfcb, tag string, tota
 			}
 		}
 
-		out := table.Template(hideHeader)
+		out, err := tableTmpl(c, table, hideHeader)
+		if err != nil {
+			return err
+		}
 		return teb.Print(tstatusMap, out)
 	}
 
@@ -453,7 +519,10 @@ func showPerfTab(c *cli.Context, metrics cos.StrKVs, cb perfcb, tag string, tota
 			return err
 		}
 
-		out := table.Template(hideHeader)
+		out, err := tableTmpl(c, table, hideHeader)
+		if err != nil {
+			return err
+		}
 		err = teb.Print(mapBegin, out)
 		if err != nil || !refresh || allPerfTabs {
 			return err
@@ -481,6 +550,9 @@ func showMpathCapHandler(c *cli.Context) error {
 	if node != nil {
 		tid = node.ID()
 	}
+	if flagIsSet(c, capForecastFlag) {
+		return showCapForecast(c, node, units)
+	}
 	if regexStr != "" {
 		regex, err = regexp.Compile(regexStr)
 		if err != nil {
@@ -498,6 +570,476 @@ func showMpathCapHandler(c *cli.Context) error {
 	ctx := teb.PerfTabCtx{Smap: smap, Sid: tid, Regex: regex, Units: units}
 	table := teb.NewMpathCapTab(tstatusMap, &ctx, showMpaths)
 
-	out := table.Template(hideHeader)
+	out, err := tableTmpl(c, table, hideHeader)
+	if err != nil {
+		return err
+	}
 	return teb.Print(tstatusMap, out)
 }
+
+//
+// capacity forecast (see fs/capfcast.go); per-bucket estimate is cluster-wide:
+// total headroom (capacity below high watermark, all targets) divided by the bucket's growth rate
+//
+
+type capFcastBck struct {
+	used, rate int64
+}
+
+func showCapForecast(c *cli.Context, node *meta.Snode, units string) error {
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	targets := smap.Tmap
+	if node != nil {
+		targets = meta.NodeMap{node.ID(): node}
+	}
+	var (
+		tids     = make([]string, 0, len(targets))
+		fcasts   = make(map[string]*fs.CapFcast, len(targets))
+		bcks     = make(map[string]*capFcastBck, 8)
+		headroom int64
+		span     time.Duration
+		nsnaps   int
+	)
+	for tid, tsi := range targets {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		out, err := api.GetAnyStats(apiBP, tid, apc.WhatCapForecast)
+		if err != nil {
+			return V(err)
+		}
+		fcast := &fs.CapFcast{}
+		if err := jsoniter.Unmarshal(out, fcast); err != nil {
+			return fmt.Errorf("%s: failed to parse capacity forecast: %v", tsi.StringEx(), err)
+		}
+		tids = append(tids, tid)
+		fcasts[tid] = fcast
+		span = max(span, time.Duration(fcast.To-fcast.From))
+		nsnaps = max(nsnaps, fcast.NumSnaps)
+		for _, trend := range fcast.Mpaths {
+			hwm := int64(trend.Used+trend.Avail) / 100 * fcast.HighWM
+			headroom += max(hwm-int64(trend.Used), 0)
+		}
+		for cname, trend := range fcast.Bcks {
+			b, ok := bcks[cname]
+			if !ok {
+				b = &capFcastBck{}
+				bcks[cname] = b
+			}
+			b.used += int64(trend.Used)
+			b.rate += trend.Rate
+		}
+	}
+	if nsnaps < 3 {
+		fmt.Fprintln(c.App.Writer, "Not enough data yet (capacity snapshots are taken hourly) - please try again later")
+		return nil
+	}
+	sort.Strings(tids)
+	fmt.Fprintf(c.App.Writer, "Trends over the last %s (up to %d hourly snapshots per target):\n\n",
+		teb.FormatDuration(span.Round(time.Minute)), nsnaps)
+
+	hideHeader := flagIsSet(c, noHeaderFlag)
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !hideHeader {
+		fmt.Fprintln(tw, "TARGET\tMOUNTPATH\tUSED\tAVAIL\tUSED(%)\tGROWTH/DAY\tDAYS TO HIGH-WM")
+	}
+	for _, tid := range tids {
+		fcast := fcasts[tid]
+		mpaths := make([]string, 0, len(fcast.Mpaths))
+		for mpath := range fcast.Mpaths {
+			mpaths = append(mpaths, mpath)
+		}
+		sort.Strings(mpaths)
+		for _, mpath := range mpaths {
+			trend := fcast.Mpaths[mpath]
+			var pct uint64
+			if total := trend.Used + trend.Avail; total > 0 {
+				pct = trend.Used * 100 / total
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d%%\t%s\t%s\n", meta.Tname(tid), mpath,
+				teb.FmtSize(int64(trend.Used), units, 2), teb.FmtSize(int64(trend.Avail), units, 2), pct,
+				_fmtGrowth(trend.Rate, units), _fmtDays(trend.HwmETA.Hours()/24))
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if len(bcks) == 0 {
+		return nil
+	}
+
+	cnames := make([]string, 0, len(bcks))
+	for cname := range bcks {
+		cnames = append(cnames, cname)
+	}
+	sort.Strings(cnames)
+	fmt.Fprintln(c.App.Writer)
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !hideHeader {
+		fmt.Fprintln(tw, "BUCKET\tSIZE ON DISK\tGROWTH/DAY\tDAYS TO HIGH-WM")
+	}
+	for _, cname := range cnames {
+		var (
+			b    = bcks[cname]
+			days float64
+		)
+		if b.rate > 0 {
+			days = float64(headroom) / float64(b.rate)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", cname, teb.FmtSize(b.used, units, 2), _fmtGrowth(b.rate, units), _fmtDays(days))
+	}
+	return tw.Flush()
+}
+
+func _fmtGrowth(rate int64, units string) string {
+	switch {
+	case rate > 0:
+		return "+" + teb.FmtSize(rate, units, 1)
+	case rate < 0:
+		return "-" + teb.FmtSize(-rate, units, 1)
+	default:
+		return teb.NotSetVal
+	}
+}
+
+func _fmtDays(days float64) string {
+	if days <= 0 || days > 10*365 {
+		return teb.NotSetVal
+	}
+	return fmt.Sprintf("%.1f", days)
+}
+
+//
+// per-job breakdown: attribute disk and network throughput to running jobs (xactions), on the one hand,
+// and user GETs and PUTs, on the other
+//
+// NOTE: the numbers are derived from the jobs' own (locally processed, sent, and received) byte counters;
+// a job that starts and finishes in-between two consecutive samples won't show up
+//
+
+type jobSample struct {
+	snaps  xact.MultiSnap
+	tstats teb.StstMap
+}
+
+func showPerfByJobHandler(c *cli.Context) error {
+	var (
+		tid         string
+		hideHeader  = flagIsSet(c, noHeaderFlag)
+		refresh     = flagIsSet(c, refreshFlag)
+		sleep       = _refreshRate(c)
+		units, errU = parseUnitsFlag(c, unitsFlag)
+	)
+	if errU != nil {
+		return errU
+	}
+	node, _, err := arg0Node(c)
+	if err != nil {
+		return err
+	}
+	if node != nil {
+		if !node.IsTarget() {
+			return fmt.Errorf("%s: expecting target ID", node.StringEx())
+		}
+		tid = node.ID()
+	}
+	if sleep < time.Second || sleep > time.Minute {
+		return fmt.Errorf("invalid %s value, got %v, expecting [1s - 1m]", qflprn(refreshFlag), sleep)
+	}
+
+	begin, err := _jobSample(c, tid)
+	if err != nil {
+		return err
+	}
+	cntRun := &longRun{}
+	cntRun.init(c, true /*run once unless*/)
+	for countdown := cntRun.count; countdown > 0 || cntRun.isForever(); countdown-- {
+		time.Sleep(sleep)
+		end, err := _jobSample(c, tid)
+		if err != nil {
+			return err
+		}
+		jobs := _byJob(begin, end, tid, sleep)
+		perfCptn(c, "by-job")
+		if len(jobs) == 0 {
+			actionNote(c, "no disk or network activity attributed to running jobs and user GETs and PUTs\n")
+		} else {
+			table := teb.NewJobPerfTab(jobs, units)
+			out, err := tableTmpl(c, table, hideHeader)
+			if err != nil {
+				return err
+			}
+			if err := teb.Print(nil, out); err != nil {
+				return err
+			}
+		}
+		if !refresh {
+			break
+		}
+		begin = end
+	}
+	return nil
+}
+
+func _jobSample(c *cli.Context, tid string) (*jobSample, error) {
+	snaps, _, err := queryXactions(&xact.ArgsMsg{DaemonID: tid, OnlyRunning: true}, false /*summarize*/)
+	if err != nil {
+		return nil, err
+	}
+	_, tstats, _, err := fillNodeStatusMap(c, apc.Target)
+	if err != nil {
+		return nil, err
+	}
+	return &jobSample{snaps: snaps, tstats: tstats}, nil
+}
+
+// compute per-second deltas and aggregate across targets (by job ID)
+func _byJob(begin, end *jobSample, tid string, elapsed time.Duration) []*teb.JobPerf {
+	var (
+		seconds = max(int64(elapsed.Seconds()), 1)
+		byID    = make(map[string]*teb.JobPerf, 8)
+		prev    = make(map[string]*core.Snap, 16) // by (target ID + xaction ID)
+	)
+	for t, snaps := range begin.snaps {
+		for _, snap := range snaps {
+			prev[t+snap.ID] = snap
+		}
+	}
+	for t, snaps := range end.snaps {
+		for _, snap := range snaps {
+			var b core.Stats // (zero when started in-between)
+			if p, ok := prev[t+snap.ID]; ok {
+				b = p.Stats
+			}
+			e := &snap.Stats
+			jp, ok := byID[snap.ID]
+			if !ok {
+				_, xname := xact.GetKindName(snap.Kind)
+				jp = &teb.JobPerf{Name: xact.Cname(xname, snap.ID)}
+				if !snap.Bck.IsEmpty() {
+					jp.Bck = snap.Bck.Cname("")
+				}
+				byID[snap.ID] = jp
+			}
+			jp.Bytes += max(e.Bytes-b.Bytes, 0) / seconds
+			jp.OutBytes += max(e.OutBytes-b.OutBytes, 0) / seconds
+			jp.InBytes += max(e.InBytes-b.InBytes, 0) / seconds
+		}
+	}
+
+	// user GETs (read from disk and sent) and PUTs (received and written)
+	var (
+		get = &teb.JobPerf{Name: "user GET"}
+		put = &teb.JobPerf{Name: "user PUT"}
+	)
+	for t, ds := range end.tstats {
+		if tid != "" && t != tid {
+			continue
+		}
+		bs, ok := begin.tstats[t]
+		if !ok || ds.Tracker == nil || bs.Tracker == nil {
+			continue
+		}
+		if n := max(ds.Tracker[stats.GetSize].Value-bs.Tracker[stats.GetSize].Value, 0) / seconds; n > 0 {
+			get.Bytes += n
+			get.OutBytes += n
+		}
+		if n := max(ds.Tracker[stats.PutSize].Value-bs.Tracker[stats.PutSize].Value, 0) / seconds; n > 0 {
+			put.Bytes += n
+			put.InBytes += n
+		}
+	}
+
+	jobs := make([]*teb.JobPerf, 0, len(byID)+2)
+	for _, jp := range byID {
+		if jp.Total() > 0 {
+			jobs = append(jobs, jp)
+		}
+	}
+	if get.Total() > 0 {
+		jobs = append(jobs, get)
+	}
+	if put.Total() > 0 {
+		jobs = append(jobs, put)
+	}
+	return jobs
+}
+
+//
+// slow requests
+//
+
+type slowReq struct {
+	stats.SlowReq
+	tid string
+}
+
+func showSlowReqsHandler(c *cli.Context) error {
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	node, _, err := arg0Node(c)
+	if err != nil {
+		return err
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	targets := smap.Tmap
+	if node != nil {
+		debug.Assert(node.IsTarget())
+		targets = meta.NodeMap{node.ID(): node}
+	}
+	var recs []slowReq
+	for tid, tsi := range targets {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		out, err := api.GetAnyStats(apiBP, tid, apc.WhatSlowReqs)
+		if err != nil {
+			return V(err)
+		}
+		var srs []stats.SlowReq
+		if err := jsoniter.Unmarshal(out, &srs); err != nil {
+			return fmt.Errorf("%s: failed to parse slow requests: %v", tsi.StringEx(), err)
+		}
+		for i := range srs {
+			recs = append(recs, slowReq{srs[i], tid})
+		}
+	}
+	if len(recs) == 0 {
+		fmt.Fprintln(c.App.Writer, "No slow requests (hint: check 'ais config cluster log.slow_req')")
+		return nil
+	}
+	sort.Slice(recs, func(i, j int) bool { return recs[i].Time > recs[j].Time }) // most recent first
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "TIME\tTARGET\tMETHOD\tOBJECT\tSIZE\tTOTAL\tREDIRECT\tLOCK\tDISK\tBACKEND\tTRANSMIT")
+	}
+	for i := range recs {
+		rec := &recs[i]
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			cos.FormatNanoTime(rec.Time, time.DateTime), meta.Tname(rec.tid), rec.Method, rec.Cname,
+			teb.FmtSize(rec.Size, units, 2),
+			teb.FormatDuration(rec.Total),
+			_slowPhase(rec.Redirect),
+			_slowPhase(rec.Lock),
+			_slowPhase(rec.Disk),
+			_slowPhase(rec.Backend),
+			_slowPhase(rec.Transmit))
+	}
+	return tw.Flush()
+}
+
+func _slowPhase(d time.Duration) string {
+	if d == 0 {
+		return teb.NotSetVal
+	}
+	return teb.FormatDuration(d)
+}
+
+//
+// GPU
+//
+
+func showGPUHandler(c *cli.Context) error {
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	node, _, err := arg0Node(c)
+	if err != nil {
+		return err
+	}
+	if node != nil && !node.IsTarget() {
+		return fmt.Errorf("%s is not a target", node.StringEx())
+	}
+	if !allPerfTabs {
+		setLongRunParams(c)
+	}
+	_, tstatusMap, _, err := fillNodeStatusMap(c, apc.Target)
+	if err != nil {
+		return err
+	}
+	var (
+		tids  = make([]string, 0, len(tstatusMap))
+		ngpus int
+	)
+	for tid, ds := range tstatusMap {
+		if node != nil && tid != node.ID() {
+			continue
+		}
+		tids = append(tids, tid)
+		ngpus += len(ds.MemCPUInfo.GPUs)
+	}
+	if ngpus == 0 {
+		if !allPerfTabs {
+			fmt.Fprintln(c.App.Writer, "No GPU stats (hint: 'ais config cluster gpu.source=nvidia-smi' or 'gpu.source=dcgm')")
+		}
+		return nil
+	}
+	sort.Strings(tids)
+
+	if allPerfTabs {
+		perfCptn(c, cmdShowGPU)
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "TARGET\tCPU(%)\tDISK UTIL(%)\tGPU\tMODEL\tGPU UTIL(%)\tGPU MEM USED\tGPU MEM TOTAL\tTEMP(C)\tPOWER(W)")
+	}
+	for _, tid := range tids {
+		var (
+			ds    = tstatusMap[tid]
+			tname = meta.Tname(tid)
+			cpu   = fmt.Sprintf("%.1f", ds.MemCPUInfo.PctCPUUsed)
+			util  = _maxDiskUtil(ds)
+		)
+		if len(ds.MemCPUInfo.GPUs) == 0 {
+			fmt.Fprintf(tw, "%s\t%s\t%s%s\n", tname, cpu, util, strings.Repeat("\t"+teb.NotSetVal, 7))
+			continue
+		}
+		for i := range ds.MemCPUInfo.GPUs {
+			g := &ds.MemCPUInfo.GPUs[i]
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\t%.0f\t%s\t%s\t%s\t%s\n", tname, cpu, util,
+				g.Index, g.Name, g.PctUtil,
+				teb.FmtSize(int64(g.MemUsed), units, 2), teb.FmtSize(int64(g.MemTotal), units, 2),
+				_gpuVal(float64(g.Temp), "%.0f"), _gpuVal(g.Power, "%.1f"))
+			tname, cpu, util = "", "", "" // (once per target)
+		}
+	}
+	return tw.Flush()
+}
+
+// max utilization across target's disks, e.g. "disk.nvme0n1.util"
+func _maxDiskUtil(ds *stats.NodeStatus) string {
+	var (
+		util  int64
+		found bool
+	)
+	for name, v := range ds.Tracker {
+		if strings.HasPrefix(name, "disk.") && strings.HasSuffix(name, ".util") {
+			util = max(util, v.Value)
+			found = true
+		}
+	}
+	if !found {
+		return teb.NotSetVal
+	}
+	return strconv.FormatInt(util, 10)
+}
+
+func _gpuVal(v float64, format string) string {
+	if v == 0 {
+		return teb.NotSetVal // (not supported or not reported)
+	}
+	return fmt.Sprintf(format, v)
+}
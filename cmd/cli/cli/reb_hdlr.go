@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -17,22 +18,35 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/reb"
 	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 )
 
 const (
 	showRebHdr = "REB ID\t NODE\t OBJECTS RECV\t SIZE RECV\t OBJECTS SENT\t SIZE SENT\t START\t END\t STATE"
+	rebEstHdr  = "TARGET\t OBJECTS SEND\t SIZE SEND\t OBJECTS RECV\t SIZE RECV\t SKIPPED (EC)\t PROJECTED\t TOOK"
+	rebHistHdr = "REB ID\t CAUSE\t START\t DURATION\t TARGETS\t OBJECTS SENT\t SIZE SENT\t OBJECTS RECV\t SIZE RECV\t ERRORS\t STATE"
 )
 
-type targetRebSnap struct {
-	tid  string
-	snap *core.Snap
-}
+type (
+	targetRebSnap struct {
+		tid  string
+		snap *core.Snap
+	}
+	// rebalance run: summaries aggregated across targets
+	rebHistRun struct {
+		reb.RunSummary
+		ntargets int
+	}
+)
 
 var (
-	showRebFlags = append(longRunFlags, allJobsFlag, noHeaderFlag, unitsFlag, dateTimeFlag)
+	showRebFlags = append(longRunFlags, allJobsFlag, noHeaderFlag, unitsFlag, dateTimeFlag, rebHistoryFlag)
 
 	showCmdRebalance = cli.Command{
 		Name:      cmdRebalance,
@@ -61,6 +75,10 @@ func showRebalanceHandler(c *cli.Context) error {
 	}
 	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
 
+	if flagIsSet(c, rebHistoryFlag) {
+		return showRebHistory(c, tw, units, datedTime, hideHeader)
+	}
+
 	// [REB_ID] [NODE_ID]
 	if c.NArg() > 0 {
 		arg := c.Args().Get(0)
@@ -204,3 +222,353 @@ func displayRebStats(tw *tabwriter.Writer, st *targetRebSnap, units string, date
 		startTime, endTime, teb.FmtXactStatus(st.snap),
 	)
 }
+
+// [NODE_ID]
+func showRebHistory(c *cli.Context, tw *tabwriter.Writer, units string, datedTime, hideHeader bool) error {
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	targets := smap.Tmap
+	if c.NArg() > 0 {
+		node, sname, err := getNode(c, c.Args().Get(0))
+		if err != nil {
+			return err
+		}
+		if !node.IsTarget() {
+			return fmt.Errorf("%s is not a target (rebalance history is only kept by targets)", sname)
+		}
+		targets = meta.NodeMap{node.ID(): node}
+	}
+
+	runs := make(map[string]*rebHistRun, 16)
+	for tid, tsi := range targets {
+		out, err := api.GetAnyStats(apiBP, tid, apc.WhatRebHistory)
+		if err != nil {
+			if tsi.InMaintOrDecomm() {
+				continue
+			}
+			return V(err)
+		}
+		var hist []*reb.RunSummary
+		if err := jsoniter.Unmarshal(out, &hist); err != nil {
+			return fmt.Errorf("%s: failed to parse rebalance history: %v", tsi.StringEx(), err)
+		}
+		for _, sum := range hist {
+			run, ok := runs[sum.ID]
+			if !ok {
+				runs[sum.ID] = &rebHistRun{RunSummary: *sum, ntargets: 1}
+				continue
+			}
+			run.merge(sum)
+		}
+	}
+	if len(runs) == 0 {
+		fmt.Fprintln(c.App.Writer, "No rebalance history.")
+		return nil
+	}
+
+	sorted := make([]*rebHistRun, 0, len(runs))
+	for _, run := range runs {
+		sorted = append(sorted, run)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		idi, _ := xact.S2RebID(sorted[i].ID)
+		idj, _ := xact.S2RebID(sorted[j].ID)
+		return idi < idj
+	})
+
+	if !hideHeader {
+		fmt.Fprintln(tw, rebHistHdr)
+	}
+	for _, run := range sorted {
+		var (
+			started  = time.Unix(0, run.Started)
+			duration = time.Duration(run.Ended - run.Started)
+			startStr = teb.FmtTime(started)
+			cause    = run.Cause
+			errs     = "-"
+			state    = "completed"
+		)
+		if cause == apc.ActRebalance {
+			cause += " (user-requested)"
+		}
+		if datedTime {
+			startStr = teb.FmtDateTime(started)
+		}
+		if run.ErrCnt > 0 {
+			errs = strconv.Itoa(run.ErrCnt)
+		}
+		if run.Aborted {
+			state = "aborted"
+		}
+		fmt.Fprintf(tw, "%s\t %s\t %s\t %s\t %d\t %d\t %s\t %d\t %s\t %s\t %s\n",
+			run.ID, cause, startStr, teb.FormatDuration(duration.Round(time.Millisecond)), run.ntargets,
+			run.SentObjs, teb.FmtSize(run.SentBytes, units, 2),
+			run.RecvObjs, teb.FmtSize(run.RecvBytes, units, 2),
+			errs, state)
+	}
+	tw.Flush()
+
+	// abort reasons, if any
+	for _, run := range sorted {
+		if run.Aborted && run.AbortErr != "" {
+			fmt.Fprintf(c.App.Writer, "%s: %s\n", fcyan(run.ID), run.AbortErr)
+		}
+	}
+	return nil
+}
+
+func (run *rebHistRun) merge(sum *reb.RunSummary) {
+	run.ntargets++
+	if run.Cause == "" {
+		run.Cause = sum.Cause
+	}
+	run.Started = min(run.Started, sum.Started)
+	run.Ended = max(run.Ended, sum.Ended)
+	run.SentObjs += sum.SentObjs
+	run.SentBytes += sum.SentBytes
+	run.RecvObjs += sum.RecvObjs
+	run.RecvBytes += sum.RecvBytes
+	run.ErrCnt += sum.ErrCnt
+	if sum.Aborted && !run.Aborted {
+		run.Aborted = true
+		run.AbortErr = sum.AbortErr
+	}
+}
+
+// `ais start rebalance --estimate-only [--exclude NODE_ID]`
+func estimateRebalance(c *cli.Context) error {
+	var exclude string
+	if flagIsSet(c, rebExcludeFlag) {
+		node, sname, err := getNode(c, parseStrFlag(c, rebExcludeFlag))
+		if err != nil {
+			return err
+		}
+		if !node.IsTarget() {
+			return fmt.Errorf("%s is not a target", sname)
+		}
+		exclude = node.ID()
+	}
+	units, err := parseUnitsFlag(c, unitsFlag)
+	if err != nil {
+		return err
+	}
+	plan, err := api.EstimateRebalance(apiBP, exclude)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(plan, "", teb.Jopts(true))
+	}
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, rebEstHdr)
+	for _, est := range plan.Targets {
+		var (
+			name      = est.TargetID
+			projected = "unknown"
+			skipped   = "-"
+		)
+		if est.TargetID == exclude {
+			name += " (excluded)"
+		}
+		if est.Duration > 0 {
+			projected = teb.FormatDuration(time.Duration(est.Duration).Round(time.Second))
+		}
+		if est.SkippedEC.Objs > 0 {
+			skipped = fmt.Sprintf("%d (%s)", est.SkippedEC.Objs, teb.FmtSize(est.SkippedEC.Bytes, units, 2))
+		}
+		fmt.Fprintf(tw, "%s\t %d\t %s\t %d\t %s\t %s\t %s\t %s\n",
+			name, est.Send.Objs, teb.FmtSize(est.Send.Bytes, units, 2),
+			est.Recv.Objs, teb.FmtSize(est.Recv.Bytes, units, 2),
+			skipped, projected, teb.FormatDuration(time.Duration(est.Took).Round(time.Millisecond)))
+	}
+	tw.Flush()
+
+	fmt.Fprintln(c.App.Writer)
+	if plan.Move.Objs == 0 {
+		fmt.Fprintf(c.App.Writer, "Nothing to move: all %d objects are properly placed (cluster map v%d)\n",
+			plan.Total.Objs, plan.SmapVersion)
+		return nil
+	}
+	fmt.Fprintf(c.App.Writer, "Rebalance would move %d out of %d objects (%s out of %s)\n",
+		plan.Move.Objs, plan.Total.Objs, teb.FmtSize(plan.Move.Bytes, units, 2), teb.FmtSize(plan.Total.Bytes, units, 2))
+	if plan.Duration > 0 {
+		fmt.Fprintf(c.App.Writer, "Projected duration: %s (based on the most recent completed rebalance)\n",
+			teb.FormatDuration(time.Duration(plan.Duration).Round(time.Second)))
+	} else {
+		fmt.Fprintln(c.App.Writer, "Projected duration: unknown (no rebalance history)")
+	}
+	return nil
+}
+
+//
+// `ais cluster add-remove-nodes rebalance-status`: post-change gate
+//
+
+// (a single poll)
+type rebGate struct {
+	pending  []string // what's still in progress
+	rebID    int64    // the most recent rebalance
+	abortErr string   // ditto, if aborted
+	running  int      // targets running rebalance
+	smapVer  int64
+	rmdVer   int64
+	sent     core.Stats // rebalance progress (sum)
+}
+
+// Block until all data movement triggered by recent membership changes is done, and placement is verified:
+// - no decommissioning targets (removed from the cluster map upon rebalance);
+// - no targets in maintenance that are still transitioning to post-rebalance state;
+// - no target rebalancing or resilvering, no unresponsive targets;
+// - cluster map and rebalance metadata (RMD) remain unchanged between two consecutive polls;
+// - finally, unless '--skip-verify': `estimate-only` pass confirming that no object is misplaced.
+// Returns error (non-zero exit) upon timeout, aborted or interrupted rebalance, or misplaced objects.
+func rebStatusHandler(c *cli.Context) error {
+	var (
+		timeout time.Duration
+		sleep   = _refreshRate(c)
+		quiet   = flagIsSet(c, nonverboseFlag)
+		started = time.Now()
+		prev    *rebGate
+	)
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	for {
+		curSmap = nil // always fresh
+		gate, err := pollRebGate(c)
+		if err != nil {
+			return err
+		}
+		elapsed := time.Since(started).Round(time.Second)
+		if len(gate.pending) > 0 {
+			if !quiet {
+				fmt.Fprintf(c.App.Writer, "[%s] %s\n", teb.FormatDuration(elapsed), gate.progress())
+			}
+			prev = nil
+		} else if prev != nil && prev.smapVer == gate.smapVer && prev.rmdVer == gate.rmdVer {
+			// settled
+			if gate.abortErr != "" {
+				return fmt.Errorf("rebalance %s aborted: %s (to restart, run 'ais cluster rebalance start')",
+					xact.RebID2S(gate.rebID), gate.abortErr)
+			}
+			if flagIsSet(c, rebSkipVerifyFlag) {
+				actionDone(c, fmt.Sprintf("Done: no data movement in progress (cluster map v%d)", gate.smapVer))
+				return nil
+			}
+			done, err := verifyPlacement(c, gate)
+			if done || err != nil {
+				return err
+			}
+			prev = nil // cluster map changed while verifying
+		} else {
+			prev = gate
+		}
+		if timeout > 0 && time.Since(started)+sleep > timeout {
+			what := "cluster to settle"
+			if len(gate.pending) > 0 {
+				what = strings.Join(gate.pending, ", ")
+			}
+			return fmt.Errorf("timed out after %s waiting for %s", teb.FormatDuration(elapsed), what)
+		}
+		time.Sleep(sleep)
+	}
+}
+
+func pollRebGate(c *cli.Context) (*rebGate, error) {
+	smap, tstatusMap, _, err := fillNodeStatusMap(c, apc.Target)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		interrupted string
+		gate        = &rebGate{smapVer: smap.Version}
+	)
+	for tid, tsi := range smap.Tmap {
+		switch {
+		case tsi.Flags.IsSet(meta.SnodeDecomm):
+			gate.pending = append(gate.pending, "decommissioning "+tsi.StringEx())
+			continue
+		case tsi.InMaint():
+			if !tsi.Flags.IsSet(meta.SnodeMaintPostReb) && isRebalancing(tstatusMap) {
+				gate.pending = append(gate.pending, "putting "+tsi.StringEx()+" in maintenance")
+			}
+			continue
+		}
+		ds, ok := tstatusMap[tid]
+		if !ok || ds.Version == "" {
+			gate.pending = append(gate.pending, tsi.StringEx()+" not responding")
+			continue
+		}
+		gate.rmdVer = max(gate.rmdVer, ds.Cluster.RMD.Version)
+		flags := ds.Cluster.Flags
+		if flags.IsSet(cos.Resilvering) {
+			gate.pending = append(gate.pending, tsi.StringEx()+" resilvering")
+		}
+		if flags.IsSet(cos.RebalanceInterrupted) {
+			interrupted = tsi.StringEx()
+		}
+		snap := ds.RebSnap
+		if snap == nil {
+			continue
+		}
+		switch {
+		case snap.RebID > gate.rebID:
+			gate.rebID, gate.abortErr, gate.running, gate.sent = snap.RebID, "", 0, core.Stats{}
+		case snap.RebID < gate.rebID:
+			continue
+		}
+		if snap.IsAborted() {
+			gate.abortErr = cos.Left(snap.AbortErr, "interrupted")
+		} else if snap.EndTime.IsZero() {
+			gate.running++
+		}
+		gate.sent.OutObjs += snap.Stats.OutObjs
+		gate.sent.OutBytes += snap.Stats.OutBytes
+		gate.sent.InObjs += snap.Stats.InObjs
+		gate.sent.InBytes += snap.Stats.InBytes
+	}
+	if gate.running > 0 {
+		gate.pending = append(gate.pending, "rebalance "+xact.RebID2S(gate.rebID))
+	} else if interrupted != "" && gate.abortErr == "" {
+		gate.abortErr = "interrupted on " + interrupted
+	}
+	return gate, nil
+}
+
+func (gate *rebGate) progress() string {
+	if gate.running == 0 {
+		return "waiting for " + strings.Join(gate.pending, ", ")
+	}
+	s := fmt.Sprintf("rebalance %s running on %d target%s: sent %d objects (%s), received %d objects (%s)",
+		xact.RebID2S(gate.rebID), gate.running, cos.Plural(gate.running),
+		gate.sent.OutObjs, teb.FmtSize(gate.sent.OutBytes, "", 2),
+		gate.sent.InObjs, teb.FmtSize(gate.sent.InBytes, "", 2))
+	if len(gate.pending) > 1 {
+		s += "; also waiting for " + strings.Join(gate.pending[:len(gate.pending)-1], ", ")
+	}
+	return s
+}
+
+// returns true when done (successfully or not)
+func verifyPlacement(c *cli.Context, gate *rebGate) (bool, error) {
+	if !flagIsSet(c, nonverboseFlag) {
+		fmt.Fprintf(c.App.Writer, "Verifying placement (cluster map v%d)...\n", gate.smapVer)
+	}
+	plan, err := api.EstimateRebalance(apiBP, "")
+	if err != nil {
+		return true, V(err)
+	}
+	if plan.SmapVersion != gate.smapVer {
+		return false, nil
+	}
+	if plan.Move.Objs > 0 {
+		return true, fmt.Errorf("%d out of %d objects (%s) are misplaced (to rebalance, run 'ais cluster rebalance start')",
+			plan.Move.Objs, plan.Total.Objs, teb.FmtSize(plan.Move.Bytes, "", 2))
+	}
+	actionDone(c, fmt.Sprintf("Done: all %d objects are properly placed (cluster map v%d)", plan.Total.Objs, plan.SmapVersion))
+	return true, nil
+}
@@ -8,6 +8,8 @@ package cli
 import (
 	"fmt"
 
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/urfave/cli"
 )
@@ -23,6 +25,9 @@ var (
 			paritySlicesFlag,
 			nonverboseFlag,
 		},
+		cmdECVerify: {
+			jsonFlag,
+		},
 	}
 
 	storageSvcCmds = []cli.Command{
@@ -44,6 +49,17 @@ var (
 			BashComplete: bucketCompletions(bcmplop{}),
 		},
 	}
+
+	// 'ais storage ec-verify' (and 'ais ec-verify')
+	storageCmdECVerify = cli.Command{
+		Name: cmdECVerify,
+		Usage: "check integrity of a given erasure-coded object: read and checksum all its slices and replicas,\n" +
+			indent4 + "\tcheck parity math (without restoring the object), and report missing and corrupted slices (if any)",
+		ArgsUsage:    objectArgument,
+		Flags:        storageSvcCmdsFlags[cmdECVerify],
+		Action:       ecVerifyHandler,
+		BashComplete: bucketCompletions(bcmplop{separator: true}),
+	}
 )
 
 func setCopiesHandler(c *cli.Context) (err error) {
@@ -94,3 +110,38 @@ func ecEncodeHandler(c *cli.Context) (err error) {
 
 	return ecEncode(c, bck, dataSlices, paritySlices)
 }
+
+func ecVerifyHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, objName, err := parseBckObjURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	res, err := api.ECVerifyObject(apiBP, bck, objName)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(res, "", teb.Opts{UseJSON: true})
+	}
+	layout := fmt.Sprintf("%d data + %d parity slices", res.DataSlices, res.ParitySlices)
+	if res.IsCopy {
+		layout = fmt.Sprintf("%d replicas", res.ParitySlices+1)
+	}
+	fmt.Fprintf(c.App.Writer, "%s (size %s, %s):\n", res.Cname, teb.FmtSize(res.Size, "", 2), layout)
+	if err := teb.Print(res.CTs, teb.ECVerifyTmpl); err != nil {
+		return err
+	}
+	parity := res.Parity
+	if res.ParityErr != "" {
+		parity += " (" + res.ParityErr + ")"
+	}
+	fmt.Fprintln(c.App.Writer, "Parity:", parity)
+	if !res.OK() {
+		return fmt.Errorf("%s: integrity check failed", res.Cname)
+	}
+	actionDone(c, res.Cname+": OK")
+	return nil
+}
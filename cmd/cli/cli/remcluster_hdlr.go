@@ -5,12 +5,161 @@
  */
 package cli
 
-import "github.com/urfave/cli"
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/urfave/cli"
+)
 
 var remClusterCmd = cli.Command{
 	Name:  cmdShowRemoteAIS,
 	Usage: "show attached AIS clusters",
 	Subcommands: []cli.Command{
 		makeAlias(showCmdRemoteAIS, "", true, commandShow), // alias for `ais show`
+		{
+			Name: cmdRemAisPing,
+			Usage: "measure latency from each target in this cluster to each node of each attached cluster:\n" +
+				indent1 + "remote proxies (control plane) and remote targets (data plane); failures are highlighted\n" +
+				indent1 + "(use it to debug slow cross-cluster copying and transformation)",
+			ArgsUsage:    pingRemoteAISArgument,
+			Flags:        []cli.Flag{noHeaderFlag, jsonFlag},
+			Action:       pingRemoteAISHandler,
+			BashComplete: suggestRemote,
+		},
 	},
 }
+
+func pingRemoteAISHandler(c *cli.Context) error {
+	var (
+		filter = c.Args().Get(0)
+		found  bool
+	)
+	all, err := api.PingRemoteAIS(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(all, "", teb.Jopts(true))
+	}
+	if len(all) == 0 {
+		return fmt.Errorf("no targets in the cluster %s", apiBP.URL)
+	}
+
+	// local targets (rows)
+	tids := make([]string, 0, len(all))
+	for tid := range all {
+		tids = append(tids, tid)
+	}
+	sort.Strings(tids)
+
+	// remote clusters, as seen by the first target that has them
+	clusters := make(map[string]*meta.RemAisPing, 4)
+	for _, tid := range tids {
+		for _, rp := range all[tid] {
+			if _, ok := clusters[rp.UUID]; !ok {
+				clusters[rp.UUID] = rp
+			}
+		}
+	}
+	if len(clusters) == 0 {
+		actionWarn(c, "no remote clusters attached (see 'ais cluster remote-attach --help')")
+		return nil
+	}
+	uuids := make([]string, 0, len(clusters))
+	for uuid := range clusters {
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+
+	for _, uuid := range uuids {
+		rp := clusters[uuid]
+		if filter != "" && filter != uuid && filter != rp.Alias {
+			continue
+		}
+		if found {
+			fmt.Fprintln(c.App.Writer)
+		}
+		found = true
+		actionCptn(c, rp.Alias+"["+uuid+"]", ":")
+		if rp.Err != "" {
+			actionWarn(c, fmt.Sprintf("failed to get %s cluster map: %s", rp.Alias, rp.Err))
+		}
+		var pids, rtids []string
+		for _, np := range rp.Nodes {
+			if np.Proxy {
+				pids = append(pids, np.ID)
+			} else {
+				rtids = append(rtids, np.ID)
+			}
+		}
+		errs := make([]string, 0, 4)
+		errs = _pingMatrix(c, "CONTROL PLANE (proxies)", uuid, pids, tids, all, errs)
+		errs = _pingMatrix(c, "DATA PLANE (targets)", uuid, rtids, tids, all, errs)
+		for _, e := range errs {
+			fmt.Fprintln(c.App.Writer, fred("Error: ")+e)
+		}
+	}
+	if !found {
+		return fmt.Errorf("remote cluster %q not found (see 'ais show remote-cluster')", filter)
+	}
+	return nil
+}
+
+// rows: local targets; columns: given remote nodes
+func _pingMatrix(c *cli.Context, plane, uuid string, rids, tids []string, all map[string][]*meta.RemAisPing,
+	errs []string) []string {
+	if len(rids) == 0 {
+		return errs
+	}
+	sort.Strings(rids)
+
+	fmt.Fprintln(c.App.Writer)
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, plane)
+		fmt.Fprintln(tw, "TARGET\t"+strings.Join(rids, "\t"))
+	}
+	for _, tid := range tids {
+		var (
+			nodes = _pingNodes(all[tid], uuid)
+			row   = make([]string, 0, len(rids)+1)
+		)
+		row = append(row, tid)
+		for _, rid := range rids {
+			np, ok := nodes[rid]
+			switch {
+			case !ok:
+				row = append(row, teb.UnknownStatusVal)
+			case np.Err != "":
+				row = append(row, fred("FAIL"))
+				errs = append(errs, fmt.Sprintf("%s => %s (%s): %s", tid, rid, np.URL, np.Err))
+			default:
+				row = append(row, teb.FormatDuration(time.Duration(np.Latency)))
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	tw.Flush()
+	return errs
+}
+
+func _pingNodes(rps []*meta.RemAisPing, uuid string) map[string]*meta.RemAisNodePing {
+	for _, rp := range rps {
+		if rp.UUID == uuid {
+			nodes := make(map[string]*meta.RemAisNodePing, len(rp.Nodes))
+			for _, np := range rp.Nodes {
+				nodes[np.ID] = np
+			}
+			return nodes
+		}
+	}
+	return nil
+}
@@ -33,6 +33,7 @@ var (
 		commandCopy:     {"copy", "replicate", "backup"},
 		commandGet:      {"fetch", "read", "download"},
 		commandPrefetch: {"load", "preload", "warmup", "cache", "get"},
+		cmdWarmUp:       {"prefetch", "preload", "warmup", "cache", "page-cache"},
 		commandMirror:   {"protect", "replicate", "copy"},
 		commandECEncode: {"protect", "encode", "replicate", "erasure-code"},
 		commandStart:    {"do", "run", "execute"},
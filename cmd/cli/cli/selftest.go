@@ -0,0 +1,412 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais performance selftest`.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/config"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/urfave/cli"
+)
+
+// Self-test: short controlled measurements that establish cluster performance baseline:
+// - disk:     every target mountpath (sequential write and read, random reads) - all targets at once;
+// - net:      intra-cluster transport throughput between each (ordered) pair of targets -
+//             one sending target at a time;
+// - redirect: proxy redirect latency - GET requests that are not followed to the designated target.
+// The first report for a given cluster is saved as its baseline (in the CLI config directory);
+// subsequent runs are compared against it (see `--save` to replace).
+
+const (
+	selftestDir      = "selftest" // (under CLI config dir)
+	selftestRedirect = "redirect"
+	selftestObjName  = "selftest-redirect" // (does not need to exist)
+
+	selftestRegress = 10 // percentage change (for the worse) to highlight
+)
+
+type (
+	redirectSelftest struct {
+		Bucket string `json:"bucket"`
+		Err    string `json:"err,omitempty"`
+		Count  int    `json:"count"`
+		Avg    int64  `json:"avg,string"` // ns
+		P50    int64  `json:"p50,string"`
+		P99    int64  `json:"p99,string"`
+	}
+	selftestReport struct {
+		Time     time.Time                      `json:"time"`
+		Redirect *redirectSelftest              `json:"redirect,omitempty"`
+		Targets  map[string]*apc.TargetSelftest `json:"targets"`
+		Cluster  string                         `json:"cluster"` // UUID
+	}
+)
+
+var perfSelftestCmd = cli.Command{
+	Name: cmdPerfSelftest,
+	Usage: "run short controlled measurements: target-to-target transport throughput, sequential and random IO\n" +
+		indent1 + "on each mountpath, and proxy redirect latency; show the report and compare it with the saved baseline, e.g.:\n" +
+		indent1 + "\t- 'ais performance selftest' - use any existing ais:// bucket to measure redirect latency;\n" +
+		indent1 + "\t- 'ais performance selftest ais://nnn --mpath-size 1GiB --save' - update the baseline;\n" +
+		indent1 + "\t- 'ais performance selftest --skip net,redirect' - disks only",
+	ArgsUsage: optionalBucketArgument,
+	Flags: []cli.Flag{
+		perfSelftestMpathSizeFlag,
+		perfSelftestXferSizeFlag,
+		perfSelftestCountFlag,
+		perfSelftestSkipFlag,
+		perfSelftestSaveFlag,
+		unitsFlag,
+	},
+	Action:       perfSelftestHandler,
+	BashComplete: bucketCompletions(bcmplop{}),
+}
+
+func perfSelftestHandler(c *cli.Context) error {
+	var (
+		skip = make(cos.StrSet, 3)
+		msg  = apc.SelftestMsg{}
+		err  error
+	)
+	if s := parseStrFlag(c, perfSelftestSkipFlag); s != "" {
+		for _, what := range splitCsv(s) {
+			switch what {
+			case apc.SelftestDisk, apc.SelftestNet, selftestRedirect:
+				skip.Add(what)
+			default:
+				return fmt.Errorf("invalid %s=%q (expecting comma-separated %q, %q, and/or %q)",
+					flprn(perfSelftestSkipFlag), s, apc.SelftestDisk, apc.SelftestNet, selftestRedirect)
+			}
+		}
+	}
+	if msg.MpathSize, err = cos.ParseSize(parseStrFlag(c, perfSelftestMpathSizeFlag), cos.UnitsIEC); err != nil {
+		return fmt.Errorf("invalid %s: %v", flprn(perfSelftestMpathSizeFlag), err)
+	}
+	if msg.XferSize, err = cos.ParseSize(parseStrFlag(c, perfSelftestXferSizeFlag), cos.UnitsIEC); err != nil {
+		return fmt.Errorf("invalid %s: %v", flprn(perfSelftestXferSizeFlag), err)
+	}
+	var (
+		bck *cmn.Bck
+		cnt = parseIntFlag(c, perfSelftestCountFlag)
+	)
+	if !skip.Contains(selftestRedirect) {
+		if cnt <= 0 {
+			return fmt.Errorf("invalid %s=%d (expecting positive integer)", flprn(perfSelftestCountFlag), cnt)
+		}
+		if bck, err = selftestBucket(c); err != nil {
+			return err
+		}
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	tsis := make(meta.Nodes, 0, len(smap.Tmap))
+	for _, si := range smap.Tmap {
+		if !si.InMaintOrDecomm() {
+			tsis = append(tsis, si)
+		}
+	}
+	if len(tsis) == 0 {
+		return fmt.Errorf("%s: no active targets", smap)
+	}
+	sort.Slice(tsis, func(i, j int) bool { return tsis[i].ID() < tsis[j].ID() })
+
+	report := &selftestReport{Cluster: smap.UUID, Time: time.Now(), Targets: make(map[string]*apc.TargetSelftest, len(tsis))}
+	for _, si := range tsis {
+		report.Targets[si.ID()] = &apc.TargetSelftest{}
+	}
+
+	// 1. disks: all targets at once
+	if !skip.Contains(apc.SelftestDisk) {
+		fmt.Fprintf(c.App.Writer, "Running disk self-test on %d target%s (%s per mountpath)...\n",
+			len(tsis), cos.Plural(len(tsis)), cos.ToSizeIEC(msg.MpathSize, 0))
+		msg.What = apc.SelftestDisk
+		var wg sync.WaitGroup
+		for _, si := range tsis {
+			wg.Add(1)
+			go func(si *meta.Snode) {
+				defer wg.Done()
+				res, err := api.Selftest(apiBP, si, &msg)
+				if err != nil {
+					actionWarn(c, si.StringEx()+": "+V(err).Error())
+					return
+				}
+				report.Targets[si.ID()].Mpaths = res.Mpaths // (distinct keys)
+			}(si)
+		}
+		wg.Wait()
+	}
+
+	// 2. network: one sending target at a time
+	if !skip.Contains(apc.SelftestNet) && len(tsis) > 1 {
+		msg.What = apc.SelftestNet
+		for _, si := range tsis {
+			fmt.Fprintf(c.App.Writer, "Running network self-test: %s => %d target%s (%s each)...\n",
+				si.StringEx(), len(tsis)-1, cos.Plural(len(tsis)-1), cos.ToSizeIEC(msg.XferSize, 0))
+			res, err := api.Selftest(apiBP, si, &msg)
+			if err != nil {
+				actionWarn(c, si.StringEx()+": "+V(err).Error())
+				continue
+			}
+			report.Targets[si.ID()].Peers = res.Peers
+		}
+	}
+
+	// 3. redirect
+	if bck != nil {
+		report.Redirect = runSelftestRedirect(c, bck, cnt)
+	}
+
+	// compare and save
+	var (
+		base   = &selftestReport{}
+		dir    = filepath.Join(config.ConfigDir, selftestDir)
+		fname  = smap.UUID + ".json"
+		exists = true
+	)
+	if err := jsp.LoadAppConfig(dir, fname, base); err != nil {
+		if !os.IsNotExist(err) {
+			actionWarn(c, "failed to load baseline: "+err.Error())
+		}
+		base, exists = nil, false
+	}
+	fmt.Fprintln(c.App.Writer)
+	report.print(c, base)
+
+	if exists && !flagIsSet(c, perfSelftestSaveFlag) {
+		fmt.Fprintln(c.App.Writer)
+		actionNote(c, fmt.Sprintf("compared with the baseline from %s (use %s to replace it)",
+			base.Time.Format(time.RFC822), qflprn(perfSelftestSaveFlag)))
+		return nil
+	}
+	if err := jsp.SaveAppConfig(dir, fname, report); err != nil {
+		return fmt.Errorf("failed to save baseline: %v", err)
+	}
+	fmt.Fprintln(c.App.Writer)
+	actionDone(c, "Saved as baseline: "+filepath.Join(dir, fname))
+	return nil
+}
+
+//
+// redirect
+//
+
+// the bucket to GET from: specified or any existing ais:// bucket (nil when none)
+func selftestBucket(c *cli.Context) (*cmn.Bck, error) {
+	if c.NArg() > 0 {
+		bck, err := parseBckURI(c, c.Args().Get(0), false)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := headBucket(bck, false /* don't add */); err != nil {
+			return nil, err
+		}
+		return &bck, nil
+	}
+	bcks, err := api.ListBuckets(apiBP, cmn.QueryBcks{Provider: apc.AIS}, apc.FltPresent)
+	if err != nil {
+		return nil, V(err)
+	}
+	if len(bcks) == 0 {
+		actionNote(c, "no ais:// buckets - skipping proxy redirect self-test (hint: specify bucket)")
+		return nil, nil
+	}
+	return &bcks[0], nil
+}
+
+func runSelftestRedirect(c *cli.Context, bck *cmn.Bck, cnt int) *redirectSelftest {
+	fmt.Fprintf(c.App.Writer, "Running proxy redirect self-test: %d GET request%s to %s...\n", cnt, cos.Plural(cnt), bck.Cname(""))
+
+	var (
+		res    = &redirectSelftest{Bucket: bck.Cname("")}
+		lat    = make([]time.Duration, 0, cnt)
+		client = *apiBP.Client // (shallow copy)
+		u      = apiBP.URL + apc.URLPathObjects.Join(bck.Name, selftestObjName) + "?" + bck.NewQuery().Encode()
+	)
+	client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	for range cnt {
+		req, err := http.NewRequest(http.MethodGet, u, http.NoBody)
+		if err != nil {
+			res.Err = err.Error()
+			break
+		}
+		api.SetAuxHeaders(req, &apiBP)
+		started := time.Now()
+		resp, err := client.Do(req)
+		if err != nil {
+			res.Err = err.Error()
+			break
+		}
+		elapsed := time.Since(started)
+		cos.DrainReader(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode < http.StatusMultipleChoices || resp.StatusCode >= http.StatusBadRequest {
+			res.Err = "expected redirect, got " + resp.Status
+			break
+		}
+		lat = append(lat, elapsed)
+	}
+	if len(lat) == 0 {
+		if res.Err == "" {
+			res.Err = "no samples"
+		}
+		return res
+	}
+	sort.Slice(lat, func(i, j int) bool { return lat[i] < lat[j] })
+	var total time.Duration
+	for _, l := range lat {
+		total += l
+	}
+	res.Count = len(lat)
+	res.Avg = int64(total) / int64(len(lat))
+	res.P50, res.P99 = int64(_pctl(lat, 50)), int64(_pctl(lat, 99))
+	return res
+}
+
+//
+// report
+//
+
+func (report *selftestReport) print(c *cli.Context, base *selftestReport) {
+	var (
+		units, _ = parseUnitsFlag(c, unitsFlag)
+		tw       = &tabwriter.Writer{}
+		tids     = make([]string, 0, len(report.Targets))
+		hasDisk  bool
+		hasNet   bool
+	)
+	for tid, tres := range report.Targets {
+		tids = append(tids, tid)
+		hasDisk = hasDisk || len(tres.Mpaths) > 0
+		hasNet = hasNet || len(tres.Peers) > 0
+	}
+	sort.Strings(tids)
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+
+	if hasDisk {
+		fmt.Fprintln(tw, "TARGET\tMOUNTPATH\tWRITE\tREAD\tRANDOM READ IOPS\tRANDOM READ LATENCY")
+		for _, tid := range tids {
+			mpaths := report.Targets[tid].Mpaths
+			sort.Slice(mpaths, func(i, j int) bool { return mpaths[i].Mountpath < mpaths[j].Mountpath })
+			for i := range mpaths {
+				mp := &mpaths[i]
+				if mp.Err != "" {
+					fmt.Fprintf(tw, "%s\t%s\t%s\t-\t-\t-\n", tid, mp.Mountpath, fred("error: ")+mp.Err)
+					continue
+				}
+				var bmp *apc.MpathSelftest
+				if btres := base.target(tid); btres != nil {
+					for j := range btres.Mpaths {
+						if btres.Mpaths[j].Mountpath == mp.Mountpath && btres.Mpaths[j].Err == "" {
+							bmp = &btres.Mpaths[j]
+						}
+					}
+				}
+				if bmp == nil {
+					bmp = &apc.MpathSelftest{}
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s/s%s\t%s/s%s\t%d%s\t%s%s\n", tid, mp.Mountpath,
+					teb.FmtSize(mp.WriteBps, units, 2), _delta(mp.WriteBps, bmp.WriteBps, false),
+					teb.FmtSize(mp.ReadBps, units, 2), _delta(mp.ReadBps, bmp.ReadBps, false),
+					mp.RandIOPS, _delta(mp.RandIOPS, bmp.RandIOPS, false),
+					teb.FormatDuration(time.Duration(mp.RandLat)), _delta(mp.RandLat, bmp.RandLat, true))
+			}
+		}
+		tw.Flush()
+	}
+
+	if hasNet {
+		if hasDisk {
+			fmt.Fprintln(c.App.Writer)
+		}
+		fmt.Fprintln(tw, "FROM\tTO\tTHROUGHPUT")
+		for _, tid := range tids {
+			peers := report.Targets[tid].Peers
+			sort.Slice(peers, func(i, j int) bool { return peers[i].ID < peers[j].ID })
+			for i := range peers {
+				peer := &peers[i]
+				if peer.Err != "" {
+					fmt.Fprintf(tw, "%s\t%s\t%s\n", tid, peer.ID, fred("error: ")+peer.Err)
+					continue
+				}
+				var bps int64
+				if btres := base.target(tid); btres != nil {
+					for j := range btres.Peers {
+						if btres.Peers[j].ID == peer.ID && btres.Peers[j].Err == "" {
+							bps = btres.Peers[j].Bps
+						}
+					}
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s/s%s\n", tid, peer.ID, teb.FmtSize(peer.Bps, units, 2), _delta(peer.Bps, bps, false))
+			}
+		}
+		tw.Flush()
+	}
+
+	if rd := report.Redirect; rd != nil {
+		if hasDisk || hasNet {
+			fmt.Fprintln(c.App.Writer)
+		}
+		fmt.Fprintln(tw, "REDIRECT (BUCKET)\tCOUNT\tAVG\tP50\tP99")
+		if rd.Err != "" && rd.Count == 0 {
+			fmt.Fprintf(tw, "%s\t%s\t-\t-\t-\n", rd.Bucket, fred("error: ")+rd.Err)
+		} else {
+			brd := &redirectSelftest{}
+			if base != nil && base.Redirect != nil && base.Redirect.Err == "" {
+				brd = base.Redirect
+			}
+			fmt.Fprintf(tw, "%s\t%d\t%s%s\t%s%s\t%s%s\n", rd.Bucket, rd.Count,
+				teb.FormatDuration(time.Duration(rd.Avg)), _delta(rd.Avg, brd.Avg, true),
+				teb.FormatDuration(time.Duration(rd.P50)), _delta(rd.P50, brd.P50, true),
+				teb.FormatDuration(time.Duration(rd.P99)), _delta(rd.P99, brd.P99, true))
+		}
+		tw.Flush()
+		if rd.Err != "" && rd.Count > 0 {
+			actionWarn(c, "redirect self-test: "+rd.Err)
+		}
+	}
+	if !hasDisk && !hasNet && report.Redirect == nil {
+		actionWarn(c, "nothing to show")
+	}
+}
+
+func (report *selftestReport) target(tid string) *apc.TargetSelftest {
+	if report == nil {
+		return nil
+	}
+	return report.Targets[tid]
+}
+
+// change vs baseline, if any; highlight when worse by more than selftestRegress percent
+func _delta(cur, base int64, lowerIsBetter bool) string {
+	if base == 0 {
+		return ""
+	}
+	pct := float64(cur-base) * 100 / float64(base)
+	s := fmt.Sprintf(" (%+.0f%%)", pct)
+	if lowerIsBetter {
+		pct = -pct
+	}
+	if pct < -selftestRegress {
+		return fred(s)
+	}
+	return s
+}
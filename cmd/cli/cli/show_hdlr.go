@@ -8,6 +8,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"net"
 	"regexp"
 	"sort"
 	"strconv"
@@ -19,11 +20,14 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/certloader"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ec"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 )
@@ -50,11 +54,13 @@ var (
 			regexJobsFlag,
 			noHeaderFlag,
 			verboseJobFlag,
+			perNodeJobFlag,
 			unitsFlag,
 			dateTimeFlag,
 			// download and dsort only
 			progressFlag,
 			dsortLogFlag,
+			dloadScheduledFlag,
 		),
 		cmdObject: {
 			objPropsFlag, // --props [list]
@@ -68,6 +74,7 @@ var (
 			longRunFlags,
 			jsonFlag,
 			noHeaderFlag,
+			columnsFlag,
 			unitsFlag,
 			nonverboseFlag,
 		),
@@ -81,6 +88,18 @@ var (
 			jsonFlag,
 			noHeaderFlag,
 		),
+		cmdShowTLS: {
+			jsonFlag,
+			noHeaderFlag,
+		},
+		cmdShowAlerts: {
+			jsonFlag,
+			noHeaderFlag,
+		},
+		cmdShowNetwork: {
+			jsonFlag,
+			noHeaderFlag,
+		},
 		cmdBucket: {
 			jsonFlag,
 			compactPropFlag,
@@ -90,6 +109,7 @@ var (
 		cmdConfig: {
 			jsonFlag,
 			noHeaderFlag,
+			diffDefaultsFlag,
 		},
 		cmdShowRemoteAIS: {
 			noHeaderFlag,
@@ -168,6 +188,28 @@ var (
 				Flags:     showCmdsFlags[cmdConfig],
 				Action:    showClusterConfigHandler,
 			},
+			{
+				Name:         cmdShowTLS,
+				Usage:        "show TLS certificates currently used by all (or selected) nodes, and their expiration",
+				ArgsUsage:    optionalNodeIDArgument,
+				Flags:        showCmdsFlags[cmdShowTLS],
+				Action:       showClusterTLSHandler,
+				BashComplete: suggestAllNodes,
+			},
+			{
+				Name:         cmdShowNetwork,
+				Usage:        "show network interfaces (NICs) used by all (or selected) nodes for public, intra-cluster control, and intra-cluster data",
+				ArgsUsage:    optionalNodeIDArgument,
+				Flags:        showCmdsFlags[cmdShowNetwork],
+				Action:       showClusterNetworkHandler,
+				BashComplete: suggestAllNodes,
+			},
+			{
+				Name:   cmdShowAlerts,
+				Usage:  "show active (firing and pending) and recently resolved alerts (see 'ais config cluster alerts')",
+				Flags:  showCmdsFlags[cmdShowAlerts],
+				Action: showClusterAlertsHandler,
+			},
 			makeAlias(showCmdPeformance, cliName+" "+commandShow+" "+commandPerf, false /*silent*/, cmdShowStats),
 		},
 	}
@@ -338,6 +380,9 @@ func _showJobs(c *cli.Context, name, xid, daemonID string, bck cmn.Bck, caption
 }
 
 func showDownloads(c *cli.Context, id string, caption bool) (int, error) {
+	if flagIsSet(c, dloadScheduledFlag) {
+		return downloadSchedList(c)
+	}
 	if id == "" { // list all download jobs
 		return downloadJobsList(c, parseStrFlag(c, regexJobsFlag), caption)
 	}
@@ -507,37 +552,54 @@ func xlistByKindID(c *cli.Context, xargs *xact.ArgsMsg, caption bool, xs xact.Mu
 		units = ""
 	}
 	opts := teb.Opts{AltMap: teb.FuncMapUnits(units, datedTime), UseJSON: usejs}
-	switch xargs.Kind {
-	case apc.ActECGet:
-		if hideHeader {
-			err = teb.Print(dts, teb.XactECGetNoHdrTmpl, opts)
-		} else {
-			err = teb.Print(dts, teb.XactECGetTmpl, opts)
-		}
-	case apc.ActECPut:
+
+	// multi-target job: cluster-wide summary (one row) unless '--per-node' (or JSON, or a given node)
+	// (EC extended stats are per target)
+	rollup := l > 1 && !usejs && xargs.DaemonID == "" && !flagIsSet(c, perNodeJobFlag) &&
+		xargs.Kind != apc.ActECGet && xargs.Kind != apc.ActECPut
+	if rollup {
+		r := xrollup(dts)
 		if hideHeader {
-			err = teb.Print(dts, teb.XactECPutNoHdrTmpl, opts)
+			err = teb.Print([]*teb.XactRollup{r}, teb.XactNoHdrRollupTmpl, opts)
 		} else {
-			err = teb.Print(dts, teb.XactECPutTmpl, opts)
+			err = teb.Print([]*teb.XactRollup{r}, teb.XactRollupTmpl, opts)
 		}
-	default:
-		switch {
-		case fromToBck && hideHeader:
-			err = teb.Print(dts, teb.XactNoHdrFromToTmpl, opts)
-		case fromToBck:
-			err = teb.Print(dts, teb.XactFromToTmpl, opts)
-		case haveBck && hideHeader:
-			err = teb.Print(dts, teb.XactNoHdrBucketTmpl, opts)
-		case haveBck:
-			err = teb.Print(dts, teb.XactBucketTmpl, opts)
-		default:
+	} else {
+		switch xargs.Kind {
+		case apc.ActECGet:
+			if hideHeader {
+				err = teb.Print(dts, teb.XactECGetNoHdrTmpl, opts)
+			} else {
+				err = teb.Print(dts, teb.XactECGetTmpl, opts)
+			}
+		case apc.ActECPut:
 			if hideHeader {
-				err = teb.Print(dts, teb.XactNoHdrNoBucketTmpl, opts)
+				err = teb.Print(dts, teb.XactECPutNoHdrTmpl, opts)
 			} else {
-				err = teb.Print(dts, teb.XactNoBucketTmpl, opts)
+				err = teb.Print(dts, teb.XactECPutTmpl, opts)
+			}
+		default:
+			switch {
+			case fromToBck && hideHeader:
+				err = teb.Print(dts, teb.XactNoHdrFromToTmpl, opts)
+			case fromToBck:
+				err = teb.Print(dts, teb.XactFromToTmpl, opts)
+			case haveBck && hideHeader:
+				err = teb.Print(dts, teb.XactNoHdrBucketTmpl, opts)
+			case haveBck:
+				err = teb.Print(dts, teb.XactBucketTmpl, opts)
+			default:
+				if hideHeader {
+					err = teb.Print(dts, teb.XactNoHdrNoBucketTmpl, opts)
+				} else {
+					err = teb.Print(dts, teb.XactNoBucketTmpl, opts)
+				}
 			}
 		}
 	}
+	if err == nil && xargs.Kind == apc.ActECRestore && !usejs {
+		err = showECRestoreHist(c, dts, hideHeader, opts)
+	}
 	if err != nil || !flagIsSet(c, verboseJobFlag) {
 		return l, err
 	}
@@ -567,6 +629,99 @@ func xlistByKindID(c *cli.Context, xargs *xact.ArgsMsg, caption bool, xs xact.Mu
 	return l, nil
 }
 
+// aggregate a given job's snaps from all targets
+func xrollup(dts []daemonTemplateXactSnaps) *teb.XactRollup {
+	var (
+		r        = &teb.XactRollup{Snap: &core.Snap{}, Nodes: len(dts)}
+		sum      = r.Snap
+		allIdle  = true
+		finished = true
+	)
+	for i, di := range dts {
+		snap := di.XactSnaps[0]
+		if i == 0 {
+			sum.ID, sum.Kind = snap.ID, snap.Kind
+			sum.Bck, sum.SrcBck, sum.DstBck = snap.Bck, snap.SrcBck, snap.DstBck
+			sum.StartTime = snap.StartTime
+		}
+		sum.Stats.Objs += snap.Stats.Objs
+		sum.Stats.Bytes += snap.Stats.Bytes
+		sum.Stats.InObjs += snap.Stats.InObjs
+		sum.Stats.InBytes += snap.Stats.InBytes
+		sum.Stats.OutObjs += snap.Stats.OutObjs
+		sum.Stats.OutBytes += snap.Stats.OutBytes
+		if !snap.StartTime.IsZero() && (sum.StartTime.IsZero() || snap.StartTime.Before(sum.StartTime)) {
+			sum.StartTime = snap.StartTime
+		}
+		if snap.EndTime.After(sum.EndTime) {
+			sum.EndTime = snap.EndTime
+		}
+		if snap.IsAborted() && !sum.AbortedX {
+			sum.AbortedX, sum.AbortErr = true, snap.AbortErr
+		}
+		if snap.Err != "" || snap.IsAborted() {
+			r.Errs++
+			if sum.Err == "" {
+				sum.Err = snap.Err
+			}
+		}
+		if snap.Running() {
+			r.Running++
+			finished = false
+			allIdle = allIdle && snap.IsIdle()
+		}
+	}
+	sum.IdleX = r.Running > 0 && allIdle
+	if !finished {
+		sum.EndTime = time.Time{}
+	}
+	if sum.Err != "" && r.Errs > 1 {
+		sum.Err = fmt.Sprintf("%s (and %d more)", sum.Err, r.Errs-1)
+	}
+
+	// (cluster-wide) throughput
+	if !sum.StartTime.IsZero() {
+		end := sum.EndTime
+		if end.IsZero() {
+			end = time.Now()
+		}
+		if elapsed := end.Sub(sum.StartTime); elapsed >= time.Second {
+			r.Rate = int64(float64(sum.Stats.Bytes) / elapsed.Seconds())
+		}
+	}
+	return r
+}
+
+// ec-restore: sum up per-target exposure histograms (most exposed first)
+func showECRestoreHist(c *cli.Context, dts []daemonTemplateXactSnaps, hideHeader bool, opts teb.Opts) error {
+	var hist []*teb.ECRestoreHist
+	for _, di := range dts {
+		var ext ec.ExtECRestoreStats
+		if err := cos.MorphMarshal(di.XactSnaps[0].Ext, &ext); err != nil {
+			continue
+		}
+		for level := range max(len(ext.Pending), len(ext.Recovered)) {
+			for len(hist) <= level {
+				hist = append(hist, &teb.ECRestoreHist{Level: len(hist)})
+			}
+			if level < len(ext.Pending) {
+				hist[level].Pending += ext.Pending[level]
+			}
+			if level < len(ext.Recovered) {
+				hist[level].Recovered += ext.Recovered[level]
+			}
+		}
+	}
+	if len(hist) == 0 {
+		return nil
+	}
+	fmt.Fprintln(c.App.Writer)
+	if hideHeader {
+		return teb.Print(hist, teb.ECRestoreHistNoHdrTmpl, opts)
+	}
+	return teb.Print(hist, teb.ECRestoreHistTmpl, opts)
+}
+
 func showObjectHandler(c *cli.Context) error {
 	if c.NArg() < 1 {
 		return missingArgumentsError(c, "object name in the form "+objectArgument)
@@ -615,6 +770,205 @@ func showSmapHandler(c *cli.Context) error {
 	return smapFromNode(c, smap, sid, flagIsSet(c, jsonFlag))
 }
 
+func showClusterTLSHandler(c *cli.Context) error {
+	node, _, err := arg0Node(c)
+	if err != nil {
+		return err
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	all, err := api.GetClusterCertInfo(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	if node != nil {
+		all = map[string]*certloader.Info{node.ID(): all[node.ID()]}
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(all, "", teb.Jopts(true))
+	}
+
+	sids := make([]string, 0, len(all))
+	for sid := range all {
+		sids = append(sids, sid)
+	}
+	sortNodeIDs(smap, sids)
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "NODE\tCERTIFICATE\tSUBJECT\tVALID FROM\tEXPIRES\tREMAINING\tLOADED\tSTATUS")
+	}
+	for _, sid := range sids {
+		sname := sid
+		if si := smap.GetNode(sid); si != nil {
+			sname = si.StringEx()
+		}
+		info := all[sid]
+		if info == nil || info.CertFile == "" {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", sname, teb.NotSetVal, teb.NotSetVal,
+				teb.NotSetVal, teb.NotSetVal, teb.NotSetVal, teb.NotSetVal, "TLS not in use")
+			continue
+		}
+		var (
+			rem    = time.Until(info.NotAfter)
+			remain = teb.NotSetVal
+			status = "ok"
+		)
+		switch {
+		case info.Error != "":
+			status = fred(info.Error)
+		case rem <= 0:
+			status = fred("expired")
+		case rem < 3*24*time.Hour:
+			status = fcyan("expires soon")
+		}
+		switch {
+		case rem > 48*time.Hour:
+			remain = strconv.Itoa(int(rem.Hours()/24)) + "d"
+		case rem > 0:
+			remain = teb.FormatDuration(rem)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", sname, info.CertFile, info.Subject,
+			_fmtCertTime(info.NotBefore), _fmtCertTime(info.NotAfter), remain, _fmtCertTime(info.Loaded), status)
+	}
+	tw.Flush()
+	return nil
+}
+
+func showClusterNetworkHandler(c *cli.Context) error {
+	node, _, err := arg0Node(c)
+	if err != nil {
+		return err
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	all, err := api.GetClusterNetIfaces(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	if node != nil {
+		all = map[string][]apc.NetIface{node.ID(): all[node.ID()]}
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(all, "", teb.Jopts(true))
+	}
+
+	sids := make([]string, 0, len(all))
+	for sid := range all {
+		sids = append(sids, sid)
+	}
+	sortNodeIDs(smap, sids)
+
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "NODE\tNETWORK\tADDRESS\tINTERFACE\tIP\tMTU\tSELECTED BY")
+	}
+	for _, sid := range sids {
+		sname := sid
+		if si := smap.GetNode(sid); si != nil {
+			sname = si.StringEx()
+		}
+		for _, nif := range all[sid] {
+			var (
+				iface, ip, mtu = teb.NotSetVal, teb.NotSetVal, teb.NotSetVal
+				source         = nif.Source
+			)
+			if nif.Iface != "" {
+				iface, ip, mtu = nif.Iface, nif.IP, strconv.Itoa(nif.MTU)
+			}
+			switch nif.Source {
+			case apc.NetSelCIDR:
+				source += " " + nif.CIDR
+			case apc.NetSelPublic:
+				source = fcyan(source) // (not configured separately)
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", sname, nif.Net,
+				net.JoinHostPort(nif.Hostname, nif.Port), iface, ip, mtu, source)
+			sname = ""
+		}
+	}
+	tw.Flush()
+	return nil
+}
+
+func showClusterAlertsHandler(c *cli.Context) error {
+	alerts, err := api.GetClusterAlerts(apiBP)
+	if err != nil {
+		return V(err)
+	}
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(alerts, "", teb.Jopts(true))
+	}
+	if len(alerts) == 0 {
+		if config, errV := api.GetClusterConfig(apiBP); errV == nil && !config.Alerts.Enabled {
+			actionNote(c, "alerts are disabled (to enable, see 'ais config cluster alerts --json')")
+		} else {
+			fmt.Fprintln(c.App.Writer, "No alerts")
+		}
+		return nil
+	}
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return err
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "RULE\tNODE\tSTATE\tSEVERITY\tMETRIC\tVALUE\tTHRESHOLD\tSINCE\tRESOLVED")
+	}
+	for _, a := range alerts {
+		var (
+			sname    = a.NodeID
+			state    = a.State
+			value    = strconv.FormatFloat(a.Value, 'g', 4, 64)
+			thresh   = strconv.FormatFloat(a.Threshold, 'g', 4, 64)
+			resolved = teb.NotSetVal
+		)
+		switch {
+		case a.State == stats.AlertFiring && a.Severity == cmn.AlertSeverityCritical:
+			state = fred(state)
+		case a.State == stats.AlertFiring:
+			state = fcyan(state)
+		case a.State == stats.AlertResolved:
+			resolved = cos.FormatNanoTime(a.Resolved, time.DateTime)
+		}
+		if si := smap.GetNode(a.NodeID); si != nil {
+			sname = si.StringEx()
+		}
+		if a.Metric == cmn.AlertMetricOffline {
+			value, thresh = teb.NotSetVal, teb.NotSetVal
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", a.Rule, sname, state, a.Severity,
+			a.Metric, value, thresh, cos.FormatNanoTime(a.Since, time.DateTime), resolved)
+	}
+	tw.Flush()
+	return nil
+}
+
+// proxies first, then targets
+func sortNodeIDs(smap *meta.Smap, sids []string) {
+	sort.Slice(sids, func(i, j int) bool {
+		si, sj := smap.GetNode(sids[i]), smap.GetNode(sids[j])
+		if si != nil && sj != nil && si.Type() != sj.Type() {
+			return si.IsProxy()
+		}
+		return sids[i] < sids[j]
+	})
+}
+
+func _fmtCertTime(t time.Time) string {
+	if t.IsZero() {
+		return teb.NotSetVal
+	}
+	return cos.FormatTime(t, time.DateTime)
+}
+
 func showBMDHandler(c *cli.Context) error {
 	var (
 		bmd              *meta.BMD
@@ -713,6 +1067,9 @@ func showClusterConfig(c *cli.Context, section string) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, diffDefaultsFlag) {
+		return showDiffDefaults(c, cluConfig, nil /*node*/, section)
+	}
 
 	if usejs && section != "" {
 		if printSectionJSON(c, cluConfig, section) {
@@ -791,6 +1148,17 @@ func showNodeConfig(c *cli.Context) error {
 		}
 	}
 
+	if flagIsSet(c, diffDefaultsFlag) {
+		if scope == cfgScopeLocal {
+			return incorrectUsageMsg(c, "option %s applies to inherited configuration (not %q)", qflprn(diffDefaultsFlag), scope)
+		}
+		cluConf, err := api.GetClusterConfig(apiBP)
+		if err != nil {
+			return V(err)
+		}
+		return showDiffDefaults(c, cluConf, &config.ClusterConfig, section)
+	}
+
 	if section == "backend" {
 		// NOTE compare with showClusterConfig above (ref 080235)
 		usejs = true
@@ -865,6 +1233,64 @@ func showNodeConfig(c *cli.Context) error {
 	return err
 }
 
+// deployment-specific (no meaningful defaults)
+var noDiffDefaults = []string{"backend", "proxy.primary_url", "proxy.original_url", "proxy.discovery_url", "auth.secret"}
+
+// show cluster (or node's inherited) config values that differ from compiled-in defaults;
+// node != nil: values that differ from cluster config are the node's local overrides
+func showDiffDefaults(c *cli.Context, cluConf, node *cmn.ClusterConfig, section string) error {
+	dflt, err := cmn.DefaultClusterConfig()
+	if err != nil {
+		return err
+	}
+	var (
+		flatClu  = flattenJSON(cluConf, section).toMap()
+		flatNode cos.StrKVs
+		diff     = make([]dfltDiff, 0, 16)
+	)
+	if node != nil {
+		flatNode = flattenJSON(node, section).toMap()
+	}
+outer:
+	for _, nv := range flattenJSON(dflt, section) {
+		for _, prefix := range noDiffDefaults {
+			if strings.HasPrefix(nv.Name, prefix) {
+				continue outer
+			}
+		}
+		item := dfltDiff{Name: nv.Name, Value: flatClu[nv.Name], Default: nv.Value, SetBy: apc.Cluster}
+		if node != nil {
+			if v := flatNode[nv.Name]; v != item.Value {
+				item.Value, item.SetBy = v, "node (local override)"
+			}
+		}
+		if item.Value != item.Default {
+			diff = append(diff, item)
+		}
+	}
+
+	if flagIsSet(c, jsonFlag) {
+		return teb.Print(diff, "", teb.Jopts(true))
+	}
+	if len(diff) == 0 {
+		actionDone(c, "No differences from the defaults")
+		return nil
+	}
+	tw := &tabwriter.Writer{}
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	if !flagIsSet(c, noHeaderFlag) {
+		fmt.Fprintln(tw, "PROPERTY\tVALUE\tDEFAULT\tSET BY")
+	}
+	for _, item := range diff {
+		setBy := item.SetBy
+		if setBy != apc.Cluster {
+			setBy = fred(setBy)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", item.Name, fcyan(item.Value), item.Default, setBy)
+	}
+	return tw.Flush()
+}
+
 // TODO -- FIXME: check backend.conf <new JSON formatted value>
 func showRemoteAISHandler(c *cli.Context) error {
 	const (
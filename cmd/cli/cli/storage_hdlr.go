@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -43,6 +44,7 @@ var (
 	mpathCmdsFlags = map[string][]cli.Flag{
 		cmdMpathAttach: {
 			mountpathLabelFlag,
+			mountpathInitFlag,
 		},
 		"default": {
 			noResilverFlag,
@@ -129,6 +131,7 @@ var (
 		longRunFlags,
 		bsummPrefixFlag,
 		listObjCachedFlag,
+		bsummFromCacheFlag,
 		unitsFlag,
 		verboseFlag,
 		dontWaitFlag,
@@ -144,6 +147,7 @@ var (
 			noHeaderFlag,
 			unitsFlag,
 			regexColsFlag,
+			columnsFlag,
 			diskSummaryFlag,
 		),
 		cmdMountpath: append(
@@ -198,6 +202,7 @@ var (
 				Action:       showMisplacedAndMore,
 				BashComplete: bucketCompletions(bcmplop{}),
 			},
+			storageCmdECVerify,
 			mpathCmd,
 			showCmdDisk,
 			cleanupCmd,
@@ -339,7 +344,10 @@ func showDiskStats(c *cli.Context, tid string) error {
 	}
 
 	table := teb.NewDiskTab(dsh, smap, regex, units, totalsHdr, withCap)
-	out := table.Template(hideHeader)
+	out, err := tableTmpl(c, table, hideHeader)
+	if err != nil {
+		return err
+	}
 	return teb.Print(dsh, out)
 }
 
@@ -369,6 +377,8 @@ func summaryStorageHandler(c *cli.Context) error {
 	if xid := c.Args().Get(1); xid != "" && cos.IsValidUUID(xid) {
 		ctx.msg.UUID = xid
 		news = false
+	} else {
+		ctx.msg.FromCache = flagIsSet(c, bsummFromCacheFlag)
 	}
 
 	// execute
@@ -413,9 +423,31 @@ func summaryStorageHandler(c *cli.Context) error {
 	opts := teb.Opts{AltMap: altMap}
 	hideHeader := flagIsSet(c, noHeaderFlag)
 	if hideHeader {
-		return teb.Print(summaries, teb.BucketsSummariesBody, opts)
+		err = teb.Print(summaries, teb.BucketsSummariesBody, opts)
+	} else {
+		err = teb.Print(summaries, teb.BucketsSummariesTmpl, opts)
+	}
+	if err == nil && ctx.msg.FromCache && xid == "" {
+		ctx.cacheAge(summaries)
+	}
+	return err
+}
+
+// cache hit: show the age of the oldest summary
+func (ctx *bsummCtx) cacheAge(summaries cmn.AllBsummResults) {
+	var computed int64
+	for _, summ := range summaries {
+		if computed == 0 || (summ.Computed != 0 && summ.Computed < computed) {
+			computed = summ.Computed
+		}
 	}
-	return teb.Print(summaries, teb.BucketsSummariesTmpl, opts)
+	if computed == 0 {
+		return
+	}
+	age := time.Since(time.Unix(0, computed)).Round(time.Second)
+	msg := fmt.Sprintf("showing cached summary computed %s ago (to recompute, run without %s)",
+		teb.FormatDuration(age), qflprn(bsummFromCacheFlag))
+	actionNote(ctx.c, msg)
 }
 
 func newBsummCtxMsg(c *cli.Context, qbck cmn.QueryBcks, prefix string, objCached, bckPresent bool) (*bsummCtx, error) {
@@ -634,6 +666,11 @@ func mpathAction(c *cli.Context, action string) error {
 		case apc.ActMountpathAttach:
 			acted = "attached"
 			label := parseStrFlag(c, mountpathLabelFlag)
+			if flagIsSet(c, mountpathInitFlag) {
+				if label, err = probeMpath(c, si, mountpath, label); err != nil {
+					return err
+				}
+			}
 			err = api.AttachMountpath(apiBP, si, mountpath, ios.Label(label))
 		case apc.ActMountpathEnable:
 			acted = "enabled"
@@ -666,3 +703,20 @@ func mpathAction(c *cli.Context, action string) error {
 	}
 	return nil
 }
+
+// `attach --init`: run onboarding sequence and print the report; returns the label to attach with
+func probeMpath(c *cli.Context, si *meta.Snode, mountpath, label string) (string, error) {
+	fmt.Fprintf(c.App.Writer, "%s: probing mountpath %q...\n\n", si.StringEx(), mountpath)
+	mpp, err := api.ProbeMountpath(apiBP, si, mountpath, ios.Label(label))
+	if err != nil {
+		return "", V(err)
+	}
+	if err := teb.Print(mpp, teb.MpathProbeTmpl); err != nil {
+		return "", err
+	}
+	fmt.Fprintln(c.App.Writer)
+	if !mpp.Passed() {
+		return "", fmt.Errorf("%s: mountpath %q failed onboarding checks - not attaching", si.StringEx(), mountpath)
+	}
+	return mpp.Label, nil
+}
@@ -159,10 +159,71 @@ func _iniCopyBckMsg(c *cli.Context, msg *apc.CopyBckMsg) (err error) {
 		msg.Sync = flagIsSet(c, syncFlag)
 	}
 	if msg.Sync && msg.Prepend != "" {
-		err = fmt.Errorf("prepend option (%q) is incompatible with %s (the latter requires identical source/destination naming)",
+		return fmt.Errorf("prepend option (%q) is incompatible with %s (the latter requires identical source/destination naming)",
 			msg.Prepend, qflprn(progressFlag))
 	}
-	return err
+	return _iniCopyRetries(c, msg)
+}
+
+// (both x-tcb and x-tco)
+func _iniCopyRetries(c *cli.Context, msg *apc.CopyBckMsg) error {
+	msg.ContinueOnError = flagIsSet(c, continueOnErrorFlag)
+	msg.NumRetries = parseIntFlag(c, copyRetriesFlag)
+	if flagIsSet(c, copyRetryBackoffFlag) {
+		msg.RetryBackoff = cos.Duration(parseDurationFlag(c, copyRetryBackoffFlag))
+	}
+	if err := _iniCopyPace(c, msg); err != nil {
+		return err
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("%v (see %s and %s)", err, qflprn(copyRetriesFlag), qflprn(copyRetryBackoffFlag))
+	}
+	return nil
+}
+
+// bandwidth limit and schedule (ditto)
+func _iniCopyPace(c *cli.Context, msg *apc.CopyBckMsg) (err error) {
+	if flagIsSet(c, copyLimitBphFlag) {
+		if msg.LimitBph, err = parseSizeFlag(c, copyLimitBphFlag); err != nil {
+			return fmt.Errorf("invalid %s: %v", qflprn(copyLimitBphFlag), err)
+		}
+		if msg.LimitBph <= 0 {
+			return fmt.Errorf("invalid %s: expecting positive size", qflprn(copyLimitBphFlag))
+		}
+	}
+	msg.Schedule = parseStrFlag(c, copyScheduleFlag)
+	if _, err = msg.ParseSchedule(); err != nil {
+		return fmt.Errorf("%v (see %s)", err, qflprn(copyScheduleFlag))
+	}
+	return nil
+}
+
+// upon completion: objects that were copied after retrying and those that failed (and were skipped)
+func showCopyRetries(c *cli.Context, xid, kind string) {
+	snaps, err := api.QueryXactionSnaps(apiBP, &xact.ArgsMsg{ID: xid, Kind: kind})
+	if err != nil {
+		return // (non-essential)
+	}
+	cr := snaps.CopyRetries(xid)
+	if cr.NRetried > 0 {
+		actionNote(c, fmt.Sprintf("%d object%s copied after retrying%s", cr.NRetried, cos.Plural(int(cr.NRetried)),
+			_copyRetryNames(cr.Retried, cr.NRetried)))
+	}
+	if cr.NFailed > 0 {
+		actionWarn(c, fmt.Sprintf("%d object%s failed to copy%s", cr.NFailed, cos.Plural(int(cr.NFailed)),
+			_copyRetryNames(cr.Failed, cr.NFailed)))
+	}
+}
+
+func _copyRetryNames(names []string, cnt int64) string {
+	if len(names) == 0 {
+		return ""
+	}
+	s := ":\n\t" + strings.Join(names, "\n\t")
+	if more := cnt - int64(len(names)); more > 0 {
+		s += fmt.Sprintf("\n\t... and %d more", more)
+	}
+	return s
 }
 
 func copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck) error {
@@ -232,9 +293,11 @@ func copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck) error {
 	xargs := xact.ArgsMsg{ID: xid, Kind: kind, Timeout: timeout}
 	if err := waitXact(&xargs); err != nil {
 		fmt.Fprintf(c.App.ErrWriter, fmtXactFailed, "copy", from, to)
+		showCopyRetries(c, xid, kind)
 		return err
 	}
 	actionDone(c, fmtXactSucceeded)
+	showCopyRetries(c, xid, kind)
 	return nil
 }
 
@@ -262,13 +325,19 @@ func etlBucketHandler(c *cli.Context) error {
 	return copyTransform(c, etlName, objFrom, bckFrom, bckTo)
 }
 
-func etlBucket(c *cli.Context, etlName string, bckFrom, bckTo cmn.Bck) error {
+func etlBucket(c *cli.Context, etlName string, bckFrom, bckTo cmn.Bck) (err error) {
 	var msg = apc.TCBMsg{
 		Transform: apc.Transform{Name: etlName},
 	}
 	if err := _iniCopyBckMsg(c, &msg.CopyBckMsg); err != nil {
 		return err
 	}
+	if msg.Filter, err = parseETLFilter(c); err != nil {
+		return err
+	}
+	if msg.Params, err = parseETLParams(c); err != nil {
+		return err
+	}
 	if flagIsSet(c, etlExtFlag) {
 		mapStr := parseStrFlag(c, etlExtFlag)
 		extMap := make(cos.StrKVs, 1)
@@ -317,7 +386,9 @@ func etlBucket(c *cli.Context, etlName string, bckFrom, bckTo cmn.Bck) error {
 	}
 	fmt.Fprintln(c.App.Writer, text+" ...")
 	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActETLBck, Timeout: timeout}
-	if err := waitXact(&xargs); err != nil {
+	err = waitXact(&xargs)
+	showCopyRetries(c, xid, apc.ActETLBck)
+	if err != nil {
 		return err
 	}
 	if !flagIsSet(c, copyDryRunFlag) {
@@ -337,6 +408,66 @@ func etlBucket(c *cli.Context, etlName string, bckFrom, bckTo cmn.Bck) error {
 	return nil
 }
 
+// (nil when no filtering is requested)
+func parseETLFilter(c *cli.Context) (*apc.ETLFilter, error) {
+	var (
+		f   = &apc.ETLFilter{}
+		err error
+	)
+	f.Prefix = parseStrFlag(c, etlFilterPrefixFlag)
+	if flagIsSet(c, etlFilterExtFlag) {
+		f.Exts = splitCsv(parseStrFlag(c, etlFilterExtFlag))
+	}
+	if flagIsSet(c, etlFilterMinSizeFlag) {
+		if f.MinSize, err = parseSizeFlag(c, etlFilterMinSizeFlag); err != nil {
+			return nil, err
+		}
+	}
+	if flagIsSet(c, etlFilterMaxSizeFlag) {
+		if f.MaxSize, err = parseSizeFlag(c, etlFilterMaxSizeFlag); err != nil {
+			return nil, err
+		}
+	}
+	if flagIsSet(c, etlFilterMDFlag) {
+		f.CustomMD = make(cos.StrKVs, 2)
+		for _, kv := range splitCsv(parseStrFlag(c, etlFilterMDFlag)) {
+			k, v, _ := strings.Cut(kv, "=")
+			if k = strings.TrimSpace(k); k == "" {
+				return nil, fmt.Errorf("invalid %s=%q: expecting comma-separated 'key=value' pairs",
+					qflprn(etlFilterMDFlag), parseStrFlag(c, etlFilterMDFlag))
+			}
+			f.CustomMD[k] = strings.TrimSpace(v)
+		}
+	}
+	f.CopyUnmatched = flagIsSet(c, etlCopyUnmatchedFlag)
+	if f.Prefix == "" && len(f.Exts) == 0 && f.MinSize == 0 && f.MaxSize == 0 && len(f.CustomMD) == 0 {
+		if f.CopyUnmatched {
+			return nil, fmt.Errorf("option %s requires at least one of the '--filter-*' options", qflprn(etlCopyUnmatchedFlag))
+		}
+		return nil, nil
+	}
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func parseETLParams(c *cli.Context) (cos.StrKVs, error) {
+	if !flagIsSet(c, etlParamsFlag) {
+		return nil, nil
+	}
+	params := make(cos.StrKVs, 2)
+	for _, kv := range splitCsv(parseStrFlag(c, etlParamsFlag)) {
+		k, v, ok := strings.Cut(kv, "=")
+		if k = strings.TrimSpace(k); k == "" || !ok {
+			return nil, fmt.Errorf("invalid %s=%q: expecting comma-separated 'key=value' pairs",
+				qflprn(etlParamsFlag), parseStrFlag(c, etlParamsFlag))
+		}
+		params[k] = strings.TrimSpace(v)
+	}
+	return params, nil
+}
+
 func handleETLHTTPError(err error, etlName string) error {
 	if err == nil {
 		return nil
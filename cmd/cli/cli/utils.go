@@ -15,6 +15,7 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"reflect"
 	"regexp"
 	"sort"
 	"strconv"
@@ -30,9 +31,11 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/ext/dload"
 	"github.com/NVIDIA/aistore/xact"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
+	"sigs.k8s.io/yaml"
 )
 
 // This file contains common utilities and low-level helpers.
@@ -75,6 +78,13 @@ type (
 		Current string
 		Old     string
 	}
+	// (--diff-defaults)
+	dfltDiff struct {
+		Name    string `json:"name"`
+		Value   string `json:"value"`
+		Default string `json:"default"`
+		SetBy   string `json:"set_by"`
+	}
 )
 
 // TODO: unify, use instead of splitting handlers (that each have different flags)
@@ -470,6 +480,86 @@ func parseBpropsFromContext(c *cli.Context) (props *cmn.BpropsToSet, err error)
 	return
 }
 
+// load (`--from-file`) YAML or JSON document with bucket properties:
+// - top-level names that are not settable but are otherwise valid bucket props (e.g., "created") are ignored;
+// - all other names must be valid, including nested ones
+func loadBpropsFile(path string) (*cmn.BpropsToSet, error) {
+	var (
+		b   []byte
+		err error
+	)
+	if path == fileStdIO {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// (JSON is a subset of YAML)
+	if b, err = yaml.YAMLToJSON(b); err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %v", path, err)
+	}
+	var doc map[string]jsoniter.RawMessage
+	if err := jsoniter.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %v", path, err)
+	}
+	if len(doc) == 0 {
+		return nil, fmt.Errorf("%q: no bucket properties to apply", path)
+	}
+	var (
+		settable = _jsonNames(&cmn.BpropsToSet{})
+		all      = _jsonNames(&cmn.Bprops{})
+	)
+	for name := range doc {
+		switch {
+		case settable.Contains(name):
+		case all.Contains(name):
+			delete(doc, name) // read-only
+		default:
+			return nil, fmt.Errorf("%q: invalid bucket property %q", path, name)
+		}
+	}
+	// backend bucket: cmn.Bck => cmn.BackendBckToSet
+	if raw, ok := doc["backend_bck"]; ok {
+		var bck cmn.Bck
+		if err := jsoniter.Unmarshal(raw, &bck); err != nil {
+			return nil, fmt.Errorf("%q: invalid backend_bck: %v", path, err)
+		}
+		if !bck.Ns.IsGlobal() {
+			return nil, fmt.Errorf("%q: backend_bck with namespace (%s) is not supported", path, bck.Cname(""))
+		}
+		doc["backend_bck"] = cos.MustMarshal(&cmn.BackendBckToSet{Name: &bck.Name, Provider: &bck.Provider})
+	}
+	var (
+		props  = &cmn.BpropsToSet{}
+		strict = jsoniter.Config{EscapeHTML: true, DisallowUnknownFields: true}.Froze()
+	)
+	if err := strict.Unmarshal(cos.MustMarshal(doc), props); err != nil {
+		return nil, fmt.Errorf("%q: invalid bucket properties: %v", path, err)
+	}
+	props.Force = false // (the flag)
+	return props, nil
+}
+
+// top-level JSON names of a given struct
+func _jsonNames(v any) cos.StrSet {
+	var (
+		typ   = reflect.TypeOf(v).Elem()
+		names = make(cos.StrSet, typ.NumField())
+	)
+	for i := range typ.NumField() {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+		if name == "" {
+			name = typ.Field(i).Name
+		}
+		if name != "-" {
+			names.Add(name)
+		}
+	}
+	return names
+}
+
 func bucketsFromArgsOrEnv(c *cli.Context) ([]cmn.Bck, error) {
 	uris := c.Args()
 	bcks := make([]cmn.Bck, 0, len(uris))
@@ -789,6 +879,14 @@ func diffConfigs(actual, original nvpairList) []propDiff {
 	return diff
 }
 
+func (nvs nvpairList) toMap() cos.StrKVs {
+	m := make(cos.StrKVs, len(nvs))
+	for _, nv := range nvs {
+		m[nv.Name] = nv.Value
+	}
+	return m
+}
+
 func printSectionJSON(c *cli.Context, in any, section string) (done bool) {
 	if i := strings.LastIndexByte(section, '.'); i > 0 {
 		section = section[:i]
@@ -960,6 +1058,20 @@ func actionCptn(c *cli.Context, prefix, msg string) {
 	}
 }
 
+// table template with `--columns` (if specified) applied
+func tableTmpl(c *cli.Context, table *teb.Table, hideHeader bool) (string, error) {
+	if flagIsSet(c, columnsFlag) {
+		cols, err := teb.ParseColumns(parseStrFlag(c, columnsFlag))
+		if err != nil {
+			return "", incorrectUsageMsg(c, "%v", err)
+		}
+		if err := table.Select(cols); err != nil {
+			return "", err
+		}
+	}
+	return table.Template(hideHeader), nil
+}
+
 func dryRunHeader() string {
 	return fcyan("[DRY RUN]")
 }
@@ -1035,6 +1147,9 @@ func parseSource(rawURL string) (source dlSource, err error) {
 			host += ":8080" // TODO: What if host is listening on `:80` so we don't need port?
 		}
 		fullPath = path.Join(apc.Version, apc.Objects, fullPath)
+	case "file":
+		// directory on targets' local (or mounted) filesystems (see dload.FSBody)
+		return dlSource{link: dload.FSScheme + path.Join(host, fullPath)}, nil
 	case "":
 		scheme = apc.DefaultScheme
 	case "https", "http":
@@ -5,10 +5,14 @@
 package cli
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/tools/tassert"
@@ -180,6 +184,10 @@ func TestParseSourceValidURIs(t *testing.T) {
 			input:    "ais://172.10.10.10:4444/bucket",
 			expected: dlSource{link: "http://172.10.10.10:4444/v1/objects/bucket"},
 		},
+		{
+			input:    "file:///mnt/nfs/dataset/",
+			expected: dlSource{link: "file:///mnt/nfs/dataset"},
+		},
 	}
 
 	for _, test := range parseSourceTests {
@@ -457,3 +465,81 @@ func TestParseBckObjectURI(t *testing.T) {
 		tassert.Errorf(t, err != nil, "expected error on %s (bck: %q, obj_name: %q)", test.uri, bck, objName)
 	}
 }
+
+func TestValidateCfgProps(t *testing.T) {
+	config := &cmn.ClusterConfig{}
+	config.LRU.CapacityUpdTime = cos.Duration(time.Minute)
+
+	// types only
+	tassert.CheckError(t, validateCfgProps(cos.StrKVs{"lru.enabled": "true", "lru.capacity_upd_time": "1s"}, nil))
+	tassert.Errorf(t, validateCfgProps(cos.StrKVs{"lru.enabled": "maybe"}, nil) != nil, "expected invalid bool")
+	tassert.Errorf(t, validateCfgProps(cos.StrKVs{"lru.capacity_upd_time": "ten"}, nil) != nil, "expected invalid duration")
+
+	// ranges
+	tassert.CheckError(t, validateCfgProps(cos.StrKVs{"lru.capacity_upd_time": "20s"}, config))
+	tassert.Errorf(t, validateCfgProps(cos.StrKVs{"lru.capacity_upd_time": "1s"}, config) != nil,
+		"expected lru.capacity_upd_time out of range")
+}
+
+func TestParseColumns(t *testing.T) {
+	cols, err := teb.ParseColumns("NAME, size:12,Version")
+	tassert.CheckFatal(t, err)
+	expected := teb.Columns{{Name: "NAME"}, {Name: "size", Width: 12}, {Name: "Version"}}
+	tassert.Errorf(t, reflect.DeepEqual(cols, expected), "expected %v, got %v", expected, cols)
+
+	for _, s := range []string{"", ",", "NAME,SIZE:0", "NAME:abc", "NAME,name"} {
+		_, err := teb.ParseColumns(s)
+		tassert.Errorf(t, err != nil, "expected error parsing %q", s)
+	}
+}
+
+func TestLoadBpropsFile(t *testing.T) {
+	var (
+		dir   = t.TempDir()
+		write = func(name, content string) string {
+			path := filepath.Join(dir, name)
+			tassert.CheckFatal(t, os.WriteFile(path, []byte(content), cos.PermRWR))
+			return path
+		}
+	)
+	// YAML, including read-only props
+	props, err := loadBpropsFile(write("props.yaml", `
+created: "1700000000000000000"
+mirror:
+  enabled: true
+  copies: 3
+backend_bck:
+  name: mmm
+  provider: aws
+quota:
+  max_objects: "1000"
+`))
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, props.Mirror != nil && *props.Mirror.Enabled && *props.Mirror.Copies == 3, "mirror: %+v", props.Mirror)
+	tassert.Fatalf(t, props.BackendBck != nil && *props.BackendBck.Name == "mmm", "backend_bck: %+v", props.BackendBck)
+	tassert.Fatalf(t, props.Quota != nil && *props.Quota.MaxObjects == 1000, "quota: %+v", props.Quota)
+	tassert.Errorf(t, props.EC == nil && props.LRU == nil, "expecting only specified props to be set")
+
+	// JSON
+	props, err = loadBpropsFile(write("props.json", `{"versioning": {"enabled": true}}`))
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, props.Versioning != nil && *props.Versioning.Enabled, "versioning: %+v", props.Versioning)
+
+	// entire document, as in 'ais bucket props show --json'
+	bprops := &cmn.Bprops{Provider: apc.AIS, Created: time.Now().UnixNano()}
+	bprops.Mirror.Copies = 2
+	_, err = loadBpropsFile(write("all.json", string(cos.MustMarshal(bprops))))
+	tassert.CheckFatal(t, err)
+
+	// invalid
+	for _, content := range []string{
+		"mirorr:\n  enabled: true\n", // top-level typo
+		"mirror:\n  enabeld: true\n", // nested typo
+		"mirror:\n  copies: many\n",  // type
+		"",                           // empty
+		"backend_bck:\n  name: mmm\n  provider: ais\n  namespace:\n    uuid: remais\n",
+	} {
+		_, err := loadBpropsFile(write("invalid.yaml", content))
+		tassert.Errorf(t, err != nil, "expecting error for %q", content)
+	}
+}
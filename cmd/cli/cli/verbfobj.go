@@ -175,8 +175,11 @@ func (p *uparams) do(c *cli.Context) error {
 		u.progress.Wait()
 		fmt.Fprint(c.App.Writer, u.errSb.String())
 	}
-	if numFailed := u.errCount.Load(); numFailed > 0 {
-		return fmt.Errorf("failed to %s %d file%s", p.wop.verb(), numFailed, cos.Plural(int(numFailed)))
+	if numFailed := int(u.errCount.Load()); numFailed > 0 {
+		if l := len(p.fobjs); numFailed < l {
+			return newErrPartial("failed to %s %d (out of %d) file%s", p.wop.verb(), numFailed, l, cos.Plural(l))
+		}
+		return fmt.Errorf("failed to %s %d file%s", p.wop.verb(), numFailed, cos.Plural(numFailed))
 	}
 	if !flagIsSet(c, dryRunFlag) {
 		if !flagIsSet(c, yesFlag) {
@@ -241,6 +244,9 @@ func (p *uparams) _a2aOne(c *cli.Context, fobj fobj, reader cos.ReadOpenCloser,
 		debug.Assert(!a.appendOnly)
 		putApndArchArgs.Flags = apc.ArchAppendIfExist
 	}
+	if a.createIndex {
+		putApndArchArgs.Flags |= apc.ArchCreateIndex
+	}
 	return api.PutApndArch(&putApndArchArgs)
 }
 
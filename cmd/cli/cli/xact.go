@@ -6,16 +6,19 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
 	"sort"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
-	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -71,8 +74,9 @@ func toShowMsg(c *cli.Context, xjid, prompt string, verbose bool) string {
 }
 
 // Wait for the caller's started xaction to run until finished _or_ idle (NOTE),
-// warn if aborted
-func waitXact(args *xact.ArgsMsg) error {
+// fail if aborted; when not nil, `onProgress` gets called upon each poll (see api.WaitForXactionCtx)
+// Ctrl-C stops waiting (but not the xaction)
+func waitXact(args *xact.ArgsMsg, onProgress ...func(xact.MultiSnap)) error {
 	debug.Assert(args.ID == "" || xact.IsValidUUID(args.ID))
 
 	// NOTE: relying on the Kind to decide between waiting APIs
@@ -80,42 +84,27 @@ func waitXact(args *xact.ArgsMsg) error {
 	kind, xname := xact.GetKindName(args.Kind)
 	debug.Assert(kind != "")
 
-	if kind == apc.ActBlobDl {
-		return waitXactBlob(args)
+	var cb func(xact.MultiSnap)
+	if len(onProgress) > 0 {
+		cb = onProgress[0]
 	}
-
-	if xact.IdlesBeforeFinishing(kind) {
-		return api.WaitForXactionIdle(apiBP, args)
-	}
-	// otherwise, IC
-	status, err := api.WaitForXactionIC(apiBP, args)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	status, err := api.WaitForXactionCtx(ctx, apiBP, args, cb)
 	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return fmt.Errorf("stopped waiting for %s (the job continues to run)", xact.Cname(xname, args.ID))
+		}
 		return V(err)
 	}
 	if status.Aborted() {
-		return fmt.Errorf("%s aborted", xact.Cname(xname, status.UUID))
-	}
-	return nil
-}
-
-// (x-blob doesn't do nofif listener - see ais/prxclu xstart)
-func waitXactBlob(xargs *xact.ArgsMsg) error {
-	var sleep = xact.MinPollTime
-	for {
-		time.Sleep(sleep)
-		_, snap, errN := getAnyXactSnap(xargs)
-		if errN != nil {
-			return errN
-		}
-		if snap.IsAborted() {
-			return errors.New(snap.AbortErr)
+		err := fmt.Errorf("%s aborted", xact.Cname(xname, status.UUID))
+		if status.ErrMsg != "" {
+			err = fmt.Errorf("%v: %s", err, status.ErrMsg)
 		}
-		debug.Assert(snap.ID == xargs.ID)
-		if snap.Finished() {
-			return nil
-		}
-		sleep = min(sleep+sleep/2, xact.MaxPollTime)
+		return err
 	}
+	return nil
 }
 
 func getKindNameForID(xid string, otherKind ...string) (kind, xname string, rerr error) {
@@ -61,6 +61,7 @@ type (
 		archpath    string
 		appendOnly  bool
 		appendOrPut bool
+		createIndex bool
 	}
 )
 
@@ -249,6 +250,7 @@ func (a *archput) parse(c *cli.Context) (err error) {
 	a.archpath = parseStrFlag(c, archpathFlag)
 	a.appendOnly = flagIsSet(c, archAppendOnlyFlag)
 	a.appendOrPut = flagIsSet(c, archAppendOrPutFlag)
+	a.createIndex = flagIsSet(c, archCreateIndexFlag)
 	if a.appendOnly && a.appendOrPut {
 		return incorrectUsageMsg(c, errFmtExclusive, qflprn(archAppendOnlyFlag), qflprn(archAppendOrPutFlag))
 	}
@@ -81,6 +81,8 @@ var (
 		"cp":     "bucket cp",
 		"rmb":    "bucket rm",
 		"evict":  "bucket evict",
+		// storage
+		apc.ActECVerify: "storage " + apc.ActECVerify,
 		// job
 		"start":         "job start",
 		"stop":          "job stop",
@@ -31,15 +31,15 @@ func main() {
 	dispatchInterruptHandler()
 
 	if err := cli.Init(os.Args); err != nil {
-		exitf("%v", err)
+		exit(err, cli.ExitGeneric)
 	}
 
 	if err := cli.Run(cmn.VersionCLI+"."+build, buildtime, os.Args); err != nil {
-		exitf("%v", err)
+		exit(err, cli.ExitCode(err))
 	}
 }
 
-func exitf(f string, a ...any) {
-	fmt.Fprintf(os.Stderr, f+"\n", a...)
-	os.Exit(1)
+func exit(err error, code int) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(code)
 }
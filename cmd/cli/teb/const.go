@@ -32,4 +32,5 @@ const (
 	xrunning      = "Running"
 	xidle         = "Idle"
 	xaborted      = "Aborted"
+	xdeadline     = "Deadline exceeded" // aborted upon max-runtime
 )
@@ -5,6 +5,7 @@
 package teb
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -76,6 +77,39 @@ func LsoTemplate(propsList []string, hideHeader, addCachedCol, addStatusCol bool
 	return headSb.String() + bodySb.String()
 }
 
+// same as above for user-selected columns (CLI `--columns`) - in the given order
+// and with optional fixed widths; column names must be valid ObjectPropsMap keys
+func LsoColumnsTemplate(cols Columns, hideHeader bool) string {
+	var (
+		headSb strings.Builder
+		bodySb strings.Builder
+	)
+	bodySb.WriteString("{{range $obj := .}}")
+	for _, col := range cols {
+		prop := strings.ToLower(col.Name)
+		format, ok := ObjectPropsMap[prop]
+		if !ok {
+			debug.Assert(false, prop)
+			continue
+		}
+		columnName := strings.ToUpper(prop)
+		if col.Width > 0 {
+			columnName = fmtWidth(columnName, col.Width)
+			format = "{{FormatWidth " + strconv.Itoa(col.Width) + " (" +
+				strings.TrimSuffix(strings.TrimPrefix(format, "{{"), "}}") + ")}}"
+		}
+		headSb.WriteString(columnName + "\t ")
+		bodySb.WriteString(format + "\t ")
+	}
+	headSb.WriteString("\n")
+	bodySb.WriteString("\n{{end}}")
+
+	if hideHeader {
+		return bodySb.String()
+	}
+	return headSb.String() + bodySb.String()
+}
+
 //
 // formatting
 //
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -27,6 +28,9 @@ const (
 	colFS      = "File System"
 
 	colCapStatus = "CAP STATUS"
+
+	colIngest = "INGEST" // rate of change of the used capacity
+	colHwmETA = "HIGH-WM IN"
 )
 
 func NewMpathCapTab(st StstMap, c *PerfTabCtx, showMpaths bool) *Table {
@@ -50,6 +54,8 @@ func NewMpathCapTab(st StstMap, c *PerfTabCtx, showMpaths bool) *Table {
 			{name: colUsedAvgMax},
 			{name: colCapAvail},
 			{name: colDisksFS},
+			{name: colIngest},
+			{name: colHwmETA},
 			{name: colCapStatus},
 		}
 	}
@@ -167,6 +173,23 @@ func numMpathsRow(ds *stats.NodeStatus, c *PerfTabCtx, cols []*header, row []str
 	if i := _idx(cols, colDisksFS); i >= 0 {
 		row = append(row, _fmtMpathDisks(tcdf.Mountpaths, i))
 	}
+	if _idx(cols, colIngest) >= 0 {
+		switch {
+		case tcdf.IngestRate > 0:
+			row = append(row, "+"+FmtSize(tcdf.IngestRate, c.Units, 1)+"/s")
+		case tcdf.IngestRate < 0:
+			row = append(row, "-"+FmtSize(-tcdf.IngestRate, c.Units, 1)+"/s")
+		default:
+			row = append(row, unknownVal)
+		}
+	}
+	if _idx(cols, colHwmETA) >= 0 {
+		if tcdf.HwmETA > 0 {
+			row = append(row, FormatDuration(tcdf.HwmETA.Round(time.Second)))
+		} else {
+			row = append(row, unknownVal)
+		}
+	}
 	if _idx(cols, colCapStatus) >= 0 {
 		row = append(row, _capStatus(tcdf))
 	}
@@ -391,3 +391,67 @@ func _compAvgSize(ds *stats.NodeStatus, ns string, vs int64, n2n cos.StrKVs) (av
 	}
 	return
 }
+
+//
+// per-job (xaction) breakdown of disk and network throughput (`ais show performance --by-job`)
+//
+
+const (
+	colJob      = "JOB"
+	colJobBck   = "BUCKET"
+	colJobDisk  = "DISK (READ+WRITE)"
+	colJobSent  = "NETWORK (SENT)"
+	colJobRecv  = "NETWORK (RECEIVED)"
+	colJobTotal = "TOTAL"
+)
+
+type JobPerf struct {
+	Name     string // job name and ID, or "user GET" (and similar)
+	Bck      string
+	Bytes    int64 // locally read and/or written, per second
+	OutBytes int64 // sent, per second
+	InBytes  int64 // received, per second
+}
+
+func (jp *JobPerf) Total() int64 { return jp.Bytes + jp.OutBytes + jp.InBytes }
+
+// the busiest job comes first
+func NewJobPerfTab(jobs []*JobPerf, units string) *Table {
+	sort.Slice(jobs, func(i, j int) bool {
+		ti, tj := jobs[i].Total(), jobs[j].Total()
+		if ti != tj {
+			return ti > tj
+		}
+		return jobs[i].Name < jobs[j].Name
+	})
+	table := newTable(
+		&header{name: colJob},
+		&header{name: colJobBck},
+		&header{name: colJobDisk},
+		&header{name: colJobSent},
+		&header{name: colJobRecv},
+		&header{name: colJobTotal},
+	)
+	for _, jp := range jobs {
+		bck := jp.Bck
+		if bck == "" {
+			bck = unknownVal
+		}
+		table.addRow(row{
+			jp.Name,
+			bck,
+			_fmtThroughput(jp.Bytes, units),
+			_fmtThroughput(jp.OutBytes, units),
+			_fmtThroughput(jp.InBytes, units),
+			_fmtThroughput(jp.Total(), units),
+		})
+	}
+	return table
+}
+
+func _fmtThroughput(v int64, units string) string {
+	if v == 0 {
+		return unknownVal
+	}
+	return FmtStatValue("", stats.KindThroughput, v, units)
+}
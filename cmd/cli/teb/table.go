@@ -1,12 +1,14 @@
 // Package teb contains templates and (templated) tables to format CLI output.
 /*
- * Copyright (c) 2018-2023, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
  */
 package teb
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/NVIDIA/aistore/cmn/debug"
 )
@@ -20,12 +22,23 @@ type Table struct {
 
 type (
 	header struct {
-		name string
-		hide bool
+		name  string
+		hide  bool
+		width int // fixed width (see Columns)
 	}
 	row []string
 )
 
+// Columns: user-selected table columns, in order, with optional fixed widths;
+// see ParseColumns
+type (
+	Column struct {
+		Name  string
+		Width int // zero: auto
+	}
+	Columns []Column
+)
+
 func newTable(headers ...*header) *Table { return &Table{headers: headers} }
 
 func (t *Table) addRow(row row) {
@@ -37,13 +50,55 @@ func (t *Table) addRow(row row) {
 	t.rows = append(t.rows, row)
 }
 
+// Select reorders and selects table columns; column names are case-insensitive
+func (t *Table) Select(cols Columns) error {
+	idx := make([]int, 0, len(cols))
+	for _, col := range cols {
+		i := t.find(col.Name)
+		if i < 0 {
+			return fmt.Errorf("unknown column %q (expecting one of: %s)", col.Name, strings.Join(t.names(), ", "))
+		}
+		idx = append(idx, i)
+	}
+	headers := make([]*header, 0, len(idx))
+	for j, i := range idx {
+		headers = append(headers, &header{name: t.headers[i].name, width: cols[j].Width})
+	}
+	for k, r := range t.rows {
+		nr := make(row, 0, len(idx))
+		for _, i := range idx {
+			nr = append(nr, r[i])
+		}
+		t.rows[k] = nr
+	}
+	t.headers = headers
+	return nil
+}
+
+func (t *Table) find(name string) int {
+	for i, h := range t.headers {
+		if strings.EqualFold(strings.TrimSpace(h.name), name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *Table) names() []string {
+	names := make([]string, 0, len(t.headers))
+	for _, h := range t.headers {
+		names = append(names, strings.TrimSpace(h.name))
+	}
+	return names
+}
+
 func (t *Table) Template(hideHeader bool) string {
 	sb := strings.Builder{}
 	if !hideHeader {
 		headers := make([]string, 0, len(t.headers))
 		for _, header := range t.headers {
 			if !header.hide {
-				headers = append(headers, header.name)
+				headers = append(headers, fmtWidth(header.name, header.width))
 			}
 		}
 		sb.WriteString(strings.Join(headers, rowSepa))
@@ -53,7 +108,7 @@ func (t *Table) Template(hideHeader bool) string {
 		rowStrings := make([]string, 0, len(row))
 		for i, value := range row {
 			if !t.headers[i].hide {
-				rowStrings = append(rowStrings, value)
+				rowStrings = append(rowStrings, fmtWidth(value, t.headers[i].width))
 			}
 		}
 		sb.WriteString(strings.Join(rowStrings, rowSepa))
@@ -61,3 +116,52 @@ func (t *Table) Template(hideHeader bool) string {
 	}
 	return sb.String()
 }
+
+/////////////
+// Columns //
+/////////////
+
+// ParseColumns parses comma-separated column names with optional widths, e.g.: "NAME,SIZE:12,VERSION"
+func ParseColumns(s string) (Columns, error) {
+	var cols Columns
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		col := Column{Name: item}
+		if i := strings.LastIndexByte(item, ':'); i > 0 {
+			width, err := strconv.Atoi(item[i+1:])
+			if err != nil || width <= 0 {
+				return nil, fmt.Errorf("invalid column %q: expecting NAME or NAME:WIDTH (where WIDTH is a positive integer)", item)
+			}
+			col.Name, col.Width = strings.TrimSpace(item[:i]), width
+		}
+		for _, other := range cols {
+			if strings.EqualFold(other.Name, col.Name) {
+				return nil, fmt.Errorf("duplicate column %q", col.Name)
+			}
+		}
+		cols = append(cols, col)
+	}
+	if len(cols) == 0 {
+		return nil, fmt.Errorf("invalid columns %q: expecting comma-separated list of column names", s)
+	}
+	return cols, nil
+}
+
+// pad or truncate to exactly `width` characters (colored values are padded only)
+func fmtWidth(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+	n := utf8.RuneCountInString(s)
+	switch {
+	case n < width:
+		return s + strings.Repeat(" ", width-n)
+	case n > width && !strings.ContainsRune(s, '\x1b'):
+		return string([]rune(s)[:width])
+	default:
+		return s
+	}
+}
@@ -8,11 +8,13 @@ import (
 	"fmt"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/ios"
@@ -55,6 +57,7 @@ const (
 		indent1 + "Software:\t{{FormatCluSoft .Version .BuildTime}}\n" +
 		indent1 + "Deployment:\t{{ ( Deployments .Status) }}\n" +
 		indent1 + "Status:\t{{ ( OnlineStatus .Status) }}\n" +
+		"{{if .CluConfig.Proxy.ReadOnly}}" + indent1 + "State:\tread-only\n{{end}}" +
 		indent1 + "Rebalance:\t{{ ( Rebalance .Status) }}\n" +
 		indent1 + "Authentication:\t{{if .CluConfig.Auth.Enabled}}enabled{{else}}disabled{{end}}\n" +
 		indent1 + "Version:\t{{ ( Versions .Status) }}\n" +
@@ -87,6 +90,18 @@ const (
 	DownloadListNoHdrTmpl = "{{ range $key, $value := . }}" + downloadListBody + "{{end}}"
 	DownloadListTmpl      = downloadListHdr + DownloadListNoHdrTmpl
 
+	downloadSchedHdr  = "SCHEDULE ID\t EVERY\t ENABLED\t RUNS\t LAST RUN\t LAST JOB\t NEXT RUN\t DESCRIPTION\n"
+	downloadSchedBody = "{{$value.ID}}\t " +
+		"{{$value.Interval}}\t " +
+		"{{FormatBool $value.Enabled}}\t " +
+		"{{$value.RunCnt}}\t " +
+		"{{FormatStart $value.LastRun}}\t " +
+		"{{if $value.LastErr}}error: {{$value.LastErr}}{{else}}{{if $value.LastJobID}}{{$value.LastJobID}}{{else}}-{{end}}{{end}}\t " +
+		"{{if $value.Enabled}}{{FormatEnd $value.NextRun}}{{else}}-{{end}}\t " +
+		"{{$value.Description}}\n"
+	DownloadSchedNoHdrTmpl = "{{ range $value := . }}" + downloadSchedBody + "{{end}}"
+	DownloadSchedTmpl      = downloadSchedHdr + DownloadSchedNoHdrTmpl
+
 	dsortListHdr  = "JOB ID\t STATUS\t START\t FINISH\t SRC BUCKET\t DST BUCKET\t SRC SHARDS\n"
 	dsortListBody = "{{$value.ID}}\t " +
 		"{{FormatDsortStatus $value}}\t " +
@@ -107,9 +122,14 @@ const (
 		indent1 + "Description:\t{{$value.Metrics.Description}}\n" +
 		"{{end}}"
 
-	transformListHdr  = "ETL NAME\t XACTION\t OBJECTS\n"
+	transformListHdr  = "ETL NAME\t XACTION\t OBJECTS\t ERRORS\t AVG LATENCY\t BREAKER\t HEALTH\n"
 	transformListBody = "{{$value.Name}}\t {{$value.XactID}}\t " +
-		"{{if (eq $value.ObjCount 0) }}-{{else}}{{$value.ObjCount}}{{end}}\n"
+		"{{if (eq $value.ObjCount 0) }}-{{else}}{{$value.ObjCount}}{{end}}\t " +
+		"{{if (eq $value.ErrCount 0) }}-{{else}}{{$value.ErrCount}}{{end}}\t " +
+		"{{if (eq $value.AvgLatency 0) }}-{{else}}{{FormatDuration $value.AvgLatency.D}}{{end}}\t " +
+		"{{if (eq $value.Breaker \"\") }}-{{else}}{{$value.Breaker}}{{end}}\t " +
+		"{{if (eq $value.Health \"\") }}-{{else}}{{$value.Health}}{{end}}" +
+		"{{if (ne $value.Restarts 0) }} (restarts: {{$value.Restarts}}){{end}}\n"
 	TransformListNoHdrTmpl = "{{ range $value := . }}" + transformListBody + "{{end}}"
 	TransformListTmpl      = transformListHdr + TransformListNoHdrTmpl
 
@@ -163,6 +183,23 @@ const (
 		"{{FormatEnd $xctn.EndTime}}\t " +
 		"{{FormatXactState $xctn}}\n"
 
+	// cluster-wide summary: one row per job (see also: '--per-node')
+	XactRollupTmpl      = xactRollupHdr + XactNoHdrRollupTmpl
+	XactNoHdrRollupTmpl = "{{range $r := . }}" + xactRollupBody + "{{end}}"
+
+	xactRollupHdr  = "ID\t KIND\t BUCKET\t NODES\t OBJECTS\t BYTES\t RATE\t ERRORS\t START\t END\t STATE\n"
+	xactRollupBody = "{{if $r.Snap.ID}}{{$r.Snap.ID}}{{else}}-{{end}}\t " +
+		"{{$r.Snap.Kind}}\t " +
+		"{{$r.Bucket}}\t " +
+		"{{if (eq $r.Running 0) }}{{$r.Nodes}}{{else}}{{$r.Running}}/{{$r.Nodes}}{{end}}\t " +
+		"{{if (eq $r.Snap.Stats.Objs 0) }}-{{else}}{{$r.Snap.Stats.Objs}}{{end}}\t " +
+		"{{if (eq $r.Snap.Stats.Bytes 0) }}-{{else}}{{FormatBytesSig $r.Snap.Stats.Bytes 2}}{{end}}\t " +
+		"{{if (eq $r.Rate 0) }}-{{else}}{{FormatBytesSig $r.Rate 2}}/s{{end}}\t " +
+		"{{if (eq $r.Errs 0) }}-{{else}}{{$r.Errs}}{{end}}\t " +
+		"{{FormatStart $r.Snap.StartTime}}\t " +
+		"{{FormatEnd $r.Snap.EndTime}}\t " +
+		"{{FormatXactState $r.Snap}}\n"
+
 	XactECGetTmpl      = xactECGetStatsHdr + XactECGetNoHdrTmpl
 	XactECGetNoHdrTmpl = "{{range $daemon := . }}" + xactECGetBody + "{{end}}"
 
@@ -183,6 +220,14 @@ const (
 		"{{FormatEnd $xctn.EndTime}}\t " +
 		"{{FormatXactState $xctn}}\n"
 
+	// ec-restore: cluster-wide exposure histogram (see ec.ExtECRestoreStats)
+	ECRestoreHistTmpl      = "EXPOSURE\t PENDING\t RECOVERED\n" + ECRestoreHistNoHdrTmpl
+	ECRestoreHistNoHdrTmpl = "{{range $r := . }}" +
+		"{{$r.Level}}{{if (eq $r.Level 0)}} (at risk){{end}}\t " +
+		"{{if (eq $r.Pending 0)}}-{{else}}{{$r.Pending}}{{end}}\t " +
+		"{{if (eq $r.Recovered 0)}}-{{else}}{{$r.Recovered}}{{end}}\n" +
+		"{{end}}"
+
 	XactECPutTmpl      = xactECPutStatsHdr + XactECPutNoHdrTmpl
 	XactECPutNoHdrTmpl = "{{range $daemon := . }}" + xactECPutBody + "{{end}}"
 
@@ -220,12 +265,46 @@ const (
 	ListBucketsTmplNoSummary = ListBucketsHdrNoSummary + ListBucketsBodyNoSummary
 
 	// Bucket summary templates
-	BucketsSummariesTmpl = "NAME\t OBJECTS (cached, remote)\t OBJECT SIZES (min, avg, max)\t TOTAL OBJECT SIZE (cached, remote)\t USAGE(%)\n" +
+	BucketsSummariesTmpl = "NAME\t OBJECTS (cached, remote)\t OBJECT SIZES (min, avg, max)\t TOTAL OBJECT SIZE (cached, remote)\t USAGE(%)\t QUOTA\n" +
 		BucketsSummariesBody
 	BucketsSummariesBody = "{{range $k, $v := . }}" +
 		"{{FormatBckName $v.Bck}}\t {{$v.ObjCount.Present}} {{$v.ObjCount.Remote}}\t " +
 		"{{FormatMAM $v.ObjSize.Min}} {{FormatMAM $v.ObjSize.Avg}} {{FormatMAM $v.ObjSize.Max}}\t " +
-		"{{FormatBytesUns $v.TotalSize.PresentObjs 2}} {{FormatBytesUns $v.TotalSize.RemoteObjs 2}}\t {{$v.UsedPct}}%\n" +
+		"{{FormatBytesUns $v.TotalSize.PresentObjs 2}} {{FormatBytesUns $v.TotalSize.RemoteObjs 2}}\t {{$v.UsedPct}}%\t " +
+		"{{FormatQuota $v}}\n" +
+		"{{end}}"
+
+	// `ais bucket diff`
+	BckDiffTmpl = "NAME\t DIFF\t LEFT\t RIGHT\n" + BckDiffBody
+	BckDiffBody = "{{range $v := . }}" +
+		"{{$v.Name}}\t {{$v.Diff}}\t {{$v.Left}}\t {{$v.Right}}\n" +
+		"{{end}}"
+
+	// `ais storage mountpath attach --init`
+	MpathProbeTmpl = "Mountpath:\t {{.Mountpath}}\n" +
+		"{{if .FS}}File system:\t {{.FS}} ({{.FsType}})\n{{end}}" +
+		"{{if .Capacity}}Capacity:\t {{FormatBytesUns .Capacity 2}} ({{FormatBytesUns .Avail 2}} available)\n{{end}}" +
+		"{{if .WriteBps}}" +
+		"Sequential write:\t {{FormatBytesSig .WriteBps 2}}/s\n" +
+		"Sequential read:\t {{FormatBytesSig .ReadBps 2}}/s\n" +
+		"Sync write latency:\t {{FormatDurationNs .SyncLat}}\n" +
+		"{{end}}" +
+		"{{if .Label}}Label:\t {{.Label}}\n{{end}}" +
+		"\nCHECK\t RESULT\n" +
+		"{{range $c := .Checks}}" +
+		"{{$c.Name}}\t {{if $c.Err}}{{if $c.Warn}}warning: {{else}}FAILED: {{end}}{{$c.Err}}{{else}}ok{{end}}\n" +
+		"{{end}}"
+
+	// `ais bucket snapshot ls`
+	SnapListTmpl = "NAME\t CREATED\t OBJECTS\t SIZE\t TARGETS\n" + SnapListBody
+	SnapListBody = "{{range $v := . }}" +
+		"{{$v.Name}}\t {{FormatUnixNano $v.Created}}\t {{$v.ObjCount}}\t {{FormatBytesSig $v.Size 2}}\t {{$v.NumTargets}}\n" +
+		"{{end}}"
+
+	ECVerifyTmpl = "SLICE\t TARGET\t STATUS\t ERROR\n" +
+		"{{range $v := . }}" +
+		"{{if eq $v.SliceID 0}}replica{{else}}{{$v.SliceID}}{{end}}\t t[{{$v.Node}}]\t {{$v.Status}}\t " +
+		"{{if $v.Err}}{{$v.Err}}{{else}}-{{end}}\n" +
 		"{{end}}"
 
 	BucketSummaryValidateTmpl = "BUCKET\t OBJECTS\t MISPLACED\t MISSING COPIES\n" + bucketSummaryValidateBody
@@ -273,6 +352,11 @@ See '--help' and docs/cli for details.`
 		"{{ $role.Name }}\t{{ $role.Description }}\n" +
 		"{{end}}"
 
+	AuthNSyncTmpl = "OPERATION\tKIND\tNAME\tDETAILS\n" +
+		"{{ range $ch := . }}" +
+		"{{ $ch.Op }}\t{{ $ch.Kind }}\t{{ $ch.Name }}\t{{ $ch.Details }}\n" +
+		"{{end}}"
+
 	AuthNUserTmpl = "NAME\tROLES\n" +
 		"{{ range $user := . }}" +
 		"{{ $user.ID }}\t{{ range $i, $role := $user.Roles }}" +
@@ -295,6 +379,8 @@ See '--help' and docs/cli for details.`
 
 	AuthNRoleVerboseTmpl = "Role\t{{ .Name }}\n" +
 		"Description\t{{ .Description }}\n" +
+		"{{ if .QPS }}Max requests/s\t{{ .QPS }}\n{{ end }}" +
+		"{{ if .MaxConc }}Max concurrent\t{{ .MaxConc }}\n{{ end }}" +
 		"{{ if ne (len .Roles) 0 }}" +
 		"Roles\t{{ JoinList .Roles }}\n" +
 		"{{ end }}" +
@@ -371,6 +457,26 @@ type (
 		BuildTime string // ditto
 		NumDisks  int
 	}
+	// job (xaction) across all targets
+	XactRollup struct {
+		Snap    *core.Snap // aggregated stats, start/end times, and state
+		Nodes   int        // number of targets
+		Running int        // number of targets where the job is still running
+		Errs    int        // number of targets that reported errors
+		Rate    int64      // cluster-wide throughput (bytes per second)
+	}
+	// number of objects pending recovery and recovered, by exposure level
+	ECRestoreHist struct {
+		Level     int
+		Pending   int64
+		Recovered int64
+	}
+	BckDiffEnt struct {
+		Name  string `json:"name"`
+		Diff  string `json:"diff"`  // only-in-left | only-in-right | differ
+		Left  string `json:"left"`  // size[, version][, checksum]
+		Right string `json:"right"` // ditto
+	}
 	ListBucketsHelper struct {
 		XactID string
 		Bck    cmn.Bck
@@ -388,12 +494,15 @@ var (
 		// formatting
 		"FormatBytesSig":      func(size int64, digits int) string { return FmtSize(size, cos.UnitsIEC, digits) },
 		"FormatBytesSig2":     fmtSize2,
+		"FormatWidth":         func(width int, v any) string { return fmtWidth(fmt.Sprint(v), width) },
 		"FormatBytesUns":      func(size uint64, digits int) string { return FmtSize(int64(size), cos.UnitsIEC, digits) },
 		"FormatMAM":           func(u int64) string { return fmt.Sprintf("%-10s", FmtSize(u, cos.UnitsIEC, 2)) },
 		"FormatMilli":         func(dur cos.Duration) string { return fmtMilli(dur, cos.UnitsIEC) },
 		"FormatDuration":      FormatDuration,
+		"FormatDurationNs":    func(ns int64) string { return FormatDuration(time.Duration(ns)) },
 		"FormatStart":         FmtTime,
 		"FormatEnd":           FmtTime,
+		"FormatUnixNano":      func(ns int64) string { return FmtDateTime(time.Unix(0, ns)) },
 		"FormatDsortStatus":   dsortJobInfoStatus,
 		"FormatLsObjStatus":   fmtLsObjStatus,
 		"FormatLsObjIsCached": fmtLsObjIsCached,
@@ -411,6 +520,7 @@ var (
 		"FormatACL":           fmtACL,
 		"FormatNameDirArch":   fmtNameDirArch,
 		"FormatXactState":     FmtXactStatus,
+		"FormatQuota":         fmtQuota,
 		//  misc. helpers
 		"IsUnsetTime":   isUnsetTime,
 		"IsEqS":         func(a, b string) bool { return a == b },
@@ -243,6 +243,31 @@ func fmtACL(acl apc.AccessAttrs) string {
 	return acl.Describe(true /*incl. all*/)
 }
 
+func fmtQuota(summ *cmn.BsummResult) string {
+	q := &summ.Quota
+	if q.MaxBytes == 0 && q.MaxObjects == 0 {
+		return NotSetVal
+	}
+	var (
+		parts = make([]string, 0, 2)
+		pct   = max(q.UsedPct, q.ObjUsedPct)
+	)
+	if q.MaxBytes > 0 {
+		parts = append(parts, fmt.Sprintf("%s (%d%%)", FmtSize(int64(q.MaxBytes), cos.UnitsIEC, 2), q.UsedPct))
+	}
+	if q.MaxObjects > 0 {
+		parts = append(parts, fmt.Sprintf("%d objects (%d%%)", q.MaxObjects, q.ObjUsedPct))
+	}
+	s := strings.Join(parts, ", ")
+	switch {
+	case pct >= 100:
+		s += fred(" (full)")
+	case q.SoftPct > 0 && pct > q.SoftPct:
+		s += fcyan(" (above soft threshold)")
+	}
+	return s
+}
+
 func fmtNameDirArch(val string, flags uint16) string {
 	if flags&apc.EntryInArch == 0 {
 		if flags&apc.EntryIsDir != 0 {
@@ -283,6 +308,9 @@ func fmtRebStatus(snap *core.Snap) string {
 		if snap.AbortErr == cmn.ErrXactUserAbort.Error() {
 			return fmt.Sprintf("user-abort(%s)", snap.ID)
 		}
+		if snap.AbortErr == cmn.ErrXactDeadline.Error() {
+			return fmt.Sprintf("deadline-exceeded(%s)", snap.ID)
+		}
 		return fmt.Sprintf("%s(%s): %q", strings.ToLower(xaborted), snap.ID, snap.AbortErr)
 	}
 	if snap.EndTime.IsZero() {
@@ -306,6 +334,9 @@ func FmtXactStatus(snap *core.Snap) (s string) {
 		if snap.AbortErr == cmn.ErrXactUserAbort.Error() {
 			return xaborted + " by user"
 		}
+		if snap.AbortErr == cmn.ErrXactDeadline.Error() {
+			return xdeadline
+		}
 		return fmt.Sprintf("%s: %q", xaborted, snap.AbortErr)
 	case !snap.EndTime.IsZero():
 		if snap.Err == "" {
@@ -323,6 +354,18 @@ func FmtXactStatus(snap *core.Snap) (s string) {
 	return
 }
 
+// bucket column: source => destination, or the (single) bucket, or none
+func (r *XactRollup) Bucket() string {
+	switch {
+	case !r.Snap.SrcBck.IsEmpty():
+		return fmtBckName(r.Snap.SrcBck) + " => " + fmtBckName(r.Snap.DstBck)
+	case !r.Snap.Bck.IsEmpty():
+		return fmtBckName(r.Snap.Bck)
+	default:
+		return NotSetVal
+	}
+}
+
 func extECGetStats(base *core.Snap) *ec.ExtECGetStats {
 	ecGet := &ec.ExtECGetStats{}
 	if err := cos.MorphMarshal(base.Ext, ecGet); err != nil {
@@ -108,3 +108,42 @@ func TestActMsgRawUnmarshal(t *testing.T) {
 		testRawUnmarshal(t, test)
 	}
 }
+
+func TestETLFilter(t *testing.T) {
+	md := cos.StrKVs{"label": "cat", "src": "cam1"}
+	tests := []struct {
+		filter  apc.ETLFilter
+		objName string
+		size    int64
+		match   bool
+	}{
+		{apc.ETLFilter{}, "a/b.jpg", 10, true},
+		{apc.ETLFilter{Prefix: "a/"}, "a/b.jpg", 10, true},
+		{apc.ETLFilter{Prefix: "b/"}, "a/b.jpg", 10, false},
+		{apc.ETLFilter{Exts: []string{"png", ".JPG"}}, "a/b.jpg", 10, true},
+		{apc.ETLFilter{Exts: []string{"jpg"}}, "a.jpg/b", 10, false},
+		{apc.ETLFilter{Exts: []string{"jpg"}}, "a/b", 10, false},
+		{apc.ETLFilter{MinSize: 10, MaxSize: 20}, "a/b.jpg", 10, true},
+		{apc.ETLFilter{MinSize: 11}, "a/b.jpg", 10, false},
+		{apc.ETLFilter{MaxSize: 9}, "a/b.jpg", 10, false},
+		{apc.ETLFilter{CustomMD: cos.StrKVs{"label": "cat"}}, "a/b.jpg", 10, true},
+		{apc.ETLFilter{CustomMD: cos.StrKVs{"label": "dog"}}, "a/b.jpg", 10, false},
+		{apc.ETLFilter{CustomMD: cos.StrKVs{"src": ""}}, "a/b.jpg", 10, true},
+		{apc.ETLFilter{CustomMD: cos.StrKVs{"owner": ""}}, "a/b.jpg", 10, false},
+	}
+	for _, test := range tests {
+		tassert.CheckFatal(t, test.filter.Validate())
+		match := test.filter.Match(test.objName, test.size, md)
+		tassert.Errorf(t, match == test.match, "%+v: %q (size %d): expected match=%t, got %t",
+			test.filter, test.objName, test.size, test.match, match)
+	}
+
+	invalid := []apc.ETLFilter{
+		{MinSize: -1},
+		{MinSize: 20, MaxSize: 10},
+		{Exts: []string{"."}},
+	}
+	for _, filter := range invalid {
+		tassert.Errorf(t, filter.Validate() != nil, "%+v: expected validation error", filter)
+	}
+}
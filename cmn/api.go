@@ -56,6 +56,19 @@ type (
 		BID         uint64          `json:"bid,string" list:"omit"`         // unique ID
 		Created     int64           `json:"created,string" list:"readonly"` // creation timestamp
 		Versioning  VersionConf     `json:"versioning"`                     // versioning (see "inherit")
+		Quota       QuotaConf       `json:"quota"`                          // usage quota (disabled when zero)
+	}
+
+	// bucket (cluster-wide) usage quota
+	QuotaConf struct {
+		MaxBytes   int64 `json:"max_bytes,string"`   // total size of all objects in the bucket, in bytes
+		MaxObjects int64 `json:"max_objects,string"` // total number of objects in the bucket
+		SoftPct    int64 `json:"soft_pct"`           // soft threshold (% of the quota) - warn but do not reject
+	}
+	QuotaConfToSet struct {
+		MaxBytes   *int64 `json:"max_bytes,string,omitempty"`
+		MaxObjects *int64 `json:"max_objects,string,omitempty"`
+		SoftPct    *int64 `json:"soft_pct,omitempty"`
 	}
 
 	ExtraProps struct {
@@ -88,20 +101,32 @@ type (
 		// vs OpenStack Swift: 10,000
 		// - https://docs.openstack.org/swift/latest/api/pagination.html
 		MaxPageSize int64 `json:"max_pagesize,omitempty"`
+
+		// server-side encryption with AWS KMS (SSE-KMS) of all objects written to the S3 bucket
+		// (PUT, write-back, multipart upload) - KMS key ID, ARN, or alias;
+		// empty: bucket's own default encryption (if any) applies
+		SSEKMSKeyID string `json:"sse_kms_key_id,omitempty"`
 	}
 	ExtraPropsAWSToSet struct {
 		CloudRegion *string `json:"cloud_region"`
 		Endpoint    *string `json:"endpoint"`
 		Profile     *string `json:"profile"`
 		MaxPageSize *int64  `json:"max_pagesize"`
+		SSEKMSKeyID *string `json:"sse_kms_key_id"`
 	}
 
 	ExtraPropsHTTP struct {
 		// Original URL prior to hashing.
 		OrigURLBck string `json:"original_url,omitempty" list:"readonly"`
+
+		// How to list remote objects (by default, only in-cluster objects are listed):
+		// - "html": crawl HTML directory index pages (e.g., nginx autoindex) starting from the original URL
+		// - URL of a manifest: plain text, one object name (relative to the original URL) per line
+		Index string `json:"index,omitempty"`
 	}
 	ExtraPropsHTTPToSet struct {
 		OrigURLBck *string `json:"original_url"`
+		Index      *string `json:"index"`
 	}
 
 	ExtraPropsHDFS struct {
@@ -126,6 +151,7 @@ type (
 		Features    *feat.Flags           `json:"features,string,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
 		Extra       *ExtraToSet           `json:"extra,omitempty"`
+		Quota       *QuotaConfToSet       `json:"quota,omitempty"`
 		Force       bool                  `json:"force,omitempty" copy:"skip" list:"omit"`
 	}
 
@@ -133,6 +159,20 @@ type (
 		Name     *string `json:"name"`
 		Provider *string `json:"provider"`
 	}
+
+	// set-bucket-props and reset-bucket-props with apc.QparamDryRun:
+	// the resulting (effective) props, the differences vs. current props,
+	// and the xactions that the change would trigger (e.g., apc.ActMakeNCopies)
+	BpropsDryRun struct {
+		Props    *Bprops     `json:"props"`
+		Diff     []BpropDiff `json:"diff"`
+		Xactions []string    `json:"xactions,omitempty"`
+	}
+	BpropDiff struct {
+		Name string `json:"name"` // e.g. "mirror.copies" (see IterFields)
+		Old  string `json:"old"`
+		New  string `json:"new"`
+	}
 )
 
 /////////////////
@@ -198,6 +238,31 @@ func (bp *Bprops) Equal(other *Bprops) (eq bool) {
 	return
 }
 
+// returns changed props sorted by name
+func (bp *Bprops) Diff(other *Bprops) (diff []BpropDiff) {
+	var (
+		from = bp.flatten()
+		to   = other.flatten()
+	)
+	for name, nv := range to {
+		if ov := from[name]; ov != nv {
+			diff = append(diff, BpropDiff{Name: name, Old: ov, New: nv})
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool { return diff[i].Name < diff[j].Name })
+	return diff
+}
+
+func (bp *Bprops) flatten() cos.StrKVs {
+	nvs := make(cos.StrKVs, 32)
+	err := IterFields(bp, func(tag string, field IterField) (error, bool) {
+		nvs[tag] = fmt.Sprintf("%v", field.Value())
+		return nil, false
+	})
+	debug.AssertNoErr(err)
+	return nvs
+}
+
 func (bp *Bprops) Validate(targetCnt int) error {
 	debug.Assert(apc.IsProvider(bp.Provider))
 	if !bp.BackendBck.IsEmpty() {
@@ -218,7 +283,7 @@ func (bp *Bprops) Validate(targetCnt int) error {
 
 	// run assorted props validators
 	var softErr error
-	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy} {
+	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.Quota} {
 		var err error
 		if pv == &bp.EC {
 			err = bp.EC.ValidateAsProps(targetCnt)
@@ -279,12 +344,41 @@ func NewBpropsToSet(nvs cos.StrKVs) (props *BpropsToSet, err error) {
 func (c *ExtraProps) ValidateAsProps(arg ...any) error {
 	provider, ok := arg[0].(string)
 	debug.Assert(ok)
-	if provider == apc.HT && c.HTTP.OrigURLBck == "" {
+	if provider != apc.HT {
+		return nil
+	}
+	if c.HTTP.OrigURLBck == "" {
 		return errors.New("original bucket URL must be set for a bucket with HTTP provider")
 	}
+	if idx := c.HTTP.Index; idx != "" && idx != apc.HTIndexHTML && !cos.IsHT(idx) && !cos.IsHTTPS(idx) {
+		return fmt.Errorf("invalid extra.http.index %q (expecting %q or manifest URL)", idx, apc.HTIndexHTML)
+	}
+	return nil
+}
+
+// whether remote ht:// objects can be listed (see ExtraPropsHTTP.Index)
+func (c *ExtraPropsHTTP) Listable() bool { return c.Index != "" }
+
+func (c *QuotaConf) ValidateAsProps(...any) error {
+	if c.MaxBytes < 0 {
+		return fmt.Errorf("invalid quota.max_bytes %d (expecting non-negative integer)", c.MaxBytes)
+	}
+	if c.MaxObjects < 0 {
+		return fmt.Errorf("invalid quota.max_objects %d (expecting non-negative integer)", c.MaxObjects)
+	}
+	if c.SoftPct < 0 || c.SoftPct >= 100 {
+		return fmt.Errorf("invalid quota.soft_pct %d (expecting [0, 100) range)", c.SoftPct)
+	}
 	return nil
 }
 
+func (c *QuotaConf) Enabled() bool { return c.MaxBytes > 0 || c.MaxObjects > 0 }
+
+// whether `used` exceeds the soft threshold (if any) of the `limit`
+func (c *QuotaConf) SoftExceeded(used, limit int64) bool {
+	return c.SoftPct > 0 && limit > 0 && used*100 > limit*c.SoftPct
+}
+
 //
 // Bucket Summary - result for a given bucket, and all results -------------------------------------------------
 //
@@ -349,6 +443,23 @@ func (s AllBsummResults) Finalize(dsize map[string]uint64, testingEnv bool) {
 	}
 }
 
+// (proxy) add bucket quota and its current utilization
+func (summ *BsummResult) SetQuota(props *Bprops) {
+	if props == nil || !props.Quota.Enabled() {
+		return
+	}
+	q := &summ.Quota
+	q.SoftPct = uint64(props.Quota.SoftPct)
+	if props.Quota.MaxBytes > 0 {
+		q.MaxBytes = uint64(props.Quota.MaxBytes)
+		q.UsedPct = cos.DivRoundU64(summ.TotalSize.OnDisk*100, q.MaxBytes)
+	}
+	if props.Quota.MaxObjects > 0 {
+		q.MaxObjects = uint64(props.Quota.MaxObjects)
+		q.ObjUsedPct = cos.DivRoundU64(summ.ObjCount.Present*100, q.MaxObjects)
+	}
+}
+
 //
 // Multi-object (list|range) operations source bucket => dest. bucket ---------------------------------------
 //
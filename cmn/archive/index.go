@@ -0,0 +1,119 @@
+// Package archive: write, read, copy, append, list primitives
+// across all supported formats
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package archive
+
+import (
+	"archive/tar"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Archived-content index (TAR only):
+// - names, sizes, and data offsets of all archived files, in the order of appearance;
+// - allows to list and read archived files without reading the whole shard;
+// - stamped with the shard's size and mtime - a mismatch means the index is stale
+//   (e.g., the shard was overwritten) and must not be used.
+// Stored alongside the shard (see fs.ArchIdxType and core.LOM.LoadArchIndex).
+
+const IndexVersion = 1
+
+type (
+	Index struct {
+		Entries []*IdxEntry `json:"entries"`
+		Size    int64       `json:"size,string"`  // shard size
+		Mtime   int64       `json:"mtime,string"` // shard mtime (unix nanoseconds)
+		Version int         `json:"v"`
+	}
+	IdxEntry struct {
+		Name   string `json:"n"`
+		Offset int64  `json:"o"` // data offset
+		Size   int64  `json:"s"`
+	}
+)
+
+// build index by reading (and seeking through) the entire TAR
+func BuildIndex(fh io.ReadSeeker) (*Index, error) {
+	var (
+		idx = &Index{Version: IndexVersion}
+		tr  = tar.NewReader(fh)
+	)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				return idx, nil
+			}
+			return nil, err
+		}
+		if hdr.FileInfo().IsDir() {
+			continue
+		}
+		// (tar reader has just consumed the header - current position is where data starts)
+		off, err := fh.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		idx.Entries = append(idx.Entries, &IdxEntry{Name: hdr.Name, Offset: off, Size: hdr.Size})
+	}
+}
+
+func (idx *Index) Add(name string, offset, size int64) {
+	idx.Entries = append(idx.Entries, &IdxEntry{Name: name, Offset: offset, Size: size})
+}
+
+// stamp with the shard's current size and mtime
+func (idx *Index) Stamp(finfo os.FileInfo) {
+	idx.Size, idx.Mtime = finfo.Size(), finfo.ModTime().UnixNano()
+}
+
+func (idx *Index) Valid(finfo os.FileInfo) bool {
+	return idx.Version == IndexVersion && idx.Size == finfo.Size() && idx.Mtime == finfo.ModTime().UnixNano()
+}
+
+// first match - same as tarReader.ReadOne
+func (idx *Index) Find(filename string) *IdxEntry {
+	for _, e := range idx.Entries {
+		if e.Name == filename || namesEq(e.Name, filename) {
+			return e
+		}
+	}
+	return nil
+}
+
+// same as List(fqn) - sorted by name
+func (idx *Index) List() []*Entry {
+	lst := make([]*Entry, len(idx.Entries))
+	for i, e := range idx.Entries {
+		lst[i] = &Entry{Name: e.Name, Size: e.Size}
+	}
+	sort.Slice(lst, func(i, j int) bool { return lst[i].Name < lst[j].Name })
+	return lst
+}
+
+// open archived file for reading (does not take ownership of `fh`)
+func (idx *Index) Open(fh io.ReaderAt, e *IdxEntry) cos.ReadCloseSizer {
+	return &cslSection{SectionReader: io.NewSectionReader(fh, e.Offset, e.Size)}
+}
+
+func (idx *Index) Marshal() []byte { return cos.MustMarshal(idx) }
+
+func UnmarshalIndex(b []byte) (*Index, error) {
+	idx := &Index{}
+	if err := jsoniter.Unmarshal(b, idx); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+type cslSection struct {
+	*io.SectionReader
+}
+
+func (*cslSection) Close() error { return nil }
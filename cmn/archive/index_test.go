@@ -0,0 +1,97 @@
+// Package archive_test: unit tests
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package archive_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestIndexAppend(t *testing.T) {
+	var (
+		fqn   = filepath.Join(t.TempDir(), "shard.tar")
+		files = map[string]string{
+			"a.txt":                     "aaa",
+			"dir/b.bin":                 strings.Repeat("b", 1000),
+			strings.Repeat("c", 120):    "long name (PAX header)",
+			"dir/subdir/empty-file.txt": "",
+		}
+		buf bytes.Buffer
+	)
+	tw := tar.NewWriter(&buf)
+	for name, content := range files {
+		tassert.CheckFatal(t, tw.WriteHeader(&tar.Header{Name: name, Size: int64(len(content)), Mode: 0o644, Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		tassert.CheckFatal(t, err)
+	}
+	tassert.CheckFatal(t, tw.Close())
+	tassert.CheckFatal(t, os.WriteFile(fqn, buf.Bytes(), 0o644))
+
+	fh, err := os.Open(fqn)
+	tassert.CheckFatal(t, err)
+	idx, err := archive.BuildIndex(fh)
+	fh.Close()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(idx.Entries) == len(files), "expecting %d entries, got %d", len(files), len(idx.Entries))
+
+	// fast append (same as target's putA2I)
+	const (
+		name    = "appended.txt"
+		content = "appended content"
+	)
+	rwfh, format, _, err := archive.OpenTarForAppend(fqn, fqn)
+	tassert.CheckFatal(t, err)
+	tw = tar.NewWriter(rwfh)
+	tassert.CheckFatal(t, tw.WriteHeader(&tar.Header{Name: name, Size: int64(len(content)), Mode: 0o644,
+		Typeflag: tar.TypeReg, Format: format}))
+	off, err := rwfh.Seek(0, io.SeekCurrent)
+	tassert.CheckFatal(t, err)
+	_, err = tw.Write([]byte(content))
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, tw.Close())
+	rwfh.Close()
+	idx.Add(name, off, int64(len(content)))
+
+	// updated index must be identical to the one built from scratch
+	fh, err = os.Open(fqn)
+	tassert.CheckFatal(t, err)
+	defer fh.Close()
+	rebuilt, err := archive.BuildIndex(fh)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(rebuilt.Entries) == len(idx.Entries), "expecting %d entries, got %d",
+		len(idx.Entries), len(rebuilt.Entries))
+	for i, e := range rebuilt.Entries {
+		tassert.Errorf(t, *e == *idx.Entries[i], "entry %d: %+v vs %+v", i, *e, *idx.Entries[i])
+	}
+
+	// read archived files via index
+	files[name] = content
+	for fname, expected := range files {
+		e := idx.Find(fname)
+		tassert.Fatalf(t, e != nil, "%q not found", fname)
+		b, err := io.ReadAll(idx.Open(fh, e))
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, string(b) == expected, "%q: expecting %q, got %q", fname, expected, string(b))
+	}
+	tassert.Errorf(t, idx.Find("nonexistent") == nil, "expecting not found")
+
+	// stamp and validate
+	finfo, err := fh.Stat()
+	tassert.CheckFatal(t, err)
+	idx.Stamp(finfo)
+	idx, err = archive.UnmarshalIndex(idx.Marshal())
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, idx.Valid(finfo), "expecting valid index")
+	lst := idx.List()
+	tassert.Errorf(t, len(lst) == len(files) && lst[0].Name == "a.txt", "unexpected list: %v", lst)
+}
@@ -24,6 +24,10 @@ const name = "tls-cert-loader"
 const (
 	dfltTimeInvalid = time.Hour
 	warnSoonExpire  = 3 * 24 * time.Hour
+
+	// how often to fstat (cert, key) to detect rotation (e.g., by cert-manager) and reload;
+	// listeners use the most recently loaded certificate (see GetCert) - no restart required
+	watchInterval = time.Minute
 )
 
 const fmtErrExpired = "%s: %s expired (valid until %v)"
@@ -33,9 +37,12 @@ type (
 		tls.Certificate
 		parent    *certLoader
 		modTime   time.Time
+		keyMod    time.Time
 		notBefore time.Time
 		notAfter  time.Time
+		loaded    time.Time
 		size      int64
+		keySize   int64
 	}
 	certLoader struct {
 		tstats   cos.StatsUpdater
@@ -53,6 +60,19 @@ type (
 	errExpired struct {
 		msg string
 	}
+
+	// currently loaded X.509 (see GetInfo)
+	Info struct {
+		NotBefore time.Time `json:"not_before"`
+		NotAfter  time.Time `json:"not_after"`
+		Loaded    time.Time `json:"loaded"` // when (re)loaded
+		CertFile  string    `json:"cert_file"`
+		KeyFile   string    `json:"key_file"`
+		Subject   string    `json:"subject"`
+		Issuer    string    `json:"issuer"`
+		DNSNames  []string  `json:"dns_names,omitempty"`
+		Error     string    `json:"error,omitempty"` // invalid or expired
+	}
 )
 
 var (
@@ -72,7 +92,7 @@ func Init(certFile, keyFile string, tstats cos.StatsUpdater) (err error) {
 		return err
 	}
 
-	hk.Reg(name, gcl.hk, gcl.hktime())
+	hk.Reg(name, gcl.hk, min(gcl.hktime(), watchInterval))
 	return nil
 }
 
@@ -93,7 +113,7 @@ func (cl *certLoader) hk() time.Duration {
 	if err := cl.do(true /*compare*/); err != nil {
 		nlog.Errorln(err)
 	}
-	return cl.hktime()
+	return min(cl.hktime(), watchInterval)
 }
 
 func (cl *certLoader) hktime() (d time.Duration) {
@@ -166,22 +186,48 @@ func GetClientCert() (GetClientCertCB, error) {
 	return gcl._info, nil
 }
 
+// GetInfo returns the currently loaded certificate (empty Info when TLS is not in use).
+func GetInfo() *Info {
+	info := &Info{}
+	if gcl == nil {
+		return info
+	}
+	info.CertFile, info.KeyFile = gcl.certFile, gcl.keyFile
+	if err := gcl.errorf(); err != nil {
+		info.Error = err.Error()
+	}
+	xcert := gcl.xcert.Load()
+	if xcert == nil || xcert.Leaf == nil {
+		return info
+	}
+	info.NotBefore, info.NotAfter, info.Loaded = xcert.notBefore, xcert.notAfter, xcert.loaded
+	info.Subject = xcert.Leaf.Subject.String()
+	info.Issuer = xcert.Leaf.Issuer.String()
+	info.DNSNames = xcert.Leaf.DNSNames
+	return info
+}
+
 func (cl *certLoader) do(compare bool) (err error) {
 	var (
-		finfo os.FileInfo
-		xcert = xcert{parent: cl}
+		finfo, kinfo os.FileInfo
+		xcert        = xcert{parent: cl}
 	)
-	// 1. fstat
+	// 1. fstat (both; when mounted from a secret, the files are symlinks that get atomically swapped)
 	finfo, err = os.Stat(cl.certFile)
 	if err != nil {
 		return fmt.Errorf("%s: failed to fstat %q, err: %w", name, cl.certFile, err)
 	}
+	kinfo, err = os.Stat(cl.keyFile)
+	if err != nil {
+		return fmt.Errorf("%s: failed to fstat %q, err: %w", name, cl.keyFile, err)
+	}
 
 	// 2. updated?
 	if compare {
 		xcert := cl.xcert.Load()
 		debug.Assert(xcert != nil, "expecting X.509 loaded at startup: ", cl.certFile, ", ", cl.keyFile)
-		if finfo.ModTime() == xcert.modTime && finfo.Size() == xcert.size {
+		if finfo.ModTime() == xcert.modTime && finfo.Size() == xcert.size &&
+			kinfo.ModTime() == xcert.keyMod && kinfo.Size() == xcert.keySize {
 			return nil
 		}
 	}
@@ -191,7 +237,7 @@ func (cl *certLoader) do(compare bool) (err error) {
 	if err != nil {
 		return fmt.Errorf("%s: failed to load (%s, %s), err: %w", name, cl.certFile, cl.keyFile, err)
 	}
-	rem, err := xcert.ini(finfo)
+	rem, err := xcert.ini(finfo, kinfo)
 	if err != nil {
 		return err
 	}
@@ -226,7 +272,7 @@ func (x *xcert) String() string {
 
 // NOTE: second time parsing certificate (first time in tls.LoadX509KeyPair above)
 // to find out valid time bounds
-func (x *xcert) ini(finfo os.FileInfo) (rem time.Duration, err error) {
+func (x *xcert) ini(finfo, kinfo os.FileInfo) (rem time.Duration, err error) {
 	if x.Certificate.Leaf == nil {
 		x.Certificate.Leaf, err = x509.ParseCertificate(x.Certificate.Certificate[0])
 		if err != nil {
@@ -236,6 +282,9 @@ func (x *xcert) ini(finfo os.FileInfo) (rem time.Duration, err error) {
 	{
 		x.modTime = finfo.ModTime()
 		x.size = finfo.Size()
+		x.keyMod = kinfo.ModTime()
+		x.keySize = kinfo.Size()
+		x.loaded = time.Now()
 		x.notBefore = x.Certificate.Leaf.NotBefore
 		x.notAfter = x.Certificate.Leaf.NotAfter
 	}
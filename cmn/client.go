@@ -40,13 +40,9 @@ type (
 	}
 )
 
-// {TransportArgs + defaults} => http.Transport for a variety of ais clients
-// NOTE: TLS below, and separately
-func NewTransport(cargs TransportArgs) *http.Transport {
-	var (
-		dialTimeout      = cargs.DialTimeout
-		defaultTransport = http.DefaultTransport.(*http.Transport)
-	)
+// {TransportArgs + defaults} => net.Dialer (see also NewTransport)
+func NewDialer(cargs TransportArgs) *net.Dialer {
+	dialTimeout := cargs.DialTimeout
 	if dialTimeout == 0 {
 		dialTimeout = 30 * time.Second
 	}
@@ -58,6 +54,16 @@ func NewTransport(cargs TransportArgs) *http.Transport {
 	if cargs.SndRcvBufSize > 0 {
 		dialer.Control = cargs.setSockOpt
 	}
+	return dialer
+}
+
+// {TransportArgs + defaults} => http.Transport for a variety of ais clients
+// NOTE: TLS below, and separately
+func NewTransport(cargs TransportArgs) *http.Transport {
+	var (
+		dialer           = NewDialer(cargs)
+		defaultTransport = http.DefaultTransport.(*http.Transport)
+	)
 	transport := &http.Transport{
 		DialContext:           dialer.DialContext,
 		TLSHandshakeTimeout:   defaultTransport.TLSHandshakeTimeout,
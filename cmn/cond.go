@@ -0,0 +1,113 @@
+// Package cmn provides common constants, types, and utilities for AIS clients and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Conditional requests (RFC 9110, section 13): GET, PUT, and DELETE(object)
+// with If-Match, If-None-Match, If-Modified-Since, and If-Unmodified-Since.
+// Evaluation order (RFC 9110, section 13.2.2):
+// 1. If-Match, or (if not present) If-Unmodified-Since - 412 when false
+// 2. If-None-Match, or (if not present and reading) If-Modified-Since - 304 when reading, 412 otherwise
+
+const ETagAny = "*"
+
+type Cond struct {
+	IfModifiedSince   time.Time
+	IfUnmodifiedSince time.Time
+	IfMatch           []string // entity tags (unquoted); ETagAny matches any existing object
+	IfNoneMatch       []string // ditto
+}
+
+// returns nil if the request is not conditional
+func ParseCond(hdr http.Header) (*Cond, error) {
+	var (
+		c   Cond
+		err error
+	)
+	if v := hdr.Get(cos.HdrIfMatch); v != "" {
+		c.IfMatch = parseETags(v)
+	}
+	if v := hdr.Get(cos.HdrIfNoneMatch); v != "" {
+		c.IfNoneMatch = parseETags(v)
+	}
+	if v := hdr.Get(cos.HdrIfModifiedSince); v != "" {
+		if c.IfModifiedSince, err = http.ParseTime(v); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", cos.HdrIfModifiedSince, v, err)
+		}
+	}
+	if v := hdr.Get(cos.HdrIfUnmodifiedSince); v != "" {
+		if c.IfUnmodifiedSince, err = http.ParseTime(v); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", cos.HdrIfUnmodifiedSince, v, err)
+		}
+	}
+	if c.IfMatch == nil && c.IfNoneMatch == nil && c.IfModifiedSince.IsZero() && c.IfUnmodifiedSince.IsZero() {
+		return nil, nil
+	}
+	return &c, nil
+}
+
+// comma-separated list of (quoted, possibly weak) entity tags
+func parseETags(v string) (tags []string) {
+	for _, s := range strings.Split(v, ",") {
+		s = strings.TrimPrefix(strings.TrimSpace(s), "W/") // (weak comparison)
+		if s = UnquoteCEV(s); s != "" {
+			tags = append(tags, s)
+		}
+	}
+	return tags
+}
+
+// Eval returns zero if the request must proceed; otherwise, http.StatusNotModified (when `read`)
+// or http.StatusPreconditionFailed.
+// `etags` is the list of the object's entity tags (any one of them matches), `mtime` - its last modification time.
+func (c *Cond) Eval(exists bool, etags []string, mtime time.Time, read bool) int {
+	mtime = mtime.Truncate(time.Second) // (HTTP-date granularity)
+	switch {
+	case c.IfMatch != nil:
+		if !exists || !matchETag(c.IfMatch, etags) {
+			return http.StatusPreconditionFailed
+		}
+	case !c.IfUnmodifiedSince.IsZero():
+		if exists && mtime.After(c.IfUnmodifiedSince) {
+			return http.StatusPreconditionFailed
+		}
+	}
+	switch {
+	case c.IfNoneMatch != nil:
+		if exists && matchETag(c.IfNoneMatch, etags) {
+			if read {
+				return http.StatusNotModified
+			}
+			return http.StatusPreconditionFailed
+		}
+	case read && !c.IfModifiedSince.IsZero():
+		if exists && !mtime.After(c.IfModifiedSince) {
+			return http.StatusNotModified
+		}
+	}
+	return 0
+}
+
+func matchETag(tags, etags []string) bool {
+	for _, tag := range tags {
+		if tag == ETagAny {
+			return true
+		}
+		for _, etag := range etags {
+			if etag != "" && tag == UnquoteCEV(etag) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -10,12 +10,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"net/http"
 	"net/url"
 	"os"
 	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
+	ratomic "sync/atomic"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -25,6 +28,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/sys"
 	jsoniter "github.com/json-iterator/go"
 )
 
@@ -67,6 +71,15 @@ type (
 		Port                 int    `json:"port,string"`               // listening port
 		PortIntraControl     int    `json:"port_intra_control,string"` // --/-- for intra-cluster control
 		PortIntraData        int    `json:"port_intra_data,string"`    // --/-- for intra-cluster data
+		// comma-separated names of the network interfaces to select local unicast IPs from (default: all)
+		Interfaces string `json:"interfaces,omitempty"`
+		// IP address family: "ipv4" (default), "ipv6", or "dual" (both, IPv4 preferred)
+		IPFamily string `json:"ip_family,omitempty"`
+		// CIDR subnets to automatically select the respective network interface (local unicast IP) from;
+		// used only when the corresponding hostname is not configured
+		CIDR             string `json:"cidr,omitempty"`
+		CIDRIntraControl string `json:"cidr_intra_control,omitempty"`
+		CIDRIntraData    string `json:"cidr_intra_data,omitempty"`
 		// omit
 		UseIntraControl bool `json:"-"`
 		UseIntraData    bool `json:"-"`
@@ -119,9 +132,26 @@ type (
 		// Transform (offline) or Copy src Bucket => dst bucket
 		TCB TCBConf `json:"tcb"`
 
+		// xaction registry: retention of finished xactions
+		Xact XactConf `json:"xaction"`
+
 		// metadata write policy: (immediate | delayed | never)
 		WritePolicy WritePolicyConf `json:"write_policy"`
 
+		// OpenTelemetry tracing (W3C trace context; OTLP/HTTP export)
+		Tracing TracingConf `json:"tracing"`
+
+		// maintenance window(s) for housekeeping xactions
+		Housekeep HousekeepConf `json:"housekeeping"`
+
+		// cross-origin resource sharing (CORS) for browser-based clients
+		CORS CORSConf `json:"cors"`
+		// alert rules evaluated by the primary proxy; notification sinks
+		Alerts AlertsConf `json:"alerts" allow:"cluster"`
+
+		// GPU utilization and memory (optional; for nodes co-located with GPUs)
+		GPU GPUConf `json:"gpu"`
+
 		// standalone enumerated features that can be configured
 		// to flip assorted global defaults (see cmn/feat/feat.go)
 		Features feat.Flags `json:"features,string" allow:"cluster"`
@@ -156,7 +186,13 @@ type (
 		Transport   *TransportConfToSet   `json:"transport,omitempty"`
 		Memsys      *MemsysConfToSet      `json:"memsys,omitempty"`
 		TCB         *TCBConfToSet         `json:"tcb,omitempty"`
+		Xact        *XactConfToSet        `json:"xaction,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
+		Tracing     *TracingConfToSet     `json:"tracing,omitempty"`
+		Housekeep   *HousekeepConfToSet   `json:"housekeeping,omitempty"`
+		CORS        *CORSConfToSet        `json:"cors,omitempty"`
+		GPU         *GPUConfToSet         `json:"gpu,omitempty"`
+		Alerts      *AlertsConfToSet      `json:"alerts,omitempty"`
 		Proxy       *ProxyConfToSet       `json:"proxy,omitempty"`
 		Features    *feat.Flags           `json:"features,string,omitempty"`
 
@@ -170,6 +206,25 @@ type (
 	}
 	BackendConfAIS map[string][]string // cluster alias -> [urls...]
 
+	// HTTP client tuning for a given cloud (or HTTP) backend: `backend.<provider>` section, e.g.:
+	// "backend": {"aws": {"endpoint": "http://minio.local:9000", "use_path_style": true, "max_retries": 5}, "gcp": {}}
+	// zero values (including empty `{}`) mean compiled-in defaults
+	BackendClientConf struct {
+		// custom endpoint (aws, gcp, azure), e.g. on-prem S3-compatible storage;
+		// takes precedence over the respective environment variable (but not over bucket props)
+		Endpoint string `json:"endpoint,omitempty"`
+		// total time limit for a single request, including reading response body (zero: no limit)
+		Timeout          cos.Duration `json:"timeout,omitempty"`
+		DialTimeout      cos.Duration `json:"dial_timeout,omitempty"`
+		IdleConnTimeout  cos.Duration `json:"idle_conn_timeout,omitempty"`
+		MaxIdleConns     int          `json:"max_idle_conns,omitempty"`
+		IdleConnsPerHost int          `json:"max_idle_conns_per_host,omitempty"`
+		// max number of attempts (aws, gcp) or retries (azure); zero: SDK default
+		MaxRetries int `json:"max_retries,omitempty"`
+		// aws only: path-style addressing, same as (and in addition to) feature flag "S3-Use-Path-Style"
+		UsePathStyle bool `json:"use_path_style,omitempty"`
+	}
+
 	MirrorConf struct {
 		Copies  int64 `json:"copies"`       // num copies
 		Burst   int   `json:"burst_buffer"` // xaction channel (buffer) size
@@ -199,8 +254,17 @@ type (
 
 		// Number of data (D) slices; the value 1 will have an effect of producing
 		// (P) additional full-size replicas.
+		// With adaptive slicing (see `MinSliceSize`) - the maximum number of data slices.
 		DataSlices int `json:"data_slices"`
 
+		// Adaptive slicing: when non-zero, an object gets as many data slices as it takes for each
+		// slice to be at least `MinSliceSize` but no more than (D) - and never less than one.
+		// In other words, very large objects are sliced into (D) bigger slices, while medium-size
+		// objects get fewer (and not too small) slices - with no change in the number of parity
+		// slices (P) and, therefore, in the level of data protection.
+		// The resulting geometry is recorded in the object's EC metadata and used for recovery.
+		MinSliceSize cos.SizeIEC `json:"min_slice_size,omitempty"`
+
 		// Depending on the object size and `ObjSizeLimit`, the value of `ParitySlices` (or P) indicates:
 		// - a number of additional parity slices (generated or _computed_ from the (D) data slices),
 		// or:
@@ -215,13 +279,14 @@ type (
 		DiskOnly bool `json:"disk_only"` // if true, EC does not use SGL - data goes directly to drives
 	}
 	ECConfToSet struct {
-		ObjSizeLimit *int64  `json:"objsize_limit,omitempty"`
-		Compression  *string `json:"compression,omitempty"`
-		SbundleMult  *int    `json:"bundle_multiplier,omitempty"`
-		DataSlices   *int    `json:"data_slices,omitempty"`
-		ParitySlices *int    `json:"parity_slices,omitempty"`
-		Enabled      *bool   `json:"enabled,omitempty"`
-		DiskOnly     *bool   `json:"disk_only,omitempty"`
+		ObjSizeLimit *int64       `json:"objsize_limit,omitempty"`
+		Compression  *string      `json:"compression,omitempty"`
+		SbundleMult  *int         `json:"bundle_multiplier,omitempty"`
+		DataSlices   *int         `json:"data_slices,omitempty"`
+		MinSliceSize *cos.SizeIEC `json:"min_slice_size,omitempty"`
+		ParitySlices *int         `json:"parity_slices,omitempty"`
+		Enabled      *bool        `json:"enabled,omitempty"`
+		DiskOnly     *bool        `json:"disk_only,omitempty"`
 	}
 
 	LogConf struct {
@@ -231,6 +296,7 @@ type (
 		FlushTime cos.Duration `json:"flush_time"` // log flush interval
 		StatsTime cos.Duration `json:"stats_time"` // (not used)
 		ToStderr  bool         `json:"to_stderr"`  // Log only to stderr instead of files.
+		SlowReq   cos.Duration `json:"slow_req"`   // trace data-path requests that take longer (zero: disabled)
 	}
 	LogConfToSet struct {
 		Level     *cos.LogLevel `json:"level,omitempty"`
@@ -239,6 +305,7 @@ type (
 		MaxTotal  *cos.SizeIEC  `json:"max_total,omitempty"`
 		FlushTime *cos.Duration `json:"flush_time,omitempty"`
 		StatsTime *cos.Duration `json:"stats_time,omitempty"`
+		SlowReq   *cos.Duration `json:"slow_req,omitempty"`
 	}
 
 	// NOTE: StatsTime is a one important timer
@@ -287,12 +354,20 @@ type (
 		OriginalURL  string `json:"original_url"`
 		DiscoveryURL string `json:"discovery_url"`
 		NonElectable bool   `json:"non_electable"`
+		// cluster-wide read-only mode: reject all requests that modify user data
+		// or bucket metadata with 503 (Service Unavailable) while continuing to serve reads
+		ReadOnly bool `json:"read_only"`
+		// redirect GETs away from degraded targets (saturated disks or red-alert state)
+		// to the targets that store object replicas, if any (see ais/prxhredir.go)
+		HealthRedirect bool `json:"health_redirect"`
 	}
 	ProxyConfToSet struct {
-		PrimaryURL   *string `json:"primary_url,omitempty"`
-		OriginalURL  *string `json:"original_url,omitempty"`
-		DiscoveryURL *string `json:"discovery_url,omitempty"`
-		NonElectable *bool   `json:"non_electable,omitempty"`
+		PrimaryURL     *string `json:"primary_url,omitempty"`
+		OriginalURL    *string `json:"original_url,omitempty"`
+		DiscoveryURL   *string `json:"discovery_url,omitempty"`
+		NonElectable   *bool   `json:"non_electable,omitempty"`
+		ReadOnly       *bool   `json:"read_only,omitempty"`
+		HealthRedirect *bool   `json:"health_redirect,omitempty"`
 	}
 
 	SpaceConf struct {
@@ -312,12 +387,23 @@ type (
 		// Out-of-Space: if exceeded, the target starts failing new PUTs and keeps
 		// failing them until its local used-cap gets back below HighWM (see above)
 		OOS int64 `json:"out_of_space"`
+
+		// ReservedPct: percentage of each mountpath's capacity reserved for system use
+		// (workfiles, logs, metadata); user writes fail with "insufficient storage"
+		// once used capacity of any mountpath exceeds (100 - ReservedPct)%
+		ReservedPct int64 `json:"reserved_pct,omitempty"`
+
+		// EarlyEvict: when, given the recent ingest rate, used capacity is predicted to reach HighWM
+		// within this time, start LRU eviction early (and gently); zero (default) disables
+		EarlyEvict cos.Duration `json:"early_evict,omitempty"`
 	}
 	SpaceConfToSet struct {
-		CleanupWM *int64 `json:"cleanupwm,omitempty"`
-		LowWM     *int64 `json:"lowwm,omitempty"`
-		HighWM    *int64 `json:"highwm,omitempty"`
-		OOS       *int64 `json:"out_of_space,omitempty"`
+		CleanupWM   *int64        `json:"cleanupwm,omitempty"`
+		LowWM       *int64        `json:"lowwm,omitempty"`
+		HighWM      *int64        `json:"highwm,omitempty"`
+		OOS         *int64        `json:"out_of_space,omitempty"`
+		ReservedPct *int64        `json:"reserved_pct,omitempty"`
+		EarlyEvict  *cos.Duration `json:"early_evict,omitempty"`
 	}
 
 	LRUConf struct {
@@ -343,6 +429,9 @@ type (
 		DiskUtilMaxWM   int64        `json:"disk_util_max_wm"`
 		IostatTimeLong  cos.Duration `json:"iostat_time_long"`
 		IostatTimeShort cos.Duration `json:"iostat_time_short"`
+		// max utilization that background IO (xactions) must not push disks beyond;
+		// zero (default) - same as disk_util_high_wm (see fs/throttle.go)
+		DiskUtilCeiling int64 `json:"disk_util_ceiling,omitempty"`
 	}
 	DiskConfToSet struct {
 		DiskUtilLowWM   *int64        `json:"disk_util_low_wm,omitempty"`
@@ -350,6 +439,7 @@ type (
 		DiskUtilMaxWM   *int64        `json:"disk_util_max_wm,omitempty"`
 		IostatTimeLong  *cos.Duration `json:"iostat_time_long,omitempty"`
 		IostatTimeShort *cos.Duration `json:"iostat_time_short,omitempty"`
+		DiskUtilCeiling *int64        `json:"disk_util_ceiling,omitempty"`
 	}
 
 	RebalanceConf struct {
@@ -573,6 +663,9 @@ type (
 		// fastcompression.blogspot.com/2013/04/lz4-streaming-format-final.html
 		LZ4BlockMaxSize  cos.SizeIEC `json:"lz4_block"`
 		LZ4FrameChecksum bool        `json:"lz4_frame_checksum"`
+		// transmit object payloads from local files via sendfile/splice
+		// (uncompressed non-TLS streams only - see transport/zerocopy.go)
+		ZeroCopy bool `json:"zero_copy"`
 	}
 	TransportConfToSet struct {
 		MaxHeaderSize    *int          `json:"max_header,omitempty"`
@@ -581,6 +674,7 @@ type (
 		QuiesceTime      *cos.Duration `json:"quiescent,omitempty"`
 		LZ4BlockMaxSize  *cos.SizeIEC  `json:"lz4_block,omitempty"`
 		LZ4FrameChecksum *bool         `json:"lz4_frame_checksum,omitempty"`
+		ZeroCopy         *bool         `json:"zero_copy,omitempty"`
 	}
 
 	MemsysConf struct {
@@ -609,6 +703,24 @@ type (
 		SbundleMult *int    `json:"bundle_multiplier,omitempty"`
 	}
 
+	XactConf struct {
+		// how long to keep finished xactions (jobs) in the registry;
+		// zero (default) means 1h - except list-objects that is kept for 1m
+		Retention cos.Duration `json:"retention,omitempty"`
+
+		// per-kind retention that takes precedence over the above, e.g.: "rebalance=24h, list=30s"
+		RetentionKind string `json:"retention_kind,omitempty"`
+
+		// keep at least so many most recently finished xactions regardless of their age
+		// (list-objects not counted); zero (default) means 256
+		KeepMin int `json:"keep_min,omitempty"`
+	}
+	XactConfToSet struct {
+		Retention     *cos.Duration `json:"retention,omitempty"`
+		RetentionKind *string       `json:"retention_kind,omitempty"`
+		KeepMin       *int          `json:"keep_min,omitempty"`
+	}
+
 	WritePolicyConf struct {
 		Data apc.WritePolicy `json:"data"`
 		MD   apc.WritePolicy `json:"md"`
@@ -617,6 +729,126 @@ type (
 		Data *apc.WritePolicy `json:"data,omitempty" list:"readonly"` // NOTE: NIY
 		MD   *apc.WritePolicy `json:"md,omitempty"`
 	}
+
+	TracingConf struct {
+		// OTLP/HTTP collector, e.g. "http://otel-collector:4318" (spans are posted to <endpoint>/v1/traces)
+		ExporterEndpoint string `json:"exporter_endpoint,omitempty"`
+
+		// service name: prefix + "-" + node type (proxy | target); empty defaults to "ais"
+		ServiceNamePrefix string `json:"service_name_prefix,omitempty"`
+
+		// fraction of requests that start a new trace; requests that carry W3C `traceparent`
+		// always follow the caller's sampling decision
+		SamplerProbability float64 `json:"sampler_probability,omitempty"`
+
+		Enabled bool `json:"enabled,omitempty"`
+	}
+	TracingConfToSet struct {
+		ExporterEndpoint   *string  `json:"exporter_endpoint,omitempty"`
+		ServiceNamePrefix  *string  `json:"service_name_prefix,omitempty"`
+		SamplerProbability *float64 `json:"sampler_probability,omitempty"`
+		Enabled            *bool    `json:"enabled,omitempty"`
+	}
+
+	// Housekeeping xactions - LRU, storage cleanup, and EC rebalance - run aggressively (unthrottled)
+	// within the maintenance window, and get throttled to the floor outside of it.
+	// No window (the default) means no change: each xaction throttles itself based on
+	// disk utilization, capacity, and other considerations of its own.
+	HousekeepConf struct {
+		// one or more semicolon-separated cron expressions, e.g.: "* 1-5 * * *" (daily from 1am to 6am)
+		// or "* 22-23 * * 1-5; * * * * sat,sun" (weeknights and weekends);
+		// the window includes every minute that matches any of the expressions (local time)
+		Window string `json:"window"`
+	}
+	HousekeepConfToSet struct {
+		Window *string `json:"window,omitempty"`
+	}
+
+	// CORS: proxies (including the S3 API endpoint) respond to preflight (OPTIONS) requests
+	// and add Access-Control-* headers to responses for the allowed origins,
+	// so that web dashboards and browser uploads can talk to the cluster directly
+	CORSConf struct {
+		// comma-separated origins, e.g. "https://dash.example.com, https://*.example.com";
+		// "*" allows any origin
+		AllowedOrigins string `json:"allowed_origins"`
+		// comma-separated HTTP methods allowed in cross-origin requests
+		AllowedMethods string `json:"allowed_methods"`
+		// comma-separated request headers; "*" allows whatever the preflight requests
+		AllowedHeaders string `json:"allowed_headers"`
+		// comma-separated response headers that browsers are allowed to access, e.g. "ETag"
+		ExposeHeaders string `json:"expose_headers"`
+		// how long browsers can cache preflight results (zero: browser default)
+		MaxAge cos.Duration `json:"max_age"`
+		// allow cookies and Authorization header (requires explicit origins)
+		AllowCredentials bool `json:"allow_credentials"`
+		Enabled          bool `json:"enabled"`
+	}
+	CORSConfToSet struct {
+		AllowedOrigins   *string       `json:"allowed_origins,omitempty"`
+		AllowedMethods   *string       `json:"allowed_methods,omitempty"`
+		AllowedHeaders   *string       `json:"allowed_headers,omitempty"`
+		ExposeHeaders    *string       `json:"expose_headers,omitempty"`
+		MaxAge           *cos.Duration `json:"max_age,omitempty"`
+		AllowCredentials *bool         `json:"allow_credentials,omitempty"`
+		Enabled          *bool         `json:"enabled,omitempty"`
+	}
+
+	// Alerts: the primary proxy periodically collects node stats and evaluates the
+	// configured rules; state transitions (firing, resolved) are sent to all sinks
+	AlertsConf struct {
+		Rules []AlertRule `json:"rules"`
+		Sinks []AlertSink `json:"sinks"`
+		// how often to evaluate the rules (default: 30s)
+		Interval cos.Duration `json:"interval"`
+		Enabled  bool         `json:"enabled"`
+	}
+	AlertsConfToSet struct {
+		Rules    *[]AlertRule  `json:"rules,omitempty"`
+		Sinks    *[]AlertSink  `json:"sinks,omitempty"`
+		Interval *cos.Duration `json:"interval,omitempty"`
+		Enabled  *bool         `json:"enabled,omitempty"`
+	}
+	AlertRule struct {
+		Name string `json:"name"`
+		// enum AlertMetric* or any node metric name, e.g. "err.get.n" (counters are converted to per-second rates)
+		Metric string `json:"metric"`
+		// fire when the value exceeds the threshold (not used with "offline")
+		Threshold float64 `json:"threshold,omitempty"`
+		// (optional) the condition must hold for at least this long
+		For cos.Duration `json:"for,omitempty"`
+		// enum AlertSeverity* (default: warning)
+		Severity string `json:"severity,omitempty"`
+		// apc.Target, apc.Proxy, or empty for all nodes
+		NodeType string `json:"node_type,omitempty"`
+	}
+	AlertSink struct {
+		// enum AlertSink*
+		Type string `json:"type"`
+		// webhook or slack (incoming webhook) URL
+		URL string `json:"url,omitempty"`
+		// email: SMTP server (host:port), sender, and comma-separated recipients
+		SMTP string `json:"smtp,omitempty"`
+		From string `json:"from,omitempty"`
+		To   string `json:"to,omitempty"`
+		// email: (optional) SMTP credentials
+		Username string `json:"username,omitempty"`
+		Password string `json:"password,omitempty"`
+		// skip alerts below this severity (default: all)
+		MinSeverity string `json:"min_severity,omitempty"`
+	}
+
+	// GPU stats are scraped every 'periodic.stats_time' and included in node status
+	// (see `ais show performance gpu`)
+	GPUConf struct {
+		// "" (disabled, the default) | "nvidia-smi" | "dcgm" (DCGM exporter)
+		Source string `json:"source"`
+		// DCGM exporter's metrics endpoint (default: "http://localhost:9400/metrics")
+		Endpoint string `json:"endpoint"`
+	}
+	GPUConfToSet struct {
+		Source   *string `json:"source,omitempty"`
+		Endpoint *string `json:"endpoint,omitempty"`
+	}
 )
 
 // assorted named fields that require (cluster | node) restart for changes to make an effect
@@ -661,6 +893,7 @@ var (
 	_ Validator = (*MirrorConf)(nil)
 	_ Validator = (*ECConf)(nil)
 	_ Validator = (*VersionConf)(nil)
+	_ Validator = (*XactConf)(nil)
 	_ Validator = (*KeepaliveConf)(nil)
 	_ Validator = (*PeriodConf)(nil)
 	_ Validator = (*TimeoutConf)(nil)
@@ -676,12 +909,18 @@ var (
 	_ Validator = (*MemsysConf)(nil)
 	_ Validator = (*TCBConf)(nil)
 	_ Validator = (*WritePolicyConf)(nil)
+	_ Validator = (*TracingConf)(nil)
+	_ Validator = (*HousekeepConf)(nil)
+	_ Validator = (*CORSConf)(nil)
+	_ Validator = (*AlertsConf)(nil)
+	_ Validator = (*GPUConf)(nil)
 
 	_ PropsValidator = (*CksumConf)(nil)
 	_ PropsValidator = (*SpaceConf)(nil)
 	_ PropsValidator = (*MirrorConf)(nil)
 	_ PropsValidator = (*ECConf)(nil)
 	_ PropsValidator = (*WritePolicyConf)(nil)
+	_ PropsValidator = (*QuotaConf)(nil)
 
 	_ json.Marshaler   = (*BackendConf)(nil)
 	_ json.Unmarshaler = (*BackendConf)(nil)
@@ -827,6 +1066,9 @@ func (c *LogConf) Validate() error {
 	if c.StatsTime.D() > 10*time.Minute {
 		return fmt.Errorf("invalid log.stats_time=%s (expected range [periodic.stats_time, 10m])", c.StatsTime)
 	}
+	if c.SlowReq < 0 {
+		return fmt.Errorf("invalid log.slow_req=%s (expecting non-negative duration)", c.SlowReq)
+	}
 	return nil
 }
 
@@ -881,6 +1123,16 @@ func (c *BackendConf) Validate() (err error) {
 				}
 			}
 			c.Conf[provider] = aisConf
+		case apc.AWS, apc.Azure, apc.GCP, apc.HT:
+			var clientConf BackendClientConf
+			if err := jsoniter.Unmarshal(b, &clientConf); err != nil {
+				return fmt.Errorf("invalid backend.%s specification: %v", provider, err)
+			}
+			if err := clientConf.validate(provider); err != nil {
+				return err
+			}
+			c.Conf[provider] = clientConf
+			c.setProvider(provider)
 		case "":
 			continue
 		default:
@@ -911,6 +1163,21 @@ func (c *BackendConf) Get(provider string) (conf any) {
 	return
 }
 
+// ClientConf returns HTTP client tuning for a given (cloud or HTTP) backend provider
+// (all zeros - compiled-in defaults - when not configured)
+func (c *BackendConf) ClientConf(provider string) (clientConf BackendClientConf) {
+	switch v := c.Conf[provider].(type) {
+	case BackendClientConf:
+		clientConf = v
+	case nil:
+	default:
+		// (not validated)
+		err := cos.MorphMarshal(v, &clientConf)
+		debug.AssertNoErr(err)
+	}
+	return clientConf
+}
+
 func (c *BackendConf) Set(provider string, newConf any) {
 	c.Conf[provider] = newConf
 }
@@ -943,6 +1210,43 @@ func (c *BackendConf) EqualRemAIS(o *BackendConf, sname string) bool {
 	return true
 }
 
+func (c *BackendClientConf) validate(provider string) error {
+	if c.Timeout < 0 || c.DialTimeout < 0 || c.IdleConnTimeout < 0 {
+		return fmt.Errorf("invalid backend.%s timeouts (%s, %s, %s): expecting non-negative durations",
+			provider, c.Timeout, c.DialTimeout, c.IdleConnTimeout)
+	}
+	if c.MaxIdleConns < 0 || c.IdleConnsPerHost < 0 || c.MaxRetries < 0 {
+		return fmt.Errorf("invalid backend.%s (max_idle_conns=%d, max_idle_conns_per_host=%d, max_retries=%d): %s",
+			provider, c.MaxIdleConns, c.IdleConnsPerHost, c.MaxRetries, "expecting non-negative values")
+	}
+	if c.Endpoint != "" {
+		if provider == apc.HT {
+			return fmt.Errorf("invalid backend.%s: endpoint is not supported", provider)
+		}
+		if _, err := url.ParseRequestURI(c.Endpoint); err != nil {
+			return fmt.Errorf("invalid backend.%s.endpoint %q: %v", provider, c.Endpoint, err)
+		}
+	}
+	if c.MaxRetries > 0 && provider == apc.HT {
+		return fmt.Errorf("invalid backend.%s: max_retries is not supported", provider)
+	}
+	if c.UsePathStyle && provider != apc.AWS {
+		return fmt.Errorf("invalid backend.%s: use_path_style is only supported by %s backend", provider, apc.AWS)
+	}
+	return nil
+}
+
+// (see NewTransport)
+func (c *BackendClientConf) TransportArgs() TransportArgs {
+	return TransportArgs{
+		Timeout:          c.Timeout.D(),
+		DialTimeout:      c.DialTimeout.D(),
+		IdleConnTimeout:  c.IdleConnTimeout.D(),
+		MaxIdleConns:     c.MaxIdleConns,
+		IdleConnsPerHost: c.IdleConnsPerHost,
+	}
+}
+
 func (c BackendConfAIS) String() (s string) {
 	for a, urls := range c {
 		if s != "" {
@@ -972,9 +1276,19 @@ func (c *DiskConf) Validate() (err error) {
 		return fmt.Errorf("disk.iostat_time_long %v shorter than disk.iostat_time_short %v",
 			c.IostatTimeLong, c.IostatTimeShort)
 	}
+	if c.DiskUtilCeiling != 0 && (c.DiskUtilCeiling <= lwm || c.DiskUtilCeiling > 100) {
+		return fmt.Errorf("invalid disk.disk_util_ceiling %d (expecting zero or (disk_util_low_wm, 100])", c.DiskUtilCeiling)
+	}
 	return nil
 }
 
+func (c *DiskConf) UtilCeiling() int64 {
+	if c.DiskUtilCeiling > 0 {
+		return c.DiskUtilCeiling
+	}
+	return c.DiskUtilHighWM
+}
+
 ///////////////
 // SpaceConf //
 ///////////////
@@ -982,6 +1296,10 @@ func (c *DiskConf) Validate() (err error) {
 func (c *SpaceConf) Validate() (err error) {
 	if c.CleanupWM <= 0 || c.LowWM < c.CleanupWM || c.HighWM < c.LowWM || c.OOS < c.HighWM || c.OOS > 100 {
 		err = fmt.Errorf("invalid %s (expecting: 0 < cleanup < low < high < OOS < 100)", c)
+	} else if c.ReservedPct < 0 || c.ReservedPct > 100-c.HighWM {
+		err = fmt.Errorf("invalid %s (expecting: 0 <= reserved <= 100 - high)", c)
+	} else if c.EarlyEvict < 0 {
+		err = fmt.Errorf("invalid %s (expecting: early_evict >= 0)", c)
 	}
 	return
 }
@@ -989,8 +1307,8 @@ func (c *SpaceConf) Validate() (err error) {
 func (c *SpaceConf) ValidateAsProps(...any) error { return c.Validate() }
 
 func (c *SpaceConf) String() string {
-	return fmt.Sprintf("space config: cleanup=%d%%, low=%d%%, high=%d%%, OOS=%d%%",
-		c.CleanupWM, c.LowWM, c.HighWM, c.OOS)
+	return fmt.Sprintf("space config: cleanup=%d%%, low=%d%%, high=%d%%, OOS=%d%%, reserved=%d%%",
+		c.CleanupWM, c.LowWM, c.HighWM, c.OOS, c.ReservedPct)
 }
 
 /////////////
@@ -1126,6 +1444,9 @@ func (c *ECConf) Validate() error {
 		return fmt.Errorf("invalid ec.parity_slices: %d (expected value in range [%d, %d])",
 			c.ParitySlices, minSliceCount, maxSliceCount)
 	}
+	if c.MinSliceSize < 0 {
+		return fmt.Errorf("invalid ec.min_slice_size: %d (expecting non-negative value)", c.MinSliceSize)
+	}
 	if c.SbundleMult < 0 || c.SbundleMult > 16 {
 		return fmt.Errorf("invalid ec.bundle_multiplier: %v (expected range [0, 16])", c.SbundleMult)
 	}
@@ -1165,9 +1486,28 @@ func (c *ECConf) String() string {
 	if objSizeLimit == ObjSizeToAlwaysReplicate {
 		return fmt.Sprintf("no EC - always producing %d total replicas", c.ParitySlices+1)
 	}
+	if c.MinSliceSize > 0 {
+		return fmt.Sprintf("%d:%d (objsize limit %s, min slice %s)", c.DataSlices, c.ParitySlices,
+			cos.ToSizeIEC(objSizeLimit, 0), cos.ToSizeIEC(int64(c.MinSliceSize), 0))
+	}
 	return fmt.Sprintf("%d:%d (objsize limit %s)", c.DataSlices, c.ParitySlices, cos.ToSizeIEC(objSizeLimit, 0))
 }
 
+// number of data slices to encode an object of a given size (see MinSliceSize):
+//   - below (D * MinSliceSize): as many slices as fit, each at least MinSliceSize;
+//   - at and above: (D) slices (and never more than maxSliceCount), with the slice size
+//     growing proportionally to the object size
+func (c *ECConf) NumDataSlices(size int64) int {
+	if c.MinSliceSize <= 0 || c.DataSlices <= minSliceCount {
+		return c.DataSlices
+	}
+	var (
+		n     = size / int64(c.MinSliceSize)
+		upper = int64(min(c.DataSlices, maxSliceCount))
+	)
+	return int(max(min(n, upper), minSliceCount))
+}
+
 func (c *ECConf) numRequiredTargets() int {
 	if c.ObjSizeLimit == ObjSizeToAlwaysReplicate {
 		return c.ParitySlices + 1
@@ -1321,10 +1661,30 @@ func (c *FSHCConf) Validate() error {
 
 const HostnameListSepa = ","
 
+// enum LocalNetConfig.IPFamily
+const (
+	IPFamilyV4   = "ipv4"
+	IPFamilyV6   = "ipv6"
+	IPFamilyDual = "dual"
+)
+
 func (c *LocalNetConfig) Validate(contextConfig *Config) (err error) {
 	c.Hostname = strings.ReplaceAll(c.Hostname, " ", "")
 	c.HostnameIntraControl = strings.ReplaceAll(c.HostnameIntraControl, " ", "")
 	c.HostnameIntraData = strings.ReplaceAll(c.HostnameIntraData, " ", "")
+	c.Interfaces = strings.ReplaceAll(c.Interfaces, " ", "")
+	c.CIDR = strings.TrimSpace(c.CIDR)
+	c.CIDRIntraControl = strings.TrimSpace(c.CIDRIntraControl)
+	c.CIDRIntraData = strings.TrimSpace(c.CIDRIntraData)
+
+	switch c.IPFamily {
+	case "":
+		c.IPFamily = IPFamilyV4
+	case IPFamilyV4, IPFamilyV6, IPFamilyDual:
+	default:
+		return fmt.Errorf("invalid host_net.ip_family %q (expecting one of: %q, %q, %q)",
+			c.IPFamily, IPFamilyV4, IPFamilyV6, IPFamilyDual)
+	}
 
 	if addr, over := ipsOverlap(c.Hostname, c.HostnameIntraControl); over {
 		return fmt.Errorf("public (%s) and intra-cluster control (%s) share the same: %q",
@@ -1343,6 +1703,10 @@ func (c *LocalNetConfig) Validate(contextConfig *Config) (err error) {
 		}
 	}
 
+	if err := c.validateCIDRs(); err != nil {
+		return err
+	}
+
 	// Parse ports
 	if _, err := ValidatePort(c.Port); err != nil {
 		return fmt.Errorf("invalid %s port specified: %v", NetPublic, err)
@@ -1359,18 +1723,41 @@ func (c *LocalNetConfig) Validate(contextConfig *Config) (err error) {
 	}
 
 	// NOTE: intra-cluster networks
-	differentIPs := c.Hostname != c.HostnameIntraControl
+	differentIPs := c.Hostname != c.HostnameIntraControl || c.CIDR != c.CIDRIntraControl
 	differentPorts := c.Port != c.PortIntraControl
-	c.UseIntraControl = (contextConfig.TestingEnv() || c.HostnameIntraControl != "") &&
+	c.UseIntraControl = (contextConfig.TestingEnv() || c.HostnameIntraControl != "" || c.CIDRIntraControl != "") &&
 		c.PortIntraControl != 0 && (differentIPs || differentPorts)
 
-	differentIPs = c.Hostname != c.HostnameIntraData
+	differentIPs = c.Hostname != c.HostnameIntraData || c.CIDR != c.CIDRIntraData
 	differentPorts = c.Port != c.PortIntraData
-	c.UseIntraData = (contextConfig.TestingEnv() || c.HostnameIntraData != "") &&
+	c.UseIntraData = (contextConfig.TestingEnv() || c.HostnameIntraData != "" || c.CIDRIntraData != "") &&
 		c.PortIntraData != 0 && (differentIPs || differentPorts)
 	return
 }
 
+// same rules as the hostnames (above): public network must be separate;
+// intra-cluster control and data may share the same subnet (not recommended)
+func (c *LocalNetConfig) validateCIDRs() error {
+	for _, cidr := range []string{c.CIDR, c.CIDRIntraControl, c.CIDRIntraData} {
+		if cidr == "" {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("invalid host_net CIDR %q: %v", cidr, err)
+		}
+	}
+	if c.CIDR != "" && c.CIDR == c.CIDRIntraControl {
+		return fmt.Errorf("public and intra-cluster control networks share the same CIDR %q", c.CIDR)
+	}
+	if c.CIDR != "" && c.CIDR == c.CIDRIntraData {
+		return fmt.Errorf("public and intra-cluster data networks share the same CIDR %q", c.CIDR)
+	}
+	if c.CIDRIntraControl != "" && c.CIDRIntraControl == c.CIDRIntraData {
+		nlog.Warningln("control and data share the same intra-cluster subnet:", c.CIDRIntraData)
+	}
+	return nil
+}
+
 ///////////////
 // DsortConf //
 ///////////////
@@ -1646,6 +2033,356 @@ func (c *TCBConf) Validate() error {
 	return nil
 }
 
+//////////////
+// XactConf //
+//////////////
+
+func (c *XactConf) Validate() error {
+	if c.Retention < 0 || c.KeepMin < 0 {
+		return fmt.Errorf("invalid xaction.retention=%s or xaction.keep_min=%d (expecting non-negative)",
+			c.Retention, c.KeepMin)
+	}
+	_, err := c.ParseRetention()
+	return err
+}
+
+// parse RetentionKind: comma-separated "kind=duration" pairs
+func (c *XactConf) ParseRetention() (map[string]time.Duration, error) {
+	if strings.TrimSpace(c.RetentionKind) == "" {
+		return nil, nil
+	}
+	var (
+		pairs = strings.Split(c.RetentionKind, ",")
+		m     = make(map[string]time.Duration, len(pairs))
+	)
+	for _, pair := range pairs {
+		kind, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		kind = strings.TrimSpace(kind)
+		if !ok || kind == "" {
+			return nil, fmt.Errorf("invalid xaction.retention_kind %q: expecting \"kind=duration\" pairs", c.RetentionKind)
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(val))
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid xaction.retention_kind %q: bad duration for %q", c.RetentionKind, kind)
+		}
+		m[kind] = d
+	}
+	return m, nil
+}
+
+/////////////////
+// TracingConf //
+/////////////////
+
+func (c *TracingConf) Validate() error {
+	if c.SamplerProbability < 0 || c.SamplerProbability > 1 {
+		return fmt.Errorf("invalid tracing.sampler_probability=%v (expected range [0, 1])", c.SamplerProbability)
+	}
+	if !c.Enabled {
+		return nil
+	}
+	if !strings.HasPrefix(c.ExporterEndpoint, "http://") && !strings.HasPrefix(c.ExporterEndpoint, "https://") {
+		return fmt.Errorf("invalid tracing.exporter_endpoint=%q (expecting http(s) URL of the OTLP collector)",
+			c.ExporterEndpoint)
+	}
+	return nil
+}
+
+///////////////////
+// HousekeepConf //
+///////////////////
+
+// see HousekeepConf.Pace
+const (
+	HkPaceDefault = iota // no maintenance window
+	HkPaceMax            // within the window: run aggressively
+	HkPaceFloor          // outside the window: throttle to the floor
+)
+
+type hkWindow struct {
+	expr  string
+	crons []*cos.Cron
+}
+
+// (parsed window cache)
+var hkwin ratomic.Pointer[hkWindow]
+
+func (c *HousekeepConf) Validate() error {
+	_, err := parseHkWindow(c.Window)
+	return err
+}
+
+func parseHkWindow(expr string) (*hkWindow, error) {
+	w := &hkWindow{expr: expr}
+	for _, e := range strings.Split(expr, ";") {
+		if e = strings.TrimSpace(e); e == "" {
+			continue
+		}
+		cron, err := cos.ParseCron(e)
+		if err != nil {
+			return nil, fmt.Errorf("invalid housekeeping.window: %v", err)
+		}
+		w.crons = append(w.crons, cron)
+	}
+	return w, nil
+}
+
+// Pace returns one of the enumerated HkPace* values to tell housekeeping xactions
+// whether the current time falls into the configured maintenance window
+func (c *HousekeepConf) Pace(now time.Time) int {
+	if c.Window == "" {
+		return HkPaceDefault
+	}
+	w := hkwin.Load()
+	if w == nil || w.expr != c.Window {
+		var err error
+		if w, err = parseHkWindow(c.Window); err != nil {
+			debug.AssertNoErr(err) // (validated)
+			return HkPaceDefault
+		}
+		hkwin.Store(w)
+	}
+	if len(w.crons) == 0 {
+		return HkPaceDefault
+	}
+	for _, cron := range w.crons {
+		if cron.Match(now) {
+			return HkPaceMax
+		}
+	}
+	return HkPaceFloor
+}
+
+//////////////
+// CORSConf //
+//////////////
+
+func (c *CORSConf) Validate() error {
+	c.AllowedOrigins = _corsList(c.AllowedOrigins, false)
+	c.AllowedMethods = _corsList(c.AllowedMethods, true)
+	c.AllowedHeaders = _corsList(c.AllowedHeaders, false)
+	c.ExposeHeaders = _corsList(c.ExposeHeaders, false)
+
+	if c.MaxAge < 0 {
+		return fmt.Errorf("invalid cors.max_age=%s (expecting non-negative duration)", c.MaxAge)
+	}
+	for _, o := range strings.Split(c.AllowedOrigins, ",") {
+		switch {
+		case o == "", o == "*":
+		case !strings.HasPrefix(o, "http://") && !strings.HasPrefix(o, "https://"):
+			return fmt.Errorf("invalid cors.allowed_origins %q: expecting \"*\" or http(s)://host[:port]", o)
+		case strings.Count(o, "*") > 1:
+			return fmt.Errorf("invalid cors.allowed_origins %q: at most one wildcard is permitted", o)
+		}
+		if o == "*" && c.AllowCredentials {
+			return errors.New("cors.allow_credentials requires explicitly listed cors.allowed_origins (cannot be used with \"*\")")
+		}
+	}
+	for _, m := range strings.Split(c.AllowedMethods, ",") {
+		switch m {
+		case "", http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			return fmt.Errorf("invalid cors.allowed_methods: %q", m)
+		}
+	}
+	if c.Enabled && c.AllowedOrigins == "" {
+		return errors.New("cors.allowed_origins cannot be empty when CORS is enabled")
+	}
+	return nil
+}
+
+// remove spaces; optionally, upper-case
+func _corsList(s string, upper bool) string {
+	s = strings.ReplaceAll(s, " ", "")
+	if upper {
+		s = strings.ToUpper(s)
+	}
+	return strings.Trim(s, ",")
+}
+
+// AllowOrigin returns true if the (request's) origin matches one of the allowed origins,
+// where "*" matches any origin and "https://*.example.com" matches all subdomains
+func (c *CORSConf) AllowOrigin(origin string) bool {
+	for s := c.AllowedOrigins; s != ""; {
+		var o string
+		o, s, _ = strings.Cut(s, ",")
+		if o == "*" || strings.EqualFold(o, origin) {
+			return true
+		}
+		if pre, suf, ok := strings.Cut(o, "*"); ok {
+			if len(origin) > len(pre)+len(suf) && strings.HasPrefix(origin, pre) && strings.HasSuffix(origin, suf) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AllowMethod returns true if the method is listed in cors.allowed_methods
+func (c *CORSConf) AllowMethod(method string) bool {
+	for s := c.AllowedMethods; s != ""; {
+		var m string
+		m, s, _ = strings.Cut(s, ",")
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+////////////////
+// AlertsConf //
+////////////////
+
+// enum AlertRule.Metric (in addition to node metric names)
+const (
+	AlertMetricCapacity = "capacity" // target's max mountpath utilization (%)
+	AlertMetricErrRate  = "err.rate" // all errors (err.*) per second
+	AlertMetricOffline  = "offline"  // node is not responding
+)
+
+// enum AlertRule.Severity
+const (
+	AlertSeverityWarning  = "warning"
+	AlertSeverityCritical = "critical"
+)
+
+// enum AlertSink.Type
+const (
+	AlertSinkWebhook = "webhook"
+	AlertSinkSlack   = "slack"
+	AlertSinkEmail   = "email"
+)
+
+const dfltAlertIval = 30 * time.Second
+
+func (c *AlertsConf) Validate() error {
+	if c.Interval < 0 {
+		return fmt.Errorf("invalid alerts.interval=%s (expecting non-negative duration)", c.Interval)
+	}
+	if c.Interval == 0 {
+		c.Interval = cos.Duration(dfltAlertIval)
+	}
+	names := make(cos.StrSet, len(c.Rules))
+	for i := range c.Rules {
+		r := &c.Rules[i]
+		if err := r.validate(); err != nil {
+			return err
+		}
+		if names.Contains(r.Name) {
+			return fmt.Errorf("duplicate alert rule %q", r.Name)
+		}
+		names.Add(r.Name)
+	}
+	for i := range c.Sinks {
+		if err := c.Sinks[i].validate(); err != nil {
+			return err
+		}
+	}
+	if c.Enabled && len(c.Rules) == 0 {
+		return errors.New("alerts.rules cannot be empty when alerts are enabled")
+	}
+	return nil
+}
+
+func (r *AlertRule) validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("alert rule %+v: missing name", r)
+	}
+	if r.Metric == "" {
+		return fmt.Errorf("alert rule %q: missing metric", r.Name)
+	}
+	if r.Threshold < 0 || r.For < 0 {
+		return fmt.Errorf("alert rule %q: expecting non-negative threshold and duration", r.Name)
+	}
+	if r.Metric == AlertMetricCapacity && r.Threshold > 100 {
+		return fmt.Errorf("alert rule %q: %s threshold must be a percentage in the range [0, 100]", r.Name, r.Metric)
+	}
+	switch r.Severity {
+	case "":
+		r.Severity = AlertSeverityWarning
+	case AlertSeverityWarning, AlertSeverityCritical:
+	default:
+		return fmt.Errorf("alert rule %q: invalid severity %q (expecting %q or %q)",
+			r.Name, r.Severity, AlertSeverityWarning, AlertSeverityCritical)
+	}
+	switch r.NodeType {
+	case "", apc.Target, apc.Proxy:
+	default:
+		return fmt.Errorf("alert rule %q: invalid node_type %q (expecting %q, %q, or empty for all nodes)",
+			r.Name, r.NodeType, apc.Target, apc.Proxy)
+	}
+	if r.Metric == AlertMetricCapacity && r.NodeType == apc.Proxy {
+		return fmt.Errorf("alert rule %q: %s applies to targets only", r.Name, r.Metric)
+	}
+	return nil
+}
+
+func (s *AlertSink) validate() error {
+	switch s.Type {
+	case AlertSinkWebhook, AlertSinkSlack:
+		if _, err := url.ParseRequestURI(s.URL); err != nil || (!cos.IsHT(s.URL) && !cos.IsHTTPS(s.URL)) {
+			return fmt.Errorf("alert sink %q: invalid URL %q", s.Type, s.URL)
+		}
+	case AlertSinkEmail:
+		if _, _, err := net.SplitHostPort(s.SMTP); err != nil {
+			return fmt.Errorf("alert sink %q: invalid SMTP server address %q: %v", s.Type, s.SMTP, err)
+		}
+		if s.From == "" || s.To == "" {
+			return fmt.Errorf("alert sink %q: both sender (from) and recipients (to) are required", s.Type)
+		}
+	default:
+		return fmt.Errorf("invalid alert sink type %q (expecting one of: %q, %q, %q)",
+			s.Type, AlertSinkWebhook, AlertSinkSlack, AlertSinkEmail)
+	}
+	switch s.MinSeverity {
+	case "", AlertSeverityWarning, AlertSeverityCritical:
+	default:
+		return fmt.Errorf("alert sink %q: invalid min_severity %q", s.Type, s.MinSeverity)
+	}
+	return nil
+}
+
+/////////////
+// GPUConf //
+/////////////
+
+const dfltDCGMEndpoint = "http://localhost:9400/metrics"
+
+func (c *GPUConf) Validate() error {
+	switch c.Source {
+	case "", sys.GPUSrcSMI:
+	case sys.GPUSrcDCGM:
+		if c.Endpoint == "" {
+			c.Endpoint = dfltDCGMEndpoint
+		}
+		if !strings.HasPrefix(c.Endpoint, "http://") && !strings.HasPrefix(c.Endpoint, "https://") {
+			return fmt.Errorf("invalid gpu.endpoint %q: expecting http(s)://host:port/path", c.Endpoint)
+		}
+	default:
+		return fmt.Errorf("invalid gpu.source %q (expecting one of: %q, %q, or empty to disable)",
+			c.Source, sys.GPUSrcSMI, sys.GPUSrcDCGM)
+	}
+	return nil
+}
+
+// HidePasswords replaces SMTP passwords in a (shallow) copy of the config
+func (c *AlertsConf) HidePasswords() {
+	sinks := make([]AlertSink, len(c.Sinks))
+	for i := range c.Sinks {
+		sinks[i] = c.Sinks[i]
+		if sinks[i].Password != "" {
+			sinks[i].Password = "**********"
+		}
+	}
+	c.Sinks = sinks
+}
+
+// Accept returns true if the alert's severity passes the sink's min_severity filter
+func (s *AlertSink) Accept(severity string) bool {
+	return s.MinSeverity != AlertSeverityCritical || severity == AlertSeverityCritical
+}
+
 /////////////////
 // TimeoutConf //
 /////////////////
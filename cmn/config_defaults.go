@@ -0,0 +1,28 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	_ "embed"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Compiled-in cluster config defaults (same as the ones that `make deploy` generates,
+// with no environment overrides), e.g. to show which knobs were touched:
+// `ais config cluster --diff-defaults`.
+// NOTE: deployment-specific values (e.g., primary URL) are empty.
+
+//go:embed config_defaults.json
+var configDefaults []byte
+
+func DefaultClusterConfig() (*ClusterConfig, error) {
+	c := &ClusterConfig{}
+	if err := jsoniter.Unmarshal(configDefaults, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
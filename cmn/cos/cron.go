@@ -0,0 +1,143 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Standard 5-field cron expression: "minute hour day-of-month month day-of-week", where
+// each field is a comma-separated list of: `*`, value, range (`a-b`), and step (`*/n`, `a-b/n`).
+// Months and weekdays may also be specified by their (3-letter) names; weekday 7 is Sunday.
+// As in cron(8), when both day-of-month and day-of-week are restricted, matching either will do.
+//
+// (Here, cron expressions are only used to _match_ a given time, e.g. to tell whether it falls
+// into a configured maintenance window - there's no scheduling.)
+
+type Cron struct {
+	minute, hour, dom, month, dow uint64 // bitmasks
+	domStar, dowStar              bool
+}
+
+type cronField struct {
+	names       []string
+	first, last int
+}
+
+var (
+	cronMonths   = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+	cronWeekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+	cronFields = [5]cronField{
+		{first: 0, last: 59},                     // minute
+		{first: 0, last: 23},                     // hour
+		{first: 1, last: 31},                     // day of month
+		{first: 1, last: 12, names: cronMonths},  // month
+		{first: 0, last: 7, names: cronWeekdays}, // day of week (0 and 7: Sunday)
+	}
+)
+
+func ParseCron(expr string) (*Cron, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("invalid cron expression %q: expecting %d fields (minute hour day-of-month month day-of-week), got %d",
+			expr, len(cronFields), len(fields))
+	}
+	var (
+		c     = &Cron{}
+		masks = [5]*uint64{&c.minute, &c.hour, &c.dom, &c.month, &c.dow}
+	)
+	for i, f := range fields {
+		mask, err := cronFields[i].parse(f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %v", expr, err)
+		}
+		*masks[i] = mask
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1 // Sunday
+	}
+	c.domStar, c.dowStar = fields[2] == "*", fields[4] == "*"
+	return c, nil
+}
+
+func (c *Cron) Match(t time.Time) bool {
+	if c.minute&(1<<t.Minute()) == 0 || c.hour&(1<<t.Hour()) == 0 || c.month&(1<<int(t.Month())) == 0 {
+		return false
+	}
+	var (
+		dom = c.dom&(1<<t.Day()) != 0
+		dow = c.dow&(1<<int(t.Weekday())) != 0
+	)
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func (f *cronField) parse(s string) (mask uint64, _ error) {
+	for _, item := range strings.Split(s, ",") {
+		var (
+			lo, hi = f.first, f.last
+			step   = 1
+			rng    = item
+			err    error
+		)
+		if i := strings.IndexByte(item, '/'); i >= 0 {
+			rng = item[:i]
+			if step, err = strconv.Atoi(item[i+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step in %q", item)
+			}
+		}
+		switch {
+		case rng == "*":
+		case strings.IndexByte(rng, '-') > 0:
+			i := strings.IndexByte(rng, '-')
+			if lo, err = f.value(rng[:i]); err != nil {
+				return 0, err
+			}
+			if hi, err = f.value(rng[i+1:]); err != nil {
+				return 0, err
+			}
+			if lo > hi && hi == 0 && f.last == 7 {
+				hi = 7 // day of week: range ending on Sunday, e.g. "sat-sun"
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q", rng)
+			}
+		default:
+			if lo, err = f.value(rng); err != nil {
+				return 0, err
+			}
+			if rng == item {
+				hi = lo
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			mask |= 1 << v
+		}
+	}
+	return mask, nil
+}
+
+func (f *cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return i + f.first, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.New("invalid value " + strconv.Quote(s))
+	}
+	if v < f.first || v > f.last {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, f.first, f.last)
+	}
+	return v, nil
+}
@@ -0,0 +1,57 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos_test
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cron", func() {
+	// (2024-05-15 is Wednesday)
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2024, month, day, hour, minute, 0, 0, time.Local)
+	}
+
+	DescribeTable("match",
+		func(expr string, t time.Time, expected bool) {
+			cron, err := cos.ParseCron(expr)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(cron.Match(t)).To(Equal(expected))
+		},
+		Entry("any", "* * * * *", at(time.May, 15, 13, 7), true),
+		Entry("hour range", "* 1-5 * * *", at(time.May, 15, 5, 59), true),
+		Entry("hour range (out)", "* 1-5 * * *", at(time.May, 15, 6, 0), false),
+		Entry("list", "0,30 * * * *", at(time.May, 15, 6, 30), true),
+		Entry("step", "*/15 * * * *", at(time.May, 15, 6, 45), true),
+		Entry("step (out)", "*/15 * * * *", at(time.May, 15, 6, 46), false),
+		Entry("range with step", "10-40/10 * * * *", at(time.May, 15, 6, 40), true),
+		Entry("value with step", "50/5 * * * *", at(time.May, 15, 6, 55), true),
+		Entry("weekday name", "* * * * wed", at(time.May, 15, 6, 0), true),
+		Entry("weekday range", "* * * * sat-sun", at(time.May, 15, 6, 0), false),
+		Entry("weekday range (Saturday)", "* * * * sat-sun", at(time.May, 18, 6, 0), true),
+		Entry("weekday range (Sunday)", "* * * * sat-sun", at(time.May, 19, 6, 0), true),
+		Entry("Sunday as 7", "* * * * 7", at(time.May, 19, 6, 0), true),
+		Entry("month name", "* * * jun-aug *", at(time.May, 15, 6, 0), false),
+		Entry("day-of-month or day-of-week", "* * 1 * wed", at(time.May, 15, 6, 0), true),
+		Entry("day-of-month and any day-of-week", "* * 1 * *", at(time.May, 15, 6, 0), false),
+	)
+
+	DescribeTable("invalid",
+		func(expr string) {
+			_, err := cos.ParseCron(expr)
+			Expect(err).Should(HaveOccurred())
+		},
+		Entry("too few fields", "* * * *"),
+		Entry("out of range", "60 * * * *"),
+		Entry("reversed range", "* 5-1 * * *"),
+		Entry("zero step", "*/0 * * * *"),
+		Entry("invalid name", "* * * * fun"),
+		Entry("zero day", "* * 0 * *"),
+	)
+})
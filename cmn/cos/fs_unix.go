@@ -26,3 +26,12 @@ func Stat(path string) error {
 	var sys syscall.Stat_t
 	return syscall.Stat(path, &sys)
 }
+
+// number of hard links (see bucket snapshots)
+func NumLinks(path string) (uint64, error) {
+	var sys syscall.Stat_t
+	if err := syscall.Stat(path, &sys); err != nil {
+		return 0, err
+	}
+	return uint64(sys.Nlink), nil //nolint:unconvert // (uint16 on darwin)
+}
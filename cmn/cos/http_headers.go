@@ -44,7 +44,31 @@ const (
 	HdrServer    = "Server"
 	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
 
+	HdrLastModified = "Last-Modified" // Ref: https://www.rfc-editor.org/rfc/rfc9110#field.last-modified
+
+	// conditional requests (Ref: https://www.rfc-editor.org/rfc/rfc9110#section-13.1)
+	HdrIfMatch           = "If-Match"
+	HdrIfNoneMatch       = "If-None-Match"
+	HdrIfModifiedSince   = "If-Modified-Since"
+	HdrIfUnmodifiedSince = "If-Unmodified-Since"
+
+	HdrRetryAfter = "Retry-After" // Ref: https://www.rfc-editor.org/rfc/rfc9110#field.retry-after
+
+	HdrTraceparent = "Traceparent" // Ref: https://www.w3.org/TR/trace-context/#traceparent-header
+
 	HdrHSTS = "Strict-Transport-Security"
+
+	// CORS (Ref: https://fetch.spec.whatwg.org/#http-cors-protocol)
+	HdrOrigin             = "Origin"
+	HdrVary               = "Vary"
+	HdrACRequestMethod    = "Access-Control-Request-Method"
+	HdrACRequestHeaders   = "Access-Control-Request-Headers"
+	HdrACAllowOrigin      = "Access-Control-Allow-Origin"
+	HdrACAllowMethods     = "Access-Control-Allow-Methods"
+	HdrACAllowHeaders     = "Access-Control-Allow-Headers"
+	HdrACAllowCredentials = "Access-Control-Allow-Credentials"
+	HdrACExposeHeaders    = "Access-Control-Expose-Headers"
+	HdrACMaxAge           = "Access-Control-Max-Age"
 )
 
 //
@@ -81,6 +105,14 @@ const (
 	S3MetadataChecksumType = "x-amz-meta-ais-cksum-type"
 	S3MetadataChecksumVal  = "x-amz-meta-ais-cksum-val"
 
+	// server-side encryption
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/serv-side-encryption.html
+	S3HdrSSE           = "x-amz-server-side-encryption"
+	S3HdrSSEKMSKeyID   = "x-amz-server-side-encryption-aws-kms-key-id"
+	S3HdrSSECAlgorithm = "x-amz-server-side-encryption-customer-algorithm"
+	S3HdrSSECKey       = "x-amz-server-side-encryption-customer-key"
+	S3HdrSSECKeyMD5    = "x-amz-server-side-encryption-customer-key-MD5"
+
 	S3LastModified = "Last-Modified"
 )
 
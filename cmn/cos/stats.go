@@ -12,8 +12,18 @@ const (
 	StreamsOutObjSize  = "stream.out.size"
 	StreamsInObjCount  = "stream.in.n"
 	StreamsInObjSize   = "stream.in.size"
+
+	StreamsOutZeroCopySize = "stream.out.zc.size" // sent via sendfile/splice (see transport/zerocopy.go)
 )
 
+// same as above, by stream kind - e.g., "stream.out.reb.bps" (see transport/stats.go for trname => kind)
+var StreamKinds = [...]string{"reb", "ec", "tcb", "tco", "arch", "lso", "dsort", "other"}
+
+// where dir is "out" or "in", and suffix follows the naming convention (".n", ".bps", etc.)
+func StreamsKindMetric(dir, kind, suffix string) string {
+	return "stream." + dir + "." + kind + "." + suffix
+}
+
 type (
 	StatsUpdater interface {
 		Inc(name string)
@@ -108,6 +108,14 @@ type (
 	ErrGetCap struct {
 		err error
 	}
+	ErrQuotaExceeded struct {
+		bname string
+		used  int64
+		size  int64
+		limit int64 // this target's share of the bucket quota
+		quota int64
+		nobj  bool // number of objects (quota.max_objects) rather than bytes
+	}
 
 	ErrBucketAccessDenied struct{ errAccessDenied }
 	ErrObjectAccessDenied struct{ errAccessDenied }
@@ -117,6 +125,16 @@ type (
 		accessAttrs apc.AccessAttrs
 	}
 
+	// (api.CheckBucketAccess)
+	ErrUnauthorized struct {
+		bck   Bck
+		cause string
+	}
+	ErrForbidden struct {
+		bck   Bck
+		cause string
+	}
+
 	ErrInvalidCksum struct {
 		expectedHash string
 		actualHash   string
@@ -252,6 +270,7 @@ var (
 	ErrXactRenewAbort   = errors.New("renewal abort")
 	ErrXactUserAbort    = errors.New("user abort")              // via apc.ActXactStop
 	ErrXactICNotifAbort = errors.New("IC(notifications) abort") // ditto
+	ErrXactDeadline     = errors.New("deadline exceeded")       // max-runtime (see xreg.SetDeadline)
 )
 
 // ErrFailedTo
@@ -448,6 +467,36 @@ func NewObjectAccessDenied(object, oper string, aattrs apc.AccessAttrs) *ErrObje
 	return &ErrObjectAccessDenied{errAccessDenied{object, oper, aattrs}}
 }
 
+// ErrUnauthorized: missing, invalid, or expired token (HTTP 401)
+
+func NewErrUnauthorized(bck *Bck, cause string) *ErrUnauthorized {
+	return &ErrUnauthorized{bck: *bck, cause: cause}
+}
+
+func (e *ErrUnauthorized) Error() string {
+	return fmt.Sprintf("bucket %q: unauthorized: %s", e.bck.Cname(""), e.cause)
+}
+
+func IsErrUnauthorized(err error) bool {
+	_, ok := err.(*ErrUnauthorized)
+	return ok
+}
+
+// ErrForbidden: denied by the bucket's ACL, the token's permissions, or the remote backend (HTTP 403)
+
+func NewErrForbidden(bck *Bck, cause string) *ErrForbidden {
+	return &ErrForbidden{bck: *bck, cause: cause}
+}
+
+func (e *ErrForbidden) Error() string {
+	return fmt.Sprintf("bucket %q: access denied: %s", e.bck.Cname(""), e.cause)
+}
+
+func IsErrForbidden(err error) bool {
+	_, ok := err.(*ErrForbidden)
+	return ok
+}
+
 // ErrCapExceeded
 
 func NewErrCapExceeded(totalBytesUsed, totalBytes uint64, highWM, cleanupWM int64, usedPct int32, oos bool) *ErrCapExceeded {
@@ -483,6 +532,31 @@ func IsErrCapExceeded(err error) bool {
 	return ok || cos.IsErrOOS(err) // NOTE: a superset
 }
 
+// ErrQuotaExceeded
+
+func NewErrQuotaExceeded(bname string, used, size, limit, quota int64) *ErrQuotaExceeded {
+	return &ErrQuotaExceeded{bname: bname, used: used, size: size, limit: limit, quota: quota}
+}
+
+func NewErrObjQuotaExceeded(bname string, used, limit, quota int64) *ErrQuotaExceeded {
+	return &ErrQuotaExceeded{bname: bname, used: used, size: 1, limit: limit, quota: quota, nobj: true}
+}
+
+func (e *ErrQuotaExceeded) Error() string {
+	if e.nobj {
+		return fmt.Sprintf("bucket %s: quota exceeded: local number of objects %d (limit %d, bucket quota %d objects)",
+			e.bname, e.used, e.limit, e.quota)
+	}
+	return fmt.Sprintf("bucket %s: quota exceeded: writing %s would bring local usage to %s (limit %s, bucket quota %s)",
+		e.bname, cos.ToSizeIEC(e.size, 2), cos.ToSizeIEC(e.used+e.size, 2), cos.ToSizeIEC(e.limit, 2),
+		cos.ToSizeIEC(e.quota, 2))
+}
+
+func IsErrQuotaExceeded(err error) bool {
+	_, ok := err.(*ErrQuotaExceeded)
+	return ok
+}
+
 // ErrGetCap
 
 func NewErrGetCap(err error) *ErrGetCap {
@@ -1138,6 +1212,16 @@ func IsStatusBadGateway(err error) (yes bool) {
 	return ok && herr.Status == http.StatusBadGateway
 }
 
+func IsStatusNotModified(err error) (yes bool) {
+	herr, ok := err.(*ErrHTTP)
+	return ok && herr.Status == http.StatusNotModified
+}
+
+func IsStatusPreconditionFailed(err error) (yes bool) {
+	herr, ok := err.(*ErrHTTP)
+	return ok && herr.Status == http.StatusPreconditionFailed
+}
+
 func IsStatusGone(err error) (yes bool) {
 	herr, ok := err.(*ErrHTTP)
 	return ok && herr.Status == http.StatusGone
@@ -1178,7 +1262,7 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, opts ...int /*[
 		switch {
 		case isErrNotFoundExtended(err, status):
 			status = http.StatusNotFound
-		case IsErrCapExceeded(err):
+		case IsErrCapExceeded(err), IsErrQuotaExceeded(err):
 			status = http.StatusInsufficientStorage
 		case IsErrRangeNotSatisfiable(err):
 			status = http.StatusRequestedRangeNotSatisfiable
@@ -33,6 +33,24 @@ const (
 	Vmd         = ".ais.vmd"    // vmd persistent file basename
 	Emd         = ".ais.emd"    // emd persistent file basename
 
+	// proxy: scheduled downloads
+	DlSchedules = ".ais.dlsched"
+
+	// proxy: downloader credentials profiles (encrypted)
+	DlCreds = ".ais.dlcreds"
+
+	// proxy: downloader presets (job templates)
+	DlPresets = ".ais.dlpresets"
+
+	// target: capacity snapshots (see fs/capfcast.go)
+	CapSnaps = ".ais.capsnap"
+
+	// target: rebalance history (see reb/history.go)
+	RebHistory = ".ais.rebhist"
+
+	// target: patched objects pending checksum recompute (see ais/tgtpatch.go)
+	PatchPending = ".ais.patchq"
+
 	// CLI config
 	CliConfig = "cli.json" // see jsp/app.go
 
@@ -15,6 +15,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	jsoniter "github.com/json-iterator/go"
 )
 
 const IterFieldNameSepa = "."
@@ -391,6 +392,13 @@ reflectDst:
 			dst = dst.Elem()                        // dereference pointer
 			goto reflectDst
 		case reflect.Slice:
+			// JSON-formatted slice of structs, e.g. alerts.rules='[{"name": ...}]'
+			if dst.Type().Elem().Kind() == reflect.Struct {
+				if err := jsoniter.UnmarshalFromString(srcVal.String(), dst.Addr().Interface()); err != nil {
+					return fmt.Errorf("invalid %q value (expecting JSON-formatted list): %v", f.name, err)
+				}
+				break
+			}
 			// A slice value looks like: "[value1 value2]"
 			s := strings.TrimPrefix(srcVal.String(), "[")
 			s = strings.TrimSuffix(s, "]")
@@ -31,6 +31,8 @@ type (
 		Pods() (*corev1.PodList, error)
 		Service(name string) (*corev1.Service, error)
 		Node(name string) (*corev1.Node, error)
+		Nodes() (*corev1.NodeList, error)
+		NodePods(nodeName string) (*corev1.PodList, error)
 		Logs(podName string) ([]byte, error)
 		Health(podName string) (string, error)
 		CheckMetricsAvailability() error
@@ -186,6 +188,18 @@ func (c *defaultClient) Node(name string) (*corev1.Node, error) {
 	return c.client.CoreV1().Nodes().Get(context.Background(), name, metav1.GetOptions{})
 }
 
+func (c *defaultClient) Nodes() (*corev1.NodeList, error) {
+	return c.client.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
+}
+
+// all pods scheduled on a given node, across all namespaces
+func (c *defaultClient) NodePods(nodeName string) (*corev1.PodList, error) {
+	listOptions := metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	}
+	return c.client.CoreV1().Pods(metav1.NamespaceAll).List(context.Background(), listOptions)
+}
+
 func (c *defaultClient) Logs(podName string) (b []byte, err error) {
 	var (
 		logStream io.ReadCloser
@@ -0,0 +1,91 @@
+// Package k8s: initialization, client, and misc. helpers
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package k8s
+
+import (
+	"sort"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// GPUs are advertised by the (NVIDIA) device plugin as an extended node resource;
+// extended resources cannot be overcommitted, and pod requests must equal pod limits.
+const GPUResource corev1.ResourceName = "nvidia.com/gpu"
+
+type NodeGPUs struct {
+	Name        string `json:"name"`
+	Allocatable int64  `json:"allocatable"`
+	Allocated   int64  `json:"allocated"` // requested by all (non-terminated) pods on the node
+}
+
+func (n *NodeGPUs) Free() int64 { return max(n.Allocatable-n.Allocated, 0) }
+
+// GPUNodes returns all nodes with allocatable GPUs, sorted by name;
+// pods named in the `exclude` set (if any) are not counted
+func GPUNodes(client Client, exclude cos.StrSet) ([]NodeGPUs, error) {
+	nodes, err := client.Nodes()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]NodeGPUs, 0, len(nodes.Items))
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		q, ok := node.Status.Allocatable[GPUResource]
+		if !ok || q.Value() == 0 {
+			continue
+		}
+		ng := NodeGPUs{Name: node.Name, Allocatable: q.Value()}
+		if ng.Allocated, err = nodeAllocated(client, node.Name, exclude); err != nil {
+			return nil, err
+		}
+		out = append(out, ng)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// GPUNode returns GPU allocation for a given node
+func GPUNode(client Client, nodeName string) (*NodeGPUs, error) {
+	node, err := client.Node(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	q := node.Status.Allocatable[GPUResource]
+	ng := &NodeGPUs{Name: nodeName, Allocatable: q.Value()}
+	ng.Allocated, err = nodeAllocated(client, nodeName, nil)
+	return ng, err
+}
+
+func nodeAllocated(client Client, nodeName string, exclude cos.StrSet) (n int64, _ error) {
+	pods, err := client.NodePods(nodeName)
+	if err != nil {
+		return 0, err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if exclude.Contains(pod.Name) {
+			continue
+		}
+		n += PodGPUs(&pod.Spec)
+	}
+	return n, nil
+}
+
+// PodGPUs returns the number of GPUs requested by a given pod
+func PodGPUs(spec *corev1.PodSpec) (n int64) {
+	for i := range spec.Containers {
+		res := &spec.Containers[i].Resources
+		if q, ok := res.Limits[GPUResource]; ok {
+			n += q.Value()
+		} else if q, ok := res.Requests[GPUResource]; ok {
+			n += q.Value()
+		}
+	}
+	return n
+}
@@ -58,15 +58,23 @@ func ValidatePort(port int) (int, error) {
 	return port, nil
 }
 
+// resolve hostname; IPv4 is preferred, with IPv6 being the fallback (IPv6-only hosts)
 func Host2IP(host string) (net.IP, error) {
 	ips, err := net.LookupIP(host)
 	if err != nil {
 		return nil, err
 	}
+	var ipv6 net.IP
 	for _, ip := range ips {
 		if ip.To4() != nil {
 			return ip, nil
 		}
+		if ipv6 == nil && ip.To16() != nil && !ip.IsLinkLocalUnicast() {
+			ipv6 = ip
+		}
+	}
+	if ipv6 != nil {
+		return ipv6, nil
 	}
 	return nil, fmt.Errorf("failed to locally resolve %q (have IPs %v)", host, ips)
 }
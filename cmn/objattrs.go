@@ -25,6 +25,9 @@ const (
 	// downloader' source is "web"
 	WebObjMD = "web"
 
+	// ditto, when importing from local (or mounted) filesystem
+	FileObjMD = "file"
+
 	// system-supported custom attrs
 	// NOTE: for provider specific HTTP headers, see cmn/cos/const_http.go
 
@@ -35,8 +38,16 @@ const (
 
 	OrigURLObjMD = "orig_url"
 
+	// previous checksum ("type:value") that is kept while the bucket's objects
+	// are being re-checksummed to the new `checksum.type` (see apc.ActCksumMigrate)
+	PrevCksumObjMD = "prev_cksum"
+
 	// additional backend
 	LastModified = "LastModified"
+
+	// server-side encryption at the remote backend (S3): "AES256" (SSE-S3), "aws:kms", "aws:kms:dsse",
+	// or "SSE-C" (customer-provided key); not set when not encrypted (or unknown)
+	SSEObjMD = "sse"
 )
 
 // object properties
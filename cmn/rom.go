@@ -20,6 +20,7 @@ type readMostly struct {
 		keepalive time.Duration // ditto MaxKeepalive
 	}
 	features       feat.Flags
+	slowReq        time.Duration // Config.Log.SlowReq
 	level, modules int
 	testingEnv     bool
 	authEnabled    bool
@@ -37,6 +38,7 @@ func (rom *readMostly) Set(cfg *ClusterConfig) {
 	rom.timeout.keepalive = cfg.Timeout.MaxKeepalive.D()
 	rom.features = cfg.Features
 	rom.authEnabled = cfg.Auth.Enabled
+	rom.slowReq = cfg.Log.SlowReq.D()
 
 	// pre-parse for FastV (below)
 	rom.level, rom.modules = cfg.Log.Level.Parse()
@@ -47,6 +49,7 @@ func (rom *readMostly) MaxKeepalive() time.Duration    { return rom.timeout.keep
 func (rom *readMostly) Features() feat.Flags           { return rom.features }
 func (rom *readMostly) TestingEnv() bool               { return rom.testingEnv }
 func (rom *readMostly) AuthEnabled() bool              { return rom.authEnabled }
+func (rom *readMostly) SlowReq() time.Duration         { return rom.slowReq }
 
 func (rom *readMostly) FastV(verbosity, fl int) bool {
 	return rom.level >= verbosity || rom.modules&fl != 0
@@ -7,6 +7,7 @@ package tests_test
 import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -52,6 +53,39 @@ var _ = Describe("API", func() {
 					},
 				},
 			),
+			Entry("bucket quota",
+				cmn.Bprops{},
+				cmn.BpropsToSet{
+					Quota: &cmn.QuotaConfToSet{
+						MaxBytes: apc.Ptr[int64](cos.GiB),
+					},
+				},
+				cmn.Bprops{
+					Quota: cmn.QuotaConf{
+						MaxBytes: cos.GiB,
+					},
+				},
+			),
+			Entry("bucket quota: objects and soft threshold",
+				cmn.Bprops{
+					Quota: cmn.QuotaConf{
+						MaxBytes: cos.GiB,
+					},
+				},
+				cmn.BpropsToSet{
+					Quota: &cmn.QuotaConfToSet{
+						MaxObjects: apc.Ptr[int64](1000),
+						SoftPct:    apc.Ptr[int64](80),
+					},
+				},
+				cmn.Bprops{
+					Quota: cmn.QuotaConf{
+						MaxBytes:   cos.GiB,
+						MaxObjects: 1000,
+						SoftPct:    80,
+					},
+				},
+			),
 			Entry("multiple nested fields",
 				cmn.Bprops{},
 				cmn.BpropsToSet{
@@ -184,4 +218,22 @@ var _ = Describe("API", func() {
 			),
 		)
 	})
+
+	Describe("Diff", func() {
+		It("should return changed props only, sorted by name", func() {
+			from := cmn.Bprops{
+				Provider: apc.AIS,
+				Mirror:   cmn.MirrorConf{Copies: 2, Enabled: false},
+				Access:   1024,
+			}
+			to := from
+			to.Mirror.Copies = 3
+			to.Mirror.Enabled = true
+			Expect(from.Diff(&to)).To(Equal([]cmn.BpropDiff{
+				{Name: "mirror.copies", Old: "2", New: "3"},
+				{Name: "mirror.enabled", Old: "false", New: "true"},
+			}))
+			Expect(from.Diff(&from)).To(BeEmpty())
+		})
+	})
 })
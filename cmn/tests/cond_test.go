@@ -0,0 +1,66 @@
+// Package test provides tests for common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package tests_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCondEval(t *testing.T) {
+	var (
+		mtime = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+		etags = []string{"abc123", "2"} // (checksum, version)
+		hour  = time.Hour
+	)
+	tests := []struct {
+		name   string
+		hdr    map[string]string
+		exists bool
+		read   bool
+		ecode  int
+	}{
+		{"if-match", map[string]string{cos.HdrIfMatch: `"abc123"`}, true, false, 0},
+		{"if-match-version", map[string]string{cos.HdrIfMatch: `"1", "2"`}, true, false, 0},
+		{"if-match-mismatch", map[string]string{cos.HdrIfMatch: `"xyz"`}, true, true, http.StatusPreconditionFailed},
+		{"if-match-any-missing", map[string]string{cos.HdrIfMatch: "*"}, false, false, http.StatusPreconditionFailed},
+		{"if-none-match-get", map[string]string{cos.HdrIfNoneMatch: `W/"abc123"`}, true, true, http.StatusNotModified},
+		{"if-none-match-put", map[string]string{cos.HdrIfNoneMatch: `"abc123"`}, true, false, http.StatusPreconditionFailed},
+		{"create-only", map[string]string{cos.HdrIfNoneMatch: "*"}, false, false, 0},
+		{"create-only-exists", map[string]string{cos.HdrIfNoneMatch: "*"}, true, false, http.StatusPreconditionFailed},
+		{"if-modified-since", map[string]string{cos.HdrIfModifiedSince: mtime.Format(http.TimeFormat)}, true, true, http.StatusNotModified},
+		{"if-modified-since-older", map[string]string{cos.HdrIfModifiedSince: mtime.Add(-hour).Format(http.TimeFormat)}, true, true, 0},
+		{"if-modified-since-put", map[string]string{cos.HdrIfModifiedSince: mtime.Format(http.TimeFormat)}, true, false, 0},
+		{"if-unmodified-since", map[string]string{cos.HdrIfUnmodifiedSince: mtime.Add(-hour).Format(http.TimeFormat)}, true, false, http.StatusPreconditionFailed},
+		{
+			"if-match-takes-precedence",
+			map[string]string{cos.HdrIfMatch: `"abc123"`, cos.HdrIfUnmodifiedSince: mtime.Add(-hour).Format(http.TimeFormat)},
+			true, false, 0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hdr := make(http.Header)
+			for k, v := range test.hdr {
+				hdr.Set(k, v)
+			}
+			cond, err := cmn.ParseCond(hdr)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, cond != nil, "expecting conditional request")
+			ecode := cond.Eval(test.exists, etags, mtime.Add(500*time.Millisecond), test.read)
+			tassert.Errorf(t, ecode == test.ecode, "expected %d, got %d", test.ecode, ecode)
+		})
+	}
+
+	cond, err := cmn.ParseCond(http.Header{})
+	tassert.Errorf(t, cond == nil && err == nil, "expecting non-conditional request")
+	_, err = cmn.ParseCond(http.Header{cos.HdrIfModifiedSince: []string{"yesterday"}})
+	tassert.Errorf(t, err != nil, "expecting invalid date error")
+}
@@ -5,12 +5,15 @@
 package tests_test
 
 import (
+	"net/url"
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/tools/tassert"
 )
@@ -72,3 +75,108 @@ func TestValidateMpath(t *testing.T) {
 		}
 	}
 }
+
+func TestECNumDataSlices(t *testing.T) {
+	const mib = cos.MiB
+	tests := []struct {
+		name     string
+		conf     cmn.ECConf
+		size     int64
+		expected int
+	}{
+		{"no min_slice_size", cmn.ECConf{DataSlices: 8}, mib, 8},
+		{"negative min_slice_size", cmn.ECConf{DataSlices: 8, MinSliceSize: -1}, mib, 8},
+		{"single data slice", cmn.ECConf{DataSlices: 1, MinSliceSize: 4 * mib}, 1024 * mib, 1},
+		{"empty", cmn.ECConf{DataSlices: 8, MinSliceSize: 4 * mib}, 0, 1},
+		{"below min slice", cmn.ECConf{DataSlices: 8, MinSliceSize: 4 * mib}, mib, 1},
+		{"exactly min slice", cmn.ECConf{DataSlices: 8, MinSliceSize: 4 * mib}, 4 * mib, 1},
+		{"medium", cmn.ECConf{DataSlices: 8, MinSliceSize: 4 * mib}, 8 * mib, 2},
+		{"medium, rounded down", cmn.ECConf{DataSlices: 8, MinSliceSize: 4 * mib}, 13 * mib, 3},
+		{"just below threshold", cmn.ECConf{DataSlices: 8, MinSliceSize: 4 * mib}, 32*mib - 1, 7},
+		{"exactly at threshold", cmn.ECConf{DataSlices: 8, MinSliceSize: 4 * mib}, 32 * mib, 8},
+		{"very large", cmn.ECConf{DataSlices: 8, MinSliceSize: 4 * mib}, 1024 * mib, 8},
+	}
+	for _, test := range tests {
+		n := test.conf.NumDataSlices(test.size)
+		tassert.Errorf(t, n == test.expected, "%s (size %d): expected %d data slices, got %d",
+			test.name, test.size, test.expected, n)
+		if test.conf.MinSliceSize > 0 && n > 1 {
+			tassert.Errorf(t, test.size/int64(n) >= int64(test.conf.MinSliceSize),
+				"%s (size %d): slice size below the minimum", test.name, test.size)
+		}
+	}
+
+	// at and above the threshold: same number of slices, growing slice size
+	var (
+		conf = cmn.ECConf{DataSlices: 8, MinSliceSize: 4 * mib}
+		prev int64
+	)
+	for _, size := range []int64{32 * mib, 256 * mib, cos.GiB} {
+		n := conf.NumDataSlices(size)
+		tassert.Errorf(t, n == 8, "size %d: expected 8 data slices, got %d", size, n)
+		tassert.Errorf(t, size/int64(n) > prev, "size %d: expecting slice size to grow", size)
+		prev = size / int64(n)
+	}
+}
+
+func TestBackendClientConf(t *testing.T) {
+	tests := []struct {
+		conf map[string]any
+		ok   bool
+	}{
+		{map[string]any{apc.AWS: map[string]any{}, apc.GCP: map[string]any{}}, true},
+		{map[string]any{apc.AWS: map[string]any{"endpoint": "http://minio.local:9000", "use_path_style": true, "max_retries": 5}}, true},
+		{map[string]any{apc.Azure: map[string]any{"timeout": "2m", "max_idle_conns_per_host": 64}}, true},
+		{map[string]any{apc.AWS: map[string]any{"endpoint": "minio.local"}}, false},
+		{map[string]any{apc.GCP: map[string]any{"use_path_style": true}}, false},
+		{map[string]any{apc.HT: map[string]any{"endpoint": "http://example.com"}}, false},
+		{map[string]any{apc.Azure: map[string]any{"max_retries": -1}}, false},
+	}
+	for i, test := range tests {
+		conf := cmn.BackendConf{Conf: test.conf}
+		err := conf.Validate()
+		tassert.Errorf(t, (err == nil) == test.ok, "%d: %v: expected ok=%t, got err=%v", i, test.conf, test.ok, err)
+	}
+
+	conf := cmn.BackendConf{Conf: map[string]any{apc.AWS: map[string]any{"endpoint": "http://minio.local:9000", "max_retries": 5}}}
+	tassert.CheckFatal(t, conf.Validate())
+	cc := conf.ClientConf(apc.AWS)
+	tassert.Errorf(t, cc.Endpoint == "http://minio.local:9000" && cc.MaxRetries == 5, "unexpected %+v", cc)
+	_, ok := conf.Providers[apc.AWS]
+	tassert.Errorf(t, ok, "expecting %q provider", apc.AWS)
+	cc = conf.ClientConf(apc.GCP)
+	tassert.Errorf(t, cc == cmn.BackendClientConf{}, "expecting zero values, got %+v", cc)
+}
+
+func TestConfigToSetAlerts(t *testing.T) {
+	var (
+		toUpdate cmn.ConfigToSet
+		query    = url.Values{}
+	)
+	query.Set("alerts.rules", `[{"name": "disk-full", "metric": "capacity", "threshold": 90, "for": "1m"}]`)
+	query.Set("alerts.enabled", "true")
+	tassert.CheckFatal(t, toUpdate.FillFromQuery(query))
+	tassert.Fatalf(t, toUpdate.Alerts != nil && toUpdate.Alerts.Rules != nil, "expecting alert rules")
+	rules := *toUpdate.Alerts.Rules
+	tassert.Fatalf(t, len(rules) == 1 && rules[0].Threshold == 90 && rules[0].For.D() == time.Minute, "unexpected %+v", rules)
+
+	query = url.Values{}
+	query.Set("alerts.sinks", "slack")
+	err := toUpdate.FillFromQuery(query)
+	tassert.Errorf(t, err != nil, "expecting error parsing non-JSON list of sinks")
+}
+
+func TestDefaultClusterConfig(t *testing.T) {
+	c, err := cmn.DefaultClusterConfig()
+	tassert.CheckFatal(t, err)
+	err = cmn.IterFields(c, func(tag string, field cmn.IterField) (error, bool) {
+		if v, ok := field.Value().(cmn.Validator); ok {
+			if err := v.Validate(); err != nil {
+				t.Errorf("%s: %v", tag, err)
+			}
+		}
+		return nil, false
+	}, cmn.IterOpts{VisitAll: true})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, c.Space.HighWM == 90 && c.Cksum.Type == cos.ChecksumXXHash, "unexpected defaults: %+v, %+v", c.Space, c.Cksum)
+}
@@ -22,3 +22,13 @@ func TestAbortedErrorAs(t *testing.T) {
 	mockError := fmt.Errorf("wrapping aborted error %w", abortedError)
 	tassert.Fatalf(t, cmn.IsErrAborted(mockError), "expected errors.As to return true on a wrapped error")
 }
+
+func TestAccessErrors(t *testing.T) {
+	bck := cmn.Bck{Name: "abc", Provider: "aws"}
+
+	err := error(cmn.NewErrForbidden(&bck, "bucket \"s3://abc\": PUT access denied"))
+	tassert.Errorf(t, cmn.IsErrForbidden(err) && !cmn.IsErrUnauthorized(err), "expected forbidden, got %v", err)
+	err = cmn.NewErrUnauthorized(&bck, "token expired")
+	tassert.Errorf(t, cmn.IsErrUnauthorized(err) && !cmn.IsErrForbidden(err), "expected unauthorized, got %v", err)
+	tassert.Errorf(t, err.Error() == `bucket "s3://abc": unauthorized: token expired`, "unexpected message: %v", err)
+}
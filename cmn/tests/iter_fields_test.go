@@ -76,6 +76,7 @@ var _ = Describe("IterFields", func() {
 					"ec.compression":       "",
 					"ec.bundle_multiplier": 0,
 					"ec.disk_only":         false,
+					"ec.min_slice_size":    cos.SizeIEC(0),
 
 					"versioning.enabled":           false,
 					"versioning.validate_warm_get": false,
@@ -91,10 +92,11 @@ var _ = Describe("IterFields", func() {
 					"lru.dont_evict_time":   cos.Duration(0),
 					"lru.capacity_upd_time": cos.Duration(0),
 
-					"extra.aws.cloud_region": "us-central",
-					"extra.aws.endpoint":     "",
-					"extra.aws.profile":      "",
-					"extra.aws.max_pagesize": int64(0),
+					"extra.aws.cloud_region":   "us-central",
+					"extra.aws.endpoint":       "",
+					"extra.aws.profile":        "",
+					"extra.aws.max_pagesize":   int64(0),
+					"extra.aws.sse_kms_key_id": "",
 
 					"access":   apc.AccessAttrs(0),
 					"features": feat.Flags(0),
@@ -102,6 +104,10 @@ var _ = Describe("IterFields", func() {
 
 					"write_policy.data": apc.WritePolicy(""),
 					"write_policy.md":   apc.WritePolicy(""),
+
+					"quota.max_bytes":   int64(0),
+					"quota.max_objects": int64(0),
+					"quota.soft_pct":    int64(0),
 				},
 			),
 			Entry("list BpropsToSet fields",
@@ -135,6 +141,7 @@ var _ = Describe("IterFields", func() {
 					"ec.compression":       (*string)(nil),
 					"ec.bundle_multiplier": (*int)(nil),
 					"ec.disk_only":         (*bool)(nil),
+					"ec.min_slice_size":    (*cos.SizeIEC)(nil),
 
 					"versioning.enabled":           (*bool)(nil),
 					"versioning.validate_warm_get": (*bool)(nil),
@@ -156,12 +163,18 @@ var _ = Describe("IterFields", func() {
 					"write_policy.data": (*apc.WritePolicy)(nil),
 					"write_policy.md":   apc.Ptr(apc.WriteDelayed),
 
+					"quota.max_bytes":   (*int64)(nil),
+					"quota.max_objects": (*int64)(nil),
+					"quota.soft_pct":    (*int64)(nil),
+
 					"extra.hdfs.ref_directory": (*string)(nil),
 					"extra.aws.cloud_region":   (*string)(nil),
 					"extra.aws.endpoint":       (*string)(nil),
 					"extra.aws.profile":        (*string)(nil),
 					"extra.aws.max_pagesize":   (*int64)(nil),
+					"extra.aws.sse_kms_key_id": (*string)(nil),
 					"extra.http.original_url":  (*string)(nil),
+					"extra.http.index":         (*string)(nil),
 				},
 			),
 			Entry("check for omit tag",
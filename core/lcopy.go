@@ -167,6 +167,15 @@ func (lom *LOM) syncMetaWithCopies() (err error) {
 	return
 }
 
+// PersistWithCopies persists updated metadata (e.g., checksum) of the object and all its copies.
+// NOTE: uname for LOM must be already locked.
+func (lom *LOM) PersistWithCopies() error {
+	if err := lom.syncMetaWithCopies(); err != nil {
+		return err
+	}
+	return lom.Persist()
+}
+
 // RestoreObjectFromAny tries to restore the object at its default location.
 // Returns true if object exists, false otherwise
 // TODO: locking vs concurrent restore: consider (read-lock object + write-lock meta) split
@@ -22,6 +22,11 @@ type (
 	DP interface {
 		Reader(lom *LOM, latestVer, sync bool) (reader cos.ReadOpenCloser, oah cos.OAH, err error)
 	}
+	// data provider that takes per-request parameters (e.g., ETL transformer parameters)
+	ParamDP interface {
+		DP
+		WithParams(params cos.StrKVs) DP
+	}
 
 	LDP struct{}
 
@@ -48,6 +53,12 @@ func (r *deferROC) Close() (err error) {
 	return
 }
 
+// (transport.Reopener) rlocked file can be reopened to resend upon reconnect
+func (r *deferROC) CanReopen() bool {
+	_, ok := r.ReadOpenCloser.(*cos.FileHandle)
+	return ok
+}
+
 // is called under rlock; unlocks on fail
 func (lom *LOM) NewDeferROC() (cos.ReadOpenCloser, error) {
 	fh, err := cos.NewFileHandle(lom.FQN)
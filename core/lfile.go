@@ -11,8 +11,10 @@ import (
 	"syscall"
 
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/fs"
 )
 
 const (
@@ -142,3 +144,73 @@ func (lom *LOM) RenameFinalize(wfqn string) error {
 	}
 	return nil
 }
+
+// whether the object's file is hard-linked by bucket snapshot(s) and,
+// therefore, must not be modified in place (copy-on-write)
+func (lom *LOM) IsShared() bool {
+	n, err := cos.NumLinks(lom.FQN)
+	return err == nil && n > 1
+}
+
+//
+// archived-content index (TAR shards only - see cmn/archive/index.go)
+//
+
+// returns nil when there's no index, or the index is stale
+func (lom *LOM) LoadArchIndex() *archive.Index {
+	b, err := os.ReadFile(fs.CSM.Gen(lom, fs.ArchIdxType, ""))
+	if err != nil {
+		return nil
+	}
+	idx, err := archive.UnmarshalIndex(b)
+	if err != nil {
+		return nil
+	}
+	finfo, err := os.Stat(lom.FQN)
+	if err != nil || !idx.Valid(finfo) {
+		return nil
+	}
+	return idx
+}
+
+// stamp the index with the current size and mtime of the shard, and store it
+func (lom *LOM) SaveArchIndex(idx *archive.Index) error {
+	finfo, err := os.Stat(lom.FQN)
+	if err != nil {
+		return err
+	}
+	idx.Stamp(finfo)
+	var (
+		fqn     = fs.CSM.Gen(lom, fs.ArchIdxType, "")
+		workFQN = fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileArchIdx)
+	)
+	if err := cos.CreateDir(filepath.Dir(workFQN)); err != nil {
+		return err
+	}
+	if err := os.WriteFile(workFQN, idx.Marshal(), cos.PermRWR); err != nil {
+		return err
+	}
+	if err := cos.CreateDir(filepath.Dir(fqn)); err != nil {
+		cos.RemoveFile(workFQN)
+		return err
+	}
+	if err := os.Rename(workFQN, fqn); err != nil {
+		cos.RemoveFile(workFQN)
+		return err
+	}
+	return nil
+}
+
+// build the index by reading the entire shard
+func (lom *LOM) BuildArchIndex() (*archive.Index, error) {
+	fh, err := os.Open(lom.FQN)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := archive.BuildIndex(fh)
+	cos.Close(fh)
+	if err != nil {
+		return nil, err
+	}
+	return idx, lom.SaveArchIndex(idx)
+}
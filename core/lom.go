@@ -185,6 +185,7 @@ func (lom *LOM) SetCustomMD(md cos.StrKVs) { lom.md.SetCustomMD(md) }
 
 func (lom *LOM) GetCustomKey(key string) (string, bool) { return lom.md.GetCustomKey(key) }
 func (lom *LOM) SetCustomKey(key, value string)         { lom.md.SetCustomKey(key, value) }
+func (lom *LOM) DelCustomKey(key string)                { lom.md.DelCustomKeys(key) }
 
 // subj to resilvering
 func (lom *LOM) IsHRW() bool {
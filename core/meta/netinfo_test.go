@@ -0,0 +1,29 @@
+// Package meta_test: unit tests for the package
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta_test
+
+import (
+	"github.com/NVIDIA/aistore/core/meta"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NetInfo", func() {
+	DescribeTable("should construct TCP endpoint and URL",
+		func(hostname, port, ep, url string) {
+			var ni meta.NetInfo
+			ni.Init("http", hostname, port)
+			Expect(ni.TCPEndpoint()).To(Equal(ep))
+			Expect(ni.URL).To(Equal(url))
+
+			ni2 := meta.NetInfo{Hostname: hostname, Port: port}
+			Expect(ni2.TCPEndpoint()).To(Equal(ep))
+		},
+		Entry("IPv4", "10.0.0.1", "8080", "10.0.0.1:8080", "http://10.0.0.1:8080"),
+		Entry("hostname", "ais-target-1", "8080", "ais-target-1:8080", "http://ais-target-1:8080"),
+		Entry("IPv6", "fd00::1", "8080", "[fd00::1]:8080", "http://[fd00::1]:8080"),
+		Entry("IPv6 loopback", "::1", "51081", "[::1]:51081", "http://[::1]:51081"),
+	)
+})
@@ -16,3 +16,20 @@ type (
 		Ver int64     `json:"ver"`
 	}
 )
+
+// `ais remote-cluster ping`: latencies from a given (local) target to all nodes of all attached clusters
+type (
+	RemAisNodePing struct {
+		ID      string `json:"id"`
+		URL     string `json:"url"` // public URL
+		Err     string `json:"err,omitempty"`
+		Latency int64  `json:"latency,string"` // ns
+		Proxy   bool   `json:"proxy"`          // true: control plane; false: data plane
+	}
+	RemAisPing struct {
+		UUID  string            `json:"uuid"`
+		Alias string            `json:"alias"`
+		Err   string            `json:"err,omitempty"` // (e.g., cluster unreachable)
+		Nodes []*RemAisNodePing `json:"nodes"`
+	}
+)
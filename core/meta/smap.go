@@ -350,7 +350,8 @@ func (e *errNetInfoChanged) Error() string {
 	return fmt.Sprintf("%s: %s %s vs %s", e.sname, e.tag, e.nep, e.oep)
 }
 
-func _ep(hostname, port string) string { return hostname + ":" + port }
+// (IPv6 addresses get bracketed, e.g. "[fd00::1]:8080")
+func _ep(hostname, port string) string { return net.JoinHostPort(hostname, port) }
 
 func (ni *NetInfo) Init(proto, hostname, port string) {
 	ep := _ep(hostname, port)
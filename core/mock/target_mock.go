@@ -68,6 +68,8 @@ func (*TargetMock) CopyObject(*core.LOM, core.DM, *core.CopyParams) (int64, erro
 	return 0, nil
 }
 
+func (*TargetMock) RenameObject(*core.LOM, string) error { return nil }
+
 func (*TargetMock) GetCold(context.Context, *core.LOM, cmn.OWT) (int, error) {
 	return http.StatusOK, nil
 }
@@ -125,6 +125,7 @@ type (
 		HeadCold(lom *LOM, origReq *http.Request) (objAttrs *cmn.ObjAttrs, ecode int, err error)
 
 		CopyObject(lom *LOM, dm DM, coi *CopyParams) (int64, error)
+		RenameObject(lom *LOM, objNameTo string) error
 		Promote(params *PromoteParams) (ecode int, err error)
 		HeadObjT2T(lom *LOM, si *meta.Snode) bool
 
@@ -14,6 +14,7 @@ cat > $AIS_CONF_FILE <<EOL
 		"compression":		"${AIS_EC_COMPRESSION:-never}",
 		"bundle_multiplier":	${AIS_EC_BUNDLE_MULTIPLIER:-2},
 		"data_slices":		${AIS_DATA_SLICES:-1},
+		"min_slice_size":	"${AIS_EC_MIN_SLICE_SIZE:-0}",
 		"parity_slices":	${AIS_PARITY_SLICES:-1},
 		"enabled":		${AIS_EC_ENABLED:-false},
 		"disk_only":		false
@@ -89,7 +90,8 @@ cat > $AIS_CONF_FILE <<EOL
 		"idle_teardown":	"${AIS_TRANSPORT_IDLE_TEARDOWN:-4s}",
 		"quiescent":		"${AIS_TRANSPORT_QUIESCENT:-10s}",
 		"lz4_block":		"${AIS_TRANSPORT_LZ4_BLOCK:-256kb}",
-		"lz4_frame_checksum":	${AIS_TRANSPORT_LZ4_FRAME_CHECKSUM:-false}
+		"lz4_frame_checksum":	${AIS_TRANSPORT_LZ4_FRAME_CHECKSUM:-false},
+		"zero_copy":		${AIS_TRANSPORT_ZERO_COPY:-false}
 	},
 	"memsys": {
 		"min_free":		"2gb",
@@ -163,6 +165,39 @@ cat > $AIS_CONF_FILE <<EOL
 		"compression":		"never",
 		"bundle_multiplier":	2
 	},
+	"xaction": {
+		"retention":		"1h",
+		"retention_kind":	"list=1m",
+		"keep_min":		256
+	},
+	"tracing": {
+		"enabled":		${AIS_TRACING_ENABLED:-false},
+		"exporter_endpoint":	"${AIS_TRACING_ENDPOINT:-}",
+		"service_name_prefix":	"ais",
+		"sampler_probability":	${AIS_TRACING_SAMPLER_PROBABILITY:-0}
+	},
+	"housekeeping": {
+		"window":	"${AIS_HOUSEKEEPING_WINDOW:-}"
+	},
+	"cors": {
+		"enabled":		${AIS_CORS_ENABLED:-false},
+		"allowed_origins":	"${AIS_CORS_ORIGINS:-}",
+		"allowed_methods":	"GET,HEAD,PUT,POST,DELETE",
+		"allowed_headers":	"*",
+		"expose_headers":	"ETag,Content-Length,Content-Range,Accept-Ranges",
+		"max_age":		"10m",
+		"allow_credentials":	false
+	},
+	"alerts": {
+		"enabled":	false,
+		"interval":	"30s",
+		"rules":	[],
+		"sinks":	[]
+	},
+	"gpu": {
+		"source":	"${AIS_GPU_STATS_SOURCE:-}",
+		"endpoint":	"${AIS_GPU_STATS_ENDPOINT:-}"
+	},
 	"write_policy": {
 		"data": "${WRITE_POLICY_DATA:-}",
 		"md": "${WRITE_POLICY_MD:-}"
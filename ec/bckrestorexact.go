@@ -0,0 +1,345 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"container/heap"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Background recovery of erasure-coded (and replicated) objects with missing CTs (slices or replicas).
+// Runs on all targets, in two phases:
+// 1. "scan": for each local object this target is the main (HRW) target of, check the CTs listed
+//    in its EC metadata and compute the object's exposure level (see exposure() below);
+// 2. "recover": re-encode the objects with missing CTs, most exposed first - that is, objects
+//    closest to data loss get recovered before those that can still survive more failures.
+// Extended stats (ExtECRestoreStats) include the per-exposure-level histogram of objects
+// pending recovery and recovered so far.
+
+const (
+	rstPhaseScan    = "scan"
+	rstPhaseRecover = "recover"
+)
+
+type (
+	rstFactory struct {
+		xreg.RenewBase
+		xctn *XactBckRestore
+	}
+	XactBckRestore struct {
+		xact.Base
+		bck     *meta.Bck
+		smap    *meta.Smap
+		client  *http.Client
+		sema    *cos.Semaphore // limits the number of objects being recovered at the same time
+		wg      sync.WaitGroup // waits for in-flight recoveries
+		hist    exposureHist
+		queue   rstQueue
+		qmu     sync.Mutex
+		scanned atomic.Int64
+		failed  atomic.Int64
+		recover atomic.Bool // second phase
+	}
+	// extended x-ec-restore statistics;
+	// histograms are indexed by exposure level: 0 - one more lost CT means data loss, etc.
+	ExtECRestoreStats struct {
+		Phase     string  `json:"phase"`
+		Pending   []int64 `json:"pending"`
+		Recovered []int64 `json:"recovered"`
+		Scanned   int64   `json:"scanned.n,string"`
+		Failed    int64   `json:"failed.n,string"`
+	}
+
+	exposureHist struct {
+		pending   []int64
+		recovered []int64
+		mu        sync.Mutex
+	}
+
+	// priority queue of objects to recover, ordered by exposure level (ascending)
+	rstItem struct {
+		objName string
+		level   int
+		missing int
+	}
+	rstQueue []*rstItem
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactBckRestore)(nil)
+	_ xreg.Renewable = (*rstFactory)(nil)
+	_ heap.Interface = (*rstQueue)(nil)
+)
+
+////////////////
+// rstFactory //
+////////////////
+
+func (*rstFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &rstFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *rstFactory) Start() error {
+	if !p.Bck.Props.EC.Enabled {
+		return fmt.Errorf("%s does not have EC enabled", p.Bck.Cname(""))
+	}
+	p.xctn = newXactBckRestore(p.Bck, p.UUID())
+	go p.xctn.Run(nil)
+	return nil
+}
+
+func (*rstFactory) Kind() string     { return apc.ActECRestore }
+func (p *rstFactory) Get() core.Xact { return p.xctn }
+
+func (*rstFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+////////////////////
+// XactBckRestore //
+////////////////////
+
+func newXactBckRestore(bck *meta.Bck, uuid string) (r *XactBckRestore) {
+	avail, _ := fs.Get()
+	r = &XactBckRestore{
+		bck:    bck,
+		smap:   core.T.Sowner().Get(),
+		client: core.T.DataClient(),
+		sema:   cos.NewSemaphore(max(len(avail), 1)),
+	}
+	r.InitBase(uuid, apc.ActECRestore, bck)
+	return
+}
+
+func (r *XactBckRestore) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name())
+
+	ECM.incActive(r)
+
+	// 1. scan
+	opts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		DoLoad:   mpather.LoadUnsafe,
+		Throttle: true,
+	}
+	opts.Bck.Copy(r.bck.Bucket())
+	jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), nil)
+	jg.Run()
+	select {
+	case <-r.ChanAbort():
+		jg.Stop()
+		r.Finish()
+		return
+	case <-jg.ListenFinished():
+		if err := jg.Stop(); err != nil {
+			r.AddErr(err)
+			r.Finish()
+			return
+		}
+	}
+
+	// 2. recover, most exposed first
+	r.recover.Store(true)
+	nlog.Infoln(r.Name(), "scanned:", r.scanned.Load(), "to recover:", r.queue.Len())
+	for r.queue.Len() > 0 {
+		select {
+		case <-r.ChanAbort():
+			r.wg.Wait()
+			r.Finish()
+			return
+		case <-r.sema.TryAcquire():
+		}
+		it := heap.Pop(&r.queue).(*rstItem)
+		r.recoverObj(it)
+	}
+	r.wg.Wait()
+	r.Finish()
+}
+
+// (scan phase) compute exposure and enqueue objects with missing CTs
+func (r *XactBckRestore) visitObj(lom *core.LOM, _ []byte) error {
+	if _, local, err := lom.HrwTarget(r.smap); err != nil || !local {
+		return nil // (not the main replica)
+	}
+	mdFQN, _, err := core.HrwFQN(lom.Bck().Bucket(), fs.ECMetaType, lom.ObjName)
+	if err != nil {
+		nlog.Warningf("metadata FQN generation failed %q: %v", lom, err)
+		return nil
+	}
+	md, err := LoadMetadata(mdFQN)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			r.AddErr(err, 5, cos.SmoduleEC)
+		}
+		return nil // (not erasure-coded yet - see ec-encode)
+	}
+	r.scanned.Inc()
+
+	present := 1 // (this main replica)
+	for tid := range md.Daemons {
+		if tid == core.T.SID() {
+			continue
+		}
+		tsi := r.smap.GetTarget(tid)
+		if tsi == nil || tsi.InMaintOrDecomm() {
+			continue
+		}
+		rmd, err := RequestECMeta(lom.Bucket(), lom.ObjName, tsi, r.client)
+		if err == nil && rmd.Generation == md.Generation {
+			present++
+		}
+	}
+	if present >= len(md.Daemons) {
+		return nil
+	}
+
+	it := &rstItem{objName: lom.ObjName, level: exposure(md, present), missing: len(md.Daemons) - present}
+	r.qmu.Lock()
+	heap.Push(&r.queue, it)
+	r.qmu.Unlock()
+	r.hist.add(it.level)
+	return nil
+}
+
+// (recover phase) re-encode from the local (main) replica; the semaphore is acquired by the caller
+func (r *XactBckRestore) recoverObj(it *rstItem) {
+	lom := core.AllocLOM(it.objName)
+	defer core.FreeLOM(lom)
+
+	err := lom.InitBck(r.bck.Bucket())
+	if err == nil {
+		err = lom.Load(false /*cache it*/, false /*locked*/)
+	}
+	if err != nil {
+		r.done(it.level, lom, err)
+		r.sema.Release()
+		return
+	}
+	r.wg.Add(1)
+	cb := func(lom *core.LOM, err error) {
+		r.done(it.level, lom, err)
+		r.sema.Release()
+		r.wg.Done()
+	}
+	if err := ECM.EncodeObject(lom, cb); err != nil {
+		cb(lom, err)
+	}
+}
+
+func (r *XactBckRestore) done(level int, lom *core.LOM, err error) {
+	if err == nil {
+		r.LomAdd(lom)
+		r.hist.done(level, true)
+		return
+	}
+	r.hist.done(level, false)
+	if !cos.IsNotExist(err, 0) && err != errSkipped {
+		r.failed.Inc()
+		r.AddErr(fmt.Errorf("failed to recover %s: %w", lom.Cname(), err), 5, cos.SmoduleEC)
+	}
+}
+
+func (r *XactBckRestore) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	phase := rstPhaseScan
+	if r.recover.Load() {
+		phase = rstPhaseRecover
+	}
+	ext := &ExtECRestoreStats{Phase: phase, Scanned: r.scanned.Load(), Failed: r.failed.Load()}
+	ext.Pending, ext.Recovered = r.hist.get()
+	snap.Ext = ext
+	return
+}
+
+// Exposure level: the number of additional CTs an object can lose and still be recoverable,
+// given the number of its CTs present in the cluster (including the main replica):
+// - replicated: any single surviving replica will do;
+// - encoded: the main replica, or any `Data` slices.
+func exposure(md *Metadata, present int) int {
+	if md.IsCopy {
+		return max(present-1, 0)
+	}
+	slices := present - 1
+	if slices < md.Data {
+		return 0 // the main replica is the only remaining full copy
+	}
+	return slices - md.Data + 1
+}
+
+//////////////////
+// exposureHist //
+//////////////////
+
+func (h *exposureHist) add(level int) {
+	h.mu.Lock()
+	for len(h.pending) <= level {
+		h.pending = append(h.pending, 0)
+		h.recovered = append(h.recovered, 0)
+	}
+	h.pending[level]++
+	h.mu.Unlock()
+}
+
+// recovered or not, the object is no longer pending
+func (h *exposureHist) done(level int, recovered bool) {
+	h.mu.Lock()
+	h.pending[level]--
+	if recovered {
+		h.recovered[level]++
+	}
+	h.mu.Unlock()
+}
+
+func (h *exposureHist) get() (pending, recovered []int64) {
+	h.mu.Lock()
+	pending = append([]int64(nil), h.pending...)
+	recovered = append([]int64(nil), h.recovered...)
+	h.mu.Unlock()
+	return
+}
+
+//////////////
+// rstQueue //
+//////////////
+
+func (q rstQueue) Len() int { return len(q) }
+
+// most exposed first; same level - the one with more missing CTs
+func (q rstQueue) Less(i, j int) bool {
+	if q[i].level != q[j].level {
+		return q[i].level < q[j].level
+	}
+	return q[i].missing > q[j].missing
+}
+
+func (q rstQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *rstQueue) Push(x any)   { *q = append(*q, x.(*rstItem)) }
+
+func (q *rstQueue) Pop() any {
+	old := *q
+	n := len(old)
+	it := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return it
+}
@@ -0,0 +1,78 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"container/heap"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestExposure(t *testing.T) {
+	tests := []struct {
+		name     string
+		md       Metadata
+		present  int
+		expected int
+	}{
+		{"replicated, main only", Metadata{IsCopy: true, Parity: 2}, 1, 0},
+		{"replicated, one replica", Metadata{IsCopy: true, Parity: 2}, 2, 1},
+		{"replicated, all present", Metadata{IsCopy: true, Parity: 2}, 3, 2},
+		{"encoded, main only", Metadata{Data: 4, Parity: 2}, 1, 0},
+		{"encoded, fewer than data slices", Metadata{Data: 4, Parity: 2}, 4, 0},
+		{"encoded, exactly data slices", Metadata{Data: 4, Parity: 2}, 5, 1},
+		{"encoded, one parity lost", Metadata{Data: 4, Parity: 2}, 6, 2},
+		{"encoded, single data slice", Metadata{Data: 1, Parity: 1}, 2, 1},
+	}
+	for _, test := range tests {
+		level := exposure(&test.md, test.present)
+		tassert.Errorf(t, level == test.expected, "%s: expected exposure %d, got %d", test.name, test.expected, level)
+	}
+}
+
+func TestRestoreQueuePriority(t *testing.T) {
+	var (
+		q     rstQueue
+		items = []*rstItem{
+			{objName: "l2-m1", level: 2, missing: 1},
+			{objName: "l0-m1", level: 0, missing: 1},
+			{objName: "l1-m2", level: 1, missing: 2},
+			{objName: "l0-m3", level: 0, missing: 3},
+			{objName: "l1-m1", level: 1, missing: 1},
+			{objName: "l3-m1", level: 3, missing: 1},
+		}
+		expected = []string{"l0-m3", "l0-m1", "l1-m2", "l1-m1", "l2-m1", "l3-m1"}
+	)
+	for _, it := range items {
+		heap.Push(&q, it)
+	}
+	tassert.Fatalf(t, q.Len() == len(items), "expected %d items, got %d", len(items), q.Len())
+
+	// interleave: a newly discovered (more exposed) object jumps the queue
+	it := heap.Pop(&q).(*rstItem)
+	tassert.Errorf(t, it.objName == expected[0], "expected %q first, got %q", expected[0], it.objName)
+	heap.Push(&q, &rstItem{objName: "l0-m2", level: 0, missing: 2})
+	expected = append([]string{"l0-m2"}, expected[1:]...)
+
+	for i := 0; q.Len() > 0; i++ {
+		it := heap.Pop(&q).(*rstItem)
+		tassert.Errorf(t, it.objName == expected[i], "%d: expected %q, got %q", i, expected[i], it.objName)
+	}
+}
+
+func TestExposureHist(t *testing.T) {
+	var h exposureHist
+	h.add(2)
+	h.add(0)
+	h.add(0)
+	h.done(0, true)
+	h.done(2, false)
+
+	pending, recovered := h.get()
+	tassert.Fatalf(t, len(pending) == 3 && len(recovered) == 3, "expecting 3 levels, got %v, %v", pending, recovered)
+	tassert.Errorf(t, pending[0] == 1 && pending[1] == 0 && pending[2] == 0, "unexpected pending %v", pending)
+	tassert.Errorf(t, recovered[0] == 1 && recovered[1] == 0 && recovered[2] == 0, "unexpected recovered %v", recovered)
+}
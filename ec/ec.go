@@ -34,7 +34,8 @@ import (
 // data protection is off. To enable it, set the bucket EC configuration:
 //	ECConf:
 //		Enable: true|false    # enables or disables protection
-//		DataSlices: [1-32]    # the number of data slices
+//		DataSlices: [1-32]    # the (maximum) number of data slices
+//		MinSliceSize: 0       # when non-zero, fewer data slices for smaller objects
 //		ParitySlices: [1-32]  # the number of parity slices
 //		ObjSizeLimit: 0       # replication versus erasure coding
 //
@@ -115,8 +116,9 @@ const (
 	ActClearRequests  = "clear-requests"
 	ActEnableRequests = "enable-requests"
 
-	URLCT   = "ct"   // for using in URL path - requests for slices/replicas
-	URLMeta = "meta" /// .. - metadata requests
+	URLCT     = "ct"     // for using in URL path - requests for slices/replicas
+	URLMeta   = "meta"   /// .. - metadata requests
+	URLVerify = "verify" // .. - verify local CT (see VerifyCT)
 
 	// EC switches to disk from SGL when memory pressure is high and the amount of
 	// memory required to encode an object exceeds the limit
@@ -205,6 +207,7 @@ func Init() {
 	xreg.RegBckXact(&putFactory{})
 	xreg.RegBckXact(&rspFactory{})
 	xreg.RegBckXact(&encFactory{})
+	xreg.RegBckXact(&rstFactory{})
 
 	if err := initManager(); err != nil {
 		cos.ExitLog("Failed to initialize EC manager:", err)
@@ -97,7 +97,7 @@ func (mgr *Manager) initECBundles() error {
 		client      = transport.NewIntraDataClient()
 		config      = cmn.GCO.Get()
 		compression = config.EC.Compression
-		extraReq    = transport.Extra{Callback: cbReq, Compression: compression, Config: config}
+		extraReq    = transport.Extra{Callback: cbReq, Compression: compression, Config: config, MaxReplay: transport.DfltMaxReplay}
 	)
 	reqSbArgs := bundle.Args{
 		Multiplier: config.EC.SbundleMult,
@@ -109,7 +109,7 @@ func (mgr *Manager) initECBundles() error {
 		Multiplier: config.EC.SbundleMult,
 		Trname:     RespStreamName,
 		Net:        mgr.netResp,
-		Extra:      &transport.Extra{Compression: compression, Config: config},
+		Extra:      &transport.Extra{Compression: compression, Config: config, MaxReplay: transport.DfltMaxReplay},
 	}
 
 	mgr.reqBundle.Store(bundle.New(client, reqSbArgs))
@@ -125,7 +125,8 @@ func (c *putJogger) processRequest(req *request) {
 			return
 		}
 		ecConf := lom.Bprops().EC
-		memRequired := lom.Lsize() * int64(ecConf.DataSlices+ecConf.ParitySlices) / int64(ecConf.ParitySlices)
+		dataSlices := ecConf.NumDataSlices(lom.Lsize())
+		memRequired := lom.Lsize() * int64(dataSlices+ecConf.ParitySlices) / int64(ecConf.ParitySlices)
 		c.toDisk = useDisk(memRequired, c.parent.config)
 	}
 
@@ -195,16 +196,18 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 	}
 	var (
 		ecConf     = lom.Bprops().EC
+		dataSlices = ecConf.DataSlices
 		reqTargets = ecConf.ParitySlices + 1
 		smap       = core.T.Sowner().Get()
 	)
 	if !req.IsCopy {
-		reqTargets += ecConf.DataSlices
+		dataSlices = ecConf.NumDataSlices(lom.Lsize())
+		reqTargets += dataSlices
 	}
 	targetCnt := smap.CountActiveTs()
 	if targetCnt < reqTargets {
 		return fmt.Errorf("%v: given EC config (d=%d, p=%d), %d targets required to encode %s (have %d, %s)",
-			cmn.ErrNotEnoughTargets, ecConf.DataSlices, ecConf.ParitySlices, reqTargets, lom, targetCnt, smap.StringEx())
+			cmn.ErrNotEnoughTargets, dataSlices, ecConf.ParitySlices, reqTargets, lom, targetCnt, smap.StringEx())
 	}
 
 	var (
@@ -216,7 +219,7 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 		MDVersion:   MDVersionLast,
 		Generation:  generation,
 		Size:        lom.Lsize(),
-		Data:        ecConf.DataSlices,
+		Data:        dataSlices, // (geometry used for recovery)
 		Parity:      ecConf.ParitySlices,
 		IsCopy:      req.IsCopy,
 		ObjCksum:    cksumValue,
@@ -270,8 +273,8 @@ func (c *putJogger) encode(req *request, lom *core.LOM) error {
 func (*putJogger) newCtx(lom *core.LOM, meta *Metadata) (ctx *encodeCtx, err error) {
 	ctx = allocCtx()
 	ctx.lom = lom
-	ctx.dataSlices = lom.Bprops().EC.DataSlices
-	ctx.paritySlices = lom.Bprops().EC.ParitySlices
+	ctx.dataSlices = meta.Data
+	ctx.paritySlices = meta.Parity
 	ctx.meta = meta
 
 	totalCnt := ctx.paritySlices + ctx.dataSlices
@@ -0,0 +1,358 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/klauspost/reedsolomon"
+)
+
+// On-demand integrity check of a single erasure-coded (or replicated) object:
+// - runs on the object's main (HRW) target that collects EC metadata and checks all CTs - full replicas
+//   and slices - listed in the latter;
+// - each CT gets checksummed locally by the target that stores it (see VerifyCT);
+// - when all data and parity slices are present and intact, the main target additionally streams
+//   them in to check parity math - without restoring (reconstructing) the object.
+
+// CT statuses
+const (
+	CTok      = "ok"
+	CTmissing = "missing"
+	CTcorrupt = "corrupt"
+	CTstale   = "stale" // belongs to a different generation of the object
+)
+
+// parity statuses
+const (
+	ParityOK       = "ok"
+	ParityMismatch = "mismatch"
+	ParitySkipped  = "skipped" // not all data and parity slices are available
+	ParityNA       = "n/a"     // replicated (not encoded)
+)
+
+type (
+	CTStatus struct {
+		Node    string `json:"node"`
+		Status  string `json:"status"`
+		Err     string `json:"error,omitempty"`
+		SliceID int    `json:"slice_id"` // 0 for full replica, 1 to N for slices
+	}
+	VerifyResult struct {
+		Cname        string      `json:"name"`
+		Parity       string      `json:"parity"`
+		ParityErr    string      `json:"parity_error,omitempty"`
+		CTs          []*CTStatus `json:"cts"`
+		Generation   int64       `json:"generation"`
+		Size         int64       `json:"size"`
+		DataSlices   int         `json:"data_slices"`
+		ParitySlices int         `json:"parity_slices"`
+		IsCopy       bool        `json:"is_copy"`
+	}
+)
+
+func (res *VerifyResult) OK() bool {
+	for _, st := range res.CTs {
+		if st.Status != CTok {
+			return false
+		}
+	}
+	return res.Parity == ParityOK || res.Parity == ParityNA
+}
+
+// VerifyObject is called on the object's main target.
+func VerifyObject(lom *core.LOM) (*VerifyResult, error) {
+	var (
+		bck    = lom.Bck()
+		smap   = core.T.Sowner().Get()
+		client = core.T.DataClient()
+	)
+	md, err := ObjectMetadata(bck, lom.ObjName)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		// the main replica and its metafile may be missing - look up the latest metadata elsewhere
+		if md = latestMeta(lom, smap, client); md == nil {
+			return nil, cos.NewErrNotFound(core.T, lom.Cname()+" (EC metadata)")
+		}
+	}
+
+	res := &VerifyResult{
+		Cname:        lom.Cname(),
+		CTs:          make([]*CTStatus, 0, len(md.Daemons)),
+		Generation:   md.Generation,
+		Size:         md.Size,
+		DataSlices:   md.Data,
+		ParitySlices: md.Parity,
+		IsCopy:       md.IsCopy,
+	}
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for tid, sliceID := range md.Daemons {
+		wg.Add(1)
+		go func(tid string, sliceID int) {
+			var st *CTStatus
+			switch tsi := smap.GetTarget(tid); {
+			case tid == core.T.SID():
+				st = VerifyCT(bck, lom.ObjName, md.Generation)
+			case tsi == nil:
+				st = &CTStatus{Status: CTmissing, Err: "not present in " + smap.StringEx()}
+			default:
+				st = requestVerify(tsi, bck, lom.ObjName, md.Generation, client)
+			}
+			if st.Status == CTok && st.SliceID != sliceID {
+				st.Status, st.Err = CTcorrupt, fmt.Sprintf("unexpected slice ID %d", st.SliceID)
+			}
+			st.Node, st.SliceID = tid, sliceID
+			mu.Lock()
+			res.CTs = append(res.CTs, st)
+			mu.Unlock()
+			wg.Done()
+		}(tid, int(sliceID))
+	}
+	wg.Wait()
+	sort.Slice(res.CTs, func(i, j int) bool { return res.CTs[i].SliceID < res.CTs[j].SliceID })
+
+	if md.IsCopy {
+		res.Parity = ParityNA
+	} else {
+		res.Parity, err = verifyParity(bck, lom.ObjName, md, res.CTs, smap, client)
+		if err != nil {
+			res.ParityErr = err.Error()
+		}
+	}
+	return res, nil
+}
+
+// VerifyCT checksums (and validates) local CT - replica or slice - against its EC metadata.
+func VerifyCT(bck *meta.Bck, objName string, generation int64) *CTStatus {
+	st := &CTStatus{Node: core.T.SID(), Status: CTok}
+	md, err := ObjectMetadata(bck, objName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			st.Status, st.Err = CTmissing, ErrorNoMetafile.Error()
+		} else {
+			st.Status, st.Err = CTcorrupt, err.Error()
+		}
+		return st
+	}
+	st.SliceID = md.SliceID
+	if generation != 0 && md.Generation != generation {
+		st.Status, st.Err = CTstale, fmt.Sprintf("generation %d (expected %d)", md.Generation, generation)
+		return st
+	}
+	if md.SliceID == 0 {
+		err = verifyReplica(bck, objName, md)
+	} else {
+		err = verifySlice(bck, objName, md)
+	}
+	if err != nil {
+		st.Status, st.Err = CTcorrupt, err.Error()
+		if cos.IsNotExist(err, 0) {
+			st.Status = CTmissing
+		}
+	}
+	return st
+}
+
+func verifyReplica(bck *meta.Bck, objName string, md *Metadata) error {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return err
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	if lom.Lsize() != md.Size {
+		return fmt.Errorf("size %d (expected %d)", lom.Lsize(), md.Size)
+	}
+	if md.CksumType == cos.ChecksumNone || md.CksumType == "" || md.ObjCksum == "" {
+		return nil
+	}
+	cksum, err := lom.ComputeCksum(md.CksumType)
+	if err != nil {
+		return err
+	}
+	if cksum.Val() != md.ObjCksum {
+		return cos.NewErrDataCksum(&cksum.Cksum, cos.NewCksum(md.CksumType, md.ObjCksum), lom.Cname())
+	}
+	return nil
+}
+
+func verifySlice(bck *meta.Bck, objName string, md *Metadata) error {
+	ct, err := core.NewCTFromBO(bck.Bucket(), objName, core.T.Bowner(), fs.ECSliceType)
+	if err != nil {
+		return err
+	}
+	ct.Lock(false)
+	defer ct.Unlock(false)
+	fh, err := os.Open(ct.FQN())
+	if err != nil {
+		return err
+	}
+	defer cos.Close(fh)
+
+	size, cksum, err := cos.CopyAndChecksum(io.Discard, fh, nil, md.CksumType)
+	if err != nil {
+		return err
+	}
+	if expected := SliceSize(md.Size, md.Data); size != expected {
+		return fmt.Errorf("slice %d: size %d (expected %d)", md.SliceID, size, expected)
+	}
+	if cksum != nil && md.CksumValue != "" && !cksum.Equal(cos.NewCksum(md.CksumType, md.CksumValue)) {
+		return cos.NewErrDataCksum(&cksum.Cksum, cos.NewCksum(md.CksumType, md.CksumValue), bck.Cname(objName))
+	}
+	return nil
+}
+
+// stream in all data and parity slices to check parity math
+func verifyParity(bck *meta.Bck, objName string, md *Metadata, cts []*CTStatus, smap *meta.Smap,
+	client *http.Client) (string, error) {
+	var (
+		n       = md.Data + md.Parity
+		readers = make([]io.Reader, n)
+		tsis    = make([]*meta.Snode, n)
+	)
+	for _, st := range cts {
+		if st.SliceID == 0 || st.SliceID > n {
+			continue
+		}
+		if st.Status != CTok {
+			return ParitySkipped, nil
+		}
+		tsis[st.SliceID-1] = smap.GetTarget(st.Node)
+	}
+	for i, tsi := range tsis {
+		if tsi == nil {
+			return ParitySkipped, fmt.Errorf("slice %d not found", i+1)
+		}
+	}
+
+	for i, tsi := range tsis {
+		body, err := requestCT(tsi, bck, objName, client)
+		if err != nil {
+			for _, r := range readers[:i] {
+				cos.Close(r.(io.ReadCloser))
+			}
+			return ParitySkipped, err
+		}
+		readers[i] = body
+	}
+	defer func() {
+		for _, r := range readers {
+			cos.Close(r.(io.ReadCloser))
+		}
+	}()
+
+	stream, err := reedsolomon.NewStreamC(md.Data, md.Parity, true, true)
+	if err != nil {
+		return ParitySkipped, err
+	}
+	ok, err := stream.Verify(readers)
+	switch {
+	case err != nil:
+		if errors.Is(err, reedsolomon.ErrShardSize) || errors.Is(err, reedsolomon.ErrShardNoData) {
+			return ParityMismatch, err
+		}
+		return ParitySkipped, err
+	case !ok:
+		return ParityMismatch, nil
+	default:
+		return ParityOK, nil
+	}
+}
+
+// the newest metadata from all targets (other than this one)
+func latestMeta(lom *core.LOM, smap *meta.Smap, client *http.Client) (latest *Metadata) {
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for _, tsi := range smap.Tmap {
+		if tsi.ID() == core.T.SID() || tsi.InMaintOrDecomm() {
+			continue
+		}
+		wg.Add(1)
+		go func(tsi *meta.Snode) {
+			if md, err := RequestECMeta(lom.Bucket(), lom.ObjName, tsi, client); err == nil {
+				mu.Lock()
+				if latest == nil || md.Generation > latest.Generation {
+					latest = md
+				}
+				mu.Unlock()
+			}
+			wg.Done()
+		}(tsi)
+	}
+	wg.Wait()
+	return latest
+}
+
+func requestVerify(tsi *meta.Snode, bck *meta.Bck, objName string, generation int64, client *http.Client) *CTStatus {
+	q := bck.Bucket().AddToQuery(url.Values{})
+	q.Set(apc.QparamECGen, strconv.FormatInt(generation, 10))
+	resp, err := _intraGet(tsi, URLVerify, bck, objName, q, client)
+	if err != nil {
+		return &CTStatus{Status: CTmissing, Err: err.Error()}
+	}
+	defer cos.Close(resp.Body)
+	st := &CTStatus{}
+	if err := jsoniter.NewDecoder(resp.Body).Decode(st); err != nil {
+		return &CTStatus{Status: CTmissing, Err: err.Error()}
+	}
+	return st
+}
+
+func requestCT(tsi *meta.Snode, bck *meta.Bck, objName string, client *http.Client) (io.ReadCloser, error) {
+	q := bck.Bucket().AddToQuery(url.Values{})
+	resp, err := _intraGet(tsi, URLCT, bck, objName, q, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func _intraGet(tsi *meta.Snode, what string, bck *meta.Bck, objName string, q url.Values,
+	client *http.Client) (*http.Response, error) {
+	path := apc.URLPathEC.Join(what, bck.Name, objName)
+	req, err := http.NewRequest(http.MethodGet, tsi.URL(cmn.NetIntraData)+path, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = q.Encode()
+	resp, err := client.Do(req) //nolint:bodyclose // closed by the caller
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		cos.Close(resp.Body)
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, cos.NewErrNotFound(tsi, bck.Cname(objName))
+		}
+		return nil, fmt.Errorf("%s: failed to GET %s %s: status %d", tsi, what, bck.Cname(objName), resp.StatusCode)
+	}
+	return resp, nil
+}
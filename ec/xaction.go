@@ -178,6 +178,9 @@ func (r *xactECBase) dataResponse(act intraReqType, hdr *transport.ObjHdr, fqn s
 
 	o := transport.AllocSend()
 	o.Hdr, o.Callback = rHdr, r.sendCb
+	if reader == nil {
+		o.Prio = transport.PrioHigh // header-only (does not exist): don't wait behind slices
+	}
 
 	r.ObjsAdd(1, objAttrs.Size)
 	r.IncPending()
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
@@ -26,8 +27,11 @@ const (
 	TypeRange   Type = "range"
 	TypeMulti   Type = "multi"
 	TypeBackend Type = "backend"
+	TypeFS      Type = "fs" // (a directory on each target's local or mounted filesystem)
 )
 
+const FSScheme = "file://"
+
 const PrefixJobID = "dnl-"
 
 const DownloadProgressInterval = 10 * time.Second
@@ -73,12 +77,23 @@ type (
 		BytesPerHour int `json:"bytes_per_hour"`
 	}
 
+	// parallel ranged fetching of large single-URL sources (see ranged.go)
+	Ranged struct {
+		PartSize int64 `json:"part_size"` // zero (default) - disabled, otherwise - fetch larger sources in parts of this size
+		Workers  int   `json:"workers"`   // max number of parts fetched concurrently (default: 4)
+		Spread   bool  `json:"spread"`    // spread parts across all active targets
+	}
+
 	Base struct {
 		Description      string  `json:"description"`
 		Bck              cmn.Bck `json:"bucket"`
 		Timeout          string  `json:"timeout"`
 		ProgressInterval string  `json:"progress_interval"`
 		Limits           Limits  `json:"limits"`
+		Ranged           Ranged  `json:"ranged"`
+		// credentials profile (see creds.go); the proxy resolves it and passes the result to targets (Auth)
+		Creds string        `json:"creds,omitempty"`
+		Auth  *CredsProfile `json:"auth,omitempty"` // (internal)
 	}
 
 	SingleObj struct {
@@ -130,11 +145,20 @@ type (
 		Base
 		ObjectsPayload any `json:"objects"`
 	}
+
+	// import from a directory that is visible on every target (e.g., mounted NFS);
+	// each target reads only the files that map to it (HRW)
+	FSBody struct {
+		Base
+		Path      string `json:"path"`      // absolute directory path, with or without "file://"
+		Prefix    string `json:"prefix"`    // destination object name prefix
+		Recursive bool   `json:"recursive"` // include subdirectories
+	}
 )
 
 func IsType(a string) bool {
 	b := Type(a)
-	return b == TypeMulti || b == TypeBackend || b == TypeSingle || b == TypeRange
+	return b == TypeMulti || b == TypeBackend || b == TypeSingle || b == TypeRange || b == TypeFS
 }
 
 /////////
@@ -301,6 +325,24 @@ func (b *Base) Validate() error {
 	if b.Limits.BytesPerHour < 0 {
 		return fmt.Errorf("'limit.bytes_per_hour' must be non-negative (got: %d)", b.Limits.BytesPerHour)
 	}
+	if b.Creds != "" && b.Auth == nil {
+		return fmt.Errorf("credentials profile %q not resolved", b.Creds)
+	}
+	return b.Ranged.Validate()
+}
+
+////////////
+// Ranged //
+////////////
+
+func (r *Ranged) Validate() error {
+	if r.PartSize < 0 || (r.PartSize > 0 && r.PartSize < minPartSize) {
+		return fmt.Errorf("'ranged.part_size' must be either zero (disabled) or at least %s (got: %d)",
+			cos.ToSizeIEC(minPartSize, 0), r.PartSize)
+	}
+	if r.Workers < 0 {
+		return fmt.Errorf("'ranged.workers' must be non-negative (got: %d)", r.Workers)
+	}
 	return nil
 }
 
@@ -475,3 +517,36 @@ func (b *BackendBody) Describe() string {
 	}
 	return fmt.Sprintf("remote bucket prefetch -> %s", b.Bck)
 }
+
+////////////
+// FSBody //
+////////////
+
+func (b *FSBody) Validate() error {
+	if err := b.Base.Validate(); err != nil {
+		return err
+	}
+	if b.Path == "" {
+		return errors.New("missing 'path' in the request body")
+	}
+	if dir := b.Dir(); !filepath.IsAbs(dir) {
+		return fmt.Errorf("'path' must be absolute (got: %q)", b.Path)
+	}
+	return nil
+}
+
+// source directory (without "file://")
+func (b *FSBody) Dir() string {
+	return filepath.Clean(strings.TrimPrefix(b.Path, FSScheme))
+}
+
+func (b *FSBody) Describe() string {
+	if b.Description != "" {
+		return b.Description
+	}
+	return fmt.Sprintf("%s%s -> %s", FSScheme, b.Dir(), b.Bck.Cname(b.Prefix))
+}
+
+func (b *FSBody) String() string {
+	return fmt.Sprintf("path: %q, bucket: %q, prefix: %q, recursive: %t", b.Path, b.Bck, b.Prefix, b.Recursive)
+}
@@ -0,0 +1,248 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Credential profiles: named sets of source credentials that download jobs reference by name
+// (`Base.Creds`), e.g.:
+// `ais download https://data.example.com/train/shard-{0..99}.tar ais://dst --creds example`
+// - profiles are owned by the primary proxy that replicates them to all other proxies;
+// - profiles are persisted and replicated encrypted (see CredsSealed);
+// - when starting a new job the proxy resolves the profile and passes it on to all targets
+//   (`Base.Auth`) - the profile itself is never persisted by targets;
+// - credentials are only used with the profile's hosts: a link (URL) that points to any other
+//   host is fetched anonymously, and the same holds for redirects.
+
+const (
+	credsRedacted = "****"
+	maxRedirects  = 10 // same as http.Client default
+
+	awsHdrSecurityToken = "X-Amz-Security-Token" // (SigV4 with temporary credentials)
+)
+
+type (
+	CredsProfile struct {
+		Name       string     `json:"name"`
+		Hosts      []string   `json:"hosts"`                 // exact host names, or wildcards "*.example.com"
+		Headers    cos.StrKVs `json:"headers,omitempty"`     // custom request headers (name => value)
+		Bearer     string     `json:"bearer,omitempty"`      // "Authorization: Bearer <token>"
+		AWSProfile string     `json:"aws_profile,omitempty"` // sign requests (SigV4) using targets' named AWS profile
+	}
+	CredsProfiles []*CredsProfile
+
+	// plain-text metadata (in memory only)
+	CredsMD struct {
+		Profiles CredsProfiles `json:"profiles"`
+		Version  int64         `json:"version,string"`
+	}
+
+	// persisted and replicated
+	CredsSealed struct {
+		Nonce   []byte `json:"nonce"`
+		Data    []byte `json:"data"`           // AES-GCM encrypted CredsMD
+		Version int64  `json:"version,string"` // authenticated as GCM additional data
+	}
+)
+
+//////////////////
+// CredsProfile //
+//////////////////
+
+func (cp *CredsProfile) Validate() error {
+	if cp.Name == "" {
+		return errors.New("missing credentials profile name")
+	}
+	if err := cos.CheckAlphaPlus(cp.Name, "credentials profile name"); err != nil {
+		return err
+	}
+	if len(cp.Hosts) == 0 {
+		return fmt.Errorf("credentials profile %q: missing source host(s)", cp.Name)
+	}
+	for _, host := range cp.Hosts {
+		h := strings.TrimPrefix(host, "*.")
+		if h == "" || strings.ContainsAny(h, "*/:") {
+			return fmt.Errorf("credentials profile %q: invalid host %q (expecting host name or \"*.domain\")", cp.Name, host)
+		}
+	}
+	if len(cp.Headers) == 0 && cp.Bearer == "" && cp.AWSProfile == "" {
+		return fmt.Errorf("credentials profile %q: no credentials (expecting headers, bearer token, and/or AWS profile)", cp.Name)
+	}
+	var auth int
+	for k := range cp.Headers {
+		if http.CanonicalHeaderKey(k) == apc.HdrAuthorization {
+			auth++
+		}
+	}
+	if cp.Bearer != "" {
+		auth++
+	}
+	if cp.AWSProfile != "" {
+		auth++
+	}
+	if auth > 1 {
+		return fmt.Errorf("credentials profile %q: %q header, bearer token, and AWS profile are mutually exclusive",
+			cp.Name, apc.HdrAuthorization)
+	}
+	return nil
+}
+
+// whether to use the credentials with a given URL host
+func (cp *CredsProfile) Match(host string) bool {
+	host = strings.ToLower(host)
+	for _, h := range cp.Hosts {
+		h = strings.ToLower(h)
+		if suffix, ok := strings.CutPrefix(h, "*"); ok {
+			if strings.HasSuffix(host, suffix) {
+				return true
+			}
+		} else if host == h {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply adds the credentials to a given (outgoing) request - iff the request's host matches;
+// must be called last, after all other request headers are set
+func (cp *CredsProfile) Apply(req *http.Request) error {
+	if cp == nil || !cp.Match(req.URL.Hostname()) {
+		return nil
+	}
+	for k, v := range cp.Headers {
+		req.Header.Set(k, v)
+	}
+	if cp.Bearer != "" {
+		req.Header.Set(apc.HdrAuthorization, "Bearer "+cp.Bearer)
+	}
+	if cp.AWSProfile != "" {
+		return signAWS(req, cp.AWSProfile)
+	}
+	return nil
+}
+
+// CheckRedirect (see http.Client) makes sure that the credentials do not follow redirects
+// to other hosts: the client copies all headers of the original request into the redirected one,
+// and those that were added by Apply must be removed when the new host does not match
+func (cp *CredsProfile) CheckRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	if cp.Match(req.URL.Hostname()) {
+		return nil
+	}
+	for k := range cp.Headers {
+		req.Header.Del(k)
+	}
+	if cp.Bearer != "" || cp.AWSProfile != "" {
+		req.Header.Del(apc.HdrAuthorization)
+		req.Header.Del(awsHdrSecurityToken)
+	}
+	return nil
+}
+
+// Redacted returns a copy that can be shown to users
+func (cp *CredsProfile) Redacted() *CredsProfile {
+	clone := *cp
+	if len(cp.Headers) > 0 {
+		clone.Headers = make(cos.StrKVs, len(cp.Headers))
+		for k := range cp.Headers {
+			clone.Headers[k] = credsRedacted
+		}
+	}
+	if cp.Bearer != "" {
+		clone.Bearer = credsRedacted
+	}
+	return &clone
+}
+
+///////////////////
+// CredsProfiles //
+///////////////////
+
+func (cps CredsProfiles) Find(name string) (int, *CredsProfile) {
+	for i, cp := range cps {
+		if cp.Name == name {
+			return i, cp
+		}
+	}
+	return -1, nil
+}
+
+func (cps CredsProfiles) Sort() {
+	sort.Slice(cps, func(i, j int) bool { return cps[i].Name < cps[j].Name })
+}
+
+/////////////////
+// CredsSealed //
+/////////////////
+
+// Seal encrypts a given metadata with a key derived from the secret;
+// the version (that remains plain text) is authenticated, so that it can't be changed
+// without breaking the seal
+func (md *CredsMD) Seal(secret string) (*CredsSealed, error) {
+	gcm, err := credsGCM(secret)
+	if err != nil {
+		return nil, err
+	}
+	sealed := &CredsSealed{Nonce: make([]byte, gcm.NonceSize()), Version: md.Version}
+	if _, err := rand.Read(sealed.Nonce); err != nil {
+		return nil, err
+	}
+	sealed.Data = gcm.Seal(nil, sealed.Nonce, cos.MustMarshal(md), credsAAD(md.Version))
+	return sealed, nil
+}
+
+func (sealed *CredsSealed) Open(secret string) (*CredsMD, error) {
+	md := &CredsMD{}
+	if len(sealed.Data) == 0 {
+		md.Version = sealed.Version
+		return md, nil
+	}
+	gcm, err := credsGCM(secret)
+	if err != nil {
+		return nil, err
+	}
+	b, err := gcm.Open(nil, sealed.Nonce, sealed.Data, credsAAD(sealed.Version))
+	if err != nil {
+		return nil, errors.New("failed to decrypt credentials profiles (wrong key or tampered version?)")
+	}
+	if err := jsoniter.Unmarshal(b, md); err != nil {
+		return nil, err
+	}
+	if md.Version != sealed.Version {
+		return nil, fmt.Errorf("credentials profiles: version mismatch (sealed %d vs %d)", md.Version, sealed.Version)
+	}
+	return md, nil
+}
+
+func credsAAD(version int64) []byte { return []byte(strconv.FormatInt(version, 10)) }
+
+func credsGCM(secret string) (cipher.AEAD, error) {
+	if secret == "" {
+		return nil, errors.New("credentials profiles: empty encryption key")
+	}
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
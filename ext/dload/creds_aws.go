@@ -0,0 +1,50 @@
+//go:build aws
+
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+)
+
+const (
+	awsUnsignedPayload = "UNSIGNED-PAYLOAD"
+	awsDefaultRegion   = "us-east-1"
+)
+
+var (
+	awsConfigs sync.Map // AWS profile name => aws.Config (with cached credentials)
+	awsSigner  = v4.NewSigner()
+)
+
+// sign S3 request (SigV4) using a given AWS profile (from the target's ~/.aws/config and ~/.aws/credentials)
+func signAWS(req *http.Request, profile string) error {
+	ctx := req.Context()
+	v, ok := awsConfigs.Load(profile)
+	if !ok {
+		cfg, err := config.LoadDefaultConfig(ctx, config.WithSharedConfigProfile(profile))
+		if err != nil {
+			return err
+		}
+		if cfg.Region == "" {
+			cfg.Region = awsDefaultRegion
+		}
+		v, _ = awsConfigs.LoadOrStore(profile, cfg)
+	}
+	cfg := v.(aws.Config)
+	creds, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Amz-Content-Sha256", awsUnsignedPayload)
+	return awsSigner.SignHTTP(ctx, creds, req, awsUnsignedPayload, "s3", cfg.Region, time.Now())
+}
@@ -0,0 +1,16 @@
+//go:build !aws
+
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"net/http"
+)
+
+func signAWS(*http.Request, string) error {
+	return errors.New("credentials profile with AWS profile: aistore was built without AWS support (build tag \"aws\")")
+}
@@ -0,0 +1,156 @@
+// Package dloader_test is a unit test
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ext/dload"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCredsProfileValidate(t *testing.T) {
+	tests := []struct {
+		cp    dload.CredsProfile
+		valid bool
+	}{
+		{dload.CredsProfile{Name: "a", Hosts: []string{"data.example.com"}, Bearer: "t"}, true},
+		{dload.CredsProfile{Name: "a", Hosts: []string{"*.example.com"}, Headers: cos.StrKVs{"X-Api-Key": "k"}}, true},
+		{dload.CredsProfile{Name: "a", Hosts: []string{"s3.amazonaws.com"}, AWSProfile: "prod"}, true},
+		{dload.CredsProfile{Hosts: []string{"data.example.com"}, Bearer: "t"}, false},
+		{dload.CredsProfile{Name: "a/b", Hosts: []string{"data.example.com"}, Bearer: "t"}, false},
+		{dload.CredsProfile{Name: "a", Bearer: "t"}, false},
+		{dload.CredsProfile{Name: "a", Hosts: []string{"https://data.example.com"}, Bearer: "t"}, false},
+		{dload.CredsProfile{Name: "a", Hosts: []string{"data.*.com"}, Bearer: "t"}, false},
+		{dload.CredsProfile{Name: "a", Hosts: []string{"data.example.com"}}, false},
+		{dload.CredsProfile{Name: "a", Hosts: []string{"data.example.com"}, Bearer: "t", Headers: cos.StrKVs{"authorization": "x"}}, false},
+		{dload.CredsProfile{Name: "a", Hosts: []string{"data.example.com"}, Bearer: "t", AWSProfile: "prod"}, false},
+	}
+	for i, test := range tests {
+		err := test.cp.Validate()
+		tassert.Errorf(t, (err == nil) == test.valid, "%d: expected valid=%t, got err: %v", i, test.valid, err)
+	}
+}
+
+func TestCredsProfileApply(t *testing.T) {
+	cp := &dload.CredsProfile{
+		Name:    "example",
+		Hosts:   []string{"data.example.com", "*.example.org"},
+		Headers: cos.StrKVs{"X-Api-Key": "12345"},
+		Bearer:  "token",
+	}
+	tests := []struct {
+		link  string
+		match bool
+	}{
+		{"https://data.example.com/train/shard-01.tar", true},
+		{"http://DATA.example.com:8080/a", true},
+		{"https://cdn.example.org/a", true},
+		{"https://a.b.example.org/a", true},
+		{"https://example.org/a", false},
+		{"https://example.com/a", false},
+		{"https://data.example.com.evil.io/a", false},
+	}
+	for _, test := range tests {
+		req, err := http.NewRequest(http.MethodGet, test.link, http.NoBody)
+		tassert.CheckFatal(t, err)
+		tassert.CheckFatal(t, cp.Apply(req))
+		auth, key := req.Header.Get("Authorization"), req.Header.Get("X-Api-Key")
+		if test.match {
+			tassert.Errorf(t, auth == "Bearer token" && key == "12345", "%s: expected credentials, got %v", test.link, req.Header)
+		} else {
+			tassert.Errorf(t, auth == "" && key == "", "%s: expected no credentials, got %v", test.link, req.Header)
+		}
+	}
+
+	r := cp.Redacted()
+	tassert.Errorf(t, r.Bearer != cp.Bearer && r.Headers["X-Api-Key"] != "12345", "expected redacted secrets, got %+v", r)
+	tassert.Errorf(t, cp.Bearer == "token" && cp.Headers["X-Api-Key"] == "12345", "original must not be modified: %+v", cp)
+}
+
+func TestCredsProfileRedirect(t *testing.T) {
+	// (second host) records the credentials it receives
+	var auth, key string
+	other := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		auth, key = r.Header.Get("Authorization"), r.Header.Get("X-Api-Key")
+	}))
+	defer other.Close()
+	u, err := url.Parse(other.URL)
+	tassert.CheckFatal(t, err)
+	otherURL := "http://localhost:" + u.Port() // different host name, same server
+
+	src := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/same" {
+			http.Redirect(w, r, other.URL, http.StatusFound)
+		} else {
+			http.Redirect(w, r, otherURL, http.StatusFound)
+		}
+	}))
+	defer src.Close()
+
+	cp := &dload.CredsProfile{
+		Name:    "example",
+		Hosts:   []string{"127.0.0.1"},
+		Headers: cos.StrKVs{"X-Api-Key": "12345"},
+		Bearer:  "token",
+	}
+	client := &http.Client{CheckRedirect: cp.CheckRedirect}
+	tests := []struct {
+		path  string
+		match bool
+	}{
+		{"/same", true},   // redirected to a profile's host
+		{"/other", false}, // redirected to any other host
+	}
+	for _, test := range tests {
+		auth, key = "", ""
+		req, err := http.NewRequest(http.MethodGet, src.URL+test.path, http.NoBody)
+		tassert.CheckFatal(t, err)
+		tassert.CheckFatal(t, cp.Apply(req))
+		resp, err := client.Do(req)
+		tassert.CheckFatal(t, err)
+		resp.Body.Close()
+		if test.match {
+			tassert.Errorf(t, auth == "Bearer token" && key == "12345", "%s: expected credentials, got %q, %q", test.path, auth, key)
+		} else {
+			tassert.Errorf(t, auth == "" && key == "", "%s: expected no credentials, got %q, %q", test.path, auth, key)
+		}
+	}
+}
+
+func TestCredsSeal(t *testing.T) {
+	md := &dload.CredsMD{
+		Profiles: dload.CredsProfiles{{Name: "example", Hosts: []string{"data.example.com"}, Bearer: "token"}},
+		Version:  3,
+	}
+	sealed, err := md.Seal("secret")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, sealed.Version == md.Version, "expected version %d, got %d", md.Version, sealed.Version)
+	tassert.Errorf(t, !bytes.Contains(sealed.Data, []byte("token")), "expected encrypted data")
+
+	opened, err := sealed.Open("secret")
+	tassert.CheckFatal(t, err)
+	_, cp := opened.Profiles.Find("example")
+	tassert.Fatalf(t, cp != nil && cp.Bearer == "token", "expected profile 'example', got %+v", opened.Profiles)
+
+	_, err = sealed.Open("wrong")
+	tassert.Errorf(t, err != nil, "expected error opening with the wrong key")
+
+	// version is authenticated
+	tampered := *sealed
+	tampered.Version++
+	_, err = tampered.Open("secret")
+	tassert.Errorf(t, err != nil, "expected error opening with tampered version")
+
+	// empty (never sealed)
+	empty, err := (&dload.CredsSealed{}).Open("")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(empty.Profiles) == 0, "expected no profiles")
+}
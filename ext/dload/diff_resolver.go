@@ -303,5 +303,5 @@ func (*defaultDiffResolverCtx) IsObjFromRemote(src *core.LOM) (bool, error) {
 	if !ok {
 		return false, nil
 	}
-	return objSrc != cmn.WebObjMD, nil
+	return objSrc != cmn.WebObjMD && objSrc != cmn.FileObjMD, nil
 }
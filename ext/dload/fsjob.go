@@ -0,0 +1,123 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// fsDlJob: import from a directory that all targets can see (e.g., mounted NFS);
+// unlike (single-shot) promote, the files are downloaded as regular download tasks:
+// scheduled in batches, throttled (Limits), monitored (progress), and retried.
+// Each target walks the entire directory but takes only the files that map to it (HRW).
+
+type fsDlJob struct {
+	baseDlJob
+	dir       string
+	prefix    string
+	recursive bool
+	ch        chan dlObj // walk => genNext
+	stopCh    cos.StopCh // cleanup => walk
+	walkErr   error      // (is set prior to closing ch)
+	objs      []dlObj    // next batch
+	started   bool       // walk started
+}
+
+var errFSJobStopped = errors.New("fs-download job stopped")
+
+func newFSDlJob(id string, bck *meta.Bck, payload *FSBody, xdl *Xact) (*fsDlJob, error) {
+	dir := payload.Dir()
+	finfo, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("%s: source directory is not accessible: %v", core.T, err)
+	}
+	if !finfo.IsDir() {
+		return nil, fmt.Errorf("%s: source %q is not a directory", core.T, dir)
+	}
+	fj := &fsDlJob{dir: dir, prefix: payload.Prefix, recursive: payload.Recursive}
+	fj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl)
+	fj.ch = make(chan dlObj, downloadBatchSize)
+	fj.stopCh.Init()
+	return fj, nil
+}
+
+func (*fsDlJob) Len() int { return -1 }
+
+func (j *fsDlJob) String() string {
+	return fmt.Sprintf("fs-%s-%s-%s", &j.baseDlJob, j.dir, j.prefix)
+}
+
+func (j *fsDlJob) genNext() ([]dlObj, bool, error) {
+	if !j.started {
+		j.started = true
+		go j.walk()
+	}
+	j.objs = j.objs[:0]
+	for len(j.objs) < downloadBatchSize {
+		obj, ok := <-j.ch
+		if !ok {
+			break
+		}
+		j.objs = append(j.objs, obj)
+	}
+	if len(j.objs) == 0 {
+		return nil, false, j.walkErr
+	}
+	return j.objs, true, nil
+}
+
+func (j *fsDlJob) cleanup() {
+	j.stopCh.Close()
+	j.baseDlJob.cleanup()
+}
+
+func (j *fsDlJob) walk() {
+	var err error
+	if j.recursive {
+		err = fs.Walk(&fs.WalkOpts{Dir: j.dir, Callback: j.cb})
+	} else {
+		err = fs.WalkDir(j.dir, j.cb)
+	}
+	if err != nil && err != errFSJobStopped {
+		j.walkErr = err
+	}
+	close(j.ch)
+}
+
+func (j *fsDlJob) cb(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	rel, err := filepath.Rel(j.dir, fqn)
+	if err != nil {
+		return err
+	}
+	var (
+		objName = j.prefix + filepath.ToSlash(rel)
+		smap    = core.T.Sowner().Get()
+	)
+	si, err := smap.HrwName2T(j.bck.MakeUname(objName))
+	if err != nil {
+		return err
+	}
+	if si.ID() != core.T.SID() {
+		return nil
+	}
+	obj := dlObj{objName: objName, link: FSScheme + fqn}
+	select {
+	case j.ch <- obj:
+		return nil
+	case <-j.stopCh.Listen():
+		return errFSJobStopped
+	}
+}
@@ -65,6 +65,7 @@ func (is *infoStore) setJob(job jobif) (njob *dljob) {
 		total:       job.Len(),
 		description: job.Description(),
 		startedTime: time.Now(),
+		creds:       job.creds(),
 	}
 	is.Lock()
 	is.dljobs[job.ID()] = njob
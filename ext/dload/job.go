@@ -32,6 +32,7 @@ var (
 	_ jobif = (*sliceDlJob)(nil)
 	_ jobif = (*backendDlJob)(nil)
 	_ jobif = (*rangeDlJob)(nil)
+	_ jobif = (*fsDlJob)(nil)
 )
 
 type (
@@ -68,6 +69,12 @@ type (
 		// via tryAcquire and release
 		throttler() *throttler
 
+		// parallel ranged fetching (see ranged.go)
+		ranged() *Ranged
+
+		// source credentials (see creds.go)
+		creds() *CredsProfile
+
 		// job cleanup
 		cleanup()
 	}
@@ -80,6 +87,8 @@ type (
 		description string
 		timeout     time.Duration
 		throt       throttler
+		rngd        Ranged
+		auth        *CredsProfile // resolved credentials profile, if any
 	}
 
 	sliceDlJob struct {
@@ -126,6 +135,7 @@ type (
 		total         int
 		aborted       atomic.Bool
 		allDispatched atomic.Bool
+		creds         *CredsProfile // (not persisted)
 	}
 )
 
@@ -133,19 +143,22 @@ type (
 // baseDlJob //
 ///////////////
 
-func (j *baseDlJob) init(id string, bck *meta.Bck, timeout, desc string, limits Limits, xdl *Xact) {
+func (j *baseDlJob) init(id string, bck *meta.Bck, base *Base, desc string, xdl *Xact) {
+	limits := base.Limits
 	// TODO: this might be inaccurate if we download 1 or 2 objects because then
 	//  other targets will have limits but will not use them.
 	if limits.BytesPerHour > 0 {
 		limits.BytesPerHour /= core.T.Sowner().Get().CountActiveTs()
 	}
-	td, _ := time.ParseDuration(timeout)
+	td, _ := time.ParseDuration(base.Timeout)
 	{
 		j.id = id
 		j.bck = bck
 		j.timeout = td
 		j.description = desc
 		j.throt.init(limits)
+		j.rngd = base.Ranged
+		j.auth = base.Auth
 		j.xdl = xdl
 	}
 }
@@ -189,6 +202,8 @@ func (j *baseDlJob) ActiveStats() (*StatusResp, error) {
 
 func (*baseDlJob) checkObj(string) bool    { debug.Assert(false); return false }
 func (j *baseDlJob) throttler() *throttler { return &j.throt }
+func (j *baseDlJob) ranged() *Ranged       { return &j.rngd }
+func (j *baseDlJob) creds() *CredsProfile  { return j.auth }
 
 func (j *baseDlJob) cleanup() {
 	j.throttler().stop()
@@ -235,7 +250,7 @@ func newMultiDlJob(id string, bck *meta.Bck, payload *MultiBody, xdl *Xact) (mj
 	var objs cos.StrKVs
 
 	mj = &multiDlJob{}
-	mj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	mj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl)
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
@@ -250,7 +265,7 @@ func newSingleDlJob(id string, bck *meta.Bck, payload *SingleBody, xdl *Xact) (s
 	var objs cos.StrKVs
 
 	sj = &singleDlJob{}
-	sj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	sj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl)
 
 	if objs, err = payload.ExtractPayload(); err != nil {
 		return nil, err
@@ -273,7 +288,7 @@ func newRangeDlJob(id string, bck *meta.Bck, payload *RangeBody, xdl *Xact) (rj
 	if rj.pt, err = cos.ParseBashTemplate(payload.Template); err != nil {
 		return nil, err
 	}
-	rj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	rj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl)
 
 	if rj.count, err = countObjects(rj.pt, payload.Subdir, rj.bck); err != nil {
 		return nil, err
@@ -336,7 +351,7 @@ func newBackendDlJob(id string, bck *meta.Bck, payload *BackendBody, xdl *Xact)
 		return nil, errors.New("bucket download does not support HTTP buckets")
 	}
 	bj = &backendDlJob{}
-	bj.baseDlJob.init(id, bck, payload.Timeout, payload.Describe(), payload.Limits, xdl)
+	bj.baseDlJob.init(id, bck, &payload.Base, payload.Describe(), xdl)
 	{
 		bj.sync = payload.Sync
 		bj.prefix = payload.Prefix
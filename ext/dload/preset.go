@@ -0,0 +1,147 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Download presets: named download job templates (source, destination, limits, sync options, etc.)
+// that users instantiate by name, e.g.: `ais start download --preset nightly-imagenet`
+// - presets are owned by the primary proxy that replicates them to all other proxies;
+// - to instantiate, the proxy merges the (optional) request body on top of the preset's body -
+//   the fields specified in the request override those of the preset (see Preset.Instantiate);
+// - changing or removing a preset does not affect download jobs that are already running.
+
+type (
+	Preset struct {
+		Name    string    `json:"name"`
+		Body    Body      `json:"body"` // download body (the same as for regular downloads)
+		Created time.Time `json:"created"`
+	}
+	Presets []*Preset
+
+	// persisted and replicated
+	PresetsMD struct {
+		Presets Presets `json:"presets"`
+		Version int64   `json:"version,string"`
+	}
+)
+
+////////////
+// Preset //
+////////////
+
+func (ps *Preset) Validate() error {
+	if ps.Name == "" {
+		return errors.New("missing download preset name")
+	}
+	if err := cos.CheckAlphaPlus(ps.Name, "download preset name"); err != nil {
+		return err
+	}
+	if ps.Body.Type == "" || len(ps.Body.RawMessage) == 0 {
+		return fmt.Errorf("download preset %q: missing download body (type)", ps.Name)
+	}
+	if !IsType(string(ps.Body.Type)) {
+		return fmt.Errorf("download preset %q: invalid download type %q", ps.Name, ps.Body.Type)
+	}
+	var base Base
+	if err := jsoniter.Unmarshal(ps.Body.RawMessage, &base); err != nil {
+		return fmt.Errorf("download preset %q: %v", ps.Name, err)
+	}
+	if base.Auth != nil {
+		return fmt.Errorf("download preset %q: 'auth' is reserved (use 'creds' to reference credentials profile)", ps.Name)
+	}
+	return nil
+}
+
+// Instantiate returns the preset's download body with `overrides` (if any) applied;
+// `overrides` is a JSON object with the same (type-specific) fields as the download body itself,
+// e.g.: {"description": "run #2", "limits": {"connections": 8}} - nested objects get merged
+func (ps *Preset) Instantiate(overrides []byte) (Body, error) {
+	body := Body{Type: ps.Body.Type}
+	m := make(map[string]jsoniter.RawMessage, 8)
+	if err := jsoniter.Unmarshal(ps.Body.RawMessage, &m); err != nil {
+		return body, err
+	}
+	if len(overrides) > 0 {
+		o := make(map[string]jsoniter.RawMessage, 4)
+		if err := jsoniter.Unmarshal(overrides, &o); err != nil {
+			return body, fmt.Errorf("download preset %q: invalid overrides: %v", ps.Name, err)
+		}
+		if t, ok := o["type"]; ok {
+			if typ := jsoniter.Get(t).ToString(); typ != string(ps.Body.Type) {
+				return body, fmt.Errorf("download preset %q: cannot override download type %q with %q", ps.Name, ps.Body.Type, typ)
+			}
+			delete(o, "type")
+		}
+		if err := mergeJSON(m, o); err != nil {
+			return body, fmt.Errorf("download preset %q: %v", ps.Name, err)
+		}
+	}
+	delete(m, "auth") // (resolved by the proxy)
+	body.RawMessage = cos.MustMarshal(m)
+	return body, nil
+}
+
+// merge src into dst, recursively (JSON objects only)
+func mergeJSON(dst, src map[string]jsoniter.RawMessage) error {
+	for k, v := range src {
+		prev, ok := dst[k]
+		if !ok || !_isObj(prev) || !_isObj(v) {
+			dst[k] = v
+			continue
+		}
+		var a, b map[string]jsoniter.RawMessage
+		if err := jsoniter.Unmarshal(prev, &a); err != nil {
+			return err
+		}
+		if err := jsoniter.Unmarshal(v, &b); err != nil {
+			return err
+		}
+		if err := mergeJSON(a, b); err != nil {
+			return err
+		}
+		dst[k] = cos.MustMarshal(a)
+	}
+	return nil
+}
+
+func _isObj(b jsoniter.RawMessage) bool {
+	for _, c := range b {
+		switch c {
+		case ' ', '\t', '\n', '\r':
+			continue
+		case '{':
+			return true
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+/////////////
+// Presets //
+/////////////
+
+func (pss Presets) Find(name string) (int, *Preset) {
+	for i, ps := range pss {
+		if ps.Name == name {
+			return i, ps
+		}
+	}
+	return -1, nil
+}
+
+func (pss Presets) Sort() {
+	sort.Slice(pss, func(i, j int) bool { return pss[i].Name < pss[j].Name })
+}
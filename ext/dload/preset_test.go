@@ -0,0 +1,79 @@
+// Package dloader_test is a unit test
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ext/dload"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestPresetValidate(t *testing.T) {
+	body := dload.Body{Type: dload.TypeBackend, RawMessage: cos.MustMarshal(dload.BackendBody{})}
+	tests := []struct {
+		ps    dload.Preset
+		valid bool
+	}{
+		{dload.Preset{Name: "nightly-imagenet", Body: body}, true},
+		{dload.Preset{Body: body}, false},
+		{dload.Preset{Name: "bad name", Body: body}, false},
+		{dload.Preset{Name: "nightly"}, false},
+		{dload.Preset{Name: "nightly", Body: dload.Body{Type: "unknown", RawMessage: body.RawMessage}}, false},
+	}
+	for _, test := range tests {
+		err := test.ps.Validate()
+		tassert.Errorf(t, (err == nil) == test.valid, "expected valid=%t, got err: %v (%+v)", test.valid, err, test.ps)
+	}
+}
+
+func TestPresetInstantiate(t *testing.T) {
+	ps := &dload.Preset{
+		Name: "nightly-imagenet",
+		Body: dload.Body{
+			Type: dload.TypeBackend,
+			RawMessage: cos.MustMarshal(dload.BackendBody{
+				Base: dload.Base{
+					Description: "imagenet",
+					Bck:         cmn.Bck{Name: "imagenet", Provider: "gcp"},
+					Limits:      dload.Limits{Connections: 4, BytesPerHour: 1024},
+				},
+				Prefix: "train/",
+				Sync:   true,
+			}),
+		},
+	}
+
+	// as is
+	body, err := ps.Instantiate(nil)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, body.Type == dload.TypeBackend, "unexpected type %q", body.Type)
+	var b dload.BackendBody
+	tassert.CheckFatal(t, jsoniter.Unmarshal(body.RawMessage, &b))
+	tassert.Errorf(t, b.Prefix == "train/" && b.Sync && b.Limits.Connections == 4, "unexpected body %+v", b)
+
+	// with overrides (nested objects get merged)
+	body, err = ps.Instantiate([]byte(`{"description": "run #2", "limits": {"connections": 8}}`))
+	tassert.CheckFatal(t, err)
+	b = dload.BackendBody{}
+	tassert.CheckFatal(t, jsoniter.Unmarshal(body.RawMessage, &b))
+	tassert.Errorf(t, b.Description == "run #2", "expected overridden description, got %q", b.Description)
+	tassert.Errorf(t, b.Limits.Connections == 8 && b.Limits.BytesPerHour == 1024, "unexpected limits %+v", b.Limits)
+	tassert.Errorf(t, b.Bck.Name == "imagenet" && b.Prefix == "train/" && b.Sync, "unexpected body %+v", b)
+
+	// the preset itself remains unchanged
+	b = dload.BackendBody{}
+	tassert.CheckFatal(t, jsoniter.Unmarshal(ps.Body.RawMessage, &b))
+	tassert.Errorf(t, b.Description == "imagenet" && b.Limits.Connections == 4, "preset modified: %+v", b)
+
+	// cannot change the type
+	_, err = ps.Instantiate([]byte(`{"type": "range"}`))
+	tassert.Errorf(t, err != nil, "expected error overriding download type")
+	_, err = ps.Instantiate([]byte(`{"type": "backend", "prefix": "val/"}`))
+	tassert.CheckError(t, err)
+}
@@ -0,0 +1,333 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Parallel ranged fetching of a single (large) source URL:
+// - enabled per job via `Ranged.PartSize` (zero - disabled);
+// - applies to HTTP(S) links (not remote buckets) that report Content-Length greater than the part size
+//   and support byte ranges (`Accept-Ranges: bytes`); otherwise, the download falls back to a single GET;
+// - the object's (HRW) target splits the source into parts and fetches up to `Ranged.Workers` of them
+//   in parallel, writing each part at its offset into a work file that, once complete, gets checksummed
+//   and finalized as a regular object;
+// - with `Ranged.Spread`, the parts are assigned round-robin to all active targets: each part assigned
+//   to another target gets fetched by the latter and relayed back via intra-cluster data network
+//   (see FetchPart);
+// - each part is retried independently; a part that fails on another target is retried locally;
+// - progress reporting and throttling apply to all parts (regardless of who fetches them).
+
+const (
+	dfltRangedWorkers = 4
+	minPartSize       = cos.MiB
+)
+
+type rangedFetch struct {
+	task     *singleTask
+	fh       *os.File
+	ctx      context.Context
+	tsis     []*meta.Snode // parts' fetchers; nil entry - this target
+	size     int64
+	partSize int64
+	nparts   int64
+	next     atomic.Int64 // next part to fetch
+}
+
+// HEAD the source to find out whether it is eligible for ranged fetching
+func (task *singleTask) probeRanged() (int64, *http.Response) {
+	ctx, cancel := context.WithTimeout(task.downloadCtx, task.initialTimeout())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, task.obj.link, http.NoBody)
+	if err != nil {
+		return 0, nil
+	}
+	if cos.IsGoogleStorageURL(req.URL) {
+		req.Header.Add("User-Agent", gcsUA)
+	}
+	if task.job.creds().Apply(req) != nil {
+		return 0, nil
+	}
+	resp, err := clientForURL(task.obj.link, task.job.creds()).Do(req) //nolint:bodyclose // cos.Close
+	if err != nil {
+		return 0, nil
+	}
+	cos.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK || !strings.Contains(resp.Header.Get(cos.HdrAcceptRanges), "bytes") {
+		if cmn.Rom.FastV(4, cos.SmoduleDload) {
+			nlog.Infof("%s: no ranged reads (status %d, %s: %q)", task, resp.StatusCode, cos.HdrAcceptRanges,
+				resp.Header.Get(cos.HdrAcceptRanges))
+		}
+		return 0, nil
+	}
+	return resp.ContentLength, resp
+}
+
+func (task *singleTask) downloadRanged(lom *core.LOM, size int64, resp *http.Response, rngd *Ranged) error {
+	var (
+		workFQN = fs.CSM.Gen(lom, fs.WorkfileType, "dl-ranged")
+		rf      = &rangedFetch{task: task, size: size, partSize: rngd.PartSize}
+		workers = rngd.Workers
+	)
+	rf.nparts = (size + rf.partSize - 1) / rf.partSize
+	if workers == 0 {
+		workers = dfltRangedWorkers
+	}
+	workers = int(min(int64(workers), rf.nparts))
+	rf.tsis = []*meta.Snode{nil}
+	if rngd.Spread {
+		rf.tsis = append(rf.tsis, _peers()...)
+	}
+
+	attrsFromLink(task.obj.link, resp, lom)
+	task.setTotalSize(size)
+
+	fh, err := lom.CreatePart(workFQN)
+	if err != nil {
+		return err
+	}
+	rf.fh = fh
+
+	// all parts share the task's context (see also: wrapReader and throttling)
+	ctx, cancel := context.WithCancel(task.downloadCtx)
+	rf.ctx, task.getCtx = ctx, ctx
+
+	var (
+		wg    sync.WaitGroup
+		errCh = make(chan error, workers)
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			if err := rf.run(); err != nil {
+				errCh <- err
+				cancel() // stop the others
+			}
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+	cancel()
+	close(errCh)
+	if err = <-errCh; err == nil { // (the first one is the cause, the rest are likely canceled)
+		err = rf.finalize(lom, workFQN)
+	} else {
+		cos.Close(fh)
+	}
+	if err != nil {
+		if errRemove := cos.RemoveFile(workFQN); errRemove != nil && !os.IsNotExist(errRemove) {
+			nlog.Errorln("nested err:", errRemove)
+		}
+	}
+	return err
+}
+
+// active targets other than this one
+func _peers() (tsis []*meta.Snode) {
+	smap := core.T.Sowner().Get()
+	for _, tsi := range smap.Tmap {
+		if tsi.ID() != core.T.SID() && !tsi.InMaintOrDecomm() {
+			tsis = append(tsis, tsi)
+		}
+	}
+	sort.Slice(tsis, func(i, j int) bool { return tsis[i].ID() < tsis[j].ID() })
+	return tsis
+}
+
+/////////////////
+// rangedFetch //
+/////////////////
+
+func (rf *rangedFetch) run() error {
+	buf, slab := core.T.PageMM().Alloc()
+	defer slab.Free(buf)
+	for {
+		i := rf.next.Inc() - 1
+		if i >= rf.nparts {
+			return nil
+		}
+		if err := rf.fetchPart(i, buf); err != nil {
+			return err
+		}
+	}
+}
+
+func (rf *rangedFetch) fetchPart(i int64, buf []byte) (err error) {
+	var (
+		tsi     = rf.tsis[i%int64(len(rf.tsis))]
+		off     = i * rf.partSize
+		length  = min(rf.partSize, rf.size-off)
+		timeout = rf.task.initialTimeout()
+		n       int64
+	)
+	for j := range retryCnt {
+		n, err = rf._fetch(tsi, off, length, timeout, buf)
+		if err == nil {
+			return nil
+		}
+		rf.task.currentSize.Sub(n) // (will be fetched again)
+		if !errors.Is(err, io.ErrUnexpectedEOF) && !rf.task.canRetry(err, j, &timeout) {
+			break
+		}
+		if tsi != nil {
+			nlog.Warningf("%s: part #%d failed on %s, retrying locally", rf.task, i, tsi)
+			tsi = nil
+		}
+	}
+	return fmt.Errorf("part #%d [%d, %d): %w", i, off, off+length, err)
+}
+
+func (rf *rangedFetch) _fetch(tsi *meta.Snode, off, length int64, timeout time.Duration, buf []byte) (int64, error) {
+	var (
+		link   = rf.task.obj.link
+		client = clientForURL(link, rf.task.job.creds())
+		u      = link
+	)
+	ctx, cancel := context.WithTimeout(rf.ctx, timeout)
+	defer cancel()
+	if tsi != nil {
+		q := url.Values{apc.QparamDlLink: []string{link}, apc.QparamUUID: []string{rf.task.jobID()}}
+		u = tsi.URL(cmn.NetIntraData) + apc.URLPathDownloadPart.S + "?" + q.Encode()
+		client = core.T.DataClient()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return 0, err
+	}
+	if tsi != nil {
+		req.Header.Set(apc.HdrCallerID, core.T.SID())
+		req.Header.Set(apc.HdrCallerName, core.T.String())
+	} else if cos.IsGoogleStorageURL(req.URL) {
+		req.Header.Add("User-Agent", gcsUA)
+	}
+	req.Header.Set(cos.HdrRange, cmn.MakeRangeHdr(off, length))
+	if tsi == nil {
+		if err := rf.task.job.creds().Apply(req); err != nil {
+			return 0, err
+		}
+	}
+
+	resp, err := client.Do(req) //nolint:bodyclose // closed below
+	if err != nil {
+		return 0, err
+	}
+	r := rf.task.wrapReader(resp.Body)
+	defer cos.Close(r)
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		// (the source or the target in-between ignores range requests)
+		return 0, fmt.Errorf("failed to download %q: expected ranged read, got status %d", link, resp.StatusCode)
+	case resp.StatusCode != http.StatusPartialContent:
+		return 0, cmn.NewErrHTTP(req, fmt.Errorf("failed to download %q: status %d", link, resp.StatusCode), resp.StatusCode)
+	case resp.ContentLength >= 0 && resp.ContentLength != length:
+		return 0, fmt.Errorf("failed to download %q: part size %d (expected %d)", link, resp.ContentLength, length)
+	}
+
+	n, err := io.CopyBuffer(io.NewOffsetWriter(rf.fh, off), io.LimitReader(r, length), buf)
+	if err == nil && n != length {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+// all parts are in place: checksum the work file and finalize the object
+func (rf *rangedFetch) finalize(lom *core.LOM, workFQN string) error {
+	var cksum *cos.CksumHash
+	if ty := lom.CksumConf().Type; ty != cos.ChecksumNone {
+		if _, err := rf.fh.Seek(0, io.SeekStart); err != nil {
+			cos.Close(rf.fh)
+			return err
+		}
+		_, cksumHash, err := cos.CopyAndChecksum(io.Discard, rf.fh, nil, ty)
+		if err != nil {
+			cos.Close(rf.fh)
+			return err
+		}
+		cksum = cksumHash
+	}
+	if err := rf.fh.Close(); err != nil {
+		return err
+	}
+	lom.SetSize(rf.size)
+	if cksum != nil {
+		lom.SetCksum(cksum.Clone())
+	}
+	if _, err := core.T.FinalizeObj(lom, workFQN, rf.task.xdl, cmn.OwtPut); err != nil {
+		return err
+	}
+	return lom.Load(true /*cache it*/, false /*locked*/)
+}
+
+// FetchPart is executed by a target on behalf of the target that assembles the object (see above):
+// fetch the requested range of the source and relay it back.
+// The job (ID) - the same job that this target is also running - provides source credentials, if any.
+func FetchPart(w http.ResponseWriter, r *http.Request, link, jobID string) (int /*status*/, error) {
+	if link == "" {
+		return http.StatusBadRequest, errors.New("missing source link")
+	}
+	rng := r.Header.Get(cos.HdrRange)
+	if rng == "" {
+		return http.StatusBadRequest, fmt.Errorf("%q: missing %s header", link, cos.HdrRange)
+	}
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, link, http.NoBody)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if cos.IsGoogleStorageURL(req.URL) {
+		req.Header.Add("User-Agent", gcsUA)
+	}
+	req.Header.Set(cos.HdrRange, rng)
+	var cp *CredsProfile
+	if jobID != "" {
+		if dljob, err := g.store.getJob(jobID); err == nil {
+			cp = dljob.creds
+			if err := cp.Apply(req); err != nil {
+				return http.StatusInternalServerError, err
+			}
+		}
+	}
+	resp, err := clientForURL(link, cp).Do(req) //nolint:bodyclose // cos.Close
+	if err != nil {
+		return http.StatusBadGateway, err
+	}
+	defer cos.Close(resp.Body)
+	if resp.StatusCode != http.StatusPartialContent {
+		status := resp.StatusCode
+		if status < http.StatusBadRequest {
+			status = http.StatusRequestedRangeNotSatisfiable // (e.g., 200 when the source ignores ranges)
+		}
+		return status, fmt.Errorf("failed to download %q (range %s): status %d", link, rng, resp.StatusCode)
+	}
+
+	if resp.ContentLength >= 0 {
+		w.Header().Set(cos.HdrContentLength, resp.Header.Get(cos.HdrContentLength))
+	}
+	w.WriteHeader(http.StatusPartialContent)
+	buf, slab := core.T.PageMM().Alloc()
+	_, err = io.CopyBuffer(w, resp.Body, buf)
+	slab.Free(buf)
+	return 0, err // (status already sent)
+}
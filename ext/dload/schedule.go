@@ -0,0 +1,131 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Scheduled (recurring) download jobs are owned by the primary proxy that:
+// - persists all schedules and replicates them to all other proxies,
+// - periodically starts a new download job for each enabled schedule that is due.
+// Typical usage: synchronize a given cloud prefix every N hours (see `BackendBody.Sync`).
+
+const PrefixSchedID = "dls-"
+
+const MinScheduleInterval = time.Minute
+
+type (
+	// create schedule (POST /v1/download/schedule)
+	ScheduleMsg struct {
+		Body     Body         `json:"body"`     // download body (the same as for regular downloads)
+		Interval cos.Duration `json:"interval"` // run every so often
+		Disabled bool         `json:"disabled"` // create in disabled state
+	}
+
+	Schedule struct {
+		ID          string       `json:"id"`
+		Description string       `json:"description"`
+		Body        Body         `json:"body"`
+		Interval    cos.Duration `json:"interval"`
+		Created     time.Time    `json:"created"`
+		LastRun     time.Time    `json:"last_run"`
+		NextRun     time.Time    `json:"next_run"`
+		LastJobID   string       `json:"last_job_id"`
+		LastErr     string       `json:"last_error,omitempty"`
+		RunCnt      int          `json:"run_cnt"`
+		Enabled     bool         `json:"enabled"`
+	}
+	Schedules []*Schedule
+
+	// persisted and replicated
+	SchedulesMD struct {
+		Schedules Schedules `json:"schedules"`
+		Version   int64     `json:"version,string"`
+	}
+)
+
+/////////////////
+// ScheduleMsg //
+/////////////////
+
+func (msg *ScheduleMsg) Validate() error {
+	if msg.Body.Type == "" {
+		return errors.New("missing download body (type)")
+	}
+	if !IsType(string(msg.Body.Type)) {
+		return fmt.Errorf("invalid download type %q", msg.Body.Type)
+	}
+	if msg.Interval.D() < MinScheduleInterval {
+		return fmt.Errorf("invalid schedule interval %v (expecting at least %v)", msg.Interval, MinScheduleInterval)
+	}
+	return nil
+}
+
+//////////////
+// Schedule //
+//////////////
+
+func NewSchedule(msg *ScheduleMsg, descr string, now time.Time) *Schedule {
+	return &Schedule{
+		ID:          PrefixSchedID + cos.GenUUID(),
+		Description: descr,
+		Body:        msg.Body,
+		Interval:    msg.Interval,
+		Created:     now,
+		NextRun:     now, // run asap
+		Enabled:     !msg.Disabled,
+	}
+}
+
+func (s *Schedule) Due(now time.Time) bool { return s.Enabled && !now.Before(s.NextRun) }
+
+// update upon (successful or failed) attempt to start a new download job
+func (s *Schedule) Ran(now time.Time, jobID string, err error) {
+	s.LastRun = now
+	s.NextRun = now.Add(s.Interval.D())
+	s.RunCnt++
+	if err != nil {
+		s.LastErr = err.Error()
+		return
+	}
+	s.LastJobID, s.LastErr = jobID, ""
+}
+
+func (s *Schedule) Enable(now time.Time) {
+	s.Enabled = true
+	if s.NextRun.Before(now) {
+		s.NextRun = now
+	}
+}
+
+func (s *Schedule) String() string {
+	if s.Description == "" {
+		return fmt.Sprintf("%s(every %v)", s.ID, s.Interval)
+	}
+	return fmt.Sprintf("%s(%s, every %v)", s.ID, s.Description, s.Interval)
+}
+
+///////////////
+// Schedules //
+///////////////
+
+func (ss Schedules) Find(id string) (int, *Schedule) {
+	for i, s := range ss {
+		if s.ID == id {
+			return i, s
+		}
+	}
+	return -1, nil
+}
+
+func (ss Schedules) Sort() {
+	sort.Slice(ss, func(i, j int) bool { return ss[i].Created.Before(ss[j].Created) })
+}
@@ -0,0 +1,53 @@
+// Package dloader_test is a unit test
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ext/dload"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestScheduleMsgValidate(t *testing.T) {
+	tests := []struct {
+		msg   dload.ScheduleMsg
+		valid bool
+	}{
+		{dload.ScheduleMsg{Body: dload.Body{Type: dload.TypeBackend}, Interval: cos.Duration(time.Hour)}, true},
+		{dload.ScheduleMsg{Body: dload.Body{Type: dload.TypeBackend}, Interval: cos.Duration(time.Second)}, false},
+		{dload.ScheduleMsg{Body: dload.Body{Type: "unknown"}, Interval: cos.Duration(time.Hour)}, false},
+		{dload.ScheduleMsg{Interval: cos.Duration(time.Hour)}, false},
+	}
+	for _, test := range tests {
+		err := test.msg.Validate()
+		tassert.Errorf(t, (err == nil) == test.valid, "expected valid=%t, got err: %v (%+v)", test.valid, err, test.msg)
+	}
+}
+
+func TestScheduleDue(t *testing.T) {
+	var (
+		now = time.Now()
+		msg = &dload.ScheduleMsg{Body: dload.Body{Type: dload.TypeBackend}, Interval: cos.Duration(6 * time.Hour)}
+		s   = dload.NewSchedule(msg, "nightly", now)
+	)
+	tassert.Fatalf(t, s.Due(now), "new schedule expected to be due immediately")
+
+	s.Ran(now, "dnl-1", nil)
+	tassert.Errorf(t, !s.Due(now.Add(time.Hour)), "not expected to be due within the interval")
+	tassert.Errorf(t, s.Due(now.Add(6*time.Hour)), "expected to be due after the interval")
+	tassert.Errorf(t, s.LastJobID == "dnl-1" && s.RunCnt == 1, "unexpected state %+v", s)
+
+	s.Ran(now.Add(6*time.Hour), "", errors.New("failed"))
+	tassert.Errorf(t, s.LastJobID == "dnl-1" && s.LastErr == "failed", "unexpected state %+v", s)
+
+	s.Enabled = false
+	tassert.Errorf(t, !s.Due(now.Add(24*time.Hour)), "disabled schedule is never due")
+	s.Enable(now.Add(24 * time.Hour))
+	tassert.Errorf(t, s.Due(now.Add(24*time.Hour)), "re-enabled overdue schedule must run asap")
+}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -30,6 +31,7 @@ const (
 	retryCnt         = 10  // number of retries to external resource
 	reqTimeoutFactor = 1.2 // newTimeout = prevTimeout * reqTimeoutFactor
 	internalErrorMsg = "internal server error"
+	fileRetryWait    = time.Second // fs-download: (constant) delay between retries
 )
 
 type singleTask struct {
@@ -55,6 +57,8 @@ var terminalStatuses = map[int]struct{}{
 	http.StatusNotAcceptable:     {},
 	http.StatusProxyAuthRequired: {},
 	http.StatusGone:              {},
+
+	http.StatusRequestedRangeNotSatisfiable: {}, // (ranged.go)
 }
 
 ////////////////
@@ -82,9 +86,12 @@ func (task *singleTask) download(lom *core.LOM) {
 
 	task.started.Store(time.Now())
 	lom.SetAtimeUnix(task.started.Load().UnixNano())
-	if task.obj.fromRemote {
+	switch {
+	case task.obj.fromRemote:
 		err = task.downloadRemote(lom)
-	} else {
+	case task.fromFS():
+		err = task.downloadFile(lom)
+	default:
 		err = task.downloadLocal(lom)
 	}
 	task.ended.Store(time.Now())
@@ -119,8 +126,11 @@ func (task *singleTask) _dlocal(lom *core.LOM, timeout time.Duration) (bool /*er
 	if cos.IsGoogleStorageURL(req.URL) {
 		req.Header.Add("User-Agent", gcsUA)
 	}
+	if err := task.job.creds().Apply(req); err != nil {
+		return true, err
+	}
 
-	resp, err := clientForURL(task.obj.link).Do(req) //nolint:bodyclose // cos.Close
+	resp, err := clientForURL(task.obj.link, task.job.creds()).Do(req) //nolint:bodyclose // cos.Close
 	if err != nil {
 		return false, err
 	}
@@ -165,6 +175,11 @@ func (task *singleTask) _dput(lom *core.LOM, req *http.Request, resp *http.Respo
 }
 
 func (task *singleTask) downloadLocal(lom *core.LOM) (err error) {
+	if rngd := task.job.ranged(); rngd.PartSize > 0 {
+		if size, resp := task.probeRanged(); size > rngd.PartSize {
+			return task.downloadRanged(lom, size, resp, rngd)
+		}
+	}
 	var (
 		timeout = task.initialTimeout()
 		fatal   bool
@@ -174,30 +189,105 @@ func (task *singleTask) downloadLocal(lom *core.LOM) (err error) {
 		if err == nil || fatal {
 			return err
 		}
+		if !task.canRetry(err, i, &timeout) {
+			return err
+		}
+		task.reset()
+	}
+	return err
+}
 
-		// handle more
-		if errors.Is(err, context.Canceled) || errors.Is(err, errThrottlerStopped) {
-			return err // canceled or stopped, so just return
+// whether to retry a failed request (increases the timeout when the latter has expired)
+func (task *singleTask) canRetry(err error, i int, timeout *time.Duration) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, errThrottlerStopped) {
+		return false // canceled or stopped, so just return
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		nlog.Warningf("%s [retries: %d/%d]: timeout (%v) - increasing and retrying", task, i, retryCnt, *timeout)
+		*timeout = time.Duration(float64(*timeout) * reqTimeoutFactor)
+		return true
+	}
+	if herr := cmn.Err2HTTPErr(err); herr != nil {
+		nlog.Warningf("%s [retries: %d/%d]: failed to perform request: %v (code: %d)", task, i, retryCnt, err, herr.Status)
+		_, exists := terminalStatuses[herr.Status]
+		return !exists // nothing we can do
+	}
+	if !cos.IsRetriableConnErr(err) {
+		return false // ditto
+	}
+	nlog.Warningf("%s [retries: %d/%d]: connection failed with (%v), retrying...", task, i, retryCnt, err)
+	return true
+}
+
+// NOTE: only fs-download jobs read local files - `file://` links in other job types are not trusted
+func (task *singleTask) fromFS() bool {
+	_, ok := task.job.(*fsDlJob)
+	return ok && strings.HasPrefix(task.obj.link, FSScheme)
+}
+
+func (task *singleTask) downloadFile(lom *core.LOM) (err error) {
+	var (
+		fqn   = strings.TrimPrefix(task.obj.link, FSScheme)
+		fatal bool
+	)
+	for i := range retryCnt {
+		fatal, err = task._dfile(lom, fqn)
+		if err == nil || fatal {
+			return err
 		}
-		if errors.Is(err, context.DeadlineExceeded) {
-			nlog.Warningf("%s [retries: %d/%d]: timeout (%v) - increasing and retrying", task, i, retryCnt, timeout)
-			timeout = time.Duration(float64(timeout) * reqTimeoutFactor)
-		} else if herr := cmn.Err2HTTPErr(err); herr != nil {
-			nlog.Warningf("%s [retries: %d/%d]: failed to perform request: %v (code: %d)", task, i, retryCnt, err, herr.Status)
-			if _, exists := terminalStatuses[herr.Status]; exists {
-				return err // nothing we can do
-			}
-		} else {
-			if !cos.IsRetriableConnErr(err) {
-				return err // ditto
-			}
-			nlog.Warningf("%s [retries: %d/%d]: connection failed with (%v), retrying...", task, i, retryCnt, err)
+		if errors.Is(err, context.Canceled) || errors.Is(err, errThrottlerStopped) {
+			return err
 		}
+		nlog.Warningf("%s [retries: %d/%d]: %v, retrying...", task, i, retryCnt, err)
 		task.reset()
+		select {
+		case <-time.After(fileRetryWait):
+		case <-task.downloadCtx.Done():
+			return task.downloadCtx.Err()
+		}
 	}
 	return err
 }
 
+func (task *singleTask) _dfile(lom *core.LOM, fqn string) (bool /*err is fatal*/, error) {
+	ctx, cancel := context.WithTimeout(task.downloadCtx, task.initialTimeout())
+	defer cancel()
+	task.getCtx = ctx
+
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return os.IsNotExist(err) || os.IsPermission(err), err
+	}
+	finfo, err := fh.Stat()
+	if err != nil {
+		cos.Close(fh)
+		return false, err
+	}
+	size := finfo.Size()
+	task.setTotalSize(size)
+	lom.SetCustomKey(cmn.SourceObjMD, cmn.FileObjMD)
+	lom.SetCustomKey(cmn.LastModified, fileMtime(finfo))
+
+	params := core.AllocPutParams()
+	{
+		params.WorkTag = "dl"
+		params.Reader = task.wrapReader(fh) // (PutObject closes it)
+		params.OWT = cmn.OwtPut
+		params.Atime = task.started.Load()
+		params.Size = size
+		params.Xact = task.xdl
+	}
+	erp := core.T.PutObject(lom, params)
+	core.FreePutParams(params)
+	if erp != nil {
+		return true, erp
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		return true, err
+	}
+	return false, nil
+}
+
 func (task *singleTask) setTotalSize(size int64) {
 	if size > 0 {
 		task.totalSize.Store(size)
@@ -9,7 +9,9 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"os"
 	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,11 +29,19 @@ const headReqTimeout = 5 * time.Second
 
 var errInvalidTarget = errors.New("downloader: invalid target")
 
-func clientForURL(u string) *http.Client {
+// with credentials, returns a copy of the client that won't forward them
+// on redirect to other hosts (see CredsProfile.CheckRedirect)
+func clientForURL(u string, cp *CredsProfile) *http.Client {
+	client := g.clientH
 	if cos.IsHTTPS(u) {
-		return g.clientTLS
+		client = g.clientTLS
 	}
-	return g.clientH
+	if cp == nil {
+		return client
+	}
+	c := *client
+	c.CheckRedirect = cp.CheckRedirect
+	return &c
 }
 
 //nolint:gocritic // need a copy of cos.ParsedTemplate
@@ -159,8 +169,18 @@ func ParseStartRequest(bck *meta.Bck, id string, dlb Body, xdl *Xact) (jobif, er
 			return nil, err
 		}
 		return newSingleDlJob(id, bck, dp, xdl)
+	case TypeFS:
+		dp := &FSBody{}
+		err := jsoniter.Unmarshal(dlb.RawMessage, dp)
+		if err != nil {
+			return nil, err
+		}
+		if err := dp.Validate(); err != nil {
+			return nil, err
+		}
+		return newFSDlJob(id, bck, dp, xdl)
 	default:
-		return nil, errors.New("input does not match any of the supported formats (single, range, multi, backend)")
+		return nil, errors.New("input does not match any of the supported formats (single, range, multi, backend, fs)")
 	}
 }
 
@@ -233,7 +253,7 @@ func headLink(link string) (resp *http.Response, err error) {
 	)
 	req, err = http.NewRequestWithContext(ctx, http.MethodHead, link, http.NoBody)
 	if err == nil {
-		resp, err = clientForURL(link).Do(req)
+		resp, err = clientForURL(link, nil).Do(req)
 	}
 	cancel()
 	return
@@ -247,6 +267,9 @@ func CompareObjects(lom *core.LOM, dst *DstElement) (bool /*equal*/, error) {
 		return res.Eq, res.Err
 		// TODO: make use of res.ObjAttrs
 	}
+	if strings.HasPrefix(dst.Link, FSScheme) {
+		return compareFile(lom, strings.TrimPrefix(dst.Link, FSScheme))
+	}
 
 	resp, err := headLink(dst.Link) //nolint:bodyclose // cos.Close
 	if err != nil {
@@ -260,6 +283,21 @@ func CompareObjects(lom *core.LOM, dst *DstElement) (bool /*equal*/, error) {
 	return lom.CheckEq(oa) == nil, nil
 }
 
+// local (or mounted) file: size and modification time
+func compareFile(lom *core.LOM, fqn string) (bool /*equal*/, error) {
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		return false, err
+	}
+	if lom.Lsize() != finfo.Size() {
+		return false, nil
+	}
+	mtime, ok := lom.GetCustomKey(cmn.LastModified)
+	return ok && mtime == fileMtime(finfo), nil
+}
+
+func fileMtime(finfo os.FileInfo) string { return strconv.FormatInt(finfo.ModTime().UnixNano(), 10) }
+
 // called via ais/prxnotifs generic mechanism
 func AbortReq(jobID string) cmn.HreqArgs {
 	var (
@@ -44,6 +44,31 @@ func TestNormalizeObjName(t *testing.T) {
 	}
 }
 
+func TestFSBodyValidate(t *testing.T) {
+	bck := cmn.Bck{Name: "bck", Provider: apc.AIS}
+	tests := []struct {
+		path  string
+		dir   string
+		valid bool
+	}{
+		{"/mnt/nfs/data", "/mnt/nfs/data", true},
+		{"file:///mnt/nfs/data/", "/mnt/nfs/data", true},
+		{"mnt/nfs", "", false},
+		{"file://mnt/nfs", "", false},
+		{"", "", false},
+	}
+	for _, test := range tests {
+		body := &dload.FSBody{Base: dload.Base{Bck: bck}, Path: test.path}
+		err := body.Validate()
+		if !test.valid {
+			tassert.Errorf(t, err != nil, "expected %q to fail validation", test.path)
+			continue
+		}
+		tassert.CheckError(t, err)
+		tassert.Errorf(t, body.Dir() == test.dir, "expected %q, got %q", test.dir, body.Dir())
+	}
+}
+
 func TestCompareObject(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 	var (
@@ -114,3 +139,25 @@ func prepareObject(t *testing.T) *core.LOM {
 	tassert.CheckFatal(t, err)
 	return lom
 }
+
+func TestRangedValidate(t *testing.T) {
+	tests := []struct {
+		ranged dload.Ranged
+		valid  bool
+	}{
+		{dload.Ranged{}, true},
+		{dload.Ranged{PartSize: 64 * cos.MiB, Workers: 8, Spread: true}, true},
+		{dload.Ranged{PartSize: cos.MiB}, true},
+		{dload.Ranged{PartSize: cos.KiB}, false},
+		{dload.Ranged{PartSize: -1}, false},
+		{dload.Ranged{PartSize: 64 * cos.MiB, Workers: -1}, false},
+	}
+	for _, test := range tests {
+		err := test.ranged.Validate()
+		if test.valid {
+			tassert.CheckError(t, err)
+		} else {
+			tassert.Errorf(t, err != nil, "expected %+v to be invalid", test.ranged)
+		}
+	}
+}
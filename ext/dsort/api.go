@@ -73,6 +73,11 @@ type RequestSpec struct {
 	ExtractConcMaxLimit int `json:"extract_concurrency_max_limit" yaml:"extract_concurrency_max_limit"`
 	// Default: calcMaxLimit()
 	CreateConcMaxLimit int `json:"create_concurrency_max_limit" yaml:"create_concurrency_max_limit"`
+	// Default: false (when true, persist per-target checkpoints so that the job, if aborted, can be resumed)
+	Resumable bool `json:"resumable" yaml:"resumable"`
+	// Default: false (write-through) - applies only when the output bucket is remote (e.g., s3://):
+	// when true, upload output shards asynchronously while creating other shards (see wback.go)
+	OutputWriteBack bool `json:"output_write_back" yaml:"output_write_back"`
 
 	// debug
 	DsorterType string `json:"dsorter_type"`
@@ -97,10 +97,23 @@ type (
 		// data. Sometimes, rather than creating at the destination, it is faster
 		// to create a shard on a specific target and send it over (to the destination).
 		MovedShardCnt int64 `json:"moved_shard_count,string"`
+		// SkippedCnt (resumed job only) - the number of output shards created by the previous run(s)
+		// and, therefore, skipped (reported by the final target).
+		SkippedCnt int64 `json:"skipped_count,string"`
 		// RequestStats - time statistics: requests to other targets.
 		RequestStats *TimeStats `json:"req_stats,omitempty"`
 		// ResponseStats - time statistics: responses to other targets.
 		ResponseStats *TimeStats `json:"resp_stats,omitempty"`
+		// Remote output bucket only (see wback.go):
+		// UploadedCnt and UploadedSize - shards (and bytes) written to the remote backend;
+		// PendingUploadCnt - write-back only: shards created locally but not uploaded yet;
+		// UploadErrCnt - number of failed uploads, with UploadErrs listing (up to wbackMaxErrs)
+		// failed shards by name.
+		UploadedCnt      int64             `json:"uploaded_count,string"`
+		UploadedSize     int64             `json:"uploaded_size,string"`
+		PendingUploadCnt int64             `json:"pending_upload_count,string"`
+		UploadErrCnt     int64             `json:"upload_error_count,string"`
+		UploadErrs       map[string]string `json:"upload_errors,omitempty"`
 	}
 )
 
@@ -166,6 +179,34 @@ func (pi *phaseBase) finish() {
 	pi.mu.Unlock()
 }
 
+///////////////////
+// ShardCreation //
+///////////////////
+
+func (sc *ShardCreation) pendingUpload(n int64) {
+	sc.mu.Lock()
+	sc.PendingUploadCnt += n
+	sc.mu.Unlock()
+}
+
+// upload status: write-through and write-back (see wback.go)
+func (sc *ShardCreation) uploaded(shardName string, size int64, err error) {
+	sc.mu.Lock()
+	if err == nil {
+		sc.UploadedCnt++
+		sc.UploadedSize += size
+	} else {
+		sc.UploadErrCnt++
+		if sc.UploadErrs == nil {
+			sc.UploadErrs = make(map[string]string, 4)
+		}
+		if len(sc.UploadErrs) < wbackMaxErrs {
+			sc.UploadErrs[shardName] = err.Error()
+		}
+	}
+	sc.mu.Unlock()
+}
+
 /////////////
 // Metrics //
 /////////////
@@ -0,0 +1,341 @@
+// Package dsort provides distributed massively parallel resharding for very large datasets.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dsort
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
+	"github.com/OneOfOne/xxhash"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Resumable jobs (`RequestSpec.Resumable`):
+// - each target persists its own checkpoint (dsort collection, key: checkpoints/<root job ID>),
+//   where root job is the very first run of a given (possibly, resumed multiple times) job;
+// - the checkpoint records the job's phase, the number of extracted input shards, and the names
+//   of the output shards that this target has already created;
+// - upon completing phase 2, the final target also records a digest of the sorted records' metadata;
+// - `resume` (proxy) collects all checkpoints and starts a new job with the same spec, the current
+//   cluster map, and the list of already created shards - the latter get skipped in phase 3 iff
+//   the sorted records' digest remains unchanged (otherwise, all output shards are recreated);
+// - thus, a target that restarts (or goes away) mid-job causes only its share of the output shards
+//   to be created again (and redistributed, via HRW, when the cluster map changes);
+// - checkpoints are removed upon successful completion, when the job is removed,
+//   and by the housekeeper (when stale).
+
+const (
+	checkpointsKey = "checkpoints"
+
+	ckptFlushCnt = 128 // persist created shards every so often (and, of course, at the end of each phase)
+)
+
+// phases
+const (
+	ckptStarted   = "started"
+	ckptExtracted = "extracted"
+	ckptSorted    = "sorted"
+	ckptCreated   = "created"
+)
+
+type (
+	// what a resumed job inherits from the previous run(s)
+	resumeInfo struct {
+		Digest     string   `json:"digest"`
+		Created    []string `json:"created"`
+		NumRecords int64    `json:"num_records,string"`
+	}
+	checkpoint struct {
+		Updated    time.Time      `json:"updated"`
+		Pars       *parsedReqSpec `json:"pars"`
+		RootID     string         `json:"root_id"`
+		JobID      string         `json:"job_id"`
+		Phase      string         `json:"phase"`
+		Digest     string         `json:"digest,omitempty"`
+		Created    []string       `json:"created,omitempty"`
+		Extracted  int64          `json:"extracted,string"`
+		NumRecords int64          `json:"num_records,string"`
+		Running    bool           `json:"running"` // (not persisted; set when responding to the proxy)
+	}
+	ckpt struct {
+		m     *Manager
+		c     checkpoint
+		mu    sync.Mutex
+		dirty int
+	}
+)
+
+func newCkpt(m *Manager) *ckpt {
+	rootID := m.Pars.ResumeOf
+	if rootID == "" {
+		rootID = m.ManagerUUID
+	}
+	ck := &ckpt{m: m}
+	ck.c.Pars, ck.c.RootID, ck.c.JobID, ck.c.Phase = m.Pars, rootID, m.ManagerUUID, ckptStarted
+	return ck
+}
+
+func (ck *ckpt) key() string { return path.Join(checkpointsKey, ck.c.RootID) }
+
+// NOTE: all methods below are no-op when the job is not resumable (`m.ckpt == nil`)
+
+func (ck *ckpt) phase(phase string, n int64) {
+	if ck == nil {
+		return
+	}
+	ck.mu.Lock()
+	ck.c.Phase = phase
+	if phase == ckptExtracted {
+		ck.c.Extracted = n
+	}
+	ck._flush()
+	ck.mu.Unlock()
+}
+
+func (ck *ckpt) sorted(digest string, n int64) {
+	if ck == nil {
+		return
+	}
+	ck.mu.Lock()
+	ck.c.Phase, ck.c.Digest, ck.c.NumRecords = ckptSorted, digest, n
+	ck._flush()
+	ck.mu.Unlock()
+}
+
+func (ck *ckpt) created(names ...string) {
+	if ck == nil {
+		return
+	}
+	ck.mu.Lock()
+	ck.c.Created = append(ck.c.Created, names...)
+	ck.dirty += len(names)
+	if ck.dirty >= ckptFlushCnt {
+		ck._flush()
+	}
+	ck.mu.Unlock()
+}
+
+func (ck *ckpt) flush() {
+	if ck == nil {
+		return
+	}
+	ck.mu.Lock()
+	if ck.dirty > 0 {
+		ck._flush()
+	}
+	ck.mu.Unlock()
+}
+
+func (ck *ckpt) _flush() {
+	ck.c.Updated = time.Now()
+	ck.dirty = 0
+	ck.m.mg.mtx.Lock()
+	err := ck.m.mg.db.Set(dsortCollection, ck.key(), &ck.c)
+	ck.m.mg.mtx.Unlock()
+	if err != nil {
+		nlog.Errorf("%s: [dsort] %s failed to store checkpoint: %v", core.T, ck.m.ManagerUUID, err)
+	}
+}
+
+func (ck *ckpt) remove() {
+	if ck == nil {
+		return
+	}
+	ck.m.mg.mtx.Lock()
+	_ = ck.m.mg.db.Delete(dsortCollection, ck.key())
+	ck.m.mg.mtx.Unlock()
+}
+
+// digest of the sorted records (order included) - a resumed job may skip creating
+// output shards only if it has got exactly the same records in exactly the same order
+func recordsDigest(records *shard.Records) string {
+	h := xxhash.New64()
+	for _, r := range records.All() {
+		h.WriteString(r.Name)
+		for _, obj := range r.Objects {
+			h.WriteString(obj.Extension)
+			h.WriteString(strconv.FormatInt(obj.Size, 10))
+		}
+	}
+	return strconv.FormatUint(h.Sum64(), 16)
+}
+
+// phase 3: filter out output shards created by the previous run(s);
+// return the number of skipped records' objects per (source) target
+func (m *Manager) skipCreated(shards []*shard.Shard, digest string) ([]*shard.Shard, map[string]int64) {
+	resume := m.Pars.Resume
+	if resume == nil || len(resume.Created) == 0 {
+		return shards, nil
+	}
+	if resume.Digest != digest || resume.NumRecords != int64(m.recm.Records.Len()) {
+		nlog.Warningf("%s: [dsort] %s (resuming %s): sorted records differ from the previous run - recreating all shards",
+			core.T, m.ManagerUUID, m.Pars.ResumeOf)
+		return shards, nil
+	}
+	var (
+		created  = cos.NewStrSet(resume.Created...)
+		skipped  = make(map[string]int64, 4)
+		toCreate = shards[:0]
+		names    []string
+	)
+	for _, s := range shards {
+		if !created.Contains(s.Name) {
+			toCreate = append(toCreate, s)
+			continue
+		}
+		names = append(names, s.Name)
+		for _, rec := range s.Records.All() {
+			skipped[rec.DaemonID] += int64(len(rec.Objects))
+		}
+	}
+	nlog.Infof("%s: [dsort] %s (resuming %s): skipping %d already created shards, %d remaining",
+		core.T, m.ManagerUUID, m.Pars.ResumeOf, len(names), len(toCreate))
+
+	m.Metrics.Creation.mu.Lock()
+	m.Metrics.Creation.SkippedCnt = int64(len(names))
+	m.Metrics.Creation.mu.Unlock()
+
+	// carry over (so that the next resume, if any, has it)
+	m.ckpt.created(names...)
+	return toCreate, skipped
+}
+
+// (housekeeping)
+func (mg *ManagerGroup) _hkCkpts(maxAge time.Duration) {
+	records, err := mg.db.GetAll(dsortCollection, checkpointsKey)
+	if err != nil {
+		if !cos.IsErrNotFound(err) {
+			nlog.Errorln(err)
+		}
+		return
+	}
+	for key, r := range records {
+		var c checkpoint
+		if err := jsoniter.Unmarshal([]byte(r), &c); err != nil || time.Since(c.Updated) > maxAge {
+			if _, exists := mg.managers[c.JobID]; !exists {
+				_ = mg.db.Delete(dsortCollection, key)
+			}
+		}
+	}
+}
+
+// (when removing the job)
+func (mg *ManagerGroup) _delCkpt(jobID string) {
+	records, err := mg.db.GetAll(dsortCollection, checkpointsKey)
+	if err != nil {
+		return
+	}
+	for key, r := range records {
+		var c checkpoint
+		if err := jsoniter.Unmarshal([]byte(r), &c); err == nil && (c.JobID == jobID || c.RootID == jobID) {
+			_ = mg.db.Delete(dsortCollection, key)
+		}
+	}
+}
+
+// find this target's checkpoint by (root or any resumed) job ID
+func (mg *ManagerGroup) findCkpt(jobID string) (*checkpoint, error) {
+	mg.mtx.Lock()
+	defer mg.mtx.Unlock()
+	var c checkpoint
+	err := mg.db.Get(dsortCollection, path.Join(checkpointsKey, jobID), &c)
+	if err == nil {
+		return &c, nil
+	}
+	if !cos.IsErrNotFound(err) {
+		return nil, err
+	}
+	records, err := mg.db.GetAll(dsortCollection, checkpointsKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range records {
+		if err := jsoniter.Unmarshal([]byte(r), &c); err == nil && c.JobID == jobID {
+			return &c, nil
+		}
+	}
+	return nil, cos.NewErrNotFound(core.T, "dsort checkpoint "+jobID)
+}
+
+//
+// handlers
+//
+
+// GET /v1/sort/checkpoint/<job ID>
+func tckptHandler(w http.ResponseWriter, r *http.Request) {
+	if !checkHTTPMethod(w, r, http.MethodGet) {
+		return
+	}
+	apiItems, err := parseURL(w, r, 1, apc.URLPathdSortCkpt.L)
+	if err != nil {
+		return
+	}
+	c, err := Managers.findCkpt(apiItems[0])
+	if err != nil {
+		cmn.WriteErr(w, r, err)
+		return
+	}
+	if m, exists := Managers.Get(c.JobID, false /*incl. archived*/); exists {
+		c.Running = m.inProgress() || !m.Metrics.Archived.Load()
+	}
+	w.Write(cos.MustMarshal(c))
+}
+
+// PresumeCtx is called by proxy to resume a given (aborted) resumable job:
+// collect all targets' checkpoints and prepare the spec for the new run (see PstartHandler)
+func PresumeCtx(jobID string) (*ParsedReq, error) {
+	var (
+		latest    *checkpoint
+		upath     = apc.URLPathdSortCkpt.Join(jobID)
+		responses = bcast(http.MethodGet, upath, nil, nil, psi.Sowner().Get())
+		created   = cos.NewStrSet()
+		digest    checkpoint
+	)
+	for _, resp := range responses {
+		if resp.statusCode == http.StatusNotFound {
+			continue // e.g., new target (or the one that has lost its state)
+		}
+		if resp.err != nil {
+			return nil, resp.err
+		}
+		c := &checkpoint{}
+		if err := js.Unmarshal(resp.res, c); err != nil {
+			return nil, err
+		}
+		if c.Running {
+			return nil, fmt.Errorf("%s job %s is still running (abort it first)", apc.ActDsort, c.JobID)
+		}
+		created.Add(c.Created...)
+		if latest == nil || c.Updated.After(latest.Updated) {
+			latest = c
+		}
+		if c.Digest != "" && c.Updated.After(digest.Updated) {
+			digest = *c
+		}
+	}
+	if latest == nil {
+		return nil, cos.NewErrNotFound(psi, fmt.Sprintf("resumable %s job %q", apc.ActDsort, jobID))
+	}
+
+	pars := latest.Pars
+	pars.ResumeOf = latest.RootID
+	pars.Resume = &resumeInfo{Digest: digest.Digest, NumRecords: digest.NumRecords}
+	if digest.Digest != "" {
+		pars.Resume.Created = created.ToSlice()
+		sort.Strings(pars.Resume.Created)
+	}
+	return &ParsedReq{pars.InputBck, pars.OutputBck, pars}, nil
+}
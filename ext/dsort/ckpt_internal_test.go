@@ -0,0 +1,74 @@
+// Package dsort provides distributed massively parallel resharding for very large datasets.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dsort
+
+import (
+	"github.com/NVIDIA/aistore/ext/dsort/shard"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func ckptRecords(tids ...string) *shard.Records {
+	records := shard.NewRecords(len(tids))
+	for i, tid := range tids {
+		name := string(rune('a' + i))
+		records.Insert(&shard.Record{
+			Key: name, Name: name, DaemonID: tid,
+			Objects: []*shard.RecordObj{{Extension: ".txt", Size: 10}, {Extension: ".cls", Size: 1}},
+		})
+	}
+	return records
+}
+
+var _ = Describe("Checkpoint", func() {
+	It("should compute records digest that depends on the order", func() {
+		records := ckptRecords("t1", "t2", "t1")
+		digest := recordsDigest(records)
+		Expect(recordsDigest(ckptRecords("t1", "t2", "t1"))).To(Equal(digest))
+
+		records.Swap(0, 2)
+		Expect(recordsDigest(records)).NotTo(Equal(digest))
+	})
+
+	It("should skip shards created by the previous run", func() {
+		var (
+			records = ckptRecords("t1", "t2", "t1", "t2")
+			digest  = recordsDigest(records)
+			shards  = []*shard.Shard{
+				{Name: "out-0.tar", Records: records.Slice(0, 2)},
+				{Name: "out-1.tar", Records: records.Slice(2, 3)},
+				{Name: "out-2.tar", Records: records.Slice(3, 4)},
+			}
+			m = &Manager{
+				Pars: &parsedReqSpec{
+					ResumeOf: "srt-root",
+					Resume:   &resumeInfo{Digest: digest, NumRecords: 4, Created: []string{"out-0.tar", "out-2.tar"}},
+				},
+				Metrics: newMetrics(""),
+				recm:    &shard.RecordManager{Records: records},
+			}
+		)
+		toCreate, skipped := m.skipCreated(shards, digest)
+		Expect(toCreate).To(HaveLen(1))
+		Expect(toCreate[0].Name).To(Equal("out-1.tar"))
+		Expect(skipped).To(Equal(map[string]int64{"t1": 2, "t2": 4}))
+		Expect(m.Metrics.Creation.SkippedCnt).To(BeEquivalentTo(2))
+	})
+
+	It("should recreate all shards when records differ", func() {
+		var (
+			records = ckptRecords("t1", "t2")
+			shards  = []*shard.Shard{{Name: "out-0.tar", Records: records}}
+			m       = &Manager{
+				Pars:    &parsedReqSpec{Resume: &resumeInfo{Digest: "0", NumRecords: 2, Created: []string{"out-0.tar"}}},
+				Metrics: newMetrics(""),
+				recm:    &shard.RecordManager{Records: records},
+			}
+		)
+		toCreate, skipped := m.skipCreated(shards, recordsDigest(records))
+		Expect(toCreate).To(HaveLen(1))
+		Expect(skipped).To(BeEmpty())
+	})
+})
@@ -90,6 +90,7 @@ func (m *Manager) start() (err error) {
 	if err := m.extractLocalShards(); err != nil {
 		return err
 	}
+	m.ckpt.phase(ckptExtracted, m.Metrics.Extraction.ExtractedCnt)
 
 	s := binary.BigEndian.Uint64(m.Pars.TargetOrderSalt)
 	targetOrder := _torder(s, m.smap.Tmap)
@@ -136,9 +137,16 @@ func (m *Manager) start() (err error) {
 	// After each target participates in the cluster-wide record distribution,
 	// start listening for the signal to start creating shards locally.
 	nlog.Infof("%s: %s started creation stage", core.T, m.ManagerUUID)
-	if err := m.dsorter.createShardsLocally(); err != nil {
+	err = m.dsorter.createShardsLocally()
+	if m.wback != nil {
+		if errW := m.wback.wait(); err == nil {
+			err = errW
+		}
+	}
+	if err != nil {
 		return err
 	}
+	m.ckpt.phase(ckptCreated, 0)
 
 	nlog.Infof("%s: %s finished successfully", core.T, m.ManagerUUID)
 	return nil
@@ -262,6 +270,12 @@ func (m *Manager) createShard(s *shard.Shard, lom *core.LOM) (err error) {
 	}
 	lom.SetAtimeUnix(time.Now().UnixNano())
 
+	// remote output bucket: write-through (default) or write-back (see wback.go)
+	var (
+		remote = lom.Bck().IsRemote() && !m.Pars.DryRun
+		wback  = remote && m.wback != nil
+	)
+
 	if m.aborted() {
 		return m.newErrAborted()
 	}
@@ -301,6 +315,10 @@ func (m *Manager) createShard(s *shard.Shard, lom *core.LOM) (err error) {
 				// (vs metrics.ShardCreationStats.updateThroughput - see below)
 
 				// TODO: add params.Size = (size resulting from shardRW.Create below)
+
+				if wback {
+					params.OWT = cmn.OwtRebalance // local only (upload is deferred)
+				}
 			}
 			err = core.T.PutObject(lom, params)
 			core.FreePutParams(params)
@@ -344,6 +362,9 @@ func (m *Manager) createShard(s *shard.Shard, lom *core.LOM) (err error) {
 	wg.Wait()
 	close(errCh)
 
+	if remote && !wback {
+		metrics.uploaded(shardName, lom.Lsize(), err) // write-through
+	}
 	if err != nil {
 		return err
 	}
@@ -357,50 +378,18 @@ func (m *Manager) createShard(s *shard.Shard, lom *core.LOM) (err error) {
 	// according to HRW, send it there. Since it doesn't really matter
 	// if we have an extra copy of the object local to this target, we
 	// optimize for performance by not removing the object now.
-	if si.ID() != core.T.SID() && !m.Pars.DryRun {
-		lom.Lock(false)
-		defer lom.Unlock(false)
-
-		// Need to make sure that the object is still there.
-		if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
-			return err
-		}
-
-		if lom.Lsize() <= 0 {
-			goto exit
-		}
-
-		file, err := cos.NewFileHandle(lom.FQN)
-		if err != nil {
-			return err
-		}
-
-		o := transport.AllocSend()
-		o.Hdr = transport.ObjHdr{
-			ObjName:  shardName,
-			ObjAttrs: cmn.ObjAttrs{Size: lom.Lsize(), Cksum: lom.Checksum()},
-		}
-		o.Hdr.Bck.Copy(lom.Bucket())
-
-		// Make send synchronous.
-		streamWg := &sync.WaitGroup{}
-		errCh := make(chan error, 1)
-		o.Callback = func(_ *transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
-			errCh <- err
-			streamWg.Done()
-		}
-		streamWg.Add(1)
-		err = m.streams.shards.Send(o, file, si)
-		if err != nil {
-			return err
-		}
-		streamWg.Wait()
-		if err := <-errCh; err != nil {
+	switch {
+	case wback:
+		m.wback.upload(shardName, lom.Lsize(), si) // upload, and then send
+	case si.ID() != core.T.SID() && !m.Pars.DryRun:
+		if err := m.sendShard(lom, si); err != nil {
 			return err
 		}
+		fallthrough
+	default:
+		m.ckpt.created(shardName)
 	}
 
-exit:
 	metrics.mu.Lock()
 	metrics.CreatedCnt++
 	if si.ID() != core.T.SID() {
@@ -411,6 +400,45 @@ exit:
 	return nil
 }
 
+// send newly created shard to its HRW destination (synchronously);
+// the destination receives shard's metadata as well, including remote version and ETag (if any)
+func (m *Manager) sendShard(lom *core.LOM, si *meta.Snode) error {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+
+	// Need to make sure that the object is still there.
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	if lom.Lsize() <= 0 {
+		return nil
+	}
+
+	file, err := cos.NewFileHandle(lom.FQN)
+	if err != nil {
+		return err
+	}
+
+	o := transport.AllocSend()
+	o.Hdr = transport.ObjHdr{ObjName: lom.ObjName}
+	o.Hdr.ObjAttrs.CopyFrom(lom, false /*skip cksum*/)
+	o.Hdr.Bck.Copy(lom.Bucket())
+
+	// Make send synchronous.
+	streamWg := &sync.WaitGroup{}
+	errCh := make(chan error, 1)
+	o.Callback = func(_ *transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
+		errCh <- err
+		streamWg.Done()
+	}
+	streamWg.Add(1)
+	if err := m.streams.shards.Send(o, file, si); err != nil {
+		return err
+	}
+	streamWg.Wait()
+	return <-errCh
+}
+
 // participateInRecordDistribution coordinates the distributed merging and
 // sorting of each target's SortedRecords based on the order defined by
 // targetOrder. It returns a bool, currentTargetIsFinal, which is true iff the
@@ -804,6 +832,14 @@ func (m *Manager) phase3(maxSize int64) error {
 		return err
 	}
 
+	// resumable job: checkpoint sorted records' digest and skip output shards created by the previous run(s)
+	var skipped map[string]int64
+	if m.ckpt != nil {
+		digest := recordsDigest(m.recm.Records)
+		m.ckpt.sorted(digest, int64(m.recm.Records.Len()))
+		shards, skipped = m.skipCreated(shards, digest)
+	}
+
 	bck := meta.CloneBck(&m.Pars.OutputBck)
 	if err := bck.Init(core.T.Bowner()); err != nil {
 		return err
@@ -840,7 +876,7 @@ func (m *Manager) phase3(maxSize int64) error {
 	wg := cos.NewLimitedWaitGroup(cmn.MaxParallelism(), len(shardsToTarget))
 	for si, s := range shardsToTarget {
 		wg.Add(1)
-		go m._dist(si, s, sendOrder[si.ID()], errCh, wg)
+		go m._dist(si, s, sendOrder[si.ID()], skipped[si.ID()], errCh, wg)
 	}
 
 	wg.Wait()
@@ -854,7 +890,7 @@ func (m *Manager) phase3(maxSize int64) error {
 	return nil
 }
 
-func (m *Manager) _dist(si *meta.Snode, s []*shard.Shard, order map[string]*shard.Shard, errCh chan error, wg cos.WG) {
+func (m *Manager) _dist(si *meta.Snode, s []*shard.Shard, order map[string]*shard.Shard, skipped int64, errCh chan error, wg cos.WG) {
 	var (
 		group = &errgroup.Group{}
 		r, w  = io.Pipe()
@@ -875,6 +911,9 @@ func (m *Manager) _dist(si *meta.Snode, s []*shard.Shard, order map[string]*shar
 	})
 	group.Go(func() error {
 		query := m.Pars.InputBck.NewQuery()
+		if skipped > 0 {
+			query.Set(apc.QparamSkippedObjects, strconv.FormatInt(skipped, 10))
+		}
 		reqArgs := &cmn.HreqArgs{
 			Method: http.MethodPost,
 			Base:   si.URL(cmn.NetIntraData),
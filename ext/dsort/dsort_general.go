@@ -362,6 +362,7 @@ func (ds *dsorterGeneral) loadRemote(w io.Writer, rec *shard.Record, obj *shard.
 	o := transport.AllocSend()
 	o.Hdr = transport.ObjHdr{Opaque: opaque}
 	o.Callback, o.CmplArg = ds.sentCallback, &req
+	o.Prio = transport.PrioHigh
 
 	if err := ds.streams.request.Send(o, nil, tsi); err != nil {
 		return 0, errors.WithStack(err)
@@ -425,7 +426,7 @@ func (ds *dsorterGeneral) sentCallback(_ *transport.ObjHdr, _ io.ReadCloser, arg
 }
 
 func (ds *dsorterGeneral) errHandler(err error, node *meta.Snode, o *transport.Obj) {
-	*o = transport.Obj{Hdr: o.Hdr}
+	*o = transport.Obj{Hdr: o.Hdr, Prio: transport.PrioHigh}
 	o.Hdr.Opaque = []byte(err.Error())
 	o.Hdr.ObjAttrs.Size = 0
 	if err = ds.streams.response.Send(o, nil, node); err != nil {
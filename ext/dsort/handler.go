@@ -417,6 +417,8 @@ func TargetHandler(w http.ResponseWriter, r *http.Request) {
 		tmetricsHandler(w, r)
 	case apc.FinishedAck:
 		tfiniHandler(w, r)
+	case apc.Checkpoint:
+		tckptHandler(w, r)
 	default:
 		cmn.WriteErrMsg(w, r, "invalid path")
 	}
@@ -577,6 +579,16 @@ func (managers *ManagerGroup) shardsHandler(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	// resumed job: release local records that belong to the output shards created by the previous run(s)
+	if v := r.URL.Query().Get(apc.QparamSkippedObjects); v != "" {
+		skipped, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			cmn.WriteErrMsg(w, r, fmt.Sprintf("invalid %s=%q: %v", apc.QparamSkippedObjects, v, err))
+			return
+		}
+		m.decrementRef(skipped)
+	}
+
 	m.creationPhase.metadata = *tmpMetadata
 	m.startShardCreation <- struct{}{}
 }
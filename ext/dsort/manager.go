@@ -116,6 +116,8 @@ type (
 		callTimeout    time.Duration // max time to wait for another node to respond
 		config         *cmn.Config
 		xctn           *xaction
+		ckpt           *ckpt  // nil unless resumable
+		wback          *wback // nil unless write-back (remote output bucket)
 	}
 )
 
@@ -239,6 +241,14 @@ func (m *Manager) init(pars *parsedReqSpec) error {
 	m.state.cleanWait = sync.NewCond(&m.mu)
 
 	m.callTimeout = m.config.Dsort.CallTimeout.D()
+
+	if pars.OutputWriteBack {
+		m.wback = newWback(m)
+	}
+	if pars.Resumable {
+		m.ckpt = newCkpt(m)
+		m.ckpt.phase(ckptStarted, 0)
+	}
 	return nil
 }
 
@@ -318,6 +328,8 @@ func (m *Manager) cleanup() {
 	if !m.aborted() {
 		m.updateFinishedAck(core.T.SID())
 		m.xctn.Finish()
+	} else {
+		m.ckpt.flush() // to resume
 	}
 }
 
@@ -370,6 +382,11 @@ func (m *Manager) finalCleanup() {
 	m.creationPhase.metadata.SendOrder = nil
 	m.creationPhase.metadata.Shards = nil
 
+	// all targets have finished (compare w/ cleanup on abort)
+	if !m.aborted() {
+		m.ckpt.remove()
+	}
+
 	m.finishedAck.m = nil
 
 	// Update clean state.
@@ -638,6 +655,11 @@ func (m *Manager) recvShard(hdr *transport.ObjHdr, objReader io.Reader, err erro
 		params.Atime = started
 		params.Size = hdr.ObjAttrs.Size
 	}
+	if lom.Bck().IsRemote() {
+		// already uploaded by the sender (see wback.go)
+		lom.CopyAttrs(&hdr.ObjAttrs, true /*skip-checksum*/)
+		params.OWT = cmn.OwtRebalance
+	}
 	erp := core.T.PutObject(lom, params)
 	core.FreePutParams(params)
 	if erp != nil {
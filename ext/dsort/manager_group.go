@@ -144,6 +144,7 @@ func (mg *ManagerGroup) Remove(managerUUID string) error {
 
 	key := path.Join(managersKey, managerUUID)
 	_ = mg.db.Delete(dsortCollection, key) // Delete only returns err when record does not exist, which should be ignored
+	mg._delCkpt(managerUUID)
 	return nil
 }
 
@@ -188,6 +189,8 @@ func (mg *ManagerGroup) housekeep() time.Duration {
 	mg.mtx.Lock()
 	defer mg.mtx.Unlock()
 
+	mg._hkCkpts(regularInterval)
+
 	records, err := mg.db.GetAll(dsortCollection, managersKey)
 	if err != nil {
 		if cos.IsErrNotFound(err) {
@@ -95,6 +95,24 @@ var _ = Describe("RequestSpec", func() {
 			Expect(pars.OutputBck.Provider).To(Equal(apc.AWS))
 		})
 
+		It("should parse remote output bucket with write-back", func() {
+			rs := RequestSpec{
+				InputBck:        cmn.Bck{Name: "test"},
+				OutputBck:       cmn.Bck{Provider: "s3", Name: "testing"},
+				InputExtension:  archive.ExtTar,
+				InputFormat:     newInputFormat("prefix-{0010..0111..2}-suffix"),
+				OutputFormat:    "prefix-{10..111}-suffix",
+				OutputShardSize: "10KB",
+				OutputWriteBack: true,
+				Algorithm:       Algorithm{Kind: None},
+			}
+			pars, err := rs.parse()
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(pars.OutputBck.Provider).To(Equal(apc.AWS))
+			Expect(pars.OutputWriteBack).To(BeTrue())
+		})
+
 		It("should parse spec with mem usage as bytes", func() {
 			rs := RequestSpec{
 				InputBck: cmn.Bck{Name: "test"},
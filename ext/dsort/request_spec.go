@@ -53,6 +53,14 @@ type parsedReqSpec struct {
 	CreateConcMaxLimit  int                   `json:"create_concurrency_max_limit"`
 	SbundleMult         int                   `json:"bundle_multiplier"`
 
+	// remote output bucket (see wback.go)
+	OutputWriteBack bool `json:"output_write_back"`
+
+	// resumable job (see ckpt.go)
+	Resumable bool        `json:"resumable"`
+	ResumeOf  string      `json:"resume_of,omitempty"` // root job ID (the first run)
+	Resume    *resumeInfo `json:"resume,omitempty"`
+
 	// debug
 	DsorterType string `json:"dsorter_type"`
 	DryRun      bool   `json:"dry_run"`
@@ -210,6 +218,8 @@ func (rs *RequestSpec) parse() (*parsedReqSpec, error) {
 	pars.CreateConcMaxLimit = rs.CreateConcMaxLimit
 	pars.DsorterType = rs.DsorterType
 	pars.DryRun = rs.DryRun
+	pars.Resumable = rs.Resumable && !rs.DryRun
+	pars.OutputWriteBack = rs.OutputWriteBack
 
 	// `cfg` here contains inherited (aka global) part of the dsort config -
 	// apply this request's rs.Config values to override or assign defaults
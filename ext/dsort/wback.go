@@ -0,0 +1,123 @@
+// Package dsort provides distributed massively parallel resharding for very large datasets.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package dsort
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Remote output bucket (s3://, gs://, az://, and ais:// with remote backend):
+// - write-through (default): each output shard gets uploaded to the remote backend as part of its
+//   creation (`core.T.PutObject`), and only then sent to its (HRW) destination target, if different;
+// - write-back (`RequestSpec.OutputWriteBack`): output shards are created locally, with their upload
+//   (and subsequent transfer to the HRW destination) running asynchronously, while the job keeps on
+//   creating other shards; the job (on a given target) completes when all its uploads are done;
+// - either way, the uploading target is the one that creates the shard: the destination receives
+//   the shard together with its remote metadata (version, ETag, etc.) and does not re-upload;
+// - big shards are uploaded by the respective backends in parts (e.g., S3 multipart upload);
+// - upload status is reported via `ShardCreation` metrics (counters and failed shards, if any).
+
+const (
+	wbackWorkersPerMpath = 2
+	wbackMaxErrs         = 64 // max number of failed uploads to report (by name)
+)
+
+type wback struct {
+	m    *Manager
+	sema *cos.Semaphore
+	wg   sync.WaitGroup
+	err  cos.Errs
+}
+
+func newWback(m *Manager) *wback {
+	avail := fs.NumAvail()
+	return &wback{m: m, sema: cos.NewSemaphore(max(avail, 1) * wbackWorkersPerMpath)}
+}
+
+// schedule async upload of the (locally created) shard, and then send it to `si` (if not self)
+func (wb *wback) upload(shardName string, size int64, si *meta.Snode) {
+	wb.m.Metrics.Creation.pendingUpload(1)
+	wb.wg.Add(1)
+	go wb.do(shardName, size, si)
+}
+
+func (wb *wback) do(shardName string, size int64, si *meta.Snode) {
+	metrics := wb.m.Metrics.Creation
+	defer func() {
+		metrics.pendingUpload(-1)
+		wb.wg.Done()
+	}()
+	wb.sema.Acquire()
+	defer wb.sema.Release()
+
+	if wb.m.aborted() {
+		return
+	}
+	lom := core.AllocLOM(shardName)
+	err := wb._do(lom, si)
+	core.FreeLOM(lom)
+	metrics.uploaded(shardName, size, err)
+	if err != nil {
+		wb.err.Add(err)
+		nlog.Errorln(core.T.String(), "[dsort]", wb.m.ManagerUUID, "write-back", shardName, "failed:", err)
+		return
+	}
+	wb.m.ckpt.created(shardName)
+}
+
+func (wb *wback) _do(lom *core.LOM, si *meta.Snode) error {
+	m := wb.m
+	if err := lom.InitBck(&m.Pars.OutputBck); err != nil {
+		return err
+	}
+	if err := wb.put(lom); err != nil {
+		return err
+	}
+	if si.ID() == core.T.SID() {
+		return nil
+	}
+	return m.sendShard(lom, si)
+}
+
+// upload local replica via backend.PutObj and persist the resulting (remote) metadata
+func (*wback) put(lom *core.LOM) error {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	fh, err := cos.NewFileHandle(lom.FQN)
+	if err != nil {
+		return err
+	}
+	var (
+		bck     = lom.Bck()
+		backend = core.T.Backend(bck)
+	)
+	if !bck.IsRemoteAIS() {
+		lom.ObjAttrs().DelCustomKeys(cmn.SourceObjMD, cmn.CRC32CObjMD, cmn.ETag, cmn.MD5ObjMD, cmn.VersionObjMD)
+	}
+	if _, err := backend.PutObj(fh, lom, nil); err != nil {
+		return err
+	}
+	if !bck.IsRemoteAIS() {
+		lom.SetCustomKey(cmn.SourceObjMD, backend.Provider())
+	}
+	return lom.PersistMain()
+}
+
+// wait for all scheduled uploads to complete
+func (wb *wback) wait() error {
+	wb.wg.Wait()
+	_, err := wb.err.JoinErr()
+	return err
+}
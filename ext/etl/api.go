@@ -58,19 +58,31 @@ const (
 type (
 	InitMsg interface {
 		Name() string
+		Version() string
 		MsgType() string // Code or Spec
 		CommType() string
 		ArgType() string
+		NumGPUs() int
 		Validate() error
 		String() string
 	}
 
 	// and implementations
 	InitMsgBase struct {
-		IDX       string       `json:"id"`            // etlName (not to be confused)
-		CommTypeX string       `json:"communication"` // enum commTypes
-		ArgTypeX  string       `json:"argument"`      // enum argTypes
-		Timeout   cos.Duration `json:"timeout"`
+		IDX       string       `json:"id"`                // etlName (not to be confused)
+		CommTypeX string       `json:"communication"`     // enum commTypes
+		ArgTypeX  string       `json:"argument"`          // enum argTypes
+		Timeout   cos.Duration `json:"timeout"`           // waiting time for the pod to become ready
+		VersionX  string       `json:"version,omitempty"` // user-defined version (label), e.g. "v2"
+		GPUs      int          `json:"gpus,omitempty"`    // number of GPUs per ETL pod (see gpu.go)
+		// request timeout and the number of consecutive failures that opens the circuit breaker
+		// (zero - use DefaultTimeout and DefaultMaxFails, respectively; negative MaxFails disables)
+		// see breaker.go
+		ReqTimeout cos.Duration `json:"request_timeout,omitempty"`
+		MaxFails   int          `json:"max_failures,omitempty"`
+		// max consecutive pod restarts before aborting ETL cluster-wide
+		// (zero - use DefaultMaxRestarts; negative - restart indefinitely), see health.go
+		MaxRestarts int `json:"max_restarts,omitempty"`
 	}
 	InitSpecMsg struct {
 		InitMsgBase
@@ -106,6 +118,14 @@ type (
 		ObjCount int64  `json:"obj_count"`
 		InBytes  int64  `json:"in_bytes"`
 		OutBytes int64  `json:"out_bytes"`
+		// inline transforms
+		ErrCount   int64        `json:"err_count"`
+		AvgLatency cos.Duration `json:"avg_latency"`
+		// circuit breaker
+		Breaker string `json:"breaker,omitempty"` // enum { BreakerClosed, ... } (empty when disabled)
+		// pod health and restarts (triggered by health probes and circuit breaker)
+		Health   string `json:"health,omitempty"` // enum { PodHealthy, ... }
+		Restarts int64  `json:"restarts,omitempty"`
 	}
 
 	LogsByTarget []Logs
@@ -125,6 +145,11 @@ type (
 		TargetID string  `json:"target_id"`
 		CPU      float64 `json:"cpu"`
 		Mem      int64   `json:"mem"`
+		// GPUs allocated to the ETL pod, and to all pods on the same node (out of node's allocatable)
+		GPUs          int64  `json:"gpus,omitempty"`
+		GPUNode       string `json:"gpu_node,omitempty"`
+		NodeGPUsAlloc int64  `json:"node_gpus_alloc,omitempty"`
+		NodeGPUs      int64  `json:"node_gpus,omitempty"`
 	}
 )
 
@@ -146,8 +171,17 @@ var (
 func (m InitMsgBase) CommType() string { return m.CommTypeX }
 func (m InitMsgBase) ArgType() string  { return m.ArgTypeX }
 func (m InitMsgBase) Name() string     { return m.IDX }
-func (*InitCodeMsg) MsgType() string   { return Code }
-func (*InitSpecMsg) MsgType() string   { return Spec }
+func (m InitMsgBase) Version() string  { return m.VersionX }
+func (m InitMsgBase) NumGPUs() int     { return m.GPUs }
+
+func (m *InitMsgBase) reqTimeout() time.Duration {
+	if m.ReqTimeout > 0 {
+		return m.ReqTimeout.D()
+	}
+	return DefaultTimeout
+}
+func (*InitCodeMsg) MsgType() string { return Code }
+func (*InitSpecMsg) MsgType() string { return Spec }
 
 func (m *InitCodeMsg) String() string {
 	return fmt.Sprintf("init-%s[%s-%s-%s-%s]", Code, m.IDX, m.CommTypeX, m.ArgTypeX, m.Runtime)
@@ -217,6 +251,16 @@ func (m *InitMsgBase) validate(detail string) error {
 		return cmn.NewErrETLf(errCtx, ferr, err, detail)
 	}
 
+	if m.ReqTimeout < 0 {
+		err := fmt.Errorf("invalid request timeout %v", m.ReqTimeout)
+		return cmn.NewErrETLf(errCtx, ferr, err, detail)
+	}
+
+	if m.GPUs < 0 || m.GPUs > MaxGPUs {
+		err := fmt.Errorf("invalid number of GPUs %d, expecting 0 <= gpus <= %d", m.GPUs, MaxGPUs)
+		return cmn.NewErrETLf(errCtx, ferr, err, detail)
+	}
+
 	// NOTE: default comm-type
 	if m.CommType() == "" {
 		cos.Infoln("Warning: empty comm-type, defaulting to", Hpush)
@@ -296,6 +340,17 @@ func (m *InitSpecMsg) Validate() (err error) {
 	if container.ReadinessProbe.HTTPGet.Port.StrVal != k8s.Default {
 		return cmn.NewErrETLf(errCtx, "readinessProbe port must be the %q port", k8s.Default)
 	}
+
+	// GPUs may be requested via the spec (container resources) and/or the message
+	if n := int(k8s.PodGPUs(&pod.Spec)); n > 0 {
+		switch m.GPUs {
+		case 0:
+			m.GPUs = n
+		case n:
+		default:
+			return cmn.NewErrETLf(errCtx, "number of GPUs in the spec (%d) differs from the requested %d", n, m.GPUs)
+		}
+	}
 	return nil
 }
 
@@ -321,3 +376,45 @@ var _ sort.Interface = (*InfoList)(nil)
 func (il InfoList) Len() int           { return len(il) }
 func (il InfoList) Less(i, j int) bool { return il[i].Name < il[j].Name }
 func (il InfoList) Swap(i, j int)      { il[i], il[j] = il[j], il[i] }
+
+// MergeBreakers reports (in place) the "worst" breaker state and pod health across targets,
+// and the total number of pod restarts
+func (il InfoList) MergeBreakers(other InfoList) {
+	for i := range il {
+		for j := range other {
+			if il[i].Name != other[j].Name {
+				continue
+			}
+			if brkRank(other[j].Breaker) > brkRank(il[i].Breaker) {
+				il[i].Breaker = other[j].Breaker
+			}
+			if healthRank(other[j].Health) > healthRank(il[i].Health) {
+				il[i].Health = other[j].Health
+			}
+			il[i].Restarts += other[j].Restarts
+			break
+		}
+	}
+}
+
+func healthRank(health string) int {
+	switch health {
+	case PodUnhealthy:
+		return 2
+	case PodRestarting:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func brkRank(state string) int {
+	switch state {
+	case BreakerOpen:
+		return 2
+	case BreakerHalfOpen:
+		return 1
+	default:
+		return 0
+	}
+}
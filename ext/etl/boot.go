@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -19,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
@@ -33,9 +36,11 @@ type etlBootstrapper struct {
 	env    map[string]string
 
 	// runtime
+	node            string // k8s node to run the pod: target's own node or GPU node (see gpu.go)
 	xctn            core.Xact
 	pod             *corev1.Pod
 	svc             *corev1.Service
+	mon             *podMonitor // see health.go
 	uri             string
 	originalPodName string
 	originalCommand []string
@@ -52,13 +57,21 @@ func (b *etlBootstrapper) createPodSpec() (err error) {
 
 func (b *etlBootstrapper) _prepSpec() (err error) {
 	// Override pod name: append target ID
-	// (K8s doesn't allow `_` and uppercase)
-	b.pod.SetName(k8s.CleanName(b.msg.IDX + "-" + core.T.SID()))
+	b.pod.SetName(podName(b.msg.IDX, core.T.SID()))
 	b.errCtx.PodName = b.pod.GetName()
 	b.pod.APIVersion = "v1"
 
+	b.node = k8s.NodeName
+	if b.msg.GPUs > 0 {
+		if b.node, err = gpuNode(&b.msg, core.T.Sowner().Get(), core.T.SID()); err != nil {
+			return cmn.NewErrETL(b.errCtx, err.Error())
+		}
+		b._setGPUs()
+	}
+
 	// The following combination of Affinity and Anti-Affinity provides for:
-	// 1. The ETL container is always scheduled on the target invoking it.
+	// 1. The ETL container is always scheduled on the target invoking it
+	//    (or, when requesting GPUs, on the selected GPU node).
 	// 2. No more than a single ETL container with the same target is scheduled on
 	//    the same node at any given point in time.
 	if err = b._setAffinity(); err != nil {
@@ -80,6 +93,9 @@ func (b *etlBootstrapper) _prepSpec() (err error) {
 	return
 }
 
+// (K8s doesn't allow `_` and uppercase)
+func podName(etlName, tid string) string { return k8s.CleanName(etlName + "-" + tid) }
+
 func (b *etlBootstrapper) createServiceSpec() {
 	b.svc = &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
@@ -119,7 +135,7 @@ func (b *etlBootstrapper) setupConnection() (err error) {
 
 	// Make sure we can access the pod via TCP socket address to ensure that
 	// it is accessible from target.
-	etlSocketAddr := fmt.Sprintf("%s:%d", hostIP, nodePort)
+	etlSocketAddr := net.JoinHostPort(hostIP, strconv.FormatUint(uint64(nodePort), 10))
 	if err = b._dial(etlSocketAddr); err != nil {
 		if cmn.Rom.FastV(4, cos.SmoduleETL) {
 			nlog.Warningf("failed to dial -> %s: %s, %+v, %s", etlSocketAddr, b.msg.String(), b.errCtx, b.uri)
@@ -218,6 +234,28 @@ func (b *etlBootstrapper) waitPodReady() error {
 	return err
 }
 
+// restartPod re-creates the (unhealthy) pod in place - same spec, same service and, therefore,
+// same URI (see podMonitor.restart)
+func (b *etlBootstrapper) restartPod() error {
+	if b.xctn.Finished() {
+		return cmn.NewErrETL(b.errCtx, "stopped")
+	}
+	nlog.Warningln("restarting pod", b.pod.Name, b.errCtx)
+	if err := deleteEntity(b.errCtx, k8s.Pod, b.pod.Name); err != nil {
+		return err
+	}
+	if err := b.createEntity(k8s.Pod); err != nil {
+		return err
+	}
+	if b.xctn.Finished() { // stopped in the meantime
+		return deleteEntity(b.errCtx, k8s.Pod, b.pod.Name)
+	}
+	if err := b.waitPodReady(); err != nil {
+		return err
+	}
+	return b._dial(strings.TrimPrefix(b.uri, "http://"))
+}
+
 func (b *etlBootstrapper) setupXaction(xid string) {
 	rns := xreg.RenewETL(b.msg, xid)
 	debug.AssertNoErr(rns.Err)
@@ -235,7 +273,8 @@ func (b *etlBootstrapper) _updPodCommand() {
 	b.pod.Spec.Containers[0].Command = []string{"sh", "-c", "/server"}
 }
 
-// Sets pods node affinity, so pod will be scheduled on the same node as a target creating it.
+// Sets pods node affinity, so pod will be scheduled on the same node as a target creating it
+// (or on the GPU node selected for it).
 func (b *etlBootstrapper) _setAffinity() error {
 	if b.pod.Spec.Affinity == nil {
 		b.pod.Spec.Affinity = &corev1.Affinity{}
@@ -256,7 +295,7 @@ func (b *etlBootstrapper) _setAffinity() error {
 				MatchExpressions: []corev1.NodeSelectorRequirement{{
 					Key:      nodeNameLabel,
 					Operator: corev1.NodeSelectorOpIn,
-					Values:   []string{k8s.NodeName},
+					Values:   []string{b.node},
 				}},
 			},
 		},
@@ -289,6 +328,20 @@ func (b *etlBootstrapper) _setAntiAffinity() error {
 	return nil
 }
 
+// Requests GPUs for the (single) ETL container; for extended resources, requests must equal limits.
+func (b *etlBootstrapper) _setGPUs() {
+	res := &b.pod.Spec.Containers[0].Resources
+	if res.Limits == nil {
+		res.Limits = make(corev1.ResourceList, 1)
+	}
+	if res.Requests == nil {
+		res.Requests = make(corev1.ResourceList, 1)
+	}
+	q := *resource.NewQuantity(int64(b.msg.GPUs), resource.DecimalSI)
+	res.Limits[k8s.GPUResource] = q
+	res.Requests[k8s.GPUResource] = q
+}
+
 func (b *etlBootstrapper) _updPodLabels() {
 	if b.pod.Labels == nil {
 		b.pod.Labels = make(map[string]string, 6)
@@ -296,7 +349,7 @@ func (b *etlBootstrapper) _updPodLabels() {
 
 	b.pod.Labels[appLabel] = "ais"
 	b.pod.Labels[podNameLabel] = b.pod.GetName()
-	b.pod.Labels[podNodeLabel] = k8s.NodeName
+	b.pod.Labels[podNodeLabel] = b.node
 	b.pod.Labels[podTargetLabel] = core.T.SID()
 	b.pod.Labels[appK8sNameLabel] = "etl"
 	b.pod.Labels[appK8sComponentLabel] = "server"
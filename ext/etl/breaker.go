@@ -0,0 +1,225 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Per-ETL (and per-target) request timeout and circuit breaker:
+// - each request to the ETL pod is bounded by `InitMsgBase.ReqTimeout` (defaults to DefaultTimeout);
+//   offline transforms use the bucket-to-bucket `etl-timeout`, if specified;
+// - the breaker counts consecutive pod failures: timeouts, connection errors, and 5xx responses
+//   (except inline hrev:// where the response gets reverse-proxied as is);
+//   other errors (e.g., object not found) do not count;
+// - upon `InitMsgBase.MaxFails` (DefaultMaxFails) consecutive failures the breaker opens - the pod
+//   is deemed unhealthy, and both inline and offline transforms fail fast (503) rather than hang
+//   user GETs;
+// - opening the breaker triggers (asynchronous) pod restart: delete, re-create, and wait until ready;
+//   failed restart is retried, at most once per brkCooldown; restarts are subject to the same
+//   backoff and max-restarts policy as the ones triggered by health probes (see health.go);
+// - once the pod is restarted the breaker goes half-open and lets a single probe through:
+//   success closes the breaker, failure re-opens it (and restarts the pod again);
+// - with hpull:// the client accesses the pod directly, which is why only the fail-fast part applies;
+// - negative `MaxFails` disables the breaker;
+// - breaker state and the number of restarts are reported in ETL details (see `Info`).
+
+// enum breaker state
+const (
+	BreakerClosed   = "closed"
+	BreakerOpen     = "open"
+	BreakerHalfOpen = "half-open"
+)
+
+const (
+	DefaultMaxFails = 5
+	brkCooldown     = 10 * time.Second
+)
+
+type (
+	breaker struct {
+		err        error                   // most recent failure
+		restart    func(cause error) error // (see podMonitor.restart)
+		name       string
+		state      string
+		opened     int64 // mono time
+		restarts   int64
+		fails      int // consecutive
+		maxFails   int
+		mu         sync.Mutex
+		restarting bool
+		probing    bool
+	}
+
+	// pod failure (that the breaker counts)
+	errPod struct {
+		err error
+	}
+
+	ErrUnavailable struct {
+		err   error
+		name  string
+		state string
+	}
+)
+
+func newBreaker(name string, maxFails int, restart func(error) error) *breaker {
+	if maxFails == 0 {
+		maxFails = DefaultMaxFails
+	}
+	return &breaker{name: name, state: BreakerClosed, maxFails: maxFails, restart: restart}
+}
+
+// to be called prior to sending request to the pod
+func (b *breaker) allow() error {
+	if b.maxFails < 0 {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case BreakerClosed:
+		return nil
+	case BreakerHalfOpen:
+		if !b.probing {
+			b.probing = true
+			return nil
+		}
+	case BreakerOpen:
+		if !b.restarting && mono.Since(b.opened) >= brkCooldown {
+			b._restart() // retry
+		}
+	}
+	return &ErrUnavailable{name: b.name, state: b.state, err: b.err}
+}
+
+// to be called upon completion: nil - success, errPod - failure, all other errors are inconclusive
+func (b *breaker) done(err error) {
+	if b.maxFails < 0 {
+		return
+	}
+	if err != nil && !isErrPod(err) {
+		if !IsErrUnavailable(err) {
+			b.skip()
+		}
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err == nil {
+		b.fails = 0
+		b.probing = false
+		if b.state == BreakerHalfOpen {
+			b.state = BreakerClosed
+			nlog.Infoln("etl[" + b.name + "]: circuit breaker closed")
+		}
+		return
+	}
+	b.fails++
+	b.err = err
+	switch b.state {
+	case BreakerHalfOpen:
+		b._open()
+	case BreakerClosed:
+		if b.fails >= b.maxFails {
+			b._open()
+		}
+	}
+}
+
+// inconclusive outcome (e.g., object not found, hpull redirect) - neither success nor failure
+func (b *breaker) skip() {
+	if b.maxFails < 0 {
+		return
+	}
+	b.mu.Lock()
+	b.probing = false
+	b.mu.Unlock()
+}
+
+func (b *breaker) _open() {
+	nlog.Errorf("etl[%s]: circuit breaker open after %d consecutive failure%s, last: %v",
+		b.name, b.fails, cos.Plural(b.fails), b.err)
+	b.state = BreakerOpen
+	b.probing = false
+	b.opened = mono.NanoTime()
+	b._restart()
+}
+
+func (b *breaker) _restart() {
+	b.restarting = true
+	b.restarts++
+	go b.doRestart(b.err)
+}
+
+func (b *breaker) doRestart(cause error) {
+	err := b.restart(cause)
+	b.mu.Lock()
+	b.restarting = false
+	b.opened = mono.NanoTime()
+	if err == nil {
+		b.state = BreakerHalfOpen
+		nlog.Infoln("etl[" + b.name + "]: pod restarted, circuit breaker half-open")
+	} else {
+		b.err = err
+		nlog.Errorf("etl[%s]: failed to restart pod: %v (will retry in %v)", b.name, err, brkCooldown)
+	}
+	b.mu.Unlock()
+}
+
+func (b *breaker) status() (state string, restarts int64) {
+	if b.maxFails < 0 {
+		return "", 0
+	}
+	b.mu.Lock()
+	state, restarts = b.state, b.restarts
+	b.mu.Unlock()
+	return
+}
+
+////////////
+// errPod //
+////////////
+
+func (e *errPod) Error() string { return e.err.Error() }
+func (e *errPod) Unwrap() error { return e.err }
+
+func isErrPod(err error) bool {
+	var e *errPod
+	return errors.As(err, &e)
+}
+
+// request timeout is a pod failure; any other context error is not
+func podErr(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+	return &errPod{err}
+}
+
+////////////////////
+// ErrUnavailable //
+////////////////////
+
+func (e *ErrUnavailable) Error() string {
+	s := fmt.Sprintf("etl[%s] is unavailable (circuit breaker %s)", e.name, e.state)
+	if e.err != nil {
+		s += ": " + e.err.Error()
+	}
+	return s
+}
+
+func IsErrUnavailable(err error) bool {
+	var e *ErrUnavailable
+	return errors.As(err, &e)
+}
@@ -0,0 +1,115 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BreakerTest", func() {
+	var (
+		errTimeout = podErr(context.DeadlineExceeded)
+		errStatus  = errors.New("not found") // does not count
+	)
+
+	state := func(b *breaker) string {
+		s, _ := b.status()
+		return s
+	}
+
+	It("should open after consecutive failures and close after successful probe", func() {
+		restarted := make(chan struct{}, 1)
+		b := newBreaker("md5", 3, func(error) error {
+			restarted <- struct{}{}
+			return nil
+		})
+
+		for range 2 {
+			Expect(b.allow()).NotTo(HaveOccurred())
+			b.done(errTimeout)
+		}
+		Expect(state(b)).To(Equal(BreakerClosed))
+
+		// success resets the count
+		b.done(nil)
+		for range 2 {
+			b.done(errTimeout)
+			b.done(errStatus)
+		}
+		Expect(state(b)).To(Equal(BreakerClosed))
+
+		b.done(errTimeout)
+		Eventually(restarted).Should(Receive())
+		Eventually(func() string { return state(b) }).Should(Equal(BreakerHalfOpen))
+
+		// half-open: single probe
+		Expect(b.allow()).NotTo(HaveOccurred())
+		err := b.allow()
+		Expect(IsErrUnavailable(err)).To(BeTrue())
+		b.done(err) // (rejected request does not release the probe)
+		Expect(IsErrUnavailable(b.allow())).To(BeTrue())
+
+		b.done(nil)
+		Expect(state(b)).To(Equal(BreakerClosed))
+		Expect(b.allow()).NotTo(HaveOccurred())
+
+		_, restarts := b.status()
+		Expect(restarts).To(BeEquivalentTo(1))
+	})
+
+	It("should fail fast while open and re-open upon failed probe", func() {
+		var (
+			release  = make(chan error)
+			restarts int
+		)
+		b := newBreaker("md5", 1, func(error) error {
+			restarts++
+			return <-release
+		})
+
+		b.done(errTimeout)
+		Expect(state(b)).To(Equal(BreakerOpen))
+		err := b.allow()
+		Expect(IsErrUnavailable(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(context.DeadlineExceeded.Error()))
+
+		release <- nil
+		Eventually(func() string { return state(b) }).Should(Equal(BreakerHalfOpen))
+
+		// inconclusive probe
+		Expect(b.allow()).NotTo(HaveOccurred())
+		b.done(errStatus)
+		Expect(state(b)).To(Equal(BreakerHalfOpen))
+
+		// failed probe
+		Expect(b.allow()).NotTo(HaveOccurred())
+		b.done(errTimeout)
+		Expect(state(b)).To(Equal(BreakerOpen))
+		release <- errors.New("failed to restart")
+		Eventually(func() string { return state(b) }, 5*time.Second).Should(Equal(BreakerOpen))
+		Expect(restarts).To(Equal(2))
+	})
+
+	It("should be disabled", func() {
+		b := newBreaker("md5", -1, nil)
+		for range 2 * DefaultMaxFails {
+			Expect(b.allow()).NotTo(HaveOccurred())
+			b.done(errTimeout)
+		}
+		Expect(state(b)).To(BeEmpty())
+	})
+
+	It("should classify pod errors", func() {
+		Expect(isErrPod(podErr(context.DeadlineExceeded))).To(BeTrue())
+		Expect(isErrPod(podErr(context.Canceled))).To(BeFalse())
+		Expect(isErrPod(cos.NewErrNotFound(nil, "object"))).To(BeFalse())
+	})
+})
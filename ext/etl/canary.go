@@ -0,0 +1,109 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/k8s"
+)
+
+// Canary deployment:
+// - a new version of an existing ETL runs side by side with the current one, as a separate
+//   ETL named `<name>-<version>` (see CanaryName), with its own pods, xaction, and stats;
+// - the configured percentage of inline transform requests that reference the (current) ETL
+//   by name gets routed to the new version;
+// - promote: the current ETL gets restarted with the new version's init message, after which
+//   the canary is removed;
+// - rollback: the canary gets stopped and removed.
+
+type (
+	Canary struct {
+		Name    string `json:"name"`    // ETL name (current version)
+		Version string `json:"version"` // new version
+		ETL     string `json:"etl"`     // new version's ETL name
+		Pct     int    `json:"pct"`     // percentage of transform requests routed to the new version
+	}
+	Canaries map[string]*Canary // keyed by (current) ETL name
+)
+
+func CanaryName(name, version string) string { return name + "-" + version }
+
+func NewCanary(name, version string, pct int) (*Canary, error) {
+	if version == "" {
+		return nil, fmt.Errorf("etl[%s]: canary version must be specified", name)
+	}
+	c := &Canary{Name: name, Version: version, ETL: CanaryName(name, version), Pct: pct}
+	return c, c.Validate()
+}
+
+func (c *Canary) Validate() error {
+	if c.Pct < 0 || c.Pct > 100 {
+		return fmt.Errorf("%s: invalid percentage %d (expecting 0 <= pct <= 100)", c, c.Pct)
+	}
+	if err := k8s.ValidateEtlName(c.ETL); err != nil {
+		return fmt.Errorf("%s: invalid version %q: %v", c, c.Version, err)
+	}
+	return nil
+}
+
+// returns the name of the ETL to route the next transform request to
+func (c *Canary) Route() string {
+	if c.Pct > 0 && rand.IntN(100) < c.Pct {
+		return c.ETL
+	}
+	return c.Name
+}
+
+func (c *Canary) String() string {
+	return fmt.Sprintf("etl-canary[%s => %s, %d%%]", c.Name, c.ETL, c.Pct)
+}
+
+// clone init message under a different name and version (to promote canary)
+func Rename(msg InitMsg, name, version string) InitMsg {
+	switch m := msg.(type) {
+	case *InitCodeMsg:
+		clone := *m
+		clone.IDX, clone.VersionX = name, version
+		return &clone
+	case *InitSpecMsg:
+		clone := *m
+		clone.IDX, clone.VersionX = name, version
+		return &clone
+	default:
+		debug.Assert(false, msg.String())
+		return nil
+	}
+}
+
+//////////////
+// Canaries //
+//////////////
+
+func (cs Canaries) Get(name string) *Canary {
+	if cs == nil {
+		return nil
+	}
+	return cs[name]
+}
+
+// (current) ETL name => name of the ETL to use
+func (e *MD) Route(name string) string {
+	if c := e.Canaries.Get(name); c != nil {
+		return c.Route()
+	}
+	return name
+}
+
+func (e *MD) IsCanary(etlName string) bool {
+	for _, c := range e.Canaries {
+		if c.ETL == etlName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CanaryTest", func() {
+	It("should validate canary", func() {
+		_, err := NewCanary("md5", "v2", 10)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = NewCanary("md5", "", 10)
+		Expect(err).To(HaveOccurred())
+		_, err = NewCanary("md5", "v2", 101)
+		Expect(err).To(HaveOccurred())
+		_, err = NewCanary("md5", "V_2", 10)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should route transform requests", func() {
+		md := &MD{}
+		md.Init(2)
+		Expect(md.Route("md5")).To(Equal("md5"))
+
+		c, err := NewCanary("md5", "v2", 0)
+		Expect(err).NotTo(HaveOccurred())
+		md.Canaries = Canaries{"md5": c}
+		for range 100 {
+			Expect(md.Route("md5")).To(Equal("md5"))
+		}
+		c.Pct = 100
+		for range 100 {
+			Expect(md.Route("md5")).To(Equal("md5-v2"))
+		}
+		Expect(md.IsCanary("md5-v2")).To(BeTrue())
+		Expect(md.IsCanary("md5")).To(BeFalse())
+	})
+
+	It("should rename init message", func() {
+		msg := &InitCodeMsg{InitMsgBase: InitMsgBase{IDX: "md5-v2", VersionX: "v2"}, Runtime: "python3.11v2"}
+		renamed := Rename(msg, "md5", "v2")
+		Expect(renamed.Name()).To(Equal("md5"))
+		Expect(renamed.Version()).To(Equal("v2"))
+		Expect(renamed.(*InitCodeMsg).Runtime).To(Equal("python3.11v2"))
+		Expect(msg.Name()).To(Equal("md5-v2"))
+	})
+})
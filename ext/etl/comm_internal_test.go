@@ -41,6 +41,7 @@ var _ = Describe("CommunicatorTest", func() {
 		transformerServer *httptest.Server
 		targetServer      *httptest.Server
 		proxyServer       *httptest.Server
+		gotWidth          string // transformer parameter, as received
 
 		dataSize      = int64(cos.MiB * 50)
 		transformData = make([]byte, dataSize)
@@ -85,7 +86,11 @@ var _ = Describe("CommunicatorTest", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		// Initialize the HTTP servers.
-		transformerServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		transformerServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotWidth = r.URL.Query().Get("width")
+			w.Header().Add(apc.HdrObjCustomMD, "label=cat")
+			w.Header().Add(apc.HdrObjCustomMD, cos.HdrContentType+"=image/jpeg")
+			w.Header().Add(apc.HdrObjCustomMD, cmn.ETag+"=spoofed") // (reserved)
 			_, err := w.Write(transformData)
 			Expect(err).NotTo(HaveOccurred())
 		}))
@@ -94,7 +99,7 @@ var _ = Describe("CommunicatorTest", func() {
 			Expect(err).NotTo(HaveOccurred())
 		}))
 		proxyServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			http.Redirect(w, r, targetServer.URL, http.StatusMovedPermanently)
+			http.Redirect(w, r, targetServer.URL+"?"+r.URL.RawQuery, http.StatusMovedPermanently)
 		}))
 	})
 
@@ -129,7 +134,7 @@ var _ = Describe("CommunicatorTest", func() {
 			}
 			comm = newCommunicator(nil, boot)
 
-			resp, err := http.Get(proxyServer.URL)
+			resp, err := http.Get(proxyServer.URL + "?" + apc.QparamETLParamPrefix + "width=224")
 			Expect(err).NotTo(HaveOccurred())
 			defer resp.Body.Close()
 
@@ -137,6 +142,20 @@ var _ = Describe("CommunicatorTest", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(len(b)).To(Equal(len(transformData)))
 			Expect(b).To(Equal(transformData))
+			Expect(resp.Header.Values(apc.HdrObjCustomMD)).To(ContainElement("label=cat"))
+			Expect(gotWidth).To(Equal("224"))
+
+			// offline
+			lom := &core.LOM{ObjName: objName}
+			Expect(lom.InitBck(clusterBck.Bucket())).NotTo(HaveOccurred())
+			r, md, err := comm.OfflineTransform(lom, 0 /*timeout*/, cos.StrKVs{"width": "448"})
+			Expect(err).NotTo(HaveOccurred())
+			b, err = cos.ReadAll(r)
+			Expect(err).NotTo(HaveOccurred())
+			r.Close()
+			Expect(b).To(Equal(transformData))
+			Expect(md).To(Equal(cos.StrKVs{"label": "cat", cos.HdrContentType: "image/jpeg"}))
+			Expect(gotWidth).To(Equal("448"))
 		})
 	}
 })
@@ -6,6 +6,7 @@ package etl
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -29,6 +31,13 @@ type (
 		ObjCount() int64
 		InBytes() int64
 		OutBytes() int64
+		// inline transforms
+		ErrCount() int64
+		AvgLatency() time.Duration
+		// circuit breaker state (see breaker.go)
+		Breaker() string
+		// pod health and the number of pod restarts (see health.go)
+		PodHealth() (string, int64)
 	}
 
 	// Communicator is responsible for managing communications with local ETL container.
@@ -46,7 +55,11 @@ type (
 		// InlineTransform uses one of the two ETL container endpoints:
 		//  - Method "PUT", Path "/"
 		//  - Method "GET", Path "/bucket/object"
+		// User-specified (per-request) parameters, if any, are passed to the container
+		// as query parameters - see reqParams below
 		InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM) error
+		// account for a completed inline transform (for per-ETL error and latency stats)
+		InlineDone(latency time.Duration, err error)
 
 		// OfflineTransform is driven by `OfflineDP` to provide offline transformation, as it were
 		// Implementations include:
@@ -54,7 +67,10 @@ type (
 		// - redirectComm
 		// - revProxyComm
 		// See also, and separately: on-the-fly transformation as part of a user (e.g. training model) GET request handling
-		OfflineTransform(lom *core.LOM, timeout time.Duration) (cos.ReadCloseSizer, error)
+		// In addition to the transformed bytes, returns custom metadata (if any) that the ETL container
+		// wants to be stored with the transformed object - see `objMD` below.
+		// `params` are the job's transformer parameters (apc.Transform.Params), if any.
+		OfflineTransform(lom *core.LOM, timeout time.Duration, params cos.StrKVs) (cos.ReadCloseSizer, cos.StrKVs, error)
 
 		Stop()
 
@@ -64,6 +80,12 @@ type (
 	baseComm struct {
 		listener meta.Slistener
 		boot     *etlBootstrapper
+		brk      *breaker      // see breaker.go
+		timeout  time.Duration // request timeout
+		inline   struct {
+			cnt, errs atomic.Int64
+			latency   atomic.Int64 // total
+		}
 	}
 	pushComm struct {
 		baseComm
@@ -82,8 +104,14 @@ type (
 		w       io.Writer
 		writeCb func(int)
 	}
+
+	// (reverse proxy => revProxyComm.InlineTransform)
+	rpErrKey struct{}
 )
 
+// system-reserved custom metadata that ETL container cannot override
+var reservedMD = []string{cmn.SourceObjMD, cmn.VersionObjMD, cmn.CRC32CObjMD, cmn.MD5ObjMD, cmn.ETag, cmn.OrigURLObjMD, cmn.LastModified}
+
 // interface guard
 var (
 	_ Communicator = (*pushComm)(nil)
@@ -101,18 +129,18 @@ func newCommunicator(listener meta.Slistener, boot *etlBootstrapper) Communicato
 	switch boot.msg.CommTypeX {
 	case Hpush, HpushStdin:
 		pc := &pushComm{}
-		pc.listener, pc.boot = listener, boot
+		pc.init(listener, boot)
 		if boot.msg.CommTypeX == HpushStdin { // io://
 			pc.command = boot.originalCommand
 		}
 		return pc
 	case Hpull:
 		rc := &redirectComm{}
-		rc.listener, rc.boot = listener, boot
+		rc.init(listener, boot)
 		return rc
 	case Hrev:
 		rp := &revProxyComm{}
-		rp.listener, rp.boot = listener, boot
+		rp.init(listener, boot)
 
 		transformerURL, err := url.Parse(boot.uri)
 		debug.AssertNoErr(err)
@@ -121,12 +149,18 @@ func newCommunicator(listener meta.Slistener, boot *etlBootstrapper) Communicato
 				// Replacing the `req.URL` host with ETL container host
 				req.URL.Scheme = transformerURL.Scheme
 				req.URL.Host = transformerURL.Host
-				req.URL.RawQuery = pruneQuery(req.URL.RawQuery)
+				req.URL.RawQuery = pruneQuery(req.URL.RawQuery, reqParams(req))
 				if _, ok := req.Header["User-Agent"]; !ok {
 					// Explicitly disable `User-Agent` so it's not set to default value.
 					req.Header.Set("User-Agent", "")
 				}
 			},
+			// NOTE: not writing the response - the caller does (see revProxyComm.InlineTransform)
+			ErrorHandler: func(_ http.ResponseWriter, req *http.Request, err error) {
+				if perr, ok := req.Context().Value(rpErrKey{}).(*error); ok {
+					*perr = podErr(err)
+				}
+			},
 		}
 		rp.rp = revProxy
 		return rp
@@ -136,6 +170,12 @@ func newCommunicator(listener meta.Slistener, boot *etlBootstrapper) Communicato
 	return nil
 }
 
+func (c *baseComm) init(listener meta.Slistener, boot *etlBootstrapper) {
+	c.listener, c.boot = listener, boot
+	c.brk = newBreaker(boot.msg.IDX, boot.msg.MaxFails, boot.mon.restart)
+	c.timeout = boot.msg.reqTimeout()
+}
+
 func (c *baseComm) Name() string    { return c.boot.originalPodName }
 func (c *baseComm) PodName() string { return c.boot.pod.Name }
 func (c *baseComm) SvcName() string { return c.boot.pod.Name /*same as pod name*/ }
@@ -151,11 +191,44 @@ func (c *baseComm) ObjCount() int64 { return c.boot.xctn.Objs() }
 func (c *baseComm) InBytes() int64  { return c.boot.xctn.InBytes() }
 func (c *baseComm) OutBytes() int64 { return c.boot.xctn.OutBytes() }
 
-func (c *baseComm) Stop() { c.boot.xctn.Finish() }
+func (c *baseComm) ErrCount() int64 { return c.inline.errs.Load() }
+
+func (c *baseComm) AvgLatency() time.Duration {
+	cnt := c.inline.cnt.Load()
+	if cnt == 0 {
+		return 0
+	}
+	return time.Duration(c.inline.latency.Load() / cnt)
+}
+
+func (c *baseComm) Breaker() string {
+	state, _ := c.brk.status()
+	return state
+}
+
+func (c *baseComm) PodHealth() (string, int64) { return c.boot.mon.status() }
+
+func (c *baseComm) InlineDone(latency time.Duration, err error) {
+	c.inlineStats(latency, err)
+	c.brk.done(err)
+}
+
+func (c *baseComm) inlineStats(latency time.Duration, err error) {
+	c.inline.cnt.Inc()
+	c.inline.latency.Add(int64(latency))
+	if err != nil {
+		c.inline.errs.Inc()
+	}
+}
+
+func (c *baseComm) Stop() {
+	c.boot.mon.stop()
+	c.boot.xctn.Finish()
+}
 
-func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
+func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration) (r cos.ReadCloseSizer, md cos.StrKVs, err error) {
 	if err := c.boot.xctn.AbortErr(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var (
@@ -163,6 +236,9 @@ func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration)
 		resp   *http.Response
 		cancel func()
 	)
+	if timeout == 0 {
+		timeout = c.timeout
+	}
 	if timeout != 0 {
 		var ctx context.Context
 		ctx, cancel = context.WithTimeout(context.Background(), timeout)
@@ -172,12 +248,18 @@ func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration)
 	}
 	if err == nil {
 		resp, err = core.T.DataClient().Do(req) //nolint:bodyclose // Closed by the caller.
+		switch {
+		case err != nil:
+			err = podErr(err)
+		case resp.StatusCode >= http.StatusInternalServerError:
+			err = respErr(resp)
+		}
 	}
 	if err != nil {
 		if cancel != nil {
 			cancel()
 		}
-		return nil, err
+		return nil, nil, err
 	}
 
 	return cos.NewReaderWithArgs(cos.ReaderArgs{
@@ -191,36 +273,36 @@ func (c *baseComm) getWithTimeout(url string, size int64, timeout time.Duration)
 			c.boot.xctn.InObjsAdd(1, 0)
 			c.boot.xctn.OutObjsAdd(1, size) // see also: `coi.objsAdd`
 		},
-	}), nil
+	}), objMD(resp.Header), nil
 }
 
 //////////////
 // pushComm: implements (Hpush | HpushStdin)
 //////////////
 
-func (pc *pushComm) doRequest(lom *core.LOM, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
+func (pc *pushComm) doRequest(lom *core.LOM, timeout time.Duration, params cos.StrKVs) (r cos.ReadCloseSizer, hdr http.Header, err error) {
 	if err := lom.InitBck(lom.Bucket()); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var ecode int
 	lom.Lock(false)
-	r, ecode, err = pc.do(lom, timeout)
+	r, hdr, ecode, err = pc.do(lom, timeout, params)
 	lom.Unlock(false)
 
 	if err != nil && cos.IsNotExist(err, ecode) && lom.Bucket().IsRemote() {
 		_, err = core.T.GetCold(context.Background(), lom, cmn.OwtGetLock)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		lom.Lock(false)
-		r, _, err = pc.do(lom, timeout)
+		r, hdr, _, err = pc.do(lom, timeout, params)
 		lom.Unlock(false)
 	}
 	return
 }
 
-func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSizer, ecode int, err error) {
+func (pc *pushComm) do(lom *core.LOM, timeout time.Duration, params cos.StrKVs) (_ cos.ReadCloseSizer, _ http.Header, ecode int, err error) {
 	var (
 		body   io.ReadCloser
 		cancel func()
@@ -229,10 +311,10 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 		u      string
 	)
 	if err := pc.boot.xctn.AbortErr(); err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 	size := lom.Lsize()
 
@@ -246,7 +328,7 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 
 		fh, err := cos.NewFileHandle(lom.FQN)
 		if err != nil {
-			return nil, 0, err
+			return nil, nil, 0, err
 		}
 		body = fh
 	case ArgTypeFQN:
@@ -268,10 +350,15 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 		goto finish
 	}
 
-	if len(pc.command) != 0 {
-		// HpushStdin case
+	if len(pc.command) != 0 || len(params) != 0 {
 		q := req.URL.Query()
-		q["command"] = []string{"bash", "-c", strings.Join(pc.command, " ")}
+		if len(pc.command) != 0 {
+			// HpushStdin case
+			q["command"] = []string{"bash", "-c", strings.Join(pc.command, " ")}
+		}
+		for k, v := range params {
+			q.Set(k, v)
+		}
 		req.URL.RawQuery = q.Encode()
 	}
 	req.ContentLength = size
@@ -281,6 +368,12 @@ func (pc *pushComm) do(lom *core.LOM, timeout time.Duration) (_ cos.ReadCloseSiz
 	// Do it
 	//
 	resp, err = core.T.DataClient().Do(req) //nolint:bodyclose // Closed by the caller.
+	switch {
+	case err != nil:
+		err = podErr(err)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		err = respErr(resp)
+	}
 
 finish:
 	if err != nil {
@@ -290,7 +383,7 @@ finish:
 		if resp != nil {
 			ecode = resp.StatusCode
 		}
-		return nil, ecode, err
+		return nil, nil, ecode, err
 	}
 	args := cos.ReaderArgs{
 		R:      resp.Body,
@@ -304,14 +397,22 @@ finish:
 			pc.boot.xctn.OutObjsAdd(1, size) // see also: `coi.objsAdd`
 		},
 	}
-	return cos.NewReaderWithArgs(args), 0, nil
+	return cos.NewReaderWithArgs(args), resp.Header, 0, nil
 }
 
-func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, lom *core.LOM) error {
-	r, err := pc.doRequest(lom, 0 /*timeout*/)
+func (pc *pushComm) InlineTransform(w http.ResponseWriter, req *http.Request, lom *core.LOM) error {
+	if err := pc.brk.allow(); err != nil {
+		return err
+	}
+	r, hdr, err := pc.doRequest(lom, pc.timeout, reqParams(req))
 	if err != nil {
 		return err
 	}
+	// pass the container's object metadata (if any) to the caller, as is
+	// (compare with hpull and hrev where the container responds directly)
+	if vals := hdr.Values(apc.HdrObjCustomMD); len(vals) > 0 {
+		w.Header()[http.CanonicalHeaderKey(apc.HdrObjCustomMD)] = vals
+	}
 	if cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hpush, lom.Cname(), err)
 	}
@@ -322,19 +423,32 @@ func (pc *pushComm) InlineTransform(w http.ResponseWriter, _ *http.Request, lom
 	}
 	buf, slab := core.T.PageMM().AllocSize(size)
 	_, err = io.CopyBuffer(w, r, buf)
+	if err != nil && errors.Is(err, context.DeadlineExceeded) {
+		err = podErr(err)
+	}
 
 	slab.Free(buf)
 	r.Close()
 	return err
 }
 
-func (pc *pushComm) OfflineTransform(lom *core.LOM, timeout time.Duration) (r cos.ReadCloseSizer, err error) {
+func (pc *pushComm) OfflineTransform(lom *core.LOM, timeout time.Duration, params cos.StrKVs) (cos.ReadCloseSizer, cos.StrKVs, error) {
+	if err := pc.brk.allow(); err != nil {
+		return nil, nil, err
+	}
+	if timeout == 0 {
+		timeout = pc.timeout
+	}
 	clone := *lom
-	r, err = pc.doRequest(&clone, timeout)
-	if err == nil && cmn.Rom.FastV(5, cos.SmoduleETL) {
-		nlog.Infoln(Hpush, clone.Cname(), err)
+	r, hdr, err := pc.doRequest(&clone, timeout, params)
+	pc.brk.done(err)
+	if err != nil {
+		return nil, nil, err
 	}
-	return
+	if cmn.Rom.FastV(5, cos.SmoduleETL) {
+		nlog.Infoln(Hpush, clone.Cname())
+	}
+	return r, objMD(hdr), nil
 }
 
 //////////////////
@@ -345,6 +459,9 @@ func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request,
 	if err := rc.boot.xctn.AbortErr(); err != nil {
 		return err
 	}
+	if err := rc.brk.allow(); err != nil {
+		return err
+	}
 	size, err := lomLoad(lom)
 	if err != nil {
 		return err
@@ -352,7 +469,7 @@ func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request,
 	if size > 0 {
 		rc.boot.xctn.OutObjsAdd(1, size)
 	}
-	http.Redirect(w, r, rc.redirectURL(lom), http.StatusTemporaryRedirect)
+	http.Redirect(w, r, rc.redirectURL(lom, reqParams(r)), http.StatusTemporaryRedirect)
 
 	if cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hpull, lom.Cname())
@@ -360,31 +477,44 @@ func (rc *redirectComm) InlineTransform(w http.ResponseWriter, r *http.Request,
 	return nil
 }
 
-func (rc *redirectComm) redirectURL(lom *core.LOM) string {
+// the client gets redirected to the pod - nothing to account for, breaker-wise
+func (rc *redirectComm) InlineDone(latency time.Duration, err error) {
+	rc.inlineStats(latency, err)
+	if !IsErrUnavailable(err) {
+		rc.brk.skip()
+	}
+}
+
+func (rc *redirectComm) redirectURL(lom *core.LOM, params cos.StrKVs) string {
 	switch rc.boot.msg.ArgTypeX {
 	case ArgTypeDefault, ArgTypeURL:
-		return cos.JoinPath(rc.boot.uri, transformerPath(lom))
+		return withParams(cos.JoinPath(rc.boot.uri, transformerPath(lom)), params)
 	case ArgTypeFQN:
-		return cos.JoinPath(rc.boot.uri, url.PathEscape(lom.FQN))
+		return withParams(cos.JoinPath(rc.boot.uri, url.PathEscape(lom.FQN)), params)
 	}
 	cos.Assert(false) // is validated at construction time
 	return ""
 }
 
-func (rc *redirectComm) OfflineTransform(lom *core.LOM, timeout time.Duration) (cos.ReadCloseSizer, error) {
+func (rc *redirectComm) OfflineTransform(lom *core.LOM, timeout time.Duration, params cos.StrKVs) (cos.ReadCloseSizer, cos.StrKVs, error) {
+	if err := rc.brk.allow(); err != nil {
+		return nil, nil, err
+	}
 	clone := *lom
 	size, errV := lomLoad(&clone)
 	if errV != nil {
-		return nil, errV
+		rc.brk.done(errV)
+		return nil, nil, errV
 	}
 
-	etlURL := rc.redirectURL(&clone)
-	r, err := rc.getWithTimeout(etlURL, size, timeout)
+	etlURL := rc.redirectURL(&clone, params)
+	r, md, err := rc.getWithTimeout(etlURL, size, timeout)
+	rc.brk.done(err)
 
 	if cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hpull, clone.Cname(), err)
 	}
-	return r, err
+	return r, md, err
 }
 
 //////////////////
@@ -392,6 +522,9 @@ func (rc *redirectComm) OfflineTransform(lom *core.LOM, timeout time.Duration) (
 //////////////////
 
 func (rp *revProxyComm) InlineTransform(w http.ResponseWriter, r *http.Request, lom *core.LOM) error {
+	if err := rp.brk.allow(); err != nil {
+		return err
+	}
 	size, err := lomLoad(lom)
 	if err != nil {
 		return err
@@ -403,24 +536,33 @@ func (rp *revProxyComm) InlineTransform(w http.ResponseWriter, r *http.Request,
 
 	r.URL.Path, _ = url.PathUnescape(path) // `Path` must be unescaped otherwise it will be escaped again.
 	r.URL.RawPath = path                   // `RawPath` should be escaped version of `Path`.
-	rp.rp.ServeHTTP(w, r)
 
-	return nil
+	// enforce request timeout and capture transport error (if any) - see ErrorHandler
+	ctx, cancel := context.WithTimeout(context.WithValue(r.Context(), rpErrKey{}, &err), rp.timeout)
+	rp.rp.ServeHTTP(w, r.WithContext(ctx))
+	cancel()
+
+	return err
 }
 
-func (rp *revProxyComm) OfflineTransform(lom *core.LOM, timeout time.Duration) (cos.ReadCloseSizer, error) {
+func (rp *revProxyComm) OfflineTransform(lom *core.LOM, timeout time.Duration, params cos.StrKVs) (cos.ReadCloseSizer, cos.StrKVs, error) {
+	if err := rp.brk.allow(); err != nil {
+		return nil, nil, err
+	}
 	clone := *lom
 	size, errV := lomLoad(&clone)
 	if errV != nil {
-		return nil, errV
+		rp.brk.done(errV)
+		return nil, nil, errV
 	}
-	etlURL := cos.JoinPath(rp.boot.uri, transformerPath(&clone))
-	r, err := rp.getWithTimeout(etlURL, size, timeout)
+	etlURL := withParams(cos.JoinPath(rp.boot.uri, transformerPath(&clone)), params)
+	r, md, err := rp.getWithTimeout(etlURL, size, timeout)
+	rp.brk.done(err)
 
 	if cmn.Rom.FastV(5, cos.SmoduleETL) {
 		nlog.Infoln(Hrev, clone.Cname(), err)
 	}
-	return r, err
+	return r, md, err
 }
 
 //////////////
@@ -438,8 +580,9 @@ func (cw *cbWriter) Write(b []byte) (n int, err error) {
 //
 
 // prune query (received from AIS proxy) prior to reverse-proxying the request to/from container -
-// not removing apc.QparamETLName, for instance, would cause infinite loop.
-func pruneQuery(rawQuery string) string {
+// not removing apc.QparamETLName, for instance, would cause infinite loop;
+// user-specified ETL parameters are passed on without the prefix
+func pruneQuery(rawQuery string, params cos.StrKVs) string {
 	vals, err := url.ParseQuery(rawQuery)
 	if err != nil {
 		nlog.Errorf("failed to parse raw query %q, err: %v", rawQuery, err)
@@ -448,9 +591,55 @@ func pruneQuery(rawQuery string) string {
 	for _, filtered := range []string{apc.QparamETLName, apc.QparamProxyID, apc.QparamUnixTime} {
 		vals.Del(filtered)
 	}
+	for k := range vals {
+		if strings.HasPrefix(k, apc.QparamETLParamPrefix) {
+			vals.Del(k)
+		}
+	}
+	for k, v := range params {
+		vals.Set(k, v)
+	}
 	return vals.Encode()
 }
 
+// user-specified (per-request) transformer parameters, if any:
+//   - query parameters prefixed with apc.QparamETLParamPrefix (e.g., "etl.width=224");
+//   - headers prefixed with apc.HdrETLParamPrefix (e.g., "Ais-Etl-Param-Width: 224"),
+//     in which case the parameter name gets lowercased
+func reqParams(r *http.Request) (params cos.StrKVs) {
+	for k, vals := range r.URL.Query() {
+		if name, ok := strings.CutPrefix(k, apc.QparamETLParamPrefix); ok && name != "" && len(vals) > 0 {
+			if params == nil {
+				params = make(cos.StrKVs, 4)
+			}
+			params[name] = vals[0]
+		}
+	}
+	for k, vals := range r.Header {
+		if name, ok := strings.CutPrefix(k, apc.HdrETLParamPrefix); ok && name != "" && len(vals) > 0 {
+			if params == nil {
+				params = make(cos.StrKVs, 4)
+			}
+			params[strings.ToLower(name)] = vals[0]
+		}
+	}
+	return params
+}
+
+func withParams(u string, params cos.StrKVs) string {
+	if len(params) == 0 {
+		return u
+	}
+	q := make(url.Values, len(params))
+	for k, v := range params {
+		q.Set(k, v)
+	}
+	if strings.IndexByte(u, '?') >= 0 {
+		return u + "&" + q.Encode()
+	}
+	return u + "?" + q.Encode()
+}
+
 // TODO -- FIXME: unify the way we encode bucket/object:
 // - url.PathEscape(uname) - see below - versus
 // - Bck().Name + "/" + lom.ObjName - see pushComm above - versus
@@ -459,6 +648,35 @@ func transformerPath(lom *core.LOM) string {
 	return "/" + url.PathEscape(lom.Uname())
 }
 
+// Object metadata returned by ETL container along with the transformed bytes:
+// zero or more `apc.HdrObjCustomMD` response headers, each in the form "key=value", e.g.:
+//   - "Ais-Custom-Md: label=cat"
+//   - "Ais-Custom-Md: Content-Type=image/jpeg"
+//
+// Malformed entries, as well as system-reserved keys (checksums, version, source, etc.)
+// are silently ignored.
+func objMD(hdr http.Header) (md cos.StrKVs) {
+	for _, v := range hdr.Values(apc.HdrObjCustomMD) {
+		k, val, ok := strings.Cut(v, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" || cos.StringInSlice(k, reservedMD) {
+			continue
+		}
+		if md == nil {
+			md = make(cos.StrKVs, 4)
+		}
+		md[k] = strings.TrimSpace(val)
+	}
+	return md
+}
+
+// 5xx response from the pod
+func respErr(resp *http.Response) error {
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	cos.Close(resp.Body)
+	return &errPod{fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(b)))}
+}
+
 func lomLoad(lom *core.LOM) (size int64, err error) {
 	if err = lom.Load(true /*cacheIt*/, false /*locked*/); err != nil {
 		if cos.IsNotExist(err, 0) && lom.Bucket().IsRemote() {
@@ -21,35 +21,44 @@ type (
 	OfflineDP struct {
 		comm           Communicator
 		tcbmsg         *apc.TCBMsg
+		params         cos.StrKVs // transformer parameters (apc.Transform.Params)
 		config         *cmn.Config
 		requestTimeout time.Duration
 	}
 )
 
 // interface guard
-var _ core.DP = (*OfflineDP)(nil)
+var _ core.ParamDP = (*OfflineDP)(nil)
 
 func NewOfflineDP(msg *apc.TCBMsg, config *cmn.Config) (*OfflineDP, error) {
 	comm, err := GetCommunicator(msg.Transform.Name)
 	if err != nil {
 		return nil, err
 	}
-	pr := &OfflineDP{comm: comm, tcbmsg: msg, config: config}
+	pr := &OfflineDP{comm: comm, tcbmsg: msg, params: msg.Transform.Params, config: config}
 	pr.requestTimeout = time.Duration(msg.Transform.Timeout)
 	return pr, nil
 }
 
+// (core.ParamDP) same ETL, different transformer parameters
+func (dp *OfflineDP) WithParams(params cos.StrKVs) core.DP {
+	clone := *dp
+	clone.params = params
+	return &clone
+}
+
 // Returns reader resulting from lom ETL transformation.
 // TODO -- FIXME: comm.OfflineTransform to support latestVer and sync
 func (dp *OfflineDP) Reader(lom *core.LOM, latestVer, sync bool) (cos.ReadOpenCloser, cos.OAH, error) {
 	var (
 		r      cos.ReadCloseSizer // note: +sizer
+		md     cos.StrKVs
 		err    error
 		action = "read [" + dp.tcbmsg.Transform.Name + "]-transformed " + lom.Cname()
 	)
 	debug.Assert(!latestVer && !sync, "NIY") // TODO -- FIXME
 	call := func() (int, error) {
-		r, err = dp.comm.OfflineTransform(lom, dp.requestTimeout)
+		r, md, err = dp.comm.OfflineTransform(lom, dp.requestTimeout, dp.params)
 		return 0, err
 	}
 	// TODO: Check if ETL pod is healthy and wait some more if not (yet).
@@ -70,10 +79,11 @@ func (dp *OfflineDP) Reader(lom *core.LOM, latestVer, sync bool) (cos.ReadOpenCl
 	}
 	lom.SetAtimeUnix(time.Now().UnixNano())
 	oah := &cmn.ObjAttrs{
-		Size:  r.Size(),
-		Ver:   nil,           // NOTE: transformed object - current version does not apply
-		Cksum: cos.NoneCksum, // TODO: checksum
-		Atime: lom.AtimeUnix(),
+		Size:     r.Size(),
+		Ver:      nil,           // NOTE: transformed object - current version does not apply
+		Cksum:    cos.NoneCksum, // TODO: checksum
+		Atime:    lom.AtimeUnix(),
+		CustomMD: md, // (as returned by ETL container - to store with the transformed object)
 	}
 	return cos.NopOpener(r), oah, nil
 }
@@ -0,0 +1,223 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/k8s"
+	"github.com/NVIDIA/aistore/core"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Dry run (validation only), e.g. `ais etl init spec --dry-run`:
+// - proxy validates the message (including pod spec linting), checks the ETL name
+//   for collisions with the existing ETLs, and validates GPU placement (if requested);
+// - proxy then broadcasts the (validated) message to all targets, and each target:
+//   * prepares - but does not create - its pod and service, exactly as it'd do upon init,
+//   * checks comm-type and arg-type compatibility with the resulting pod,
+//   * checks container images: reference syntax, pull policy, and presence on the node,
+//   * checks for collisions with the ETL pod and communicator that may already exist;
+// - all the findings are returned in a single `DryRunResult`; nothing gets started.
+
+// enum DryRunIssue.Check
+const (
+	DryRunCheckSpec  = "spec"
+	DryRunCheckName  = "name"
+	DryRunCheckComm  = "comm-type"
+	DryRunCheckImage = "image"
+	DryRunCheckGPUs  = "gpus"
+	DryRunCheckNode  = "target"
+)
+
+type (
+	DryRunIssue struct {
+		Check    string `json:"check"`               // enum DryRunCheck* above
+		TargetID string `json:"target_id,omitempty"` // empty when found by proxy
+		Msg      string `json:"msg"`
+		Warning  bool   `json:"warning,omitempty"` // (won't prevent ETL from starting)
+	}
+	DryRunIssues []DryRunIssue
+
+	DryRunResult struct {
+		Name       string       `json:"id"`
+		Issues     DryRunIssues `json:"issues,omitempty"`
+		NumTargets int          `json:"num_targets"` // number of targets that performed the checks
+	}
+)
+
+// (see https://github.com/distribution/reference: simplified, lowercase-only)
+var imageRefRegex = regexp.MustCompile(
+	`^[a-z0-9]+([._-][a-z0-9]+)*(:[0-9]+)?(/[a-z0-9]+([._-]+[a-z0-9]+)*)*(:[\w][\w.-]{0,127})?(@sha256:[a-f0-9]{64})?$`)
+
+//////////////////
+// DryRunIssues //
+//////////////////
+
+func (issues DryRunIssues) add(check, tid string, err error) DryRunIssues {
+	return append(issues, DryRunIssue{Check: check, TargetID: tid, Msg: err.Error()})
+}
+
+func (issues DryRunIssues) warn(check, tid, msg string) DryRunIssues {
+	return append(issues, DryRunIssue{Check: check, TargetID: tid, Msg: msg, Warning: true})
+}
+
+//////////////////
+// DryRunResult //
+//////////////////
+
+func (res *DryRunResult) Add(check, tid string, err error) {
+	res.Issues = res.Issues.add(check, tid, err)
+}
+
+func (res *DryRunResult) NumErrs() (n int) {
+	for i := range res.Issues {
+		if !res.Issues[i].Warning {
+			n++
+		}
+	}
+	return n
+}
+
+// DryRun is executed by each target to validate a given ETL against the target's
+// K8s environment, without creating any K8s entities
+func DryRun(initMsg InitMsg) (issues DryRunIssues) {
+	var (
+		msg  *InitSpecMsg
+		opts StartOpts
+		tid  = core.T.SID()
+	)
+	switch m := initMsg.(type) {
+	case *InitSpecMsg:
+		msg = m
+	case *InitCodeMsg:
+		msg, opts = codeToSpec(m)
+	default:
+		debug.Assert(false, initMsg.String())
+		return nil
+	}
+	if _, exists := reg.get(msg.IDX); exists {
+		issues = issues.add(DryRunCheckName, tid, fmt.Errorf("etl[%s] is already running", msg.IDX))
+	}
+
+	errCtx := &cmn.ETLErrCtx{TID: tid, ETLName: msg.IDX}
+	boot := &etlBootstrapper{errCtx: errCtx, config: cmn.GCO.Get(), env: opts.Env}
+	boot.msg = *msg
+	if err := boot.createPodSpec(); err != nil {
+		return issues.add(DryRunCheckSpec, tid, err)
+	}
+	boot.createServiceSpec()
+
+	if err := boot.checkComm(); err != nil {
+		issues = issues.add(DryRunCheckComm, tid, err)
+	}
+
+	client, err := k8s.GetClient()
+	if err != nil {
+		return issues.add(DryRunCheckNode, tid, err)
+	}
+	issues = boot.checkImages(client, issues)
+
+	if exists, err := client.CheckExists(k8s.Pod, boot.pod.Name); err == nil && exists {
+		issues = issues.warn(DryRunCheckName, tid, fmt.Sprintf("pod %q already exists (to be replaced)", boot.pod.Name))
+	}
+	return issues
+}
+
+// comm-type (and arg-type) vs prepared pod
+func (b *etlBootstrapper) checkComm() error {
+	c := &b.pod.Spec.Containers[0]
+	if b.msg.CommTypeX == HpushStdin && len(b.originalCommand) == 0 {
+		return fmt.Errorf("comm-type %q requires container command (to run via %q)", HpushStdin, c.Command)
+	}
+	for _, env := range c.Env {
+		if env.Name == "COMM_TYPE" && env.Value != "" && env.Value != b.msg.CommTypeX {
+			return fmt.Errorf("container environment specifies COMM_TYPE %q, while the requested comm-type is %q",
+				env.Value, b.msg.CommTypeX)
+		}
+	}
+	if b.msg.ArgTypeX == ArgTypeFQN && b.node != k8s.NodeName {
+		return fmt.Errorf("arg-type %q requires ETL pod to run on the target's node %q (selected GPU node %q)",
+			ArgTypeFQN, k8s.NodeName, b.node)
+	}
+	return nil
+}
+
+func (b *etlBootstrapper) checkImages(client k8s.Client, issues DryRunIssues) DryRunIssues {
+	var (
+		tid    = core.T.SID()
+		cached map[string]struct{}
+	)
+	if node, err := client.Node(b.node); err != nil {
+		issues = issues.warn(DryRunCheckImage, tid, fmt.Sprintf("failed to get node %q: %v", b.node, err))
+	} else {
+		cached = make(map[string]struct{}, 16)
+		for _, img := range node.Status.Images {
+			for _, name := range img.Names {
+				cached[name] = struct{}{}
+			}
+		}
+	}
+	containers := make([]*corev1.Container, 0, len(b.pod.Spec.InitContainers)+1)
+	for i := range b.pod.Spec.InitContainers {
+		containers = append(containers, &b.pod.Spec.InitContainers[i])
+	}
+	containers = append(containers, &b.pod.Spec.Containers[0])
+
+	for _, c := range containers {
+		if c.Image == "" {
+			issues = issues.add(DryRunCheckImage, tid, fmt.Errorf("container %q: image is not specified", c.Name))
+			continue
+		}
+		if !imageRefRegex.MatchString(c.Image) {
+			issues = issues.add(DryRunCheckImage, tid, fmt.Errorf("container %q: invalid image reference %q", c.Name, c.Image))
+			continue
+		}
+		if cached == nil {
+			continue
+		}
+		_, present := cached[c.Image]
+		if !present {
+			_, present = cached[normImage(c.Image)]
+		}
+		switch {
+		case present:
+		case c.ImagePullPolicy == corev1.PullNever:
+			issues = issues.add(DryRunCheckImage, tid,
+				fmt.Errorf("container %q: image %q is not present on node %q (and pull policy is %q)",
+					c.Name, c.Image, b.node, corev1.PullNever))
+		default:
+			issues = issues.warn(DryRunCheckImage, tid,
+				fmt.Sprintf("container %q: image %q is not present on node %q and will be pulled", c.Name, c.Image, b.node))
+		}
+	}
+	return issues
+}
+
+// fully-qualified image name as reported by the container runtime, e.g.:
+// "busybox" => "docker.io/library/busybox:latest"
+func normImage(image string) string {
+	name, tag := image, ""
+	if i := strings.IndexByte(image, '@'); i > 0 {
+		name, tag = image[:i], image[i:]
+	} else if i := strings.LastIndexByte(image, ':'); i > strings.LastIndexByte(image, '/') {
+		name, tag = image[:i], image[i:]
+	}
+	if tag == "" {
+		tag = ":latest"
+	}
+	domain, _, found := strings.Cut(name, "/")
+	switch {
+	case !found:
+		name = "docker.io/library/" + name
+	case !strings.ContainsAny(domain, ".:") && domain != "localhost":
+		name = "docker.io/" + name
+	}
+	return name + tag
+}
@@ -0,0 +1,45 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DryRunTest", func() {
+	DescribeTable("image reference",
+		func(image string, valid bool) {
+			Expect(imageRefRegex.MatchString(image)).To(Equal(valid))
+		},
+		Entry("name", "busybox", true),
+		Entry("repo and tag", "aistorage/runtime_python:3.11v2", true),
+		Entry("registry with port", "registry.local:5000/etl/md5:v1", true),
+		Entry("digest", "alpine@sha256:"+"0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", true),
+		Entry("uppercase", "AIStorage/md5", false),
+		Entry("whitespace", "aistorage/md5 :v1", false),
+		Entry("empty tag", "aistorage/md5:", false),
+	)
+
+	DescribeTable("normalized image name",
+		func(image, expected string) {
+			Expect(normImage(image)).To(Equal(expected))
+		},
+		Entry("official", "busybox", "docker.io/library/busybox:latest"),
+		Entry("user repo", "aistorage/runtime_python:3.11v2", "docker.io/aistorage/runtime_python:3.11v2"),
+		Entry("registry", "registry.local:5000/etl/md5", "registry.local:5000/etl/md5:latest"),
+		Entry("localhost", "localhost/md5:v1", "localhost/md5:v1"),
+	)
+
+	It("should count errors but not warnings", func() {
+		res := &DryRunResult{Name: "md5"}
+		res.Issues = res.Issues.warn(DryRunCheckImage, "t1", "will be pulled")
+		Expect(res.NumErrs()).To(Equal(0))
+		res.Issues = res.Issues.add(DryRunCheckName, "", errors.New("etl[md5] already exists"))
+		Expect(res.NumErrs()).To(Equal(1))
+	})
+})
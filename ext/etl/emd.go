@@ -20,9 +20,10 @@ type (
 
 	// ETL metadata
 	MD struct {
-		Version int64
-		ETLs    ETLs
-		Ext     any
+		Version  int64
+		ETLs     ETLs
+		Canaries Canaries
+		Ext      any
 	}
 
 	jsonETL struct {
@@ -30,9 +31,10 @@ type (
 		Msg  jsoniter.RawMessage `json:"msg"`
 	}
 	jsonMD struct {
-		Version int64              `json:"version"`
-		ETLs    map[string]jsonETL `json:"etls"`
-		Ext     any                `json:"ext,omitempty"` // within meta-version extensions
+		Version  int64              `json:"version"`
+		ETLs     map[string]jsonETL `json:"etls"`
+		Canaries Canaries           `json:"canaries,omitempty"`
+		Ext      any                `json:"ext,omitempty"` // within meta-version extensions
 	}
 )
 
@@ -79,9 +81,10 @@ func (e *MD) String() string {
 
 func (e *MD) MarshalJSON() ([]byte, error) {
 	jsonMD := jsonMD{
-		Version: e.Version,
-		ETLs:    make(map[string]jsonETL, len(e.ETLs)),
-		Ext:     e.Ext,
+		Version:  e.Version,
+		ETLs:     make(map[string]jsonETL, len(e.ETLs)),
+		Canaries: e.Canaries,
+		Ext:      e.Ext,
 	}
 	for k, v := range e.ETLs {
 		jsonMD.ETLs[k] = jsonETL{v.MsgType(), cos.MustMarshal(v)}
@@ -95,6 +98,7 @@ func (e *MD) UnmarshalJSON(data []byte) (err error) {
 		return
 	}
 	e.Version, e.Ext = jsonMD.Version, jsonMD.Ext
+	e.Canaries = jsonMD.Canaries
 	e.ETLs = make(ETLs, len(jsonMD.ETLs))
 	for k, v := range jsonMD.ETLs {
 		switch v.Type {
@@ -0,0 +1,131 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/k8s"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// GPU scheduling:
+// - InitMsgBase.GPUs (or, equivalently, `nvidia.com/gpu` container limits in the spec)
+//   requests a number of GPUs for each ETL pod - one pod per target;
+// - before accepting the ETL, proxy makes sure (via k8s) that GPU nodes have enough
+//   free GPUs to place all the pods;
+// - GPU nodes are not necessarily the nodes that run targets, so instead of running
+//   next to its target, a GPU-requesting pod gets placed on a GPU node selected round-robin
+//   (by the target's position in the sorted list of active targets), skipping nodes
+//   that do not have enough free GPUs;
+// - the same (deterministic) placement is computed by the proxy (to validate) and
+//   by each target (to place its own pod).
+
+const MaxGPUs = 64 // per ETL pod
+
+// ValidateGPUs checks whether k8s cluster has enough GPUs to run a given ETL
+// (executed by proxy prior to broadcasting ETL init)
+func ValidateGPUs(msg InitMsg, smap *meta.Smap) error {
+	if msg.NumGPUs() == 0 {
+		return nil
+	}
+	_, err := placeGPUs(msg, smap)
+	return err
+}
+
+// select GPU node for the pod of a given target
+func gpuNode(msg InitMsg, smap *meta.Smap, tid string) (string, error) {
+	plan, err := placeGPUs(msg, smap)
+	if err != nil {
+		return "", err
+	}
+	node, ok := plan[tid]
+	if !ok {
+		return "", fmt.Errorf("etl[%s]: %s is not an active target (GPU placement %v)", msg.Name(), smap, plan)
+	}
+	return node, nil
+}
+
+func placeGPUs(msg InitMsg, smap *meta.Smap) (map[string]string, error) {
+	if !k8s.IsK8s() {
+		return nil, k8s.ErrK8sRequired
+	}
+	client, err := k8s.GetClient()
+	if err != nil {
+		return nil, err
+	}
+	// the ETL's own (previously started) pods, if any, are about to be replaced
+	var (
+		tids = activeTIDs(smap)
+		own  = make(cos.StrSet, len(tids))
+	)
+	for _, tid := range tids {
+		own.Set(podName(msg.Name(), tid))
+	}
+	nodes, err := k8s.GPUNodes(client, own)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := PlaceGPUs(nodes, tids, int64(msg.NumGPUs()))
+	if err != nil {
+		return nil, fmt.Errorf("etl[%s]: %v", msg.Name(), err)
+	}
+	return plan, nil
+}
+
+// PlaceGPUs assigns GPU nodes to targets, round-robin; returns target ID => node name
+func PlaceGPUs(nodes []k8s.NodeGPUs, tids []string, gpus int64) (map[string]string, error) {
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no GPU nodes (no nodes with allocatable %q)", k8s.GPUResource)
+	}
+	var (
+		n    = len(nodes)
+		free = make([]int64, n)
+		plan = make(map[string]string, len(tids))
+	)
+	for i := range nodes {
+		free[i] = nodes[i].Free()
+	}
+	for i, tid := range tids {
+		for j := range n {
+			k := (i + j) % n
+			if free[k] >= gpus {
+				plan[tid] = nodes[k].Name
+				free[k] -= gpus
+				break
+			}
+		}
+		if _, ok := plan[tid]; !ok {
+			return nil, fmt.Errorf("insufficient GPUs: need %d GPU(s) for each of the %d targets, free: %s",
+				gpus, len(tids), _freeGPUs(nodes))
+		}
+	}
+	return plan, nil
+}
+
+func _freeGPUs(nodes []k8s.NodeGPUs) string {
+	var sb strings.Builder
+	for i := range nodes {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%s: %d/%d", nodes[i].Name, nodes[i].Free(), nodes[i].Allocatable)
+	}
+	return sb.String()
+}
+
+func activeTIDs(smap *meta.Smap) []string {
+	tids := make([]string, 0, len(smap.Tmap))
+	for tid, tsi := range smap.Tmap {
+		if !tsi.InMaintOrDecomm() {
+			tids = append(tids, tid)
+		}
+	}
+	sort.Strings(tids)
+	return tids
+}
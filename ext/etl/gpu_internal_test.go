@@ -0,0 +1,50 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"github.com/NVIDIA/aistore/cmn/k8s"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GPUPlacementTest", func() {
+	tids := []string{"t1", "t2", "t3", "t4"}
+
+	It("should place round-robin", func() {
+		nodes := []k8s.NodeGPUs{
+			{Name: "gpu-a", Allocatable: 8},
+			{Name: "gpu-b", Allocatable: 8},
+		}
+		plan, err := PlaceGPUs(nodes, tids, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan).To(Equal(map[string]string{"t1": "gpu-a", "t2": "gpu-b", "t3": "gpu-a", "t4": "gpu-b"}))
+	})
+
+	It("should skip nodes without enough free GPUs", func() {
+		nodes := []k8s.NodeGPUs{
+			{Name: "gpu-a", Allocatable: 8, Allocated: 7},
+			{Name: "gpu-b", Allocatable: 8},
+		}
+		plan, err := PlaceGPUs(nodes, tids, 2)
+		Expect(err).NotTo(HaveOccurred())
+		for _, tid := range tids {
+			Expect(plan[tid]).To(Equal("gpu-b"))
+		}
+	})
+
+	It("should fail when out of GPUs", func() {
+		nodes := []k8s.NodeGPUs{
+			{Name: "gpu-a", Allocatable: 4, Allocated: 1},
+			{Name: "gpu-b", Allocatable: 4},
+		}
+		_, err := PlaceGPUs(nodes, tids, 2)
+		Expect(err).To(HaveOccurred())
+
+		_, err = PlaceGPUs(nil, tids, 1)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,252 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/k8s"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Pod health monitoring and restart policy (per ETL, per target):
+// - the owning target probes its pod every probeInterval:
+//   liveness  - pod phase and container states (via k8s API), and
+//   readiness - TCP connect to the pod's (host, port);
+// - a crashed pod (failed liveness) gets restarted right away; a pod that fails probeMaxFails
+//   consecutive readiness probes gets restarted as well;
+// - restarts triggered by the circuit breaker (see breaker.go) go through the same policy;
+// - consecutive restarts back off exponentially: restartBackoff, 2*restartBackoff, ... up to
+//   maxRestartBackoff; a pod that stays up for restartStable resets the count;
+// - upon `InitMsgBase.MaxRestarts` (DefaultMaxRestarts) consecutive restarts the target gives up:
+//   aborts the ETL locally and asks the primary to abort it cluster-wide (see AbortClusterWide) -
+//   rather than leave some targets with a working ETL and some without;
+// - negative MaxRestarts means restarting indefinitely.
+
+// enum pod health (see Info.Health)
+const (
+	PodHealthy    = "healthy"
+	PodUnhealthy  = "unhealthy"
+	PodRestarting = "restarting"
+)
+
+const (
+	DefaultMaxRestarts = 5
+
+	probeInterval     = 10 * time.Second
+	probeTimeout      = 5 * time.Second
+	probeMaxFails     = 3
+	restartBackoff    = 2 * time.Second
+	maxRestartBackoff = 2 * time.Minute
+	restartStable     = 5 * time.Minute
+)
+
+// set by the target: ask primary to abort a given ETL on all targets
+var AbortClusterWide func(etlName string, cause error) error
+
+type podMonitor struct {
+	boot     *etlBootstrapper
+	stopCh   *cos.StopCh
+	lastErr  error // most recent failure that caused restart
+	started  int64 // mono time: the most recent (re)start
+	restarts atomic.Int64
+	health   atomic.Int32 // index in podHealth
+	consec   int          // consecutive restarts
+	maxRsts  int
+	fails    int // consecutive failed readiness probes
+	mu       sync.Mutex
+	aborted  bool
+}
+
+// (podMonitor.health)
+const (
+	phHealthy int32 = iota
+	phUnhealthy
+	phRestarting
+)
+
+var podHealth = [...]string{PodHealthy, PodUnhealthy, PodRestarting}
+
+func newPodMonitor(boot *etlBootstrapper) *podMonitor {
+	pm := &podMonitor{boot: boot, stopCh: cos.NewStopCh(), maxRsts: boot.msg.MaxRestarts, started: mono.NanoTime()}
+	if pm.maxRsts == 0 {
+		pm.maxRsts = DefaultMaxRestarts
+	}
+	return pm
+}
+
+func (pm *podMonitor) run() {
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if pm.boot.xctn.Finished() || pm.boot.xctn.IsAborted() {
+				return
+			}
+			pm.check()
+		case <-pm.stopCh.Listen():
+			return
+		}
+	}
+}
+
+func (pm *podMonitor) stop() { pm.stopCh.Close() }
+
+func (pm *podMonitor) status() (string, int64) {
+	return podHealth[pm.health.Load()], pm.restarts.Load()
+}
+
+func (pm *podMonitor) check() {
+	client, err := k8s.GetClient()
+	if err != nil {
+		nlog.Warningln("etl["+pm.boot.msg.IDX+"]: skipping health probe:", err)
+		return
+	}
+	crashed, err := pm.probe(client)
+	switch {
+	case err == nil:
+		pm.fails = 0
+		pm.health.Store(phHealthy)
+		return
+	case crashed:
+		pm.health.Store(phUnhealthy)
+	default:
+		pm.fails++
+		pm.health.Store(phUnhealthy)
+		nlog.Warningf("etl[%s]: pod %s failed readiness probe (%d/%d): %v",
+			pm.boot.msg.IDX, pm.boot.pod.Name, pm.fails, probeMaxFails, err)
+		if pm.fails < probeMaxFails {
+			return
+		}
+	}
+	pm.fails = 0
+	if err := pm.restart(err); err != nil {
+		nlog.Errorln(err)
+	}
+}
+
+// returns (crashed, err) where crashed is a failed liveness check;
+// (errors talking to k8s API are inconclusive and are not counted)
+func (pm *podMonitor) probe(client k8s.Client) (bool, error) {
+	pod, err := client.Pod(pm.boot.pod.Name)
+	if err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return true, fmt.Errorf("pod %s not found (deleted?)", pm.boot.pod.Name)
+		}
+		return false, nil
+	}
+	if err := podCrashed(pod); err != nil {
+		return true, err
+	}
+	conn, err := net.DialTimeout("tcp", strings.TrimPrefix(pm.boot.uri, "http://"), probeTimeout)
+	if err != nil {
+		return false, err
+	}
+	cos.Close(conn)
+	return false, nil
+}
+
+func podCrashed(pod *corev1.Pod) error {
+	switch pod.Status.Phase {
+	case corev1.PodFailed, corev1.PodSucceeded:
+		return fmt.Errorf("pod %s ran to completion (phase: %s), state message: %q",
+			pod.Name, pod.Status.Phase, pod.Status.Message)
+	}
+	for i := range pod.Status.ContainerStatuses {
+		cs := &pod.Status.ContainerStatuses[i]
+		if w := cs.State.Waiting; w != nil && w.Reason == "CrashLoopBackOff" {
+			return fmt.Errorf("pod %s: container %q is crash-looping: %s", pod.Name, cs.Name, w.Message)
+		}
+		if t := cs.State.Terminated; t != nil {
+			return fmt.Errorf("pod %s: container %q terminated (reason: %q, exit code %d)",
+				pod.Name, cs.Name, t.Reason, t.ExitCode)
+		}
+	}
+	return nil
+}
+
+// restart the pod with backoff, or give up and abort - as per restart policy;
+// called by the monitor itself and by the circuit breaker
+func (pm *podMonitor) restart(cause error) error {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	if pm.aborted {
+		return cmn.NewErrETL(pm.boot.errCtx, "aborted")
+	}
+	// (restarted by the other caller while we were waiting)
+	if pm.consec > 0 && pm.health.Load() == phHealthy && mono.Since(pm.started) < probeInterval {
+		return nil
+	}
+	if mono.Since(pm.started) >= restartStable {
+		pm.consec = 0
+	}
+	if cause != nil {
+		pm.lastErr = cause
+	}
+	if pm.maxRsts >= 0 && pm.consec >= pm.maxRsts {
+		return pm.abort()
+	}
+	if pm.consec > 0 {
+		backoff := restartDelay(pm.consec)
+		nlog.Warningf("etl[%s]: restarting pod %s in %v (consecutive restarts: %d)",
+			pm.boot.msg.IDX, pm.boot.pod.Name, backoff, pm.consec)
+		select {
+		case <-time.After(backoff):
+		case <-pm.stopCh.Listen():
+			return cmn.NewErrETL(pm.boot.errCtx, "stopped")
+		}
+	}
+	pm.consec++
+	pm.restarts.Inc()
+	pm.health.Store(phRestarting)
+	err := pm.boot.restartPod()
+	pm.started = mono.NanoTime()
+	if err != nil {
+		pm.health.Store(phUnhealthy)
+		pm.lastErr = err
+		return err
+	}
+	pm.health.Store(phHealthy)
+	return nil
+}
+
+func restartDelay(consec int) time.Duration {
+	d := restartBackoff
+	for i := 1; i < consec && d < maxRestartBackoff; i++ {
+		d *= 2
+	}
+	return min(d, maxRestartBackoff)
+}
+
+// under lock
+func (pm *podMonitor) abort() error {
+	pm.aborted = true
+	err := cmn.NewErrETLf(pm.boot.errCtx, "pod failed after %d consecutive restart%s (max-restarts %d), last error: %v",
+		pm.consec, cos.Plural(pm.consec), pm.maxRsts, pm.lastErr)
+	nlog.Errorln(err, "- aborting cluster-wide")
+	go func(name string) {
+		if AbortClusterWide != nil {
+			if errV := AbortClusterWide(name, err); errV != nil {
+				nlog.Errorln("etl["+name+"]: failed to request cluster-wide abort:", errV)
+			}
+		}
+		// regardless (and in case the primary could not be reached)
+		if errV := Abort(name, err); errV != nil && !cos.IsErrNotFound(errV) {
+			nlog.Errorln(errV)
+		}
+	}(pm.boot.msg.IDX)
+	return err
+}
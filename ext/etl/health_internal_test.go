@@ -0,0 +1,57 @@
+// Package etl provides utilities to initialize and use transformation pods.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package etl
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("PodHealthTest", func() {
+	It("should back off exponentially", func() {
+		Expect(restartDelay(1)).To(Equal(restartBackoff))
+		Expect(restartDelay(2)).To(Equal(2 * restartBackoff))
+		Expect(restartDelay(3)).To(Equal(4 * restartBackoff))
+		Expect(restartDelay(100)).To(Equal(maxRestartBackoff))
+	})
+
+	It("should detect crashed pods", func() {
+		pod := &corev1.Pod{}
+		pod.Name = "md5-t1"
+		pod.Status.Phase = corev1.PodRunning
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+			{Name: "server", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+		}
+		Expect(podCrashed(pod)).NotTo(HaveOccurred())
+
+		pod.Status.ContainerStatuses[0].State = corev1.ContainerState{
+			Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"},
+		}
+		Expect(podCrashed(pod)).NotTo(HaveOccurred())
+
+		pod.Status.ContainerStatuses[0].State = corev1.ContainerState{
+			Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 40s"},
+		}
+		Expect(podCrashed(pod)).To(MatchError(ContainSubstring("crash-looping")))
+
+		pod.Status.ContainerStatuses[0].State = corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+		}
+		Expect(podCrashed(pod)).To(MatchError(ContainSubstring("OOMKilled")))
+
+		pod.Status.ContainerStatuses = nil
+		pod.Status.Phase = corev1.PodFailed
+		Expect(podCrashed(pod)).To(HaveOccurred())
+	})
+
+	It("should report the worst health and total restarts across targets", func() {
+		il := InfoList{{Name: "md5", Health: PodHealthy, Restarts: 1}}
+		il.MergeBreakers(InfoList{{Name: "md5", Health: PodUnhealthy, Restarts: 2}})
+		il.MergeBreakers(InfoList{{Name: "md5", Health: PodRestarting}})
+		Expect(il[0].Health).To(Equal(PodUnhealthy))
+		Expect(il[0].Restarts).To(BeEquivalentTo(3))
+	})
+})
@@ -63,12 +63,20 @@ func (r *registry) list() []Info {
 	r.mtx.RLock()
 	etls := make([]Info, 0, len(r.m))
 	for name, comm := range r.m {
+		health, restarts := comm.PodHealth()
 		etls = append(etls, Info{
 			Name:     name,
 			XactID:   comm.Xact().ID(),
 			ObjCount: comm.ObjCount(),
 			InBytes:  comm.InBytes(),
 			OutBytes: comm.OutBytes(),
+
+			ErrCount:   comm.ErrCount(),
+			AvgLatency: cos.Duration(comm.AvgLatency()),
+
+			Breaker:  comm.Breaker(),
+			Health:   health,
+			Restarts: restarts,
 		})
 	}
 	r.mtx.RUnlock()
@@ -168,6 +168,13 @@ func InitSpec(msg *InitSpecMsg, etlName string, opts StartOpts) error {
 // - execute `InitSpec` with the modified podspec
 // See also: etl/runtime/podspec.yaml
 func InitCode(msg *InitCodeMsg, xid string) error {
+	// Start ETL
+	// (the point where InitCode flow converges w/ InitSpec)
+	spec, opts := codeToSpec(msg)
+	return InitSpec(spec, xid, opts)
+}
+
+func codeToSpec(msg *InitCodeMsg) (*InitSpecMsg, StartOpts) {
 	var (
 		ftp      = fromToPairs(msg)
 		replacer = strings.NewReplacer(ftp...)
@@ -176,16 +183,11 @@ func InitCode(msg *InitCodeMsg, xid string) error {
 	debug.Assert(exists, msg.Runtime) // must've been checked by proxy
 
 	podSpec := replacer.Replace(r.PodSpec())
-
-	// Start ETL
-	// (the point where InitCode flow converges w/ InitSpec)
-	return InitSpec(
-		&InitSpecMsg{msg.InitMsgBase, []byte(podSpec)},
-		xid,
-		StartOpts{Env: map[string]string{
-			r.CodeEnvName(): string(msg.Code),
-			r.DepsEnvName(): string(msg.Deps),
-		}})
+	opts := StartOpts{Env: map[string]string{
+		r.CodeEnvName(): string(msg.Code),
+		r.DepsEnvName(): string(msg.Deps),
+	}}
+	return &InitSpecMsg{msg.InitMsgBase, []byte(podSpec)}, opts
 }
 
 // generate (from => to) replacements
@@ -285,6 +287,7 @@ func start(msg *InitSpecMsg, xid string, opts StartOpts, config *cmn.Config) (er
 	}
 
 	boot.setupXaction(xid)
+	boot.mon = newPodMonitor(boot)
 
 	// finally, add Communicator to the runtime registry
 	comm := newCommunicator(newAborter(msg.IDX), boot)
@@ -292,6 +295,7 @@ func start(msg *InitSpecMsg, xid string, opts StartOpts, config *cmn.Config) (er
 		return
 	}
 	core.T.Sowner().Listeners().Reg(comm)
+	go boot.mon.run()
 	return
 }
 
@@ -326,6 +330,17 @@ func Stop(id string, errCause error) error {
 	return nil
 }
 
+// Abort stops ETL (same as Stop) and, in addition, aborts its (inline transform) xaction
+// with a given error - e.g., when the pod keeps failing (see health.go)
+func Abort(id string, cause error) error {
+	c, err := GetCommunicator(id)
+	if err != nil {
+		return err
+	}
+	c.Xact().Abort(cause)
+	return Stop(id, cause)
+}
+
 // StopAll terminates all running ETLs.
 func StopAll() {
 	if !k8s.IsK8s() {
@@ -390,7 +405,9 @@ func PodMetrics(etlName string) (*CPUMemUsed, error) {
 	}
 	cpuUsed, memUsed, err := k8s.Metrics(c.PodName())
 	if err == nil {
-		return &CPUMemUsed{TargetID: core.T.SID(), CPU: cpuUsed, Mem: memUsed}, nil
+		used := &CPUMemUsed{TargetID: core.T.SID(), CPU: cpuUsed, Mem: memUsed}
+		podGPUs(client, c.PodName(), used)
+		return used, nil
 	}
 	if cos.IsErrNotFound(err) {
 		return nil, err
@@ -401,6 +418,25 @@ func PodMetrics(etlName string) (*CPUMemUsed, error) {
 	return nil, err
 }
 
+// GPUs allocated to the pod and, if any, GPU allocation on the pod's node
+func podGPUs(client k8s.Client, podName string, used *CPUMemUsed) {
+	pod, err := client.Pod(podName)
+	if err != nil {
+		nlog.Warningln("failed to get pod", podName, "for GPU stats:", err)
+		return
+	}
+	if used.GPUs = k8s.PodGPUs(&pod.Spec); used.GPUs == 0 {
+		return
+	}
+	used.GPUNode = pod.Spec.NodeName
+	ng, err := k8s.GPUNode(client, pod.Spec.NodeName)
+	if err != nil {
+		nlog.Warningln("failed to get GPU allocation on node", pod.Spec.NodeName+":", err)
+		return
+	}
+	used.NodeGPUsAlloc, used.NodeGPUs = ng.Allocated, ng.Allocatable
+}
+
 // Pod conditions include enumerated lifecycle states, such as `PodScheduled`,
 // `ContainersReady`, `Initialized`, `Ready`
 // (see https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle).
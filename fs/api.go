@@ -6,6 +6,7 @@ package fs
 
 import (
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -39,19 +40,22 @@ type (
 	// Capacity, Disks, Filesystem (CDF)
 	CDF struct {
 		Capacity
-		Disks []string  `json:"disks"` // owned or shared disks (ios.FsDisks map => slice); "name[.faulted | degraded]"
-		Label ios.Label `json:"mountpath_label"`
-		FS    cos.FS    `json:"fs"`
+		Disks    []string       `json:"disks"` // owned or shared disks (ios.FsDisks map => slice); "name[.faulted | degraded]"
+		Label    ios.Label      `json:"mountpath_label"`
+		FS       cos.FS         `json:"fs"`
+		Throttle *ThrottleState `json:"throttle,omitempty"` // background IO (see throttle.go)
 	}
 	// Target (cumulative) CDF
 	Tcdf struct {
 		Mountpaths map[string]*CDF // mpath => [Capacity, Disks, FS (CDF)]
-		TotalUsed  uint64          `json:"total_used,string"`  // bytes
-		TotalAvail uint64          `json:"total_avail,string"` // bytes
-		PctMax     int32           `json:"pct_max"`            // max used (%)
-		PctAvg     int32           `json:"pct_avg"`            // avg used (%)
-		PctMin     int32           `json:"pct_min"`            // min used (%)
-		CsErr      string          `json:"cs_err"`             // OOS or high-wm error message; disk fault
+		TotalUsed  uint64          `json:"total_used,string"`     // bytes
+		TotalAvail uint64          `json:"total_avail,string"`    // bytes
+		PctMax     int32           `json:"pct_max"`               // max used (%)
+		PctAvg     int32           `json:"pct_avg"`               // avg used (%)
+		PctMin     int32           `json:"pct_min"`               // min used (%)
+		CsErr      string          `json:"cs_err"`                // OOS or high-wm error message; disk fault
+		IngestRate int64           `json:"ingest_rate,omitempty"` // bytes per second (negative when shrinking)
+		HwmETA     time.Duration   `json:"hwm_eta,omitempty"`     // predicted time until high watermark (0: n/a)
 	}
 	TcdfExt struct {
 		ios.AllDiskStats
@@ -0,0 +1,210 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Capacity forecast (long-term; compare with short-term EWMA in capredict.go):
+// - once every CapSnapIval target takes a snapshot of used capacity: per mountpath and
+//   per bucket (the latter - bucket's size on disk summed up across all mountpaths);
+// - the most recent capSnapMax snapshots (one week) are persisted in the config directory
+//   and, therefore, survive restarts;
+// - growth trends are computed as least-squares linear fit over the snapshots;
+// - retrievable via `apc.WhatCapForecast` and `ais show storage capacity --forecast`.
+
+const (
+	CapSnapIval = time.Hour
+
+	capSnapMax     = 7 * 24
+	capSnapMinN    = 3 // min number of snapshots to compute a trend
+	capSnapMetaver = 1
+	secsPerDay     = 24 * 60 * 60
+
+	capFcastMaxDays = 10 * 365
+)
+
+type (
+	CapSnap struct {
+		Mpaths map[string]Capacity `json:"mpaths"`
+		Bcks   map[string]uint64   `json:"bcks"` // bucket (cname) => size on disk
+		Time   int64               `json:"time"` // unix nanoseconds
+	}
+	capSnapsMD struct {
+		Snaps []*CapSnap `json:"snaps"`
+	}
+
+	// (target's) capacity forecast
+	CapFcast struct {
+		Mpaths   map[string]*CapTrend `json:"mpaths"`
+		Bcks     map[string]*CapTrend `json:"bcks"` // bucket (cname) =>
+		From     int64                `json:"from"` // oldest snapshot (unix nanoseconds)
+		To       int64                `json:"to"`   // most recent
+		NumSnaps int                  `json:"num_snaps"`
+		HighWM   int64                `json:"high_wm"`
+	}
+	CapTrend struct {
+		Used   uint64        `json:"used,string"`            // bytes (most recent)
+		Avail  uint64        `json:"avail,string,omitempty"` // mountpaths only
+		Rate   int64         `json:"rate"`                   // bytes per day (negative when shrinking)
+		HwmETA time.Duration `json:"hwm_eta,omitempty"`      // mountpaths only: time until high watermark (0: n/a)
+	}
+
+	capSnaps struct {
+		fpath string
+		md    capSnapsMD
+		mu    sync.Mutex
+	}
+)
+
+var csnaps capSnaps
+
+func InitCapSnaps(config *cmn.Config) {
+	csnaps.fpath = filepath.Join(config.ConfigDir, fname.CapSnaps)
+	if _, err := jsp.Load(csnaps.fpath, &csnaps.md, jsp.CksumSign(capSnapMetaver)); err != nil && !os.IsNotExist(err) {
+		nlog.Errorln("failed to load capacity snapshots:", err)
+	}
+}
+
+// TakeCapSnap takes a snapshot (unless the most recent one is still fresh)
+// and returns the time until the next one is due
+func TakeCapSnap(config *cmn.Config, bcks []*cmn.Bck) time.Duration {
+	now := time.Now().UnixNano()
+	csnaps.mu.Lock()
+	if l := len(csnaps.md.Snaps); l > 0 {
+		if elapsed := time.Duration(now - csnaps.md.Snaps[l-1].Time); elapsed < CapSnapIval-time.Minute {
+			csnaps.mu.Unlock()
+			return CapSnapIval - elapsed
+		}
+	}
+	csnaps.mu.Unlock()
+
+	// (no locking while visiting mountpaths)
+	var (
+		avail = GetAvail()
+		snap  = &CapSnap{Mpaths: make(map[string]Capacity, len(avail)), Bcks: make(map[string]uint64, len(bcks)), Time: now}
+	)
+	for _, mi := range avail {
+		c, err := mi.getCapacity(config, true)
+		if err != nil {
+			nlog.Errorln(mi.String()+":", err)
+			continue
+		}
+		snap.Mpaths[mi.Path] = c
+	}
+	for _, bck := range bcks {
+		if size := OnDiskSize(bck, ""); size > 0 {
+			snap.Bcks[bck.Cname("")] = size
+		}
+	}
+
+	csnaps.mu.Lock()
+	csnaps.md.Snaps = append(csnaps.md.Snaps, snap)
+	if l := len(csnaps.md.Snaps); l > capSnapMax {
+		csnaps.md.Snaps = append(csnaps.md.Snaps[:0], csnaps.md.Snaps[l-capSnapMax:]...)
+	}
+	err := jsp.Save(csnaps.fpath, &csnaps.md, jsp.CksumSign(capSnapMetaver), nil)
+	csnaps.mu.Unlock()
+	if err != nil {
+		nlog.Errorln("failed to persist capacity snapshots:", err)
+	}
+	return CapSnapIval
+}
+
+// GetCapFcast computes growth trends over persisted snapshots
+func GetCapFcast(config *cmn.Config) *CapFcast {
+	csnaps.mu.Lock()
+	defer csnaps.mu.Unlock()
+
+	var (
+		snaps = csnaps.md.Snaps
+		fcast = &CapFcast{
+			Mpaths:   make(map[string]*CapTrend, 4),
+			Bcks:     make(map[string]*CapTrend, 4),
+			NumSnaps: len(snaps),
+			HighWM:   config.Space.HighWM,
+		}
+	)
+	if len(snaps) == 0 {
+		return fcast
+	}
+	last := snaps[len(snaps)-1]
+	fcast.From, fcast.To = snaps[0].Time, last.Time
+
+	for mpath, c := range last.Mpaths {
+		trend := &CapTrend{Used: c.Used, Avail: c.Avail}
+		trend.Rate = capRate(snaps, func(snap *CapSnap) (uint64, bool) {
+			c, ok := snap.Mpaths[mpath]
+			return c.Used, ok
+		})
+		trend.HwmETA = trend.hwmETA(fcast.HighWM)
+		fcast.Mpaths[mpath] = trend
+	}
+	for cname, size := range last.Bcks {
+		trend := &CapTrend{Used: size}
+		trend.Rate = capRate(snaps, func(snap *CapSnap) (uint64, bool) {
+			size, ok := snap.Bcks[cname]
+			return size, ok
+		})
+		fcast.Bcks[cname] = trend
+	}
+	return fcast
+}
+
+// least-squares slope (bytes per day) over the snapshots that have the value
+func capRate(snaps []*CapSnap, get func(*CapSnap) (uint64, bool)) int64 {
+	var (
+		n                int
+		t0               = snaps[0].Time
+		sx, sy, sxx, sxy float64
+	)
+	for _, snap := range snaps {
+		v, ok := get(snap)
+		if !ok {
+			continue
+		}
+		x := float64(snap.Time-t0) / float64(time.Second)
+		y := float64(v)
+		sx += x
+		sy += y
+		sxx += x * x
+		sxy += x * y
+		n++
+	}
+	if n < capSnapMinN {
+		return 0
+	}
+	d := float64(n)*sxx - sx*sx
+	if d == 0 {
+		return 0
+	}
+	slope := (float64(n)*sxy - sx*sy) / d // bytes per second
+	return int64(slope * secsPerDay)
+}
+
+func (trend *CapTrend) hwmETA(highWM int64) time.Duration {
+	total := trend.Used + trend.Avail
+	if trend.Rate <= 0 || total == 0 {
+		return 0
+	}
+	hwm := total / 100 * uint64(highWM)
+	if trend.Used >= hwm {
+		return 0
+	}
+	days := float64(hwm-trend.Used) / float64(trend.Rate)
+	if days > capFcastMaxDays {
+		return 0 // (not in the foreseeable future)
+	}
+	return time.Duration(days * secsPerDay * float64(time.Second))
+}
@@ -0,0 +1,59 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCapFcastTrend(t *testing.T) {
+	const mpath = "/tmp/mp1"
+	var (
+		snaps []*CapSnap
+		now   = time.Now().UnixNano()
+	)
+	// hourly, +1GiB per hour (mountpath) and +100MiB per hour (bucket that shows up later)
+	for i := range 24 {
+		snap := &CapSnap{
+			Mpaths: map[string]Capacity{mpath: {Used: uint64(100+i) * cos.GiB, Avail: uint64(900-i) * cos.GiB}},
+			Bcks:   map[string]uint64{},
+			Time:   now + int64(i)*int64(time.Hour),
+		}
+		if i >= 12 {
+			snap.Bcks["ais://abc"] = uint64(i-11) * 100 * cos.MiB
+		}
+		snaps = append(snaps, snap)
+	}
+	rate := capRate(snaps, func(snap *CapSnap) (uint64, bool) {
+		c, ok := snap.Mpaths[mpath]
+		return c.Used, ok
+	})
+	tassert.Errorf(t, rate == 24*cos.GiB, "expecting %d bytes/day, got %d", 24*cos.GiB, rate)
+
+	rate = capRate(snaps, func(snap *CapSnap) (uint64, bool) {
+		size, ok := snap.Bcks["ais://abc"]
+		return size, ok
+	})
+	tassert.Errorf(t, rate == 2400*cos.MiB, "expecting %d bytes/day, got %d", 2400*cos.MiB, rate)
+
+	// not enough samples
+	rate = capRate(snaps[:capSnapMinN-1], func(snap *CapSnap) (uint64, bool) {
+		c, ok := snap.Mpaths[mpath]
+		return c.Used, ok
+	})
+	tassert.Errorf(t, rate == 0, "expecting no trend, got %d", rate)
+
+	// 123GiB used out of 1000GiB, growing 24GiB/day => (900 - 123) / 24 days until 90%
+	trend := &CapTrend{Used: 123 * cos.GiB, Avail: 877 * cos.GiB, Rate: 24 * cos.GiB}
+	eta, expected := trend.hwmETA(90), time.Duration(float64(777)/24*24)*time.Hour
+	tassert.Errorf(t, eta.Round(time.Minute) == expected.Round(time.Minute), "expecting %v, got %v", expected, eta)
+
+	trend.Rate = -cos.GiB
+	tassert.Errorf(t, trend.hwmETA(90) == 0, "expecting no ETA when shrinking")
+}
@@ -0,0 +1,87 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// Capacity prediction:
+// - upon each capacity refresh, compute the ingest rate: change in total used capacity per second,
+//   smoothed (EWMA) over the recent samples;
+// - assuming that new data is spread evenly across mountpaths (which is what HRW does),
+//   forecast the time until the most utilized mountpath reaches high watermark;
+// - target uses the forecast to start (gentle) LRU eviction early - before the watermark
+//   gets actually exceeded (see config.Space.EarlyEvict and space.IniLRU.Early).
+
+const (
+	capPredMinIval = 10 * time.Second // min interval between samples
+	capPredAlpha   = 0.3              // EWMA smoothing factor
+)
+
+type capPred struct {
+	eta   time.Duration // predicted time until high watermark (0: not growing or already above)
+	rate  float64       // bytes per second (negative when shrinking)
+	used  uint64        // previous sample
+	tsamp int64         // ditto
+	nsamp int
+	mu    sync.Mutex
+}
+
+func (cp *capPred) update(cs *CapStatus, now int64) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if cp.nsamp > 0 && time.Duration(now-cp.tsamp) < capPredMinIval {
+		return
+	}
+	if cp.nsamp > 0 {
+		var (
+			elapsed = time.Duration(now - cp.tsamp).Seconds()
+			rate    = (float64(cs.TotalUsed) - float64(cp.used)) / elapsed
+		)
+		if cp.nsamp == 1 {
+			cp.rate = rate
+		} else {
+			cp.rate = capPredAlpha*rate + (1-capPredAlpha)*cp.rate
+		}
+	}
+	cp.used, cp.tsamp = cs.TotalUsed, now
+	cp.nsamp++
+	cp.eta = cp._eta(cs)
+}
+
+func (cp *capPred) _eta(cs *CapStatus) time.Duration {
+	total := float64(cs.TotalUsed + cs.TotalAvail)
+	if cp.nsamp < 2 || cp.rate <= 0 || total == 0 || int64(cs.PctMax) >= cs.HighWM {
+		return 0
+	}
+	var (
+		pctRate = cp.rate * 100 / total // percent per second
+		secs    = float64(cs.HighWM-int64(cs.PctMax)) / pctRate
+	)
+	return time.Duration(secs * float64(time.Second))
+}
+
+// CapPredict returns the current ingest rate (bytes per second; negative when used capacity
+// is shrinking) and, if growing, the predicted time until high watermark (otherwise, zero)
+func CapPredict() (rate int64, eta time.Duration) {
+	cp := &mfs.cpred
+	cp.mu.Lock()
+	rate, eta = int64(cp.rate), cp.eta
+	cp.mu.Unlock()
+	return
+}
+
+// whether to start LRU early (config.Space.EarlyEvict)
+func (cs *CapStatus) EvictEarly(config *cmn.Config) bool {
+	if config.Space.EarlyEvict <= 0 || int64(cs.PctMax) < config.Space.LowWM || cs.Err() != nil {
+		return false
+	}
+	_, eta := CapPredict()
+	return eta > 0 && eta < config.Space.EarlyEvict.D()
+}
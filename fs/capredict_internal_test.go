@@ -0,0 +1,45 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCapPredict(t *testing.T) {
+	var (
+		cp  capPred
+		now = int64(time.Hour)
+		cs  = CapStatus{HighWM: 90, TotalUsed: 50 * cos.GiB, TotalAvail: 50 * cos.GiB, PctMax: 50}
+	)
+	cp.update(&cs, now)
+	tassert.Errorf(t, cp.eta == 0, "expecting no prediction upon the first sample, got %v", cp.eta)
+
+	// too soon - ignored
+	cs.TotalUsed += cos.GiB
+	cp.update(&cs, now+int64(time.Second))
+	tassert.Errorf(t, cp.nsamp == 1, "expecting a single sample, got %d", cp.nsamp)
+
+	// +1GiB in 100s => 1% of capacity per 100s => 40% in 4000s
+	cs.PctMax = 51
+	cs.TotalAvail -= cos.GiB
+	now += int64(100 * time.Second)
+	cp.update(&cs, now)
+	eta := time.Duration(39*100) * time.Second
+	tassert.Errorf(t, cp.eta.Round(time.Second) == eta, "expecting %v, got %v", eta, cp.eta)
+
+	// shrinking
+	for range 10 {
+		cs.TotalUsed -= 2 * cos.GiB
+		cs.TotalAvail += 2 * cos.GiB
+		now += int64(100 * time.Second)
+		cp.update(&cs, now)
+	}
+	tassert.Errorf(t, cp.rate < 0 && cp.eta == 0, "expecting negative rate and no prediction, got %f, %v", cp.rate, cp.eta)
+}
@@ -38,6 +38,8 @@ const (
 	WorkfileType = "wk"
 	ECSliceType  = "ec"
 	ECMetaType   = "mt"
+	ArchIdxType  = "ai" // archived-content index (see cmn/archive/index.go)
+	SnapType     = "sn" // bucket snapshot (see ais/tgtsnap.go)
 )
 
 type (
@@ -178,6 +180,8 @@ type (
 	WorkfileContentResolver struct{}
 	ECSliceContentResolver  struct{}
 	ECMetaContentResolver   struct{}
+	ArchIdxContentResolver  struct{}
+	SnapContentResolver     struct{}
 )
 
 func (*ObjectContentResolver) PermToMove() bool                   { return true }
@@ -252,3 +256,25 @@ func (*ECMetaContentResolver) GenUniqueFQN(base, _ string) string { return base
 func (*ECMetaContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
 	return base, false, true
 }
+
+// (not moved by rebalance: the index gets rebuilt upon request; see cmn/archive/index.go)
+func (*ArchIdxContentResolver) PermToMove() bool                   { return false }
+func (*ArchIdxContentResolver) PermToEvict() bool                  { return true }
+func (*ArchIdxContentResolver) PermToProcess() bool                { return false }
+func (*ArchIdxContentResolver) GenUniqueFQN(base, _ string) string { return base }
+
+func (*ArchIdxContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	return base, false, true
+}
+
+// (hard links: neither moved nor evicted; removed only when the snapshot (or the bucket) gets destroyed)
+func (*SnapContentResolver) PermToMove() bool    { return false }
+func (*SnapContentResolver) PermToEvict() bool   { return false }
+func (*SnapContentResolver) PermToProcess() bool { return false }
+
+// prefix: snapshot name
+func (*SnapContentResolver) GenUniqueFQN(base, prefix string) string { return prefix + "/" + base }
+
+func (*SnapContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	return base, false, true
+}
@@ -35,6 +35,8 @@ const (
 	WorkfileAppend       = "append"         // APPEND to object (as file)
 	WorkfileAppendToArch = "append-to-arch" // APPEND to existing archive
 	WorkfileCreateArch   = "create-arch"    // CREATE multi-object archive
+	WorkfileArchIdx      = "arch-idx"       // archived-content index (see ArchIdxType)
+	WorkfileSnapRestore  = "snap-restore"   // restore object from bucket snapshot (see SnapType)
 )
 
 type ParsedFQN struct {
@@ -184,6 +186,10 @@ func CleanPathErr(err error) {
 			what = "'ec slice'"
 		case ECMetaType:
 			what = "'ec metadata'"
+		case ArchIdxType:
+			what = "'archive index'"
+		case SnapType:
+			what = "'snapshot'"
 		default:
 			what = fmt.Sprintf("'%s'(?)", parsed.ContentType)
 		}
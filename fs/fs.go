@@ -22,6 +22,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/OneOfOne/xxhash"
@@ -64,6 +65,7 @@ type (
 		flags      uint64    // bit flags (set/get atomic)
 		PathDigest uint64    // (HRW logic)
 		capacity   Capacity
+		thr        throttler // adaptive throttling of background IO (see throttle.go)
 	}
 	MPI map[string]*Mountpath
 
@@ -84,6 +86,7 @@ type (
 		// capacity
 		cs        CapStatus
 		csExpires atomic.Int64
+		cpred     capPred
 		totalSize atomic.Uint64
 
 		mu sync.Mutex
@@ -506,6 +509,7 @@ func (mi *Mountpath) _cdf(tcdf *Tcdf) *CDF {
 	cdf.Disks = mi.Disks
 	cdf.FS = mi.FS
 	cdf.Label = mi.Label
+	cdf.Throttle = mi.ThrottleState()
 	cdf.Capacity = Capacity{} // reset (for caller to fill-in)
 	return cdf
 }
@@ -1129,6 +1133,28 @@ func OnDiskSize(bck *cmn.Bck, prefix string) (size uint64) {
 	return
 }
 
+// number of objects in a given bucket across all available mountpaths
+// (see also: bucket quotas)
+func NumObjects(bck *cmn.Bck) (n int64) {
+	avail := GetAvail()
+	for _, mi := range avail {
+		opts := &WalkOpts{Mi: mi, Bck: *bck, CTs: []string{ObjectType}}
+		opts.Callback = func(_ string, de DirEntry) error {
+			if !de.IsDir() {
+				n++
+			}
+			return nil
+		}
+		if err := Walk(opts); err != nil {
+			if cmn.Rom.FastV(4, cos.SmoduleFS) {
+				nlog.Warningln("failed to count objects:", err, "["+mi.String(), bck.String()+"]")
+			}
+			return 0
+		}
+	}
+	return n
+}
+
 // via (`apc.WhatDiskStats`, target_stats)
 func DiskStats(allds ios.AllDiskStats, tcdf *Tcdf, config *cmn.Config, refreshCap bool) {
 	// iops and bw
@@ -1259,10 +1285,13 @@ func CapRefresh(config *cmn.Config, tcdf *Tcdf) (cs CapStatus, _, errCap error)
 
 	errCap = cs.Err()
 
+	mfs.cpred.update(&cs, mono.NanoTime())
+
 	// fill-in and prune
 	if tcdf != nil {
 		tcdf.PctMax, tcdf.PctAvg, tcdf.PctMin = cs.PctMax, cs.PctAvg, cs.PctMin
 		tcdf.TotalUsed, tcdf.TotalAvail = cs.TotalUsed, cs.TotalAvail
+		tcdf.IngestRate, tcdf.HwmETA = CapPredict()
 		if errCap != nil {
 			tcdf.CsErr = errCap.Error()
 		}
@@ -1361,6 +1390,15 @@ func (cs *CapStatus) Err() (err error) {
 
 func (cs *CapStatus) IsOOS() bool { return int64(cs.PctMax) > cs.OOS }
 
+// user writes are not permitted to consume capacity reserved for system use (config.Space.ReservedPct)
+func (cs *CapStatus) ErrReserved(reservedPct int64) error {
+	if reservedPct <= 0 || int64(cs.PctMax) <= 100-reservedPct {
+		return nil
+	}
+	return fmt.Errorf("insufficient storage: used capacity %d%% exceeds %d%% on at least one of the mountpaths "+
+		"(the remaining %d%% is reserved for system use)", cs.PctMax, 100-reservedPct, reservedPct)
+}
+
 func (cs *CapStatus) IsNil() bool { return cs.TotalUsed == 0 && cs.TotalAvail == 0 }
 
 func (cs *CapStatus) String() (s string) {
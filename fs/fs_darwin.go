@@ -5,6 +5,7 @@
 package fs
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"syscall"
@@ -49,3 +50,6 @@ func DirectOpen(path string, flag int, perm os.FileMode) (*os.File, error) {
 
 	return file, nil
 }
+
+// not supported (compare w/ linux)
+func ExchangeDirs(_, _ string) error { return errors.ErrUnsupported }
@@ -11,6 +11,8 @@ import (
 	"path/filepath"
 	"strings"
 	"syscall"
+
+	"golang.org/x/sys/unix"
 )
 
 const procmounts = "/proc/mounts"
@@ -88,3 +90,8 @@ outer:
 func DirectOpen(path string, flag int, perm os.FileMode) (*os.File, error) {
 	return os.OpenFile(path, syscall.O_DIRECT|flag, perm)
 }
+
+// ExchangeDirs atomically swaps two (same-filesystem) directories (renameat2 RENAME_EXCHANGE)
+func ExchangeDirs(a, b string) error {
+	return unix.Renameat2(unix.AT_FDCWD, a, unix.AT_FDCWD, b, unix.RENAME_EXCHANGE)
+}
@@ -452,12 +452,8 @@ func (sg *joggerSyncGroup) abortAsyncTasks() error {
 	return sg.waitForAsyncTasks()
 }
 
-func (j *jogger) throttle() {
-	curUtil := fs.GetMpathUtil(j.mi.Path)
-	if curUtil >= j.config.Disk.DiskUtilHighWM {
-		time.Sleep(ThrottleMinDur)
-	}
-}
+// adaptive, disk utilization-driven (see fs/throttle.go)
+func (j *jogger) throttle() { j.mi.Throttle(j.config) }
 
 func (j *jogger) abort()         { j.stopCh.Close() }
 func (j *jogger) String() string { return fmt.Sprintf("jogger [%s/%s]", j.mi, j.opts.Bck) }
@@ -0,0 +1,308 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/ios"
+)
+
+// Probe runs the onboarding sequence for a new (not yet attached) mountpath:
+// - checks: path, filesystem, duplication and nesting, filesystem sharing, read-write access,
+//   extended attributes, direct I/O, and available capacity;
+// - benchmark: sequential write (including fsync) and read of a probe file, and the
+//   average latency of small synchronous writes;
+// - label: unless specified by the user, the label is suggested based on the latter latency
+//   (probeLabelNVMe et al.) - a heuristic that is, nonetheless, useful to group mountpaths
+//   by storage class.
+// Probe leaves no data behind and does not change the target's volume.
+
+const (
+	ProbeSize = 64 * cos.MiB
+
+	probeBufSize  = cos.MiB
+	probeSyncSize = 4 * cos.KiB
+	probeSyncCnt  = 16
+	probeXattr    = "user.ais.probe"
+)
+
+// suggested labels
+const (
+	probeLabelNVMe = "nvme"
+	probeLabelSSD  = "ssd"
+	probeLabelHDD  = "hdd"
+)
+
+const (
+	probeCheckPath    = "path"
+	probeCheckFS      = "filesystem"
+	probeCheckDup     = "not attached"
+	probeCheckShared  = "dedicated filesystem"
+	probeCheckRW      = "read-write"
+	probeCheckXattr   = "xattr support"
+	probeCheckDirect  = "direct I/O"
+	probeCheckCap     = "capacity"
+	probeCheckBenchmk = "benchmark"
+)
+
+func Probe(mpath string, label ios.Label, size int64) (mpp *apc.MpathProbe) {
+	mpp = &apc.MpathProbe{Mountpath: mpath, Label: string(label), ProbeBytes: size}
+
+	// path and filesystem
+	cleanMpath, err := cmn.ValidateMpath(mpath)
+	if err == nil {
+		mpp.Mountpath = cleanMpath
+		var finfo os.FileInfo
+		if finfo, err = os.Stat(cleanMpath); err == nil && !finfo.IsDir() {
+			err = fmt.Errorf("%q is not a directory", cleanMpath)
+		}
+	}
+	if _addCheck(mpp, probeCheckPath, err, false) {
+		return
+	}
+	mi := &Mountpath{Path: cleanMpath, Label: label}
+	err = mi.resolveFS()
+	if _addCheck(mpp, probeCheckFS, err, false) {
+		return
+	}
+	mpp.FS, mpp.FsType = mi.Fs, mi.FsType
+
+	_addCheck(mpp, probeCheckDup, mi.checkDup(), false)
+	err = mi.checkShared()
+	_addCheck(mpp, probeCheckShared, err, !label.IsNil() /*labeled: user's responsibility*/)
+
+	// read-write and xattrs
+	tmpDir := filepath.Join(cleanMpath, deletedRoot, "mpath-probe-"+cos.GenTie())
+	if err = cos.CreateDir(tmpDir); err == nil {
+		err = _probeRW(tmpDir)
+	}
+	if _addCheck(mpp, probeCheckRW, err, false) {
+		os.RemoveAll(tmpDir)
+		return
+	}
+	defer os.RemoveAll(tmpDir)
+	_addCheck(mpp, probeCheckXattr, _probeXattr(tmpDir), false)
+
+	// capacity
+	blocks, bavail, bsize, err := ios.GetFSStats(cleanMpath)
+	if err == nil {
+		mpp.Capacity, mpp.Avail = blocks*uint64(bsize), bavail*uint64(bsize)
+		if mpp.Avail < uint64(2*size) {
+			err = fmt.Errorf("insufficient space: %s available", cos.ToSizeIEC(int64(mpp.Avail), 1))
+		}
+	}
+	if _addCheck(mpp, probeCheckCap, err, false) {
+		return
+	}
+
+	// benchmark
+	fqn := filepath.Join(tmpDir, "bench")
+	if mpp.WriteBps, err = _probeWrite(fqn, size); err == nil {
+		var direct bool
+		mpp.ReadBps, direct, err = _probeRead(fqn)
+		if err == nil && !direct {
+			_addCheck(mpp, probeCheckDirect, errors.New("not supported (read throughput may reflect page cache)"), true)
+		}
+	}
+	if err == nil {
+		mpp.SyncLat, err = _probeSync(tmpDir)
+	}
+	if _addCheck(mpp, probeCheckBenchmk, err, false) {
+		return
+	}
+	if label.IsNil() {
+		mpp.Label = probeLabel(time.Duration(mpp.SyncLat))
+	}
+	return mpp
+}
+
+func probeLabel(syncLat time.Duration) string {
+	switch {
+	case syncLat < 100*time.Microsecond:
+		return probeLabelNVMe
+	case syncLat < 2*time.Millisecond:
+		return probeLabelSSD
+	default:
+		return probeLabelHDD
+	}
+}
+
+// not attached yet (neither available nor disabled), and not nested
+func (mi *Mountpath) checkDup() error {
+	avail, disabled := Get()
+	if _, ok := avail[mi.Path]; ok {
+		return fmt.Errorf("%q is already attached", mi.Path)
+	}
+	if _, ok := disabled[mi.Path]; ok {
+		return fmt.Errorf("%q is already attached and is currently disabled", mi.Path)
+	}
+	l := len(mi.Path)
+	for _, mpis := range []MPI{avail, disabled} {
+		for mpath := range mpis {
+			if err := cmn.IsNestedMpath(mi.Path, l, mpath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// compare with mi._validate
+func (mi *Mountpath) checkShared() error {
+	mfs.mu.Lock()
+	otherMpath, ok := mfs.fsIDs[mi.FsID]
+	mfs.mu.Unlock()
+	if ok {
+		return fmt.Errorf("filesystem %s is already used by mountpath %q", mi.Fs, otherMpath)
+	}
+	return nil
+}
+
+// adds check result; returns true if the (fatal) check failed
+func _addCheck(mpp *apc.MpathProbe, name string, err error, warn bool) bool {
+	check := apc.MpathCheck{Name: name}
+	if err != nil {
+		check.Err, check.Warn = err.Error(), warn
+	}
+	mpp.Checks = append(mpp.Checks, check)
+	return err != nil && !warn
+}
+
+func _probeRW(dir string) error {
+	fqn := filepath.Join(dir, "rw")
+	if err := os.WriteFile(fqn, []byte(probeXattr), cos.PermRWR); err != nil {
+		return err
+	}
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(b, []byte(probeXattr)) {
+		return errors.New("read-after-write mismatch")
+	}
+	return os.Remove(fqn)
+}
+
+func _probeXattr(dir string) error {
+	val := []byte(cos.GenTie())
+	if err := SetXattr(dir, probeXattr, val); err != nil {
+		return err
+	}
+	b, err := GetXattr(dir, probeXattr)
+	if err == nil && !bytes.Equal(b, val) {
+		err = fmt.Errorf("xattr mismatch: %q vs %q", b, val)
+	}
+	if err != nil {
+		return err
+	}
+	return removeXattr(dir, probeXattr)
+}
+
+// sequential write, including fsync; returns bytes per second
+func _probeWrite(fqn string, size int64) (int64, error) {
+	buf := make([]byte, probeBufSize)
+	if _, err := cryptorand.Read(buf); err != nil {
+		return 0, err
+	}
+	started := mono.NanoTime()
+	fh, err := os.OpenFile(fqn, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, cos.PermRWR)
+	if err != nil {
+		return 0, err
+	}
+	for written := int64(0); written < size; written += probeBufSize {
+		if _, err = fh.Write(buf); err != nil {
+			fh.Close()
+			return 0, err
+		}
+	}
+	if err = fh.Sync(); err != nil {
+		fh.Close()
+		return 0, err
+	}
+	if err = fh.Close(); err != nil {
+		return 0, err
+	}
+	return _bps(size, mono.Since(started)), nil
+}
+
+// sequential read, direct I/O if supported (otherwise, regular read)
+func _probeRead(fqn string) (bps int64, direct bool, err error) {
+	if bps, err = _probeReadFile(fqn, true); err == nil {
+		return bps, true, nil
+	}
+	// e.g., EINVAL: no O_DIRECT support
+	bps, err = _probeReadFile(fqn, false)
+	return bps, false, err
+}
+
+func _probeReadFile(fqn string, direct bool) (int64, error) {
+	var (
+		fh  *os.File
+		err error
+		buf = make([]byte, probeBufSize) // (large allocations are page-aligned, as required by O_DIRECT)
+	)
+	started := mono.NanoTime()
+	if direct {
+		fh, err = DirectOpen(fqn, os.O_RDONLY, 0)
+	} else {
+		fh, err = os.Open(fqn)
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer fh.Close()
+	var total int64
+	for {
+		n, err := fh.Read(buf)
+		total += int64(n)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return _bps(total, mono.Since(started)), nil
+}
+
+// average latency of small synchronous writes, ns
+func _probeSync(dir string) (int64, error) {
+	buf := make([]byte, probeSyncSize)
+	fqn := filepath.Join(dir, "sync")
+	fh, err := os.OpenFile(fqn, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, cos.PermRWR)
+	if err != nil {
+		return 0, err
+	}
+	defer fh.Close()
+	started := mono.NanoTime()
+	for range probeSyncCnt {
+		if _, err := fh.Write(buf); err != nil {
+			return 0, err
+		}
+		if err := fh.Sync(); err != nil {
+			return 0, err
+		}
+	}
+	return int64(mono.Since(started)) / probeSyncCnt, nil
+}
+
+func _bps(size int64, elapsed time.Duration) int64 {
+	if elapsed <= 0 {
+		elapsed = time.Microsecond
+	}
+	return int64(float64(size) / elapsed.Seconds())
+}
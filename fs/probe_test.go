@@ -0,0 +1,66 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs_test
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestMountpathProbe(t *testing.T) {
+	initFS()
+
+	mpp := fs.Probe("/nonexistingpath", "", cos.MiB)
+	tassert.Fatalf(t, !mpp.Passed(), "expected probe of non-existing mountpath to fail")
+	tassert.Errorf(t, len(mpp.Checks) == 1 && mpp.Checks[0].Err != "", "unexpected checks %+v", mpp.Checks)
+
+	mpath := t.TempDir()
+	mpp = fs.Probe(mpath, "", cos.MiB)
+	for _, name := range []string{"path", "filesystem", "not attached", "read-write"} {
+		c := findCheck(mpp, name)
+		tassert.Fatalf(t, c != nil && c.Err == "", "expected %q check to pass, got %+v", name, mpp.Checks)
+	}
+	if mpp.Passed() {
+		tassert.Errorf(t, mpp.WriteBps > 0 && mpp.ReadBps > 0 && mpp.SyncLat > 0, "unexpected benchmark %+v", mpp)
+		tassert.Errorf(t, mpp.Label != "", "expected suggested label")
+	}
+	mpp = fs.Probe(mpath, "fast", cos.MiB)
+	tassert.Errorf(t, mpp.Label == "fast", "expected user-specified label, got %q", mpp.Label)
+
+	// already attached
+	tools.AddMpath(t, mpath)
+	mpp = fs.Probe(mpath, "", cos.MiB)
+	c := findCheck(mpp, "not attached")
+	tassert.Errorf(t, !mpp.Passed() && c != nil && c.Err != "", "expected probe of attached mountpath to fail: %+v", mpp.Checks)
+}
+
+func findCheck(mpp *apc.MpathProbe, name string) *apc.MpathCheck {
+	for i := range mpp.Checks {
+		if mpp.Checks[i].Name == name {
+			return &mpp.Checks[i]
+		}
+	}
+	return nil
+}
+
+func TestMountpathSelftest(t *testing.T) {
+	initFS()
+
+	mpath := t.TempDir()
+	tools.AddMpath(t, mpath)
+	avail, _ := fs.Get()
+	mi, ok := avail[mpath]
+	tassert.Fatalf(t, ok, "expected %q to be attached", mpath)
+
+	res := mi.Selftest(cos.MiB)
+	tassert.Fatalf(t, res.Err == "", "selftest failed: %s", res.Err)
+	tassert.Errorf(t, res.WriteBps > 0 && res.ReadBps > 0, "unexpected sequential IO %+v", res)
+	tassert.Errorf(t, res.RandIOPS > 0 && res.RandLat > 0, "unexpected random IO %+v", res)
+}
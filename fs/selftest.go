@@ -0,0 +1,83 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// Selftest measures a given (attached) mountpath: sequential write and read of a
+// temporary file followed by random (aligned) reads of the same file - the same
+// measurements as the benchmark part of the Probe plus random IO.
+// The file is created under the mountpath's "deleted" root and is always removed.
+
+const (
+	SelftestSize = 256 * cos.MiB
+
+	selftestRandSize = 4 * cos.KiB
+	selftestRandCnt  = 512
+)
+
+func (mi *Mountpath) Selftest(size int64) (res apc.MpathSelftest) {
+	res.Mountpath = mi.Path
+	if size < selftestRandSize {
+		size = SelftestSize
+	}
+	tmpDir := filepath.Join(mi.Path, deletedRoot, "selftest-"+cos.GenTie())
+	if err := cos.CreateDir(tmpDir); err != nil {
+		res.Err = err.Error()
+		return res
+	}
+	defer os.RemoveAll(tmpDir)
+
+	var (
+		err    error
+		direct bool
+		fqn    = filepath.Join(tmpDir, "bench")
+	)
+	if res.WriteBps, err = _probeWrite(fqn, size); err == nil {
+		if res.ReadBps, direct, err = _probeRead(fqn); err == nil {
+			res.RandIOPS, res.RandLat, err = _probeRand(fqn, size, direct)
+		}
+	}
+	if err != nil {
+		res.Err = err.Error()
+	}
+	return res
+}
+
+// random aligned reads; returns IOPS and average latency (ns)
+func _probeRand(fqn string, size int64, direct bool) (iops, lat int64, err error) {
+	var (
+		fh   *os.File
+		buf  = make([]byte, probeBufSize)[:selftestRandSize] // (page-aligned - see _probeReadFile)
+		nblk = size / selftestRandSize
+	)
+	if direct {
+		fh, err = DirectOpen(fqn, os.O_RDONLY, 0)
+	} else {
+		fh, err = os.Open(fqn)
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	defer fh.Close()
+	started := mono.NanoTime()
+	for range selftestRandCnt {
+		off := rand.Int64N(nblk) * selftestRandSize
+		if _, err = fh.ReadAt(buf, off); err != nil && err != io.EOF {
+			return 0, 0, err
+		}
+	}
+	elapsed := mono.Since(started)
+	return _bps(selftestRandCnt, elapsed), int64(elapsed) / selftestRandCnt, nil
+}
@@ -0,0 +1,91 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// Adaptive (feedback-loop) throttling of background IO:
+// - each mountpath runs its own controller that adjusts the delay injected into background
+//   (xaction) IO so as to keep disk utilization at or below the configured ceiling
+//   (`disk.disk_util_ceiling`; defaults to `disk.disk_util_high_wm`);
+// - the controller is AIMD: above the ceiling the delay doubles (starting from thrMinDelay
+//   and up to thrMaxDelay); below the ceiling minus hysteresis it halves (and eventually drops to zero);
+// - user GET and PUT are never throttled - only callers of Mountpath.Throttle (mountpath joggers et al.);
+// - adjustments are made lazily, upon Throttle, at most once per `disk.iostat_time_short`
+//   (the rate at which utilization itself gets refreshed);
+// - controller state is included in the target's stats (see ThrottleState and CDF.Throttle).
+
+const (
+	thrMinDelay = time.Millisecond
+	thrMaxDelay = 100 * time.Millisecond
+	thrHyst     = 5 // utilization hysteresis (%)
+)
+
+type (
+	// (mountpath) controller state
+	ThrottleState struct {
+		Util    int64         `json:"util"`    // disk utilization (%) at the time of the most recent adjustment
+		Ceiling int64         `json:"ceiling"` // target max utilization (%)
+		Delay   time.Duration `json:"delay"`   // current per-call delay
+		Total   time.Duration `json:"total"`   // total cumulative delay injected so far
+	}
+	throttler struct {
+		delay   atomic.Int64 // time.Duration
+		total   atomic.Int64 // ditto
+		util    atomic.Int64
+		ceiling atomic.Int64
+		last    atomic.Int64 // mono time of the most recent adjustment
+	}
+)
+
+var thrTotal atomic.Int64 // all mountpaths
+
+// Throttle is called by background xactions, typically once every so many visited objects;
+// it sleeps for the controller's current delay (possibly zero)
+func (mi *Mountpath) Throttle(config *cmn.Config) {
+	thr := &mi.thr
+	if now, last := mono.NanoTime(), thr.last.Load(); now-last >= int64(config.Disk.IostatTimeShort) && thr.last.CAS(last, now) {
+		thr.adjust(mfs.ios.GetMpathUtil(mi.Path), config.Disk.UtilCeiling())
+	}
+	if d := thr.delay.Load(); d > 0 {
+		time.Sleep(time.Duration(d))
+		thr.total.Add(d)
+		thrTotal.Add(d)
+	}
+}
+
+func (mi *Mountpath) ThrottleState() *ThrottleState {
+	thr := &mi.thr
+	return &ThrottleState{
+		Util:    thr.util.Load(),
+		Ceiling: thr.ceiling.Load(),
+		Delay:   time.Duration(thr.delay.Load()),
+		Total:   time.Duration(thr.total.Load()),
+	}
+}
+
+// total cumulative delay injected across all mountpaths (stats)
+func ThrottleTotal() time.Duration { return time.Duration(thrTotal.Load()) }
+
+func (thr *throttler) adjust(util, ceiling int64) {
+	d := thr.delay.Load()
+	switch {
+	case util > ceiling:
+		d = min(max(2*d, int64(thrMinDelay)), int64(thrMaxDelay))
+	case util <= ceiling-thrHyst:
+		if d /= 2; d < int64(thrMinDelay) {
+			d = 0
+		}
+	}
+	thr.delay.Store(d)
+	thr.util.Store(util)
+	thr.ceiling.Store(ceiling)
+}
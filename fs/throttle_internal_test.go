@@ -0,0 +1,42 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestThrottleAdjust(t *testing.T) {
+	const ceiling = 80
+	var thr throttler
+
+	// below the ceiling: no delay
+	thr.adjust(50, ceiling)
+	tassert.Errorf(t, thr.delay.Load() == 0, "expecting zero delay, got %v", time.Duration(thr.delay.Load()))
+
+	// above: ramp up from the minimum and cap at the maximum
+	thr.adjust(95, ceiling)
+	tassert.Errorf(t, thr.delay.Load() == int64(thrMinDelay), "expecting %v, got %v", thrMinDelay, time.Duration(thr.delay.Load()))
+	for range 20 {
+		thr.adjust(95, ceiling)
+	}
+	tassert.Errorf(t, thr.delay.Load() == int64(thrMaxDelay), "expecting %v, got %v", thrMaxDelay, time.Duration(thr.delay.Load()))
+
+	// within hysteresis: no change
+	thr.adjust(ceiling-thrHyst+1, ceiling)
+	tassert.Errorf(t, thr.delay.Load() == int64(thrMaxDelay), "expecting %v, got %v", thrMaxDelay, time.Duration(thr.delay.Load()))
+
+	// well below: decay to zero
+	for range 20 {
+		thr.adjust(10, ceiling)
+	}
+	tassert.Errorf(t, thr.delay.Load() == 0, "expecting zero delay, got %v", time.Duration(thr.delay.Load()))
+
+	st := thr.util.Load()
+	tassert.Errorf(t, st == 10 && thr.ceiling.Load() == ceiling, "unexpected state: util %d, ceiling %d", st, thr.ceiling.Load())
+}
@@ -5,8 +5,10 @@
 package fs_test
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/NVIDIA/aistore/fs"
@@ -49,6 +51,29 @@ func TestIsDirEmptyNonExist(t *testing.T) {
 	tassert.Fatalf(t, err != nil, "expected error")
 }
 
+func TestExchangeDirs(t *testing.T) {
+	var (
+		root = t.TempDir()
+		a    = filepath.Join(root, "a")
+		b    = filepath.Join(root, "b")
+	)
+	tassert.CheckFatal(t, os.Mkdir(a, 0o755))
+	tassert.CheckFatal(t, os.Mkdir(b, 0o755))
+	tassert.CheckFatal(t, os.WriteFile(filepath.Join(a, "x"), nil, 0o644))
+
+	err := fs.ExchangeDirs(a, b)
+	if errors.Is(err, errors.ErrUnsupported) {
+		t.Skip(err)
+	}
+	tassert.CheckFatal(t, err)
+
+	_, err = os.Stat(filepath.Join(b, "x"))
+	tassert.CheckFatal(t, err)
+	_, empty, err := fs.IsDirEmpty(a)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, empty, "expected %q to be empty upon exchange", a)
+}
+
 func BenchmarkIsDirEmpty(b *testing.B) {
 	benches := []tools.DirTreeDesc{
 		{Dirs: 0, Depth: 1, Empty: true},
@@ -50,6 +50,7 @@ type Listener interface {
 	SetOwner(string)
 	LastUpdated(*meta.Snode) int64
 	ProgressInterval() time.Duration
+	Watch() (seq int64, upd <-chan struct{})
 
 	// detailed ref-counting
 	ActiveNotifiers() meta.NodeMap
@@ -85,6 +86,8 @@ type (
 		lastUpdated map[string]int64 // [daeID => last update time(nanoseconds)]
 		progress    time.Duration    // time interval to monitor the progress
 		addedTime   atomic.Int64     // Time when `nl` is added
+		upd         chan struct{}    // closed (and reset) upon stats update - to wake up watchers
+		seq         int64            // stats update sequence number
 
 		// runtime
 		EndTimeX atomic.Int64 // timestamp when finished
@@ -184,6 +187,22 @@ func (nlb *ListenerBase) SetStats(daeID string, stats any) {
 		nlb.lastUpdated = make(map[string]int64, len(nlb.Srcs))
 	}
 	nlb.lastUpdated[daeID] = mono.NanoTime()
+
+	nlb.seq++
+	if nlb.upd != nil {
+		close(nlb.upd)
+		nlb.upd = nil
+	}
+}
+
+// Watch returns the current stats update sequence number and the channel
+// that gets closed upon the next update (see api.WatchXaction)
+func (nlb *ListenerBase) Watch() (int64, <-chan struct{}) {
+	debug.AssertRWMutexLocked(&nlb.mu)
+	if nlb.upd == nil {
+		nlb.upd = make(chan struct{})
+	}
+	return nlb.seq, nlb.upd
 }
 
 func (nlb *ListenerBase) LastUpdated(si *meta.Snode) int64 {
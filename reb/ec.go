@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -21,6 +22,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
 	"github.com/NVIDIA/aistore/transport"
 )
 
@@ -45,6 +47,8 @@ import (
 //        update their metafiles. Targets do not overwrite their metafiles with a new
 //        one. They update only `Daemons` and `FullReplica` fields.
 
+const ecPaceNum = 64 // (see jogEC)
+
 func (reb *Reb) runECjoggers() {
 	var (
 		wg    = &sync.WaitGroup{}
@@ -74,12 +78,20 @@ func (reb *Reb) runECjoggers() {
 }
 
 // mountpath walker - walks through files in /meta/ directory
+// (outside maintenance window, if configured, throttles itself to the floor - see config.Housekeep)
 func (reb *Reb) jogEC(mi *fs.Mountpath, bck *cmn.Bck, wg *sync.WaitGroup) {
 	defer wg.Done()
+	var nvisit int64
+	walk := func(fqn string, de fs.DirEntry) error {
+		if nvisit++; nvisit%ecPaceNum == 0 && cmn.GCO.Get().Housekeep.Pace(time.Now()) == cmn.HkPaceFloor {
+			time.Sleep(mpather.ThrottleMaxDur)
+		}
+		return reb.walkEC(fqn, de)
+	}
 	opts := &fs.WalkOpts{
 		Mi:       mi,
 		CTs:      []string{fs.ECMetaType},
-		Callback: reb.walkEC,
+		Callback: walk,
 		Sorted:   false,
 	}
 	opts.Bck.Copy(bck)
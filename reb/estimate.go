@@ -0,0 +1,265 @@
+// Package reb provides global cluster-wide rebalance upon adding/removing storage nodes.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package reb
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Rebalance estimate (pre-flight), e.g.: `ais start rebalance --estimate-only`
+// - each target walks all its objects and counts those (and their sizes) that
+//   global rebalance would send to other targets given the current cluster map
+//   (optionally, with one of the targets excluded - as if it were leaving the cluster);
+// - objects in EC-enabled buckets are counted separately (they are handled by EC rebalance);
+// - projected duration is based on the throughput of the most recent completed rebalance
+//   (see history.go) and is unknown when there's no history.
+
+var errEstimateRunning = errors.New("rebalance estimate is already running")
+
+type (
+	// objects and bytes to move
+	MoveStats struct {
+		Objs  int64 `json:"objs"`
+		Bytes int64 `json:"bytes"`
+	}
+	// per-target estimate
+	Estimate struct {
+		TargetID   string                `json:"target_id"`
+		To         map[string]*MoveStats `json:"to"` // by destination target ID
+		Local      MoveStats             `json:"local"`
+		Send       MoveStats             `json:"send"`
+		Recv       MoveStats             `json:"recv"`       // (filled in by NewPlan)
+		SkippedEC  MoveStats             `json:"skipped_ec"` // EC-enabled buckets
+		Throughput int64                 `json:"throughput"` // bytes/s (sent + received) of the most recent completed rebalance
+		Duration   int64                 `json:"duration"`   // projected (nanoseconds); zero - unknown
+		Took       int64                 `json:"took"`       // time to compute (ditto)
+	}
+	// cluster-wide plan (aggregated across targets)
+	Plan struct {
+		Targets     []*Estimate `json:"targets"`
+		Exclude     string      `json:"exclude,omitempty"`
+		Total       MoveStats   `json:"total"`    // all objects in the cluster (except EC)
+		Move        MoveStats   `json:"move"`     // to move
+		Duration    int64       `json:"duration"` // projected (nanoseconds); zero - unknown
+		SmapVersion int64       `json:"smap_version"`
+	}
+)
+
+// Estimate walks all local objects and counts those that rebalance would move
+func (reb *Reb) Estimate(exclude string) (*Estimate, error) {
+	if !reb.estMu.TryLock() {
+		return nil, errEstimateRunning
+	}
+	defer reb.estMu.Unlock()
+
+	smap, err := estimateSmap(core.T.Sowner().Get(), exclude)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		started = time.Now()
+		est     = &Estimate{TargetID: core.T.SID(), To: make(map[string]*MoveStats, smap.CountTargets())}
+		avail   = fs.GetAvail()
+		errs    = make([]error, 0, len(avail))
+	)
+	for _, mi := range avail {
+		wg.Add(1)
+		go func(mi *fs.Mountpath) {
+			e, err := estimateMpath(mi, smap)
+			mu.Lock()
+			est.merge(e)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			mu.Unlock()
+			wg.Done()
+		}(mi)
+	}
+	wg.Wait()
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	est.Took = int64(time.Since(started))
+	est.Throughput = reb.throughput()
+	return est, nil
+}
+
+// the cluster map to estimate against
+func estimateSmap(smap *meta.Smap, exclude string) (*meta.Smap, error) {
+	if exclude == "" {
+		return smap, nil
+	}
+	tsi := smap.GetTarget(exclude)
+	if tsi == nil {
+		return nil, cos.NewErrNotFound(core.T, "target "+exclude)
+	}
+	clone := *smap
+	clone.Tmap = make(meta.NodeMap, len(smap.Tmap))
+	for tid, si := range smap.Tmap {
+		clone.Tmap[tid] = si
+	}
+	excl := *tsi
+	excl.Flags = excl.Flags.Set(meta.SnodeMaint)
+	clone.Tmap[exclude] = &excl
+	if clone.CountActiveTs() == 0 {
+		return nil, cmn.NewErrNoNodes(apc.Target, smap.CountTargets())
+	}
+	return &clone, nil
+}
+
+func estimateMpath(mi *fs.Mountpath, smap *meta.Smap) (*Estimate, error) {
+	var (
+		est  = &Estimate{To: make(map[string]*MoveStats, 4)}
+		tid  = core.T.SID()
+		opts = fs.WalkOpts{Mi: mi, CTs: []string{fs.ObjectType}}
+		bmd  = core.T.Bowner().Get()
+		err  error
+	)
+	opts.Callback = func(fqn string, de fs.DirEntry) error {
+		if de.IsDir() {
+			return nil
+		}
+		lom := core.AllocLOM(fqn)
+		defer core.FreeLOM(lom)
+		if err := lom.InitFQN(fqn, nil); err != nil {
+			if cmn.IsErrBucketLevel(err) {
+				return err
+			}
+			return nil
+		}
+		if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+			return nil
+		}
+		size := lom.Lsize()
+		if lom.ECEnabled() {
+			est.SkippedEC.add(size)
+			return nil
+		}
+		tsi, err := smap.HrwHash2T(lom.Digest())
+		if err != nil {
+			return err
+		}
+		if tsi.ID() == tid {
+			est.Local.add(size)
+			return nil
+		}
+		est.Send.add(size)
+		to, ok := est.To[tsi.ID()]
+		if !ok {
+			to = &MoveStats{}
+			est.To[tsi.ID()] = to
+		}
+		to.add(size)
+		return nil
+	}
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		opts.Bck.Copy(bck.Bucket())
+		if err = fs.Walk(&opts); err != nil {
+			nlog.Errorln(core.T.String(), "rebalance estimate: failed to traverse", mi.String()+":", err)
+			return true
+		}
+		return false
+	})
+	return est, err
+}
+
+// bytes per second (sent + received) of the most recent completed rebalance
+func (reb *Reb) throughput() int64 {
+	runs := reb.History()
+	for i := len(runs) - 1; i >= 0; i-- {
+		sum := runs[i]
+		if sum.Aborted || sum.Ended <= sum.Started {
+			continue
+		}
+		if bytes := sum.SentBytes + sum.RecvBytes; bytes > 0 {
+			return int64(float64(bytes) / time.Duration(sum.Ended-sum.Started).Seconds())
+		}
+	}
+	return 0
+}
+
+func (ms *MoveStats) add(size int64) { ms.Objs++; ms.Bytes += size }
+
+func (ms *MoveStats) merge(rhs *MoveStats) { ms.Objs += rhs.Objs; ms.Bytes += rhs.Bytes }
+
+func (est *Estimate) merge(rhs *Estimate) {
+	est.Local.merge(&rhs.Local)
+	est.Send.merge(&rhs.Send)
+	est.SkippedEC.merge(&rhs.SkippedEC)
+	for tid, ms := range rhs.To {
+		to, ok := est.To[tid]
+		if !ok {
+			to = &MoveStats{}
+			est.To[tid] = to
+		}
+		to.merge(ms)
+	}
+}
+
+//////////
+// Plan //
+//////////
+
+// NewPlan aggregates per-target estimates: computes received objects and bytes,
+// and projected durations (when there's a throughput to go by)
+func NewPlan(ests []*Estimate, exclude string, smapVersion int64) *Plan {
+	plan := &Plan{Targets: ests, Exclude: exclude, SmapVersion: smapVersion}
+	sort.Slice(ests, func(i, j int) bool { return ests[i].TargetID < ests[j].TargetID })
+
+	byID := make(map[string]*Estimate, len(ests))
+	for _, est := range ests {
+		byID[est.TargetID] = est
+	}
+	var thrSum, thrCnt int64
+	for _, est := range ests {
+		plan.Total.merge(&est.Local)
+		plan.Total.merge(&est.Send)
+		plan.Move.merge(&est.Send)
+		for tid, ms := range est.To {
+			if dst, ok := byID[tid]; ok {
+				dst.Recv.merge(ms)
+			}
+		}
+		if est.Throughput > 0 {
+			thrSum += est.Throughput
+			thrCnt++
+		}
+	}
+	if thrCnt == 0 {
+		return plan // unknown
+	}
+	dflt := thrSum / thrCnt // for targets with no history
+	for _, est := range ests {
+		thr := est.Throughput
+		if thr == 0 {
+			thr = dflt
+		}
+		est.Duration = int64(float64(est.Send.Bytes+est.Recv.Bytes) / float64(thr) * float64(time.Second))
+		plan.Duration = max(plan.Duration, est.Duration)
+	}
+	return plan
+}
+
+func (plan *Plan) String() string {
+	s := fmt.Sprintf("rebalance plan (smap v%d): move %d objects (%d bytes)", plan.SmapVersion, plan.Move.Objs, plan.Move.Bytes)
+	if plan.Duration > 0 {
+		s += fmt.Sprintf(", projected duration %v", time.Duration(plan.Duration))
+	}
+	return s
+}
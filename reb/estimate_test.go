@@ -0,0 +1,72 @@
+// Package reb provides global cluster-wide rebalance upon adding/removing storage nodes.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package reb_test
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/reb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	newEstimates := func(thr1, thr2, thr3 int64) []*reb.Estimate {
+		return []*reb.Estimate{
+			{
+				TargetID:   "t3",
+				Local:      reb.MoveStats{Objs: 10, Bytes: 1000},
+				Throughput: thr3,
+			},
+			{
+				TargetID:   "t1",
+				To:         map[string]*reb.MoveStats{"t2": {Objs: 2, Bytes: 200}, "t3": {Objs: 3, Bytes: 300}},
+				Local:      reb.MoveStats{Objs: 5, Bytes: 500},
+				Send:       reb.MoveStats{Objs: 5, Bytes: 500},
+				Throughput: thr1,
+			},
+			{
+				TargetID:   "t2",
+				To:         map[string]*reb.MoveStats{"t3": {Objs: 1, Bytes: 100}},
+				Send:       reb.MoveStats{Objs: 1, Bytes: 100},
+				Throughput: thr2,
+			},
+		}
+	}
+
+	It("should aggregate per-target estimates", func() {
+		plan := reb.NewPlan(newEstimates(0, 0, 0), "", 7)
+
+		Expect(plan.SmapVersion).To(Equal(int64(7)))
+		Expect(plan.Targets).To(HaveLen(3))
+		Expect(plan.Targets[0].TargetID).To(Equal("t1"))
+		Expect(plan.Targets[2].TargetID).To(Equal("t3"))
+
+		Expect(plan.Total).To(Equal(reb.MoveStats{Objs: 21, Bytes: 2100}))
+		Expect(plan.Move).To(Equal(reb.MoveStats{Objs: 6, Bytes: 600}))
+
+		Expect(plan.Targets[0].Recv).To(Equal(reb.MoveStats{}))
+		Expect(plan.Targets[1].Recv).To(Equal(reb.MoveStats{Objs: 2, Bytes: 200}))
+		Expect(plan.Targets[2].Recv).To(Equal(reb.MoveStats{Objs: 4, Bytes: 400}))
+	})
+
+	It("should not project duration without history", func() {
+		plan := reb.NewPlan(newEstimates(0, 0, 0), "", 1)
+		Expect(plan.Duration).To(BeZero())
+		for _, est := range plan.Targets {
+			Expect(est.Duration).To(BeZero())
+		}
+	})
+
+	It("should project duration from throughput", func() {
+		// t2 has no history and gets the average (150 B/s)
+		plan := reb.NewPlan(newEstimates(100, 0, 200), "", 1)
+
+		Expect(time.Duration(plan.Targets[0].Duration)).To(Equal(5 * time.Second)) // t1: 500 / 100
+		Expect(time.Duration(plan.Targets[1].Duration)).To(Equal(2 * time.Second)) // t2: (100 + 200) / 150
+		Expect(time.Duration(plan.Targets[2].Duration)).To(Equal(2 * time.Second)) // t3: 400 / 200
+		Expect(time.Duration(plan.Duration)).To(Equal(5 * time.Second))
+	})
+})
@@ -90,6 +90,8 @@ type (
 		onAir   atomic.Int64
 		mu      sync.RWMutex
 		laterx  atomic.Bool
+		hist    history    // see history.go
+		estMu   sync.Mutex // see estimate.go
 	}
 	lomAcks struct {
 		mu *sync.Mutex
@@ -110,6 +112,7 @@ type (
 		smap   *meta.Smap
 		config *cmn.Config
 		apaths fs.MPI
+		cause  string
 		id     int64
 		ecUsed bool
 	}
@@ -133,6 +136,7 @@ func New(config *cmn.Config) *Reb {
 		Config:      config,
 		Compression: config.Rebalance.Compression,
 		Multiplier:  config.Rebalance.SbundleMult,
+		MaxReplay:   transport.DfltMaxReplay,
 	}
 	dm, err := bundle.NewDataMover(trname, reb.recvObj, cmn.OwtRebalance, dmExtra)
 	if err != nil {
@@ -143,6 +147,8 @@ func New(config *cmn.Config) *Reb {
 
 	// serialize one global rebalance at a time
 	reb.semaCh = cos.NewSemaphore(1)
+
+	reb.hist.init(config)
 	return reb
 }
 
@@ -171,7 +177,10 @@ func (reb *Reb) unregRecv() {
 //  4. Global rebalance performs checks such as `stage > rebStageTraverse` or
 //     `stage < rebStageWaitAck`. Since all EC stages are between
 //     `Traverse` and `WaitAck` non-EC rebalance does not "notice" stage changes.
-func (reb *Reb) RunRebalance(smap *meta.Smap, id int64, notif *xact.NotifXact, tstats cos.StatsUpdater) {
+//
+// `cause` is the action that triggered the rebalance (e.g., apc.ActRebalance - user-requested),
+// to be recorded in the history (see history.go)
+func (reb *Reb) RunRebalance(smap *meta.Smap, id int64, notif *xact.NotifXact, tstats cos.StatsUpdater, cause string) {
 	if reb.nxtID.Load() >= id {
 		return
 	}
@@ -188,7 +197,7 @@ func (reb *Reb) RunRebalance(smap *meta.Smap, id int64, notif *xact.NotifXact, t
 	nlog.Infoln(logHdr + ": initializing")
 
 	bmd := core.T.Bowner().Get()
-	rargs := &rebArgs{id: id, smap: smap, config: cmn.GCO.Get(), ecUsed: bmd.IsECUsed()}
+	rargs := &rebArgs{id: id, smap: smap, config: cmn.GCO.Get(), ecUsed: bmd.IsECUsed(), cause: cause}
 	if !reb.serialize(rargs, logHdr) {
 		return
 	}
@@ -213,6 +222,7 @@ func (reb *Reb) RunRebalance(smap *meta.Smap, id int64, notif *xact.NotifXact, t
 		fs.RemoveMarker(fname.RebalanceMarker)
 		fs.RemoveMarker(fname.NodeRestartedPrev)
 		reb.xctn().Finish()
+		reb.hist.add(reb.xctn(), rargs.cause)
 		return
 	}
 
@@ -736,6 +746,7 @@ func (reb *Reb) fini(rargs *rebArgs, logHdr string, err error) {
 	if !xreb.Finished() {
 		xreb.Finish()
 	}
+	reb.hist.add(xreb, rargs.cause)
 	nlog.Infoln(logHdr, "done", xreb.String())
 }
 
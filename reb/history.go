@@ -0,0 +1,111 @@
+// Package reb provides global cluster-wide rebalance upon adding/removing storage nodes.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package reb
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// Rebalance history:
+// - upon termination (completed or aborted), each target records a summary of its part
+//   in the cluster-wide rebalance: what triggered it, start and end time, objects and bytes
+//   sent and received, error count, and abort reason (if any);
+// - the most recent rebHistMax summaries are persisted in the config directory and, therefore,
+//   survive restarts;
+// - retrievable via `apc.WhatRebHistory`; `ais show rebalance --history` aggregates
+//   summaries across targets by rebalance ID.
+
+const (
+	rebHistMax     = 64
+	rebHistMetaver = 1
+)
+
+type (
+	RunSummary struct {
+		ID        string `json:"id"`    // e.g. "g12" (see xact.RebID2S)
+		Cause     string `json:"cause"` // triggering action, e.g. "start-maintenance" (see Reb.RunRebalance)
+		AbortErr  string `json:"abort_err,omitempty"`
+		Started   int64  `json:"started"` // unix nanoseconds
+		Ended     int64  `json:"ended"`   // ditto
+		SentObjs  int64  `json:"sent_objs"`
+		SentBytes int64  `json:"sent_bytes"`
+		RecvObjs  int64  `json:"recv_objs"`
+		RecvBytes int64  `json:"recv_bytes"`
+		ErrCnt    int    `json:"err_cnt"`
+		Aborted   bool   `json:"aborted"`
+	}
+	histMD struct {
+		Runs []*RunSummary `json:"runs"`
+	}
+	history struct {
+		fpath string
+		md    histMD
+		mu    sync.Mutex
+	}
+)
+
+func (h *history) init(config *cmn.Config) {
+	if config.ConfigDir == "" {
+		return // (unit tests)
+	}
+	h.fpath = filepath.Join(config.ConfigDir, fname.RebHistory)
+	if _, err := jsp.Load(h.fpath, &h.md, jsp.CksumSign(rebHistMetaver)); err != nil && !os.IsNotExist(err) {
+		nlog.Errorln("failed to load rebalance history:", err)
+	}
+}
+
+func (h *history) add(xreb *xs.Rebalance, cause string) {
+	var (
+		stats core.Stats
+		sum   = &RunSummary{
+			ID:      xact.RebID2S(xreb.RebID()),
+			Cause:   cause,
+			Started: xreb.StartTime().UnixNano(),
+			Ended:   xreb.EndTime().UnixNano(),
+			ErrCnt:  xreb.ErrCnt(),
+			Aborted: xreb.IsAborted(),
+		}
+	)
+	xreb.ToStats(&stats)
+	sum.SentObjs, sum.SentBytes = stats.OutObjs, stats.OutBytes
+	sum.RecvObjs, sum.RecvBytes = stats.InObjs, stats.InBytes
+	if err := xreb.AbortErr(); err != nil {
+		sum.AbortErr = err.Error()
+	}
+
+	h.mu.Lock()
+	h.md.Runs = append(h.md.Runs, sum)
+	if l := len(h.md.Runs); l > rebHistMax {
+		h.md.Runs = append(h.md.Runs[:0], h.md.Runs[l-rebHistMax:]...)
+	}
+	var err error
+	if h.fpath != "" {
+		err = jsp.Save(h.fpath, &h.md, jsp.CksumSign(rebHistMetaver), nil)
+	}
+	h.mu.Unlock()
+	if err != nil {
+		nlog.Errorln("failed to persist rebalance history:", err)
+	}
+}
+
+// History returns this target's rebalance summaries, oldest first
+func (reb *Reb) History() []*RunSummary {
+	h := &reb.hist
+	h.mu.Lock()
+	runs := make([]*RunSummary, len(h.md.Runs))
+	copy(runs, h.md.Runs)
+	h.mu.Unlock()
+	return runs
+}
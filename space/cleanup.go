@@ -22,11 +22,16 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
+// outside maintenance window (config.Housekeep.Window), cleanup gets throttled
+// once every so many visited files
+const clnPaceNum = 64
+
 type (
 	IniCln struct {
 		Config  *cmn.Config
@@ -63,8 +68,9 @@ type (
 			loms []*core.LOM
 			ec   []*core.CT // EC slices and replicas without corresponding metafiles (CT FQN -> Meta FQN)
 		}
-		bck cmn.Bck
-		now int64
+		bck    cmn.Bck
+		now    int64
+		nvisit int64
 		// init-time
 		p       *clnP
 		ini     *IniCln
@@ -347,7 +353,7 @@ func (j *clnJ) jogBck() (size int64, err error) {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		Bck:      j.bck,
-		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType},
+		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType, fs.ArchIdxType},
 		Callback: j.walk,
 		Sorted:   false,
 	}
@@ -410,6 +416,19 @@ func (j *clnJ) visitCT(parsedFQN *fs.ParsedFQN, fqn string) {
 			return
 		}
 		j.oldWork = append(j.oldWork, fqn)
+	case fs.ArchIdxType:
+		// archived-content index: remove if the shard is gone or has changed since
+		ct, err := core.NewCTFromFQN(fqn, core.T.Bowner())
+		if err != nil {
+			j.oldWork = append(j.oldWork, fqn)
+			return
+		}
+		lom := core.AllocLOM(ct.ObjectName())
+		lom.InitCT(ct.Clone(fs.ObjectType))
+		if lom.LoadArchIndex() == nil {
+			j.oldWork = append(j.oldWork, fqn)
+		}
+		core.FreeLOM(lom)
 	default:
 		debug.Assertf(false, "Unsupported content type: %s", parsedFQN.ContentType)
 	}
@@ -612,10 +631,20 @@ func (j *clnJ) yieldTerm() error {
 	case <-j.stopCh:
 		return cmn.NewErrAborted(xcln.Name(), "", nil)
 	default:
-		break
+		j.pace()
 	}
 	if xcln.Finished() {
 		return cmn.NewErrAborted(xcln.Name(), "", nil)
 	}
 	return nil
 }
+
+func (j *clnJ) pace() {
+	j.nvisit++
+	if j.nvisit%clnPaceNum != 0 {
+		return
+	}
+	if cmn.GCO.Get().Housekeep.Pace(time.Now()) == cmn.HkPaceFloor {
+		time.Sleep(mpather.ThrottleMaxDur)
+	}
+}
@@ -0,0 +1,382 @@
+// Package space provides storage cleanup and eviction functionality (the latter based on the
+// least recently used cache replacement). It also serves as a built-in garbage-collection
+// mechanism for orphaned workfiles.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package space
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Directory defragmentation (x-defrag-dirs):
+// - some filesystems (e.g., ext4) never shrink directories: after heavy delete/evict cycles
+//   a directory that once held many entries keeps its (mostly empty) blocks, slowing down listings;
+// - the xaction visits object directories, mountpath by mountpath and bucket by bucket, and compacts
+//   those that look sparse: it builds a new directory (hard links to the same objects), atomically
+//   exchanges it with the old one (renameat2 RENAME_EXCHANGE), and then removes the latter;
+// - all objects in a given directory remain write-locked for the duration; busy directories are skipped;
+// - only leaf directories (no subdirectories) get compacted;
+// - each directory is compacted when the mountpath is idle (disk utilization below low watermark) or
+//   within the maintenance window (config.Housekeep.Window) that also triggers x-defrag-dirs automatically;
+// - reclaimed directory metadata (bytes) and other counters - see xact.DefragStats.
+
+// tunables
+const (
+	dfgMinDirSize  = 64 * cos.KiB // smaller directories are never compacted
+	dfgSparseRatio = 4            // directory size vs. its estimated compacted size
+	dfgBlockSize   = 4 * cos.KiB
+	dfgMaxWaitIdle = 16 // max wait for the mountpath to become idle (in mpather.ThrottleMaxDur units)
+)
+
+type (
+	IniDfg struct {
+		Xaction *XactDfg
+		Config  *cmn.Config
+		Buckets []cmn.Bck // optional list of specific buckets to defragment
+		WG      *sync.WaitGroup
+	}
+	XactDfg struct {
+		xact.Base
+		visited   atomic.Int64
+		compacted atomic.Int64
+		skipped   atomic.Int64
+		reclaimed atomic.Int64
+	}
+)
+
+// private
+type (
+	// single mountpath /jogger/
+	dfgJ struct {
+		ini    *IniDfg
+		mi     *fs.Mountpath
+		config *cmn.Config
+		locked []*core.LOM
+	}
+	dfgFactory struct {
+		xreg.RenewBase
+		xctn *XactDfg
+	}
+)
+
+// interface guard
+var (
+	_ xreg.Renewable = (*dfgFactory)(nil)
+	_ core.Xact      = (*XactDfg)(nil)
+)
+
+var errExchangeNotSupported = errors.New("atomic directory exchange is not supported")
+
+func (*XactDfg) Run(*sync.WaitGroup) { debug.Assert(false) }
+
+func (r *XactDfg) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.Ext = r.stats()
+	snap.IdleX = r.IsIdle()
+	return
+}
+
+func (r *XactDfg) stats() *xact.DefragStats {
+	return &xact.DefragStats{
+		Visited:   r.visited.Load(),
+		Compacted: r.compacted.Load(),
+		Skipped:   r.skipped.Load(),
+		Reclaimed: r.reclaimed.Load(),
+	}
+}
+
+////////////////
+// dfgFactory //
+////////////////
+
+func (*dfgFactory) New(args xreg.Args, _ *meta.Bck) xreg.Renewable {
+	return &dfgFactory{RenewBase: xreg.RenewBase{Args: args}}
+}
+
+func (p *dfgFactory) Start() error {
+	p.xctn = &XactDfg{}
+	p.xctn.InitBase(p.UUID(), apc.ActDefragDirs, nil)
+	return nil
+}
+
+func (*dfgFactory) Kind() string     { return apc.ActDefragDirs }
+func (p *dfgFactory) Get() core.Xact { return p.xctn }
+
+func (*dfgFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (wpr xreg.WPR, err error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+func RunDefrag(ini *IniDfg) {
+	var (
+		wg    sync.WaitGroup
+		xdfg  = ini.Xaction
+		avail = fs.GetAvail()
+	)
+	defer func() {
+		if ini.WG != nil {
+			ini.WG.Done()
+		}
+	}()
+	if len(avail) == 0 {
+		xdfg.AddErr(cmn.ErrNoMountpaths, 0)
+		xdfg.Finish()
+		return
+	}
+	providers := apc.Providers.ToSlice()
+	for _, mi := range avail {
+		j := &dfgJ{ini: ini, mi: mi, config: ini.Config}
+		wg.Add(1)
+		go j.run(&wg, providers)
+	}
+	nlog.Infoln(xdfg.Name(), "started")
+	if ini.WG != nil {
+		ini.WG.Done()
+		ini.WG = nil
+	}
+	wg.Wait()
+
+	xdfg.Finish()
+	s := xdfg.stats()
+	nlog.Infof("%s finished: visited %d, compacted %d, skipped %d, reclaimed %s", xdfg.Name(),
+		s.Visited, s.Compacted, s.Skipped, cos.ToSizeIEC(s.Reclaimed, 1))
+}
+
+//////////
+// dfgJ //
+//////////
+
+func (j *dfgJ) String() string {
+	return fmt.Sprintf("%s: jog-%s", j.ini.Xaction, j.mi)
+}
+
+func (j *dfgJ) run(wg *sync.WaitGroup, providers []string) {
+	var (
+		bcks = j.ini.Buckets
+		err  error
+	)
+	defer wg.Done()
+	if len(bcks) == 0 {
+		for _, provider := range providers {
+			opts := fs.WalkOpts{Mi: j.mi, Bck: cmn.Bck{Provider: provider, Ns: cmn.NsGlobal}}
+			all, err := fs.AllMpathBcks(&opts)
+			if err != nil {
+				nlog.Errorln(j.String()+":", err)
+				continue
+			}
+			bcks = append(bcks, all...)
+		}
+	}
+	bowner := core.T.Bowner()
+	for i := range bcks {
+		b := meta.CloneBck(&bcks[i])
+		if err = b.Init(bowner); err != nil {
+			nlog.Warningln(j.String()+":", err, "- skipping", b.String())
+			continue
+		}
+		if err = j.visit(j.mi.MakePathCT(b.Bucket(), fs.ObjectType)); err != nil {
+			break
+		}
+	}
+	if err != nil && !cmn.IsErrAborted(err) {
+		j.ini.Xaction.AddErr(err)
+	}
+}
+
+// depth-first
+func (j *dfgJ) visit(dir string) error {
+	dents, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	leaf := true
+	for _, dent := range dents {
+		if !dent.IsDir() {
+			continue
+		}
+		leaf = false
+		if err := j.visit(filepath.Join(dir, dent.Name())); err != nil {
+			return err
+		}
+	}
+	if err := j.yieldTerm(); err != nil {
+		return err
+	}
+	j.ini.Xaction.visited.Inc()
+	if !leaf || len(dents) == 0 {
+		return nil
+	}
+	return j.compact(dir, dents)
+}
+
+// ext4: 8-byte entry header followed by the name, 4-byte aligned (other filesystems are similar)
+func dentSize(name string) int64 { return (8 + int64(len(name)) + 3) &^ 3 }
+
+func (j *dfgJ) compact(dir string, dents []os.DirEntry) error {
+	finfo, err := os.Stat(dir)
+	if err != nil {
+		return nil // (removed in the meantime)
+	}
+	size := finfo.Size()
+	if size < dfgMinDirSize {
+		return nil
+	}
+	var est int64
+	for _, dent := range dents {
+		est += dentSize(dent.Name())
+	}
+	if size < dfgSparseRatio*max(est, dfgBlockSize) {
+		return nil
+	}
+
+	j.pace()
+
+	// 1. write-lock all objects in the directory
+	ok := j.lock(dir, dents)
+	defer j.unlock()
+	if !ok {
+		j.ini.Xaction.skipped.Inc()
+		return nil
+	}
+
+	// 2. new directory with hard links to the same objects (same filesystem)
+	tmp := j.mi.TempDir("defrag-" + cos.GenTie())
+	if err := cos.CreateDir(tmp); err != nil {
+		return err
+	}
+	for _, dent := range dents {
+		if err := os.Link(filepath.Join(dir, dent.Name()), filepath.Join(tmp, dent.Name())); err != nil {
+			return j.fail(tmp, err)
+		}
+	}
+
+	// 3. swap
+	if err := fs.ExchangeDirs(tmp, dir); err != nil {
+		if errors.Is(err, errors.ErrUnsupported) || errors.Is(err, syscall.EINVAL) || errors.Is(err, syscall.ENOSYS) {
+			os.RemoveAll(tmp)
+			return fmt.Errorf("%s: %w (%s, %v)", j, errExchangeNotSupported, j.mi.FsType, err)
+		}
+		return j.fail(tmp, err)
+	}
+
+	// 4. the old one (now at tmp) may have received new objects in the meantime
+	j.moveNew(tmp, dir, dents)
+	if err := os.RemoveAll(tmp); err != nil {
+		nlog.Warningln(j.String()+": failed to remove", tmp+":", err) // (cleanup will)
+	}
+
+	// 5. done
+	j.ini.Xaction.compacted.Inc()
+	if finfo, err = os.Stat(dir); err == nil && finfo.Size() < size {
+		j.ini.Xaction.reclaimed.Add(size - finfo.Size())
+		if cmn.Rom.FastV(4, cos.SmoduleSpace) {
+			nlog.Infoln(j.String()+": compacted", dir, size, "=>", finfo.Size())
+		}
+	}
+	return nil
+}
+
+// (the directory stays unchanged)
+func (j *dfgJ) fail(tmp string, err error) error {
+	os.RemoveAll(tmp)
+	j.ini.Xaction.skipped.Inc()
+	nlog.Warningln(j.String()+":", err)
+	return nil
+}
+
+func (j *dfgJ) lock(dir string, dents []os.DirEntry) bool {
+	debug.Assert(len(j.locked) == 0)
+	for _, dent := range dents {
+		lom := core.AllocLOM(dent.Name())
+		if err := lom.InitFQN(filepath.Join(dir, dent.Name()), nil); err != nil || !lom.TryLock(true) {
+			core.FreeLOM(lom)
+			return false
+		}
+		j.locked = append(j.locked, lom)
+	}
+	return true
+}
+
+func (j *dfgJ) unlock() {
+	for _, lom := range j.locked {
+		lom.Unlock(true)
+		core.FreeLOM(lom)
+	}
+	clear(j.locked)
+	j.locked = j.locked[:0]
+}
+
+func (j *dfgJ) moveNew(from, to string, dents []os.DirEntry) {
+	all, err := os.ReadDir(from)
+	if err != nil || len(all) == len(dents) {
+		return
+	}
+	linked := make(cos.StrSet, len(dents))
+	for _, dent := range dents {
+		linked.Add(dent.Name())
+	}
+	for _, dent := range all {
+		name := dent.Name()
+		if linked.Contains(name) {
+			continue
+		}
+		dst := filepath.Join(to, name)
+		if _, err := os.Lstat(dst); err == nil {
+			continue // (newer)
+		}
+		if err := os.Rename(filepath.Join(from, name), dst); err != nil {
+			nlog.Errorln(j.String()+": failed to move", name, "=>", to+":", err)
+		}
+	}
+}
+
+// run when the mountpath is idle or within the maintenance window
+func (j *dfgJ) pace() {
+	switch j.config.Housekeep.Pace(time.Now()) {
+	case cmn.HkPaceMax:
+		return
+	case cmn.HkPaceFloor:
+		time.Sleep(mpather.ThrottleMaxDur)
+	}
+	for i := 0; i < dfgMaxWaitIdle && !j.mi.IsIdle(j.config); i++ {
+		time.Sleep(mpather.ThrottleMaxDur)
+	}
+}
+
+func (j *dfgJ) yieldTerm() error {
+	xdfg := j.ini.Xaction
+	select {
+	case errCause := <-xdfg.ChanAbort():
+		return cmn.NewErrAborted(xdfg.Name(), "", errCause)
+	default:
+	}
+	if xdfg.Finished() {
+		return cmn.NewErrAborted(xdfg.Name(), "", nil)
+	}
+	return nil
+}
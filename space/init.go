@@ -13,4 +13,5 @@ import (
 func Xreg() {
 	xreg.RegNonBckXact(&lruFactory{})
 	xreg.RegNonBckXact(&clnFactory{})
+	xreg.RegNonBckXact(&dfgFactory{})
 }
@@ -39,6 +39,13 @@ import (
 // runs automatically. In order to reduce its impact on the live workload, LRU throttles itself
 // in accordance with the current storage-target's utilization (see xaction_throttle.go).
 //
+// Early eviction (config.Space.EarlyEvict): when the target predicts that used capacity will exceed
+// high watermark within the configured time (see fs.CapPredict), LRU starts early - that is,
+// when used capacity is anywhere between low and high watermarks - and runs gently (always throttled).
+//
+// Maintenance window (config.Housekeep.Window), if configured, takes precedence: within the window
+// LRU runs unthrottled; outside of it - always throttled.
+//
 // There's only one API that this module provides to the rest of the code:
 //   - runLRU - to initiate a new LRU extended action on the local target
 // All other methods are private to this module and are used only internally.
@@ -59,6 +66,7 @@ type (
 		GetFSStats          func(path string) (blocks, bavail uint64, bsize int64, err error)
 		WG                  *sync.WaitGroup
 		Force               bool // Ignore LRU prop when set to be true.
+		Early               bool // predicted (not yet exceeded) high watermark - see above
 	}
 	XactLRU struct {
 		xact.Base
@@ -170,7 +178,13 @@ func RunLRU(ini *IniLRU) {
 		go j.run(providers)
 	}
 	cs := fs.Cap()
-	nlog.Infof("%s started, dont-evict-time %v, %s", xlru, config.LRU.DontEvictTime, cs.String())
+	if ini.Early {
+		_, eta := fs.CapPredict()
+		nlog.Infof("%s started early (high-wm predicted in %v), dont-evict-time %v, %s", xlru, eta.Round(time.Second),
+			config.LRU.DontEvictTime, cs.String())
+	} else {
+		nlog.Infof("%s started, dont-evict-time %v, %s", xlru, config.LRU.DontEvictTime, cs.String())
+	}
 	if ini.WG != nil {
 		ini.WG.Done()
 		ini.WG = nil
@@ -216,6 +230,10 @@ func (j *lruJ) run(providers []string) {
 		nlog.Infof("%s: used cap below threshold, nothing to do", j)
 		return
 	}
+	j.throttle = j.ini.Early
+	if pace := j.config.Housekeep.Pace(time.Now()); pace != cmn.HkPaceDefault {
+		j.throttle = pace == cmn.HkPaceFloor
+	}
 	if len(j.ini.Buckets) != 0 {
 		nlog.Infof("%s: freeing-up %s", j, cos.ToSizeIEC(j.totalSize, 2))
 		err = j.jogBcks(j.ini.Buckets, j.ini.Force)
@@ -400,10 +418,20 @@ func (j *lruJ) postRemove(prev, size int64) (capCheck int64, err error) {
 	}
 	// init, recompute, and throttle - once per capCheckThresh
 	capCheck = 0
-	j.throttle = false
+	j.throttle = j.ini.Early
 	j.allowDelObj, _ = j.allow()
 	j.config = cmn.GCO.Get()
 	j.now = time.Now().UnixNano()
+	switch j.config.Housekeep.Pace(time.Unix(0, j.now)) {
+	case cmn.HkPaceMax:
+		j.throttle = false
+		return
+	case cmn.HkPaceFloor:
+		j.throttle = true
+		time.Sleep(mpather.ThrottleMaxDur)
+		err = j.yieldTerm()
+		return
+	}
 	usedPct, ok := j.ini.GetFSUsedPercentage(j.mi.Path)
 	if ok && usedPct < j.config.Space.HighWM {
 		err = j._throttle(usedPct)
@@ -452,7 +480,7 @@ func (j *lruJ) evictSize() (err error) {
 	}
 	used := blocks - bavail
 	usedPct := used * 100 / blocks
-	if usedPct < uint64(hwm) {
+	if usedPct < uint64(hwm) && (!j.ini.Early || usedPct <= uint64(lwm)) {
 		return
 	}
 	lwmBlocks := blocks * uint64(lwm) / 100
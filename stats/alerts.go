@@ -0,0 +1,73 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// Alerts: cluster-wide rules (config.Alerts) evaluated by the primary proxy
+// against node metrics; see ais/prxalerts.go
+
+// enum Alert.State
+const (
+	AlertPending  = "pending"  // condition holds but not (yet) for the configured duration
+	AlertFiring   = "firing"   // notified
+	AlertResolved = "resolved" // (ditto)
+)
+
+type (
+	Alert struct {
+		Rule      string  `json:"rule"`
+		Metric    string  `json:"metric"`
+		NodeID    string  `json:"node_id"`
+		State     string  `json:"state"`
+		Severity  string  `json:"severity"`
+		Value     float64 `json:"value"`
+		Threshold float64 `json:"threshold"`
+		Since     int64   `json:"since"`              // condition first observed (unix nanoseconds)
+		Fired     int64   `json:"fired,omitempty"`    // when started firing (ditto)
+		Resolved  int64   `json:"resolved,omitempty"` // when resolved (ditto)
+	}
+	Alerts []*Alert
+)
+
+// NodeMetric returns the node's current value of a given (non-computed) metric
+func NodeMetric(node *Node, metric string) (int64, bool) {
+	if metric == cmn.AlertMetricCapacity {
+		if node.Snode == nil || !node.Snode.IsTarget() {
+			return 0, false
+		}
+		return int64(node.Tcdf.PctMax), true
+	}
+	if metric == cmn.AlertMetricErrRate {
+		var total int64
+		for name, v := range node.Tracker {
+			if IsErrMetric(name) && strings.HasSuffix(name, ".n") {
+				total += v.Value
+			}
+		}
+		return total, true
+	}
+	v, ok := node.Tracker[metric]
+	return v.Value, ok
+}
+
+// IsRateMetric returns true if alert rules must evaluate a given metric as a per-second rate
+func IsRateMetric(metric string) bool {
+	return metric == cmn.AlertMetricErrRate || strings.HasSuffix(metric, ".n")
+}
+
+func (a *Alert) String() string {
+	if a.Metric == cmn.AlertMetricOffline {
+		return fmt.Sprintf("%s [%s] %s: node %s is offline", a.State, a.Severity, a.Rule, a.NodeID)
+	}
+	return fmt.Sprintf("%s [%s] %s: node %s %s=%.4g (threshold %.4g)", a.State, a.Severity, a.Rule, a.NodeID,
+		a.Metric, a.Value, a.Threshold)
+}
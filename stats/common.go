@@ -6,6 +6,7 @@
 package stats
 
 import (
+	"context"
 	"encoding/json"
 	rfs "io/fs"
 	"os"
@@ -71,6 +72,7 @@ const (
 	PutCount    = "put.n" // ditto PUT
 	HeadCount   = "head.n"
 	AppendCount = "append.n"
+	PatchCount  = "patch.n" // partial update (ranged write)
 	DeleteCount = "del.n"
 	RenameCount = "ren.n"
 	ListCount   = "lst.n" // list-objects
@@ -81,6 +83,7 @@ const (
 	ErrPutCount    = errPrefix + PutCount
 	ErrHeadCount   = errPrefix + HeadCount
 	ErrAppendCount = errPrefix + AppendCount
+	ErrPatchCount  = errPrefix + PatchCount
 	ErrDeleteCount = errPrefix + DeleteCount
 	ErrRenameCount = errPrefix + RenameCount
 	ErrListCount   = errPrefix + ListCount
@@ -137,7 +140,9 @@ type (
 		prev      string      // prev ctracker.write
 		next      int64       // mono.Nano
 		mem       sys.MemStat
+		gpuErr    string // most recent GPU scraping error (to log once)
 		startedUp atomic.Bool
+		gpuBusy   atomic.Bool // GPU scraping in progress
 	}
 )
 
@@ -159,6 +164,9 @@ func (r *runner) regCommon(snode *meta.Snode) {
 	// prometheus only
 	initDfltlabel(snode)
 
+	// OTLP resource (see tracing.go)
+	trc.snode = snode
+
 	// basic counters
 	r.reg(snode, GetCount, KindCounter,
 		&Extra{
@@ -180,6 +188,11 @@ func (r *runner) regCommon(snode *meta.Snode) {
 			Help: "total number of executed APPEND(object) requests",
 		},
 	)
+	r.reg(snode, PatchCount, KindCounter,
+		&Extra{
+			Help: "total number of executed partial updates (ranged writes) of existing objects",
+		},
+	)
 	r.reg(snode, DeleteCount, KindCounter,
 		&Extra{
 			Help: "total number of executed DELETE(object) requests",
@@ -217,6 +230,11 @@ func (r *runner) regCommon(snode *meta.Snode) {
 			Help: "total number of APPEND(object) errors",
 		},
 	)
+	r.reg(snode, ErrPatchCount, KindCounter,
+		&Extra{
+			Help: "total number of partial update (ranged write) errors",
+		},
+	)
 	r.reg(snode, ErrDeleteCount, KindCounter,
 		&Extra{
 			Help: "total number of DELETE(object) errors",
@@ -431,6 +449,7 @@ waitStartup:
 			config = cmn.GCO.Get()
 			logger.log(now, time.Duration(now-startTime) /*uptime*/, config)
 			lastNgr = r.checkNgr(now, lastNgr, goMaxProcs)
+			r.scrapeGPUs(config)
 
 			if statsTime != config.Periodic.StatsTime.D() {
 				statsTime = config.Periodic.StatsTime.D()
@@ -530,6 +549,36 @@ func (r *runner) checkNgr(now, lastNgr int64, goMaxProcs int) int64 {
 	return lastNgr
 }
 
+// GPU stats (optional) - asynchronously, at most one scrape at a time
+func (r *runner) scrapeGPUs(config *cmn.Config) {
+	if config.GPU.Source == "" {
+		sys.SetGPUs(nil) // (in case it's been disabled at runtime)
+		return
+	}
+	if !r.gpuBusy.CAS(false, true) {
+		return
+	}
+	go r._gpus(config.GPU.Source, config.GPU.Endpoint, config.Periodic.StatsTime.D())
+}
+
+func (r *runner) _gpus(source, endpoint string, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	stats, err := sys.ScrapeGPUs(ctx, source, endpoint)
+	cancel()
+	switch {
+	case err == nil:
+		if r.gpuErr != "" {
+			nlog.Infoln(r.Name()+":", "GPU stats are now available:", len(stats), "GPU(s)")
+			r.gpuErr = ""
+		}
+	case err.Error() != r.gpuErr:
+		r.gpuErr = err.Error()
+		nlog.Warningln(r.Name()+":", "failed to scrape GPU stats:", err)
+	}
+	sys.SetGPUs(stats)
+	r.gpuBusy.Store(false)
+}
+
 ////////////////
 // statsValue //
 ////////////////
@@ -702,7 +751,8 @@ func ignore(s string) bool {
 			return true
 		}
 	}
-	return false
+	// stream open (and idle) times keep growing when there's no traffic (see transport/collect.go)
+	return strings.HasPrefix(s, "stream.") && strings.HasSuffix(s, ".ns.total")
 }
 
 // convert bytes to meGabytes with a fixed rounding precision = 2 digits
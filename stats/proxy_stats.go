@@ -18,7 +18,11 @@ import (
 
 const numProxyStats = 24 // approx. initial
 
-// NOTE: currently, proxy's stats == common and hardcoded
+// proxy-only metrics (in addition to common)
+const (
+	GetRedirAwayCount     = "get.redir.away.n"     // GET redirected away from a degraded target (config.Proxy.HealthRedirect)
+	GetRedirFallbackCount = "get.redir.fallback.n" // GET routed around an unreachable target (ditto)
+)
 
 type Prunner struct {
 	runner
@@ -43,6 +47,16 @@ func (r *Prunner) Init(p core.Node) *atomic.Bool {
 	r.core.init(numProxyStats)
 
 	r.regCommon(p.Snode()) // common metrics
+	r.reg(p.Snode(), GetRedirAwayCount, KindCounter,
+		&Extra{
+			Help: "number of GET requests redirected away from degraded targets (to the targets storing object replicas)",
+		},
+	)
+	r.reg(p.Snode(), GetRedirFallbackCount, KindCounter,
+		&Extra{
+			Help: "number of GET requests routed around unreachable targets (to the next target in the object's HRW order)",
+		},
+	)
 
 	r.core.statsTime = cmn.GCO.Get().Periodic.StatsTime.D()
 	r.ctracker = make(copyTracker, numProxyStats)
@@ -0,0 +1,67 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"sync"
+	"time"
+)
+
+// Slow-request tracing:
+// - enabled when config.Log.SlowReq is non-zero (see cmn.Rom.SlowReq);
+// - a data-path request (GET or PUT) that takes longer than the configured threshold
+//   gets recorded along with the time spent in each of its phases;
+// - the most recent slowRingSize records are kept in memory (and are lost upon restart);
+// - retrievable via `apc.WhatSlowReqs` and `ais show performance slow-requests`.
+//
+// Phases:
+//   GET: redirect (by proxy), rlock, read from disk, cold GET (from backend), transmit (to client)
+//   PUT: redirect, wlock, write to disk, remote PUT (to backend), and receive (from client)
+
+const slowRingSize = 256
+
+type (
+	SlowReq struct {
+		Method   string        `json:"method"`
+		Cname    string        `json:"cname"`    // bucket/object
+		Time     int64         `json:"time"`     // when started (unix nanoseconds)
+		Size     int64         `json:"size"`     // bytes transmitted (GET) or received (PUT)
+		Total    time.Duration `json:"total"`    // end-to-end, including redirect
+		Redirect time.Duration `json:"redirect"` // proxy => target
+		Lock     time.Duration `json:"lock"`     // waiting for object lock
+		Disk     time.Duration `json:"disk"`     // read (GET) or write (PUT)
+		Backend  time.Duration `json:"backend"`  // cold GET or remote PUT
+		Transmit time.Duration `json:"transmit"` // send to (GET) or receive from (PUT) client
+	}
+	slowRing struct {
+		recs [slowRingSize]SlowReq
+		next int
+		cnt  int
+		mu   sync.Mutex
+	}
+)
+
+var slow slowRing
+
+// AddSlow records a slow request (caller checks the threshold)
+func AddSlow(rec *SlowReq) {
+	slow.mu.Lock()
+	slow.recs[slow.next] = *rec
+	slow.next = (slow.next + 1) % slowRingSize
+	slow.cnt = min(slow.cnt+1, slowRingSize)
+	slow.mu.Unlock()
+}
+
+// GetSlow returns recorded slow requests, most recent first
+func GetSlow() []SlowReq {
+	slow.mu.Lock()
+	out := make([]SlowReq, 0, slow.cnt)
+	for i := 1; i <= slow.cnt; i++ {
+		out = append(out, slow.recs[(slow.next-i+slowRingSize)%slowRingSize])
+	}
+	slow.mu.Unlock()
+	return out
+}
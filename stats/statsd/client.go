@@ -7,6 +7,7 @@ package statsd
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 	"time"
 
@@ -64,7 +65,7 @@ func New(ip string, port int, prefix string, probe bool) (*Client, error) {
 	}
 	conn, ok := c.(*net.UDPConn)
 	debug.Assert(ok)
-	server, err := net.ResolveUDPAddr("udp", fmt.Sprintf("%s:%d", ip, port))
+	server, err := net.ResolveUDPAddr("udp", net.JoinHostPort(ip, strconv.Itoa(port)))
 	if err != nil {
 		conn.Close()
 		return &Client{}, err
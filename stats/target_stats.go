@@ -46,12 +46,23 @@ const (
 	VerChangeCount = "ver.change.n"
 	VerChangeSize  = "ver.change.size"
 
+	// finished xactions removed from the registry (see xreg.GC)
+	XactGCCount = "xact.gc.n"
+
+	// background IO: total delay injected by adaptive throttling (see fs/throttle.go)
+	ThrottleLatencyTotal = "throttle.ns.total"
+
+	// bucket quotas (see cmn.QuotaConf)
+	QuotaSoftCount = "quota.soft.n"
+
 	// errors
 	ErrCksumCount = errPrefix + "cksum.n"
 	ErrCksumSize  = errPrefix + "cksum.size"
 
 	ErrFSHCCount = errPrefix + "fshc.n"
 
+	ErrQuotaCount = errPrefix + "quota.n"
+
 	// IO errors (must have ioErrPrefix)
 	IOErrGetCount    = ioErrPrefix + "get.n"
 	IOErrPutCount    = ioErrPrefix + "put.n"
@@ -124,7 +135,7 @@ type (
 const (
 	minLogDiskUtil = 10 // skip logging idle disks
 
-	numTargetStats = 48 // approx. initial
+	numTargetStats = 104 // approx. initial
 )
 
 /////////////
@@ -226,6 +237,62 @@ func (r *Trunner) nameWbps(disk string) string { return r.disk.metrics[disk]["wr
 func (r *Trunner) nameWavg(disk string) string { return r.disk.metrics[disk]["avg.wsize"] }
 func (r *Trunner) nameUtil(disk string) string { return r.disk.metrics[disk]["util"] }
 
+// intra-cluster streams of a given kind (see transport/stats.go)
+func (r *Trunner) regStreamKind(snode *meta.Snode, kind string) {
+	labels := cos.StrKVs{"kind": kind}
+	r.reg(snode, cos.StreamsKindMetric("out", kind, "n"), KindCounter,
+		&Extra{
+			Help:    "intra-cluster streaming communications: number of sent objects, by stream kind",
+			StrName: "stream_kind_out_count",
+			Labels:  labels,
+		},
+	)
+	r.reg(snode, cos.StreamsKindMetric("out", kind, "bps"), KindThroughput,
+		&Extra{
+			Help:    "intra-cluster streaming communications: average send throughput (MB/s) over the last periodic.stats_time interval, by stream kind",
+			StrName: "stream_kind_out_mbps",
+			Labels:  labels,
+		},
+	)
+	r.reg(snode, cos.StreamsKindMetric("out", kind, "lz4.size"), KindSize,
+		&Extra{
+			Help: "intra-cluster streaming communications: total cumulative size (bytes) transmitted by compressed streams, by stream kind; " +
+				"compare with stream_kind_out_mbps to compute compression ratio",
+			StrName: "stream_kind_out_lz4_bytes",
+			Labels:  labels,
+		},
+	)
+	r.reg(snode, cos.StreamsKindMetric("out", kind, "ns.total"), KindTotal,
+		&Extra{
+			Help:    "intra-cluster streaming communications: total cumulative time (nanoseconds) all streams of a given kind were open",
+			StrName: "stream_kind_out_ns_total",
+			Labels:  labels,
+		},
+	)
+	r.reg(snode, cos.StreamsKindMetric("out", kind, "idle.ns.total"), KindTotal,
+		&Extra{
+			Help: "intra-cluster streaming communications: total cumulative time (nanoseconds) all streams of a given kind were open and idle " +
+				"(had nothing to send); compare with stream_kind_out_ns_total to compute idle percentage",
+			StrName: "stream_kind_out_idle_ns_total",
+			Labels:  labels,
+		},
+	)
+	r.reg(snode, cos.StreamsKindMetric("in", kind, "n"), KindCounter,
+		&Extra{
+			Help:    "intra-cluster streaming communications: number of received objects, by stream kind",
+			StrName: "stream_kind_in_count",
+			Labels:  labels,
+		},
+	)
+	r.reg(snode, cos.StreamsKindMetric("in", kind, "bps"), KindThroughput,
+		&Extra{
+			Help:    "intra-cluster streaming communications: average receive throughput (MB/s) over the last periodic.stats_time interval, by stream kind",
+			StrName: "stream_kind_in_mbps",
+			Labels:  labels,
+		},
+	)
+}
+
 // log vs idle logic
 func isDiskMetric(name string) bool {
 	return strings.HasPrefix(name, "disk.")
@@ -260,6 +327,23 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 		},
 	)
 
+	r.reg(snode, XactGCCount, KindCounter,
+		&Extra{
+			Help: "number of finished xactions (jobs) removed from the registry (upon retention or on demand)",
+		},
+	)
+	r.reg(snode, ThrottleLatencyTotal, KindTotal,
+		&Extra{
+			Help: "background IO: total cumulative time (nanoseconds) xactions were delayed to keep disk utilization below disk.disk_util_ceiling",
+		},
+	)
+
+	r.reg(snode, QuotaSoftCount, KindCounter,
+		&Extra{
+			Help: "bucket quotas: number of PUTs that brought bucket usage above the soft threshold (quota.soft_pct)",
+		},
+	)
+
 	// out-of-band (x 3)
 	r.reg(snode, VerChangeCount, KindCounter,
 		&Extra{
@@ -352,6 +436,11 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "number of times filesystem health checker (FSHC) was triggered by an I/O error or errors",
 		},
 	)
+	r.reg(snode, ErrQuotaCount, KindCounter,
+		&Extra{
+			Help: "bucket quotas: number of PUTs rejected upon exceeding the bucket quota (quota.max_bytes, quota.max_objects)",
+		},
+	)
 
 	r.reg(snode, IOErrGetCount, KindCounter,
 		&Extra{
@@ -380,6 +469,11 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "intra-cluster streaming communications: total cumulative size (bytes) of all transmitted objects",
 		},
 	)
+	r.reg(snode, cos.StreamsOutZeroCopySize, KindSize,
+		&Extra{
+			Help: "intra-cluster streaming communications: total cumulative size (bytes) of object payloads transmitted via zero-copy (sendfile/splice)",
+		},
+	)
 	r.reg(snode, cos.StreamsInObjCount, KindCounter,
 		&Extra{
 			Help: "intra-cluster streaming communications: number of received objects",
@@ -390,6 +484,9 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "intra-cluster streaming communications: total cumulative size (bytes) of all received objects",
 		},
 	)
+	for _, kind := range cos.StreamKinds {
+		r.regStreamKind(snode, kind)
+	}
 
 	// download
 	r.reg(snode, DownloadSize, KindSize,
@@ -569,6 +666,7 @@ func (r *Trunner) log(now int64, uptime time.Duration, config *cmn.Config) {
 		v = s.Tracker[r.nameUtil(disk)]
 		v.Value = stats.Util
 	}
+	s.Tracker[ThrottleLatencyTotal].Value = int64(fs.ThrottleTotal())
 
 	// 2 copy stats, reset latencies, send via StatsD if configured
 	s.updateUptime(uptime)
@@ -670,6 +768,8 @@ func (r *Trunner) _cap(config *cmn.Config, now int64) (set, clr cos.NodeStateFla
 		debug.Assert(!cs.IsOOS(), cs.String())
 		errCap = cmn.NewErrCapExceeded(cs.TotalUsed, cs.TotalAvail+cs.TotalUsed, 0, config.Space.CleanupWM, cs.PctMax, false)
 		r.t.OOS(pcs, config, &r.Tcdf)
+	} else if updated && cs.EvictEarly(config) { // predicted high-wm
+		r.t.OOS(pcs, config, &r.Tcdf)
 	}
 
 	//
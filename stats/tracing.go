@@ -0,0 +1,376 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Distributed tracing (config.Tracing):
+// - W3C trace context: proxy accepts `traceparent` header or, when there's none, starts a new
+//   trace subject to config.Tracing.SamplerProbability (incoming traces follow the caller's decision);
+// - redirects cannot carry headers, which is why proxy passes its span's context to the target
+//   via apc.QparamTraceparent query;
+// - spans: "ais.proxy.get|put" (routing), "ais.target.get|put" (lock, disk IO, transmit - as
+//   attributes), and "ais.backend.get" (cold GET from remote backend);
+// - spans are batched and exported to config.Tracing.ExporterEndpoint via OTLP/HTTP (JSON encoding);
+// - export is best-effort: when the exporter falls behind (or the collector is unreachable)
+//   spans get dropped.
+
+const (
+	SpanKindServer = 2 // (OTLP enum)
+	SpanKindClient = 3
+)
+
+const (
+	tpVersion  = "00"
+	tpSampled  = 0x01
+	tpLen      = 55 // "00-" + 32 + "-" + 16 + "-" + 2
+	otlpPath   = "/v1/traces"
+	otlpScope  = "aistore"
+	dfltSvcPfx = "ais"
+
+	spanChanSize = 4096
+	spanBatch    = 512
+	spanFlushIvl = 5 * time.Second
+)
+
+type (
+	Span struct {
+		name     string
+		attrs    []spanAttr
+		errmsg   string
+		started  int64 // unix nanoseconds
+		kind     int
+		traceID  [16]byte
+		spanID   [8]byte
+		parentID [8]byte // zero when root
+	}
+	spanAttr struct {
+		val any
+		key string
+	}
+	tracer struct {
+		snode   *meta.Snode
+		ch      chan *otlpSpan
+		client  *http.Client
+		dropped atomic.Int64
+		once    sync.Once
+	}
+
+	// OTLP/HTTP JSON (see opentelemetry-proto: trace/v1/trace.proto)
+	otlpSpan struct {
+		TraceID      string      `json:"traceId"`
+		SpanID       string      `json:"spanId"`
+		ParentSpanID string      `json:"parentSpanId,omitempty"`
+		Name         string      `json:"name"`
+		Start        string      `json:"startTimeUnixNano"`
+		End          string      `json:"endTimeUnixNano"`
+		Attrs        []otlpAttr  `json:"attributes,omitempty"`
+		Status       *otlpStatus `json:"status,omitempty"`
+		Kind         int         `json:"kind"`
+	}
+	otlpAttr struct {
+		Key   string         `json:"key"`
+		Value map[string]any `json:"value"`
+	}
+	otlpStatus struct {
+		Message string `json:"message,omitempty"`
+		Code    int    `json:"code"` // 2: error
+	}
+	otlpScopeSpans struct {
+		Scope struct {
+			Name string `json:"name"`
+		} `json:"scope"`
+		Spans []*otlpSpan `json:"spans"`
+	}
+	otlpResourceSpans struct {
+		Resource struct {
+			Attrs []otlpAttr `json:"attributes"`
+		} `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+	otlpRequest struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+)
+
+var trc tracer
+
+// parse W3C `traceparent`: version-traceid-parentid-flags
+func parseTraceparent(tp string) (traceID [16]byte, spanID [8]byte, sampled, ok bool) {
+	tp = strings.TrimSpace(tp)
+	if len(tp) < tpLen || tp[2] != '-' || tp[35] != '-' || tp[52] != '-' {
+		return
+	}
+	if tp[:2] == "ff" || (tp[:2] == tpVersion && len(tp) != tpLen) {
+		return
+	}
+	if _, err := hex.Decode(traceID[:], []byte(tp[3:35])); err != nil {
+		return
+	}
+	if _, err := hex.Decode(spanID[:], []byte(tp[36:52])); err != nil {
+		return
+	}
+	flags, err := strconv.ParseUint(tp[53:55], 16, 8)
+	if err != nil || traceID == [16]byte{} || spanID == [8]byte{} {
+		return
+	}
+	return traceID, spanID, flags&tpSampled != 0, true
+}
+
+// StartSpan starts server span that continues the caller's trace (given its `traceparent`)
+// or starts a new one; returns nil when tracing is disabled or the trace is not sampled.
+// All Span methods are nil-safe.
+func StartSpan(tconf *cmn.TracingConf, name, traceparent string) *Span {
+	return startSpan(tconf, name, traceparent, true)
+}
+
+// ContinueSpan is StartSpan that never starts a new trace
+// (e.g., the request was redirected by a proxy that did not sample it)
+func ContinueSpan(tconf *cmn.TracingConf, name, traceparent string) *Span {
+	if traceparent == "" {
+		return nil
+	}
+	return startSpan(tconf, name, traceparent, false)
+}
+
+func startSpan(tconf *cmn.TracingConf, name, traceparent string, root bool) *Span {
+	if !tconf.Enabled {
+		return nil
+	}
+	s := &Span{name: name, kind: SpanKindServer, started: time.Now().UnixNano()}
+	if traceID, parentID, sampled, ok := parseTraceparent(traceparent); ok {
+		if !sampled {
+			return nil
+		}
+		s.traceID, s.parentID = traceID, parentID
+	} else {
+		if !root || tconf.SamplerProbability <= 0 || rand.Float64() >= tconf.SamplerProbability {
+			return nil
+		}
+		for s.traceID == [16]byte{} {
+			putUint64(s.traceID[:8], rand.Uint64())
+			putUint64(s.traceID[8:], rand.Uint64())
+		}
+	}
+	s.newID()
+	return s
+}
+
+func (s *Span) newID() {
+	for s.spanID == [8]byte{} {
+		putUint64(s.spanID[:], rand.Uint64())
+	}
+}
+
+// Child starts a new span within the same trace
+func (s *Span) Child(name string, kind int) *Span {
+	if s == nil {
+		return nil
+	}
+	c := &Span{name: name, kind: kind, started: time.Now().UnixNano(), traceID: s.traceID, parentID: s.spanID}
+	c.newID()
+	return c
+}
+
+// Set adds span attribute (string, bool, integer, or float64 value)
+func (s *Span) Set(key string, val any) {
+	if s == nil {
+		return
+	}
+	s.attrs = append(s.attrs, spanAttr{key: key, val: val})
+}
+
+// Traceparent returns W3C `traceparent` to propagate this span's context
+func (s *Span) Traceparent() string {
+	if s == nil {
+		return ""
+	}
+	var sb strings.Builder
+	sb.Grow(tpLen)
+	sb.WriteString(tpVersion)
+	sb.WriteByte('-')
+	sb.WriteString(hex.EncodeToString(s.traceID[:]))
+	sb.WriteByte('-')
+	sb.WriteString(hex.EncodeToString(s.spanID[:]))
+	sb.WriteString("-01")
+	return sb.String()
+}
+
+// End completes the span and queues it for export
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+	if err != nil {
+		s.errmsg = err.Error()
+	}
+	trc.once.Do(trc.init)
+	select {
+	case trc.ch <- s.otlp(time.Now().UnixNano()):
+	default:
+		trc.dropped.Inc()
+	}
+}
+
+func (s *Span) otlp(ended int64) *otlpSpan {
+	o := &otlpSpan{
+		TraceID: hex.EncodeToString(s.traceID[:]),
+		SpanID:  hex.EncodeToString(s.spanID[:]),
+		Name:    s.name,
+		Kind:    s.kind,
+		Start:   strconv.FormatInt(s.started, 10),
+		End:     strconv.FormatInt(ended, 10),
+	}
+	if s.parentID != [8]byte{} {
+		o.ParentSpanID = hex.EncodeToString(s.parentID[:])
+	}
+	if len(s.attrs) > 0 {
+		o.Attrs = make([]otlpAttr, 0, len(s.attrs))
+		for _, a := range s.attrs {
+			o.Attrs = append(o.Attrs, otlpAttr{Key: a.key, Value: otlpValue(a.val)})
+		}
+	}
+	if s.errmsg != "" {
+		o.Status = &otlpStatus{Code: 2, Message: s.errmsg}
+	}
+	return o
+}
+
+func otlpValue(val any) map[string]any {
+	switch v := val.(type) {
+	case string:
+		return map[string]any{"stringValue": v}
+	case bool:
+		return map[string]any{"boolValue": v}
+	case int:
+		return map[string]any{"intValue": strconv.Itoa(v)}
+	case int64:
+		return map[string]any{"intValue": strconv.FormatInt(v, 10)}
+	case time.Duration:
+		return map[string]any{"intValue": strconv.FormatInt(int64(v), 10)}
+	case float64:
+		return map[string]any{"doubleValue": v}
+	default:
+		return map[string]any{"stringValue": fmt.Sprint(v)}
+	}
+}
+
+func putUint64(b []byte, v uint64) {
+	for i := range 8 {
+		b[i] = byte(v >> (56 - 8*i))
+	}
+}
+
+////////////
+// tracer //
+////////////
+
+func (t *tracer) init() {
+	t.ch = make(chan *otlpSpan, spanChanSize)
+	t.client = cmn.NewClient(cmn.TransportArgs{Timeout: spanFlushIvl})
+	go t.run()
+}
+
+func (t *tracer) run() {
+	var (
+		batch  = make([]*otlpSpan, 0, spanBatch)
+		ticker = time.NewTicker(spanFlushIvl)
+	)
+	for {
+		select {
+		case s := <-t.ch:
+			batch = append(batch, s)
+			if len(batch) < spanBatch {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+		t.flush(batch)
+		clear(batch)
+		batch = batch[:0]
+	}
+}
+
+func (t *tracer) flush(batch []*otlpSpan) {
+	tconf := &cmn.GCO.Get().Tracing
+	if !tconf.Enabled || tconf.ExporterEndpoint == "" {
+		return // (disabled at runtime)
+	}
+	if n := t.dropped.Swap(0); n > 0 {
+		nlog.Warningln("tracing: dropped", n, "span(s)")
+	}
+	var (
+		req    otlpRequest
+		rs     otlpResourceSpans
+		ss     otlpScopeSpans
+		prefix = tconf.ServiceNamePrefix
+	)
+	if prefix == "" {
+		prefix = dfltSvcPfx
+	}
+	svc, inst := prefix, ""
+	if t.snode != nil {
+		svc += "-" + t.snode.Type()
+		inst = t.snode.ID()
+	}
+	rs.Resource.Attrs = []otlpAttr{
+		{Key: "service.name", Value: otlpValue(svc)},
+		{Key: "service.instance.id", Value: otlpValue(inst)},
+	}
+	ss.Scope.Name = otlpScope
+	ss.Spans = batch
+	rs.ScopeSpans = []otlpScopeSpans{ss}
+	req.ResourceSpans = []otlpResourceSpans{rs}
+
+	body, err := jsoniter.Marshal(&req)
+	if err != nil {
+		nlog.Errorln("tracing: failed to marshal spans:", err)
+		return
+	}
+	if err := t.post(strings.TrimSuffix(tconf.ExporterEndpoint, "/")+otlpPath, body); err != nil {
+		nlog.Warningln("tracing: failed to export", len(batch), "span(s):", err)
+	}
+}
+
+func (t *tracer) post(url string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(cos.HdrContentType, cos.ContentJSON)
+	resp, err := t.client.Do(req) //nolint:bodyclose // closed below
+	if err != nil {
+		return err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return errors.New("OTLP collector responded with status " + resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+// Package sys provides methods to read system information
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package sys
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	ratomic "sync/atomic"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// GPU utilization and memory - for deployments that co-locate storage targets with GPUs.
+// Optional (see cmn.GPUConf) and sourced from either:
+// - nvidia-smi (must be in the $PATH), or
+// - DCGM exporter (https://github.com/NVIDIA/dcgm-exporter) - Prometheus text format.
+// Scraped periodically by the stats runner (and not inline with the node status requests).
+
+const (
+	GPUSrcSMI  = "nvidia-smi"
+	GPUSrcDCGM = "dcgm"
+)
+
+type GPUStat struct {
+	Name     string  `json:"name"`
+	UUID     string  `json:"uuid,omitempty"`
+	Index    int     `json:"index"`
+	PctUtil  float64 `json:"pct_util"`
+	MemUsed  uint64  `json:"mem_used"`
+	MemTotal uint64  `json:"mem_total"`
+	Temp     int     `json:"temp,omitempty"`  // degrees Celsius
+	Power    float64 `json:"power,omitempty"` // watts
+}
+
+var smiQuery = []string{
+	"--query-gpu=index,name,uuid,utilization.gpu,memory.used,memory.total,temperature.gpu,power.draw",
+	"--format=csv,noheader,nounits",
+}
+
+// most recently scraped (nil when disabled or failing)
+var gpus ratomic.Pointer[[]GPUStat]
+
+func GPUs() []GPUStat {
+	if p := gpus.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+func SetGPUs(stats []GPUStat) {
+	if stats == nil {
+		gpus.Store(nil)
+		return
+	}
+	gpus.Store(&stats)
+}
+
+func ScrapeGPUs(ctx context.Context, source, endpoint string) ([]GPUStat, error) {
+	switch source {
+	case GPUSrcSMI:
+		out, err := exec.CommandContext(ctx, GPUSrcSMI, smiQuery...).Output()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", GPUSrcSMI, err)
+		}
+		return ParseSMI(out)
+	case GPUSrcDCGM:
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", GPUSrcDCGM, err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("%s: %s returned %s", GPUSrcDCGM, endpoint, resp.Status)
+		}
+		return ParseDCGM(resp.Body)
+	default:
+		return nil, fmt.Errorf("unknown GPU stats source %q", source)
+	}
+}
+
+// parse `nvidia-smi` CSV output (see smiQuery); memory is reported in MiB
+func ParseSMI(out []byte) ([]GPUStat, error) {
+	var stats []GPUStat
+	for _, line := range strings.Split(string(bytes.TrimSpace(out)), "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		fields := strings.Split(line, ",")
+		if len(fields) < 6 {
+			return nil, fmt.Errorf("%s: unexpected output %q", GPUSrcSMI, line)
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		idx, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid GPU index in %q", GPUSrcSMI, line)
+		}
+		g := GPUStat{Index: idx, Name: fields[1], UUID: fields[2]}
+		g.PctUtil = _smiFloat(fields[3])
+		g.MemUsed = uint64(_smiFloat(fields[4])) * cos.MiB
+		g.MemTotal = uint64(_smiFloat(fields[5])) * cos.MiB
+		if len(fields) > 6 {
+			g.Temp = int(_smiFloat(fields[6]))
+		}
+		if len(fields) > 7 {
+			g.Power = _smiFloat(fields[7])
+		}
+		stats = append(stats, g)
+	}
+	return stats, nil
+}
+
+// (unsupported values are reported as "[N/A]", "[Not Supported]", etc.)
+func _smiFloat(s string) float64 {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+//
+// DCGM exporter, e.g.:
+// DCGM_FI_DEV_GPU_UTIL{gpu="0",UUID="GPU-5fd4...",device="nvidia0",modelName="NVIDIA A100-SXM4-80GB",Hostname="h1"} 87
+//
+
+const (
+	dcgmUtil  = "DCGM_FI_DEV_GPU_UTIL"
+	dcgmUsed  = "DCGM_FI_DEV_FB_USED" // MiB
+	dcgmFree  = "DCGM_FI_DEV_FB_FREE" // ditto
+	dcgmRsrvd = "DCGM_FI_DEV_FB_RESERVED"
+	dcgmTemp  = "DCGM_FI_DEV_GPU_TEMP"
+	dcgmPower = "DCGM_FI_DEV_POWER_USAGE"
+)
+
+var dcgmLabel = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+func ParseDCGM(r io.Reader) ([]GPUStat, error) {
+	var (
+		byIdx   = make(map[int]*GPUStat, 8)
+		scanner = bufio.NewScanner(r)
+	)
+	scanner.Buffer(make([]byte, 0, 4096), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || !strings.HasPrefix(line, "DCGM_FI_") {
+			continue
+		}
+		lb, rb := strings.IndexByte(line, '{'), strings.LastIndexByte(line, '}')
+		if lb < 0 || rb < lb {
+			continue
+		}
+		var (
+			name   = line[:lb]
+			labels = line[lb+1 : rb]
+			fields = strings.Fields(line[rb+1:]) // value [timestamp]
+		)
+		if len(fields) == 0 {
+			continue
+		}
+		val, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			continue
+		}
+		g := _dcgmGPU(byIdx, labels)
+		if g == nil {
+			continue
+		}
+		switch name {
+		case dcgmUtil:
+			g.PctUtil = val
+		case dcgmUsed:
+			g.MemUsed = uint64(val) * cos.MiB
+			g.MemTotal += uint64(val) * cos.MiB
+		case dcgmFree, dcgmRsrvd:
+			g.MemTotal += uint64(val) * cos.MiB
+		case dcgmTemp:
+			g.Temp = int(val)
+		case dcgmPower:
+			g.Power = val
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", GPUSrcDCGM, err)
+	}
+	if len(byIdx) == 0 {
+		return nil, errors.New(GPUSrcDCGM + ": no GPU metrics found")
+	}
+	stats := make([]GPUStat, 0, len(byIdx))
+	for _, g := range byIdx {
+		stats = append(stats, *g)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Index < stats[j].Index })
+	return stats, nil
+}
+
+func _dcgmGPU(byIdx map[int]*GPUStat, labels string) *GPUStat {
+	var (
+		idx        = -1
+		uuid, name string
+	)
+	for _, m := range dcgmLabel.FindAllStringSubmatch(labels, -1) {
+		switch m[1] {
+		case "gpu":
+			if v, err := strconv.Atoi(m[2]); err == nil {
+				idx = v
+			}
+		case "UUID":
+			uuid = m[2]
+		case "modelName":
+			name = m[2]
+		}
+	}
+	if idx < 0 {
+		return nil
+	}
+	g, ok := byIdx[idx]
+	if !ok {
+		g = &GPUStat{Index: idx, UUID: uuid, Name: name}
+		byIdx[idx] = g
+	}
+	return g
+}
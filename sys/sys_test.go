@@ -10,6 +10,7 @@ import (
 	"math"
 	"os"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
@@ -113,3 +114,36 @@ func TestProc(t *testing.T) {
 	tassert.Errorf(t, newStats.CPU.Percent > 0.0, "Process must use some CPU. Usage: %g", stats.CPU.Percent)
 	t.Logf("Process CPU usage: %6.2f%%", newStats.CPU.Percent)
 }
+
+func TestParseGPUs(t *testing.T) {
+	smi := []byte(`0, NVIDIA A100-SXM4-80GB, GPU-5fd4a1c2, 87, 40536, 81920, 61, 312.45
+1, NVIDIA A100-SXM4-80GB, GPU-7e01b3d4, 0, 4, 81920, 33, [N/A]
+`)
+	gpus, err := sys.ParseSMI(smi)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(gpus) == 2, "expecting 2 GPUs, got %d", len(gpus))
+	g := gpus[0]
+	tassert.Errorf(t, g.Name == "NVIDIA A100-SXM4-80GB" && g.UUID == "GPU-5fd4a1c2" && g.PctUtil == 87, "%+v", g)
+	tassert.Errorf(t, g.MemUsed == 40536*cos.MiB && g.MemTotal == 81920*cos.MiB && g.Temp == 61 && g.Power == 312.45, "%+v", g)
+	tassert.Errorf(t, gpus[1].Index == 1 && gpus[1].Power == 0, "%+v", gpus[1])
+
+	dcgm := `# HELP DCGM_FI_DEV_GPU_UTIL GPU utilization (in %).
+# TYPE DCGM_FI_DEV_GPU_UTIL gauge
+DCGM_FI_DEV_GPU_UTIL{gpu="1",UUID="GPU-7e01b3d4",device="nvidia1",modelName="NVIDIA H100",Hostname="h1"} 12
+DCGM_FI_DEV_GPU_UTIL{gpu="0",UUID="GPU-5fd4a1c2",device="nvidia0",modelName="NVIDIA H100",Hostname="h1"} 95
+DCGM_FI_DEV_FB_USED{gpu="0",UUID="GPU-5fd4a1c2",device="nvidia0",modelName="NVIDIA H100",Hostname="h1"} 1000
+DCGM_FI_DEV_FB_FREE{gpu="0",UUID="GPU-5fd4a1c2",device="nvidia0",modelName="NVIDIA H100",Hostname="h1"} 3000
+DCGM_FI_DEV_GPU_TEMP{gpu="0",UUID="GPU-5fd4a1c2",device="nvidia0",modelName="NVIDIA H100",Hostname="h1"} 70
+DCGM_FI_DEV_POWER_USAGE{gpu="0",UUID="GPU-5fd4a1c2",device="nvidia0",modelName="NVIDIA H100",Hostname="h1"} 450.5
+`
+	gpus, err = sys.ParseDCGM(strings.NewReader(dcgm))
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(gpus) == 2, "expecting 2 GPUs, got %d", len(gpus))
+	g = gpus[0]
+	tassert.Errorf(t, g.Index == 0 && g.Name == "NVIDIA H100" && g.UUID == "GPU-5fd4a1c2" && g.PctUtil == 95, "%+v", g)
+	tassert.Errorf(t, g.MemUsed == 1000*cos.MiB && g.MemTotal == 4000*cos.MiB && g.Temp == 70 && g.Power == 450.5, "%+v", g)
+	tassert.Errorf(t, gpus[1].Index == 1 && gpus[1].PctUtil == 12, "%+v", gpus[1])
+
+	_, err = sys.ParseDCGM(strings.NewReader("# no metrics\n"))
+	tassert.Errorf(t, err != nil, "expecting error")
+}
@@ -170,6 +170,8 @@ func PrepareObjects(t *testing.T, desc ObjectsDesc) *ObjectsOut {
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.ECSliceType, &fs.ECSliceContentResolver{}, true)
 	fs.CSM.Reg(fs.ECMetaType, &fs.ECMetaContentResolver{}, true)
+	fs.CSM.Reg(fs.ArchIdxType, &fs.ArchIdxContentResolver{}, true)
+	fs.CSM.Reg(fs.SnapType, &fs.SnapContentResolver{}, true)
 
 	dir := t.TempDir()
 
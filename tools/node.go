@@ -66,7 +66,7 @@ func WaitNodePubAddrNotInUse(si *meta.Snode, timeout time.Duration) error {
 	tlog.Logf("Waiting for %s to shutdown (and stop listening)\n", si.StringEx())
 	time.Sleep(interval) // not immediate
 	for elapsed := time.Duration(0); elapsed < timeout; elapsed += interval {
-		if _, err := net.DialTimeout("tcp4", addr, interval); err != nil {
+		if _, err := net.DialTimeout("tcp", addr, interval); err != nil {
 			time.Sleep(interval)
 			return nil
 		}
@@ -43,6 +43,12 @@ const (
 	// see also: cmn/config for (max, default) transport header sizes
 )
 
+// Obj.Prio (see "priority" in sendobj.go)
+const (
+	PrioNormal = iota
+	PrioHigh   // e.g., control and metadata objects that must not wait behind bulk data
+)
+
 const sizeofh = int(unsafe.Sizeof(Obj{}))
 
 type (
@@ -56,6 +62,15 @@ type (
 		SizePDU      int32         // NOTE: 0(zero): no PDUs; must be below maxSizePDU; unknown size _requires_ PDUs
 		MaxHdrSize   int32         // overrides config.Transport.MaxHeaderSize
 		ChanBurst    int           // overrides config.Transport.Burst
+		MinHdlVer    int           // receiver's handler must be at least this version (see caps.go)
+		MaxReplay    int           // max number of sent-but-unacknowledged objects to replay upon reconnect (see replay.go)
+	}
+
+	// receive-side options (see HandleOpts)
+	RxOpts struct {
+		Ver   int  // handler version (see HandleVer)
+		Stats bool // maintain receive-side session stats (see RxStats)
+		Cksum bool // validate checksums of the received objects while the handler is reading them (see rxcksum.go)
 	}
 
 	// receive-side session stats indexed by session ID (see recv.go for "uid")
@@ -70,6 +85,7 @@ type (
 		Opaque   []byte       // custom control (optional)
 		ObjAttrs cmn.ObjAttrs // attributes/metadata of the object that's being transmitted
 		Opcode   int          // (see reserved range above)
+		seq      int64        // sequence number (replay only)
 	}
 	// object to transmit
 	Obj struct {
@@ -78,6 +94,7 @@ type (
 		Callback ObjSentCB     // called when the last byte is sent _or_ when the stream terminates (see term.reason)
 		prc      *atomic.Int64 // private; if present, ref-counts so that we call ObjSentCB only once
 		Hdr      ObjHdr
+		Prio     int // PrioNormal (default) or PrioHigh - to overtake normal-priority objects in the send queue
 	}
 
 	// object-sent callback that has the following signature can optionally be defined on a:
@@ -118,10 +135,15 @@ func NewObjStream(client Client, dstURL, dstID string, extra *Extra) (s *Stream)
 	if extra.Compressed() {
 		s.initCompression(extra)
 	}
+	s.zeroCopy = zeroCopyOK(extra, dstURL)
+	if extra.MaxReplay > 0 {
+		s.rpl = newTxReplay(s, extra.MaxReplay)
+	}
 	debug.Assert(s.usePDU() == extra.UsePDU())
 
 	chsize := burst(extra)             // num objects the caller can post without blocking
 	s.workCh = make(chan *Obj, chsize) // Send Qeueue (SQ)
+	s.prioCh = make(chan *Obj, chsize) // high-priority SQ
 	s.cmplCh = make(chan cmpl, chsize) // Send Completion Queue (SCQ)
 
 	s.wg.Add(2)
@@ -138,6 +160,8 @@ func NewObjStream(client Client, dstURL, dstID string, extra *Extra) (s *Stream)
 // queue realized as workCh, and the latter is a send completion queue (cmplCh).
 // Together SQ and SCQ form a FIFO.
 //
+//   - objects with Prio = PrioHigh are posted to a separate (high-priority) SQ
+//     and overtake normal-priority objects - see dequeue() for details.
 //   - header-only objects are supported; when there's no data to send (that is,
 //     when the header's Dsize field is set to zero), the reader is not required and the
 //     corresponding argument in Send() can be set to nil.
@@ -158,8 +182,12 @@ func (s *Stream) Send(obj *Obj) (err error) {
 		return
 	}
 
-	s.workCh <- obj
-	if l, c := len(s.workCh), cap(s.workCh); l > (c - c>>2) {
+	ch := s.workCh
+	if obj.Prio > PrioNormal {
+		ch = s.prioCh
+	}
+	ch <- obj
+	if l, c := len(ch), cap(ch); l > (c - c>>2) {
 		runtime.Gosched() // poor man's throttle
 		if l == c {
 			s.chanFull.Inc()
@@ -178,14 +206,28 @@ func (s *Stream) Fin() {
 //////////////////////
 
 func Handle(trname string, rxObj RecvObj, withStats ...bool) error {
-	var h handler
-	if len(withStats) > 0 && withStats[0] {
+	return HandleVer(trname, 0, rxObj, withStats...)
+}
+
+// same as above with a given handler version - to be incremented upon any
+// incompatible change in the way the handler interprets received headers and payloads
+// (see caps.go for capability negotiation and Extra.MinHdlVer)
+func HandleVer(trname string, ver int, rxObj RecvObj, withStats ...bool) error {
+	return HandleOpts(trname, rxObj, &RxOpts{Ver: ver, Stats: len(withStats) > 0 && withStats[0]})
+}
+
+func HandleOpts(trname string, rxObj RecvObj, opts *RxOpts) error {
+	var (
+		h   handler
+		hdl = hdl{trname: trname, rxObj: rxObj, kst: streamKind(trname), hver: opts.Ver, cksum: opts.Cksum}
+	)
+	if opts.Stats {
 		hkName := ObjURLPath(trname)
-		hex := &hdlExtra{hdl: hdl{trname: trname, rxObj: rxObj}, hkName: hkName}
+		hex := &hdlExtra{hdl: hdl, hkName: hkName}
 		hk.Reg(hkName+hk.NameSuffix, hex.cleanup, sessionIsOld)
 		h = hex
 	} else {
-		h = &hdl{trname: trname, rxObj: rxObj}
+		h = &hdl
 	}
 	return oput(trname, h)
 }
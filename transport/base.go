@@ -60,6 +60,9 @@ type (
 		abortPending(error, bool)
 		errCmpl(error)
 		resetCompression()
+		negotiate() error
+		rplToken() (string, bool)
+		reconnect(error, int) bool
 		// gc
 		closeAndFree()
 		drain(err error)
@@ -84,19 +87,27 @@ type (
 			mu     sync.Mutex
 			done   atomic.Bool
 		}
-		stats Stats // stream stats (send side - compare with rxStats)
+		peer struct {
+			caps  Caps // receiver's caps (see caps.go)
+			mu    sync.Mutex
+			known bool
+		}
+		stats Stats      // stream stats (send side - compare with rxStats)
+		kst   *kindStats // target stats by stream kind
 		time  struct {
 			idleTeardown time.Duration // idle timeout
 			inSend       atomic.Bool   // true upon Send() or Read() - info for Collector to delay cleanup
 			ticks        int           // num 1s ticks until idle timeout
 			index        int           // heap stuff
+			offset       int64         // stream offset as of the previous tick (to tell idle)
 		}
-		wg       sync.WaitGroup
-		sessST   atomic.Int64 // state of the TCP/HTTP session: active (connected) | inactive (disconnected)
-		sessID   int64        // stream session ID
-		numCur   int64        // gets reset to zero upon each timeout
-		sizeCur  int64        // ditto
-		chanFull atomic.Int64
+		wg        sync.WaitGroup
+		sessST    atomic.Int64 // state of the TCP/HTTP session: active (connected) | inactive (disconnected)
+		sessID    int64        // stream session ID
+		minHdlVer int          // Extra.MinHdlVer
+		numCur    int64        // gets reset to zero upon each timeout
+		sizeCur   int64        // ditto
+		chanFull  atomic.Int64
 	}
 )
 
@@ -111,10 +122,11 @@ func newBase(client Client, dstURL, dstID string, extra *Extra) (s *streamBase)
 	)
 	debug.AssertNoErr(err)
 
-	s = &streamBase{client: client, dstURL: dstURL, dstID: dstID}
+	s = &streamBase{client: client, dstURL: dstURL, dstID: dstID, minHdlVer: extra.MinHdlVer}
 
 	s.sessID = nextSessionID.Inc()
 	s.trname = path.Base(u.Path)
+	s.kst = streamKind(s.trname)
 
 	s.lastCh.Init()
 	s.stopCh.Init()
@@ -232,6 +244,10 @@ func (s *streamBase) GetStats() (stats Stats) {
 	stats.Offset.Store(s.stats.Offset.Load())
 	stats.Size.Store(s.stats.Size.Load())
 	stats.CompressedSize.Store(s.stats.CompressedSize.Load())
+	stats.ZeroCopySize.Store(s.stats.ZeroCopySize.Load())
+	stats.Replayed.Store(s.stats.Replayed.Load())
+	stats.NumPrio.Store(s.stats.NumPrio.Load())
+	stats.PrioYield.Store(s.stats.PrioYield.Load())
 	return
 }
 
@@ -260,6 +276,14 @@ func (s *streamBase) isNextReq() (reason string) {
 	}
 }
 
+// one session: negotiate and PUT
+func (s *streamBase) request() error {
+	if err := s.streamer.negotiate(); err != nil {
+		return err
+	}
+	return s.streamer.doRequest()
+}
+
 func (s *streamBase) deactivate() (n int, err error) {
 	err = io.EOF
 	if cmn.Rom.FastV(5, cos.SmoduleTransport) {
@@ -273,12 +297,17 @@ func (s *streamBase) sendLoop(dryrun bool) {
 		err     error
 		reason  string
 		retried bool
+		nrc     int // reconnects in a row (see replay.go)
 	)
 	for {
 		if s.sessST.Load() == active {
 			if dryrun {
 				s.streamer.dryrun()
-			} else if errR := s.streamer.doRequest(); errR != nil {
+			} else if errR := s.request(); errR != nil {
+				if s.streamer.reconnect(errR, nrc) {
+					nrc++
+					continue
+				}
 				if !cos.IsRetriableConnErr(err) || retried {
 					reason = reasonError
 					err = errR
@@ -288,6 +317,8 @@ func (s *streamBase) sendLoop(dryrun bool) {
 				retried = true
 				nlog.Errorln(s.String(), "err: ", errR, "- retrying...")
 				time.Sleep(connErrWait)
+			} else {
+				nrc = 0
 			}
 		}
 		if reason = s.isNextReq(); reason != "" {
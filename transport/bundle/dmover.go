@@ -49,6 +49,8 @@ type (
 		}
 		sizePDU    int32
 		maxHdrSize int32
+		maxReplay  int
+		cksum      bool
 	}
 	// additional (and optional) params for new data mover
 	Extra struct {
@@ -58,6 +60,8 @@ type (
 		Multiplier  int
 		SizePDU     int32
 		MaxHdrSize  int32
+		MaxReplay   int  // see transport.Extra
+		Cksum       bool // see transport.RxOpts
 	}
 )
 
@@ -74,6 +78,8 @@ func NewDataMover(trname string, recvCB transport.RecvObj, owt cmn.OWT, extra Ex
 	dm.owt = owt
 	dm.multiplier = extra.Multiplier
 	dm.sizePDU, dm.maxHdrSize = extra.SizePDU, extra.MaxHdrSize
+	dm.maxReplay = extra.MaxReplay
+	dm.cksum = extra.Cksum
 	switch extra.Compression {
 	case "":
 		dm.compression = apc.CompressNever
@@ -111,7 +117,7 @@ func (dm *DataMover) GetXact() core.Xact     { return dm.xctn }
 
 // register user's receive-data (and, optionally, receive-ack) wrappers
 func (dm *DataMover) RegRecv() (err error) {
-	if err = transport.Handle(dm.data.trname, dm.wrapRecvData); err != nil {
+	if err = transport.HandleOpts(dm.data.trname, dm.wrapRecvData, &transport.RxOpts{Cksum: dm.cksum}); err != nil {
 		return
 	}
 	if dm.useACKs() {
@@ -130,6 +136,7 @@ func (dm *DataMover) Open() {
 			Config:      dm.config,
 			SizePDU:     dm.sizePDU,
 			MaxHdrSize:  dm.maxHdrSize,
+			MaxReplay:   dm.maxReplay,
 		},
 		Ntype:        core.Targets,
 		Multiplier:   dm.multiplier,
@@ -0,0 +1,205 @@
+// Package transport provides long-lived http/tcp connections for
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Capability negotiation (rolling upgrade and mixed-version clusters):
+// - at the start of each session (upon the very first send and after each idle teardown) the sender
+//   queries the receiver (GET <dstURL>) for its capabilities: transport protocol version, supported
+//   features, and the version of the Rx handler registered under the stream's trname (see HandleVer);
+// - a receiver that predates negotiation responds with no caps at all - and is then treated as
+//   protocol version 0 that supports all features existing at the time (featLegacy);
+// - the sender then degrades gracefully: it stops compressing when the receiver cannot decompress,
+//   and fails fast (with a descriptive error) when the receiver cannot handle PDUs
+//   or when the receiver's handler is older than Extra.MinHdlVer;
+// - higher-level callers can check Stream.PeerCaps() to adjust payloads of their own (opaque headers, etc.);
+// - finally, each PUT carries the sender's caps (apc.HdrStreamCaps) in use, so that the receiver can reject
+//   a stream it doesn't understand instead of misinterpreting it.
+
+// transport protocol version (to increment upon incompatible on-the-wire changes)
+const ProtoVer = 1
+
+// features
+const (
+	FeatLZ4 uint64 = 1 << iota // lz4 compression
+	FeatPDU                    // PDU-based (and unsized) transmission
+	FeatSeq                    // sequence-numbered objects and replay upon reconnect (see replay.go)
+)
+
+const (
+	featAll    = FeatLZ4 | FeatPDU | FeatSeq
+	featLegacy = FeatLZ4 | FeatPDU // (pre-negotiation receivers)
+)
+
+type (
+	Caps struct {
+		Proto  int    // transport protocol version
+		Feat   uint64 // bitwise features
+		HdlVer int    // Rx handler version (see HandleVer)
+		Legacy bool   // pre-negotiation receiver
+	}
+	ErrIncompatible struct {
+		peer   string
+		reason string
+	}
+)
+
+func (c *Caps) Has(feat uint64) bool { return c.Feat&feat == feat }
+
+// wire format: "<proto>,<feat>,<handler version>"
+func (c *Caps) String() string {
+	return strconv.Itoa(c.Proto) + "," + strconv.FormatUint(c.Feat, 16) + "," + strconv.Itoa(c.HdlVer)
+}
+
+func parseCaps(s string) (c Caps, err error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 3 {
+		return c, fmt.Errorf("invalid stream caps %q", s)
+	}
+	if c.Proto, err = strconv.Atoi(parts[0]); err != nil {
+		return c, fmt.Errorf("invalid stream caps %q: %v", s, err)
+	}
+	if c.Feat, err = strconv.ParseUint(parts[1], 16, 64); err != nil {
+		return c, fmt.Errorf("invalid stream caps %q: %v", s, err)
+	}
+	if c.HdlVer, err = strconv.Atoi(parts[2]); err != nil {
+		return c, fmt.Errorf("invalid stream caps %q: %v", s, err)
+	}
+	return c, nil
+}
+
+func legacyCaps() Caps { return Caps{Feat: featLegacy, Legacy: true} }
+
+func IsErrIncompatible(err error) bool {
+	var e *ErrIncompatible
+	return errors.As(err, &e)
+}
+
+func (e *ErrIncompatible) Error() string {
+	return "incompatible stream peer " + e.peer + ": " + e.reason
+}
+
+//
+// Rx
+//
+
+// GET <trname>: respond with this node's caps
+// (and, if requested, the last sequence number delivered for a given stream)
+func rxCaps(w http.ResponseWriter, r *http.Request, h handler, trname string) {
+	caps := Caps{Proto: ProtoVer, Feat: featAll, HdlVer: h.ver()}
+	w.Header().Set(apc.HdrStreamCaps, caps.String())
+	rxLastSeq(w, r, trname)
+	w.WriteHeader(http.StatusOK)
+}
+
+// PUT <trname>: check the sender's caps (if present)
+func rxCheckCaps(r *http.Request, trname string) error {
+	s := r.Header.Get(apc.HdrStreamCaps)
+	if s == "" {
+		return nil // older sender
+	}
+	caps, err := parseCaps(s)
+	if err != nil {
+		return err
+	}
+	switch {
+	case caps.Proto > ProtoVer:
+		return &ErrIncompatible{trname, fmt.Sprintf("protocol version %d (expecting %d or older)", caps.Proto, ProtoVer)}
+	case caps.Feat&^featAll != 0:
+		return &ErrIncompatible{trname, fmt.Sprintf("unsupported features %x (have %x)", caps.Feat&^featAll, featAll)}
+	}
+	return nil
+}
+
+//
+// Tx
+//
+
+// PeerCaps returns receiver's capabilities as of the current (or last) session;
+// false if not known yet (e.g., nothing sent so far)
+func (s *streamBase) PeerCaps() (caps Caps, ok bool) {
+	s.peer.mu.Lock()
+	caps, ok = s.peer.caps, s.peer.known
+	s.peer.mu.Unlock()
+	return
+}
+
+// sender's caps in use for this session
+func (s *streamBase) txCaps() string {
+	caps := Caps{Proto: ProtoVer, HdlVer: s.minHdlVer}
+	if s.streamer.compressed() {
+		caps.Feat |= FeatLZ4
+	}
+	if s.pdu != nil {
+		caps.Feat |= FeatPDU
+	}
+	if _, on := s.streamer.rplToken(); on {
+		caps.Feat |= FeatSeq
+	}
+	s.peer.mu.Lock()
+	if s.peer.known {
+		caps.Proto = min(caps.Proto, s.peer.caps.Proto)
+	}
+	s.peer.mu.Unlock()
+	return caps.String()
+}
+
+// negotiate at the start of each session (compare with streamBase.do)
+func (s *Stream) negotiate() error {
+	hdr, last, status, err := s.getCaps()
+	if err != nil || status != http.StatusOK {
+		// e.g., connection refused or trname not registered yet - proceed as before
+		// and let the PUT itself succeed or fail
+		if cmn.Rom.FastV(4, cos.SmoduleTransport) {
+			nlog.Warningln(s.String(), "failed to negotiate: [", status, err, "]")
+		}
+		return nil
+	}
+	caps := legacyCaps()
+	if hdr != "" {
+		if caps, err = parseCaps(hdr); err != nil {
+			return err
+		}
+	}
+	s.peer.mu.Lock()
+	prev, known := s.peer.caps, s.peer.known
+	s.peer.caps, s.peer.known = caps, true
+	s.peer.mu.Unlock()
+
+	switch {
+	case s.usePDU() && !caps.Has(FeatPDU):
+		return &ErrIncompatible{s.dstID, "does not support PDU-based transmission"}
+	case caps.HdlVer < s.minHdlVer:
+		return &ErrIncompatible{s.dstID, fmt.Sprintf("%q handler version %d (expecting %d or newer)",
+			s.trname, caps.HdlVer, s.minHdlVer)}
+	}
+	if s.lz4s != nil {
+		s.nolz4 = !caps.Has(FeatLZ4)
+	}
+	if s.rpl != nil {
+		s.rpl.negotiated(caps.Has(FeatSeq), last)
+	}
+	if prev != caps && (known || cmn.Rom.FastV(4, cos.SmoduleTransport)) {
+		// (e.g., peer restarted with a different version)
+		nlog.Infoln(s.String(), "peer caps:", caps.String(), "legacy:", caps.Legacy)
+	}
+	if s.nolz4 && (!known || prev.Has(FeatLZ4)) {
+		nlog.Warningln(s.String(), "peer does not support compression - sending uncompressed")
+	}
+	return nil
+}
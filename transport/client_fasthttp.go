@@ -36,20 +36,14 @@ func dialTimeout(addr string) (net.Conn, error) {
 
 // intra-cluster networking: fasthttp client
 func NewIntraDataClient() Client {
-	config := cmn.GCO.Get()
-
-	// compare with ais/httpcommon.go
-	wbuf, rbuf := config.Net.HTTP.WriteBufferSize, config.Net.HTTP.ReadBufferSize
-	if wbuf == 0 {
-		wbuf = cmn.DefaultWriteBufferSize // fasthttp uses 4KB
-	}
-	if rbuf == 0 {
-		rbuf = cmn.DefaultReadBufferSize // ditto
-	}
+	var (
+		config = cmn.GCO.Get()
+		cargs  = intraDataArgs(config) // (fasthttp uses 4KB buffers by default)
+	)
 	cl := &fasthttp.Client{
 		Dial:            dialTimeout,
-		ReadBufferSize:  rbuf,
-		WriteBufferSize: wbuf,
+		ReadBufferSize:  cargs.ReadBufferSize,
+		WriteBufferSize: cargs.WriteBufferSize,
 	}
 	if config.Net.HTTP.UseHTTPS {
 		tlsConfig, err := cmn.NewTLS(config.Net.HTTP.ToTLS(), true /*intra-cluster*/) // streams
@@ -71,6 +65,10 @@ func (s *streamBase) do(body io.Reader) (err error) {
 		req.Header.Set(apc.HdrCompress, apc.LZ4Compression)
 	}
 	req.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
+	req.Header.Set(apc.HdrStreamCaps, s.txCaps())
+	if token, on := s.streamer.rplToken(); on {
+		req.Header.Set(apc.HdrStreamReplay, token)
+	}
 	req.Header.Set(cos.HdrUserAgent, ua)
 	// do
 	err = s.client.Do(req, resp)
@@ -89,3 +87,22 @@ func (s *streamBase) do(body io.Reader) (err error) {
 	}
 	return nil
 }
+
+// (see caps.go and replay.go)
+func (s *streamBase) getCaps() (caps, last string, status int, _ error) {
+	req, resp := fasthttp.AcquireRequest(), fasthttp.AcquireResponse()
+	defer func() {
+		fasthttp.ReleaseRequest(req)
+		fasthttp.ReleaseResponse(resp)
+	}()
+	req.Header.SetMethod(http.MethodGet)
+	req.SetRequestURI(s.dstURL)
+	req.Header.Set(cos.HdrUserAgent, ua)
+	if token, _ := s.streamer.rplToken(); token != "" {
+		req.Header.Set(apc.HdrStreamReplay, token)
+	}
+	if err := s.client.Do(req, resp); err != nil {
+		return "", "", 0, err
+	}
+	return string(resp.Header.Peek(apc.HdrStreamCaps)), string(resp.Header.Peek(apc.HdrStreamReplay)), resp.StatusCode(), nil
+}
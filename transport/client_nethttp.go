@@ -28,25 +28,10 @@ func whichClient() string { return "net/http" }
 
 // intra-cluster networking: net/http client
 func NewIntraDataClient() (client *http.Client) {
-	config := cmn.GCO.Get()
-
-	// compare with ais/hcommon.go
-	wbuf, rbuf := config.Net.HTTP.WriteBufferSize, config.Net.HTTP.ReadBufferSize
-	if wbuf == 0 {
-		wbuf = cmn.DefaultWriteBufferSize
-	}
-	if rbuf == 0 {
-		rbuf = cmn.DefaultReadBufferSize
-	}
-	tcpbuf := config.Net.L4.SndRcvBufSize
-	if tcpbuf == 0 {
-		tcpbuf = cmn.DefaultSendRecvBufferSize
-	}
-	cargs := cmn.TransportArgs{
-		SndRcvBufSize:   tcpbuf,
-		WriteBufferSize: wbuf,
-		ReadBufferSize:  rbuf,
-	}
+	var (
+		config = cmn.GCO.Get()
+		cargs  = intraDataArgs(config)
+	)
 	if config.Net.HTTP.UseHTTPS {
 		client = cmn.NewClientTLS(cargs, config.Net.HTTP.ToTLS(), true /*intra-cluster*/) // streams
 	} else {
@@ -67,6 +52,10 @@ func (s *streamBase) do(body io.Reader) (err error) {
 		request.Header.Set(apc.HdrCompress, apc.LZ4Compression)
 	}
 	request.Header.Set(apc.HdrSessID, strconv.FormatInt(s.sessID, 10))
+	request.Header.Set(apc.HdrStreamCaps, s.txCaps())
+	if token, on := s.streamer.rplToken(); on {
+		request.Header.Set(apc.HdrStreamReplay, token)
+	}
 	request.Header.Set(cos.HdrUserAgent, ua)
 
 	response, err = s.client.Do(request)
@@ -83,3 +72,22 @@ func (s *streamBase) do(body io.Reader) (err error) {
 	}
 	return
 }
+
+// (see caps.go and replay.go)
+func (s *streamBase) getCaps() (caps, last string, status int, _ error) {
+	request, err := http.NewRequest(http.MethodGet, s.dstURL, http.NoBody)
+	if err != nil {
+		return "", "", 0, err
+	}
+	request.Header.Set(cos.HdrUserAgent, ua)
+	if token, _ := s.streamer.rplToken(); token != "" {
+		request.Header.Set(apc.HdrStreamReplay, token)
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return "", "", 0, err
+	}
+	cos.DrainReader(response.Body)
+	response.Body.Close()
+	return response.Header.Get(apc.HdrStreamCaps), response.Header.Get(apc.HdrStreamReplay), response.StatusCode, nil
+}
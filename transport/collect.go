@@ -151,6 +151,16 @@ func (gc *collector) update(s *streamBase, ticks int) {
 	heap.Fix(gc, s.time.index)
 }
 
+// per-kind open and idle time (target stats)
+func (*collector) tick(s *streamBase) {
+	g.tstats.Add(s.kst.outNs, int64(dfltTick))
+	if off := s.stats.Offset.Load(); off == s.time.offset {
+		g.tstats.Add(s.kst.outIdleNs, int64(dfltTick))
+	} else {
+		s.time.offset = off
+	}
+}
+
 func (gc *collector) Pop() any {
 	old := gc.heap
 	n := len(old)
@@ -182,9 +192,12 @@ func (gc *collector) do() {
 				s.streamer.closeAndFree()
 				s.streamer.abortPending(err, true /*completions*/)
 			}
-		} else if s.sessST.Load() == active {
+			continue
+		}
+		if s.sessST.Load() == active {
 			gc.update(s, s.time.ticks-1)
 		}
+		gc.tick(s)
 	}
 	for _, s := range gc.streams {
 		if s.time.ticks > 0 {
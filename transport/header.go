@@ -24,9 +24,10 @@ const (
 	pduFl                                  // is PDU
 	pduLastFl                              // is last PDU
 	pduStreamFl                            // PDU-based stream
+	seqFl                                  // object header ends with sequence number (see replay.go)
 
 	// NOTE: update when adding/changing flags :NOTE
-	allFlags = msgFl | pduFl | pduLastFl | pduStreamFl
+	allFlags = msgFl | pduFl | pduLastFl | pduStreamFl | seqFl
 
 	// all 3 headers
 	sizeProtoHdr = cos.SizeofI64 * 2
@@ -48,10 +49,16 @@ func insObjHeader(hbuf []byte, hdr *ObjHdr, usePDU bool) (off int) {
 	off = insString(off, hbuf, hdr.ObjName)
 	off = insBytes(off, hbuf, hdr.Opaque)
 	off = insAttrs(off, hbuf, &hdr.ObjAttrs)
+	if hdr.seq > 0 {
+		off = insInt64(off, hbuf, hdr.seq)
+	}
 	word1 := uint64(off - sizeProtoHdr)
 	if usePDU {
 		word1 |= pduStreamFl
 	}
+	if hdr.seq > 0 {
+		word1 |= seqFl
+	}
 	insUint64(0, hbuf, word1)
 	checksum := xoshiro256.Hash(word1)
 	insUint64(cos.SizeofI64, hbuf, checksum)
@@ -152,6 +159,17 @@ func ExtObjHeader(body []byte, hlen int) (hdr ObjHdr) {
 	return
 }
 
+// (with sequence number, if present)
+func extObjHeader(body []byte, hlen int, flags uint64) (hdr ObjHdr) {
+	if flags&seqFl == 0 {
+		return ExtObjHeader(body, hlen)
+	}
+	hlen -= cos.SizeofI64
+	hdr = ExtObjHeader(body, hlen)
+	_, hdr.seq = extInt64(hlen, body)
+	return
+}
+
 func ExtMsg(body []byte, hlen int) (msg Msg) {
 	var off int
 	off, msg.SID = extString(0, body)
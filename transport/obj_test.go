@@ -14,13 +14,16 @@ package transport_test
 // go test -v -run=Multi -tags=debug
 
 import (
+	"bytes"
 	cryptorand "crypto/rand"
 	"encoding/binary"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"math"
 	"math/rand/v2"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -523,6 +526,393 @@ func TestCompressedOne(t *testing.T) {
 	printNetworkStats()
 }
 
+func TestZeroCopy(t *testing.T) {
+	const trname = "zero-copy"
+	var (
+		tmpDir   = t.TempDir()
+		expected sync.Map // obj name => content
+		numRecv  atomic.Int64
+		random   = newRand(mono.NanoTime())
+		numObjs  = 100
+	)
+	recv := func(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		b, err := io.ReadAll(objReader)
+		tassert.CheckFatal(t, err)
+		v, ok := expected.Load(hdr.ObjName)
+		tassert.Fatalf(t, ok, "unexpected object %q", hdr.ObjName)
+		tassert.Errorf(t, string(b) == string(v.([]byte)), "%s: content mismatch (%d vs %d)", hdr.ObjName, len(b), len(v.([]byte)))
+		numRecv.Inc()
+		return nil
+	}
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+	err := transport.Handle(trname, recv)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	config := *cmn.GCO.Get() // shallow copy
+	config.Transport.ZeroCopy = true
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(),
+		&transport.Extra{Config: &config})
+
+	var zcSize int64
+	for i := range numObjs {
+		var (
+			hdr    = transport.ObjHdr{Bck: cmn.Bck{Name: "zc", Provider: apc.AIS}, ObjName: "obj-" + strconv.Itoa(i)}
+			size   = random.IntN(256*cos.KiB) + 1
+			data   = make([]byte, size)
+			reader io.ReadCloser
+		)
+		_, _ = cryptorand.Read(data)
+		switch i % 3 {
+		case 0: // local file => zero-copy
+			fqn := path.Join(tmpDir, hdr.ObjName)
+			tassert.CheckFatal(t, os.WriteFile(fqn, data, cos.PermRWR))
+			fh, err := os.Open(fqn)
+			tassert.CheckFatal(t, err)
+			reader = fh
+			zcSize += int64(size)
+		case 1: // regular read-copy-write
+			reader = io.NopCloser(bytes.NewReader(data))
+		default: // header-only
+			data = data[:0]
+		}
+		hdr.ObjAttrs.Size = int64(len(data))
+		expected.Store(hdr.ObjName, data)
+		err := stream.Send(&transport.Obj{Hdr: hdr, Reader: reader})
+		tassert.CheckFatal(t, err)
+	}
+	stream.Fin()
+
+	stats := stream.GetStats()
+	tassert.Errorf(t, numRecv.Load() == int64(numObjs), "received %d objects, expected %d", numRecv.Load(), numObjs)
+	tassert.Errorf(t, stats.ZeroCopySize.Load() == zcSize, "zero-copy size %d, expected %d", stats.ZeroCopySize.Load(), zcSize)
+}
+
+// blocks the very first read until released
+type gatedReader struct {
+	io.Reader
+	gate chan struct{}
+}
+
+func (r *gatedReader) Read(b []byte) (int, error) {
+	<-r.gate
+	return r.Reader.Read(b)
+}
+
+func (*gatedReader) Close() error { return nil }
+
+func TestPriority(t *testing.T) {
+	const (
+		trname    = "priority"
+		numNormal = 20
+		prioBurst = 16 // (see sendobj.go)
+	)
+	for _, numPrio := range []int{prioBurst / 2, prioBurst * 2} {
+		t.Run("prio-"+strconv.Itoa(numPrio), func(t *testing.T) {
+			var (
+				order []int // received: transport.Prio*
+				mu    sync.Mutex
+			)
+			recv := func(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
+				tassert.CheckFatal(t, err)
+				cos.DrainReader(objReader)
+				prio, _ := strconv.Atoi(string(hdr.Opaque))
+				mu.Lock()
+				order = append(order, prio)
+				mu.Unlock()
+				return nil
+			}
+			ts := httptest.NewServer(objmux)
+			defer ts.Close()
+			err := transport.Handle(trname, recv)
+			tassert.CheckFatal(t, err)
+			defer transport.Unhandle(trname)
+
+			httpclient := transport.NewIntraDataClient()
+			stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(),
+				&transport.Extra{ChanBurst: 4 * (numNormal + numPrio)})
+
+			// first (normal) object blocks the stream while the rest get queued
+			gate := make(chan struct{})
+			send := func(prio int, reader io.ReadCloser, size int) {
+				hdr := transport.ObjHdr{Bck: cmn.Bck{Name: trname, Provider: apc.AIS}, ObjName: cos.GenTie(), Opaque: []byte(strconv.Itoa(prio))}
+				hdr.ObjAttrs.Size = int64(size)
+				err := stream.Send(&transport.Obj{Hdr: hdr, Reader: reader, Prio: prio})
+				tassert.CheckFatal(t, err)
+			}
+			send(transport.PrioNormal, &gatedReader{Reader: bytes.NewReader(make([]byte, cos.KiB)), gate: gate}, cos.KiB)
+			time.Sleep(100 * time.Millisecond)
+			for range numNormal {
+				send(transport.PrioNormal, io.NopCloser(bytes.NewReader(make([]byte, cos.KiB))), cos.KiB)
+			}
+			for range numPrio {
+				send(transport.PrioHigh, nil, 0)
+			}
+			close(gate)
+			stream.Fin()
+
+			tassert.Fatalf(t, len(order) == 1+numNormal+numPrio, "received %d objects, expected %d", len(order), 1+numNormal+numPrio)
+			// (the first one was already in flight)
+			var (
+				expected   = min(numPrio, prioBurst)
+				overtaking int
+			)
+			for _, prio := range order[1:] {
+				if prio != transport.PrioHigh {
+					break
+				}
+				overtaking++
+			}
+			tassert.Errorf(t, overtaking == expected, "expected %d high-priority objects to overtake, got %d (%v)",
+				expected, overtaking, order)
+			stats := stream.GetStats()
+			tassert.Errorf(t, stats.NumPrio.Load() == int64(numPrio), "num-prio %d, expected %d", stats.NumPrio.Load(), numPrio)
+			if numPrio > prioBurst {
+				tassert.Errorf(t, stats.PrioYield.Load() > 0, "expected high priority to yield (starvation protection)")
+			}
+		})
+	}
+}
+
+func TestCapsNegotiation(t *testing.T) {
+	const trname = "caps"
+	var numRecv atomic.Int64
+	recv := func(_ *transport.ObjHdr, objReader io.Reader, err error) error {
+		tassert.CheckFatal(t, err)
+		cos.DrainReader(objReader)
+		numRecv.Inc()
+		return nil
+	}
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+	err := transport.HandleVer(trname, 2, recv)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	httpclient := transport.NewIntraDataClient()
+	url := ts.URL + transport.ObjURLPath(trname)
+
+	// 1. compatible
+	stream := transport.NewObjStream(httpclient, url, cos.GenTie(), &transport.Extra{MinHdlVer: 2})
+	sendText(stream, lorem, duis)
+	stream.Fin()
+	caps, ok := stream.PeerCaps()
+	tassert.Fatalf(t, ok, "peer caps not negotiated")
+	tassert.Errorf(t, caps.Proto == transport.ProtoVer && caps.HdlVer == 2 && !caps.Legacy, "unexpected peer caps %+v", caps)
+	tassert.Errorf(t, caps.Has(transport.FeatLZ4|transport.FeatPDU), "unexpected peer features %+v", caps)
+	tassert.Errorf(t, numRecv.Load() == 2, "received %d objects, expected 2", numRecv.Load())
+
+	// 2. receiver's handler is too old
+	stream = transport.NewObjStream(httpclient, url, cos.GenTie(), &transport.Extra{MinHdlVer: 3})
+	stream.Send(&transport.Obj{Hdr: transport.ObjHdr{Bck: cmn.Bck{Name: "abc", Provider: apc.AIS}, ObjName: "hdr-only"}})
+	stream.Fin()
+	tassert.Errorf(t, stream.IsTerminated(), "expecting stream to terminate")
+	_, err = stream.TermInfo()
+	tassert.Errorf(t, transport.IsErrIncompatible(err), "expecting incompatible peer, got %v", err)
+	tassert.Errorf(t, numRecv.Load() == 2, "received %d objects, expected 2", numRecv.Load())
+
+	// 3. legacy receiver (no caps): still compatible
+	legacy := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		cos.DrainReader(r.Body)
+	}))
+	defer legacy.Close()
+	stream = transport.NewObjStream(httpclient, legacy.URL, cos.GenTie(), nil)
+	sendText(stream, lorem, duis)
+	stream.Fin()
+	caps, ok = stream.PeerCaps()
+	tassert.Errorf(t, ok && caps.Legacy && caps.Proto == 0, "expecting legacy peer, got %+v", caps)
+}
+
+// breaks the (first) connection that reads past the limit
+type flakyListener struct {
+	net.Listener
+	total atomic.Int64
+	limit int64
+	once  sync.Once
+}
+
+type flakyConn struct {
+	net.Conn
+	fl *flakyListener
+}
+
+func (fl *flakyListener) Accept() (net.Conn, error) {
+	conn, err := fl.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &flakyConn{conn, fl}, nil
+}
+
+func (fc *flakyConn) Read(b []byte) (n int, err error) {
+	n, err = fc.Conn.Read(b)
+	if fc.fl.total.Add(int64(n)) > fc.fl.limit {
+		fc.fl.once.Do(func() {
+			fc.Conn.Close()
+			n, err = 0, errors.New("connection broken (test)")
+		})
+	}
+	return n, err
+}
+
+func TestReplay(t *testing.T) {
+	const trname = "replay"
+	var (
+		expected  sync.Map // obj name => content
+		received  sync.Map // obj name => num times received
+		numCmpl   atomic.Int64
+		numErrs   atomic.Int64
+		random    = newRand(mono.NanoTime())
+		numObjs   = 100
+		totalSize int64
+	)
+	recv := func(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
+		if err != nil {
+			return err // (broken connection)
+		}
+		b, err := io.ReadAll(objReader)
+		if err != nil {
+			return err // ditto
+		}
+		v, ok := expected.Load(hdr.ObjName)
+		tassert.Fatalf(t, ok, "unexpected object %q", hdr.ObjName)
+		tassert.Errorf(t, bytes.Equal(b, v.([]byte)), "%s: content mismatch (%d vs %d)", hdr.ObjName, len(b), len(v.([]byte)))
+		cnt, _ := received.LoadOrStore(hdr.ObjName, &atomic.Int64{})
+		cnt.(*atomic.Int64).Inc()
+		return nil
+	}
+	ts := httptest.NewUnstartedServer(objmux)
+	fl := &flakyListener{Listener: ts.Listener}
+	ts.Listener = fl
+	ts.Start()
+	defer ts.Close()
+	err := transport.Handle(trname, recv)
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	cb := func(_ *transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
+		numCmpl.Inc()
+		if err != nil {
+			numErrs.Inc()
+		}
+	}
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(),
+		&transport.Extra{Callback: cb, MaxReplay: numObjs})
+
+	objs := make([]*transport.Obj, 0, numObjs)
+	for i := range numObjs {
+		var (
+			hdr  = transport.ObjHdr{Bck: cmn.Bck{Name: "replay", Provider: apc.AIS}, ObjName: "obj-" + strconv.Itoa(i)}
+			data []byte
+			obj  = &transport.Obj{}
+		)
+		if i%10 != 9 {
+			data = make([]byte, random.IntN(64*cos.KiB)+1)
+			_, _ = cryptorand.Read(data)
+			obj.Reader = cos.NewByteHandle(data)
+		}
+		hdr.ObjAttrs.Size = int64(len(data))
+		totalSize += hdr.ObjAttrs.Size
+		expected.Store(hdr.ObjName, data)
+		obj.Hdr = hdr
+		objs = append(objs, obj)
+	}
+	// break the connection roughly in the middle
+	fl.limit = totalSize / 2
+
+	for _, obj := range objs {
+		err := stream.Send(obj)
+		tassert.CheckFatal(t, err)
+	}
+	stream.Fin()
+
+	stats := stream.GetStats()
+	tassert.Errorf(t, stats.Replayed.Load() > 0, "expecting replayed objects")
+	tassert.Errorf(t, numCmpl.Load() == int64(numObjs) && numErrs.Load() == 0,
+		"completions: %d (errors: %d), expected %d", numCmpl.Load(), numErrs.Load(), numObjs)
+	for i := range numObjs {
+		name := "obj-" + strconv.Itoa(i)
+		cnt, ok := received.Load(name)
+		tassert.Fatalf(t, ok, "%s: not received", name)
+		tassert.Errorf(t, cnt.(*atomic.Int64).Load() == 1, "%s: received %d times", name, cnt.(*atomic.Int64).Load())
+	}
+	tlog.Logf("replayed %d object(s)\n", stats.Replayed.Load())
+}
+
+func TestRxCksum(t *testing.T) {
+	const (
+		trname  = "rx-cksum"
+		numObjs = 40
+	)
+	var (
+		numOK, numBad, numErrs atomic.Int64
+		random                 = newRand(mono.NanoTime())
+	)
+	recv := func(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
+		if err != nil {
+			return err
+		}
+		n, err := io.Copy(io.Discard, objReader)
+		switch {
+		case err == nil:
+			tassert.Errorf(t, n == hdr.ObjAttrs.Size, "%s: received %d, expected %d", hdr.ObjName, n, hdr.ObjAttrs.Size)
+			tassert.Errorf(t, hdr.Opaque[0] == 0, "%s: expecting checksum mismatch", hdr.ObjName)
+			numOK.Inc()
+		case cos.IsErrBadCksum(err):
+			tassert.Errorf(t, hdr.Opaque[0] == 1, "%s: unexpected checksum mismatch: %v", hdr.ObjName, err)
+			numBad.Inc()
+		default:
+			numErrs.Inc()
+		}
+		transport.DrainAndFreeReader(objReader)
+		return nil
+	}
+	ts := httptest.NewServer(objmux)
+	defer ts.Close()
+	err := transport.HandleOpts(trname, recv, &transport.RxOpts{Cksum: true})
+	tassert.CheckFatal(t, err)
+	defer transport.Unhandle(trname)
+
+	httpclient := transport.NewIntraDataClient()
+	stream := transport.NewObjStream(httpclient, ts.URL+transport.ObjURLPath(trname), cos.GenTie(), nil)
+	for i := range numObjs {
+		var (
+			hdr = transport.ObjHdr{Bck: cmn.Bck{Name: trname, Provider: apc.AIS}, ObjName: "obj-" + strconv.Itoa(i)}
+			obj = &transport.Obj{}
+		)
+		hdr.Opaque = []byte{0}
+		if i%10 != 9 { // (every 10th is header-only)
+			data := make([]byte, random.IntN(64*cos.KiB)+1)
+			_, _ = cryptorand.Read(data)
+			obj.Reader = cos.NewByteHandle(data)
+			hdr.ObjAttrs.Size = int64(len(data))
+
+			switch i % 3 {
+			case 0: // correct
+				ckhash := cos.NewCksumHash(cos.ChecksumXXHash)
+				ckhash.H.Write(data)
+				ckhash.Finalize()
+				hdr.ObjAttrs.Cksum = ckhash.Clone()
+			case 1: // corrupted
+				hdr.ObjAttrs.Cksum = cos.NewCksum(cos.ChecksumXXHash, "0123456789abcdef")
+				hdr.Opaque[0] = 1
+			default: // none
+			}
+		}
+		obj.Hdr = hdr
+		tassert.CheckFatal(t, stream.Send(obj))
+	}
+	stream.Fin()
+
+	tassert.Errorf(t, numErrs.Load() == 0, "unexpected errors: %d", numErrs.Load())
+	tassert.Errorf(t, numOK.Load()+numBad.Load() == numObjs, "received %d + %d, expected %d", numOK.Load(), numBad.Load(), numObjs)
+	tassert.Errorf(t, numBad.Load() > 0, "expecting checksum mismatches")
+}
+
 func TestDryRun(t *testing.T) {
 	tools.CheckSkip(t, &tools.SkipTestArgs{Long: true})
 
@@ -57,8 +57,8 @@ func FreeRecv(object io.Reader) {
 	if object == nil {
 		return
 	}
-	obj, ok := object.(*objReader)
-	debug.Assert(ok && obj != nil)
+	obj := unwrap(object)
+	debug.Assert(obj != nil)
 	*obj = robj0
 	recvPool.Put(obj)
 }
@@ -42,6 +42,7 @@ type (
 		handler handler
 		pdu     *rpdu
 		stats   rxStats
+		rpl     *rxReplay // see replay.go
 		hbuf    []byte
 	}
 	objReader struct {
@@ -58,11 +59,16 @@ type (
 		unreg()
 		addOld(uint64)
 		getStats() RxStats
+		kstats() *kindStats
+		ver() int
 	}
 	hdl struct {
 		rxObj  RecvObj
+		kst    *kindStats
 		trname string
 		now    int64
+		hver   int
+		cksum  bool // RxOpts.Cksum
 	}
 	hdlExtra struct {
 		hdl
@@ -102,7 +108,9 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 		//  to xaction abort and such - the fact that'd be difficult to confirm
 		//  at the lowest level (and with no handler and its rxObj cb).
 		//
-		if _, ok := err.(*errAlreadyClosedTrname); ok {
+		if r.Method == http.MethodGet {
+			cmn.WriteErr(w, r, err, http.StatusNotFound) // (see caps.go)
+		} else if _, ok := err.(*errAlreadyClosedTrname); ok {
 			if cmn.Rom.FastV(5, cos.SmoduleTransport) {
 				nlog.Errorln(trname, "err:", err)
 			}
@@ -111,6 +119,17 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	// capability negotiation
+	if r.Method == http.MethodGet {
+		rxCaps(w, r, h, trname)
+		return
+	}
+	if err := rxCheckCaps(r, trname); err != nil {
+		nlog.Errorln(err)
+		cmn.WriteErr(w, r, err)
+		return
+	}
+
 	// compression
 	if compressionType := r.Header.Get(apc.HdrCompress); compressionType != "" {
 		debug.Assert(compressionType == apc.LZ4Compression)
@@ -125,6 +144,9 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 	)
 	debug.Assert(config.Transport.IdleTeardown > 0, "invalid config ", config.Transport)
 	it.hbuf, _ = mm.AllocSize(_sizeHdr(config, 0))
+	if token := r.Header.Get(apc.HdrStreamReplay); token != "" {
+		it.rpl = getRxReplay(trname, token)
+	}
 
 	// receive loop
 	err = it.rxloop(uid, loghdr, mm)
@@ -179,6 +201,8 @@ func (h *hdlExtra) stats(r *http.Request, trname string) (rxStats, uint64, strin
 	return statsif.(rxStats), uid, loghdr
 }
 
+func (h *hdl) ver() int { return h.hver }
+
 func (*hdl) unreg()        {}
 func (h *hdlExtra) unreg() { hk.Unreg(h.hkName + hk.NameSuffix) }
 
@@ -201,10 +225,15 @@ func (h *hdlExtra) cl(key, value any) bool {
 	return true
 }
 
-func (h *hdl) recv(hdr *ObjHdr, objReader io.Reader, err error) error {
-	return h.rxObj(hdr, objReader, err)
+func (h *hdl) recv(hdr *ObjHdr, reader io.Reader, err error) error {
+	if h.cksum && err == nil && reader != nil {
+		reader = newCksumReader(reader.(*objReader))
+	}
+	return h.rxObj(hdr, reader, err)
 }
 
+func (h *hdl) kstats() *kindStats { return h.kst }
+
 func (*hdl) getStats() RxStats { return nil }
 
 func (h *hdlExtra) getStats() (s RxStats) {
@@ -260,39 +289,46 @@ func (it *iterator) rxloop(uid uint64, loghdr string, mm *memsys.MMSA) (err erro
 				it.pdu.reset()
 			}
 		}
-		err = it.rxObj(loghdr, hlen)
+		err = it.rxObj(loghdr, hlen, flags)
 	}
 
 	it.handler.addOld(uid)
 	return
 }
 
-func (it *iterator) rxObj(loghdr string, hlen int) (err error) {
+func (it *iterator) rxObj(loghdr string, hlen int, flags uint64) (err error) {
 	var (
 		obj *objReader
 		h   = it.handler
 	)
-	obj, err = it.nextObj(loghdr, hlen)
+	obj, err = it.nextObj(loghdr, hlen, flags)
 	if obj != nil {
 		if !obj.hdr.IsHeaderOnly() {
 			obj.pdu = it.pdu
 		}
 		err = eofOK(err)
 		size, off := obj.hdr.ObjAttrs.Size, obj.off
-		if errCb := h.recv(&obj.hdr, obj, err); errCb != nil {
+		if obj.hdr.seq > 0 && it.rpl != nil {
+			var delivered bool
+			if delivered, err = it.rpl.recv(h, obj, err); !delivered {
+				return err // duplicate (replayed upon reconnect)
+			}
+		} else if errCb := h.recv(&obj.hdr, obj, err); errCb != nil {
 			err = errCb
 		}
 		// stats
 		if err == nil {
+			if size < 0 {
+				debug.Assert(size == SizeUnknown)
+				size = obj.off - off
+			}
 			it.stats.incNum()                   // 1. this stream stats
 			g.tstats.Inc(cos.StreamsInObjCount) // 2. stats/target_stats.go
+			g.tstats.Add(cos.StreamsInObjSize, size)
 
-			if size >= 0 {
-				g.tstats.Add(cos.StreamsInObjSize, size)
-			} else {
-				debug.Assert(size == SizeUnknown)
-				g.tstats.Add(cos.StreamsInObjSize, obj.off-off)
-			}
+			kst := h.kstats() // 3. ditto, by stream kind
+			g.tstats.Inc(kst.inN)
+			g.tstats.Add(kst.inBps, size)
 		}
 	} else if err != nil && err != io.EOF {
 		if errCb := h.recv(&ObjHdr{}, nil, err); errCb != nil {
@@ -325,7 +361,7 @@ func (it *iterator) nextProtoHdr(loghdr string) (hlen int, flags uint64, err err
 	return
 }
 
-func (it *iterator) nextObj(loghdr string, hlen int) (obj *objReader, err error) {
+func (it *iterator) nextObj(loghdr string, hlen int, flags uint64) (obj *objReader, err error) {
 	var n int
 	n, err = it.Read(it.hbuf[:hlen])
 	if n < hlen {
@@ -352,7 +388,7 @@ func (it *iterator) nextObj(loghdr string, hlen int) (obj *objReader, err error)
 			return
 		}
 	}
-	hdr := ExtObjHeader(it.hbuf, hlen)
+	hdr := extObjHeader(it.hbuf, hlen, flags)
 	if hdr.isFin() {
 		err = io.EOF
 		return
@@ -465,8 +501,7 @@ func DrainAndFreeReader(r io.Reader) {
 	if r == nil {
 		return
 	}
-	obj, ok := r.(*objReader)
-	debug.Assert(ok)
+	obj := unwrap(r)
 	if obj.body != nil && !obj.hdr.IsHeaderOnly() {
 		cos.DrainReader(obj)
 	}
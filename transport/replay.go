@@ -0,0 +1,331 @@
+// Package transport provides long-lived http/tcp connections for
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Replay of in-flight objects upon reconnect (Extra.MaxReplay > 0):
+// - each object that can be re-read (header-only, file handle, SGL, Reopener, etc. - see replayable())
+//   gets a sequence number carried in its header (seqFl) and stays in the sender's bounded
+//   replay buffer until acknowledged; its completion (ObjSentCB and closing the reader)
+//   is deferred accordingly;
+// - acknowledgment is implicit: a successfully completed PUT acknowledges all objects sent
+//   in the session; in addition, at the start of each session (see caps.go) the receiver
+//   reports the last sequence number it has delivered for this stream;
+// - when the buffer is full (Extra.MaxReplay objects or maxReplaySize bytes), the oldest
+//   (already sent) object gets completed as usual - without replay guarantees;
+// - upon connection error, the stream does not terminate - it reconnects (up to maxReconnects
+//   times in a row) and resends all unacknowledged objects, in order;
+// - the receiver drops duplicates (objects with sequence numbers it has already delivered);
+// - requires receiver's support (FeatSeq); otherwise, the stream behaves as before.
+
+const (
+	DfltMaxReplay = 64 // (usage: rebalance, EC)
+
+	maxReplaySize = 64 * cos.MiB
+	maxReconnects = 3
+)
+
+type (
+	// readers (other than the ones listed in replayable() below) that can be reopened
+	// to resend the same content from the very beginning
+	Reopener interface {
+		cos.ReadOpenCloser
+		CanReopen() bool
+	}
+
+	// Tx
+	txReplay struct {
+		s     *Stream
+		token string   // stream's identity on the receive side
+		objs  []rplObj // unacknowledged, in sequence order
+		next  int      // objs[:next] have been (re)sent in the current session
+		seq   int64    // last assigned sequence number
+		size  int64    // total size of objs
+		max   int      // Extra.MaxReplay
+		on    bool     // receiver supports FeatSeq
+		mu    sync.Mutex
+	}
+	rplObj struct {
+		rr  io.ReadCloser // reopened (to resend) reader
+		obj Obj
+	}
+
+	// Rx
+	rxReplay struct {
+		mu    sync.Mutex   // serializes delivery (in re old and new sessions of the same stream)
+		last  atomic.Int64 // last delivered sequence number
+		atime atomic.Int64
+	}
+)
+
+var rxReplays struct {
+	m     map[string]*rxReplay // trname/token => last delivered
+	swept int64
+	mu    sync.Mutex
+}
+
+//
+// Tx
+//
+
+func newTxReplay(s *Stream, maxReplay int) *txReplay {
+	return &txReplay{s: s, token: cos.GenUUID(), max: maxReplay, objs: make([]rplObj, 0, min(maxReplay, 16))}
+}
+
+func replayable(obj *Obj) bool {
+	if ReservedOpcode(obj.Hdr.Opcode) {
+		return false
+	}
+	if obj.IsHeaderOnly() {
+		return true
+	}
+	switch r := obj.Reader.(type) {
+	case *cos.FileHandle, *cos.ByteHandle, *cos.SectionHandle, *cos.FileSectionHandle, *memsys.SGL:
+		return true
+	case Reopener:
+		return r.CanReopen()
+	default:
+		return false // (e.g., memsys.Reader that may've been seeked)
+	}
+}
+
+// (streamer)
+func (s *Stream) rplToken() (string, bool) {
+	if s.rpl == nil {
+		return "", false
+	}
+	s.rpl.mu.Lock()
+	on := s.rpl.on
+	s.rpl.mu.Unlock()
+	return s.rpl.token, on
+}
+
+// assign sequence number and keep the object (that's about to be sent) until acknowledged
+func (rpl *txReplay) add(obj *Obj) {
+	if !replayable(obj) {
+		return
+	}
+	size := max(obj.Size(), 0)
+	rpl.mu.Lock()
+	if rpl.on {
+		for len(rpl.objs) > 0 && (len(rpl.objs) >= rpl.max || rpl.size+size > maxReplaySize) {
+			rpl.cmpl(0, nil) // evict the oldest
+		}
+		rpl.seq++
+		obj.Hdr.seq = rpl.seq
+		rpl.objs = append(rpl.objs, rplObj{obj: *obj})
+		rpl.size += size
+		rpl.next = len(rpl.objs)
+	}
+	rpl.mu.Unlock()
+}
+
+// next unacknowledged object to resend, if any
+func (rpl *txReplay) resend() (obj Obj, ok bool) {
+	rpl.mu.Lock()
+	for rpl.next < len(rpl.objs) {
+		ro := &rpl.objs[rpl.next]
+		obj = ro.obj
+		if !obj.IsHeaderOnly() {
+			r, err := obj.Reader.(cos.ReadOpenCloser).Open()
+			if err != nil {
+				nlog.Errorln(rpl.s.String(), "failed to reopen", obj.String(), "for replay:", err)
+				rpl.cmpl(rpl.next, err)
+				continue
+			}
+			if ro.rr != nil {
+				cos.Close(ro.rr)
+			}
+			ro.rr, obj.Reader = r, r
+		}
+		rpl.next++
+		ok = true
+		break
+	}
+	rpl.mu.Unlock()
+	return
+}
+
+// under lock: post completion and remove objs[i]
+func (rpl *txReplay) cmpl(i int, err error) {
+	ro := &rpl.objs[i]
+	if ro.rr != nil {
+		cos.Close(ro.rr)
+	}
+	rpl.size -= max(ro.obj.Size(), 0)
+	rpl.s.cmplCh <- cmpl{err, ro.obj}
+
+	copy(rpl.objs[i:], rpl.objs[i+1:])
+	rpl.objs[len(rpl.objs)-1] = rplObj{}
+	rpl.objs = rpl.objs[:len(rpl.objs)-1]
+	if i < rpl.next {
+		rpl.next--
+	}
+}
+
+// failed to send (e.g., failed to read) - complete with error
+func (rpl *txReplay) fail(seq int64, err error) {
+	rpl.mu.Lock()
+	for i := range rpl.objs {
+		if rpl.objs[i].obj.Hdr.seq == seq {
+			rpl.cmpl(i, err)
+			break
+		}
+	}
+	rpl.mu.Unlock()
+}
+
+// under lock
+func (rpl *txReplay) ack(seq int64) {
+	for len(rpl.objs) > 0 && rpl.objs[0].obj.Hdr.seq <= seq {
+		rpl.cmpl(0, nil)
+	}
+}
+
+// session completed successfully
+func (rpl *txReplay) ackAll() {
+	rpl.mu.Lock()
+	rpl.ack(rpl.seq)
+	rpl.mu.Unlock()
+}
+
+// start of session: receiver's caps and the last sequence number it has delivered (see negotiate)
+func (rpl *txReplay) negotiated(on bool, last string) {
+	rpl.mu.Lock()
+	switch {
+	case !on:
+		rpl.flush(nil) // (e.g., peer restarted with an older version)
+	case last != "":
+		if seq, err := strconv.ParseInt(last, 10, 64); err == nil {
+			rpl.ack(seq)
+		}
+	}
+	rpl.on = on
+	rpl.mu.Unlock()
+}
+
+// under lock
+func (rpl *txReplay) flush(err error) {
+	for len(rpl.objs) > 0 {
+		rpl.cmpl(0, err)
+	}
+}
+
+// (streamer) upon connection error: keep unacknowledged objects and reconnect
+func (s *Stream) reconnect(err error, cnt int) bool {
+	if s.rpl == nil || cnt >= maxReconnects || IsErrIncompatible(err) {
+		return false
+	}
+	s.rpl.mu.Lock()
+	on, num := s.rpl.on, len(s.rpl.objs)
+	s.rpl.mu.Unlock()
+	if !on {
+		return false
+	}
+
+	// the object in-send, unless replayable, completes with error
+	if ins := s.sendoff.ins; ins >= inHdr && ins < inEOB {
+		if obj := &s.sendoff.obj; obj.Hdr.seq == 0 && !obj.Hdr.isFin() {
+			s.cmplCh <- cmpl{err, *obj}
+		}
+	}
+	s.sendoff = sendoff{ins: inEOB}
+	if s.usePDU() {
+		s.pdu.reset()
+	}
+	nlog.Warningln(s.String(), "connection error:", err, "- reconnecting to replay", num, "unacknowledged object(s)")
+
+	select {
+	case <-time.After(connErrWait):
+	case <-s.stopCh.Listen():
+		return false
+	}
+	s.rpl.mu.Lock()
+	s.rpl.next = 0 // resend all
+	s.rpl.mu.Unlock()
+	return true
+}
+
+//
+// Rx
+//
+
+func rxReplayKey(trname, token string) string { return trname + "/" + token }
+
+func getRxReplay(trname, token string) *rxReplay {
+	var (
+		key = rxReplayKey(trname, token)
+		now = mono.NanoTime()
+	)
+	rxReplays.mu.Lock()
+	rs, ok := rxReplays.m[key]
+	if !ok {
+		if rxReplays.m == nil {
+			rxReplays.m = make(map[string]*rxReplay, 16)
+			rxReplays.swept = now
+		} else if time.Duration(now-rxReplays.swept) > sessionIsOld {
+			for k, v := range rxReplays.m {
+				if time.Duration(now-v.atime.Load()) > sessionIsOld {
+					delete(rxReplays.m, k)
+				}
+			}
+			rxReplays.swept = now
+		}
+		rs = &rxReplay{}
+		rxReplays.m[key] = rs
+	}
+	rs.atime.Store(now)
+	rxReplays.mu.Unlock()
+	return rs
+}
+
+// GET (caps): the last sequence number delivered for a given stream
+func rxLastSeq(w http.ResponseWriter, r *http.Request, trname string) {
+	token := r.Header.Get(apc.HdrStreamReplay)
+	if token == "" {
+		return
+	}
+	var last int64
+	rxReplays.mu.Lock()
+	if rs, ok := rxReplays.m[rxReplayKey(trname, token)]; ok {
+		last = rs.last.Load()
+	}
+	rxReplays.mu.Unlock()
+	w.Header().Set(apc.HdrStreamReplay, strconv.FormatInt(last, 10))
+}
+
+// deliver (via rxObj callback) unless already delivered
+func (rs *rxReplay) recv(h handler, obj *objReader, err error) (delivered bool, _ error) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	seq := obj.hdr.seq
+	if seq <= rs.last.Load() {
+		DrainAndFreeReader(obj)
+		return false, nil
+	}
+	if errCb := h.recv(&obj.hdr, obj, err); errCb != nil {
+		return true, errCb
+	}
+	if err == nil {
+		rs.last.Store(seq)
+		rs.atime.Store(mono.NanoTime())
+	}
+	return true, err
+}
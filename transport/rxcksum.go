@@ -0,0 +1,72 @@
+// Package transport provides long-lived http/tcp connections for
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"io"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Streaming checksum validation (opt-in via RxOpts.Cksum):
+// - the expected checksum is the one the sender puts in the object header (ObjHdr.ObjAttrs.Cksum);
+// - the receive handler gets a reader that computes the same checksum on the fly, while the handler
+//   is reading (copying) the object - no extra pass over the data;
+// - upon mismatch, the last Read returns cos.ErrBadCksum instead of io.EOF, so that io.Copy
+//   and such fail with an integrity error;
+// - objects without checksum (or with checksum type "none") and header-only objects are delivered as is.
+
+type cksumReader struct {
+	obj    *objReader
+	ckhash *cos.CksumHash
+	err    error // (sticky) result of the validation
+}
+
+func newCksumReader(obj *objReader) io.Reader {
+	cksum := obj.hdr.ObjAttrs.Cksum
+	if obj.hdr.IsHeaderOnly() || cksum.IsEmpty() {
+		return obj
+	}
+	if err := cos.ValidateCksumType(cksum.Ty()); err != nil {
+		return &cksumReader{obj: obj, err: err}
+	}
+	return &cksumReader{obj: obj, ckhash: cos.NewCksumHash(cksum.Ty())}
+}
+
+func (r *cksumReader) Read(b []byte) (n int, err error) {
+	if r.ckhash == nil {
+		if r.err != nil {
+			return 0, r.err
+		}
+		return 0, io.EOF // validated
+	}
+	n, err = r.obj.Read(b)
+	if n > 0 {
+		r.ckhash.H.Write(b[:n])
+	}
+	if err != io.EOF {
+		return n, err
+	}
+	r.ckhash.Finalize()
+	if cksum := r.obj.hdr.ObjAttrs.Cksum; !r.ckhash.Equal(cksum) {
+		r.err = cos.NewErrDataCksum(&r.ckhash.Cksum, cksum, r.obj.hdr.Cname())
+		err = r.err
+	}
+	r.ckhash = nil
+	return n, err
+}
+
+// the object being received
+func unwrap(r io.Reader) *objReader {
+	switch v := r.(type) {
+	case *objReader:
+		return v
+	case *cksumReader:
+		return v.obj
+	default:
+		return nil
+	}
+}
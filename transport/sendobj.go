@@ -22,10 +22,16 @@ import (
 type (
 	Stream struct {
 		workCh   chan *Obj // aka SQ: next object to stream
+		prioCh   chan *Obj // high-priority SQ (see Obj.Prio)
+		fin      *Obj      // Fin (opcFin) waiting for high-priority SQ to drain
 		cmplCh   chan cmpl // aka SCQ; note that SQ and SCQ together form a FIFO
 		callback ObjSentCB // to free SGLs, close files, etc.
 		lz4s     *lz4Stream
+		rpl      *txReplay // see replay.go
 		sendoff  sendoff
+		nprio    int  // consecutive high-priority objects sent
+		zeroCopy bool // see zerocopy.go
+		nolz4    bool // receiver does not support compression (see caps.go)
 		streamBase
 	}
 	lz4Stream struct {
@@ -34,6 +40,7 @@ type (
 		sgl           *memsys.SGL // zw => bb => network
 		blockMaxSize  int         // *uncompressed* block max size
 		frameChecksum bool        // true: checksum lz4 frames
+		reported      int64       // compressed size already added to target stats
 	}
 	sendoff struct {
 		obj Obj
@@ -46,6 +53,9 @@ type (
 	}
 )
 
+// priority: max number of consecutive high-priority objects while normal ones are waiting
+const prioBurst = 16
+
 // interface guard
 var _ streamer = (*Stream)(nil)
 
@@ -67,6 +77,11 @@ func (s *Stream) terminate(err error, reason string) (actReason string, actErr e
 	s.Stop()
 	err = s.term.err
 	actReason, actErr = s.term.reason, s.term.err
+	if s.rpl != nil {
+		s.rpl.mu.Lock()
+		s.rpl.flush(err)
+		s.rpl.mu.Unlock()
+	}
 	s.cmplCh <- cmpl{err, Obj{Hdr: ObjHdr{Opcode: opcFin}}}
 	s.term.mu.Unlock()
 
@@ -75,7 +90,7 @@ func (s *Stream) terminate(err error, reason string) (actReason string, actErr e
 	// would be under lock.
 	gc.remove(&s.streamBase)
 
-	if s.compressed() {
+	if s.lz4s != nil {
 		s.lz4s.sgl.Free()
 		if s.lz4s.zw != nil {
 			s.lz4s.zw.Reset(nil)
@@ -96,7 +111,7 @@ func (s *Stream) initCompression(extra *Extra) {
 	}
 }
 
-func (s *Stream) compressed() bool { return s.lz4s != nil }
+func (s *Stream) compressed() bool { return s.lz4s != nil && !s.nolz4 }
 func (s *Stream) usePDU() bool     { return s.pdu != nil }
 
 func (s *Stream) resetCompression() {
@@ -118,6 +133,9 @@ func (s *Stream) cmplLoop() {
 
 // handle the last interrupted transmission and pending SQ/SCQ
 func (s *Stream) abortPending(err error, completions bool) {
+	for obj := range s.prioCh {
+		s.doCmpl(obj, err)
+	}
 	for obj := range s.workCh {
 		s.doCmpl(obj, err)
 	}
@@ -156,11 +174,23 @@ func (s *Stream) doCmpl(obj *Obj, err error) {
 	freeSend(obj)
 }
 
-func (s *Stream) doRequest() error {
+func (s *Stream) doRequest() (err error) {
 	s.numCur, s.sizeCur = 0, 0
-	if !s.compressed() {
-		return s.do(s)
-	}
+	switch {
+	case s.zeroCopy:
+		err = s.doZeroCopy()
+	case !s.compressed():
+		err = s.do(s)
+	default:
+		err = s.doCompressed()
+	}
+	if err == nil && s.rpl != nil {
+		s.rpl.ackAll()
+	}
+	return err
+}
+
+func (s *Stream) doCompressed() error {
 	s.lz4s.sgl.Reset()
 	if s.lz4s.zw == nil {
 		s.lz4s.zw = lz4.NewWriter(s.lz4s.sgl)
@@ -214,32 +244,113 @@ func (s *Stream) Read(b []byte) (n int, err error) {
 		return s.sendHdr(b)
 	}
 repeat:
-	select {
-	case obj, ok := <-s.workCh: // next object OR idle tick
-		if !ok {
-			err = fmt.Errorf("%s closed prior to stopping", s)
-			nlog.Warningln(err)
-			return
+	if s.rpl != nil {
+		if obj, ok := s.rpl.resend(); ok { // unacknowledged, upon reconnect
+			s.sendoff.obj = obj
+			s.stats.Replayed.Inc()
+			return s.sendNext(b)
+		}
+	}
+	obj, err := s.dequeue() // next object OR idle tick
+	if err != nil {
+		return 0, err
+	}
+	s.sendoff.obj = *obj
+	obj = &s.sendoff.obj
+	if obj.Hdr.isIdleTick() {
+		if !s.idle() {
+			goto repeat
 		}
-		s.sendoff.obj = *obj
-		obj = &s.sendoff.obj
-		if obj.Hdr.isIdleTick() {
-			if len(s.workCh) > 0 {
-				goto repeat
+		return s.deactivate()
+	}
+	if s.rpl != nil {
+		s.rpl.add(obj)
+	}
+	return s.sendNext(b)
+}
+
+// priority:
+//   - high-priority SQ is always checked first;
+//   - except when it's been served prioBurst times in a row while normal-priority objects
+//     are waiting (starvation protection);
+//   - Fin goes last - after both SQs are drained.
+func (s *Stream) dequeue() (*Obj, error) {
+	for {
+		if s.nprio >= prioBurst && len(s.workCh) > 0 {
+			s.nprio = 0
+			s.stats.PrioYield.Inc()
+			select {
+			case obj, ok := <-s.workCh:
+				if obj, err := s.deqNormal(obj, ok); obj != nil || err != nil {
+					return obj, err
+				}
+				continue
+			default:
 			}
-			return s.deactivate()
 		}
-		l := insObjHeader(s.maxhdr, &obj.Hdr, s.usePDU())
-		s.header = s.maxhdr[:l]
-		s.sendoff.ins = inHdr
-		return s.sendHdr(b)
-	case <-s.stopCh.Listen():
-		if cmn.Rom.FastV(5, cos.SmoduleTransport) {
-			nlog.Infoln(s.String(), "stopped [", s.numCur, s.stats.Num.Load(), "]")
+		select {
+		case obj, ok := <-s.prioCh:
+			return s.deqPrio(obj, ok)
+		default:
 		}
-		err = io.EOF
-		return
+		s.nprio = 0
+		if s.fin != nil && len(s.prioCh) == 0 {
+			obj := s.fin
+			s.fin = nil
+			return obj, nil
+		}
+		select {
+		case obj, ok := <-s.workCh:
+			if obj, err := s.deqNormal(obj, ok); obj != nil || err != nil {
+				return obj, err
+			}
+		case obj, ok := <-s.prioCh:
+			return s.deqPrio(obj, ok)
+		case <-s.stopCh.Listen():
+			if cmn.Rom.FastV(5, cos.SmoduleTransport) {
+				nlog.Infoln(s.String(), "stopped [", s.numCur, s.stats.Num.Load(), "]")
+			}
+			return nil, io.EOF
+		}
+	}
+}
+
+// returns (nil, nil) when Fin must wait for high-priority SQ
+func (s *Stream) deqNormal(obj *Obj, ok bool) (*Obj, error) {
+	if !ok {
+		return nil, s.errClosed()
+	}
+	if obj.Hdr.isFin() && len(s.prioCh) > 0 {
+		s.fin = obj
+		return nil, nil
+	}
+	return obj, nil
+}
+
+func (s *Stream) deqPrio(obj *Obj, ok bool) (*Obj, error) {
+	if !ok {
+		return nil, s.errClosed()
 	}
+	s.nprio++
+	s.stats.NumPrio.Inc()
+	return obj, nil
+}
+
+func (s *Stream) errClosed() error {
+	err := fmt.Errorf("%s closed prior to stopping", s)
+	nlog.Warningln(err)
+	return err
+}
+
+// both SQs are empty
+func (s *Stream) idle() bool { return len(s.workCh) == 0 && len(s.prioCh) == 0 }
+
+func (s *Stream) sendNext(b []byte) (int, error) {
+	obj := &s.sendoff.obj
+	l := insObjHeader(s.maxhdr, &obj.Hdr, s.usePDU())
+	s.header = s.maxhdr[:l]
+	s.sendoff.ins = inHdr
+	return s.sendHdr(b)
 }
 
 func (s *Stream) sendHdr(b []byte) (n int, err error) {
@@ -324,13 +435,25 @@ func (s *Stream) eoObj(err error) {
 	// target stats
 	g.tstats.Inc(cos.StreamsOutObjCount)
 	g.tstats.Add(cos.StreamsOutObjSize, objSize)
+	g.tstats.Inc(s.kst.outN)
+	g.tstats.Add(s.kst.outBps, objSize)
+	if s.compressed() {
+		size := s.stats.CompressedSize.Load()
+		g.tstats.Add(s.kst.outLz4, size-s.lz4s.reported)
+		s.lz4s.reported = size
+	}
 exit:
 	if err != nil {
 		nlog.Errorln(err)
 	}
 
-	// next completion => SCQ
-	s.cmplCh <- cmpl{err, s.sendoff.obj}
+	// next completion => SCQ (unless deferred until acknowledged - see replay.go)
+	switch {
+	case s.sendoff.obj.Hdr.seq == 0:
+		s.cmplCh <- cmpl{err, s.sendoff.obj}
+	case err != nil:
+		s.rpl.fail(s.sendoff.obj.Hdr.seq, err)
+	}
 	s.sendoff = sendoff{ins: inEOB}
 }
 
@@ -349,7 +472,7 @@ func (s *Stream) dryrun() {
 		}
 		debug.AssertNoErr(err)
 		debug.Assert(flags&msgFl == 0)
-		obj, err := it.nextObj(s.String(), hlen)
+		obj, err := it.nextObj(s.String(), hlen, flags)
 		if obj != nil {
 			cos.DrainReader(obj) // TODO: recycle `objReader` here
 			continue
@@ -361,7 +484,7 @@ func (s *Stream) dryrun() {
 }
 
 func (s *Stream) errCmpl(err error) {
-	if s.inSend() {
+	if s.inSend() && s.sendoff.obj.Hdr.seq == 0 { // (replay: see terminate)
 		s.cmplCh <- cmpl{err, s.sendoff.obj}
 	}
 }
@@ -370,6 +493,8 @@ func (s *Stream) errCmpl(err error) {
 func (s *Stream) drain(err error) {
 	for {
 		select {
+		case obj := <-s.prioCh:
+			s.doCmpl(obj, err)
 		case obj := <-s.workCh:
 			s.doCmpl(obj, err)
 		default:
@@ -381,6 +506,7 @@ func (s *Stream) drain(err error) {
 // gc:
 func (s *Stream) closeAndFree() {
 	close(s.workCh)
+	close(s.prioCh)
 	close(s.cmplCh)
 
 	g.mm.Free(s.maxhdr)
@@ -391,7 +517,7 @@ func (s *Stream) closeAndFree() {
 
 // gc: post idle tick if idle
 func (s *Stream) idleTick() {
-	if len(s.workCh) == 0 && s.sessST.CAS(active, inactive) {
+	if s.idle() && s.sessST.CAS(active, inactive) {
 		s.workCh <- &Obj{Hdr: ObjHdr{Opcode: opcIdleTick}}
 		if cmn.Rom.FastV(5, cos.SmoduleTransport) {
 			nlog.Infoln(s.String(), "active => inactive")
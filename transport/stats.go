@@ -6,7 +6,10 @@
 package transport
 
 import (
+	"strings"
+
 	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
 )
 
 // stream (session) stats
@@ -15,10 +18,66 @@ type Stats struct {
 	Size           atomic.Int64 // transferred object size (does not include transport headers)
 	Offset         atomic.Int64 // stream offset, in bytes
 	CompressedSize atomic.Int64 // compressed size (converges to the actual compressed size over time)
+	ZeroCopySize   atomic.Int64 // object bytes transmitted via zero-copy (see zerocopy.go)
+	Replayed       atomic.Int64 // number of unacknowledged objects resent upon reconnect (see replay.go)
+	NumPrio        atomic.Int64 // number of high-priority objects (see Obj.Prio)
+	PrioYield      atomic.Int64 // number of times high priority yielded to normal (starvation protection)
 }
 
 type nopRxStats struct{}
 
+// per-kind target stats (names), where kind is one of the cos.StreamKinds
+type kindStats struct {
+	outN      string // number of sent objects
+	outBps    string // sent bytes (object payloads), as KindThroughput
+	outLz4    string // bytes on the wire (compressed streams only)
+	outNs     string // cumulative time streams of this kind were open...
+	outIdleNs string // ...and idle, i.e., had nothing to send
+	inN       string // number of received objects
+	inBps     string // received bytes
+}
+
+// trname prefix => kind
+// (stream names are assigned by their respective users - see reb, ec, xact/xs, and ext/dsort)
+var kindPrefixes = [...][2]string{
+	{"reb", "reb"},
+	{"pshreb", "reb"},
+	{"ec-", "ec"},
+	{"tcb-", "tcb"},
+	{"tco-", "tco"},
+	{"arch-", "arch"},
+	{"lso-", "lso"},
+	{"recv-", "dsort"},
+	{"shrd-", "dsort"},
+}
+
+var kinds = make(map[string]*kindStats, len(cos.StreamKinds))
+
+func init() {
+	for _, kind := range cos.StreamKinds {
+		kinds[kind] = &kindStats{
+			outN:      cos.StreamsKindMetric("out", kind, "n"),
+			outBps:    cos.StreamsKindMetric("out", kind, "bps"),
+			outLz4:    cos.StreamsKindMetric("out", kind, "lz4.size"),
+			outNs:     cos.StreamsKindMetric("out", kind, "ns.total"),
+			outIdleNs: cos.StreamsKindMetric("out", kind, "idle.ns.total"),
+			inN:       cos.StreamsKindMetric("in", kind, "n"),
+			inBps:     cos.StreamsKindMetric("in", kind, "bps"),
+		}
+	}
+}
+
+// e.g. "tcb-<uuid>" => "tcb"
+func streamKind(trname string) *kindStats {
+	trname = strings.TrimPrefix(trname, "ack.") // (see bundle.NewDataMover)
+	for _, kp := range kindPrefixes {
+		if strings.HasPrefix(trname, kp[0]) {
+			return kinds[kp[1]]
+		}
+	}
+	return kinds["other"]
+}
+
 // interface guard
 var (
 	_ rxStats = (*Stats)(nil)
@@ -39,6 +39,26 @@ var (
 	g global
 )
 
+// intra-data client settings, shared by both stream clients and zero-copy (see zerocopy.go);
+// compare with ais/htcommon.go
+func intraDataArgs(config *cmn.Config) cmn.TransportArgs {
+	cargs := cmn.TransportArgs{
+		SndRcvBufSize:   config.Net.L4.SndRcvBufSize,
+		WriteBufferSize: config.Net.HTTP.WriteBufferSize,
+		ReadBufferSize:  config.Net.HTTP.ReadBufferSize,
+	}
+	if cargs.SndRcvBufSize == 0 {
+		cargs.SndRcvBufSize = cmn.DefaultSendRecvBufferSize
+	}
+	if cargs.WriteBufferSize == 0 {
+		cargs.WriteBufferSize = cmn.DefaultWriteBufferSize
+	}
+	if cargs.ReadBufferSize == 0 {
+		cargs.ReadBufferSize = cmn.DefaultReadBufferSize
+	}
+	return cargs
+}
+
 func Init(tstats cos.StatsUpdater) *StreamCollector {
 	g.mm = memsys.PageMM()
 	g.tstats = tstats
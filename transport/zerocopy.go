@@ -0,0 +1,171 @@
+// Package transport provides long-lived http/tcp connections for
+// intra-cluster communications (see README for details and usage example).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package transport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Zero-copy transmission (config.Transport.ZeroCopy):
+// - applies to uncompressed streams that do not use PDUs and run over plain (non-TLS) HTTP;
+//   with TLS (or any other "https" destination) streams always take the regular path;
+// - such streams use their own minimal HTTP/1.1 client that writes the (chunked) request body
+//   directly to the TCP connection, so that object payloads read from local files (*os.File)
+//   get transmitted via (*net.TCPConn).ReadFrom => sendfile(2) or splice(2), i.e., without
+//   read-copy-write through user space;
+// - the connection is dialed to the same intra-data destination (URL) and with the same settings
+//   (TCP send/receive buffers, HTTP buffer sizes - see intraDataArgs) as the regular stream client;
+// - all other payloads (SGLs, transformed objects, etc.) take the regular path via Stream.Read;
+// - the receive side does not need to know the difference;
+// - zero-copy bytes are counted in Stats.ZeroCopySize and (target) "stream.out.zc.size".
+
+const zcDialTimeout = 10 * time.Second
+
+const crlf = "\r\n"
+
+func zeroCopyOK(extra *Extra, dstURL string) bool {
+	config := extra.Config
+	return config.Transport.ZeroCopy && !config.Net.HTTP.UseHTTPS && !cos.IsHTTPS(dstURL) &&
+		!extra.Compressed() && !extra.UsePDU()
+}
+
+// one PUT request (compare with streamBase.do)
+func (s *Stream) doZeroCopy() error {
+	u, err := url.Parse(s.dstURL)
+	if err != nil {
+		return err
+	}
+	cargs := intraDataArgs(cmn.GCO.Get())
+	cargs.DialTimeout = zcDialTimeout
+	conn, err := cmn.NewDialer(cargs).Dial("tcp", u.Host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var (
+		tcpconn   = conn.(*net.TCPConn)
+		bw        = bufio.NewWriterSize(conn, cargs.WriteBufferSize)
+		buf, slab = g.mm.AllocSize(memsys.DefaultBufSize)
+	)
+	defer slab.Free(buf)
+
+	// request line and headers
+	bw.WriteString(http.MethodPut + " " + u.RequestURI() + " HTTP/1.1" + crlf)
+	bw.WriteString("Host: " + u.Host + crlf)
+	bw.WriteString(cos.HdrUserAgent + ": " + ua + crlf)
+	bw.WriteString(apc.HdrSessID + ": " + strconv.FormatInt(s.sessID, 10) + crlf)
+	bw.WriteString(apc.HdrStreamCaps + ": " + s.txCaps() + crlf)
+	if token, on := s.rplToken(); on {
+		bw.WriteString(apc.HdrStreamReplay + ": " + token + crlf)
+	}
+	bw.WriteString("Transfer-Encoding: chunked" + crlf + crlf)
+
+	// body
+	for {
+		if fh, size := s.zcFile(); fh != nil {
+			if err := s.sendfile(tcpconn, bw, fh, size); err != nil {
+				return err
+			}
+			continue
+		}
+		n, errR := s.Read(buf)
+		if n > 0 {
+			if err := zcChunk(bw, buf[:n]); err != nil {
+				return err
+			}
+		}
+		if errR != nil {
+			if errR == io.EOF {
+				break
+			}
+			return errR
+		}
+		// flush prior to (potentially) blocking on the next object
+		if !s.inSend() && s.idle() {
+			if err := bw.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	// last chunk and empty trailer
+	bw.WriteString("0" + crlf + crlf)
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+
+	// response
+	resp, err := http.ReadResponse(bufio.NewReaderSize(conn, cargs.ReadBufferSize), nil)
+	if err != nil {
+		return err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%s: %s", s, resp.Status)
+	}
+	return nil
+}
+
+// returns object's file and remaining size when the object can be sent via zero-copy
+func (s *Stream) zcFile() (*os.File, int64) {
+	if s.sendoff.ins != inData {
+		return nil, 0
+	}
+	obj := &s.sendoff.obj
+	if obj.IsHeaderOnly() {
+		return nil, 0
+	}
+	fh, ok := obj.Reader.(*os.File)
+	if !ok {
+		return nil, 0
+	}
+	return fh, obj.Size() - s.sendoff.off
+}
+
+// send the remaining object payload as a single chunk
+func (s *Stream) sendfile(conn *net.TCPConn, bw *bufio.Writer, fh *os.File, size int64) error {
+	s.time.inSend.Store(true) // (as in Read)
+
+	bw.WriteString(strconv.FormatInt(size, 16) + crlf)
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	n, err := conn.ReadFrom(io.LimitReader(fh, size))
+	s.sendoff.off += n
+	s.stats.ZeroCopySize.Add(n)
+	g.tstats.Add(cos.StreamsOutZeroCopySize, n)
+	if err != nil {
+		return err // (errCmpl will complete the object)
+	}
+	if n < size {
+		// cannot recover - the chunk size has been already sent
+		return fmt.Errorf("%s: %s read (%d) shorter than size (%d)", s, s.sendoff.obj.Hdr.Cname(), s.sendoff.off, s.sendoff.obj.Size())
+	}
+	s.eoObj(nil)
+	_, err = bw.WriteString(crlf)
+	return err
+}
+
+func zcChunk(bw *bufio.Writer, b []byte) error {
+	bw.WriteString(strconv.FormatInt(int64(len(b)), 16) + crlf)
+	bw.Write(b)
+	_, err := bw.WriteString(crlf)
+	return err
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/nl"
 )
 
 const (
@@ -63,6 +64,9 @@ type (
 		Timeout     time.Duration // max time to wait
 		Force       bool          // force
 		OnlyRunning bool          // only for running xactions
+		OlderThan   time.Duration // finished at least so long ago (apc.ActXactGC)
+		Verify      bool          // read objects and validate their stored checksums (apc.ActLoadLomCache)
+		MaxRuntime  time.Duration // abort if still running so long after start (apc.ActXactStart, apc.ActXactDeadline)
 	}
 
 	// simplified JSON-tagged version of the above
@@ -77,8 +81,70 @@ type (
 
 	// primarily: `api.QueryXactionSnaps`
 	MultiSnap map[string][]*core.Snap // by target ID (tid)
+
+	// `api.WatchXaction`: IC-aggregated progress snapshot
+	Watch struct {
+		Snaps  MultiSnap `json:"snaps"`
+		Status nl.Status `json:"status"`
+		Seq    int64     `json:"seq"` // update sequence number
+	}
+
+	// copy (and transform) retries and failures - x-tcb and x-tco extended stats (core.Snap.Ext);
+	// see also: apc.CopyBckMsg.NumRetries and ContinueOnError
+	CopyRetries struct {
+		Retried  []string `json:"retried,omitempty"` // object names: succeeded after one or more retries (up to MaxRetryNames)
+		Failed   []string `json:"failed,omitempty"`  // ditto: failed (and skipped) after all retries
+		NRetried int64    `json:"retried.n"`
+		NFailed  int64    `json:"failed.n"`
+	}
+	// x-tcb and x-tco: all of the above plus the job's ETL (transformer) parameters, if any;
+	// see also: apc.Transform.Params
+	TCExt struct {
+		ETLParams cos.StrKVs `json:"etl_params,omitempty"`
+		CopyRetries
+	}
+
+	// x-defrag-dirs extended stats (core.Snap.Ext)
+	DefragStats struct {
+		Visited   int64 `json:"visited"`   // directories
+		Compacted int64 `json:"compacted"` // ditto
+		Skipped   int64 `json:"skipped"`   // busy (objects in use) or failed to compact
+		Reclaimed int64 `json:"reclaimed"` // directory metadata, bytes
+	}
+
+	// x-promote extended stats (core.Snap.Ext)
+	PromoteStats struct {
+		Scanned  int64 `json:"scanned"`  // files (that the target is responsible for)
+		Promoted int64 `json:"promoted"` // ditto, promoted
+		Skipped  int64 `json:"skipped"`  // destination exists (see apc.PromoteOwSkip)
+		Failed   int64 `json:"failed"`   // (see apc.PromoteArgs.ContinueOnError)
+	}
+
+	// x-load-lom-cache extended stats (core.Snap.Ext) - only when reading (see ArgsMsg.Verify)
+	WarmupStats struct {
+		Mpaths    []*MpathReadStats `json:"mpaths"`
+		Verified  int64             `json:"verified"`  // objects with validated checksums
+		Corrupted int64             `json:"corrupted"` // checksum mismatch
+		NoCksum   int64             `json:"no_cksum"`  // no stored checksum (read but not validated)
+	}
+	MpathReadStats struct {
+		Mpath string `json:"mpath"`
+		Objs  int64  `json:"objs"`
+		Bytes int64  `json:"bytes"`
+		Nanos int64  `json:"ns"` // time spent reading
+	}
 )
 
+const MaxRetryNames = 100 // max names reported by a single target
+
+// bytes per second
+func (ms *MpathReadStats) Throughput() int64 {
+	if ms.Nanos <= 0 {
+		return 0
+	}
+	return int64(float64(ms.Bytes) * float64(time.Second) / float64(ms.Nanos))
+}
+
 type (
 	Descriptor struct {
 		DisplayName string          // as implied
@@ -123,6 +189,7 @@ var Table = map[string]Descriptor{
 	// (one bucket) | (all buckets)
 	apc.ActLRU:          {DisplayName: "lru-eviction", Scope: ScopeGB, Startable: true},
 	apc.ActStoreCleanup: {DisplayName: "cleanup", Scope: ScopeGB, Startable: true},
+	apc.ActDefragDirs:   {DisplayName: "defrag", Scope: ScopeGB, Startable: true},
 	apc.ActSummaryBck: {
 		DisplayName: "summary",
 		Scope:       ScopeGB,
@@ -201,6 +268,12 @@ var Table = map[string]Descriptor{
 		Startable:   false,
 		RefreshCap:  true,
 	},
+	apc.ActRenameObjects: {
+		DisplayName: "rename-objects",
+		Scope:       ScopeB,
+		Access:      apc.AceObjMOVE,
+		Startable:   false,
+	},
 	apc.ActPrefetchObjects: {
 		DisplayName: "prefetch-objects",
 		Scope:       ScopeB,
@@ -208,6 +281,13 @@ var Table = map[string]Descriptor{
 		Startable:   true,
 		RefreshCap:  true,
 	},
+	apc.ActWarmUp: {
+		DisplayName: "warm-up",
+		Scope:       ScopeB,
+		Access:      apc.AccessRW,
+		Startable:   false, // (requires apc.WarmUpMsg - see api.WarmUp)
+		RefreshCap:  true,
+	},
 
 	// entire bucket (storage svcs)
 	apc.ActECEncode: {
@@ -219,6 +299,13 @@ var Table = map[string]Descriptor{
 		RefreshCap:     true,
 		ConflictRebRes: true,
 	},
+	apc.ActECRestore: {
+		Scope:         ScopeB,
+		Access:        apc.AccessRW,
+		Startable:     true,
+		RefreshCap:    true,
+		ExtendedStats: true,
+	},
 	apc.ActMakeNCopies: {
 		DisplayName: "mirror",
 		Scope:       ScopeB,
@@ -257,6 +344,16 @@ var Table = map[string]Descriptor{
 
 	apc.ActList: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false, Metasync: false, Idles: true},
 
+	// re-checksum existing objects (upon changing bucket's checksum type)
+	apc.ActCksumMigrate: {
+		DisplayName:   "migrate-checksum",
+		Scope:         ScopeB,
+		Access:        apc.AccessRW,
+		Startable:     true,
+		RefreshCap:    true,
+		ExtendedStats: true,
+	},
+
 	// cache management, internal usage
 	apc.ActLoadLomCache:   {DisplayName: "warm-up-metadata", Scope: ScopeB, Startable: true},
 	apc.ActInvalListCache: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false},
@@ -575,3 +672,77 @@ func (xs MultiSnap) TotalRunningTime(xid string) (time.Duration, error) {
 	}
 	return end.Sub(start), nil
 }
+
+// sum up across targets
+func (xs MultiSnap) PromoteStats(xid string) (out PromoteStats) {
+	for _, snaps := range xs {
+		for _, xsnap := range snaps {
+			if xid != xsnap.ID || xsnap.Ext == nil {
+				continue
+			}
+			var ps PromoteStats
+			if err := cos.MorphMarshal(xsnap.Ext, &ps); err != nil {
+				continue
+			}
+			out.Scanned += ps.Scanned
+			out.Promoted += ps.Promoted
+			out.Skipped += ps.Skipped
+			out.Failed += ps.Failed
+		}
+	}
+	return out
+}
+
+// by target ID (tid)
+func (xs MultiSnap) WarmupStats(xid string) map[string]*WarmupStats {
+	out := make(map[string]*WarmupStats, len(xs))
+	for tid, snaps := range xs {
+		for _, xsnap := range snaps {
+			if xid != xsnap.ID || xsnap.Ext == nil {
+				continue
+			}
+			ws := &WarmupStats{}
+			if err := cos.MorphMarshal(xsnap.Ext, ws); err != nil {
+				continue
+			}
+			out[tid] = ws
+		}
+	}
+	return out
+}
+
+// sum up across targets; names are included in the result only as far as reported (see MaxRetryNames)
+func (xs MultiSnap) CopyRetries(xid string) (out CopyRetries) {
+	for _, snaps := range xs {
+		for _, xsnap := range snaps {
+			if xid != xsnap.ID || xsnap.Ext == nil {
+				continue
+			}
+			var cr CopyRetries
+			if err := cos.MorphMarshal(xsnap.Ext, &cr); err != nil {
+				continue
+			}
+			out.Retried = append(out.Retried, cr.Retried...)
+			out.Failed = append(out.Failed, cr.Failed...)
+			out.NRetried += cr.NRetried
+			out.NFailed += cr.NFailed
+		}
+	}
+	return out
+}
+
+// ETL parameters the job was started with (same on all targets)
+func (xs MultiSnap) ETLParams(xid string) cos.StrKVs {
+	for _, snaps := range xs {
+		for _, xsnap := range snaps {
+			if xid != xsnap.ID || xsnap.Ext == nil {
+				continue
+			}
+			var ext TCExt
+			if err := cos.MorphMarshal(xsnap.Ext, &ext); err == nil && len(ext.ETLParams) > 0 {
+				return ext.ETLParams
+			}
+		}
+	}
+	return nil
+}
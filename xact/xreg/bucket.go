@@ -73,6 +73,10 @@ func RenewECEncode(bck *meta.Bck, uuid, phase string) RenewRes {
 	return RenewBucketXact(apc.ActECEncode, bck, Args{Custom: &ECEncodeArgs{Phase: phase}, UUID: uuid})
 }
 
+func RenewECRestore(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActECRestore, bck, Args{UUID: uuid})
+}
+
 func RenewMakeNCopies(uuid, tag string) {
 	var (
 		cfg      = cmn.GCO.Get()
@@ -112,8 +116,12 @@ func RenewPromote(uuid string, bck *meta.Bck, args *apc.PromoteArgs) RenewRes {
 	return RenewBucketXact(apc.ActPromote, bck, Args{Custom: args, UUID: uuid})
 }
 
-func RenewBckLoadLomCache(uuid string, bck *meta.Bck) RenewRes {
-	return RenewBucketXact(apc.ActLoadLomCache, bck, Args{UUID: uuid})
+func RenewBckLoadLomCache(uuid string, bck *meta.Bck, verify bool) RenewRes {
+	return RenewBucketXact(apc.ActLoadLomCache, bck, Args{UUID: uuid, Custom: verify})
+}
+
+func RenewCksumMigrate(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActCksumMigrate, bck, Args{UUID: uuid})
 }
 
 func RenewPutMirror(lom *core.LOM) RenewRes {
@@ -0,0 +1,104 @@
+// Package xreg provides registry and (renew, find) functions for AIS eXtended Actions (xactions).
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xreg
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Run-to-completion deadlines (max-runtime), e.g.:
+// `ais start lru --max-runtime 2h`
+// - a deadline is set by xaction ID and can be set either upon start (xact.ArgsMsg.MaxRuntime)
+//   or at any later time (apc.ActXactDeadline);
+// - max-runtime counts from the xaction's start time (or from the time the deadline is set,
+//   if the xaction is not yet in the registry);
+// - xactions that are still running past their respective deadlines get aborted
+//   with cmn.ErrXactDeadline (and are then reported as such).
+
+const (
+	deadlineMaxIval = 10 * time.Second
+	deadlineMinIval = time.Second
+)
+
+type (
+	deadline struct {
+		set        time.Time
+		maxRuntime time.Duration
+	}
+	deadlines struct {
+		m   map[string]deadline // by xaction ID
+		mtx sync.Mutex
+	}
+)
+
+// SetDeadline sets (or resets) max-runtime of a given xaction;
+// zero max-runtime removes the deadline
+func SetDeadline(id string, maxRuntime time.Duration) error {
+	if !xact.IsValidUUID(id) {
+		return fmt.Errorf("invalid UUID %q", id)
+	}
+	if maxRuntime < 0 {
+		return fmt.Errorf("invalid (negative) max-runtime %v", maxRuntime)
+	}
+	dl := &dreg.deadlines
+	dl.mtx.Lock()
+	if maxRuntime == 0 {
+		delete(dl.m, id)
+	} else {
+		dl.m[id] = deadline{set: time.Now(), maxRuntime: maxRuntime}
+	}
+	dl.mtx.Unlock()
+	return nil
+}
+
+// AbortExpired aborts all running xactions that have exceeded their respective
+// deadlines; returns the number of aborted xactions
+func AbortExpired() int {
+	n, _ := dreg.abortExpired(time.Now())
+	return n
+}
+
+func (r *registry) hkDeadlines() time.Duration {
+	_, next := r.abortExpired(time.Now())
+	return next
+}
+
+func (r *registry) abortExpired(now time.Time) (n int, next time.Duration) {
+	next = deadlineMaxIval
+	dl := &r.deadlines
+	dl.mtx.Lock()
+	for id, d := range dl.m {
+		xctn, _ := r.getXact(id)
+		if xctn != nil && xctn.Finished() {
+			delete(dl.m, id)
+			continue
+		}
+		started := d.set
+		if xctn != nil && !xctn.StartTime().IsZero() {
+			started = xctn.StartTime()
+		}
+		remains := started.Add(d.maxRuntime).Sub(now)
+		if remains > 0 {
+			next = min(next, remains)
+			continue
+		}
+		delete(dl.m, id)
+		if xctn == nil {
+			continue // never showed up
+		}
+		if xctn.Abort(cmn.ErrXactDeadline) {
+			nlog.Warningln(xctn.Name(), "exceeded max-runtime", d.maxRuntime, "- aborted")
+			n++
+		}
+	}
+	dl.mtx.Unlock()
+	return n, max(next, deadlineMinIval)
+}
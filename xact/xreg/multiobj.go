@@ -21,6 +21,14 @@ func RenewPrefetch(uuid string, bck *meta.Bck, msg *apc.PrefetchMsg) RenewRes {
 	return RenewBucketXact(apc.ActPrefetchObjects, bck, Args{UUID: uuid, Custom: msg})
 }
 
+func RenewWarmUp(uuid string, bck *meta.Bck, msg *apc.WarmUpMsg) RenewRes {
+	return RenewBucketXact(apc.ActWarmUp, bck, Args{UUID: uuid, Custom: msg})
+}
+
+func RenewRenameObjs(uuid string, bck *meta.Bck, msg *apc.RenameObjsMsg) RenewRes {
+	return RenewBucketXact(apc.ActRenameObjects, bck, Args{UUID: uuid, Custom: msg})
+}
+
 // kind: (apc.ActCopyObjects | apc.ActETLObjects)
 func RenewTCObjs(kind string, custom *TCObjsArgs) RenewRes {
 	return RenewBucketXact(kind, custom.BckFrom, Args{Custom: custom}, custom.BckFrom, custom.BckTo)
@@ -20,13 +20,13 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
 )
 
-// TODO: some of these constants must be configurable or derived from the config
 const (
 	initialCap       = 256 // initial capacity
-	keepOldThreshold = 256 // keep so many
+	keepOldThreshold = 256 // keep so many (default; see config.Xact.KeepMin)
 
 	waitPrevAborted = 2 * time.Second
 	waitLimitedCoex = 3 * time.Second
@@ -96,12 +96,20 @@ type (
 	// All entries in the registry. The entries are periodically cleaned up
 	// to make sure that we don't keep old entries forever.
 	registry struct {
+		tstats      cos.StatsUpdater
 		renewMtx    sync.RWMutex // TODO: revisit to optimiz out
 		entries     entries
 		bckXacts    map[string]Renewable
 		nonbckXacts map[string]Renewable
+		deadlines   deadlines // max-runtime, by xaction ID
 		finDelta    atomic.Int64
 	}
+	// finished xactions: retention policy (see config.Xact)
+	retention struct {
+		kinds map[string]time.Duration
+		dflt  time.Duration
+		keep  int
+	}
 )
 
 // default global registry that keeps track of all running xactions
@@ -128,13 +136,16 @@ func newRegistry() (r *registry) {
 		},
 		bckXacts:    make(map[string]Renewable, 32),
 		nonbckXacts: make(map[string]Renewable, 32),
+		deadlines:   deadlines{m: make(map[string]deadline, 4)},
 	}
 }
 
 // register w/housekeeper periodic registry cleanups
-func RegWithHK() {
+func RegWithHK(tstats cos.StatsUpdater) {
+	dreg.tstats = tstats
 	hk.Reg("x-old"+hk.NameSuffix, dreg.hkDelOld, 0)
 	hk.Reg("x-prune-active"+hk.NameSuffix, dreg.hkPruneActive, 0)
+	hk.Reg("x-deadline"+hk.NameSuffix, dreg.hkDeadlines, deadlineMaxIval)
 }
 
 func GetXact(uuid string) (core.Xact, error) { return dreg.getXact(uuid) }
@@ -414,60 +425,108 @@ func (r *registry) hkPruneActive() time.Duration {
 }
 
 func (r *registry) hkDelOld() time.Duration {
+	r.delOld(newRetention(cmn.GCO.Get()))
+	return hk.DelOldIval
+}
+
+func newRetention(config *cmn.Config) *retention {
+	rt := &retention{dflt: hk.OldAgeX, keep: keepOldThreshold}
+	if config.Xact.Retention > 0 {
+		rt.dflt = config.Xact.Retention.D()
+	}
+	if config.Xact.KeepMin > 0 {
+		rt.keep = config.Xact.KeepMin
+	}
+	rt.kinds, _ = config.Xact.ParseRetention() // (validated)
+	return rt
+}
+
+func (rt *retention) of(kind string) time.Duration {
+	if d, ok := rt.kinds[kind]; ok {
+		return d
+	}
+	if kind == apc.ActList {
+		return hk.OldAgeLso
+	}
+	return rt.dflt
+}
+
+// remove finished xactions older than their respective retention times,
+// while keeping at least `rt.keep` (not counting list-objects)
+func (r *registry) delOld(rt *retention) int {
 	var (
 		toRemove  []string
 		numNonLso int
 		now       = time.Now()
 	)
-
 	r.entries.mtx.RLock()
-	l := len(r.entries.all)
-
-	// first, cleanup list-objects: walk older to newer while counting non-lso
-	for i := range l {
-		xctn := r.entries.all[i].Get()
-		if xctn.Kind() != apc.ActList {
+	for _, entry := range r.entries.all {
+		if entry.Kind() != apc.ActList {
 			numNonLso++
+		}
+	}
+	// walk older to newer
+	excess := numNonLso - rt.keep
+	for _, entry := range r.entries.all {
+		xctn := entry.Get()
+		if !xctn.Finished() || now.Sub(xctn.EndTime()) < rt.of(xctn.Kind()) {
 			continue
 		}
-		if xctn.Finished() {
-			if sinceFin := now.Sub(xctn.EndTime()); sinceFin >= hk.OldAgeLso {
-				toRemove = append(toRemove, xctn.ID())
+		if xctn.Kind() != apc.ActList {
+			if excess <= 0 {
+				continue
 			}
+			excess--
 		}
+		toRemove = append(toRemove, xctn.ID())
 	}
-	// all the rest: older to newer, while keeping at least `keepOldThreshold`
-	if numNonLso > keepOldThreshold {
-		var cnt int
-		for i := range l {
-			xctn := r.entries.all[i].Get()
-			if xctn.Kind() == apc.ActList {
-				continue
-			}
-			if xctn.Finished() {
-				if sinceFin := now.Sub(xctn.EndTime()); sinceFin >= hk.OldAgeX {
-					toRemove = append(toRemove, xctn.ID())
-					cnt++
-					if numNonLso-cnt <= keepOldThreshold {
-						break
-					}
-				}
-			}
+	r.entries.mtx.RUnlock()
+
+	return r.delIDs(toRemove)
+}
+
+// GC removes finished xactions (all, of a given kind, or a given one by ID) that finished
+// at least `olderThan` ago - regardless of the configured retention (config.Xact);
+// returns the number of removed entries
+func GC(id, kind string, olderThan time.Duration) int { return dreg.gc(id, kind, olderThan) }
+
+func (r *registry) gc(id, kind string, olderThan time.Duration) int {
+	var (
+		toRemove []string
+		now      = time.Now()
+	)
+	r.entries.mtx.RLock()
+	for _, entry := range r.entries.all {
+		xctn := entry.Get()
+		if id != "" && xctn.ID() != id {
+			continue
+		}
+		if kind != "" && xctn.Kind() != kind {
+			continue
+		}
+		if xctn.Finished() && now.Sub(xctn.EndTime()) >= olderThan {
+			toRemove = append(toRemove, xctn.ID())
 		}
 	}
 	r.entries.mtx.RUnlock()
 
+	return r.delIDs(toRemove)
+}
+
+func (r *registry) delIDs(toRemove []string) int {
 	if len(toRemove) == 0 {
-		return hk.DelOldIval
+		return 0
 	}
-
-	// cleanup
 	r.entries.mtx.Lock()
 	for _, id := range toRemove {
 		r.entries.del(id)
 	}
 	r.entries.mtx.Unlock()
-	return hk.DelOldIval
+
+	if r.tstats != nil {
+		r.tstats.Add(stats.XactGCCount, int64(len(toRemove)))
+	}
+	return len(toRemove)
 }
 
 func (r *registry) renewByID(entry Renewable, bck *meta.Bck) (rns RenewRes) {
@@ -0,0 +1,253 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Checksum migration: changing bucket's `checksum.type` only affects new writes;
+// this xaction re-checksums existing objects (throttled) in two passes:
+// 1. "migrate": read each object once to compute the new checksum while validating
+//    the existing one; the existing checksum is kept in custom metadata (cmn.PrevCksumObjMD);
+// 2. "cleanup": upon successful completion of the first pass (and only then),
+//    remove the previous checksums.
+// Objects that fail validation (stored checksum mismatch) are reported and left as is.
+
+const (
+	cmgPhaseMigrate = "migrate"
+	cmgPhaseCleanup = "cleanup"
+)
+
+type (
+	cmgFactory struct {
+		xreg.RenewBase
+		xctn *xactCksumMigrate
+	}
+	xactCksumMigrate struct {
+		slab    *memsys.Slab
+		cksumTy string
+		cleanup atomic.Bool // second pass
+		skipped atomic.Int64
+		cleaned atomic.Int64
+		xact.BckJog
+	}
+	// extended x-cksum-migrate statistics
+	ExtCksumMigrateStats struct {
+		Type     string `json:"cksum.type"`
+		Phase    string `json:"phase"`
+		Migrated int64  `json:"migrated.n,string"`
+		Skipped  int64  `json:"skipped.n,string"`
+		Cleaned  int64  `json:"cleaned.n,string"`
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*xactCksumMigrate)(nil)
+	_ xreg.Renewable = (*cmgFactory)(nil)
+)
+
+////////////////
+// cmgFactory //
+////////////////
+
+func (*cmgFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &cmgFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *cmgFactory) Start() error {
+	ty := p.Bck.CksumConf().Type
+	if ty == cos.ChecksumNone {
+		return fmt.Errorf("cannot migrate %s checksums: checksum type is %q", p.Bck.Cname(""), ty)
+	}
+	slab, err := core.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	debug.AssertNoErr(err)
+	p.xctn = newXactCksumMigrate(p.UUID(), p.Bck, ty, slab)
+	go p.xctn.Run(nil)
+	return nil
+}
+
+func (*cmgFactory) Kind() string     { return apc.ActCksumMigrate }
+func (p *cmgFactory) Get() core.Xact { return p.xctn }
+
+func (*cmgFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+//////////////////////
+// xactCksumMigrate //
+//////////////////////
+
+// NOTE: always throttling
+func newXactCksumMigrate(uuid string, bck *meta.Bck, ty string, slab *memsys.Slab) (r *xactCksumMigrate) {
+	r = &xactCksumMigrate{slab: slab, cksumTy: ty}
+	r.BckJog.Init(uuid, apc.ActCksumMigrate, bck, r.jopts(bck, r.visitObj), cmn.GCO.Get())
+	return r
+}
+
+func (r *xactCksumMigrate) jopts(bck *meta.Bck, cb func(*core.LOM, []byte) error) *mpather.JgroupOpts {
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: cb,
+		Slab:     r.slab,
+		DoLoad:   mpather.LoadUnsafe,
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	return mpopts
+}
+
+func (r *xactCksumMigrate) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name(), "[", r.cksumTy, "]")
+
+	// 1. migrate
+	r.BckJog.Run()
+	if err := r.BckJog.Wait(); err != nil {
+		r.AddErr(err)
+		r.Finish()
+		return
+	}
+
+	// 2. cleanup
+	r.cleanup.Store(true)
+	joggers := mpather.NewJoggerGroup(r.jopts(r.Bck(), r.cleanupObj), r.Config, nil)
+	joggers.Run()
+	select {
+	case errCause := <-r.ChanAbort():
+		joggers.Stop()
+		r.AddErr(errCause)
+	case <-joggers.ListenFinished():
+		if err := joggers.Stop(); err != nil {
+			r.AddErr(err)
+		}
+	}
+	r.Finish()
+}
+
+func (r *xactCksumMigrate) visitObj(lom *core.LOM, buf []byte) error {
+	if cksum := lom.Checksum(); cksum != nil && cksum.Ty() == r.cksumTy {
+		return nil
+	}
+	lom.Lock(true)
+	err := r.migrate(lom, buf)
+	lom.Unlock(true)
+
+	switch {
+	case err == nil:
+	case cos.IsNotExist(err, 0):
+		err = nil
+	case cos.IsErrOOS(err):
+		r.Abort(err)
+	default:
+		r.skipped.Inc()
+		r.AddErr(err, 5, cos.SmoduleXs)
+		err = nil // keep going
+	}
+	return err
+}
+
+// under wlock
+func (r *xactCksumMigrate) migrate(lom *core.LOM, buf []byte) error {
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	var (
+		prev    = lom.Checksum()
+		hasPrev = !prev.IsEmpty()
+	)
+	if hasPrev && prev.Ty() == r.cksumTy {
+		return nil // (e.g., has been overwritten in the meantime)
+	}
+	lmfh, err := lom.Open()
+	if err != nil {
+		return err
+	}
+	var (
+		w      io.Writer
+		cknew  = cos.NewCksumHash(r.cksumTy)
+		ckprev *cos.CksumHash
+	)
+	if hasPrev {
+		ckprev = cos.NewCksumHash(prev.Ty())
+		w = io.MultiWriter(cknew.H, ckprev.H)
+	} else {
+		w = cknew.H
+	}
+	_, err = cos.CopyBuffer(w, lmfh, buf)
+	cos.Close(lmfh)
+	if err != nil {
+		return err
+	}
+	if hasPrev {
+		ckprev.Finalize()
+		if !ckprev.Equal(prev) {
+			return cos.NewErrDataCksum(&ckprev.Cksum, prev, lom.Cname())
+		}
+		lom.SetCustomKey(cmn.PrevCksumObjMD, prev.Ty()+":"+prev.Val())
+	}
+	cknew.Finalize()
+	lom.SetCksum(cknew.Clone())
+	if err := lom.PersistWithCopies(); err != nil {
+		return err
+	}
+	r.ObjsAdd(1, lom.Lsize())
+	return nil
+}
+
+func (r *xactCksumMigrate) cleanupObj(lom *core.LOM, _ []byte) error {
+	if _, ok := lom.GetCustomKey(cmn.PrevCksumObjMD); !ok {
+		return nil
+	}
+	lom.Lock(true)
+	err := lom.Load(false /*cache it*/, true /*locked*/)
+	if err == nil {
+		if _, ok := lom.GetCustomKey(cmn.PrevCksumObjMD); ok {
+			lom.DelCustomKey(cmn.PrevCksumObjMD)
+			if err = lom.PersistWithCopies(); err == nil {
+				r.cleaned.Inc()
+			}
+		}
+	}
+	lom.Unlock(true)
+	if err != nil && !cos.IsNotExist(err, 0) {
+		r.AddErr(err, 5, cos.SmoduleXs)
+	}
+	return nil
+}
+
+func (r *xactCksumMigrate) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	phase := cmgPhaseMigrate
+	if r.cleanup.Load() {
+		phase = cmgPhaseCleanup
+	}
+	snap.Ext = &ExtCksumMigrateStats{
+		Type:     r.cksumTy,
+		Phase:    phase,
+		Migrated: r.Objs(),
+		Skipped:  r.skipped.Load(),
+		Cleaned:  r.cleaned.Load(),
+	}
+	return
+}
@@ -0,0 +1,103 @@
+// Package xs_test contains xs unit test.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+func TestXactionCksumMigrate(t *testing.T) {
+	const objCnt = 20
+	var (
+		desc = tools.ObjectsDesc{
+			CTs:           []tools.ContentTypeDesc{{Type: fs.ObjectType, ContentCnt: objCnt}},
+			MountpathsCnt: 2,
+			ObjectSize:    cos.KiB,
+		}
+		out = tools.PrepareObjects(t, desc)
+	)
+	t.Cleanup(func() { fs.TestNew(nil) })
+	xreg.TestReset()
+	xs.Xreg(false)
+	defer xreg.AbortAll(nil)
+	cos.InitShortID(0)
+
+	// existing objects: xxhash checksums (with one mismatch) and no checksum at all
+	var (
+		fqns    = out.FQNs[fs.ObjectType]
+		corrupt = fqns[0]
+	)
+	for i, fqn := range fqns {
+		if i%4 == 3 {
+			continue // no checksum
+		}
+		lom := &core.LOM{}
+		tassert.CheckFatal(t, lom.InitFQN(fqn, nil))
+		tassert.CheckFatal(t, lom.Load(false, false))
+		cksum := cmgCksum(t, fqn, cos.ChecksumXXHash)
+		if fqn == corrupt {
+			cksum = cos.NewCksum(cos.ChecksumXXHash, "0123456789abcdef")
+		}
+		lom.SetCksum(cksum)
+		tassert.CheckFatal(t, lom.Persist())
+	}
+
+	// change bucket's checksum type and migrate
+	out.Bck.Props.Cksum.Type = cos.ChecksumSHA256
+	bck := meta.CloneBck(&out.Bck)
+	tassert.CheckFatal(t, bck.Init(core.T.Bowner()))
+
+	rns := xreg.RenewCksumMigrate(cos.GenUUID(), bck)
+	tassert.CheckFatal(t, rns.Err)
+	xctn := rns.Entry.Get()
+	deadline := time.Now().Add(time.Minute)
+	for !xctn.Finished() {
+		tassert.Fatalf(t, time.Now().Before(deadline), "%s: timed out", xctn)
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	snap := xctn.Snap()
+	ext, ok := snap.Ext.(*xs.ExtCksumMigrateStats)
+	tassert.Fatalf(t, ok, "unexpected ext stats %T", snap.Ext)
+	tassert.Errorf(t, snap.Err != "" && !snap.IsAborted(), "expecting checksum mismatch error (and not abort), got %+v", snap)
+	tassert.Errorf(t, ext.Phase == "cleanup" && ext.Type == cos.ChecksumSHA256, "unexpected %+v", ext)
+	tassert.Errorf(t, ext.Migrated == objCnt-1 && ext.Skipped == 1, "expected %d migrated and 1 skipped, got %+v", objCnt-1, ext)
+	tassert.Errorf(t, ext.Cleaned == objCnt-objCnt/4-1, "expected %d cleaned-up objects, got %+v", objCnt-objCnt/4-1, ext)
+
+	for _, fqn := range fqns {
+		lom := &core.LOM{}
+		tassert.CheckFatal(t, lom.InitFQN(fqn, nil))
+		tassert.CheckFatal(t, lom.Load(false, false))
+		_, hasPrev := lom.GetCustomKey(cmn.PrevCksumObjMD)
+		tassert.Errorf(t, !hasPrev, "%s: previous checksum not cleaned up", lom)
+		if fqn == corrupt {
+			tassert.Errorf(t, lom.Checksum().Ty() == cos.ChecksumXXHash, "%s: expecting corrupted object left as is", lom)
+			continue
+		}
+		expected := cmgCksum(t, fqn, cos.ChecksumSHA256)
+		tassert.Errorf(t, lom.Checksum().Equal(expected), "%s: expected %s, got %s", lom, expected, lom.Checksum())
+	}
+}
+
+func cmgCksum(t *testing.T, fqn, ty string) *cos.Cksum {
+	b, err := os.ReadFile(fqn)
+	tassert.CheckFatal(t, err)
+	ckhash := cos.NewCksumHash(ty)
+	ckhash.H.Write(b)
+	ckhash.Finalize()
+	return ckhash.Clone()
+}
@@ -6,11 +6,13 @@
 package xs
 
 import (
+	"os"
 	"path/filepath"
 	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -22,7 +24,12 @@ import (
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
-// promotes (i.e., copies) locally accessible (within the cluster) directory => bucket
+// promotes (i.e., copies) locally accessible (within the cluster) directory (or file) => bucket
+// - with file share (confirmed by the proxy), each target promotes only the files that "land" locally;
+// - destination objects that already exist are skipped, overwritten, or fail the promotion,
+//   depending on apc.PromoteArgs.OwPolicy;
+// - the first failure terminates the xaction, unless ContinueOnError;
+// - progress (files scanned, promoted, skipped, failed) is reported via xact.PromoteStats.
 
 type (
 	proFactory struct {
@@ -31,8 +38,12 @@ type (
 		args *apc.PromoteArgs
 	}
 	XactDirPromote struct {
-		p    *proFactory
-		smap *meta.Smap
+		p       *proFactory
+		smap    *meta.Smap
+		dir     string // source directory ("" when promoting a single file)
+		scanned atomic.Int64
+		skipped atomic.Int64
+		failed  atomic.Int64
 		xact.BckJog
 		confirmedFshare bool // set separately in the commit phase prior to Run
 	}
@@ -77,18 +88,21 @@ func (r *XactDirPromote) SetFshare(v bool) { r.confirmedFshare = v } // is calle
 func (r *XactDirPromote) Run(wg *sync.WaitGroup) {
 	wg.Done()
 
-	dir := r.p.args.SrcFQN
-	nlog.Infof("%s(%s)", r.Name(), dir)
+	src := r.p.args.SrcFQN
+	nlog.Infof("%s(%s)", r.Name(), src)
 
 	r.smap = core.T.Sowner().Get()
-	var (
-		err  error
-		opts = &fs.WalkOpts{Dir: dir, Callback: r.walk, Sorted: false}
-	)
-	if r.p.args.Recursive {
-		err = fs.Walk(opts) // godirwalk
-	} else {
-		err = fs.WalkDir(dir, r.walk) // Go filepath.WalkDir
+	finfo, err := os.Stat(src)
+	switch {
+	case err != nil:
+	case !finfo.IsDir():
+		err = r.walk(src, nil)
+	case r.p.args.Recursive:
+		r.dir = src
+		err = fs.Walk(&fs.WalkOpts{Dir: src, Callback: r.walk, Sorted: false}) // godirwalk
+	default:
+		r.dir = src
+		err = fs.WalkDir(src, r.walk) // Go filepath.WalkDir
 	}
 	if err != nil {
 		r.AddErr(err)
@@ -96,8 +110,9 @@ func (r *XactDirPromote) Run(wg *sync.WaitGroup) {
 	r.Finish()
 }
 
+// (de == nil when promoting a single file)
 func (r *XactDirPromote) walk(fqn string, de fs.DirEntry) error {
-	if de.IsDir() {
+	if de != nil && de.IsDir() {
 		return nil
 	}
 	debug.Assert(filepath.IsAbs(fqn))
@@ -105,7 +120,7 @@ func (r *XactDirPromote) walk(fqn string, de fs.DirEntry) error {
 
 	// promote
 	args := r.p.args
-	objName, err := PrmObjName(fqn, args.SrcFQN, args.ObjName)
+	objName, err := PrmObjName(fqn, r.dir, args.ObjName)
 	if err != nil {
 		return err
 	}
@@ -126,18 +141,32 @@ func (r *XactDirPromote) walk(fqn string, de fs.DirEntry) error {
 		PromoteArgs: apc.PromoteArgs{
 			SrcFQN:       fqn,
 			ObjName:      objName,
+			Overwrite:    args.OwPolicy(),
 			OverwriteDst: args.OverwriteDst,
 			DeleteSrc:    args.DeleteSrc,
 		},
 	}
-	// TODO: continue-on-error (unify w/ x-archive)
+	r.scanned.Inc()
+	nobjs := r.Objs() // (sequential walk)
 	ecode, err := core.T.Promote(&params)
 	if cos.IsNotExist(err, ecode) {
 		err = nil
 	}
 	if cmn.Rom.FastV(5, cos.SmoduleXs) {
-		nlog.Infof("%s: %s => %s (over=%t, del=%t, share=%t): %v", r.Base.Name(), fqn, bck.Cname(objName),
-			args.OverwriteDst, args.DeleteSrc, r.confirmedFshare, err)
+		nlog.Infof("%s: %s => %s (over=%s, del=%t, share=%t): %v", r.Base.Name(), fqn, bck.Cname(objName),
+			params.Overwrite, args.DeleteSrc, r.confirmedFshare, err)
+	}
+	switch {
+	case err == nil:
+		if r.Objs() == nobjs {
+			r.skipped.Inc()
+		}
+	case args.ContinueOnError && !cos.IsErrOOS(err):
+		r.failed.Inc()
+		r.AddErr(err, 4, cos.SmoduleXs)
+		err = nil
+	default:
+		r.failed.Inc()
 	}
 	return err
 }
@@ -147,6 +176,12 @@ func (r *XactDirPromote) Snap() (snap *core.Snap) {
 	r.ToSnap(snap)
 
 	snap.IdleX = r.IsIdle()
+	snap.Ext = &xact.PromoteStats{
+		Scanned:  r.scanned.Load(),
+		Promoted: r.Objs(),
+		Skipped:  r.skipped.Load(),
+		Failed:   r.failed.Load(),
+	}
 	return
 }
 
@@ -60,6 +60,8 @@ func newEvictDelete(xargs *xreg.Args, kind string, bck *meta.Bck, msg *apc.ListR
 	if err = ed.lriterator.init(ed, msg, bck); err != nil {
 		return nil, err
 	}
+	// evicting by prefix: no need to list remote bucket - walk what's cached
+	ed.lriterator.cached = kind == apc.ActEvictObjects
 	ed.InitBase(xargs.UUID, kind, bck)
 	return ed, nil
 }
@@ -25,11 +25,14 @@ func Xreg(xeleOnly bool) {
 	xreg.RegBckXact(&evdFactory{kind: apc.ActEvictObjects})
 	xreg.RegBckXact(&evdFactory{kind: apc.ActDeleteObjects})
 	xreg.RegBckXact(&prfFactory{})
+	xreg.RegBckXact(&renFactory{})
+	xreg.RegBckXact(&wupFactory{})
 
 	xreg.RegNonBckXact(&nsummFactory{})
 
 	xreg.RegBckXact(&proFactory{})
 	xreg.RegBckXact(&llcFactory{})
+	xreg.RegBckXact(&cmgFactory{})
 
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActCopyBck})
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActETLBck})
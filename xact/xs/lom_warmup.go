@@ -6,27 +6,54 @@
 package xs
 
 import (
+	"io"
+	"sort"
 	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
+// Preload (warm-up):
+// - by default, loads object metadata into in-memory cache;
+// - with `verify` (see xact.ArgsMsg.Verify), also reads each object in its entirety (thus warming up
+//   the page cache) while computing its checksum and comparing it with the stored one;
+// - mismatches are reported as errors and counted (objects are left as is); objects that have no
+//   stored checksum are read but not validated;
+// - when reading, the xaction also reports per-mountpath read throughput (see xact.WarmupStats),
+//   so that preload doubles as a storage smoke test.
+
 type (
 	llcFactory struct {
 		xreg.RenewBase
-		xctn *xactLLC
+		xctn   *xactLLC
+		verify bool
 	}
 	xactLLC struct {
+		slab      *memsys.Slab
+		mpaths    map[string]*llcMpath // (immutable once started)
+		verified  atomic.Int64
+		corrupted atomic.Int64
+		nocksum   atomic.Int64
 		xact.BckJog
 	}
+	llcMpath struct {
+		objs  atomic.Int64
+		bytes atomic.Int64
+		nanos atomic.Int64
+	}
 )
 
 // interface guard
@@ -42,11 +69,12 @@ var (
 func (*llcFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
 	p := &llcFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
 	p.Bck = bck
+	p.verify, _ = args.Custom.(bool)
 	return p
 }
 
 func (p *llcFactory) Start() error {
-	xctn := newXactLLC(p.UUID(), p.Bck)
+	xctn := newXactLLC(p.UUID(), p.Bck, p.verify)
 	p.xctn = xctn
 	go xctn.Run(nil)
 	return nil
@@ -61,13 +89,25 @@ func (*llcFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return
 // xactLLC //
 /////////////
 
-func newXactLLC(uuid string, bck *meta.Bck) (r *xactLLC) {
+func newXactLLC(uuid string, bck *meta.Bck, verify bool) (r *xactLLC) {
 	r = &xactLLC{}
 	mpopts := &mpather.JgroupOpts{
 		CTs:      []string{fs.ObjectType},
 		VisitObj: func(*core.LOM, []byte) error { return nil },
 		DoLoad:   mpather.Load,
 	}
+	if verify {
+		var err error
+		r.slab, err = core.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+		debug.AssertNoErr(err)
+		avail := fs.GetAvail()
+		r.mpaths = make(map[string]*llcMpath, len(avail))
+		for mpath := range avail {
+			r.mpaths[mpath] = &llcMpath{}
+		}
+		mpopts.VisitObj = r.visitObj
+		mpopts.Slab = r.slab
+	}
 	mpopts.Bck.Copy(bck.Bucket())
 	r.BckJog.Init(uuid, apc.ActLoadLomCache, bck, mpopts, cmn.GCO.Get())
 	return
@@ -75,7 +115,11 @@ func newXactLLC(uuid string, bck *meta.Bck) (r *xactLLC) {
 
 func (r *xactLLC) Run(*sync.WaitGroup) {
 	r.BckJog.Run()
-	nlog.Infoln(r.Name())
+	if r.mpaths != nil {
+		nlog.Infoln(r.Name(), "[ verify ]")
+	} else {
+		nlog.Infoln(r.Name())
+	}
 	err := r.BckJog.Wait()
 	if err != nil {
 		r.AddErr(err)
@@ -83,10 +127,96 @@ func (r *xactLLC) Run(*sync.WaitGroup) {
 	r.Finish()
 }
 
+// read the object and validate its checksum (verify mode only)
+func (r *xactLLC) visitObj(lom *core.LOM, buf []byte) error {
+	lom.Lock(false)
+	err := r.read(lom, buf)
+	lom.Unlock(false)
+
+	switch {
+	case err == nil:
+	case cos.IsNotExist(err, 0):
+	case cos.IsErrBadCksum(err):
+		r.corrupted.Inc()
+		r.AddErr(err, 0)
+	default:
+		r.AddErr(err, 5, cos.SmoduleXs)
+	}
+	return nil // keep going
+}
+
+// under rlock
+func (r *xactLLC) read(lom *core.LOM, buf []byte) error {
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	lmfh, err := lom.Open()
+	if err != nil {
+		return err
+	}
+	var (
+		cksum   = lom.Checksum()
+		ckhash  *cos.CksumHash
+		started = mono.NanoTime()
+		n       int64
+	)
+	if cksum.IsEmpty() {
+		n, err = cos.CopyBuffer(cos.WriterOnly{Writer: io.Discard}, lmfh, buf)
+	} else {
+		ckhash = cos.NewCksumHash(cksum.Ty())
+		n, err = cos.CopyBuffer(ckhash.H, lmfh, buf)
+	}
+	elapsed := mono.Since(started)
+	cos.Close(lmfh)
+	if err != nil {
+		return err
+	}
+
+	if mp, ok := r.mpaths[lom.Mountpath().Path]; ok {
+		mp.objs.Inc()
+		mp.bytes.Add(n)
+		mp.nanos.Add(int64(elapsed))
+	}
+	r.ObjsAdd(1, n)
+
+	if ckhash == nil {
+		r.nocksum.Inc()
+		return nil
+	}
+	ckhash.Finalize()
+	if !ckhash.Equal(cksum) {
+		return cos.NewErrDataCksum(&ckhash.Cksum, cksum, lom.Cname())
+	}
+	r.verified.Inc()
+	return nil
+}
+
 func (r *xactLLC) Snap() (snap *core.Snap) {
 	snap = &core.Snap{}
 	r.ToSnap(snap)
 
 	snap.IdleX = r.IsIdle()
+	if r.mpaths != nil {
+		snap.Ext = r.stats()
+	}
 	return
 }
+
+func (r *xactLLC) stats() *xact.WarmupStats {
+	ws := &xact.WarmupStats{
+		Mpaths:    make([]*xact.MpathReadStats, 0, len(r.mpaths)),
+		Verified:  r.verified.Load(),
+		Corrupted: r.corrupted.Load(),
+		NoCksum:   r.nocksum.Load(),
+	}
+	for mpath, mp := range r.mpaths {
+		ws.Mpaths = append(ws.Mpaths, &xact.MpathReadStats{
+			Mpath: mpath,
+			Objs:  mp.objs.Load(),
+			Bytes: mp.bytes.Load(),
+			Nanos: mp.nanos.Load(),
+		})
+	}
+	sort.Slice(ws.Mpaths, func(i, j int) bool { return ws.Mpaths[i].Mpath < ws.Mpaths[j].Mpath })
+	return ws
+}
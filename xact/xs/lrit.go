@@ -64,7 +64,8 @@ type (
 		bck    *meta.Bck
 		pt     *cos.ParsedTemplate
 		prefix string
-		lrp    int // { lrpList, ... } enum
+		lrp    int  // { lrpList, ... } enum
+		cached bool // prefix: visit only the objects that are present in the cluster (e.g., evict)
 
 		// running concurrency
 		workCh  chan lrpair
@@ -230,7 +231,7 @@ func (r *lriterator) _prefix(wi lrwi, smap *meta.Smap) error {
 		lst     *cmn.LsoRes
 		msg     = &apc.LsoMsg{Prefix: r.prefix, Props: apc.GetPropsStatus}
 		npg     = newNpgCtx(r.bck, msg, noopCb, nil /*core.LsoInvCtx bucket inventory*/)
-		bremote = r.bck.IsRemote()
+		bremote = r.bck.IsRemote() && !r.cached
 	)
 	if err := r.bck.Init(core.T.Bowner()); err != nil {
 		return err
@@ -246,6 +247,7 @@ func (r *lriterator) _prefix(wi lrwi, smap *meta.Smap) error {
 			lst = &cmn.LsoRes{Entries: allocLsoEntries()}
 			ecode, err = core.T.Backend(r.bck).ListObjects(r.bck, msg, lst) // (TODO comment above)
 		} else {
+			// local walk: this target's objects only (in particular, cached objects of a remote bucket)
 			npg.page.Entries = allocLsoEntries()
 			err = npg.nextPageA()
 			lst = &npg.page
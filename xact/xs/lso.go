@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -625,7 +626,7 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 
 	// ls arch
 	// looking only at the file extension - not reading ("detecting") file magic (TODO: add lsmsg flag)
-	archList, err := archive.List(fqn)
+	archList, err := lsArch(fqn)
 	if err != nil {
 		if archive.IsErrUnknownFileExt(err) {
 			// skip and keep going
@@ -650,6 +651,21 @@ func (r *LsoXact) cb(fqn string, de fs.DirEntry) error {
 	return nil
 }
 
+// use archived-content index, if available (and valid)
+func lsArch(fqn string) ([]*archive.Entry, error) {
+	if strings.HasSuffix(fqn, archive.ExtTar) {
+		lom := core.AllocLOM("")
+		if lom.InitFQN(fqn, nil) == nil {
+			if idx := lom.LoadArchIndex(); idx != nil {
+				core.FreeLOM(lom)
+				return idx.List(), nil
+			}
+		}
+		core.FreeLOM(lom)
+	}
+	return archive.List(fqn)
+}
+
 func (r *LsoXact) Snap() (snap *core.Snap) {
 	snap = &core.Snap{}
 	r.ToSnap(snap)
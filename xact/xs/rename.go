@@ -0,0 +1,141 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-rename-objects: multi-object rename (batch mv) within a given ais:// bucket (see apc.RenameObjsMsg)
+// - each target renames its own (HRW-local) objects via core.T.RenameObject, which first copies
+//   the object under its new name and then removes the source;
+// - failure to remove the source rolls back the copy (ais/target.go), so that any given object ends up
+//   having either its new name or the old one;
+// - list: all targets get the same list and skip non-local names; missing objects are reported as errors;
+// - prefix to prefix: each target walks its local objects that match the source prefix;
+// - progress: renamed objects (and their sizes) are counted in the xaction's stats;
+// - upon failure the xaction aborts, unless ContinueOnError (in which case the error gets reported
+//   and the object skipped).
+
+type (
+	renFactory struct {
+		xreg.RenewBase
+		xctn *XactRenameObjs
+		msg  *apc.RenameObjsMsg
+	}
+	XactRenameObjs struct {
+		lriterator
+		msg   *apc.RenameObjsMsg
+		names map[string]string // list: source name => new name
+		xact.Base
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactRenameObjs)(nil)
+	_ xreg.Renewable = (*renFactory)(nil)
+	_ lrwi           = (*XactRenameObjs)(nil)
+)
+
+////////////////
+// renFactory //
+////////////////
+
+func (*renFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	msg := args.Custom.(*apc.RenameObjsMsg)
+	return &renFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, msg: msg}
+}
+
+func (p *renFactory) Start() (err error) {
+	p.xctn, err = newRenameObjs(&p.Args, p.Bck, p.msg)
+	return err
+}
+
+func (*renFactory) Kind() string     { return apc.ActRenameObjects }
+func (p *renFactory) Get() core.Xact { return p.xctn }
+
+func (*renFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+////////////////////
+// XactRenameObjs //
+////////////////////
+
+func newRenameObjs(xargs *xreg.Args, bck *meta.Bck, msg *apc.RenameObjsMsg) (r *XactRenameObjs, err error) {
+	r = &XactRenameObjs{msg: msg}
+	lrmsg := &apc.ListRange{}
+	if msg.IsList() {
+		lrmsg.ObjNames = msg.ObjNames
+		r.names = make(map[string]string, len(msg.ObjNames))
+		for i, name := range msg.ObjNames {
+			r.names[name] = msg.NewNames[i]
+		}
+	}
+	if err = r.lriterator.init(r, lrmsg, bck); err != nil {
+		return nil, err
+	}
+	if !msg.IsList() {
+		// (not a template)
+		r.lriterator.prefix = msg.Prefix
+	}
+	r.InitBase(xargs.UUID, apc.ActRenameObjects, bck)
+	return r, nil
+}
+
+func (r *XactRenameObjs) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	err := r.lriterator.run(r, core.T.Sowner().Get())
+	if err != nil {
+		r.AddErr(err, 5, cos.SmoduleXs)
+	}
+	r.lriterator.wait()
+	r.Finish()
+}
+
+func (r *XactRenameObjs) do(lom *core.LOM, lrit *lriterator) {
+	var objNameTo string
+	if r.names != nil {
+		objNameTo = r.names[lom.ObjName]
+	} else {
+		objNameTo = r.msg.ToName(lom.ObjName)
+	}
+	err := lom.Load(false /*cache it*/, false /*locked*/)
+	if err == nil {
+		size := lom.Lsize()
+		if err = core.T.RenameObject(lom, objNameTo); err == nil {
+			r.ObjsAdd(1, size)
+			return
+		}
+	}
+	if cos.IsNotExist(err, 0) && lrit.lrp != lrpList {
+		return // (removed in the meantime)
+	}
+	if r.msg.ContinueOnError {
+		r.AddErr(err, 4, cos.SmoduleXs)
+		return
+	}
+	if !cmn.IsErrAborted(err) {
+		r.Abort(err)
+	}
+}
+
+func (r *XactRenameObjs) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -8,6 +8,7 @@ package xs
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"sync"
 	"time"
 
@@ -44,6 +45,8 @@ type (
 		rxlast atomic.Int64 // finishing
 		xact.BckJog
 		prune    prune
+		retry    tcretry
+		pace     tcpace
 		nam, str string
 		wg       sync.WaitGroup // starting up
 		refc     atomic.Int32   // finishing
@@ -90,6 +93,7 @@ func (p *tcbFactory) Start() error {
 	}
 	nat := smap.CountActiveTs()
 	p.xctn.refc.Store(int32(nat - 1))
+	p.xctn.pace.init(&p.args.Msg.CopyBckMsg, nat)
 	p.xctn.wg.Add(1)
 
 	var sizePDU int32
@@ -256,7 +260,7 @@ func (r *XactTCB) qcb(tot time.Duration) core.QuiRes {
 	return core.QuiInactiveCB
 }
 
-func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
+func (r *XactTCB) do(lom *core.LOM, buf []byte) error {
 	var (
 		args   = r.p.args // TCBArgs
 		toName = args.Msg.ToName(lom.ObjName)
@@ -264,21 +268,10 @@ func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
 	if cmn.Rom.FastV(5, cos.SmoduleXs) {
 		nlog.Infoln(r.Base.Name()+":", lom.Cname(), "=>", args.BckTo.Cname(toName))
 	}
-	coiParams := core.AllocCOI()
-	{
-		coiParams.DP = args.DP
-		coiParams.Xact = r
-		coiParams.Config = r.Config
-		coiParams.BckTo = args.BckTo
-		coiParams.ObjnameTo = toName
-		coiParams.Buf = buf
-		coiParams.OWT = r.p.owt
-		coiParams.DryRun = args.Msg.DryRun
-		coiParams.LatestVer = args.Msg.LatestVer
-		coiParams.Sync = args.Msg.Sync
+	if !r.pace.wait(r, lom.Lsize()) {
+		return nil // (aborted)
 	}
-	_, err = core.T.CopyObject(lom, r.dm, coiParams)
-	core.FreeCOI(coiParams)
+	err := r.retry.do(r, &args.Msg.CopyBckMsg, lom, func() error { return r._do(lom, buf, toName) })
 	switch {
 	case err == nil:
 		if args.Msg.Sync {
@@ -288,10 +281,41 @@ func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
 		// do nothing
 	case cos.IsErrOOS(err):
 		r.Abort(err)
+		return err
 	default:
-		r.AddErr(err, 5, cos.SmoduleXs)
+		return tcfailed(r, &args.Msg.CopyBckMsg, err)
 	}
-	return
+	return nil
+}
+
+func (r *XactTCB) _do(lom *core.LOM, buf []byte, toName string) error {
+	var (
+		args    = r.p.args
+		dp, owt = args.DP, r.p.owt
+	)
+	// ETL input filter: skip or copy as is (untransformed)
+	if f := args.Msg.Filter; f != nil && dp != nil && !f.Match(lom.ObjName, lom.Lsize(), lom.GetCustomMD()) {
+		if !f.CopyUnmatched {
+			return nil
+		}
+		dp, owt = nil, cmn.OwtCopy
+	}
+	coiParams := core.AllocCOI()
+	{
+		coiParams.DP = dp
+		coiParams.Xact = r
+		coiParams.Config = r.Config
+		coiParams.BckTo = args.BckTo
+		coiParams.ObjnameTo = toName
+		coiParams.Buf = buf
+		coiParams.OWT = owt
+		coiParams.DryRun = args.Msg.DryRun
+		coiParams.LatestVer = args.Msg.LatestVer
+		coiParams.Sync = args.Msg.Sync
+	}
+	_, err := core.T.CopyObject(lom, r.dm, coiParams)
+	core.FreeCOI(coiParams)
+	return err
 }
 
 // NOTE: strict(est) error handling: abort on any of the errors below
@@ -362,6 +386,12 @@ func (r *XactTCB) _str() (s string) {
 	if msg.Sync {
 		s = ", synchronize"
 	}
+	if msg.NumRetries > 0 {
+		s += ", retries " + strconv.Itoa(msg.NumRetries)
+	}
+	if msg.ContinueOnError {
+		s += ", continue-on-error"
+	}
 	return s
 }
 
@@ -377,6 +407,7 @@ func (r *XactTCB) Snap() (snap *core.Snap) {
 	r.ToSnap(snap)
 
 	snap.IdleX = r.IsIdle()
+	snap.Ext = r.retry.snap(r.p.args.Msg.Transform.Params)
 	f, t := r.FromTo()
 	snap.SrcBck, snap.DstBck = f.Clone(), t.Clone()
 	return
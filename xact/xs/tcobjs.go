@@ -39,15 +39,19 @@ type (
 			m   map[string]*tcowi
 			mtx sync.RWMutex
 		}
-		args     *xreg.TCObjsArgs
-		workCh   chan *cmn.TCObjsMsg
-		chanFull atomic.Int64
+		args      *xreg.TCObjsArgs
+		etlParams cos.StrKVs // most recent (ETL) job's parameters; protected by pending.mtx
+		workCh    chan *cmn.TCObjsMsg
+		retry     tcretry
+		chanFull  atomic.Int64
 		streamingX
 		owt cmn.OWT
 	}
 	tcowi struct {
-		r   *XactTCObjs
-		msg *cmn.TCObjsMsg
+		r    *XactTCObjs
+		msg  *cmn.TCObjsMsg
+		dp   core.DP // r.args.DP with this message's transformer parameters, if any
+		pace tcpace
 		// finishing
 		refc atomic.Int32
 	}
@@ -98,7 +102,7 @@ func (p *tcoFactory) Start() error {
 		sizePDU = memsys.DefaultBufSize
 	}
 
-	if err := p.newDM(p.Args.UUID /*trname*/, r.recv, r.config, r.owt, sizePDU); err != nil {
+	if err := p.newDM("tco-"+p.Args.UUID /*trname*/, r.recv, r.config, r.owt, sizePDU); err != nil {
 		return err
 	}
 
@@ -129,15 +133,26 @@ func (r *XactTCObjs) Snap() (snap *core.Snap) {
 	r.ToSnap(snap)
 
 	snap.IdleX = r.IsIdle()
+	r.pending.mtx.RLock()
+	params := r.etlParams
+	r.pending.mtx.RUnlock()
+	snap.Ext = r.retry.snap(params)
 	f, t := r.FromTo()
 	snap.SrcBck, snap.DstBck = f.Clone(), t.Clone()
 	return
 }
 
 func (r *XactTCObjs) Begin(msg *cmn.TCObjsMsg) {
-	wi := &tcowi{r: r, msg: msg}
+	wi := &tcowi{r: r, msg: msg, dp: r.args.DP}
+	params := msg.Transform.Params
+	if pdp, ok := wi.dp.(core.ParamDP); ok && len(params) > 0 {
+		wi.dp = pdp.WithParams(params)
+	}
 	r.pending.mtx.Lock()
 	r.pending.m[msg.TxnUUID] = wi
+	if len(params) > 0 {
+		r.etlParams = params
+	}
 	r.wiCnt.Inc()
 	r.pending.mtx.Unlock()
 }
@@ -172,6 +187,7 @@ func (r *XactTCObjs) Run(wg *sync.WaitGroup) {
 			}
 			nat := smap.CountActiveTs()
 			wi.refc.Store(int32(nat - 1))
+			wi.pace.init(&wi.msg.CopyBckMsg, nat)
 
 			// run
 			var wg *sync.WaitGroup
@@ -309,9 +325,49 @@ func (r *XactTCObjs) _put(hdr *transport.ObjHdr, objReader io.Reader, lom *core.
 
 func (wi *tcowi) do(lom *core.LOM, lrit *lriterator) {
 	var (
+		msg       = &wi.msg.CopyBckMsg
 		objNameTo = wi.msg.ToName(lom.ObjName)
-		buf, slab = core.T.PageMM().Alloc()
 	)
+	if wi.pace.rate > 0 {
+		_ = lom.Load(true /*cache it*/, false /*locked*/) // (source size to account for; not-found gets handled below)
+	}
+	if !wi.pace.wait(wi.r, lom.Lsize(true /*not loaded*/)) {
+		return // (aborted)
+	}
+	err := wi.r.retry.do(wi.r, msg, lom, func() error { return wi._do(lom, objNameTo) })
+	switch {
+	case err == nil:
+		if cmn.Rom.FastV(5, cos.SmoduleXs) {
+			nlog.Infoln(wi.r.Name()+":", lom.Cname(), "=>", wi.r.args.BckTo.Cname(objNameTo))
+		}
+	case cos.IsNotExist(err, 0):
+		if lrit.lrp == lrpList {
+			wi.r.AddErr(err, 5, cos.SmoduleXs)
+		}
+	case cos.IsErrOOS(err):
+		wi.r.Abort(err)
+	default:
+		tcfailed(wi.r, msg, err)
+	}
+}
+
+func (wi *tcowi) _do(lom *core.LOM, objNameTo string) error {
+	// ETL input filter: skip or copy as is (untransformed)
+	dp, owt := wi.dp, wi.r.owt
+	if f := wi.msg.Filter; f != nil && dp != nil {
+		if f.NeedsMD() {
+			if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+				return err
+			}
+		}
+		if !f.Match(lom.ObjName, lom.Lsize(true), lom.GetCustomMD()) {
+			if !f.CopyUnmatched {
+				return nil
+			}
+			dp, owt = nil, cmn.OwtCopy
+		}
+	}
+	buf, slab := core.T.PageMM().Alloc()
 
 	// under ETL, the returned sizes of transformed objects are unknown (`cos.ContentLengthUnknown`)
 	// until after the transformation; here we are disregarding the size anyway as the stats
@@ -319,13 +375,13 @@ func (wi *tcowi) do(lom *core.LOM, lrit *lriterator) {
 
 	coiParams := core.AllocCOI()
 	{
-		coiParams.DP = wi.r.args.DP
+		coiParams.DP = dp
 		coiParams.Xact = wi.r
 		coiParams.Config = wi.r.config
 		coiParams.BckTo = wi.r.args.BckTo
 		coiParams.ObjnameTo = objNameTo
 		coiParams.Buf = buf
-		coiParams.OWT = wi.r.owt
+		coiParams.OWT = owt
 		coiParams.DryRun = wi.msg.DryRun
 		coiParams.LatestVer = wi.msg.LatestVer
 		coiParams.Sync = wi.msg.Sync
@@ -333,14 +389,7 @@ func (wi *tcowi) do(lom *core.LOM, lrit *lriterator) {
 	_, err := core.T.CopyObject(lom, wi.r.p.dm, coiParams)
 	core.FreeCOI(coiParams)
 	slab.Free(buf)
-
-	if err != nil {
-		if !cos.IsNotExist(err, 0) || lrit.lrp == lrpList {
-			wi.r.AddErr(err, 5, cos.SmoduleXs)
-		}
-	} else if cmn.Rom.FastV(5, cos.SmoduleXs) {
-		nlog.Infoln(wi.r.Name()+":", lom.Cname(), "=>", wi.r.args.BckTo.Cname(objNameTo))
-	}
+	return err
 }
 
 //
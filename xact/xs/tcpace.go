@@ -0,0 +1,121 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// bandwidth limit and scheduled execution (x-tcb and x-tco); see apc.CopyBckMsg:
+// - cluster-wide LimitBph is split evenly between active targets, with each target enforcing
+//   its share by delaying its data movers (token bucket refilled continuously, burst of at most
+//   tcpaceBurst worth of bytes);
+// - bytes are accounted for prior to copying (transforming) a given object, based on its source size;
+// - outside the Schedule the data movers pause, and resume when the scheduled time comes
+//   (the check is done every minute - cron granularity);
+// - apc.CopyScheduleOffPeak resolves at runtime to the current `housekeeping.window` (no window - no pausing);
+// - waiting is abort-aware; dry-run is never paced.
+
+const tcpaceBurst = time.Minute
+
+type tcpace struct {
+	crons   []*cos.Cron
+	rate    float64 // bytes per nanosecond (this target's share)
+	avail   float64 // bytes (negative when reserved ahead)
+	last    int64   // mono time
+	mu      sync.Mutex
+	paused  atomic.Bool
+	offpeak bool
+	on      bool
+}
+
+func (pc *tcpace) init(msg *apc.CopyBckMsg, nat int) {
+	if msg.DryRun {
+		return
+	}
+	pc.offpeak = msg.Schedule == apc.CopyScheduleOffPeak
+	pc.crons, _ = msg.ParseSchedule() // (validated)
+	if msg.LimitBph > 0 {
+		pc.rate = float64(msg.LimitBph) / float64(max(nat, 1)) / float64(time.Hour)
+		pc.last = mono.NanoTime()
+	}
+	pc.on = pc.rate > 0 || pc.offpeak || len(pc.crons) > 0
+}
+
+// to be called prior to copying a given object; returns false if aborted while waiting
+func (pc *tcpace) wait(xctn core.Xact, size int64) bool {
+	if !pc.on {
+		return true
+	}
+	for !pc.scheduled(time.Now()) {
+		if pc.paused.CAS(false, true) {
+			nlog.Infoln(xctn.Name(), "paused: outside scheduled time")
+		}
+		now := time.Now()
+		if !_pacesleep(xctn, now.Truncate(time.Minute).Add(time.Minute).Sub(now)) {
+			return false
+		}
+	}
+	if pc.paused.CAS(true, false) {
+		nlog.Infoln(xctn.Name(), "resumed")
+	}
+	if pc.rate == 0 || size <= 0 {
+		return true
+	}
+	return _pacesleep(xctn, pc.reserve(size))
+}
+
+func (pc *tcpace) scheduled(now time.Time) bool {
+	if pc.offpeak {
+		return cmn.GCO.Get().Housekeep.Pace(now) != cmn.HkPaceFloor
+	}
+	if len(pc.crons) == 0 {
+		return true
+	}
+	for _, cron := range pc.crons {
+		if cron.Match(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// take `size` bytes from the bucket; return time to wait until the bucket is no longer in debt
+func (pc *tcpace) reserve(size int64) (d time.Duration) {
+	pc.mu.Lock()
+	now := mono.NanoTime()
+	pc.avail = min(pc.avail+float64(now-pc.last)*pc.rate, pc.rate*float64(tcpaceBurst))
+	pc.last = now
+	pc.avail -= float64(size)
+	if pc.avail < 0 {
+		d = time.Duration(-pc.avail / pc.rate)
+	}
+	pc.mu.Unlock()
+	return d
+}
+
+func _pacesleep(xctn core.Xact, d time.Duration) bool {
+	if d <= 0 {
+		return true
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-xctn.ChanAbort():
+		return false
+	}
+}
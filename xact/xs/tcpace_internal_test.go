@@ -0,0 +1,129 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+type tpaceXact struct {
+	xact.Base
+}
+
+func (*tpaceXact) Run(*sync.WaitGroup) {}
+func (*tpaceXact) Snap() *core.Snap    { return &core.Snap{} }
+
+func newTpaceXact() *tpaceXact {
+	cos.InitShortID(0)
+	r := &tpaceXact{}
+	r.InitBase(cos.GenUUID(), apc.ActCopyBck, nil)
+	return r
+}
+
+func TestTcpaceInit(t *testing.T) {
+	var pc tcpace
+	pc.init(&apc.CopyBckMsg{DryRun: true, LimitBph: cos.GiB, Schedule: "0 2 * * *"}, 1)
+	tassert.Errorf(t, !pc.on, "dry-run must never be paced")
+	tassert.Errorf(t, pc.wait(newTpaceXact(), cos.TiB), "expecting no wait")
+
+	pc = tcpace{}
+	pc.init(&apc.CopyBckMsg{}, 4)
+	tassert.Errorf(t, !pc.on, "expecting no pacing when neither limit nor schedule is specified")
+
+	// cluster-wide limit split between targets
+	pc = tcpace{}
+	pc.init(&apc.CopyBckMsg{LimitBph: 4 * 3600 * cos.MiB}, 4)
+	tassert.Errorf(t, pc.on, "expecting pacing")
+	perSec := pc.rate * float64(time.Second)
+	tassert.Errorf(t, perSec > cos.MiB-1 && perSec < cos.MiB+1, "expected per-target rate 1MiB/s, got %.0fB/s", perSec)
+}
+
+func TestTcpaceThrottle(t *testing.T) {
+	const (
+		perSec = 10 * cos.MiB
+		size   = cos.MiB // 100ms worth
+		cnt    = 3
+	)
+	var (
+		pc   tcpace
+		xctn = newTpaceXact()
+	)
+	pc.init(&apc.CopyBckMsg{LimitBph: 2 * 3600 * perSec}, 2)
+
+	started := time.Now()
+	for range cnt {
+		tassert.Fatalf(t, pc.wait(xctn, size), "unexpected abort")
+	}
+	elapsed := time.Since(started)
+	expected := time.Duration(cnt) * time.Second * size / perSec
+	tassert.Errorf(t, elapsed >= expected*9/10, "throttled too little: %v (expected ~%v)", elapsed, expected)
+	tassert.Errorf(t, elapsed < expected*5, "throttled too much: %v (expected ~%v)", elapsed, expected)
+
+	// debt accumulates: reserving ahead returns the time to pay it off
+	d1 := pc.reserve(size)
+	d2 := pc.reserve(size)
+	tassert.Errorf(t, d2-d1 >= 90*time.Millisecond, "expecting debt to accumulate, got %v, %v", d1, d2)
+}
+
+func TestTcpaceBurst(t *testing.T) {
+	var pc tcpace
+	pc.init(&apc.CopyBckMsg{LimitBph: 3600 * cos.MiB}, 1)
+
+	// long idle: available bytes capped at tcpaceBurst worth
+	pc.last = mono.NanoTime() - int64(10*tcpaceBurst)
+	burst := int64(pc.rate * float64(tcpaceBurst))
+	d := pc.reserve(burst - cos.KiB)
+	tassert.Errorf(t, d == 0, "expecting no wait within burst, got %v", d)
+	d = pc.reserve(2 * cos.KiB)
+	tassert.Errorf(t, d > 0 && d < time.Second, "expecting short wait past burst, got %v", d)
+}
+
+func TestTcpaceAbort(t *testing.T) {
+	var (
+		pc   tcpace
+		xctn = newTpaceXact()
+	)
+	pc.init(&apc.CopyBckMsg{LimitBph: 3600 * cos.KiB}, 1) // 1KiB/s
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		xctn.Abort(nil)
+	}()
+	started := time.Now()
+	tassert.Errorf(t, !pc.wait(xctn, cos.MiB), "expecting wait to return false upon abort")
+	tassert.Errorf(t, time.Since(started) < 10*time.Second, "abort took too long: %v", time.Since(started))
+}
+
+func TestTcpaceScheduled(t *testing.T) {
+	var pc tcpace
+	pc.init(&apc.CopyBckMsg{Schedule: "* 1-2 * * *;30 23 * * *"}, 1)
+	tassert.Fatalf(t, pc.on && len(pc.crons) == 2, "expecting 2 cron expressions, got %d", len(pc.crons))
+
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local)
+	tests := []struct {
+		tm       time.Time
+		expected bool
+	}{
+		{day.Add(30 * time.Minute), false},
+		{day.Add(time.Hour), true},
+		{day.Add(2*time.Hour + 59*time.Minute), true},
+		{day.Add(3 * time.Hour), false},
+		{day.Add(23*time.Hour + 30*time.Minute), true},
+		{day.Add(23*time.Hour + 31*time.Minute), false},
+	}
+	for _, test := range tests {
+		tassert.Errorf(t, pc.scheduled(test.tm) == test.expected, "%s: expected scheduled=%t",
+			test.tm.Format(time.Kitchen), test.expected)
+	}
+}
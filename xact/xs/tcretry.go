@@ -0,0 +1,100 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// per-object copy retries (x-tcb and x-tco); see apc.CopyBckMsg
+
+const (
+	tcretryDfltBackoff = time.Second
+	tcretryMaxBackoff  = time.Minute
+)
+
+type tcretry struct {
+	stats xact.CopyRetries
+	mu    sync.Mutex
+}
+
+// call `cb` (that copies a given object) and retry upon failure, as per msg.NumRetries;
+// returns the last error, if any, leaving it up to the caller to skip the object or abort
+func (rt *tcretry) do(xctn core.Xact, msg *apc.CopyBckMsg, lom *core.LOM, cb func() error) (err error) {
+	backoff := msg.RetryBackoff.D()
+	if backoff <= 0 {
+		backoff = tcretryDfltBackoff
+	}
+	for i := 0; ; i++ {
+		if err = cb(); err == nil {
+			if i > 0 {
+				rt.add(&rt.stats.Retried, &rt.stats.NRetried, lom.Cname())
+			}
+			return nil
+		}
+		if !_retriable(err) {
+			return err
+		}
+		if i >= msg.NumRetries {
+			break
+		}
+		if cmn.Rom.FastV(4, cos.SmoduleXs) {
+			nlog.Warningln(xctn.Name(), "retrying", lom.Cname(), "in", backoff, "[", err, i+1, "]")
+		}
+		select {
+		case <-time.After(backoff):
+		case <-xctn.ChanAbort():
+			return err
+		}
+		backoff = min(backoff*2, tcretryMaxBackoff)
+	}
+	rt.add(&rt.stats.Failed, &rt.stats.NFailed, lom.Cname())
+	return err
+}
+
+func _retriable(err error) bool {
+	return !cos.IsNotExist(err, 0) && !cos.IsErrOOS(err) && err != cmn.ErrSkip && !cmn.IsErrAborted(err)
+}
+
+func (rt *tcretry) add(names *[]string, cnt *int64, cname string) {
+	rt.mu.Lock()
+	if len(*names) < xact.MaxRetryNames {
+		*names = append(*names, cname)
+	}
+	*cnt++
+	rt.mu.Unlock()
+}
+
+// (core.Snap.Ext) including ETL parameters, if any
+func (rt *tcretry) snap(params cos.StrKVs) any {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if rt.stats.NRetried == 0 && rt.stats.NFailed == 0 && len(params) == 0 {
+		return nil
+	}
+	ext := &xact.TCExt{ETLParams: params, CopyRetries: rt.stats}
+	ext.Retried = append([]string(nil), rt.stats.Retried...)
+	ext.Failed = append([]string(nil), rt.stats.Failed...)
+	return ext
+}
+
+// once the retries are exhausted
+func tcfailed(xctn core.Xact, msg *apc.CopyBckMsg, err error) error {
+	if msg.ContinueOnError {
+		xctn.AddErr(err, 4, cos.SmoduleXs)
+		return nil
+	}
+	xctn.Abort(err)
+	return err
+}
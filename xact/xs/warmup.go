@@ -0,0 +1,180 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Warm-up (apc.ActWarmUp) - prefetch and preload in one list/range/prefix job:
+// - remote objects that are not present in the cluster (or, with `latest-ver`, are out of date)
+//   get cold-GET from the remote backend;
+// - objects that are present get read in their entirety, to populate the OS page cache
+//   (and in-memory metadata cache);
+// - stats: all warmed-up objects (objs, bytes), of which prefetched (in-objs, in-bytes);
+// - works with ais:// buckets as well (in which case it is preload only);
+// - compare with prefetch (remote buckets only) and `ais advanced preload` (entire bucket, no filtering).
+
+type (
+	wupFactory struct {
+		xreg.RenewBase
+		xctn *XactWarmUp
+		msg  *apc.WarmUpMsg
+	}
+	XactWarmUp struct {
+		msg  *apc.WarmUpMsg
+		slab *memsys.Slab
+		lriterator
+		xact.Base
+		latestVer bool
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactWarmUp)(nil)
+	_ xreg.Renewable = (*wupFactory)(nil)
+	_ lrwi           = (*XactWarmUp)(nil)
+)
+
+////////////////
+// wupFactory //
+////////////////
+
+func (*wupFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	msg := args.Custom.(*apc.WarmUpMsg)
+	debug.Assert(!msg.IsList() || !msg.HasTemplate())
+	return &wupFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, msg: msg}
+}
+
+func (p *wupFactory) Start() (err error) {
+	if err = p.Bck.Init(core.T.Bowner()); err != nil {
+		return err
+	}
+	p.xctn, err = newWarmUp(&p.Args, p.Bck, p.msg)
+	return err
+}
+
+func (*wupFactory) Kind() string     { return apc.ActWarmUp }
+func (p *wupFactory) Get() core.Xact { return p.xctn }
+
+func (*wupFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+////////////////
+// XactWarmUp //
+////////////////
+
+func newWarmUp(xargs *xreg.Args, bck *meta.Bck, msg *apc.WarmUpMsg) (r *XactWarmUp, err error) {
+	r = &XactWarmUp{msg: msg}
+	if err = r.lriterator.init(r, &msg.ListRange, bck); err != nil {
+		return nil, err
+	}
+	r.slab, err = core.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
+	debug.AssertNoErr(err)
+
+	r.InitBase(xargs.UUID, apc.ActWarmUp, bck)
+	r.latestVer = bck.IsRemote() && (bck.VersionConf().ValidateWarmGet || msg.LatestVer)
+	return r, nil
+}
+
+func (r *XactWarmUp) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	err := r.lriterator.run(r, core.T.Sowner().Get())
+	if err != nil {
+		r.AddErr(err, 5, cos.SmoduleXs)
+	}
+	r.lriterator.wait()
+	r.Finish()
+}
+
+func (r *XactWarmUp) do(lom *core.LOM, lrit *lriterator) {
+	var (
+		ecode int
+		err   error
+	)
+	lom.Lock(false)
+	oa, deleted, err := lom.LoadLatest(r.latestVer)
+	if err == nil && oa == nil {
+		// present and (if need be) validated - preload
+		err = r.preload(lom)
+		lom.Unlock(false)
+		goto ret
+	}
+	lom.Unlock(false)
+
+	switch {
+	case deleted: // remotely
+		debug.Assert(r.latestVer && err != nil)
+		if lrit.lrp != lrpList {
+			return
+		}
+		goto ret
+	case oa != nil: // not latest
+	case !cmn.IsErrObjNought(err):
+		goto ret
+	case !lom.Bck().IsRemote():
+		goto ret // (ais:// bucket: nothing to prefetch)
+	}
+
+	// prefetch (see related comment in prefetch.go)
+	lom.SetAtimeUnix(-time.Now().UnixNano())
+	ecode, err = core.T.GetCold(context.Background(), lom, cmn.OwtGetPrefetchLock)
+	if err == nil {
+		size := lom.Lsize()
+		r.ObjsAdd(1, size)
+		r.InObjsAdd(1, size)
+	}
+
+ret:
+	switch {
+	case err == nil:
+	case cos.IsNotExist(err, ecode) && lrit.lrp != lrpList:
+		// not found, prefix or range
+	case r.msg.ContinueOnError:
+		r.AddErr(err, 4, cos.SmoduleXs)
+	case !cmn.IsErrAborted(err):
+		r.Abort(err)
+	}
+}
+
+// under rlock
+func (r *XactWarmUp) preload(lom *core.LOM) error {
+	lmfh, err := lom.Open()
+	if err != nil {
+		return err
+	}
+	buf := r.slab.Alloc()
+	n, err := cos.CopyBuffer(cos.WriterOnly{Writer: io.Discard}, lmfh, buf)
+	r.slab.Free(buf)
+	cos.Close(lmfh)
+	if err == nil {
+		r.ObjsAdd(1, n)
+	}
+	return err
+}
+
+func (r *XactWarmUp) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -297,6 +297,57 @@ func TestXactionQueryFinished(t *testing.T) {
 	}
 }
 
+func TestXactionGC(t *testing.T) {
+	var (
+		bmd   = mock.NewBaseBownerMock()
+		bck1  = meta.NewBck("test1", apc.AIS, cmn.NsGlobal)
+		bck2  = meta.NewBck("test2", apc.AIS, cmn.NsGlobal)
+		tMock = mock.NewTarget(bmd)
+	)
+	core.T = tMock
+	xreg.TestReset()
+
+	defer xreg.AbortAll(nil)
+
+	bmd.Add(bck1)
+	bmd.Add(bck2)
+
+	xreg.RegBckXact(&xs.TestBmvFactory{})
+	cos.InitShortID(0)
+
+	rns1 := xreg.RenewBckRename(bck1, bck1, cos.GenUUID(), 123, "phase")
+	tassert.Fatalf(t, rns1.Err == nil && rns1.Entry.Get() != nil, "Xaction must be created")
+	rns2 := xreg.RenewBckRename(bck2, bck2, cos.GenUUID(), 123, "phase")
+	tassert.Fatalf(t, rns2.Err == nil && rns2.Entry.Get() != nil, "Xaction must be created")
+	xctn1, kind := rns1.Entry.Get(), rns1.Entry.Kind()
+	xctn1.Finish()
+
+	n := xreg.GC("", apc.ActLRU, 0)
+	tassert.Errorf(t, n == 0, "different kind: expected nothing removed, got %d", n)
+	n = xreg.GC("", kind, time.Hour)
+	tassert.Errorf(t, n == 0, "too recent: expected nothing removed, got %d", n)
+	n = xreg.GC(rns2.Entry.Get().ID(), "", 0)
+	tassert.Errorf(t, n == 0, "still running: expected nothing removed, got %d", n)
+	n = xreg.GC("", kind, 0)
+	tassert.Errorf(t, n == 1, "expected exactly one (finished) xaction removed, got %d", n)
+
+	finished := false
+	snaps, err := xreg.GetSnap(xreg.Flt{Kind: kind, OnlyRunning: &finished})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(snaps) == 0, "expected no finished xactions, got %d", len(snaps))
+
+	x, _ := xreg.GetXact(xctn1.ID())
+	tassert.Errorf(t, x == nil, "%s: expected to be removed", xctn1)
+	x, _ = xreg.GetXact(rns2.Entry.Get().ID())
+	tassert.Errorf(t, x != nil, "%s: expected to remain", rns2.Entry.Get())
+
+	// by ID
+	xctn2 := rns2.Entry.Get()
+	xctn2.Finish()
+	n = xreg.GC(xctn2.ID(), "", 0)
+	tassert.Errorf(t, n == 1, "%s: expected removed by ID, got %d", xctn2, n)
+}
+
 func TestBeid(t *testing.T) {
 	const div = uint64(100 * time.Millisecond)
 	num := 100
@@ -333,3 +384,47 @@ func TestBeid(t *testing.T) {
 		fmt.Printf("Warning: failed to reproduce %d time%s out of %d\n", cnt, cos.Plural(cnt), num)
 	}
 }
+
+func TestXactionDeadline(t *testing.T) {
+	var (
+		bmd   = mock.NewBaseBownerMock()
+		bck1  = meta.NewBck("test1", apc.AIS, cmn.NsGlobal)
+		bck2  = meta.NewBck("test2", apc.AIS, cmn.NsGlobal)
+		tMock = mock.NewTarget(bmd)
+	)
+	core.T = tMock
+	xreg.TestReset()
+
+	defer xreg.AbortAll(nil)
+
+	bmd.Add(bck1)
+	bmd.Add(bck2)
+
+	xreg.RegBckXact(&xs.TestBmvFactory{})
+	cos.InitShortID(0)
+
+	rns1 := xreg.RenewBckRename(bck1, bck1, cos.GenUUID(), 123, "phase")
+	tassert.Fatalf(t, rns1.Err == nil && rns1.Entry.Get() != nil, "Xaction must be created")
+	rns2 := xreg.RenewBckRename(bck2, bck2, cos.GenUUID(), 123, "phase")
+	tassert.Fatalf(t, rns2.Err == nil && rns2.Entry.Get() != nil, "Xaction must be created")
+	xctn1, xctn2 := rns1.Entry.Get(), rns2.Entry.Get()
+
+	tassert.Errorf(t, xreg.SetDeadline("invalid-id!", time.Second) != nil, "expected invalid UUID error")
+	tassert.Errorf(t, xreg.SetDeadline(xctn1.ID(), -time.Second) != nil, "expected negative max-runtime error")
+	tassert.CheckFatal(t, xreg.SetDeadline(xctn1.ID(), time.Millisecond))
+	tassert.CheckFatal(t, xreg.SetDeadline(xctn2.ID(), time.Hour))
+
+	time.Sleep(10 * time.Millisecond)
+	n := xreg.AbortExpired()
+	tassert.Errorf(t, n == 1, "expected exactly one xaction aborted, got %d", n)
+	tassert.Errorf(t, xctn1.IsAborted(), "%s: expected to be aborted", xctn1)
+	tassert.Errorf(t, !xctn2.IsAborted(), "%s: expected to keep running", xctn2)
+
+	snap := xctn1.Snap()
+	tassert.Errorf(t, snap.AbortErr == cmn.ErrXactDeadline.Error(), "expected %q, got %q", cmn.ErrXactDeadline, snap.AbortErr)
+
+	// removing the deadline
+	tassert.CheckFatal(t, xreg.SetDeadline(xctn2.ID(), 0))
+	n = xreg.AbortExpired()
+	tassert.Errorf(t, n == 0, "expected nothing aborted, got %d", n)
+}